@@ -63,6 +63,7 @@ func main() {
 		appLogger.Error(ctx, "Failed to instantiate Expenses application!", expensesAppErr)
 		os.Exit(1)
 	}
+	expensesApp.Start(ctx)
 	usersApp, usersAppErr := usersApp.NewApplication(ctx, cancel, appConfig, appLogger, appTracer, mongoClient)
 	if usersAppErr != nil {
 		appLogger.Error(ctx, "Failed to instantiate Users application!", usersAppErr)
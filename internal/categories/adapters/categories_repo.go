@@ -24,16 +24,22 @@ const collectionName string = "categories"
 
 // categoryModel defines category structure in MongoDB.
 type categoryModel struct {
-	ID        primitive.ObjectID  `bson:"_id,omitempty"`
-	Name      string              `bson:"name"`
-	ParentID  *primitive.ObjectID `bson:"parentId,omitempty"`
-	Path      string              `bson:"path"`
-	Icon      *string             `bson:"icon,omitempty"`
-	Level     int                 `bson:"level"`
-	CreatedBy string              `bson:"createdBy,omitempty"`
-	UpdatedBy *string             `bson:"updatedBy,omitempty"`
-	CreatedAt time.Time           `bson:"createdAt,omitempty"`
-	UpdatedAt *time.Time          `bson:"updatedAt,omitempty"`
+	ID       primitive.ObjectID  `bson:"_id,omitempty"`
+	Name     string              `bson:"name"`
+	ParentID *primitive.ObjectID `bson:"parentId,omitempty"`
+	Path     string              `bson:"path"`
+	Icon     *string             `bson:"icon,omitempty"`
+	Level    int                 `bson:"level"`
+	// Type is stored for income categories only, so updates of expense categories never reset it.
+	Type      *string    `bson:"type,omitempty"`
+	CreatedBy string     `bson:"createdBy,omitempty"`
+	UpdatedBy *string    `bson:"updatedBy,omitempty"`
+	CreatedAt time.Time  `bson:"createdAt,omitempty"`
+	UpdatedAt *time.Time `bson:"updatedAt,omitempty"`
+	// Trash state is managed with dedicated updates only, so regular updates never touch it.
+	DeletedBy   *string             `bson:"deletedBy,omitempty"`
+	DeletedAt   *time.Time          `bson:"deletedAt,omitempty"`
+	TrashRootID *primitive.ObjectID `bson:"trashRootId,omitempty"`
 }
 
 // CategoryRepository represents a struct to access categories MongoDB collection.
@@ -49,6 +55,11 @@ type CategoryRepoInterface interface {
 	Insert(ctx context.Context, category domain.Category) (*string, error)
 	Update(ctx context.Context, category domain.Category) (*domain.UpdateResult, error)
 	DeleteAll(ctx context.Context, filter domain.CategoryFilter) (*domain.DeleteResult, error)
+	SoftDeleteAll(
+		ctx context.Context,
+		filter domain.CategoryFilter,
+		deletion domain.SoftDeletion,
+	) (*domain.DeleteResult, error)
 	DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error)
 }
 
@@ -109,6 +120,18 @@ func (r *CategoryRepository) GetAll(
 		query = bson.M{}
 	}
 
+	if filter.Type != nil {
+		if *filter.Type == domain.CategoryTypeIncome {
+			query["type"] = string(domain.CategoryTypeIncome)
+		} else {
+			query["type"] = bson.M{"$ne": string(domain.CategoryTypeIncome)}
+		}
+	}
+
+	if !filter.IncludeTrashed {
+		query["deletedAt"] = bson.M{"$exists": false}
+	}
+
 	// span.AddEvent("start query", trace.WithAttributes(attribute.Any("filter", query)))
 
 	cursor, findError := r.collection().Find(ctx, query)
@@ -146,7 +169,7 @@ func (r *CategoryRepository) GetOne(ctx context.Context, id string) (*domain.Cat
 
 	objID, _ := primitive.ObjectIDFromHex(id)
 
-	filter := bson.M{"_id": objID}
+	filter := bson.M{"_id": objID, "deletedAt": bson.M{"$exists": false}}
 	categoryDbModel := categoryModel{}
 	findError := r.collection().FindOne(ctx, filter).Decode(&categoryDbModel)
 	if findError != nil {
@@ -272,6 +295,56 @@ func (r *CategoryRepository) DeleteAll(
 	return result, nil
 }
 
+// SoftDeleteAll moves all categories that match the filter to the trash.
+func (r *CategoryRepository) SoftDeleteAll(
+	ctx context.Context,
+	filter domain.CategoryFilter,
+	deletion domain.SoftDeletion,
+) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "move categories to the trash in the database")
+	span.SetAttributes(attribute.String("rootId", deletion.RootID))
+	defer span.End()
+
+	if len(filter.Path) == 0 || !filter.FindChildren {
+		return nil, errors.New("soft delete requires a category path")
+	}
+
+	rootID, rootIDErr := primitive.ObjectIDFromHex(deletion.RootID)
+	if rootIDErr != nil {
+		return nil, errors.Wrap(rootIDErr, "parse trash root id")
+	}
+
+	// Categories that are already in the trash keep their own trash root to be restored separately.
+	query := bson.M{
+		"path": bson.M{
+			"$regex": primitive.Regex{
+				Pattern: fmt.Sprintf("^%s.*", strings.ReplaceAll(filter.Path, "|", "\\|")),
+				Options: "i",
+			},
+		},
+		"deletedAt": bson.M{"$exists": false},
+	}
+	updater := bson.M{
+		"$set": bson.M{
+			"deletedBy":   deletion.DeletedBy,
+			"deletedAt":   deletion.DeletedAt,
+			"trashRootId": rootID,
+		},
+	}
+
+	mongoUpdResult, mongoUpdErr := r.collection().UpdateMany(ctx, query, updater)
+	if mongoUpdErr != nil {
+		tracer.AddSpanError(span, mongoUpdErr)
+		return nil, errors.Wrap(mongoUpdErr, "mongo soft delete categories")
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(mongoUpdResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
 func (r CategoryRepository) marshalCategory(category domain.Category) categoryModel {
 	id, _ := primitive.ObjectIDFromHex(category.ID())
 	var parentID *primitive.ObjectID
@@ -279,6 +352,11 @@ func (r CategoryRepository) marshalCategory(category domain.Category) categoryMo
 		parentIDObj, _ := primitive.ObjectIDFromHex(*category.ParentID())
 		parentID = &parentIDObj
 	}
+	var categoryType *string
+	if category.Type() == domain.CategoryTypeIncome {
+		incomeType := string(domain.CategoryTypeIncome)
+		categoryType = &incomeType
+	}
 
 	return categoryModel{
 		ID:       id,
@@ -287,6 +365,7 @@ func (r CategoryRepository) marshalCategory(category domain.Category) categoryMo
 		ParentID: parentID,
 		Path:     category.Path(),
 		Level:    category.Level(),
+		Type:     categoryType,
 	}
 }
 
@@ -298,9 +377,12 @@ func (r CategoryRepository) unmarshalCategory(categoryModel categoryModel) (*dom
 	}
 	cat, catErr := domain.NewCategory(categoryModel.ID.Hex(), categoryModel.Name,
 		parentID, categoryModel.Path, categoryModel.Icon, categoryModel.Level)
-	cat.SetMetadata(categoryModel.CreatedBy, categoryModel.CreatedAt, categoryModel.UpdatedBy, categoryModel.UpdatedAt)
 	if catErr != nil {
 		return nil, errors.Wrap(catErr, "unmarshal category")
 	}
+	cat.SetMetadata(categoryModel.CreatedBy, categoryModel.CreatedAt, categoryModel.UpdatedBy, categoryModel.UpdatedAt)
+	if categoryModel.Type != nil {
+		cat.SetType(domain.CategoryType(*categoryModel.Type))
+	}
 	return cat, nil
 }
@@ -21,6 +21,7 @@ type AddCategoryCommand struct {
 	Path     string
 	Icon     *string
 	Level    int
+	Type     domain.CategoryType
 }
 
 // AddCategoryHandler defines a handler to add category.
@@ -58,6 +59,19 @@ func (h AddCategoryHandler) Handle(ctx context.Context, cmd AddCategoryCommand)
 	if categoryErr != nil {
 		return nil, errors.Wrap(categoryErr, "prepare category failed")
 	}
+	category.SetType(cmd.Type)
+
+	// Subcategories belong to the category tree of their parent.
+	if cmd.ParentID != nil {
+		parent, parentErr := h.repo.GetOne(ctx, *cmd.ParentID)
+		if parentErr != nil {
+			tracer.AddSpanError(span, parentErr)
+			return nil, errors.Wrap(parentErr, "get parent category")
+		}
+		if parent != nil {
+			category.SetType(parent.Type())
+		}
+	}
 
 	insRes, insResErr := h.repo.Insert(ctx, *category)
 	if insResErr != nil {
@@ -68,6 +68,7 @@ func TestAddCategoryHandler_RepoError_ThrowsError(t *testing.T) {
 		return cat.Name() == cmd.Name && cat.Path() == fmt.Sprintf("%s|%s", cmd.Path, cmd.ID) &&
 			cat.Level() == cmd.Level && cat.ParentID() == cmd.ParentID
 	}
+	repo.On("GetOne", mock.Anything, parentID).Return(nil, nil)
 	repo.On("Insert", mock.Anything,
 		mock.MatchedBy(matchCategoryFn)).Return(nil, errors.New("error"))
 
@@ -103,6 +104,7 @@ func TestAddCategoryHandler_RepoSuccess_ReturnsNewId(t *testing.T) {
 		return cat.Name() == cmd.Name && cat.Path() == fmt.Sprintf("%s|%s", cmd.Path, cmd.ID) &&
 			cat.Level() == cmd.Level && cat.ParentID() == cmd.ParentID
 	}
+	repo.On("GetOne", mock.Anything, parentID).Return(nil, nil)
 	repo.On("Insert", mock.Anything,
 		mock.MatchedBy(matchCategoryFn)).Return(&categoryID, nil)
 
@@ -118,3 +120,39 @@ func TestAddCategoryHandler_RepoSuccess_ReturnsNewId(t *testing.T) {
 	assert.Equal(t, &categoryID, query, "Should return category id.")
 	assert.Nil(t, err, "Error result should be nil.")
 }
+
+func TestAddCategoryHandler_IncomeParent_InheritsParentType(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.CategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	categoryID := "categoryId"
+	parentID := "parentId"
+	parent, _ := domain.NewCategory(parentID, "Salary", nil, "|parentId", nil, 1)
+	parent.SetType(domain.CategoryTypeIncome)
+	cmd := command.AddCategoryCommand{
+		ID:       categoryID,
+		Name:     "Bonus",
+		ParentID: &parentID,
+		Path:     "|parentId",
+		Level:    2,
+	}
+
+	matchCategoryFn := func(cat domain.Category) bool {
+		return cat.Type() == domain.CategoryTypeIncome
+	}
+	repo.On("GetOne", mock.Anything, parentID).Return(parent, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(matchCategoryFn)).Return(&categoryID, nil)
+
+	// SUT
+	sut := command.NewAddCategoryHandler(repo, log)
+
+	// Act
+	query, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Equal(t, &categoryID, query, "Should return category id.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -14,9 +15,10 @@ import (
 // DeleteCategoryCommand defines a category delete command.
 type DeleteCategoryCommand struct {
 	CategoryID string
+	DeletedBy  string
 }
 
-// DeleteCategoryHandler defines a handler to delete category.
+// DeleteCategoryHandler defines a handler to move category along with its children to the trash.
 type DeleteCategoryHandler struct {
 	repo   adapters.CategoryRepoInterface
 	logger logger.LogInterface
@@ -57,7 +59,13 @@ func (h DeleteCategoryHandler) Handle(ctx context.Context, cmd DeleteCategoryCom
 		FindChildren: true,
 	}
 
-	deleteCmdResult, deleteCmdErr := h.repo.DeleteAll(ctx, deleteFilter)
+	deletion := domain.SoftDeletion{
+		RootID:    category.ID(),
+		DeletedBy: cmd.DeletedBy,
+		DeletedAt: time.Now(),
+	}
+
+	deleteCmdResult, deleteCmdErr := h.repo.SoftDeleteAll(ctx, deleteFilter, deletion)
 	if deleteCmdErr != nil {
 		return nil, errors.Wrap(deleteCmdErr, "delete category command")
 	}
@@ -94,6 +94,7 @@ func TestDeleteCategoryHandler_FailedDeleteCategory_ThrowsError(t *testing.T) {
 	icon := "icon"
 	cmd := command.DeleteCategoryCommand{
 		CategoryID: categoryID,
+		DeletedBy:  "user",
 	}
 	category, _ := domain.NewCategory(categoryID, "name", nil, "path", &icon, 1)
 
@@ -105,8 +106,8 @@ func TestDeleteCategoryHandler_FailedDeleteCategory_ThrowsError(t *testing.T) {
 	matchFilterFn := func(filter domain.CategoryFilter) bool {
 		return filter.Path == category.Path() && filter.FindChildren == true
 	}
-	repo.On("DeleteAll", mock.Anything,
-		mock.MatchedBy(matchFilterFn)).Return(nil, errors.New("error"))
+	repo.On("SoftDeleteAll", mock.Anything,
+		mock.MatchedBy(matchFilterFn), mock.Anything).Return(nil, errors.New("error"))
 
 	// SUT
 	sut := command.NewDeleteCategoryHandler(repo, log)
@@ -120,7 +121,7 @@ func TestDeleteCategoryHandler_FailedDeleteCategory_ThrowsError(t *testing.T) {
 	assert.NotNil(t, err, "Error result should not be nil.")
 }
 
-func TestDeleteCategoryHandler_DeletesCategory_ReturnsResult(t *testing.T) {
+func TestDeleteCategoryHandler_MovesCategoryToTrash_ReturnsResult(t *testing.T) {
 	t.Parallel()
 	// Arrange
 	repo := new(mocks.CategoryRepoInterface)
@@ -130,6 +131,7 @@ func TestDeleteCategoryHandler_DeletesCategory_ReturnsResult(t *testing.T) {
 	icon := "icon"
 	cmd := command.DeleteCategoryCommand{
 		CategoryID: categoryID,
+		DeletedBy:  "user",
 	}
 	category, _ := domain.NewCategory(categoryID, "name", nil, "path", &icon, 1)
 	deleteResult := &domain.DeleteResult{DeleteCount: 10}
@@ -142,8 +144,11 @@ func TestDeleteCategoryHandler_DeletesCategory_ReturnsResult(t *testing.T) {
 	matchFilterFn := func(filter domain.CategoryFilter) bool {
 		return filter.Path == category.Path() && filter.FindChildren == true
 	}
-	repo.On("DeleteAll", mock.Anything,
-		mock.MatchedBy(matchFilterFn)).Return(deleteResult, nil)
+	matchDeletionFn := func(deletion domain.SoftDeletion) bool {
+		return deletion.RootID == categoryID && deletion.DeletedBy == "user" && !deletion.DeletedAt.IsZero()
+	}
+	repo.On("SoftDeleteAll", mock.Anything,
+		mock.MatchedBy(matchFilterFn), mock.MatchedBy(matchDeletionFn)).Return(deleteResult, nil)
 
 	// SUT
 	sut := command.NewDeleteCategoryHandler(repo, log)
@@ -53,9 +53,11 @@ func (h MoveCategoryHandler) Handle(ctx context.Context, cmd MoveCategoryCommand
 		return nil, nil
 	}
 
+	// Trashed descendants have to follow the move as well to be restored in the right place.
 	pathFilter := domain.CategoryFilter{
-		CategoryID:   cmd.CategoryID,
-		FindChildren: true,
+		CategoryID:     cmd.CategoryID,
+		FindChildren:   true,
+		IncludeTrashed: true,
 	}
 
 	categoryUsages, categoryUsagesErr := h.repo.GetAll(ctx, pathFilter)
@@ -103,8 +103,9 @@ func TestMoveCategoryHandler_FailedToGetCategoryUsages_ThrowsError(t *testing.T)
 		DestinationID: destinationID,
 	}
 	pathFilter := domain.CategoryFilter{
-		CategoryID:   categoryID,
-		FindChildren: true,
+		CategoryID:     categoryID,
+		FindChildren:   true,
+		IncludeTrashed: true,
 	}
 	parentID := "parentId"
 	path := fmt.Sprintf("|%s", parentID)
@@ -146,8 +147,9 @@ func TestMoveCategoryHandler_FailedToGetDestinationCategory_ThrowsError(t *testi
 		DestinationID: destinationID,
 	}
 	pathFilter := domain.CategoryFilter{
-		CategoryID:   categoryID,
-		FindChildren: true,
+		CategoryID:     categoryID,
+		FindChildren:   true,
+		IncludeTrashed: true,
 	}
 	parentID := "parentId"
 	path := fmt.Sprintf("|%s", parentID)
@@ -192,8 +194,9 @@ func TestMoveCategoryHandler_NoDestinationCategory_ReturnsEmptyResult(t *testing
 		DestinationID: destinationID,
 	}
 	pathFilter := domain.CategoryFilter{
-		CategoryID:   categoryID,
-		FindChildren: true,
+		CategoryID:     categoryID,
+		FindChildren:   true,
+		IncludeTrashed: true,
 	}
 	parentID := "parentId"
 	path := fmt.Sprintf("|%s", parentID)
@@ -238,8 +241,9 @@ func TestMoveCategoryHandler_FailedToUpdateCategory_ThrowsError(t *testing.T) {
 		DestinationID: destinationID,
 	}
 	pathFilter := domain.CategoryFilter{
-		CategoryID:   categoryID,
-		FindChildren: true,
+		CategoryID:     categoryID,
+		FindChildren:   true,
+		IncludeTrashed: true,
 	}
 	parentID := "parentId"
 	path := fmt.Sprintf("|%s", parentID)
@@ -300,8 +304,9 @@ func TestMoveCategoryHandler_UpdateCategory_ReturnsResult(t *testing.T) {
 		DestinationID: destinationID,
 	}
 	pathFilter := domain.CategoryFilter{
-		CategoryID:   targetID,
-		FindChildren: true,
+		CategoryID:     targetID,
+		FindChildren:   true,
+		IncludeTrashed: true,
 	}
 	targetCat, _ := domain.NewCategory(targetID, "target name", &targetParentID, targetPath, &icon, targetLevel)
 	destCategory, _ := domain.NewCategory(destinationID, "dest name", &destinationParentID, destinationPath, &icon, destinationLevel)
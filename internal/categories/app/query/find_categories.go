@@ -15,6 +15,7 @@ type FindCategoriesQuery struct {
 	ParentID        *string
 	FindAllChildren bool
 	FindAll         bool
+	Type            *domain.CategoryType
 }
 
 // FindCategoriesHandler defines a handler to fetch categories.
@@ -51,6 +52,7 @@ func (h FindCategoriesHandler) Handle(
 		ParentID:     query.ParentID,
 		FindChildren: query.FindAllChildren,
 		FindAll:      query.FindAll,
+		Type:         query.Type,
 	}
 	res, resErr := h.repo.GetAll(ctx, filter)
 	if resErr != nil {
@@ -8,19 +8,30 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Defines values for CategoryType.
+const (
+	CategoryTypeExpense CategoryType = "expense"
+
+	CategoryTypeIncome CategoryType = "income"
+)
+
+// CategoryType defines whether a category tree is used for expenses or incomes.
+type CategoryType string
+
 // Category represents a domain object.
 type Category struct {
-	id        string
-	name      string
-	parentID  *string
-	path      string
-	icon      *string
-	level     int
-	parents   []Category
-	createdBy string
-	createdAt time.Time
-	updatedBy *string
-	updatedAt *time.Time
+	id           string
+	name         string
+	parentID     *string
+	path         string
+	icon         *string
+	level        int
+	categoryType CategoryType
+	parents      []Category
+	createdBy    string
+	createdAt    time.Time
+	updatedBy    *string
+	updatedAt    *time.Time
 }
 
 // NewCategory creates a new category domain object.
@@ -34,12 +45,13 @@ func NewCategory(id string, name string, parentID *string, path string, icon *st
 	}
 
 	return &Category{
-		id:       id,
-		name:     strings.TrimSpace(name),
-		parentID: parentID,
-		path:     strings.TrimSpace(path),
-		icon:     icon,
-		level:    level,
+		id:           id,
+		name:         strings.TrimSpace(name),
+		parentID:     parentID,
+		path:         strings.TrimSpace(path),
+		icon:         icon,
+		level:        level,
+		categoryType: CategoryTypeExpense,
 	}, nil
 }
 
@@ -73,6 +85,11 @@ func (c Category) Level() int {
 	return c.level
 }
 
+// Type returns category type.
+func (c Category) Type() CategoryType {
+	return c.categoryType
+}
+
 // Parents returns category parents.
 func (c Category) Parents() []Category {
 	return c.parents
@@ -122,6 +139,14 @@ func (c *Category) SetMetadata(createdBy string, createdAt time.Time, updatedBy
 	c.updatedAt = updatedAt
 }
 
+// SetType sets category type, an unknown type is an expense category type.
+func (c *Category) SetType(categoryType CategoryType) {
+	if categoryType != CategoryTypeIncome {
+		categoryType = CategoryTypeExpense
+	}
+	c.categoryType = categoryType
+}
+
 // SetParents sets category parents.
 func (c *Category) SetParents(parents []Category) {
 	c.parents = parents
@@ -8,4 +8,8 @@ type CategoryFilter struct {
 	Path         string
 	FindChildren bool
 	FindAll      bool
+	// Type limits categories to a single category tree type.
+	Type *CategoryType
+	// IncludeTrashed includes categories that were moved to the trash.
+	IncludeTrashed bool
 }
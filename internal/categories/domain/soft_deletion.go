@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// SoftDeletion holds details of moving a category subtree to the trash.
+type SoftDeletion struct {
+	RootID    string
+	DeletedBy string
+	DeletedAt time.Time
+}
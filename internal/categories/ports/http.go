@@ -11,6 +11,7 @@ import (
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/categories/app/command"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/categories/app/query"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/categories/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/auth"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/server/httperr"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
 )
@@ -43,6 +44,10 @@ func (h HTTPServer) FindCategories(echoCtx echo.Context, params FindCategoriesPa
 	if params.All != nil {
 		query.FindAll = *params.All
 	}
+	if params.Type != nil {
+		categoryType := domain.CategoryType(*params.Type)
+		query.Type = &categoryType
+	}
 	queryRes, queryErr := h.app.Queries.FindCategories.Handle(ctx, query)
 	if queryErr != nil {
 		h.app.Logger.Error(ctx, "Failed to fetch categories", queryErr)
@@ -105,6 +110,16 @@ func (h HTTPServer) AddCategory(echoCtx echo.Context) error {
 		Path:     newCategory.Path,
 		Level:    newCategory.Level,
 	}
+	if newCategory.Type != nil {
+		if *newCategory.Type != CategoryTypeExpense && *newCategory.Type != CategoryTypeIncome {
+			catErr := Error{
+				Code:    http.StatusBadRequest,
+				Message: fmt.Sprintf("Unknown category type %s", *newCategory.Type),
+			}
+			return echoCtx.JSON(http.StatusBadRequest, catErr)
+		}
+		cmdArgs.Type = domain.CategoryType(*newCategory.Type)
+	}
 	categoryID, categoryCrtErr := h.app.Commands.AddCategory.Handle(ctx, cmdArgs)
 	if categoryCrtErr != nil {
 		h.app.Logger.Error(ctx, "Failed to create category", categoryCrtErr)
@@ -150,7 +165,7 @@ func (h HTTPServer) UpdateCategory(echoCtx echo.Context, id string) error {
 	return echoCtx.JSON(http.StatusCreated, categoryUpd)
 }
 
-// DeleteCategory deletes a category.
+// DeleteCategory moves a category to the trash.
 func (h HTTPServer) DeleteCategory(echoCtx echo.Context, id string) error {
 	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete category http request")
 	span.SetAttributes(attribute.String("id", id))
@@ -159,6 +174,7 @@ func (h HTTPServer) DeleteCategory(echoCtx echo.Context, id string) error {
 
 	cmd := command.DeleteCategoryCommand{
 		CategoryID: id,
+		DeletedBy:  auth.UserFromContext(echoCtx),
 	}
 	cmdRes, cmdErr := h.app.Commands.DeleteCategory.Handle(ctx, cmd)
 	if cmdErr != nil {
@@ -236,6 +252,7 @@ func categoryToResponse(domainCategory *domain.Category) Category {
 		categoryParents := categoriesToResponse(domainCategory.Parents())
 		parents = &categoryParents
 	}
+	categoryType := CategoryType(domainCategory.Type())
 	category := Category{
 		Id: domainCategory.ID(),
 		NewCategory: NewCategory{
@@ -244,6 +261,7 @@ func categoryToResponse(domainCategory *domain.Category) Category {
 			ParentId: domainCategory.ParentID(),
 			Path:     domainCategory.Path(),
 			Level:    domainCategory.Level(),
+			Type:     &categoryType,
 			Parents:  parents,
 		},
 	}
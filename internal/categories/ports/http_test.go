@@ -337,6 +337,79 @@ func TestAddCategory_InvalidPayload_Returns400(t *testing.T) {
 	assert.NotEmpty(t, response.Body.String(), "Should not return empty body.")
 }
 
+func TestAddCategory_IncomeType_PassesTypeToCommand(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	handler := new(mocks.AddCategoryHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddCategory: handler,
+		},
+		Queries: app.Queries{},
+		Logger:  logger,
+	}
+	categoryJSON := `{"name":"Salary","type":"income"}`
+	categoryID := "categoryID"
+
+	matchCatFn := func(command command.AddCategoryCommand) bool {
+		return command.Type == domain.CategoryTypeIncome
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	handler.On("Handle", mock.Anything, mock.MatchedBy(matchCatFn)).Return(&categoryID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/categories", strings.NewReader(categoryJSON))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddCategory(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	handler.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+}
+
+func TestAddCategory_UnknownType_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	handler := new(mocks.AddCategoryHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddCategory: handler,
+		},
+		Queries: app.Queries{},
+		Logger:  logger,
+	}
+	categoryJSON := `{"name":"Salary","type":"savings"}`
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/categories", strings.NewReader(categoryJSON))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddCategory(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	handler.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
 func TestUpdateCategory_SuccessfulCommand_Returns200(t *testing.T) {
 	t.Parallel()
 	// Arrange
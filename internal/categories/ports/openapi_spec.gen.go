@@ -18,23 +18,25 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYTW/bOBD9K8TsHoUo2+7Jt9ZuAS+wzWK3wR66PtDiyGZBkQo/3AqG/vuCpBTJFu24",
-	"RZrmkEugmMN5w3mP82TvoVBVrSRKa2C2B1NssaLhcU4tbpRu/DMV4qaE2ac9/KqxhBn8kg/78m5T/gG/",
-	"3G9qsz3UWtWoLceQjzP/l6EpNK8tVxJmcCv5nUPCGVElsVskRb8/g1LpilqYgXOcQQa2qRFmYKzmcgNt",
-	"m4HGO8c1Mph98tlX7arN4J3WSnukQ/RCMZzih2AS1kaAXNrXrwZELi1uUEObQYXG0M3JRP3yQ8V2gH34",
-	"6j5erT9jYT3SuJeTw/DCg+6PUTIQuEMxWhmVLmmVqPsDrTDR+0nimmqUdhkofICYPjhWarEKD+d0MxJN",
-	"n4xqTZuYy24TRz1qaDhcF9x3YdrVNgODhdPcNv946NjMNVKN+o2LOPG/9/0R//j3I2TxVvhMcXU489ba",
-	"GlqfmMtSTdv78WZx46O5FT78xmny7muN0qAhBvUu5NqhNjH8t6vrq2t/alWjpDWHGbwOH8WjhXLzjqZO",
-	"Chu0U9i/0TotDaFCkCGclFpVgWnTGIvV1X8SApSmfp8nF95zyeYDQOCSVmhRm3D9D3F6xZBeHsQqUnJh",
-	"UZO11xH3UXcOg6iiAgcpdX2lSXqPobgshGM4PlJDii0XTKM8gUSFmA8RE7C1UgKpTKHFBh717zTK+ewr",
-	"r1VTK8+6D3h1fR0nkrQoA3u0rgUvAgv5ZxPv9pDwsqvjRZhkxzPf40MIKqkT9tFKiAM3ge8kfq2xsMgI",
-	"djEZGFdV1M+0EyINd16ZhKjnGqlFQyiR+GXQAJdjSU8E/Yax+TDX/MxAY98q1jza+Q9cb9qFfs1fDcrC",
-	"DRnqhfEYs9ph+5O0cl/lc1RKmvkQNJqG+Z6zNspGoE143SJ87tMYLjdiMDyypgYZUVFJy8VURXHrSEhn",
-	"x+Jy4S21GBHfldQNkM6muvkRPPRQBeNx8tBr0HS6/J64O30tsRD2nNgdaBn4aMhy4Ws8724JAu+5XS7O",
-	"eVvztgkB30hjibbYPhGLT+oRzfN2iIQyapdQxm3NwqC41Bxi/Pdfaxf2/zhBPL5ZXepU8WSH/Xsxq0tE",
-	"e6zBpE3lldoFh0rK+E+1w5Hop7r1Ad+v2oD9IzQ7eY3+K7zqk3QJxKoTb9QMjeWyP+7TmaOv6llZ45EQ",
-	"0lJyhm4u+S44EBB3XJ01yNuY9dstkkvWAbwY5U80yo6CNv7ooXc9f06L7ncLM8vz/VYZ64lpc1pzyGBH",
-	"NadrEXvbL0ZRdUcFoQoq/JJPvmr/DwAA//8FDJCwQhQAAA==",
+	"H4sIAAAAAAAC/+xYQW/bOBP9KwS/7yhE2XZPurVxC2SBbRa7CfbQ9YEWxxYLilTIobOCof++IClZsiQ7",
+	"TpCmOfQS2NHMvOHMm3m0djTXZaUVKLQ021GbF1Cy8PGKIWy0qf1nJuXNmmZfd/T/BtY0o/9Le7+0dUq/",
+	"wMPeqUl2tDK6AoMCQjzB/V8ONjeiQqEVzeidEvcOiOBErwkWQPLOP6FrbUqGNKPOCU4TinUFNKMWjVAb",
+	"2jQJNXDvhAFOs68++rJZNsk+7dtg7gHXzEkfB/6tQFmgySiJzoOgASAeJiHWrdpUBFiyAqnVhqAOOQaz",
+	"mK8wpGIGFNKEgnKlz6SHESrXJdDlJPeEfjJGG5qNa5RrDtMqBWMSng3KIhS+f9fXRSiEDRgfvARr2eZo",
+	"oO7xYyVtATvz/hh69Q1y9EjDjk8OI3IPupseXsIW5ODJIHXFypm8v7ASZhgyCRx7cR2I9gh9OuOYKUIZ",
+	"Ppxi94DaXTBmDKtjLCxmj4otC88JHBg7bkIoSAvQVW7aiSahFnJnBNZ/+aixAStgBswHh0X/7XNXlt/+",
+	"vqVJnHcfKT7t61QgVrTxgYVa62lLbm8WN95aoPTmN86QT5H4llgw2xBrC8ZG818uLi8ufUF0BYpVgmb0",
+	"ffhXPFpIN+0nzn/dAE5h/wR0RlnCpCS9OVkbXQZ22NoilBf/KBqgDPN+nhD0s1D8qgcI/WclIBgbFtsh",
+	"Tscy0lHKD/9aSARDVnWYbZrReweBiJG1Pf3aurIZSjTJGEqoXDoOwyPVJC+E5AbUESQm5VVvMQFbaS2B",
+	"qTm0WMBR/Y6jPC/6oDOjqe13LNFKHitkABoiP2F8lgk1YCvtmehd311exs2qEFRgFKsqKfLAjPSbjTvq",
+	"aVBxMGYZ48/c4dMm6eXnhVKIwjGD75QXnhyBE2htEmpdWTJT7zszHpywu7SdGbQrAwzBEkYUPPTtE2o4",
+	"ZpMh+8D5Vb+f/R4Dix81r1/s/Ad3jGkVei3XhHHeSXbMlw5XKxoHzQ/iyj7Lt8iU+c4Ho8GGTneCN5E2",
+	"EnBGs3/X2xBkTx0W7lAPAgsi0I5uWPurFbPFlFaLgDFg1sndfb3wWycfMKHNsV02rZa2u0bwCS2Gm+ex",
+	"W+h03fw6M0xdLjER/pbaHWt70KlVTa4XPsfTEjxwYBY40YowYoXaSPD+JwS4/lhfL57exjVgXrxSF19V",
+	"NOq3LRkzzKjcDDPuKh42x7lqEe2fP9Yu+H8/Qry8ep0rXfFkh/X7qV7nkHbMwVndSku9DZI1S2OvXAPS",
+	"T3nrDZ7P2oD9PTg7uY3/EX6PkPkUCOojt28OFoXqjvt64uizelPSOCLCPJWcZZtzfrD2DYgeFycF8i5G",
+	"fbpECsVbgJ9C+QOFsm1BiBVfh8T+OSPblys2S9NdoS36xjQpq4R/YcKMYCsZa9s9PHyLKXXOpH/kgy+b",
+	"/wYATTMCmcEVAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
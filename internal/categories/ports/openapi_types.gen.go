@@ -3,6 +3,13 @@
 // Code generated by github.com/deepmap/oapi-codegen version v1.9.0 DO NOT EDIT.
 package ports
 
+// Defines values for CategoryType.
+const (
+	CategoryTypeExpense CategoryType = "expense"
+
+	CategoryTypeIncome CategoryType = "income"
+)
+
 // Category defines model for Category.
 type Category struct {
 	// Embedded struct due to allOf(#/components/schemas/NewCategory)
@@ -12,6 +19,9 @@ type Category struct {
 	Id string `json:"id"`
 }
 
+// Category tree type, subcategories belong to the tree of their parent
+type CategoryType string
+
 // Error defines model for Error.
 type Error struct {
 	// Error code
@@ -31,6 +41,9 @@ type NewCategory struct {
 	ParentId *string     `json:"parentId,omitempty"`
 	Parents  *[]Category `json:"parents,omitempty"`
 	Path     string      `json:"path"`
+
+	// Category tree type, subcategories belong to the tree of their parent
+	Type *CategoryType `json:"type,omitempty"`
 }
 
 // FindCategoriesParams defines parameters for FindCategories.
@@ -43,6 +56,9 @@ type FindCategoriesParams struct {
 
 	// Return all categories
 	All *bool `json:"all,omitempty"`
+
+	// Return categories of the category tree type only
+	Type *CategoryType `json:"type,omitempty"`
 }
 
 // AddCategoryJSONBody defines parameters for AddCategory.
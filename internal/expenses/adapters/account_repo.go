@@ -0,0 +1,259 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const accountsCollectionName string = "accounts"
+
+type accountDbModel struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	Name           string             `bson:"name"`
+	Type           string             `bson:"type"`
+	Currency       string             `bson:"currency"`
+	OpeningBalance float64            `bson:"openingBalance"`
+}
+
+// AccountRepository represents a struct to access accounts MongoDB collection.
+type AccountRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// AccountRepoInterface defines a contract to persist accounts in the database.
+type AccountRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.Account, error)
+	GetOne(ctx context.Context, id string) (*domain.Account, error)
+	Insert(ctx context.Context, account domain.Account) (*string, error)
+	Update(ctx context.Context, account domain.Account) (*domain.UpdateResult, error)
+	DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error)
+	GetExpenses(ctx context.Context, id string, at time.Time) ([]domain.Expense, error)
+}
+
+// NewAccountRepo returns an AccountRepository.
+func NewAccountRepo(client *database.MongoClient, logger logger.LogInterface) *AccountRepository {
+	return &AccountRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *AccountRepository) collection() *mongo.Collection {
+	return r.client.Collection(accountsCollectionName)
+}
+
+// GetAll returns all accounts from the database sorted by name.
+func (r *AccountRepository) GetAll(ctx context.Context) ([]domain.Account, error) {
+	ctx, span := tracer.NewSpan(ctx, "find accounts in the database")
+	defer span.End()
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, findErr := r.collection().Find(ctx, bson.M{}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find accounts")
+	}
+
+	var accountDbModels []accountDbModel
+	if allErr := cursor.All(ctx, &accountDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	accounts := make([]domain.Account, 0, len(accountDbModels))
+	for _, dbModel := range accountDbModels {
+		account, accountErr := r.unmarshalAccount(dbModel)
+		if accountErr != nil {
+			return nil, accountErr
+		}
+		accounts = append(accounts, *account)
+	}
+
+	return accounts, nil
+}
+
+// GetOne returns a single account from the database.
+func (r *AccountRepository) GetOne(ctx context.Context, id string) (*domain.Account, error) {
+	ctx, span := tracer.NewSpan(ctx, "find account in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	dbModel := accountDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"_id": objID}).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find account")
+	}
+
+	return r.unmarshalAccount(dbModel)
+}
+
+// Insert inserts a new account into the database.
+func (r *AccountRepository) Insert(ctx context.Context, account domain.Account) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add account to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalAccount(account))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert account")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// Update updates an account in the database.
+func (r *AccountRepository) Update(ctx context.Context, account domain.Account) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update account in the database")
+	span.SetAttributes(attribute.String("id", account.ID()))
+	defer span.End()
+
+	dbModel := r.marshalAccount(account)
+	updResult, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": dbModel.ID}, bson.M{"$set": dbModel})
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update account")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// DeleteOne deletes a single account from the database. Expenses of the account are kept,
+// they are detached from the account. Transfers are kept for balances of the other accounts.
+func (r *AccountRepository) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "delete account from the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	delResult, delErr := r.collection().DeleteOne(ctx, bson.M{"_id": objID})
+	if delErr != nil {
+		tracer.AddSpanError(span, delErr)
+		return nil, errors.Wrap(delErr, "mongodb delete account")
+	}
+
+	if delResult.DeletedCount > 0 {
+		_, updErr := r.client.Collection(expenseCollectionName).UpdateMany(ctx,
+			bson.M{"accountId": objID}, bson.M{"$unset": bson.M{"accountId": ""}})
+		if updErr != nil {
+			tracer.AddSpanError(span, updErr)
+			return nil, errors.Wrap(updErr, "mongodb detach expenses from account")
+		}
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(delResult.DeletedCount),
+	}
+
+	return result, nil
+}
+
+// GetExpenses returns expenses paid from the account up to the moment excluding the trashed ones.
+func (r *AccountRepository) GetExpenses(ctx context.Context, id string, at time.Time) ([]domain.Expense, error) {
+	ctx, span := tracer.NewSpan(ctx, "find account expenses in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, errors.Wrap(objIDErr, "invalid account id")
+	}
+
+	matchStage := bson.M{
+		"$match": bson.M{
+			"accountId": objID,
+			"date":      bson.M{"$lte": at},
+			"deletedAt": bson.M{"$exists": false},
+		},
+	}
+	sortStage := bson.M{"$sort": bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}}}
+	operations := append([]bson.M{matchStage, sortStage}, categoryLookupStages()...)
+	cursor, cursorErr := r.client.Collection(expenseCollectionName).Aggregate(ctx, operations)
+	if cursorErr != nil {
+		tracer.AddSpanError(span, cursorErr)
+		return nil, errors.Wrap(cursorErr, "mongodb cursor account expenses")
+	}
+
+	var expenseDbModels []expenseDbModel
+	if allErr := cursor.All(ctx, &expenseDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	expenses := make([]domain.Expense, 0, len(expenseDbModels))
+	for _, expenseModel := range expenseDbModels {
+		expense, expenseErr := unmarshalExpense(expenseModel)
+		if expenseErr != nil {
+			return nil, expenseErr
+		}
+		expenses = append(expenses, *expense)
+	}
+
+	return expenses, nil
+}
+
+func (r AccountRepository) marshalAccount(account domain.Account) accountDbModel {
+	id, _ := primitive.ObjectIDFromHex(account.ID())
+
+	return accountDbModel{
+		ID:             id,
+		Name:           account.Name(),
+		Type:           string(account.Type()),
+		Currency:       string(account.Currency()),
+		OpeningBalance: account.OpeningBalance(),
+	}
+}
+
+func (r AccountRepository) unmarshalAccount(dbModel accountDbModel) (*domain.Account, error) {
+	account, accountErr := domain.NewAccount(dbModel.ID.Hex(), domain.AccountParams{
+		Name:           dbModel.Name,
+		Type:           dbModel.Type,
+		Currency:       dbModel.Currency,
+		OpeningBalance: dbModel.OpeningBalance,
+	})
+	if accountErr != nil {
+		return nil, errors.Wrap(accountErr, "unmarshal account")
+	}
+	return account, nil
+}
+
+// marshalAccountID converts an optional account id to an account reference, invalid ids are dropped.
+func marshalAccountID(accountID *string) *primitive.ObjectID {
+	if accountID == nil {
+		return nil
+	}
+	objID, objIDErr := primitive.ObjectIDFromHex(*accountID)
+	if objIDErr != nil {
+		return nil
+	}
+	return &objID
+}
@@ -0,0 +1,235 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const attachmentsCollectionName string = "attachments"
+
+type attachmentDbModel struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	ExpenseID    primitive.ObjectID `bson:"expenseId"`
+	FileName     string             `bson:"fileName"`
+	ContentType  string             `bson:"contentType"`
+	Size         int64              `bson:"size"`
+	BlobKey      string             `bson:"blobKey"`
+	ThumbnailKey *string            `bson:"thumbnailKey,omitempty"`
+	CreatedBy    string             `bson:"createdBy"`
+	CreatedAt    time.Time          `bson:"createdAt"`
+}
+
+// AttachmentRepository represents a struct to access attachments MongoDB collection.
+// Only attachment metadata is kept in the collection, the content lives in a blob store.
+type AttachmentRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// AttachmentRepoInterface defines a contract to persist attachments in the database.
+type AttachmentRepoInterface interface {
+	GetAll(ctx context.Context, expenseID string) ([]domain.Attachment, error)
+	GetOne(ctx context.Context, expenseID string, id string) (*domain.Attachment, error)
+	GetOrphaned(ctx context.Context) ([]domain.Attachment, error)
+	Insert(ctx context.Context, attachment domain.Attachment) (*string, error)
+	DeleteMany(ctx context.Context, ids []string) (*domain.DeleteResult, error)
+}
+
+// NewAttachmentRepo returns an AttachmentRepository.
+func NewAttachmentRepo(client *database.MongoClient, logger logger.LogInterface) *AttachmentRepository {
+	return &AttachmentRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *AttachmentRepository) collection() *mongo.Collection {
+	return r.client.Collection(attachmentsCollectionName)
+}
+
+// GetAll returns attachments of the expense sorted by creation date.
+func (r *AttachmentRepository) GetAll(ctx context.Context, expenseID string) ([]domain.Attachment, error) {
+	ctx, span := tracer.NewSpan(ctx, "find expense attachments in the database")
+	span.SetAttributes(attribute.String("expenseId", expenseID))
+	defer span.End()
+
+	expenseObjID, expenseObjIDErr := primitive.ObjectIDFromHex(expenseID)
+	if expenseObjIDErr != nil {
+		return []domain.Attachment{}, nil
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, findErr := r.collection().Find(ctx, bson.M{"expenseId": expenseObjID}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find attachments")
+	}
+
+	return r.unmarshalAttachments(ctx, cursor)
+}
+
+// GetOne returns a single attachment of the expense from the database.
+func (r *AttachmentRepository) GetOne(ctx context.Context, expenseID string, id string) (*domain.Attachment, error) {
+	ctx, span := tracer.NewSpan(ctx, "find attachment in the database")
+	span.SetAttributes(attribute.String("expenseId", expenseID), attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	expenseObjID, expenseObjIDErr := primitive.ObjectIDFromHex(expenseID)
+	if objIDErr != nil || expenseObjIDErr != nil {
+		return nil, nil
+	}
+
+	dbModel := attachmentDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"_id": objID, "expenseId": expenseObjID}).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find attachment")
+	}
+
+	return r.unmarshalAttachment(dbModel)
+}
+
+// GetOrphaned returns attachments whose expenses do not exist anymore, e.g. were purged from the trash.
+func (r *AttachmentRepository) GetOrphaned(ctx context.Context) ([]domain.Attachment, error) {
+	ctx, span := tracer.NewSpan(ctx, "find orphaned attachments in the database")
+	defer span.End()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$lookup", Value: bson.M{
+			"from":         expenseCollectionName,
+			"localField":   "expenseId",
+			"foreignField": "_id",
+			"as":           "expense",
+		}}},
+		{{Key: "$match", Value: bson.M{"expense": bson.M{"$size": 0}}}},
+		{{Key: "$project", Value: bson.M{"expense": 0}}},
+	}
+	cursor, aggregateErr := r.collection().Aggregate(ctx, pipeline)
+	if aggregateErr != nil {
+		tracer.AddSpanError(span, aggregateErr)
+		return nil, errors.Wrap(aggregateErr, "mongodb aggregate orphaned attachments")
+	}
+
+	return r.unmarshalAttachments(ctx, cursor)
+}
+
+// Insert inserts a new attachment into the database.
+func (r *AttachmentRepository) Insert(ctx context.Context, attachment domain.Attachment) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add attachment to the database")
+	span.SetAttributes(attribute.String("expenseId", attachment.ExpenseID()))
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalAttachment(attachment))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert attachment")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// DeleteMany deletes attachments from the database.
+func (r *AttachmentRepository) DeleteMany(ctx context.Context, ids []string) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "delete attachments from the database")
+	span.SetAttributes(attribute.Int("count", len(ids)))
+	defer span.End()
+
+	objIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objID, objIDErr := primitive.ObjectIDFromHex(id)
+		if objIDErr != nil {
+			continue
+		}
+		objIDs = append(objIDs, objID)
+	}
+
+	delResult, delErr := r.collection().DeleteMany(ctx, bson.M{"_id": bson.M{"$in": objIDs}})
+	if delErr != nil {
+		tracer.AddSpanError(span, delErr)
+		return nil, errors.Wrap(delErr, "mongodb delete attachments")
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(delResult.DeletedCount),
+	}
+
+	return result, nil
+}
+
+func (r AttachmentRepository) unmarshalAttachments(
+	ctx context.Context,
+	cursor *mongo.Cursor,
+) ([]domain.Attachment, error) {
+	span := tracer.SpanFromContext(ctx)
+
+	var attachmentDbModels []attachmentDbModel
+	if allErr := cursor.All(ctx, &attachmentDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	attachments := make([]domain.Attachment, 0, len(attachmentDbModels))
+	for _, dbModel := range attachmentDbModels {
+		attachment, attachmentErr := r.unmarshalAttachment(dbModel)
+		if attachmentErr != nil {
+			return nil, attachmentErr
+		}
+		attachments = append(attachments, *attachment)
+	}
+
+	return attachments, nil
+}
+
+func (r AttachmentRepository) marshalAttachment(attachment domain.Attachment) attachmentDbModel {
+	id, _ := primitive.ObjectIDFromHex(attachment.ID())
+	expenseID, _ := primitive.ObjectIDFromHex(attachment.ExpenseID())
+
+	return attachmentDbModel{
+		ID:           id,
+		ExpenseID:    expenseID,
+		FileName:     attachment.FileName(),
+		ContentType:  attachment.ContentType(),
+		Size:         attachment.Size(),
+		BlobKey:      attachment.BlobKey(),
+		ThumbnailKey: attachment.ThumbnailKey(),
+		CreatedBy:    attachment.CreatedBy(),
+		CreatedAt:    attachment.CreatedAt(),
+	}
+}
+
+func (r AttachmentRepository) unmarshalAttachment(dbModel attachmentDbModel) (*domain.Attachment, error) {
+	attachment, attachmentErr := domain.NewAttachment(dbModel.ID.Hex(), domain.AttachmentParams{
+		ExpenseID:    dbModel.ExpenseID.Hex(),
+		FileName:     dbModel.FileName,
+		ContentType:  dbModel.ContentType,
+		Size:         dbModel.Size,
+		BlobKey:      dbModel.BlobKey,
+		ThumbnailKey: dbModel.ThumbnailKey,
+		CreatedBy:    dbModel.CreatedBy,
+		CreatedAt:    dbModel.CreatedAt,
+	})
+	if attachmentErr != nil {
+		return nil, errors.Wrap(attachmentErr, "unmarshal attachment")
+	}
+	return attachment, nil
+}
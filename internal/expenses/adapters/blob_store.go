@@ -0,0 +1,47 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/config"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+)
+
+// Defines available blob storages.
+const (
+	BlobStorageLocal string = "local"
+
+	BlobStorageGridFS string = "gridfs"
+)
+
+// ErrBlobNotFound is returned when there is no blob with the key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore defines a contract to keep binary content, e.g. attachment files, under a key.
+// Keys are slash separated paths like "expenseId/blobId".
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewBlobStore returns a blob store of the configured storage.
+func NewBlobStore(
+	config config.Attachments,
+	client *database.MongoClient,
+	logger logger.LogInterface,
+) (BlobStore, error) {
+	switch config.Storage {
+	case BlobStorageLocal:
+		return NewLocalBlobStore(config.Path, logger), nil
+	case BlobStorageGridFS:
+		return NewGridFSBlobStore(client, logger), nil
+	default:
+		return nil, fmt.Errorf("unknown blob storage %s", config.Storage)
+	}
+}
@@ -0,0 +1,54 @@
+package adapters_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/config"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewBlobStore_LocalStorage_ReturnsLocalStore(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	cfg := config.Attachments{Storage: "local", Path: t.TempDir()}
+	log := new(mocks.LogInterface)
+
+	// Act
+	result, resultErr := adapters.NewBlobStore(cfg, &database.MongoClient{}, log)
+
+	// Assert
+	assert.Nil(t, resultErr, "Error should be nil.")
+	assert.IsType(t, &adapters.LocalBlobStore{}, result, "Should return local blob store.")
+}
+
+func TestNewBlobStore_GridFSStorage_ReturnsGridFSStore(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	cfg := config.Attachments{Storage: "gridfs"}
+	log := new(mocks.LogInterface)
+
+	// Act
+	result, resultErr := adapters.NewBlobStore(cfg, &database.MongoClient{}, log)
+
+	// Assert
+	assert.Nil(t, resultErr, "Error should be nil.")
+	assert.IsType(t, &adapters.GridFSBlobStore{}, result, "Should return GridFS blob store.")
+}
+
+func TestNewBlobStore_UnknownStorage_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	cfg := config.Attachments{Storage: "s3"}
+	log := new(mocks.LogInterface)
+
+	// Act
+	result, resultErr := adapters.NewBlobStore(cfg, &database.MongoClient{}, log)
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, resultErr, "Error should not be nil.")
+}
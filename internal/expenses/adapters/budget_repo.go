@@ -0,0 +1,231 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const budgetsCollectionName string = "budgets"
+
+type budgetDbModel struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	CategoryID primitive.ObjectID `bson:"categoryId"`
+	Period     string             `bson:"period"`
+	Amount     float64            `bson:"amount"`
+	Currency   *string            `bson:"currency,omitempty"`
+	Rollover   bool               `bson:"rollover"`
+	Start      time.Time          `bson:"start"`
+}
+
+// BudgetRepository represents a struct to access budgets MongoDB collection.
+type BudgetRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// BudgetRepoInterface defines a contract to persist budgets in the database.
+type BudgetRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.Budget, error)
+	GetOne(ctx context.Context, id string) (*domain.Budget, error)
+	GetByCategory(ctx context.Context, categoryID string) (*domain.Budget, error)
+	Insert(ctx context.Context, budget domain.Budget) (*string, error)
+	Update(ctx context.Context, budget domain.Budget) (*domain.UpdateResult, error)
+	DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error)
+}
+
+// NewBudgetRepo returns a BudgetRepository.
+func NewBudgetRepo(client *database.MongoClient, logger logger.LogInterface) *BudgetRepository {
+	return &BudgetRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *BudgetRepository) collection() *mongo.Collection {
+	return r.client.Collection(budgetsCollectionName)
+}
+
+// GetAll returns all budgets from the database sorted by start date.
+func (r *BudgetRepository) GetAll(ctx context.Context) ([]domain.Budget, error) {
+	ctx, span := tracer.NewSpan(ctx, "find budgets in the database")
+	defer span.End()
+
+	opts := options.Find().SetSort(bson.M{"start": 1})
+	cursor, findErr := r.collection().Find(ctx, bson.M{}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find budgets")
+	}
+
+	var budgetDbModels []budgetDbModel
+	if allErr := cursor.All(ctx, &budgetDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	budgets := make([]domain.Budget, 0, len(budgetDbModels))
+	for _, dbModel := range budgetDbModels {
+		budget, budgetErr := r.unmarshalBudget(dbModel)
+		if budgetErr != nil {
+			return nil, budgetErr
+		}
+		budgets = append(budgets, *budget)
+	}
+
+	return budgets, nil
+}
+
+// GetOne returns a single budget from the database.
+func (r *BudgetRepository) GetOne(ctx context.Context, id string) (*domain.Budget, error) {
+	ctx, span := tracer.NewSpan(ctx, "find budget in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	return r.findOne(ctx, bson.M{"_id": objID})
+}
+
+// GetByCategory returns a budget of the category from the database.
+func (r *BudgetRepository) GetByCategory(ctx context.Context, categoryID string) (*domain.Budget, error) {
+	ctx, span := tracer.NewSpan(ctx, "find category budget in the database")
+	span.SetAttributes(attribute.String("categoryId", categoryID))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(categoryID)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	return r.findOne(ctx, bson.M{"categoryId": objID})
+}
+
+// Insert inserts a new budget into the database.
+func (r *BudgetRepository) Insert(ctx context.Context, budget domain.Budget) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add budget to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalBudget(budget))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert budget")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// Update updates a budget in the database.
+func (r *BudgetRepository) Update(ctx context.Context, budget domain.Budget) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update budget in the database")
+	span.SetAttributes(attribute.String("id", budget.ID()))
+	defer span.End()
+
+	dbModel := r.marshalBudget(budget)
+	updater := bson.M{"$set": dbModel}
+	if dbModel.Currency == nil {
+		updater["$unset"] = bson.M{"currency": ""}
+	}
+
+	updResult, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": dbModel.ID}, updater)
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update budget")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// DeleteOne deletes a single budget from the database.
+func (r *BudgetRepository) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "delete budget from the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	delResult, delErr := r.collection().DeleteOne(ctx, bson.M{"_id": objID})
+	if delErr != nil {
+		tracer.AddSpanError(span, delErr)
+		return nil, errors.Wrap(delErr, "mongodb delete budget")
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(delResult.DeletedCount),
+	}
+
+	return result, nil
+}
+
+func (r *BudgetRepository) findOne(ctx context.Context, filter bson.M) (*domain.Budget, error) {
+	span := tracer.SpanFromContext(ctx)
+
+	dbModel := budgetDbModel{}
+	findErr := r.collection().FindOne(ctx, filter).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find budget")
+	}
+
+	return r.unmarshalBudget(dbModel)
+}
+
+func (r BudgetRepository) marshalBudget(budget domain.Budget) budgetDbModel {
+	id, _ := primitive.ObjectIDFromHex(budget.ID())
+	categoryID, _ := primitive.ObjectIDFromHex(budget.CategoryID())
+
+	var currency *string
+	if budget.Currency() != nil {
+		value := string(*budget.Currency())
+		currency = &value
+	}
+
+	return budgetDbModel{
+		ID:         id,
+		CategoryID: categoryID,
+		Period:     string(budget.Period()),
+		Amount:     budget.Amount(),
+		Currency:   currency,
+		Rollover:   budget.Rollover(),
+		Start:      budget.Start(),
+	}
+}
+
+func (r BudgetRepository) unmarshalBudget(dbModel budgetDbModel) (*domain.Budget, error) {
+	budget, budgetErr := domain.NewBudget(dbModel.ID.Hex(), domain.BudgetParams{
+		CategoryID: dbModel.CategoryID.Hex(),
+		Period:     dbModel.Period,
+		Amount:     dbModel.Amount,
+		Currency:   dbModel.Currency,
+		Rollover:   dbModel.Rollover,
+		Start:      dbModel.Start,
+	})
+	if budgetErr != nil {
+		return nil, errors.Wrap(budgetErr, "unmarshal budget")
+	}
+	return budget, nil
+}
@@ -2,11 +2,13 @@ package adapters
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.opentelemetry.io/otel/attribute"
 
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
@@ -15,7 +17,10 @@ import (
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
 )
 
-const categoriesCollectionName string = "categories"
+const (
+	categoriesCollectionName string = "categories"
+	incomeCategoryType       string = "income"
+)
 
 // categoryDbModel defines category structure in MongoDB.
 type categoryDbModel struct {
@@ -26,6 +31,14 @@ type categoryDbModel struct {
 	Path     string              `bson:"path"`
 	Icon     *string             `bson:"icon,omitempty"`
 	Level    int                 `bson:"level"`
+	// Trash state fields are set by categories soft deletion.
+	DeletedBy   *string             `bson:"deletedBy,omitempty"`
+	DeletedAt   *time.Time          `bson:"deletedAt,omitempty"`
+	TrashRootID *primitive.ObjectID `bson:"trashRootId,omitempty"`
+	// Inbox marks a category holding imported expenses that could not be categorized.
+	Inbox bool `bson:"inbox,omitempty"`
+	// Type is set by the categories service for income categories only.
+	Type *string `bson:"type,omitempty"`
 }
 
 // CategoryRepository represents a struct to access categories MongoDB collection.
@@ -36,7 +49,11 @@ type CategoryRepository struct {
 
 // ExpenseCategoryRepoInterface defines a contract to persist categories in the database.
 type ExpenseCategoryRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.Category, error)
 	GetOne(ctx context.Context, id string) (*domain.Category, error)
+	GetInbox(ctx context.Context) (*domain.Category, error)
+	EnsureInbox(ctx context.Context) (*domain.Category, error)
+	EnsureIndexes(ctx context.Context) error
 }
 
 // NewCategoryRepo returns a CategoryRepository.
@@ -52,6 +69,36 @@ func (r *CategoryRepository) collection() *mongo.Collection {
 	return r.client.Collection(categoriesCollectionName)
 }
 
+// GetAll returns all categories from the database except trashed ones.
+func (r *CategoryRepository) GetAll(ctx context.Context) ([]domain.Category, error) {
+	ctx, span := tracer.NewSpan(ctx, "find all categories in the database")
+	defer span.End()
+
+	filter := bson.M{"deletedAt": bson.M{"$exists": false}}
+	cursor, findErr := r.collection().Find(ctx, filter)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find categories")
+	}
+
+	var catDbModels []categoryDbModel
+	if allErr := cursor.All(ctx, &catDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	categories := make([]domain.Category, 0, len(catDbModels))
+	for _, catDbModel := range catDbModels {
+		category, categoryErr := r.unmarshalCategory(catDbModel)
+		if categoryErr != nil {
+			return nil, categoryErr
+		}
+		categories = append(categories, *category)
+	}
+
+	return categories, nil
+}
+
 // GetOne returns a single category from the database.
 func (r *CategoryRepository) GetOne(ctx context.Context, id string) (*domain.Category, error) {
 	ctx, span := tracer.NewSpan(ctx, "find categories in the database")
@@ -60,7 +107,7 @@ func (r *CategoryRepository) GetOne(ctx context.Context, id string) (*domain.Cat
 
 	objID, _ := primitive.ObjectIDFromHex(id)
 
-	filter := bson.M{"_id": objID}
+	filter := bson.M{"_id": objID, "deletedAt": bson.M{"$exists": false}}
 	catDbModel := categoryDbModel{}
 	findError := r.collection().FindOne(ctx, filter).Decode(&catDbModel)
 	if findError != nil {
@@ -78,6 +125,84 @@ func (r *CategoryRepository) GetOne(ctx context.Context, id string) (*domain.Cat
 	return category, nil
 }
 
+// inboxIndex keeps a single inbox category out of the trash. Trashed inbox categories differ by
+// the deletion time, so a new inbox could be created once the previous one is trashed.
+var inboxIndex = mongo.IndexModel{
+	Keys: bson.D{
+		{Key: "inbox", Value: 1},
+		{Key: "deletedAt", Value: 1},
+	},
+	Options: options.Index().
+		SetName("inbox_unique").
+		SetUnique(true).
+		SetPartialFilterExpression(bson.M{"inbox": true}),
+}
+
+// EnsureIndexes creates unique indexes of the categories collection unless they exist already.
+func (r *CategoryRepository) EnsureIndexes(ctx context.Context) error {
+	ctx, span := tracer.NewSpan(ctx, "ensure category indexes in the database")
+	defer span.End()
+
+	if _, createErr := r.collection().Indexes().CreateOne(ctx, inboxIndex); createErr != nil {
+		tracer.AddSpanError(span, createErr)
+		return errors.Wrap(createErr, "mongodb create inbox index")
+	}
+
+	return nil
+}
+
+// GetInbox returns the inbox category if it exists.
+func (r *CategoryRepository) GetInbox(ctx context.Context) (*domain.Category, error) {
+	ctx, span := tracer.NewSpan(ctx, "find inbox category in the database")
+	defer span.End()
+
+	filter := bson.M{"inbox": true, "deletedAt": bson.M{"$exists": false}}
+	catDbModel := categoryDbModel{}
+	findError := r.collection().FindOne(ctx, filter).Decode(&catDbModel)
+	if findError != nil {
+		if errors.Is(findError, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findError)
+		return nil, errors.Wrap(findError, "find inbox category")
+	}
+
+	return r.unmarshalCategory(catDbModel)
+}
+
+// EnsureInbox returns the inbox category, a root category is created when it does not exist.
+func (r *CategoryRepository) EnsureInbox(ctx context.Context) (*domain.Category, error) {
+	ctx, span := tracer.NewSpan(ctx, "ensure inbox category in the database")
+	defer span.End()
+
+	// The id is generated upfront, since the category path includes it.
+	id := primitive.NewObjectID()
+	filter := bson.M{"inbox": true, "deletedAt": bson.M{"$exists": false}}
+	updater := bson.M{
+		"$setOnInsert": bson.M{
+			"_id":   id,
+			"name":  domain.InboxCategoryName,
+			"path":  "|" + id.Hex(),
+			"level": 1,
+			"inbox": true,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	catDbModel := categoryDbModel{}
+	updateErr := r.collection().FindOneAndUpdate(ctx, filter, updater, opts).Decode(&catDbModel)
+	if mongo.IsDuplicateKeyError(updateErr) {
+		// A concurrent upsert has created the inbox first.
+		return r.GetInbox(ctx)
+	}
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "upsert inbox category")
+	}
+
+	return r.unmarshalCategory(catDbModel)
+}
+
 func (r CategoryRepository) unmarshalCategory(categoryModel categoryDbModel) (*domain.Category, error) {
 	var parentID *string
 	if categoryModel.ParentID != nil {
@@ -89,5 +214,6 @@ func (r CategoryRepository) unmarshalCategory(categoryModel categoryDbModel) (*d
 	if catErr != nil {
 		return nil, errors.Wrap(catErr, "unmarshal category")
 	}
+	cat.SetIncome(categoryModel.Type != nil && *categoryModel.Type == incomeCategoryType)
 	return cat, nil
 }
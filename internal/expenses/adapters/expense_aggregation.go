@@ -0,0 +1,155 @@
+package adapters
+
+import (
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+// categoryLookupStages returns aggregation stages that resolve expense category along with its parents.
+func categoryLookupStages() []bson.M {
+	// Join with categories collection to get expense category.
+	categoryLookupStage := bson.M{
+		"$lookup": bson.M{
+			"from":         categoriesCollectionName,
+			"localField":   "categoryId",
+			"foreignField": "_id",
+			"as":           "category",
+		},
+	}
+
+	// After the lookup take the very first match.
+	addCategoryFieldStage := bson.M{
+		"$addFields": bson.M{
+			"category": bson.M{
+				"$arrayElemAt": []interface{}{"$category", 0},
+			},
+		},
+	}
+
+	// Join with categories collection to get all ascendants.
+	// There will be expense category as well, that needs to be filtered out.
+	ascendantsLookupStage := bson.M{
+		"$graphLookup": bson.M{
+			"from":             categoriesCollectionName,
+			"startWith":        "$categoryId",
+			"connectFromField": "parentId",
+			"connectToField":   "_id",
+			"as":               "parentCategories",
+		},
+	}
+
+	// Add parents to the category document, but exclude expense category.
+	addAscendantsFieldStage := bson.M{
+		"$addFields": bson.M{
+			"category.parents": bson.M{
+				"$filter": bson.M{
+					"input": "$parentCategories",
+					"cond": bson.M{
+						"$ne": []interface{}{"$$this._id", "$categoryId"},
+					},
+				},
+			},
+		},
+	}
+
+	return []bson.M{
+		categoryLookupStage,
+		addCategoryFieldStage,
+		ascendantsLookupStage,
+		addAscendantsFieldStage,
+	}
+}
+
+// unmarshalExpense unmarshalls expense MongoDB model with resolved category into domain object.
+func unmarshalExpense(expenseModel expenseDbModel) (*domain.Expense, error) {
+	if expenseModel.Category == nil {
+		return nil, errors.Errorf("expense %s has no category", expenseModel.ID.Hex())
+	}
+
+	cat, catErr := unmarshalExpenseCategory(*expenseModel.Category)
+	if catErr != nil {
+		return nil, errors.Wrap(catErr, "unmarshal category")
+	}
+
+	opts := []func(*domain.Expense){
+		domain.SetCreateMetadata(expenseModel.CreatedBy, expenseModel.CreatedAt),
+	}
+	if expenseModel.UpdatedBy != nil && expenseModel.UpdatedAt != nil {
+		opts = append(opts, domain.SetUpdateMetadata(*expenseModel.UpdatedBy, *expenseModel.UpdatedAt))
+	}
+	if expenseModel.ExternalID != nil {
+		opts = append(opts, domain.SetExternalID(*expenseModel.ExternalID))
+	}
+	if expenseModel.ReceiptID != nil {
+		opts = append(opts, domain.SetReceipt(expenseModel.ReceiptID.Hex()))
+	}
+	if expenseModel.MerchantID != nil {
+		opts = append(opts, domain.SetMerchant(expenseModel.MerchantID.Hex()))
+	}
+	if expenseModel.AccountID != nil {
+		opts = append(opts, domain.SetAccount(expenseModel.AccountID.Hex()))
+	}
+	if expenseModel.ReconciliationID != nil {
+		opts = append(opts, domain.SetReconciliation(expenseModel.ReconciliationID.Hex()))
+	}
+	if expenseModel.Reimbursable {
+		opts = append(opts, domain.SetReimbursable(true))
+	}
+	if len(expenseModel.Tags) != 0 {
+		opts = append(opts, domain.SetTags(expenseModel.Tags))
+	}
+	if expenseModel.Split != nil && expenseModel.PaidBy != nil {
+		split, splitErr := unmarshalSplit(*expenseModel.Split)
+		if splitErr != nil {
+			return nil, errors.Wrap(splitErr, "unmarshal split")
+		}
+		opts = append(opts, domain.SetSplit(*expenseModel.PaidBy, *split))
+	}
+	if expenseModel.Recurrence != nil {
+		opts = append(opts, domain.SetRecurrence(domain.Recurrence{
+			RecurringExpenseID: expenseModel.Recurrence.RecurringExpenseID.Hex(),
+			Date:               expenseModel.Recurrence.Date,
+		}))
+	}
+
+	exp, expErr := domain.NewExpense(expenseModel.ID.Hex(), *cat,
+		expenseModel.Price, expenseModel.Currency, expenseModel.Quantity,
+		expenseModel.Comment, unmarshalTripID(expenseModel.TripID), expenseModel.Date, opts...)
+	if expErr != nil {
+		return nil, errors.Wrap(expErr, "unmarshal expense")
+	}
+
+	return exp, nil
+}
+
+// unmarshalExpenseCategory unmarshalls category MongoDB model with resolved parents into domain object.
+func unmarshalExpenseCategory(categoryModel categoryDbModel) (*domain.Category, error) {
+	var parentID string
+	if categoryModel.ParentID != nil && !categoryModel.ParentID.IsZero() {
+		parentID = categoryModel.ParentID.Hex()
+	}
+	cat, catErr := domain.NewCategory(categoryModel.ID.Hex(), &parentID,
+		categoryModel.Name, categoryModel.Icon, categoryModel.Level, categoryModel.Path)
+	if catErr != nil {
+		return nil, errors.Wrap(catErr, "unmarshal category")
+	}
+
+	parentCategories := make([]domain.Category, 0)
+	for _, parentCat := range categoryModel.Parents {
+		var parentID string
+		if parentCat.ParentID != nil {
+			parentID = parentCat.ParentID.Hex()
+		}
+		parent, parentErr := domain.NewCategory(parentCat.ID.Hex(), &parentID, parentCat.Name,
+			parentCat.Icon, parentCat.Level, parentCat.Path)
+		if parentErr != nil {
+			return nil, errors.Wrap(parentErr, "unmarshal parent category")
+		}
+		parentCategories = append(parentCategories, *parent)
+	}
+	cat.SetParents(&parentCategories)
+
+	return cat, nil
+}
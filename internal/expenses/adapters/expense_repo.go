@@ -2,12 +2,19 @@ package adapters
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
@@ -18,19 +25,46 @@ import (
 const expenseCollectionName string = "expenses"
 
 type expenseDbModel struct {
-	ID         primitive.ObjectID `bson:"_id,omitempty"`
-	CategoryID primitive.ObjectID `bson:"categoryId"`
-	Category   *categoryDbModel   `bson:"category,omitempty"`
-	Price      float64            `bson:"price"`
-	Currency   string             `bson:"currency"`
-	Quantity   float64            `bson:"quantity"`
-	Date       time.Time          `bson:"date"`
-	Comment    *string            `bson:"comment,omitempty"`
-	Trip       *string            `bson:"trip,omitempty"`
-	CreatedAt  time.Time          `bson:"createdAt,omitempty"`
-	CreatedBy  string             `bson:"createdBy,omitempty"`
-	UpdatedAt  *time.Time         `bson:"updatedAt,omitempty"`
-	UpdatedBy  *string            `bson:"updatedBy,omitempty"`
+	ID               primitive.ObjectID  `bson:"_id,omitempty"`
+	CategoryID       primitive.ObjectID  `bson:"categoryId"`
+	Category         *categoryDbModel    `bson:"category,omitempty"`
+	Price            float64             `bson:"price"`
+	Currency         string              `bson:"currency"`
+	Quantity         float64             `bson:"quantity"`
+	Date             time.Time           `bson:"date"`
+	Comment          *string             `bson:"comment,omitempty"`
+	TripID           *primitive.ObjectID `bson:"tripId,omitempty"`
+	ReceiptID        *primitive.ObjectID `bson:"receiptId,omitempty"`
+	MerchantID       *primitive.ObjectID `bson:"merchantId,omitempty"`
+	AccountID        *primitive.ObjectID `bson:"accountId,omitempty"`
+	ReconciliationID *primitive.ObjectID `bson:"reconciliationId,omitempty"`
+	Reimbursable     bool                `bson:"reimbursable,omitempty"`
+	Tags             []string            `bson:"tags,omitempty"`
+	PaidBy           *string             `bson:"paidBy,omitempty"`
+	Split            *splitDbModel       `bson:"split,omitempty"`
+	CreatedAt        time.Time           `bson:"createdAt,omitempty"`
+	CreatedBy        string              `bson:"createdBy,omitempty"`
+	UpdatedAt        *time.Time          `bson:"updatedAt,omitempty"`
+	UpdatedBy        *string             `bson:"updatedBy,omitempty"`
+	DeletedAt        *time.Time          `bson:"deletedAt,omitempty"`
+	DeletedBy        *string             `bson:"deletedBy,omitempty"`
+	ExternalID       *string             `bson:"externalId,omitempty"`
+	Recurrence       *recurrenceDbModel  `bson:"recurrence,omitempty"`
+}
+
+type splitDbModel struct {
+	Method string              `bson:"method"`
+	Shares []splitShareDbModel `bson:"shares"`
+}
+
+type splitShareDbModel struct {
+	User  string  `bson:"user"`
+	Value float64 `bson:"value"`
+}
+
+type recurrenceDbModel struct {
+	RecurringExpenseID primitive.ObjectID `bson:"recurringExpenseId"`
+	Date               time.Time          `bson:"date"`
 }
 
 // ExpenseRepository represents a struct to access expenses MongoDB collection.
@@ -41,7 +75,21 @@ type ExpenseRepository struct {
 
 // ExpenseRepoInterface defines a contract to persist expenses in the database.
 type ExpenseRepoInterface interface {
+	EnsureIndexes(ctx context.Context) error
+	GetAll(ctx context.Context, filter domain.ExpenseListFilter) (*domain.ExpensePage, error)
+	GetOne(ctx context.Context, id string) (*domain.Expense, error)
 	Insert(ctx context.Context, expense domain.Expense) (*string, error)
+	InsertMany(ctx context.Context, expenses []domain.Expense) ([]string, error)
+	Update(ctx context.Context, expense domain.Expense) (*domain.UpdateResult, error)
+	GetExternalIDs(ctx context.Context, externalIDs []string) ([]string, error)
+	ReassignCategories(
+		ctx context.Context,
+		fromCategoryID string,
+		assignments []domain.CategoryAssignment,
+		updatedBy string,
+		updatedAt time.Time,
+	) (*domain.UpdateResult, error)
+	SoftDeleteOne(ctx context.Context, id string, deletedBy string) (*domain.DeleteResult, error)
 	DeleteAll(ctx context.Context) (*domain.DeleteResult, error)
 }
 
@@ -58,15 +106,157 @@ func (r *ExpenseRepository) collection() *mongo.Collection {
 	return r.client.Collection(expenseCollectionName)
 }
 
+// expenseIndexes lists unique indexes the expense writes rely on.
+var expenseIndexes = []mongo.IndexModel{
+	// A recurring expense occurrence is materialized at most once.
+	{
+		Keys: bson.D{
+			{Key: "recurrence.recurringExpenseId", Value: 1},
+			{Key: "recurrence.date", Value: 1},
+		},
+		Options: options.Index().
+			SetName("recurrence_unique").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"recurrence.recurringExpenseId": bson.M{"$exists": true}}),
+	},
+	// A bank transaction is imported at most once.
+	{
+		Keys: bson.D{{Key: "externalId", Value: 1}},
+		Options: options.Index().
+			SetName("externalId_unique").
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"externalId": bson.M{"$exists": true}}),
+	},
+}
+
+// EnsureIndexes creates unique indexes of the expenses collection unless they exist already.
+func (r *ExpenseRepository) EnsureIndexes(ctx context.Context) error {
+	ctx, span := tracer.NewSpan(ctx, "ensure expense indexes in the database")
+	defer span.End()
+
+	if _, createErr := r.collection().Indexes().CreateMany(ctx, expenseIndexes); createErr != nil {
+		tracer.AddSpanError(span, createErr)
+		return errors.Wrap(createErr, "mongodb create expense indexes")
+	}
+
+	return nil
+}
+
+// GetAll returns a page of expenses from the database that matches the filter.
+func (r *ExpenseRepository) GetAll(
+	ctx context.Context,
+	filter domain.ExpenseListFilter,
+) (*domain.ExpensePage, error) {
+	ctx, span := tracer.NewSpan(ctx, "list expenses in the database")
+	defer span.End()
+
+	query, queryErr := r.buildListQuery(ctx, filter)
+	if queryErr != nil {
+		tracer.AddSpanError(span, queryErr)
+		return nil, queryErr
+	}
+
+	sortDirection := 1
+	if filter.SortOrder() == domain.SortOrderDesc {
+		sortDirection = -1
+	}
+
+	// Fetch one extra document to find out whether there is a next page.
+	operations := []bson.M{
+		{"$match": query},
+		{"$sort": bson.D{
+			{Key: string(filter.SortField()), Value: sortDirection},
+			{Key: "_id", Value: sortDirection},
+		}},
+		{"$limit": filter.Limit() + 1},
+	}
+	operations = append(operations, categoryLookupStages()...)
+
+	cursor, cursorErr := r.collection().Aggregate(ctx, operations)
+	if cursorErr != nil {
+		tracer.AddSpanError(span, cursorErr)
+		return nil, errors.Wrap(cursorErr, "mongodb cursor expense")
+	}
+
+	span.AddEvent("cursor iteration")
+
+	var expenseDbModels []expenseDbModel
+	if allError := cursor.All(ctx, &expenseDbModels); allError != nil {
+		tracer.AddSpanError(span, allError)
+		return nil, errors.Wrap(allError, "cursor iteration")
+	}
+
+	span.AddEvent("fetched finished", trace.WithAttributes(attribute.Int("items", len(expenseDbModels))))
+
+	expenses := []domain.Expense{}
+	for _, expenseDbModel := range expenseDbModels {
+		exp, expErr := unmarshalExpense(expenseDbModel)
+		if expErr != nil {
+			return nil, expErr
+		}
+		expenses = append(expenses, *exp)
+	}
+
+	page := domain.NewExpensePage(expenses, filter.Limit(), filter.SortField())
+
+	return &page, nil
+}
+
+// GetOne returns a single expense from the database.
+func (r *ExpenseRepository) GetOne(ctx context.Context, id string) (*domain.Expense, error) {
+	ctx, span := tracer.NewSpan(ctx, "find expense in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	matchStage := bson.M{
+		"$match": bson.M{
+			"_id":       objID,
+			"deletedAt": bson.M{"$exists": false},
+		},
+	}
+	// Expenses of trashed categories are in the trash along with the category.
+	categoryMatchStage := bson.M{
+		"$match": bson.M{
+			"category.deletedAt": bson.M{"$exists": false},
+		},
+	}
+	operations := append([]bson.M{matchStage}, categoryLookupStages()...)
+	operations = append(operations, categoryMatchStage)
+	cursor, cursorErr := r.collection().Aggregate(ctx, operations)
+	if cursorErr != nil {
+		tracer.AddSpanError(span, cursorErr)
+		return nil, errors.Wrap(cursorErr, "mongodb cursor expense")
+	}
+
+	var expenseDbModels []expenseDbModel
+	if allError := cursor.All(ctx, &expenseDbModels); allError != nil {
+		tracer.AddSpanError(span, allError)
+		return nil, errors.Wrap(allError, "cursor iteration")
+	}
+
+	if len(expenseDbModels) == 0 {
+		return nil, nil
+	}
+
+	return unmarshalExpense(expenseDbModels[0])
+}
+
 // Insert insert a new record into database.
 func (r *ExpenseRepository) Insert(ctx context.Context, category domain.Expense) (*string, error) {
 	ctx, span := tracer.NewSpan(ctx, "add expense to the database")
 	defer span.End()
 
 	dbModel := r.marshalExpense(category)
-	tempUser := "kot"
-	dbModel.CreatedBy = tempUser
-	dbModel.CreatedAt = time.Now()
+	setCreateMetadata(&dbModel, time.Now())
+
+	if dbModel.Recurrence != nil {
+		return r.insertOccurrence(ctx, dbModel)
+	}
 
 	insRes, insErr := r.collection().InsertOne(ctx, dbModel)
 	if insErr != nil {
@@ -79,6 +269,252 @@ func (r *ExpenseRepository) Insert(ctx context.Context, category domain.Expense)
 	return &objIDString, nil
 }
 
+// setCreateMetadata fills in create metadata the expense does not carry.
+func setCreateMetadata(dbModel *expenseDbModel, now time.Time) {
+	if dbModel.CreatedBy == "" {
+		tempUser := "kot"
+		dbModel.CreatedBy = tempUser
+	}
+	if dbModel.CreatedAt.IsZero() {
+		dbModel.CreatedAt = now
+	}
+}
+
+// insertOccurrence inserts an expense materialized from a recurring expense occurrence, unless
+// the occurrence already has an expense. Trashed expenses count too, so that an occurrence
+// deleted by the user is not materialized again.
+func (r *ExpenseRepository) insertOccurrence(ctx context.Context, dbModel expenseDbModel) (*string, error) {
+	filter := bson.M{
+		"recurrence.recurringExpenseId": dbModel.Recurrence.RecurringExpenseID,
+		"recurrence.date":               dbModel.Recurrence.Date,
+	}
+	updater := bson.M{"$setOnInsert": dbModel}
+	opts := options.FindOneAndUpdate().
+		SetUpsert(true).
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"_id": 1})
+
+	upserted := expenseDbModel{}
+	upsertErr := r.collection().FindOneAndUpdate(ctx, filter, updater, opts).Decode(&upserted)
+	if mongo.IsDuplicateKeyError(upsertErr) {
+		// A concurrent upsert has inserted the occurrence first, the retry matches it.
+		upsertErr = r.collection().FindOneAndUpdate(ctx, filter, updater, opts).Decode(&upserted)
+	}
+	if upsertErr != nil {
+		return nil, errors.Wrap(upsertErr, "mongodb upsert expense occurrence")
+	}
+
+	objIDString := upserted.ID.Hex()
+	return &objIDString, nil
+}
+
+// InsertMany inserts all expenses into the database within a single transaction.
+// Nothing is inserted when any of the expenses is imported already.
+func (r *ExpenseRepository) InsertMany(ctx context.Context, expenses []domain.Expense) ([]string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add expenses to the database")
+	span.SetAttributes(attribute.Int("items", len(expenses)))
+	defer span.End()
+
+	now := time.Now()
+	dbModels := make([]interface{}, 0, len(expenses))
+	for _, expense := range expenses {
+		dbModel := r.marshalExpense(expense)
+		setCreateMetadata(&dbModel, now)
+		dbModels = append(dbModels, dbModel)
+	}
+
+	ids := make([]string, 0, len(expenses))
+	txErr := r.client.WithTransaction(ctx, func(ctx context.Context) error {
+		// The callback is retried on transient transaction errors, IDs of an aborted attempt are dropped.
+		ids = ids[:0]
+		insRes, insErr := r.collection().InsertMany(ctx, dbModels)
+		if mongo.IsDuplicateKeyError(insErr) {
+			return errors.Wrap(domain.ErrExpenseImported, insErr.Error())
+		}
+		if insErr != nil {
+			return errors.Wrap(insErr, "mongodb insert expenses")
+		}
+		for _, insertedID := range insRes.InsertedIDs {
+			objID, _ := insertedID.(primitive.ObjectID)
+			ids = append(ids, objID.Hex())
+		}
+		return nil
+	})
+	if txErr != nil {
+		tracer.AddSpanError(span, txErr)
+		return nil, txErr
+	}
+
+	return ids, nil
+}
+
+// Update updates an expense in the database.
+func (r *ExpenseRepository) Update(ctx context.Context, expense domain.Expense) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update expense in the database")
+	span.SetAttributes(attribute.String("id", expense.ID()))
+	defer span.End()
+
+	dbModel := r.marshalExpense(expense)
+
+	filter := bson.M{"_id": dbModel.ID, "deletedAt": bson.M{"$exists": false}}
+	updater := bson.M{"$set": dbModel}
+
+	unset := bson.M{}
+	if dbModel.Comment == nil {
+		unset["comment"] = ""
+	}
+	if dbModel.TripID == nil {
+		unset["tripId"] = ""
+	}
+	if dbModel.MerchantID == nil {
+		unset["merchantId"] = ""
+	}
+	if dbModel.AccountID == nil {
+		unset["accountId"] = ""
+	}
+	if dbModel.ReconciliationID == nil {
+		unset["reconciliationId"] = ""
+	}
+	if len(dbModel.Tags) == 0 {
+		unset["tags"] = ""
+	}
+	if !dbModel.Reimbursable {
+		unset["reimbursable"] = ""
+	}
+	if dbModel.Split == nil {
+		unset["paidBy"] = ""
+		unset["split"] = ""
+	}
+	if len(unset) != 0 {
+		updater["$unset"] = unset
+	}
+
+	opts := options.Update().SetUpsert(false)
+
+	updResult, updErr := r.collection().UpdateOne(ctx, filter, updater, opts)
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update expense")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// GetExternalIDs returns those of external IDs that already belong to expenses, trashed ones included.
+func (r *ExpenseRepository) GetExternalIDs(ctx context.Context, externalIDs []string) ([]string, error) {
+	ctx, span := tracer.NewSpan(ctx, "find expense external ids in the database")
+	span.SetAttributes(attribute.Int("items", len(externalIDs)))
+	defer span.End()
+
+	if len(externalIDs) == 0 {
+		return []string{}, nil
+	}
+
+	filter := bson.M{"externalId": bson.M{"$in": externalIDs}}
+	values, distinctErr := r.collection().Distinct(ctx, "externalId", filter)
+	if distinctErr != nil {
+		tracer.AddSpanError(span, distinctErr)
+		return nil, errors.Wrap(distinctErr, "mongodb distinct external ids")
+	}
+
+	existing := make([]string, 0, len(values))
+	for _, value := range values {
+		if externalID, ok := value.(string); ok {
+			existing = append(existing, externalID)
+		}
+	}
+
+	return existing, nil
+}
+
+// ReassignCategories moves expenses of a category to other categories.
+// Expenses that do not belong to the source category or are locked by a reconciliation are left untouched.
+func (r *ExpenseRepository) ReassignCategories(
+	ctx context.Context,
+	fromCategoryID string,
+	assignments []domain.CategoryAssignment,
+	updatedBy string,
+	updatedAt time.Time,
+) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "reassign expense categories in the database")
+	span.SetAttributes(attribute.String("from", fromCategoryID), attribute.Int("items", len(assignments)))
+	defer span.End()
+
+	if len(assignments) == 0 {
+		return &domain.UpdateResult{}, nil
+	}
+
+	fromID, _ := primitive.ObjectIDFromHex(fromCategoryID)
+	operations := make([]mongo.WriteModel, 0, len(assignments))
+	for _, assignment := range assignments {
+		expenseID, _ := primitive.ObjectIDFromHex(assignment.ExpenseID)
+		categoryID, _ := primitive.ObjectIDFromHex(assignment.CategoryID)
+		operation := mongo.NewUpdateOneModel()
+		operation.SetFilter(bson.M{
+			"_id":              expenseID,
+			"categoryId":       fromID,
+			"deletedAt":        bson.M{"$exists": false},
+			"reconciliationId": bson.M{"$exists": false},
+		})
+		operation.SetUpdate(bson.M{
+			"$set": bson.M{
+				"categoryId": categoryID,
+				"updatedBy":  updatedBy,
+				"updatedAt":  updatedAt,
+			},
+		})
+		operations = append(operations, operation)
+	}
+
+	updResult, updErr := r.collection().BulkWrite(ctx, operations)
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb bulk write expense categories")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// SoftDeleteOne moves an expense to the trash.
+func (r *ExpenseRepository) SoftDeleteOne(
+	ctx context.Context,
+	id string,
+	deletedBy string,
+) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "move expense to the trash in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	filter := bson.M{"_id": objID, "deletedAt": bson.M{"$exists": false}}
+	updater := bson.M{
+		"$set": bson.M{
+			"deletedAt": time.Now(),
+			"deletedBy": deletedBy,
+		},
+	}
+
+	updResult, updErr := r.collection().UpdateOne(ctx, filter, updater)
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb soft delete expense")
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
 // DeleteAll deletes all expenses in the database.
 func (r *ExpenseRepository) DeleteAll(ctx context.Context) (*domain.DeleteResult, error) {
 	query := bson.M{}
@@ -94,30 +530,236 @@ func (r *ExpenseRepository) DeleteAll(ctx context.Context) (*domain.DeleteResult
 	return result, nil
 }
 
+// buildListQuery prepares a query to match expenses against the list filter.
+func (r *ExpenseRepository) buildListQuery(ctx context.Context, filter domain.ExpenseListFilter) (bson.M, error) {
+	query := bson.M{
+		"deletedAt": bson.M{"$exists": false},
+	}
+
+	dateQuery := bson.M{}
+	if filter.From() != nil {
+		dateQuery["$gte"] = *filter.From()
+	}
+	if filter.To() != nil {
+		dateQuery["$lte"] = *filter.To()
+	}
+	if len(dateQuery) != 0 {
+		query["date"] = dateQuery
+	}
+
+	if filter.Currency() != nil {
+		query["currency"] = *filter.Currency()
+	}
+
+	if filter.TripID() != nil {
+		tripID, _ := primitive.ObjectIDFromHex(*filter.TripID())
+		query["tripId"] = tripID
+	}
+
+	if filter.Text() != nil {
+		query["comment"] = bson.M{
+			"$regex": primitive.Regex{
+				Pattern: regexp.QuoteMeta(*filter.Text()),
+				Options: "i",
+			},
+		}
+	}
+
+	// Expenses of trashed categories are in the trash along with the category.
+	trashedIDs, trashedIDsErr := trashedCategoryIDs(ctx, r.client)
+	if trashedIDsErr != nil {
+		return nil, trashedIDsErr
+	}
+	var categoryIDs []primitive.ObjectID
+	if filter.CategoryID() != nil {
+		subtreeIDs, subtreeIDsErr := subtreeCategoryIDs(ctx, r.client, []string{*filter.CategoryID()})
+		if subtreeIDsErr != nil {
+			return nil, subtreeIDsErr
+		}
+		categoryIDs = subtreeIDs
+	}
+	if categoryQuery := categoryIDQuery(categoryIDs, trashedIDs); len(categoryQuery) != 0 {
+		query["categoryId"] = categoryQuery
+	}
+
+	if filter.Cursor() != nil {
+		cursorQuery, cursorQueryErr := r.buildCursorQuery(*filter.Cursor(), filter.SortOrder())
+		if cursorQueryErr != nil {
+			return nil, cursorQueryErr
+		}
+		query = bson.M{"$and": []bson.M{query, cursorQuery}}
+	}
+
+	return query, nil
+}
+
+// subtreeCategoryIDs returns IDs of the categories and all their descendants.
+func subtreeCategoryIDs(
+	ctx context.Context,
+	client *database.MongoClient,
+	categoryIDs []string,
+) ([]primitive.ObjectID, error) {
+	return findCategoryIDs(ctx, client, bson.M{"path": bson.M{"$regex": subtreePathRegex(categoryIDs)}})
+}
+
+// trashedCategoryIDs returns IDs of the trashed categories.
+func trashedCategoryIDs(ctx context.Context, client *database.MongoClient) ([]primitive.ObjectID, error) {
+	return findCategoryIDs(ctx, client, bson.M{"deletedAt": bson.M{"$exists": true}})
+}
+
+// findCategoryIDs returns IDs of the categories matching the query.
+func findCategoryIDs(ctx context.Context, client *database.MongoClient, query bson.M) ([]primitive.ObjectID, error) {
+	cursor, findErr := client.Collection(categoriesCollectionName).Find(ctx, query)
+	if findErr != nil {
+		return nil, errors.Wrap(findErr, "mongodb find categories")
+	}
+
+	var categoryModels []categoryDbModel
+	if allError := cursor.All(ctx, &categoryModels); allError != nil {
+		return nil, errors.Wrap(allError, "cursor iteration")
+	}
+
+	categoryIDs := make([]primitive.ObjectID, 0, len(categoryModels))
+	for _, categoryModel := range categoryModels {
+		categoryIDs = append(categoryIDs, categoryModel.ID)
+	}
+
+	return categoryIDs, nil
+}
+
+// subtreePathRegex returns a regex matching paths of the categories and all their descendants.
+func subtreePathRegex(categoryIDs []string) primitive.Regex {
+	quoted := make([]string, 0, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		quoted = append(quoted, regexp.QuoteMeta(categoryID))
+	}
+	return primitive.Regex{
+		Pattern: fmt.Sprintf("\\|(%s)(\\||$)", strings.Join(quoted, "|")),
+	}
+}
+
+// categoryIDQuery returns a query to match expense categories. Expenses are limited to the categories
+// unless they are nil, the excluded categories never match.
+func categoryIDQuery(categoryIDs []primitive.ObjectID, excludedIDs []primitive.ObjectID) bson.M {
+	query := bson.M{}
+	if categoryIDs != nil {
+		query["$in"] = categoryIDs
+	}
+	if len(excludedIDs) != 0 {
+		query["$nin"] = excludedIDs
+	}
+	return query
+}
+
+// buildCursorQuery prepares a query to continue listing after the cursor.
+func (r *ExpenseRepository) buildCursorQuery(cursor domain.ExpenseCursor, order domain.SortOrder) (bson.M, error) {
+	cursorID, cursorIDErr := primitive.ObjectIDFromHex(cursor.ID())
+	if cursorIDErr != nil {
+		return nil, errors.Wrap(cursorIDErr, "parse cursor id")
+	}
+
+	var cursorValue interface{}
+	switch cursor.SortField() {
+	case domain.SortFieldPrice:
+		price, priceErr := strconv.ParseFloat(cursor.Value(), 64)
+		if priceErr != nil {
+			return nil, errors.Wrap(priceErr, "parse cursor price")
+		}
+		cursorValue = price
+	case domain.SortFieldDate, domain.SortFieldCreatedAt:
+		date, dateErr := time.Parse(time.RFC3339Nano, cursor.Value())
+		if dateErr != nil {
+			return nil, errors.Wrap(dateErr, "parse cursor date")
+		}
+		cursorValue = date
+	}
+
+	operator := "$gt"
+	if order == domain.SortOrderDesc {
+		operator = "$lt"
+	}
+
+	field := string(cursor.SortField())
+	query := bson.M{
+		"$or": []bson.M{
+			{field: bson.M{operator: cursorValue}},
+			{field: cursorValue, "_id": bson.M{operator: cursorID}},
+		},
+	}
+
+	return query, nil
+}
+
 // marshalExpense marshalls expense domain object into MongoDB model.
 func (r ExpenseRepository) marshalExpense(expense domain.Expense) expenseDbModel {
 	id, _ := primitive.ObjectIDFromHex(expense.ID())
 	categoryID, _ := primitive.ObjectIDFromHex(expense.Category().ID())
 
 	return expenseDbModel{
-		ID:         id,
-		CategoryID: categoryID,
-		Price:      expense.Price(),
-		Currency:   expense.Currency(),
-		Quantity:   expense.Quantity(),
-		Comment:    expense.Comment(),
-		Trip:       expense.Trip(),
-		Date:       expense.Date(),
-	}
-}
-
-// func (r ExpenseRepository) unmarshalExpense(expenseModel expenseDbModel) (*domain.Expense, error) {
-// 	exp, expErr := domain.NewExpense(expenseModel.ID.Hex(), expenseModel.CategoryID.Hex(),
-// 		expenseModel.Price, expenseModel.Currency, expenseModel.Quantity,
-// 		expenseModel.Comment, expenseModel.Trip, expenseModel.Date)
-// 	if expErr != nil {
-// 		return nil, errors.Wrap(expErr, "unmarshal expense")
-// 	}
-// 	exp.SetMetadata(expenseModel.CreatedBy, expenseModel.CreatedAt, expenseModel.UpdatedBy, expenseModel.UpdatedAt)
-// 	return exp, nil
-// }
+		ID:               id,
+		CategoryID:       categoryID,
+		Price:            expense.Price(),
+		Currency:         expense.Currency(),
+		Quantity:         expense.Quantity(),
+		Comment:          expense.Comment(),
+		TripID:           marshalTripID(expense.TripID()),
+		ReceiptID:        marshalReceiptID(expense.ReceiptID()),
+		MerchantID:       marshalMerchantID(expense.MerchantID()),
+		AccountID:        marshalAccountID(expense.AccountID()),
+		ReconciliationID: marshalReconciliationID(expense.ReconciliationID()),
+		Reimbursable:     expense.Reimbursable(),
+		Tags:             expense.Tags(),
+		PaidBy:           expense.PaidBy(),
+		Split:            marshalSplit(expense.Split()),
+		Date:             expense.Date(),
+		CreatedAt:        expense.CreatedAt(),
+		CreatedBy:        expense.CreatedBy(),
+		UpdatedAt:        expense.UpdatedAt(),
+		UpdatedBy:        expense.UpdatedBy(),
+		ExternalID:       expense.ExternalID(),
+		Recurrence:       marshalRecurrence(expense.Recurrence()),
+	}
+}
+
+func marshalSplit(split *domain.Split) *splitDbModel {
+	if split == nil {
+		return nil
+	}
+	shares := make([]splitShareDbModel, 0, len(split.Shares()))
+	for _, share := range split.Shares() {
+		value, _ := share.Value.Float64()
+		shares = append(shares, splitShareDbModel{
+			User:  share.User,
+			Value: value,
+		})
+	}
+	return &splitDbModel{
+		Method: string(split.Method()),
+		Shares: shares,
+	}
+}
+
+func unmarshalSplit(dbModel splitDbModel) (*domain.Split, error) {
+	shares := make([]domain.SplitShareParams, 0, len(dbModel.Shares))
+	for _, share := range dbModel.Shares {
+		shares = append(shares, domain.SplitShareParams{
+			User:  share.User,
+			Value: share.Value,
+		})
+	}
+	return domain.NewSplit(domain.SplitParams{
+		Method: dbModel.Method,
+		Shares: shares,
+	})
+}
+
+func marshalRecurrence(recurrence *domain.Recurrence) *recurrenceDbModel {
+	if recurrence == nil {
+		return nil
+	}
+	recurringExpenseID, _ := primitive.ObjectIDFromHex(recurrence.RecurringExpenseID)
+	return &recurrenceDbModel{
+		RecurringExpenseID: recurringExpenseID,
+		Date:               recurrence.Date,
+	}
+}
@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCategoryIDQuery_TrashedCategories_ExcludesTheirExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	trashedIDs := []primitive.ObjectID{primitive.NewObjectID()}
+
+	// Act
+	res := categoryIDQuery(nil, trashedIDs)
+
+	// Assert
+	assert.Equal(t, bson.M{"$nin": trashedIDs}, res, "Expenses of trashed categories should not match.")
+}
+
+func TestCategoryIDQuery_SubtreeWithTrashedCategories_LimitsToSubtree(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	subtreeIDs := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID()}
+	trashedIDs := []primitive.ObjectID{subtreeIDs[1]}
+
+	// Act
+	res := categoryIDQuery(subtreeIDs, trashedIDs)
+
+	// Assert
+	assert.Equal(t, bson.M{"$in": subtreeIDs, "$nin": trashedIDs}, res)
+}
+
+func TestCategoryIDQuery_EmptySubtree_MatchesNothing(t *testing.T) {
+	t.Parallel()
+	// Act
+	res := categoryIDQuery([]primitive.ObjectID{}, nil)
+
+	// Assert
+	assert.Equal(t, bson.M{"$in": []primitive.ObjectID{}}, res)
+}
+
+func TestCategoryIDQuery_NoCategories_MatchesAll(t *testing.T) {
+	t.Parallel()
+	// Act
+	res := categoryIDQuery(nil, nil)
+
+	// Assert
+	assert.Empty(t, res)
+}
+
+func TestSubtreePathRegex_SeveralCategories_MatchesTheirSubtrees(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	categoryIDs := []string{"c1", "c2"}
+
+	// Act
+	res := subtreePathRegex(categoryIDs)
+
+	// Assert
+	assert.Equal(t, "\\|(c1|c2)(\\||$)", res.Pattern, "Regex should match paths through any of the categories.")
+}
+
+func TestSetCreateMetadata_WithoutMetadata_SetsDefaults(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	now := time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC)
+	dbModel := expenseDbModel{}
+
+	// Act
+	setCreateMetadata(&dbModel, now)
+
+	// Assert
+	assert.Equal(t, "kot", dbModel.CreatedBy, "Creator should default to the temporary user.")
+	assert.Equal(t, now, dbModel.CreatedAt, "Creation time should default to now.")
+}
+
+func TestSetCreateMetadata_WithMetadata_KeepsIt(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	createdAt := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	dbModel := expenseDbModel{CreatedBy: "userId", CreatedAt: createdAt}
+
+	// Act
+	setCreateMetadata(&dbModel, time.Now())
+
+	// Assert
+	assert.Equal(t, "userId", dbModel.CreatedBy, "Creator should be kept.")
+	assert.Equal(t, createdAt, dbModel.CreatedAt, "Creation time should be kept.")
+}
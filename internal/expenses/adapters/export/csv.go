@@ -0,0 +1,54 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+// CSVWriter writes expense rows as comma separated values with a header line.
+type CSVWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+// NewCSVWriter returns CSV writer.
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{
+		writer: csv.NewWriter(w),
+	}
+}
+
+// Write writes a single row, the header is written before the first row.
+func (w *CSVWriter) Write(row domain.ExportRow) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	if err := w.writer.Write(rowValues(row)); err != nil {
+		return errors.Wrap(err, "write csv row")
+	}
+	return nil
+}
+
+// Close writes the header of an empty export and flushes buffered rows.
+func (w *CSVWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return errors.Wrap(w.writer.Error(), "flush csv")
+}
+
+func (w *CSVWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	if err := w.writer.Write(columns); err != nil {
+		return errors.Wrap(err, "write csv header")
+	}
+	return nil
+}
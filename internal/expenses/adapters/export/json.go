@@ -0,0 +1,104 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+// jsonRow holds a JSON representation of an exported row.
+type jsonRow struct {
+	ID                string       `json:"id"`
+	Date              string       `json:"date"`
+	CategoryID        string       `json:"categoryId"`
+	Category          string       `json:"category"`
+	CategoryPath      []string     `json:"categoryPath"`
+	Comment           *string      `json:"comment,omitempty"`
+	Trip              *string      `json:"trip,omitempty"`
+	Price             json.Number  `json:"price"`
+	Quantity          json.Number  `json:"quantity"`
+	Total             json.Number  `json:"total"`
+	Currency          string       `json:"currency"`
+	ConvertedTotal    *json.Number `json:"convertedTotal,omitempty"`
+	ConvertedCurrency *string      `json:"convertedCurrency,omitempty"`
+	ExchangeRate      *json.Number `json:"exchangeRate,omitempty"`
+}
+
+// JSONWriter writes expense rows as a JSON array, one row at a time.
+// Amounts are written as numbers with their exact decimal representation.
+type JSONWriter struct {
+	writer io.Writer
+	rows   int
+}
+
+// NewJSONWriter returns JSON writer.
+func NewJSONWriter(w io.Writer) *JSONWriter {
+	return &JSONWriter{
+		writer: w,
+	}
+}
+
+// Write writes a single row as an array element.
+func (w *JSONWriter) Write(row domain.ExportRow) error {
+	encoded, encodedErr := json.Marshal(newJSONRow(row))
+	if encodedErr != nil {
+		return errors.Wrap(encodedErr, "marshal json row")
+	}
+
+	separator := ","
+	if w.rows == 0 {
+		separator = "["
+	}
+	if _, err := io.WriteString(w.writer, separator); err != nil {
+		return errors.Wrap(err, "write json row")
+	}
+	if _, err := w.writer.Write(encoded); err != nil {
+		return errors.Wrap(err, "write json row")
+	}
+	w.rows++
+
+	return nil
+}
+
+// Close terminates the array.
+func (w *JSONWriter) Close() error {
+	ending := "]"
+	if w.rows == 0 {
+		ending = "[]"
+	}
+	_, err := io.WriteString(w.writer, ending)
+	return errors.Wrap(err, "close json array")
+}
+
+func newJSONRow(row domain.ExportRow) jsonRow {
+	result := jsonRow{
+		ID:           row.ID,
+		Date:         row.Date.Format(dateLayout),
+		CategoryID:   row.CategoryID,
+		Category:     categoryPath(row),
+		CategoryPath: row.CategoryPath,
+		Comment:      row.Comment,
+		Trip:         row.Trip,
+		Price:        json.Number(row.Price.String()),
+		Quantity:     json.Number(row.Quantity.String()),
+		Total:        json.Number(row.Total.String()),
+		Currency:     string(row.Currency),
+	}
+	if row.ConvertedTotal != nil {
+		convertedTotal := json.Number(row.ConvertedTotal.String())
+		result.ConvertedTotal = &convertedTotal
+	}
+	if row.ConvertedCurrency != nil {
+		convertedCurrency := string(*row.ConvertedCurrency)
+		result.ConvertedCurrency = &convertedCurrency
+	}
+	if row.ExchangeRate != nil {
+		exchangeRate := json.Number(row.ExchangeRate.String())
+		result.ExchangeRate = &exchangeRate
+	}
+
+	return result
+}
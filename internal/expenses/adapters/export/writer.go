@@ -0,0 +1,96 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+const dateLayout string = "2006-01-02"
+
+// columns holds exported columns header, the order matches rowValues.
+// nolint:gochecknoglobals
+var columns = []string{
+	"id",
+	"date",
+	"categoryId",
+	"category",
+	"comment",
+	"trip",
+	"price",
+	"quantity",
+	"total",
+	"currency",
+	"convertedTotal",
+	"convertedCurrency",
+	"exchangeRate",
+}
+
+// Writer writes exported expense rows in a file format.
+type Writer interface {
+	Write(row domain.ExportRow) error
+	Close() error
+}
+
+// NewWriter returns a writer of the export format.
+func NewWriter(format domain.ExportFormat, w io.Writer) (Writer, error) {
+	switch format {
+	case domain.ExportFormatCSV:
+		return NewCSVWriter(w), nil
+	case domain.ExportFormatXLSX:
+		return NewXLSXWriter(w), nil
+	case domain.ExportFormatJSON:
+		return NewJSONWriter(w), nil
+	default:
+		return nil, fmt.Errorf("unknown export format %s", format)
+	}
+}
+
+// categoryPath joins category names into a human readable path, e.g. Food/Groceries.
+func categoryPath(row domain.ExportRow) string {
+	return strings.Join(row.CategoryPath, domain.CategoryNameSeparator)
+}
+
+// rowValues returns row values as text, empty values are returned as empty strings.
+func rowValues(row domain.ExportRow) []string {
+	return []string{
+		row.ID,
+		row.Date.Format(dateLayout),
+		row.CategoryID,
+		categoryPath(row),
+		stringOrEmpty(row.Comment),
+		stringOrEmpty(row.Trip),
+		row.Price.String(),
+		row.Quantity.String(),
+		row.Total.String(),
+		string(row.Currency),
+		decimalOrEmpty(row.ConvertedTotal),
+		currencyOrEmpty(row.ConvertedCurrency),
+		decimalOrEmpty(row.ExchangeRate),
+	}
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func decimalOrEmpty(value *decimal.Decimal) string {
+	if value == nil {
+		return ""
+	}
+	return value.String()
+}
+
+func currencyOrEmpty(value *domain.Currency) string {
+	if value == nil {
+		return ""
+	}
+	return string(*value)
+}
@@ -0,0 +1,156 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters/export"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newExportRow() domain.ExportRow {
+	comment := `Dinner & "drinks"`
+	convertedTotal := decimal.NewFromFloat(12.5)
+	convertedCurrency := domain.Currency("EUR")
+	exchangeRate := decimal.NewFromFloat(2)
+
+	return domain.ExportRow{
+		ID:                "expenseId",
+		Date:              time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+		CategoryID:        "categoryId",
+		CategoryPath:      []string{"Food", "Restaurants"},
+		Comment:           &comment,
+		Price:             decimal.NewFromFloat(12.5),
+		Quantity:          decimal.NewFromInt(2),
+		Total:             decimal.NewFromInt(25),
+		Currency:          "USD",
+		ConvertedTotal:    &convertedTotal,
+		ConvertedCurrency: &convertedCurrency,
+		ExchangeRate:      &exchangeRate,
+	}
+}
+
+func TestNewWriter_UnknownFormat_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Act
+	result, err := export.NewWriter("pdf", &bytes.Buffer{})
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestCSVWriter_Rows_WritesHeaderAndRows(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	output := &bytes.Buffer{}
+	sut := export.NewCSVWriter(output)
+
+	// Act
+	writeErr := sut.Write(newExportRow())
+	closeErr := sut.Close()
+
+	// Assert
+	assert.Nil(t, writeErr, "Error result should be nil.")
+	assert.Nil(t, closeErr, "Error result should be nil.")
+	assert.Equal(t,
+		"id,date,categoryId,category,comment,trip,price,quantity,total,currency,"+
+			"convertedTotal,convertedCurrency,exchangeRate\n"+
+			`expenseId,2021-07-01,categoryId,Food/Restaurants,"Dinner & ""drinks""",,12.5,2,25,USD,12.5,EUR,2`+"\n",
+		output.String(), "Should write CSV.")
+}
+
+func TestCSVWriter_NoRows_WritesHeader(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	output := &bytes.Buffer{}
+	sut := export.NewCSVWriter(output)
+
+	// Act
+	err := sut.Close()
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Contains(t, output.String(), "id,date,", "Should write the header.")
+}
+
+func TestJSONWriter_Rows_WritesArray(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	output := &bytes.Buffer{}
+	sut := export.NewJSONWriter(output)
+
+	// Act
+	_ = sut.Write(newExportRow())
+	_ = sut.Write(newExportRow())
+	err := sut.Close()
+
+	// Assert
+	var rows []map[string]interface{}
+	unmarshalErr := json.Unmarshal(output.Bytes(), &rows)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Nil(t, unmarshalErr, "Should write valid JSON.")
+	assert.Len(t, rows, 2, "Should write all rows.")
+	assert.Equal(t, "Food/Restaurants", rows[0]["category"], "Should write category path.")
+	assert.Equal(t, 12.5, rows[0]["convertedTotal"], "Should write amounts as numbers.")
+	assert.NotContains(t, rows[0], "trip", "Should omit empty values.")
+}
+
+func TestJSONWriter_NoRows_WritesEmptyArray(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	output := &bytes.Buffer{}
+	sut := export.NewJSONWriter(output)
+
+	// Act
+	err := sut.Close()
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "[]", output.String(), "Should write an empty array.")
+}
+
+func TestXLSXWriter_Rows_WritesWorkbook(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	output := &bytes.Buffer{}
+	sut := export.NewXLSXWriter(output)
+
+	// Act
+	writeErr := sut.Write(newExportRow())
+	closeErr := sut.Close()
+
+	// Assert
+	assert.Nil(t, writeErr, "Error result should be nil.")
+	assert.Nil(t, closeErr, "Error result should be nil.")
+
+	archive, archiveErr := zip.NewReader(bytes.NewReader(output.Bytes()), int64(output.Len()))
+	assert.Nil(t, archiveErr, "Should write a zip archive.")
+	parts := make(map[string]string)
+	for _, file := range archive.File {
+		reader, _ := file.Open()
+		content, _ := io.ReadAll(reader)
+		parts[file.Name] = string(content)
+		assert.Nil(t, xml.Unmarshal(content, new(interface{})), "Part %s should be valid XML.", file.Name)
+	}
+	for _, name := range []string{
+		"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/styles.xml",
+	} {
+		assert.Contains(t, parts, name, "Should write workbook part.")
+	}
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<c r="A1" s="2" t="inlineStr"><is><t xml:space="preserve">id</t></is></c>`,
+		"Should write the header.")
+	assert.Contains(t, sheet, `<c r="B2" s="1"><v>44378</v></c>`, "Should write date as a serial date.")
+	assert.Contains(t, sheet, `Dinner &amp; &#34;drinks&#34;`, "Should escape text.")
+	assert.Contains(t, sheet, `<c r="I2"><v>25</v></c>`, "Should write total as a number.")
+	assert.NotContains(t, sheet, `r="F2"`, "Should skip empty cells.")
+}
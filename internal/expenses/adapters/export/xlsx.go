@@ -0,0 +1,245 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+// Defines XLSX cell styles, indexes of cellXfs in xlsxStyles.
+const (
+	xlsxStyleDefault = 0
+	xlsxStyleDate    = 1
+	xlsxStyleHeader  = 2
+)
+
+// xlsxMaxRows is a maximum number of rows in a worksheet.
+const xlsxMaxRows int = 1048576
+
+const xlsxSheetPath string = "xl/worksheets/sheet1.xml"
+
+const xmlHeader string = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+// xlsxParts holds static workbook parts written next to the worksheet.
+// nolint:gochecknoglobals
+var xlsxParts = []struct {
+	path    string
+	content string
+}{
+	{
+		path: "[Content_Types].xml",
+		content: `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ` +
+			`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ` +
+			`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ` +
+			`ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			`</Types>`,
+	},
+	{
+		path: "_rels/.rels",
+		content: `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" ` +
+			`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" ` +
+			`Target="xl/workbook.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		path: "xl/workbook.xml",
+		content: `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+			`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Expenses" sheetId="1" r:id="rId1"/></sheets>` +
+			`</workbook>`,
+	},
+	{
+		path: "xl/_rels/workbook.xml.rels",
+		content: `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" ` +
+			`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" ` +
+			`Target="worksheets/sheet1.xml"/>` +
+			`<Relationship Id="rId2" ` +
+			`Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" ` +
+			`Target="styles.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		path: "xl/styles.xml",
+		content: `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<numFmts count="1"><numFmt numFmtId="164" formatCode="yyyy-mm-dd"/></numFmts>` +
+			`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font>` +
+			`<font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+			`<fills count="2"><fill><patternFill patternType="none"/></fill>` +
+			`<fill><patternFill patternType="gray125"/></fill></fills>` +
+			`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+			`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+			`<cellXfs count="3">` +
+			`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+			`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+			`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+			`</cellXfs>` +
+			`</styleSheet>`,
+	},
+}
+
+// xlsxEpoch is a day zero of spreadsheet serial dates.
+// nolint:gochecknoglobals
+var xlsxEpoch = time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+
+// XLSXWriter writes expense rows as a single worksheet Office Open XML workbook.
+// The worksheet is streamed into the archive, so rows are not kept in memory.
+type XLSXWriter struct {
+	archive *zip.Writer
+	sheet   io.Writer
+	rows    int
+}
+
+// NewXLSXWriter returns XLSX writer.
+func NewXLSXWriter(w io.Writer) *XLSXWriter {
+	return &XLSXWriter{
+		archive: zip.NewWriter(w),
+	}
+}
+
+// Write writes a single row, the header is written before the first row.
+func (w *XLSXWriter) Write(row domain.ExportRow) error {
+	if err := w.begin(); err != nil {
+		return err
+	}
+	if w.rows >= xlsxMaxRows {
+		return fmt.Errorf("worksheet could not hold more than %d rows", xlsxMaxRows)
+	}
+
+	w.rows++
+	values := rowValues(row)
+	cells := []string{
+		stringCell(w.rows, 0, values[0], xlsxStyleDefault),
+		dateCell(w.rows, 1, row.Date),
+		stringCell(w.rows, 2, values[2], xlsxStyleDefault),
+		stringCell(w.rows, 3, values[3], xlsxStyleDefault),
+		stringCell(w.rows, 4, values[4], xlsxStyleDefault),
+		stringCell(w.rows, 5, values[5], xlsxStyleDefault),
+		numberCell(w.rows, 6, values[6]),
+		numberCell(w.rows, 7, values[7]),
+		numberCell(w.rows, 8, values[8]),
+		stringCell(w.rows, 9, values[9], xlsxStyleDefault),
+		numberCell(w.rows, 10, values[10]),
+		stringCell(w.rows, 11, values[11], xlsxStyleDefault),
+		numberCell(w.rows, 12, values[12]),
+	}
+
+	return w.writeRow(cells)
+}
+
+// Close completes the worksheet and writes the rest of the workbook.
+func (w *XLSXWriter) Close() error {
+	if err := w.begin(); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w.sheet, `</sheetData></worksheet>`); err != nil {
+		return errors.Wrap(err, "write xlsx worksheet")
+	}
+
+	for _, part := range xlsxParts {
+		partWriter, partErr := w.archive.Create(part.path)
+		if partErr != nil {
+			return errors.Wrapf(partErr, "create xlsx part %s", part.path)
+		}
+		if _, err := io.WriteString(partWriter, xmlHeader+part.content); err != nil {
+			return errors.Wrapf(err, "write xlsx part %s", part.path)
+		}
+	}
+
+	return errors.Wrap(w.archive.Close(), "close xlsx archive")
+}
+
+// begin starts the worksheet and writes the header row once.
+func (w *XLSXWriter) begin() error {
+	if w.sheet != nil {
+		return nil
+	}
+
+	sheet, sheetErr := w.archive.Create(xlsxSheetPath)
+	if sheetErr != nil {
+		return errors.Wrap(sheetErr, "create xlsx worksheet")
+	}
+	w.sheet = sheet
+
+	start := xmlHeader +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<sheetViews><sheetView workbookViewId="0">` +
+		`<pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/>` +
+		`</sheetView></sheetViews>` +
+		`<sheetData>`
+	if _, err := io.WriteString(w.sheet, start); err != nil {
+		return errors.Wrap(err, "write xlsx worksheet")
+	}
+
+	w.rows++
+	cells := make([]string, 0, len(columns))
+	for index, column := range columns {
+		cells = append(cells, stringCell(w.rows, index, column, xlsxStyleHeader))
+	}
+
+	return w.writeRow(cells)
+}
+
+func (w *XLSXWriter) writeRow(cells []string) error {
+	if _, err := fmt.Fprintf(w.sheet, `<row r="%d">`, w.rows); err != nil {
+		return errors.Wrap(err, "write xlsx row")
+	}
+	for _, cell := range cells {
+		if _, err := io.WriteString(w.sheet, cell); err != nil {
+			return errors.Wrap(err, "write xlsx row")
+		}
+	}
+	if _, err := io.WriteString(w.sheet, `</row>`); err != nil {
+		return errors.Wrap(err, "write xlsx row")
+	}
+	return nil
+}
+
+// cellReference returns a cell reference like A1 out of 1-based row and 0-based column.
+func cellReference(row int, column int) string {
+	name := ""
+	for column++; column > 0; column = (column - 1) / 26 {
+		name = string(rune('A'+(column-1)%26)) + name
+	}
+	return name + strconv.Itoa(row)
+}
+
+// stringCell returns an inline string cell, empty values produce no cell.
+func stringCell(row int, column int, value string, style int) string {
+	if len(value) == 0 {
+		return ""
+	}
+	var escaped bytes.Buffer
+	_ = xml.EscapeText(&escaped, []byte(value))
+	return fmt.Sprintf(`<c r="%s" s="%d" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`,
+		cellReference(row, column), style, escaped.String())
+}
+
+// numberCell returns a numeric cell, empty values produce no cell.
+func numberCell(row int, column int, value string) string {
+	if len(value) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`<c r="%s"><v>%s</v></c>`, cellReference(row, column), value)
+}
+
+// dateCell returns a date cell holding a serial date.
+func dateCell(row int, column int, date time.Time) string {
+	serial := date.Sub(xlsxEpoch).Hours() / 24
+	return fmt.Sprintf(`<c r="%s" s="%d"><v>%s</v></c>`,
+		cellReference(row, column), xlsxStyleDate, strconv.FormatFloat(serial, 'f', -1, 64))
+}
@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const blobBucketName string = "blobs"
+
+// GridFSBlobStore represents a blob store keeping blobs in a MongoDB GridFS bucket.
+type GridFSBlobStore struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// NewGridFSBlobStore returns a GridFSBlobStore.
+func NewGridFSBlobStore(client *database.MongoClient, logger logger.LogInterface) *GridFSBlobStore {
+	return &GridFSBlobStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+// bucket returns a GridFS bucket handle. Bucket deadlines are not safe for concurrent use,
+// hence every operation gets its own handle with the context deadline applied.
+func (s *GridFSBlobStore) bucket(ctx context.Context, read bool) (*gridfs.Bucket, error) {
+	bucket, bucketErr := gridfs.NewBucket(s.client.Database(), options.GridFSBucket().SetName(blobBucketName))
+	if bucketErr != nil {
+		return nil, errors.Wrap(bucketErr, "gridfs bucket")
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		var deadlineErr error
+		if read {
+			deadlineErr = bucket.SetReadDeadline(deadline)
+		} else {
+			deadlineErr = bucket.SetWriteDeadline(deadline)
+		}
+		if deadlineErr != nil {
+			return nil, errors.Wrap(deadlineErr, "gridfs bucket deadline")
+		}
+	}
+
+	return bucket, nil
+}
+
+// Put uploads a blob into GridFS, the key is used as the file ID and name.
+func (s *GridFSBlobStore) Put(ctx context.Context, key string, content io.Reader) error {
+	ctx, span := tracer.NewSpan(ctx, "put blob into gridfs")
+	span.SetAttributes(attribute.String("key", key))
+	defer span.End()
+
+	bucket, bucketErr := s.bucket(ctx, false)
+	if bucketErr != nil {
+		tracer.AddSpanError(span, bucketErr)
+		return bucketErr
+	}
+
+	if uploadErr := bucket.UploadFromStreamWithID(key, key, content); uploadErr != nil {
+		tracer.AddSpanError(span, uploadErr)
+		return errors.Wrap(uploadErr, "gridfs upload")
+	}
+
+	return nil
+}
+
+// Get opens a GridFS download stream of the blob.
+func (s *GridFSBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	ctx, span := tracer.NewSpan(ctx, "get blob from gridfs")
+	span.SetAttributes(attribute.String("key", key))
+	defer span.End()
+
+	bucket, bucketErr := s.bucket(ctx, true)
+	if bucketErr != nil {
+		tracer.AddSpanError(span, bucketErr)
+		return nil, bucketErr
+	}
+
+	stream, streamErr := bucket.OpenDownloadStream(key)
+	if streamErr != nil {
+		if errors.Is(streamErr, gridfs.ErrFileNotFound) {
+			return nil, ErrBlobNotFound
+		}
+		tracer.AddSpanError(span, streamErr)
+		return nil, errors.Wrap(streamErr, "gridfs download")
+	}
+
+	return stream, nil
+}
+
+// Delete removes a blob from GridFS, missing blobs are ignored.
+func (s *GridFSBlobStore) Delete(ctx context.Context, key string) error {
+	ctx, span := tracer.NewSpan(ctx, "delete blob from gridfs")
+	span.SetAttributes(attribute.String("key", key))
+	defer span.End()
+
+	bucket, bucketErr := s.bucket(ctx, false)
+	if bucketErr != nil {
+		tracer.AddSpanError(span, bucketErr)
+		return bucketErr
+	}
+
+	if deleteErr := bucket.Delete(key); deleteErr != nil && !errors.Is(deleteErr, gridfs.ErrFileNotFound) {
+		tracer.AddSpanError(span, deleteErr)
+		return errors.Wrap(deleteErr, "gridfs delete")
+	}
+
+	return nil
+}
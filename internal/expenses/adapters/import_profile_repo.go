@@ -0,0 +1,182 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const importProfilesCollectionName string = "importProfiles"
+
+type importColumnsDbModel struct {
+	Date     string  `bson:"date"`
+	Amount   string  `bson:"amount"`
+	Currency *string `bson:"currency,omitempty"`
+	Quantity *string `bson:"quantity,omitempty"`
+	Comment  *string `bson:"comment,omitempty"`
+	Category string  `bson:"category"`
+}
+
+type importProfileDbModel struct {
+	ID               primitive.ObjectID   `bson:"_id,omitempty"`
+	Name             string               `bson:"name"`
+	Columns          importColumnsDbModel `bson:"columns"`
+	DateFormat       string               `bson:"dateFormat"`
+	DecimalSeparator string               `bson:"decimalSeparator"`
+	Delimiter        string               `bson:"delimiter"`
+	DefaultCurrency  *string              `bson:"defaultCurrency,omitempty"`
+}
+
+// ImportProfileRepository represents a struct to access import profiles MongoDB collection.
+type ImportProfileRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// ImportProfileRepoInterface defines a contract to persist import profiles in the database.
+type ImportProfileRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.ImportProfile, error)
+	GetOne(ctx context.Context, id string) (*domain.ImportProfile, error)
+	Insert(ctx context.Context, profile domain.ImportProfile) (*string, error)
+}
+
+// NewImportProfileRepo returns an ImportProfileRepository.
+func NewImportProfileRepo(client *database.MongoClient, logger logger.LogInterface) *ImportProfileRepository {
+	return &ImportProfileRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *ImportProfileRepository) collection() *mongo.Collection {
+	return r.client.Collection(importProfilesCollectionName)
+}
+
+// GetAll returns all import profiles from the database sorted by name.
+func (r *ImportProfileRepository) GetAll(ctx context.Context) ([]domain.ImportProfile, error) {
+	ctx, span := tracer.NewSpan(ctx, "find import profiles in the database")
+	defer span.End()
+
+	opts := options.Find().SetSort(bson.M{"name": 1})
+	cursor, findErr := r.collection().Find(ctx, bson.M{}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find import profiles")
+	}
+
+	var profileDbModels []importProfileDbModel
+	if allErr := cursor.All(ctx, &profileDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	profiles := make([]domain.ImportProfile, 0, len(profileDbModels))
+	for _, profileDbModel := range profileDbModels {
+		profile, profileErr := r.unmarshalImportProfile(profileDbModel)
+		if profileErr != nil {
+			return nil, profileErr
+		}
+		profiles = append(profiles, *profile)
+	}
+
+	return profiles, nil
+}
+
+// GetOne returns a single import profile from the database.
+func (r *ImportProfileRepository) GetOne(ctx context.Context, id string) (*domain.ImportProfile, error) {
+	ctx, span := tracer.NewSpan(ctx, "find import profile in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	profileDbModel := importProfileDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"_id": objID}).Decode(&profileDbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find import profile")
+	}
+
+	return r.unmarshalImportProfile(profileDbModel)
+}
+
+// Insert inserts a new import profile into the database.
+func (r *ImportProfileRepository) Insert(ctx context.Context, profile domain.ImportProfile) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add import profile to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalImportProfile(profile))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert import profile")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+func (r ImportProfileRepository) marshalImportProfile(profile domain.ImportProfile) importProfileDbModel {
+	id, _ := primitive.ObjectIDFromHex(profile.ID())
+	columns := profile.Columns()
+
+	return importProfileDbModel{
+		ID:   id,
+		Name: profile.Name(),
+		Columns: importColumnsDbModel{
+			Date:     columns.Date,
+			Amount:   columns.Amount,
+			Currency: columns.Currency,
+			Quantity: columns.Quantity,
+			Comment:  columns.Comment,
+			Category: columns.Category,
+		},
+		DateFormat:       profile.DateFormat(),
+		DecimalSeparator: profile.DecimalSeparator(),
+		Delimiter:        string(profile.Delimiter()),
+		DefaultCurrency:  profile.DefaultCurrency(),
+	}
+}
+
+func (r ImportProfileRepository) unmarshalImportProfile(
+	profileModel importProfileDbModel,
+) (*domain.ImportProfile, error) {
+	profile, profileErr := domain.NewImportProfile(domain.ImportProfileParams{
+		ID:   profileModel.ID.Hex(),
+		Name: profileModel.Name,
+		Columns: domain.ImportColumns{
+			Date:     profileModel.Columns.Date,
+			Amount:   profileModel.Columns.Amount,
+			Currency: profileModel.Columns.Currency,
+			Quantity: profileModel.Columns.Quantity,
+			Comment:  profileModel.Columns.Comment,
+			Category: profileModel.Columns.Category,
+		},
+		DateFormat:       profileModel.DateFormat,
+		DecimalSeparator: profileModel.DecimalSeparator,
+		Delimiter:        &profileModel.Delimiter,
+		DefaultCurrency:  profileModel.DefaultCurrency,
+	})
+	if profileErr != nil {
+		return nil, errors.Wrap(profileErr, "unmarshal import profile")
+	}
+	return profile, nil
+}
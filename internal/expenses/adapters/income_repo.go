@@ -0,0 +1,209 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const incomesCollectionName string = "incomes"
+
+type incomeDbModel struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	Source     string             `bson:"source"`
+	CategoryID primitive.ObjectID `bson:"categoryId"`
+	Amount     float64            `bson:"amount"`
+	Currency   string             `bson:"currency"`
+	Date       time.Time          `bson:"date"`
+	Comment    *string            `bson:"comment,omitempty"`
+}
+
+// IncomeRepository represents a struct to access incomes MongoDB collection.
+type IncomeRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// IncomeRepoInterface defines a contract to persist incomes in the database.
+type IncomeRepoInterface interface {
+	GetAll(ctx context.Context, dateRange domain.DateRange) ([]domain.Income, error)
+	GetOne(ctx context.Context, id string) (*domain.Income, error)
+	Insert(ctx context.Context, income domain.Income) (*string, error)
+	Update(ctx context.Context, income domain.Income) (*domain.UpdateResult, error)
+	DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error)
+}
+
+// NewIncomeRepo returns an IncomeRepository.
+func NewIncomeRepo(client *database.MongoClient, logger logger.LogInterface) *IncomeRepository {
+	return &IncomeRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *IncomeRepository) collection() *mongo.Collection {
+	return r.client.Collection(incomesCollectionName)
+}
+
+// GetAll returns incomes of the date range from the database sorted by date.
+func (r *IncomeRepository) GetAll(ctx context.Context, dateRange domain.DateRange) ([]domain.Income, error) {
+	ctx, span := tracer.NewSpan(ctx, "find incomes in the database")
+	defer span.End()
+
+	filter := bson.M{
+		"date": bson.M{
+			"$gte": dateRange.From(),
+			"$lte": dateRange.To(),
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, findErr := r.collection().Find(ctx, filter, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find incomes")
+	}
+
+	var incomeDbModels []incomeDbModel
+	if allErr := cursor.All(ctx, &incomeDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	incomes := make([]domain.Income, 0, len(incomeDbModels))
+	for _, dbModel := range incomeDbModels {
+		income, incomeErr := r.unmarshalIncome(dbModel)
+		if incomeErr != nil {
+			return nil, incomeErr
+		}
+		incomes = append(incomes, *income)
+	}
+
+	return incomes, nil
+}
+
+// GetOne returns a single income from the database.
+func (r *IncomeRepository) GetOne(ctx context.Context, id string) (*domain.Income, error) {
+	ctx, span := tracer.NewSpan(ctx, "find income in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	dbModel := incomeDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"_id": objID}).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find income")
+	}
+
+	return r.unmarshalIncome(dbModel)
+}
+
+// Insert inserts a new income into the database.
+func (r *IncomeRepository) Insert(ctx context.Context, income domain.Income) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add income to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalIncome(income))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert income")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// Update updates an income in the database.
+func (r *IncomeRepository) Update(ctx context.Context, income domain.Income) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update income in the database")
+	span.SetAttributes(attribute.String("id", income.ID()))
+	defer span.End()
+
+	dbModel := r.marshalIncome(income)
+	updater := bson.M{"$set": dbModel}
+	if dbModel.Comment == nil {
+		updater["$unset"] = bson.M{"comment": ""}
+	}
+	updResult, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": dbModel.ID}, updater)
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update income")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// DeleteOne deletes a single income from the database.
+func (r *IncomeRepository) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "delete income from the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	delResult, delErr := r.collection().DeleteOne(ctx, bson.M{"_id": objID})
+	if delErr != nil {
+		tracer.AddSpanError(span, delErr)
+		return nil, errors.Wrap(delErr, "mongodb delete income")
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(delResult.DeletedCount),
+	}
+
+	return result, nil
+}
+
+func (r IncomeRepository) marshalIncome(income domain.Income) incomeDbModel {
+	id, _ := primitive.ObjectIDFromHex(income.ID())
+	categoryID, _ := primitive.ObjectIDFromHex(income.CategoryID())
+
+	return incomeDbModel{
+		ID:         id,
+		Source:     income.Source(),
+		CategoryID: categoryID,
+		Amount:     income.Amount(),
+		Currency:   string(income.Currency()),
+		Date:       income.Date(),
+		Comment:    income.Comment(),
+	}
+}
+
+func (r IncomeRepository) unmarshalIncome(dbModel incomeDbModel) (*domain.Income, error) {
+	income, incomeErr := domain.NewIncome(dbModel.ID.Hex(), domain.IncomeParams{
+		Source:     dbModel.Source,
+		CategoryID: dbModel.CategoryID.Hex(),
+		Amount:     dbModel.Amount,
+		Currency:   dbModel.Currency,
+		Date:       dbModel.Date,
+		Comment:    dbModel.Comment,
+	})
+	if incomeErr != nil {
+		return nil, errors.Wrap(incomeErr, "unmarshal income")
+	}
+	return income, nil
+}
@@ -0,0 +1,132 @@
+package adapters
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const (
+	blobDirPerm  os.FileMode = 0o750
+	blobFilePerm os.FileMode = 0o640
+)
+
+// LocalBlobStore represents a blob store keeping blobs as files in a local directory.
+type LocalBlobStore struct {
+	root   string
+	logger logger.LogInterface
+}
+
+// NewLocalBlobStore returns a LocalBlobStore keeping blobs under the root directory.
+func NewLocalBlobStore(root string, logger logger.LogInterface) *LocalBlobStore {
+	return &LocalBlobStore{
+		root:   root,
+		logger: logger,
+	}
+}
+
+// Put writes a blob into a file. The content is written into a temporary file first,
+// so a failed upload never leaves a partial blob behind.
+func (s *LocalBlobStore) Put(ctx context.Context, key string, content io.Reader) error {
+	_, span := tracer.NewSpan(ctx, "put blob into the local storage")
+	span.SetAttributes(attribute.String("key", key))
+	defer span.End()
+
+	blobPath, pathErr := s.path(key)
+	if pathErr != nil {
+		return pathErr
+	}
+
+	if mkdirErr := os.MkdirAll(filepath.Dir(blobPath), blobDirPerm); mkdirErr != nil {
+		tracer.AddSpanError(span, mkdirErr)
+		return errors.Wrap(mkdirErr, "create blob directory")
+	}
+
+	tmpFile, tmpErr := ioutil.TempFile(filepath.Dir(blobPath), ".upload-*")
+	if tmpErr != nil {
+		tracer.AddSpanError(span, tmpErr)
+		return errors.Wrap(tmpErr, "create blob file")
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, copyErr := io.Copy(tmpFile, content); copyErr != nil {
+		tmpFile.Close()
+		tracer.AddSpanError(span, copyErr)
+		return errors.Wrap(copyErr, "write blob file")
+	}
+	if closeErr := tmpFile.Close(); closeErr != nil {
+		tracer.AddSpanError(span, closeErr)
+		return errors.Wrap(closeErr, "close blob file")
+	}
+	if chmodErr := os.Chmod(tmpFile.Name(), blobFilePerm); chmodErr != nil {
+		tracer.AddSpanError(span, chmodErr)
+		return errors.Wrap(chmodErr, "change blob file mode")
+	}
+	if renameErr := os.Rename(tmpFile.Name(), blobPath); renameErr != nil {
+		tracer.AddSpanError(span, renameErr)
+		return errors.Wrap(renameErr, "move blob file")
+	}
+
+	return nil
+}
+
+// Get opens a blob file for reading.
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	_, span := tracer.NewSpan(ctx, "get blob from the local storage")
+	span.SetAttributes(attribute.String("key", key))
+	defer span.End()
+
+	blobPath, pathErr := s.path(key)
+	if pathErr != nil {
+		return nil, pathErr
+	}
+
+	file, openErr := os.Open(blobPath)
+	if openErr != nil {
+		if os.IsNotExist(openErr) {
+			return nil, ErrBlobNotFound
+		}
+		tracer.AddSpanError(span, openErr)
+		return nil, errors.Wrap(openErr, "open blob file")
+	}
+
+	return file, nil
+}
+
+// Delete removes a blob file, missing blobs are ignored.
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	_, span := tracer.NewSpan(ctx, "delete blob from the local storage")
+	span.SetAttributes(attribute.String("key", key))
+	defer span.End()
+
+	blobPath, pathErr := s.path(key)
+	if pathErr != nil {
+		return pathErr
+	}
+
+	if removeErr := os.Remove(blobPath); removeErr != nil && !os.IsNotExist(removeErr) {
+		tracer.AddSpanError(span, removeErr)
+		return errors.Wrap(removeErr, "remove blob file")
+	}
+
+	return nil
+}
+
+// path returns a blob file path. Keys escaping the root directory are rejected.
+func (s *LocalBlobStore) path(key string) (string, error) {
+	cleanKey := path.Clean("/" + key)
+	if len(key) == 0 || cleanKey == "/" || cleanKey != "/"+strings.TrimPrefix(key, "/") {
+		return "", errors.Errorf("invalid blob key %s", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleanKey)), nil
+}
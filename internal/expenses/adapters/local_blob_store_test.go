@@ -0,0 +1,67 @@
+package adapters_test
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestLocalBlobStore_PutAndGet_ReturnsContent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	store := adapters.NewLocalBlobStore(t.TempDir(), new(mocks.LogInterface))
+
+	// Act
+	putErr := store.Put(ctx, "expenseId/blobId", strings.NewReader("receipt"))
+	reader, getErr := store.Get(ctx, "expenseId/blobId")
+
+	// Assert
+	assert.Nil(t, putErr, "Error should be nil.")
+	assert.Nil(t, getErr, "Error should be nil.")
+	content, _ := ioutil.ReadAll(reader)
+	reader.Close()
+	assert.Equal(t, "receipt", string(content))
+}
+
+func TestLocalBlobStore_Delete_RemovesBlob(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	store := adapters.NewLocalBlobStore(t.TempDir(), new(mocks.LogInterface))
+	_ = store.Put(ctx, "expenseId/blobId", strings.NewReader("receipt"))
+
+	// Act
+	deleteErr := store.Delete(ctx, "expenseId/blobId")
+	missingErr := store.Delete(ctx, "expenseId/blobId")
+	reader, getErr := store.Get(ctx, "expenseId/blobId")
+
+	// Assert
+	assert.Nil(t, deleteErr, "Error should be nil.")
+	assert.Nil(t, missingErr, "Deleting a missing blob should not fail.")
+	assert.Nil(t, reader, "Result should be nil.")
+	assert.ErrorIs(t, getErr, adapters.ErrBlobNotFound)
+}
+
+func TestLocalBlobStore_KeyEscapesRoot_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	store := adapters.NewLocalBlobStore(t.TempDir(), new(mocks.LogInterface))
+
+	// Act
+	putErr := store.Put(ctx, "../outside", strings.NewReader("receipt"))
+	_, getErr := store.Get(ctx, "expenseId/../../outside")
+	deleteErr := store.Delete(ctx, "")
+
+	// Assert
+	assert.NotNil(t, putErr, "Error should not be nil.")
+	assert.NotNil(t, getErr, "Error should not be nil.")
+	assert.NotNil(t, deleteErr, "Error should not be nil.")
+}
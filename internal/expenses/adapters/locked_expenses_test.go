@@ -0,0 +1,55 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestLockedTagsFilter_MatchesLockedExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tags := []string{"business", "work"}
+
+	// Act
+	res := lockedTagsFilter(tags)
+
+	// Assert
+	assert.Equal(t, bson.M{"$in": tags}, res["tags"])
+	assert.Equal(t, bson.M{"$exists": true}, res["reconciliationId"], "Only locked expenses should match.")
+}
+
+func TestLockedMerchantsFilter_MatchesLockedExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	merchantIDs := []primitive.ObjectID{primitive.NewObjectID(), primitive.NewObjectID()}
+
+	// Act
+	res := lockedMerchantsFilter(merchantIDs)
+
+	// Assert
+	assert.Equal(t, bson.M{"$in": merchantIDs}, res["merchantId"])
+	assert.Equal(t, bson.M{"$exists": true}, res["reconciliationId"], "Only locked expenses should match.")
+}
+
+func TestPurgeableCategoryIDs_KeepsCategoriesOfLockedExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	parentID, lockedID, siblingID, otherID := primitive.NewObjectID(), primitive.NewObjectID(),
+		primitive.NewObjectID(), primitive.NewObjectID()
+	categories := []categoryDbModel{
+		{ID: parentID, Path: "|" + parentID.Hex()},
+		{ID: lockedID, Path: "|" + parentID.Hex() + "|" + lockedID.Hex()},
+		{ID: siblingID, Path: "|" + parentID.Hex() + "|" + siblingID.Hex()},
+		{ID: otherID, Path: "|" + otherID.Hex()},
+	}
+
+	// Act
+	res := purgeableCategoryIDs(categories, []interface{}{lockedID})
+
+	// Assert
+	assert.Equal(t, []primitive.ObjectID{siblingID, otherID}, res,
+		"Categories of locked expenses and their ancestors should be kept.")
+}
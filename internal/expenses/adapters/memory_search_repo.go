@@ -0,0 +1,106 @@
+package adapters
+
+import (
+	"context"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+// InMemorySearchRepository represents an in-process tokenizer based search index.
+// It is a fallback for MongoDB text indexes in tests, the relevance of a document is a number of search terms
+// it contains and an expense relevance is a sum of the relevance of its comment, category and trip.
+type InMemorySearchRepository struct {
+	categories     []domain.Category
+	categoryTokens map[string]map[string]bool
+	tripTokens     map[string]map[string]bool
+	expenses       []domain.Expense
+	expenseTokens  []map[string]bool
+}
+
+// NewInMemorySearchRepo returns an InMemorySearchRepository indexing the documents.
+func NewInMemorySearchRepo(
+	categories []domain.Category,
+	trips []domain.Trip,
+	expenses []domain.Expense,
+) *InMemorySearchRepository {
+	categoryTokens := make(map[string]map[string]bool, len(categories))
+	for _, category := range categories {
+		categoryTokens[category.ID()] = tokenSet(category.Name())
+	}
+
+	tripTokens := make(map[string]map[string]bool, len(trips))
+	for _, trip := range trips {
+		tripTokens[trip.ID()] = tokenSet(trip.Name())
+	}
+
+	expenseTokens := make([]map[string]bool, 0, len(expenses))
+	for _, expense := range expenses {
+		var comment string
+		if expense.Comment() != nil {
+			comment = *expense.Comment()
+		}
+		expenseTokens = append(expenseTokens, tokenSet(comment))
+	}
+
+	return &InMemorySearchRepository{
+		categories:     categories,
+		categoryTokens: categoryTokens,
+		tripTokens:     tripTokens,
+		expenses:       expenses,
+		expenseTokens:  expenseTokens,
+	}
+}
+
+// EnsureIndexes does nothing as documents are indexed on creation.
+func (r *InMemorySearchRepository) EnsureIndexes(_ context.Context) error {
+	return nil
+}
+
+// Search returns expenses and categories matching the filter text.
+func (r *InMemorySearchRepository) Search(_ context.Context, filter domain.SearchFilter) (*domain.SearchResult, error) {
+	categoryScores := make(map[string]float64, len(r.categories))
+	categories := make([]domain.CategoryMatch, 0)
+	for _, category := range r.categories {
+		score := termScore(r.categoryTokens[category.ID()], filter.Terms())
+		categoryScores[category.ID()] = score
+		if score > 0 {
+			categories = append(categories, domain.CategoryMatch{Category: category, Score: score})
+		}
+	}
+
+	expenses := make([]domain.ExpenseMatch, 0)
+	for index, expense := range r.expenses {
+		if !filter.MatchesExpense(expense) {
+			continue
+		}
+		score := termScore(r.expenseTokens[index], filter.Terms()) + categoryScores[expense.Category().ID()]
+		if expense.TripID() != nil {
+			score += termScore(r.tripTokens[*expense.TripID()], filter.Terms())
+		}
+		if score > 0 {
+			expenses = append(expenses, domain.ExpenseMatch{Expense: expense, Score: score})
+		}
+	}
+
+	result := domain.NewSearchResult(expenses, categories)
+
+	return &result, nil
+}
+
+func tokenSet(text string) map[string]bool {
+	tokens := make(map[string]bool)
+	for _, token := range domain.Tokenize(text) {
+		tokens[token] = true
+	}
+	return tokens
+}
+
+func termScore(tokens map[string]bool, terms []string) float64 {
+	var score float64
+	for _, term := range terms {
+		if tokens[term] {
+			score++
+		}
+	}
+	return score
+}
@@ -0,0 +1,90 @@
+package adapters_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newSearchRepo() *adapters.InMemorySearchRepository {
+	health, _ := domain.NewCategory("healthId", nil, "Health", nil, 1, "|healthId")
+	dentist, _ := domain.NewCategory("dentistId", nil, "Dentist", nil, 2, "|healthId|dentistId")
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	trip, _ := domain.NewTrip("tripId", domain.TripParams{
+		Name:         "Dentist trip to Berlin",
+		From:         time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2021, time.April, 3, 0, 0, 0, 0, time.UTC),
+		HomeCurrency: "EUR",
+	})
+	tripID := trip.ID()
+	checkup, payment := "Annual checkup", "Dentist payment"
+	date := time.Date(2021, time.April, 2, 0, 0, 0, 0, time.UTC)
+	byCategory, _ := domain.NewExpense("byCategory", *dentist, 80, "EUR", 1, &checkup, nil, date)
+	byComment, _ := domain.NewExpense("byComment", *health, 120, "EUR", 1, &payment, nil, date)
+	byAll, _ := domain.NewExpense("byAll", *dentist, 200, "EUR", 1, &payment, &tripID, date)
+	unrelated, _ := domain.NewExpense("unrelated", *food, 5, "EUR", 1, nil, nil, date)
+
+	return adapters.NewInMemorySearchRepo(
+		[]domain.Category{*health, *dentist, *food},
+		[]domain.Trip{*trip},
+		[]domain.Expense{*byCategory, *byComment, *byAll, *unrelated},
+	)
+}
+
+func TestInMemorySearchRepo_EnsureIndexes_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// SUT
+	sut := newSearchRepo()
+
+	// Act
+	err := sut.EnsureIndexes(context.Background())
+
+	// Assert
+	assert.Nil(t, err, "Error should be nil.")
+}
+
+func TestInMemorySearchRepo_Search_RanksMatchingDocuments(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	filter, _ := domain.NewSearchFilter(domain.SearchFilterParams{Text: "that dentist payment from last spring"})
+
+	// SUT
+	sut := newSearchRepo()
+
+	// Act
+	res, resErr := sut.Search(context.Background(), *filter)
+
+	// Assert
+	assert.Nil(t, resErr, "Error should be nil.")
+	ids := make([]string, 0, len(res.Expenses))
+	for _, match := range res.Expenses {
+		ids = append(ids, match.Expense.ID())
+	}
+	assert.Equal(t, []string{"byAll", "byComment", "byCategory"}, ids, "Should rank expenses by relevance.")
+	assert.Equal(t, float64(4), res.Expenses[0].Score, "Comment, category and trip should add up.")
+	assert.Len(t, res.Categories, 1)
+	assert.Equal(t, "Dentist", res.Categories[0].Category.Name())
+}
+
+func TestInMemorySearchRepo_SearchWithRanges_FiltersExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	maxAmount := 100.0
+	filter, _ := domain.NewSearchFilter(domain.SearchFilterParams{Text: "dentist", MaxAmount: &maxAmount})
+
+	// SUT
+	sut := newSearchRepo()
+
+	// Act
+	res, resErr := sut.Search(context.Background(), *filter)
+
+	// Assert
+	assert.Nil(t, resErr, "Error should be nil.")
+	assert.Len(t, res.Expenses, 1)
+	assert.Equal(t, "byCategory", res.Expenses[0].Expense.ID())
+}
@@ -0,0 +1,356 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const merchantsCollectionName string = "merchants"
+
+type merchantDbModel struct {
+	ID                primitive.ObjectID  `bson:"_id,omitempty"`
+	Name              string              `bson:"name"`
+	Aliases           []string            `bson:"aliases,omitempty"`
+	DefaultCategoryID *primitive.ObjectID `bson:"defaultCategoryId,omitempty"`
+	Location          *string             `bson:"location,omitempty"`
+}
+
+// MerchantRepository represents a struct to access merchants MongoDB collection.
+type MerchantRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// MerchantRepoInterface defines a contract to persist merchants in the database.
+type MerchantRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.Merchant, error)
+	GetOne(ctx context.Context, id string) (*domain.Merchant, error)
+	Insert(ctx context.Context, merchant domain.Merchant) (*string, error)
+	Update(ctx context.Context, merchant domain.Merchant) (*domain.UpdateResult, error)
+	DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error)
+	Merge(ctx context.Context, merchant domain.Merchant, sourceIDs []string) (*domain.UpdateResult, error)
+	GetExpenses(ctx context.Context, id string) ([]domain.Expense, error)
+}
+
+// NewMerchantRepo returns a MerchantRepository.
+func NewMerchantRepo(client *database.MongoClient, logger logger.LogInterface) *MerchantRepository {
+	return &MerchantRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *MerchantRepository) collection() *mongo.Collection {
+	return r.client.Collection(merchantsCollectionName)
+}
+
+// GetAll returns all merchants from the database sorted by name.
+func (r *MerchantRepository) GetAll(ctx context.Context) ([]domain.Merchant, error) {
+	ctx, span := tracer.NewSpan(ctx, "find merchants in the database")
+	defer span.End()
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, findErr := r.collection().Find(ctx, bson.M{}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find merchants")
+	}
+
+	var merchantDbModels []merchantDbModel
+	if allErr := cursor.All(ctx, &merchantDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	merchants := make([]domain.Merchant, 0, len(merchantDbModels))
+	for _, dbModel := range merchantDbModels {
+		merchant, merchantErr := r.unmarshalMerchant(dbModel)
+		if merchantErr != nil {
+			return nil, merchantErr
+		}
+		merchants = append(merchants, *merchant)
+	}
+
+	return merchants, nil
+}
+
+// GetOne returns a single merchant from the database.
+func (r *MerchantRepository) GetOne(ctx context.Context, id string) (*domain.Merchant, error) {
+	ctx, span := tracer.NewSpan(ctx, "find merchant in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	dbModel := merchantDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"_id": objID}).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find merchant")
+	}
+
+	return r.unmarshalMerchant(dbModel)
+}
+
+// Insert inserts a new merchant into the database.
+func (r *MerchantRepository) Insert(ctx context.Context, merchant domain.Merchant) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add merchant to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalMerchant(merchant))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert merchant")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// Update updates a merchant in the database.
+func (r *MerchantRepository) Update(ctx context.Context, merchant domain.Merchant) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update merchant in the database")
+	span.SetAttributes(attribute.String("id", merchant.ID()))
+	defer span.End()
+
+	dbModel := r.marshalMerchant(merchant)
+	updResult, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": dbModel.ID}, r.updater(dbModel))
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update merchant")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// DeleteOne deletes a single merchant from the database. Expenses of the merchant are kept,
+// they are detached from the merchant.
+func (r *MerchantRepository) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "delete merchant from the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	delResult, delErr := r.collection().DeleteOne(ctx, bson.M{"_id": objID})
+	if delErr != nil {
+		tracer.AddSpanError(span, delErr)
+		return nil, errors.Wrap(delErr, "mongodb delete merchant")
+	}
+
+	if delResult.DeletedCount > 0 {
+		_, updErr := r.client.Collection(expenseCollectionName).UpdateMany(ctx,
+			bson.M{"merchantId": objID}, bson.M{"$unset": bson.M{"merchantId": ""}})
+		if updErr != nil {
+			tracer.AddSpanError(span, updErr)
+			return nil, errors.Wrap(updErr, "mongodb detach expenses from merchant")
+		}
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(delResult.DeletedCount),
+	}
+
+	return result, nil
+}
+
+// Merge updates the merged merchant, moves expenses of the source merchants to it and deletes the source merchants.
+// The result holds a number of the moved expenses. Nothing is changed while any expense locked by reconciliation
+// is paid to the source merchants, the locked expense would be left with a deleted merchant otherwise.
+func (r *MerchantRepository) Merge(
+	ctx context.Context,
+	merchant domain.Merchant,
+	sourceIDs []string,
+) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "merge merchants in the database")
+	span.SetAttributes(attribute.String("id", merchant.ID()))
+	defer span.End()
+
+	sourceObjIDs := make([]primitive.ObjectID, 0, len(sourceIDs))
+	for _, sourceID := range sourceIDs {
+		objID, objIDErr := primitive.ObjectIDFromHex(sourceID)
+		if objIDErr != nil {
+			return nil, errors.Wrap(objIDErr, "invalid merchant id")
+		}
+		sourceObjIDs = append(sourceObjIDs, objID)
+	}
+
+	dbModel := r.marshalMerchant(merchant)
+	result := &domain.UpdateResult{}
+	txErr := r.client.WithTransaction(ctx, func(ctx context.Context) error {
+		lockedCount, countErr := r.client.Collection(expenseCollectionName).CountDocuments(ctx,
+			lockedMerchantsFilter(sourceObjIDs))
+		if countErr != nil {
+			return errors.Wrap(countErr, "mongodb count locked expenses")
+		}
+		if lockedCount > 0 {
+			return errors.Wrapf(domain.ErrExpenseLocked, "%d expenses of the merged merchants", lockedCount)
+		}
+
+		if _, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": dbModel.ID}, r.updater(dbModel)); updErr != nil {
+			return errors.Wrap(updErr, "mongodb update merchant")
+		}
+		updResult, updErr := r.client.Collection(expenseCollectionName).UpdateMany(ctx,
+			bson.M{"merchantId": bson.M{"$in": sourceObjIDs}}, bson.M{"$set": bson.M{"merchantId": dbModel.ID}})
+		if updErr != nil {
+			return errors.Wrap(updErr, "mongodb move merchant expenses")
+		}
+		if _, delErr := r.collection().DeleteMany(ctx, bson.M{"_id": bson.M{"$in": sourceObjIDs}}); delErr != nil {
+			return errors.Wrap(delErr, "mongodb delete merged merchants")
+		}
+		result.UpdateCount = int(updResult.ModifiedCount)
+		return nil
+	})
+	if txErr != nil {
+		tracer.AddSpanError(span, txErr)
+		return nil, txErr
+	}
+
+	return result, nil
+}
+
+// GetExpenses returns expenses paid to the merchant excluding the trashed ones.
+func (r *MerchantRepository) GetExpenses(ctx context.Context, id string) ([]domain.Expense, error) {
+	ctx, span := tracer.NewSpan(ctx, "find merchant expenses in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, errors.Wrap(objIDErr, "invalid merchant id")
+	}
+
+	matchStage := bson.M{
+		"$match": bson.M{
+			"merchantId": objID,
+			"deletedAt":  bson.M{"$exists": false},
+		},
+	}
+	sortStage := bson.M{"$sort": bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}}}
+	operations := append([]bson.M{matchStage, sortStage}, categoryLookupStages()...)
+	cursor, cursorErr := r.client.Collection(expenseCollectionName).Aggregate(ctx, operations)
+	if cursorErr != nil {
+		tracer.AddSpanError(span, cursorErr)
+		return nil, errors.Wrap(cursorErr, "mongodb cursor merchant expenses")
+	}
+
+	var expenseDbModels []expenseDbModel
+	if allErr := cursor.All(ctx, &expenseDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	expenses := make([]domain.Expense, 0, len(expenseDbModels))
+	for _, expenseModel := range expenseDbModels {
+		expense, expenseErr := unmarshalExpense(expenseModel)
+		if expenseErr != nil {
+			return nil, expenseErr
+		}
+		expenses = append(expenses, *expense)
+	}
+
+	return expenses, nil
+}
+
+// updater returns an update of the merchant document, missing optional values are removed.
+func (r MerchantRepository) updater(dbModel merchantDbModel) bson.M {
+	updater := bson.M{"$set": dbModel}
+
+	unset := bson.M{}
+	if len(dbModel.Aliases) == 0 {
+		unset["aliases"] = ""
+	}
+	if dbModel.DefaultCategoryID == nil {
+		unset["defaultCategoryId"] = ""
+	}
+	if dbModel.Location == nil {
+		unset["location"] = ""
+	}
+	if len(unset) != 0 {
+		updater["$unset"] = unset
+	}
+
+	return updater
+}
+
+func (r MerchantRepository) marshalMerchant(merchant domain.Merchant) merchantDbModel {
+	id, _ := primitive.ObjectIDFromHex(merchant.ID())
+
+	var defaultCategoryID *primitive.ObjectID
+	if merchant.DefaultCategoryID() != nil {
+		if objID, objIDErr := primitive.ObjectIDFromHex(*merchant.DefaultCategoryID()); objIDErr == nil {
+			defaultCategoryID = &objID
+		}
+	}
+
+	return merchantDbModel{
+		ID:                id,
+		Name:              merchant.Name(),
+		Aliases:           merchant.Aliases(),
+		DefaultCategoryID: defaultCategoryID,
+		Location:          merchant.Location(),
+	}
+}
+
+func (r MerchantRepository) unmarshalMerchant(dbModel merchantDbModel) (*domain.Merchant, error) {
+	var defaultCategoryID *string
+	if dbModel.DefaultCategoryID != nil {
+		hex := dbModel.DefaultCategoryID.Hex()
+		defaultCategoryID = &hex
+	}
+
+	merchant, merchantErr := domain.NewMerchant(dbModel.ID.Hex(), domain.MerchantParams{
+		Name:              dbModel.Name,
+		Aliases:           dbModel.Aliases,
+		DefaultCategoryID: defaultCategoryID,
+		Location:          dbModel.Location,
+	})
+	if merchantErr != nil {
+		return nil, errors.Wrap(merchantErr, "unmarshal merchant")
+	}
+	return merchant, nil
+}
+
+// marshalMerchantID converts an optional merchant id to a merchant reference, invalid ids are dropped.
+func marshalMerchantID(merchantID *string) *primitive.ObjectID {
+	if merchantID == nil {
+		return nil
+	}
+	objID, objIDErr := primitive.ObjectIDFromHex(*merchantID)
+	if objIDErr != nil {
+		return nil
+	}
+	return &objID
+}
+
+// lockedMerchantsFilter returns a filter of expenses paid to any of the merchants, which are locked
+// by reconciliation.
+func lockedMerchantsFilter(merchantIDs []primitive.ObjectID) bson.M {
+	return bson.M{
+		"merchantId":       bson.M{"$in": merchantIDs},
+		"reconciliationId": bson.M{"$exists": true},
+	}
+}
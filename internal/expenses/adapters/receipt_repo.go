@@ -0,0 +1,170 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const receiptsCollectionName string = "receipts"
+
+type receiptDbModel struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	Date     time.Time          `bson:"date"`
+	Comment  *string            `bson:"comment,omitempty"`
+	Currency string             `bson:"currency"`
+}
+
+// ReceiptRepository represents a struct to access receipts MongoDB collection.
+// Receipt line items are kept in the expenses collection referencing the receipt.
+type ReceiptRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// ReceiptRepoInterface defines a contract to persist receipts in the database.
+type ReceiptRepoInterface interface {
+	GetOne(ctx context.Context, id string) (*domain.Receipt, error)
+	Insert(ctx context.Context, receipt domain.Receipt) (*string, error)
+}
+
+// NewReceiptRepo returns a ReceiptRepository.
+func NewReceiptRepo(client *database.MongoClient, logger logger.LogInterface) *ReceiptRepository {
+	return &ReceiptRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *ReceiptRepository) collection() *mongo.Collection {
+	return r.client.Collection(receiptsCollectionName)
+}
+
+// GetOne returns a single receipt with line items that are not in the trash.
+// Receipts with all line items trashed are considered missing.
+func (r *ReceiptRepository) GetOne(ctx context.Context, id string) (*domain.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "find receipt in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	dbModel := receiptDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"_id": objID}).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find receipt")
+	}
+
+	matchStage := bson.M{
+		"$match": bson.M{
+			"receiptId": objID,
+			"deletedAt": bson.M{"$exists": false},
+		},
+	}
+	sortStage := bson.M{"$sort": bson.D{{Key: "_id", Value: 1}}}
+	operations := append([]bson.M{matchStage, sortStage}, categoryLookupStages()...)
+	cursor, cursorErr := r.client.Collection(expenseCollectionName).Aggregate(ctx, operations)
+	if cursorErr != nil {
+		tracer.AddSpanError(span, cursorErr)
+		return nil, errors.Wrap(cursorErr, "mongodb cursor receipt items")
+	}
+
+	var expenseDbModels []expenseDbModel
+	if allErr := cursor.All(ctx, &expenseDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	if len(expenseDbModels) == 0 {
+		return nil, nil
+	}
+
+	items := make([]domain.Expense, 0, len(expenseDbModels))
+	for _, expenseModel := range expenseDbModels {
+		item, itemErr := unmarshalExpense(expenseModel)
+		if itemErr != nil {
+			return nil, itemErr
+		}
+		items = append(items, *item)
+	}
+
+	receipt, receiptErr := domain.NewReceipt(dbModel.ID.Hex(), domain.ReceiptParams{
+		Date:     dbModel.Date,
+		Comment:  dbModel.Comment,
+		Currency: dbModel.Currency,
+	}, items)
+	if receiptErr != nil {
+		return nil, errors.Wrap(receiptErr, "unmarshal receipt")
+	}
+
+	return receipt, nil
+}
+
+// Insert inserts a new receipt together with its line items.
+func (r *ReceiptRepository) Insert(ctx context.Context, receipt domain.Receipt) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add receipt to the database")
+	span.SetAttributes(attribute.Int("items", len(receipt.Items())))
+	defer span.End()
+
+	dbModel := receiptDbModel{
+		ID:       primitive.NewObjectID(),
+		Date:     receipt.Date(),
+		Comment:  receipt.Comment(),
+		Currency: string(receipt.Currency()),
+	}
+
+	itemDbModels := make([]interface{}, 0, len(receipt.Items()))
+	for _, item := range receipt.Items() {
+		itemDbModel := ExpenseRepository{}.marshalExpense(item)
+		itemDbModel.ReceiptID = &dbModel.ID
+		itemDbModels = append(itemDbModels, itemDbModel)
+	}
+
+	txErr := r.client.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, insErr := r.collection().InsertOne(ctx, dbModel); insErr != nil {
+			return errors.Wrap(insErr, "mongodb insert receipt")
+		}
+		if _, insErr := r.client.Collection(expenseCollectionName).InsertMany(ctx, itemDbModels); insErr != nil {
+			return errors.Wrap(insErr, "mongodb insert receipt items")
+		}
+		return nil
+	})
+	if txErr != nil {
+		tracer.AddSpanError(span, txErr)
+		return nil, txErr
+	}
+
+	id := dbModel.ID.Hex()
+
+	return &id, nil
+}
+
+// marshalReceiptID converts an optional receipt id to a receipt reference.
+func marshalReceiptID(receiptID *string) *primitive.ObjectID {
+	if receiptID == nil {
+		return nil
+	}
+	objID, objIDErr := primitive.ObjectIDFromHex(*receiptID)
+	if objIDErr != nil {
+		return nil
+	}
+	return &objID
+}
@@ -0,0 +1,308 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const reconciliationsCollectionName string = "reconciliations"
+
+type reconciliationDbModel struct {
+	ID               primitive.ObjectID   `bson:"_id,omitempty"`
+	AccountID        primitive.ObjectID   `bson:"accountId"`
+	StatementDate    time.Time            `bson:"statementDate"`
+	StatementBalance float64              `bson:"statementBalance"`
+	ExpenseIDs       []primitive.ObjectID `bson:"expenseIds"`
+	CreatedAt        time.Time            `bson:"createdAt"`
+	FinishedAt       *time.Time           `bson:"finishedAt,omitempty"`
+}
+
+// ReconciliationRepository represents a struct to access reconciliations MongoDB collection.
+type ReconciliationRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// ReconciliationRepoInterface defines a contract to persist reconciliations in the database.
+type ReconciliationRepoInterface interface {
+	GetByAccount(ctx context.Context, accountID string) ([]domain.Reconciliation, error)
+	GetOne(ctx context.Context, id string) (*domain.Reconciliation, error)
+	GetOpen(ctx context.Context, accountID string) (*domain.Reconciliation, error)
+	Insert(ctx context.Context, reconciliation domain.Reconciliation) (*string, error)
+	UpdateExpenses(ctx context.Context, reconciliation domain.Reconciliation) (*domain.UpdateResult, error)
+	Finish(ctx context.Context, reconciliation domain.Reconciliation) (*domain.UpdateResult, error)
+	UnlockExpense(ctx context.Context, expenseID string) (*domain.UpdateResult, error)
+}
+
+// NewReconciliationRepo returns a ReconciliationRepository.
+func NewReconciliationRepo(client *database.MongoClient, logger logger.LogInterface) *ReconciliationRepository {
+	return &ReconciliationRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *ReconciliationRepository) collection() *mongo.Collection {
+	return r.client.Collection(reconciliationsCollectionName)
+}
+
+// GetByAccount returns reconciliation history of the account, the latest statement goes first.
+func (r *ReconciliationRepository) GetByAccount(
+	ctx context.Context,
+	accountID string,
+) ([]domain.Reconciliation, error) {
+	ctx, span := tracer.NewSpan(ctx, "find account reconciliations in the database")
+	span.SetAttributes(attribute.String("accountId", accountID))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(accountID)
+	if objIDErr != nil {
+		return nil, errors.Wrap(objIDErr, "invalid account id")
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "statementDate", Value: -1}, {Key: "_id", Value: -1}})
+	cursor, findErr := r.collection().Find(ctx, bson.M{"accountId": objID}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find reconciliations")
+	}
+
+	var reconciliationDbModels []reconciliationDbModel
+	if allErr := cursor.All(ctx, &reconciliationDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	reconciliations := make([]domain.Reconciliation, 0, len(reconciliationDbModels))
+	for _, dbModel := range reconciliationDbModels {
+		reconciliation, reconciliationErr := r.unmarshalReconciliation(dbModel)
+		if reconciliationErr != nil {
+			return nil, reconciliationErr
+		}
+		reconciliations = append(reconciliations, *reconciliation)
+	}
+
+	return reconciliations, nil
+}
+
+// GetOne returns a single reconciliation from the database.
+func (r *ReconciliationRepository) GetOne(ctx context.Context, id string) (*domain.Reconciliation, error) {
+	ctx, span := tracer.NewSpan(ctx, "find reconciliation in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	return r.findOne(ctx, bson.M{"_id": objID})
+}
+
+// GetOpen returns the open reconciliation of the account, there is at most one.
+func (r *ReconciliationRepository) GetOpen(ctx context.Context, accountID string) (*domain.Reconciliation, error) {
+	ctx, span := tracer.NewSpan(ctx, "find open reconciliation in the database")
+	span.SetAttributes(attribute.String("accountId", accountID))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(accountID)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	return r.findOne(ctx, bson.M{"accountId": objID, "finishedAt": bson.M{"$exists": false}})
+}
+
+func (r *ReconciliationRepository) findOne(ctx context.Context, filter bson.M) (*domain.Reconciliation, error) {
+	span := tracer.SpanFromContext(ctx)
+
+	dbModel := reconciliationDbModel{}
+	findErr := r.collection().FindOne(ctx, filter).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find reconciliation")
+	}
+
+	return r.unmarshalReconciliation(dbModel)
+}
+
+// Insert inserts a new reconciliation into the database.
+func (r *ReconciliationRepository) Insert(
+	ctx context.Context,
+	reconciliation domain.Reconciliation,
+) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add reconciliation to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalReconciliation(reconciliation))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert reconciliation")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// UpdateExpenses updates ticked off expenses of an open reconciliation in the database.
+func (r *ReconciliationRepository) UpdateExpenses(
+	ctx context.Context,
+	reconciliation domain.Reconciliation,
+) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update reconciliation expenses in the database")
+	span.SetAttributes(attribute.String("id", reconciliation.ID()))
+	defer span.End()
+
+	dbModel := r.marshalReconciliation(reconciliation)
+	filter := bson.M{"_id": dbModel.ID, "finishedAt": bson.M{"$exists": false}}
+	updResult, updErr := r.collection().UpdateOne(ctx, filter,
+		bson.M{"$set": bson.M{"expenseIds": dbModel.ExpenseIDs}})
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update reconciliation expenses")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// Finish finishes a reconciliation and locks its ticked off expenses within a transaction.
+func (r *ReconciliationRepository) Finish(
+	ctx context.Context,
+	reconciliation domain.Reconciliation,
+) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "finish reconciliation in the database")
+	span.SetAttributes(attribute.String("id", reconciliation.ID()))
+	defer span.End()
+
+	dbModel := r.marshalReconciliation(reconciliation)
+	if dbModel.FinishedAt == nil {
+		return nil, errors.New("reconciliation is not finished")
+	}
+
+	result := &domain.UpdateResult{}
+	txErr := r.client.WithTransaction(ctx, func(ctx context.Context) error {
+		filter := bson.M{"_id": dbModel.ID, "finishedAt": bson.M{"$exists": false}}
+		updater := bson.M{"$set": bson.M{"finishedAt": dbModel.FinishedAt, "expenseIds": dbModel.ExpenseIDs}}
+		updResult, updErr := r.collection().UpdateOne(ctx, filter, updater)
+		if updErr != nil {
+			return errors.Wrap(updErr, "mongodb finish reconciliation")
+		}
+		if updResult.ModifiedCount == 0 {
+			return nil
+		}
+		_, lockErr := r.client.Collection(expenseCollectionName).UpdateMany(ctx,
+			bson.M{"_id": bson.M{"$in": dbModel.ExpenseIDs}}, bson.M{"$set": bson.M{"reconciliationId": dbModel.ID}})
+		if lockErr != nil {
+			return errors.Wrap(lockErr, "mongodb lock reconciled expenses")
+		}
+		result.UpdateCount = int(updResult.ModifiedCount)
+		return nil
+	})
+	if txErr != nil {
+		tracer.AddSpanError(span, txErr)
+		return nil, txErr
+	}
+
+	return result, nil
+}
+
+// UnlockExpense unlocks a reconciled expense, so it could be edited again. The reconciliation history is kept.
+func (r *ReconciliationRepository) UnlockExpense(ctx context.Context, expenseID string) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "unlock expense in the database")
+	span.SetAttributes(attribute.String("id", expenseID))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(expenseID)
+	filter := bson.M{"_id": objID, "deletedAt": bson.M{"$exists": false}}
+	updResult, updErr := r.client.Collection(expenseCollectionName).UpdateOne(ctx, filter,
+		bson.M{"$unset": bson.M{"reconciliationId": ""}})
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb unlock expense")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+func (r ReconciliationRepository) marshalReconciliation(reconciliation domain.Reconciliation) reconciliationDbModel {
+	id, _ := primitive.ObjectIDFromHex(reconciliation.ID())
+	accountID, _ := primitive.ObjectIDFromHex(reconciliation.AccountID())
+	expenseIDs := make([]primitive.ObjectID, 0, len(reconciliation.ExpenseIDs()))
+	for _, expenseID := range reconciliation.ExpenseIDs() {
+		objID, objIDErr := primitive.ObjectIDFromHex(expenseID)
+		if objIDErr != nil {
+			continue
+		}
+		expenseIDs = append(expenseIDs, objID)
+	}
+
+	return reconciliationDbModel{
+		ID:               id,
+		AccountID:        accountID,
+		StatementDate:    reconciliation.StatementDate(),
+		StatementBalance: reconciliation.StatementBalance(),
+		ExpenseIDs:       expenseIDs,
+		CreatedAt:        reconciliation.CreatedAt(),
+		FinishedAt:       reconciliation.FinishedAt(),
+	}
+}
+
+func (r ReconciliationRepository) unmarshalReconciliation(
+	dbModel reconciliationDbModel,
+) (*domain.Reconciliation, error) {
+	expenseIDs := make([]string, 0, len(dbModel.ExpenseIDs))
+	for _, expenseID := range dbModel.ExpenseIDs {
+		expenseIDs = append(expenseIDs, expenseID.Hex())
+	}
+
+	reconciliation, reconciliationErr := domain.NewReconciliation(dbModel.ID.Hex(), domain.ReconciliationParams{
+		AccountID:        dbModel.AccountID.Hex(),
+		StatementDate:    dbModel.StatementDate,
+		StatementBalance: dbModel.StatementBalance,
+		ExpenseIDs:       expenseIDs,
+		CreatedAt:        dbModel.CreatedAt,
+		FinishedAt:       dbModel.FinishedAt,
+	})
+	if reconciliationErr != nil {
+		return nil, errors.Wrap(reconciliationErr, "unmarshal reconciliation")
+	}
+	return reconciliation, nil
+}
+
+// marshalReconciliationID converts an optional reconciliation id to a reference, invalid ids are dropped.
+func marshalReconciliationID(reconciliationID *string) *primitive.ObjectID {
+	if reconciliationID == nil {
+		return nil
+	}
+	objID, objIDErr := primitive.ObjectIDFromHex(*reconciliationID)
+	if objIDErr != nil {
+		return nil
+	}
+	return &objID
+}
@@ -0,0 +1,337 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const recurringExpensesCollectionName string = "recurringExpenses"
+
+type scheduleDbModel struct {
+	Frequency  string     `bson:"frequency"`
+	Interval   int        `bson:"interval"`
+	DayOfMonth *int       `bson:"dayOfMonth,omitempty"`
+	Start      time.Time  `bson:"start"`
+	Until      *time.Time `bson:"until,omitempty"`
+	Count      *int       `bson:"count,omitempty"`
+}
+
+type occurrenceExceptionDbModel struct {
+	Date     time.Time `bson:"date"`
+	Skipped  bool      `bson:"skipped"`
+	Price    *float64  `bson:"price,omitempty"`
+	Quantity *float64  `bson:"quantity,omitempty"`
+	Comment  *string   `bson:"comment,omitempty"`
+}
+
+type recurringExpenseDbModel struct {
+	ID                primitive.ObjectID           `bson:"_id,omitempty"`
+	CategoryID        primitive.ObjectID           `bson:"categoryId"`
+	Category          *categoryDbModel             `bson:"category,omitempty"`
+	Price             float64                      `bson:"price"`
+	Currency          string                       `bson:"currency"`
+	Quantity          float64                      `bson:"quantity"`
+	Comment           *string                      `bson:"comment,omitempty"`
+	TripID            *primitive.ObjectID          `bson:"tripId,omitempty"`
+	Schedule          scheduleDbModel              `bson:"schedule"`
+	Exceptions        []occurrenceExceptionDbModel `bson:"exceptions"`
+	MaterializedUntil *time.Time                   `bson:"materializedUntil,omitempty"`
+}
+
+// RecurringExpenseRepository represents a struct to access recurring expenses MongoDB collection.
+type RecurringExpenseRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// RecurringExpenseRepoInterface defines a contract to persist recurring expenses in the database.
+type RecurringExpenseRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.RecurringExpense, error)
+	GetOne(ctx context.Context, id string) (*domain.RecurringExpense, error)
+	Insert(ctx context.Context, recurringExpense domain.RecurringExpense) (*string, error)
+	Update(ctx context.Context, recurringExpense domain.RecurringExpense) (*domain.UpdateResult, error)
+	UpdateMaterializedUntil(ctx context.Context, id string, materializedUntil time.Time) error
+	DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error)
+}
+
+// NewRecurringExpenseRepo returns a RecurringExpenseRepository.
+func NewRecurringExpenseRepo(client *database.MongoClient, logger logger.LogInterface) *RecurringExpenseRepository {
+	return &RecurringExpenseRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *RecurringExpenseRepository) collection() *mongo.Collection {
+	return r.client.Collection(recurringExpensesCollectionName)
+}
+
+// GetAll returns all recurring expenses from the database sorted by start date.
+func (r *RecurringExpenseRepository) GetAll(ctx context.Context) ([]domain.RecurringExpense, error) {
+	ctx, span := tracer.NewSpan(ctx, "find recurring expenses in the database")
+	defer span.End()
+
+	operations := []bson.M{
+		{"$sort": bson.D{{Key: "schedule.start", Value: 1}, {Key: "_id", Value: 1}}},
+	}
+
+	recurringExpenses, recurringErr := r.aggregate(ctx, operations)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, recurringErr
+	}
+
+	return recurringExpenses, nil
+}
+
+// GetOne returns a single recurring expense from the database.
+func (r *RecurringExpenseRepository) GetOne(ctx context.Context, id string) (*domain.RecurringExpense, error) {
+	ctx, span := tracer.NewSpan(ctx, "find recurring expense in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	recurringExpenses, recurringErr := r.aggregate(ctx, []bson.M{{"$match": bson.M{"_id": objID}}})
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, recurringErr
+	}
+	if len(recurringExpenses) == 0 {
+		return nil, nil
+	}
+
+	return &recurringExpenses[0], nil
+}
+
+// Insert inserts a new recurring expense into the database.
+func (r *RecurringExpenseRepository) Insert(
+	ctx context.Context,
+	recurringExpense domain.RecurringExpense,
+) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add recurring expense to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalRecurringExpense(recurringExpense))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert recurring expense")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// Update updates a recurring expense in the database. Materialization progress is kept as is,
+// it is changed by the scheduler only.
+func (r *RecurringExpenseRepository) Update(
+	ctx context.Context,
+	recurringExpense domain.RecurringExpense,
+) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update recurring expense in the database")
+	span.SetAttributes(attribute.String("id", recurringExpense.ID()))
+	defer span.End()
+
+	dbModel := r.marshalRecurringExpense(recurringExpense)
+	dbModel.MaterializedUntil = nil
+
+	updater := bson.M{"$set": dbModel}
+	unset := bson.M{}
+	if dbModel.Comment == nil {
+		unset["comment"] = ""
+	}
+	if dbModel.TripID == nil {
+		unset["tripId"] = ""
+	}
+	if len(unset) != 0 {
+		updater["$unset"] = unset
+	}
+
+	updResult, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": dbModel.ID}, updater)
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update recurring expense")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// UpdateMaterializedUntil records the last day occurrences were materialized for.
+func (r *RecurringExpenseRepository) UpdateMaterializedUntil(
+	ctx context.Context,
+	id string,
+	materializedUntil time.Time,
+) error {
+	ctx, span := tracer.NewSpan(ctx, "update recurring expense materialization in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	updater := bson.M{"$set": bson.M{"materializedUntil": materializedUntil}}
+
+	_, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": objID}, updater)
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return errors.Wrap(updErr, "mongodb update recurring expense materialization")
+	}
+
+	return nil
+}
+
+// DeleteOne deletes a recurring expense from the database, materialized expenses are kept.
+func (r *RecurringExpenseRepository) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "delete recurring expense from the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	delResult, delErr := r.collection().DeleteOne(ctx, bson.M{"_id": objID})
+	if delErr != nil {
+		tracer.AddSpanError(span, delErr)
+		return nil, errors.Wrap(delErr, "mongodb delete recurring expense")
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(delResult.DeletedCount),
+	}
+
+	return result, nil
+}
+
+// aggregate runs the operations followed by category lookup and unmarshalls the results.
+func (r *RecurringExpenseRepository) aggregate(
+	ctx context.Context,
+	operations []bson.M,
+) ([]domain.RecurringExpense, error) {
+	operations = append(operations, categoryLookupStages()...)
+	cursor, cursorErr := r.collection().Aggregate(ctx, operations)
+	if cursorErr != nil {
+		return nil, errors.Wrap(cursorErr, "mongodb cursor recurring expense")
+	}
+
+	var dbModels []recurringExpenseDbModel
+	if allErr := cursor.All(ctx, &dbModels); allErr != nil {
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	recurringExpenses := make([]domain.RecurringExpense, 0, len(dbModels))
+	for _, dbModel := range dbModels {
+		recurringExpense, recurringErr := r.unmarshalRecurringExpense(dbModel)
+		if recurringErr != nil {
+			return nil, recurringErr
+		}
+		recurringExpenses = append(recurringExpenses, *recurringExpense)
+	}
+
+	return recurringExpenses, nil
+}
+
+func (r RecurringExpenseRepository) marshalRecurringExpense(
+	recurringExpense domain.RecurringExpense,
+) recurringExpenseDbModel {
+	id, _ := primitive.ObjectIDFromHex(recurringExpense.ID())
+	categoryID, _ := primitive.ObjectIDFromHex(recurringExpense.Category().ID())
+	schedule := recurringExpense.Schedule()
+
+	exceptions := make([]occurrenceExceptionDbModel, 0, len(recurringExpense.Exceptions()))
+	for _, exception := range recurringExpense.Exceptions() {
+		exceptions = append(exceptions, occurrenceExceptionDbModel{
+			Date:     exception.Date(),
+			Skipped:  exception.Skipped(),
+			Price:    exception.Price(),
+			Quantity: exception.Quantity(),
+			Comment:  exception.Comment(),
+		})
+	}
+
+	return recurringExpenseDbModel{
+		ID:         id,
+		CategoryID: categoryID,
+		Price:      recurringExpense.Price(),
+		Currency:   recurringExpense.Currency(),
+		Quantity:   recurringExpense.Quantity(),
+		Comment:    recurringExpense.Comment(),
+		TripID:     marshalTripID(recurringExpense.TripID()),
+		Schedule: scheduleDbModel{
+			Frequency:  string(schedule.Frequency()),
+			Interval:   schedule.Interval(),
+			DayOfMonth: schedule.DayOfMonth(),
+			Start:      schedule.Start(),
+			Until:      schedule.Until(),
+			Count:      schedule.Count(),
+		},
+		Exceptions:        exceptions,
+		MaterializedUntil: recurringExpense.MaterializedUntil(),
+	}
+}
+
+func (r RecurringExpenseRepository) unmarshalRecurringExpense(
+	dbModel recurringExpenseDbModel,
+) (*domain.RecurringExpense, error) {
+	if dbModel.Category == nil {
+		return nil, errors.Errorf("recurring expense %s has no category", dbModel.ID.Hex())
+	}
+	category, categoryErr := unmarshalExpenseCategory(*dbModel.Category)
+	if categoryErr != nil {
+		return nil, errors.Wrap(categoryErr, "unmarshal category")
+	}
+
+	interval := dbModel.Schedule.Interval
+	schedule, scheduleErr := domain.NewSchedule(domain.ScheduleParams{
+		Frequency:  dbModel.Schedule.Frequency,
+		Interval:   &interval,
+		DayOfMonth: dbModel.Schedule.DayOfMonth,
+		Start:      dbModel.Schedule.Start,
+		Until:      dbModel.Schedule.Until,
+		Count:      dbModel.Schedule.Count,
+	})
+	if scheduleErr != nil {
+		return nil, errors.Wrap(scheduleErr, "unmarshal schedule")
+	}
+
+	exceptions := make([]domain.OccurrenceException, 0, len(dbModel.Exceptions))
+	for _, exceptionModel := range dbModel.Exceptions {
+		exception, exceptionErr := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+			Date:     exceptionModel.Date,
+			Skipped:  exceptionModel.Skipped,
+			Price:    exceptionModel.Price,
+			Quantity: exceptionModel.Quantity,
+			Comment:  exceptionModel.Comment,
+		})
+		if exceptionErr != nil {
+			return nil, errors.Wrap(exceptionErr, "unmarshal occurrence exception")
+		}
+		exceptions = append(exceptions, *exception)
+	}
+
+	recurringExpense, recurringErr := domain.NewRecurringExpense(dbModel.ID.Hex(), *category,
+		dbModel.Price, dbModel.Currency, dbModel.Quantity, dbModel.Comment,
+		unmarshalTripID(dbModel.TripID), *schedule,
+		domain.SetOccurrenceExceptions(exceptions), domain.SetMaterializedUntil(dbModel.MaterializedUntil))
+	if recurringErr != nil {
+		return nil, errors.Wrap(recurringErr, "unmarshal recurring expense")
+	}
+
+	return recurringExpense, nil
+}
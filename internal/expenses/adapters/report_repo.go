@@ -2,9 +2,11 @@ package adapters
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -25,6 +27,8 @@ type ReportRepository struct {
 // ReportRepoInterface defines a contract to persist expenses in the database.
 type ReportRepoInterface interface {
 	GetAll(ctx context.Context, filter domain.ExpenseFilter) ([]domain.Expense, error)
+	GetByTrip(ctx context.Context, tripID string) ([]domain.Expense, error)
+	GetShared(ctx context.Context) ([]domain.Expense, error)
 }
 
 // NewReportRepo returns a report repository.
@@ -38,7 +42,7 @@ func NewReportRepo(client *database.MongoClient, logger logger.LogInterface) *Re
 
 // collection returns collection handle.
 func (r *ReportRepository) collection() *mongo.Collection {
-	return r.client.Collection("expenses")
+	return r.client.Collection(expenseCollectionName)
 }
 
 // GetAll returns all expenses from the database that matches the filter.
@@ -47,69 +51,136 @@ func (r *ReportRepository) GetAll(ctx context.Context, filter domain.ExpenseFilt
 	// span.SetAttributes(attribute.Any("filter", filter))
 	defer span.End()
 
-	// Filter expense documents.
-	matchStage := bson.M{
-		"$match": bson.M{
-			"date": bson.M{
-				"$gte": filter.From(),
-				"$lte": filter.To(),
-			},
+	// Filter expense documents, trashed ones are not reported.
+	match := bson.M{
+		"date": bson.M{
+			"$gte": filter.From(),
+			"$lte": filter.To(),
 		},
+		"deletedAt": bson.M{"$exists": false},
 	}
 
-	// Join with categories collection to get expense category.
-	categoryLookupStage := bson.M{
-		"$lookup": bson.M{
-			"from":         "categories",
-			"localField":   "categoryId",
-			"foreignField": "_id",
-			"as":           "category",
-		},
+	tags := bson.M{}
+	if len(filter.Tags()) != 0 {
+		tags["$in"] = filter.Tags()
+	}
+	if len(filter.ExcludedTags()) != 0 {
+		tags["$nin"] = filter.ExcludedTags()
+	}
+	if len(tags) != 0 {
+		match["tags"] = tags
+	}
+
+	if len(filter.Currencies()) != 0 {
+		match["currency"] = bson.M{"$in": filter.Currencies()}
 	}
 
-	// After the lookup take the very first match.
-	addCategoryFieldStage := bson.M{
-		"$addFields": bson.M{
-			"category": bson.M{
-				"$arrayElemAt": []interface{}{"$category", 0},
+	if filter.TripID() != nil {
+		tripID, tripIDErr := primitive.ObjectIDFromHex(*filter.TripID())
+		if tripIDErr != nil {
+			return nil, errors.Wrapf(domain.ErrInvalidReportFilter, "trip id %s: %s", *filter.TripID(), tripIDErr)
+		}
+		match["tripId"] = tripID
+	}
+
+	if filter.Comment() != nil {
+		match["comment"] = bson.M{
+			"$regex": primitive.Regex{
+				Pattern: regexp.QuoteMeta(*filter.Comment()),
+				Options: "i",
 			},
-		},
+		}
+	}
+
+	// Expense total is not stored, compare price multiplied by quantity.
+	amount := bson.M{"$multiply": []interface{}{"$price", "$quantity"}}
+	amountConditions := []bson.M{}
+	if filter.MinAmount() != nil {
+		amountConditions = append(amountConditions, bson.M{"$gte": []interface{}{amount, *filter.MinAmount()}})
+	}
+	if filter.MaxAmount() != nil {
+		amountConditions = append(amountConditions, bson.M{"$lte": []interface{}{amount, *filter.MaxAmount()}})
+	}
+	if len(amountConditions) != 0 {
+		match["$expr"] = bson.M{"$and": amountConditions}
+	}
+
+	// Category subtrees are resolved to category IDs to filter expenses before the category is joined.
+	var categoryIDs []primitive.ObjectID
+	if len(filter.Categories()) != 0 {
+		subtreeIDs, subtreeIDsErr := subtreeCategoryIDs(ctx, r.client, filter.Categories())
+		if subtreeIDsErr != nil {
+			return nil, subtreeIDsErr
+		}
+		categoryIDs = subtreeIDs
+	}
+	var excludedIDs []primitive.ObjectID
+	if len(filter.ExcludedCategories()) != 0 {
+		subtreeIDs, subtreeIDsErr := subtreeCategoryIDs(ctx, r.client, filter.ExcludedCategories())
+		if subtreeIDsErr != nil {
+			return nil, subtreeIDsErr
+		}
+		excludedIDs = subtreeIDs
+	}
+	if categoryQuery := categoryIDQuery(categoryIDs, excludedIDs); len(categoryQuery) != 0 {
+		match["categoryId"] = categoryQuery
 	}
 
-	// Join with categories collection to get all ascendants.
-	// There will be expense category as well, that needs to be filtered out.
-	ascendantsLookupStage := bson.M{
-		"$graphLookup": bson.M{
-			"from":             "categories",
-			"startWith":        "$categoryId",
-			"connectFromField": "parentId",
-			"connectToField":   "_id",
-			"as":               "parentCategories",
+	return r.aggregate(ctx, bson.M{"$match": match})
+}
+
+// GetByTrip returns all expenses of the trip from the database.
+func (r *ReportRepository) GetByTrip(ctx context.Context, tripID string) ([]domain.Expense, error) {
+	ctx, span := r.tracer.Start(ctx, "find trip expenses in the database")
+	span.SetAttributes(attribute.String("tripId", tripID))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(tripID)
+	if objIDErr != nil {
+		return []domain.Expense{}, nil
+	}
+
+	// Filter expense documents of the trip, trashed ones are not reported.
+	matchStage := bson.M{
+		"$match": bson.M{
+			"tripId":    objID,
+			"deletedAt": bson.M{"$exists": false},
 		},
 	}
 
-	// Add parents to the category document, but exclude expense category.
-	addAscendantsFieldStage := bson.M{
-		"$addFields": bson.M{
-			"category.parents": bson.M{
-				"$filter": bson.M{
-					"input": "$parentCategories",
-					"cond": bson.M{
-						"$ne": []interface{}{"$$this._id", "$categoryId"},
-					},
-				},
-			},
+	return r.aggregate(ctx, matchStage)
+}
+
+// GetShared returns all expenses split between household members from the database.
+func (r *ReportRepository) GetShared(ctx context.Context) ([]domain.Expense, error) {
+	ctx, span := r.tracer.Start(ctx, "find shared expenses in the database")
+	defer span.End()
+
+	// Filter shared expense documents, trashed ones are not shared anymore.
+	matchStage := bson.M{
+		"$match": bson.M{
+			"split":     bson.M{"$exists": true},
+			"deletedAt": bson.M{"$exists": false},
 		},
 	}
 
-	operations := []bson.M{
-		matchStage,
-		categoryLookupStage,
-		addCategoryFieldStage,
-		ascendantsLookupStage,
-		addAscendantsFieldStage,
+	return r.aggregate(ctx, matchStage)
+}
+
+// aggregate returns expenses matching the stage along with their categories.
+func (r *ReportRepository) aggregate(ctx context.Context, matchStage bson.M) ([]domain.Expense, error) {
+	span := trace.SpanFromContext(ctx)
+
+	// Expenses of trashed categories are not reported either.
+	categoryMatchStage := bson.M{
+		"$match": bson.M{
+			"category.deletedAt": bson.M{"$exists": false},
+		},
 	}
 
+	operations := append([]bson.M{matchStage}, categoryLookupStages()...)
+	operations = append(operations, categoryMatchStage)
+
 	// span.AddEvent("start query", trace.WithAttributes(attribute.Any("filter", operations)))
 
 	cursor, cursorErr := r.collection().Aggregate(ctx, operations)
@@ -128,7 +199,7 @@ func (r *ReportRepository) GetAll(ctx context.Context, filter domain.ExpenseFilt
 
 	expenses := []domain.Expense{}
 	for _, expenseDbModel := range expenseDbModels {
-		exp, expErr := r.unmarshalExpense(expenseDbModel)
+		exp, expErr := unmarshalExpense(expenseDbModel)
 		if expErr != nil {
 			return nil, expErr
 		}
@@ -138,48 +209,3 @@ func (r *ReportRepository) GetAll(ctx context.Context, filter domain.ExpenseFilt
 
 	return expenses, nil
 }
-
-func (r ReportRepository) unmarshalExpense(expenseModel expenseDbModel) (*domain.Expense, error) {
-	cat, catErr := r.unmarshalCategory(*expenseModel.Category)
-	if catErr != nil {
-		return nil, errors.Wrap(catErr, "unmarshal category")
-	}
-
-	exp, expErr := domain.NewExpense(expenseModel.ID.Hex(), *cat,
-		expenseModel.Price, expenseModel.Currency, expenseModel.Quantity,
-		expenseModel.Comment, expenseModel.Trip, expenseModel.Date)
-	if expErr != nil {
-		return nil, errors.Wrap(expErr, "unmarshal expense")
-	}
-
-	return exp, nil
-}
-
-func (r ReportRepository) unmarshalCategory(categoryModel categoryDbModel) (*domain.Category, error) {
-	var parentID string
-	if categoryModel.ParentID != nil && !categoryModel.ParentID.IsZero() {
-		parentID = categoryModel.ParentID.Hex()
-	}
-	cat, catErr := domain.NewCategory(categoryModel.ID.Hex(), &parentID,
-		categoryModel.Name, categoryModel.Icon, categoryModel.Level, categoryModel.Path)
-	if catErr != nil {
-		return nil, errors.Wrap(catErr, "unmarshal category")
-	}
-
-	parentCategories := make([]domain.Category, 0)
-	for _, parentCat := range categoryModel.Parents {
-		var parentID string
-		if parentCat.ParentID != nil {
-			parentID = parentCat.ParentID.Hex()
-		}
-		parent, parentErr := domain.NewCategory(parentCat.ID.Hex(), &parentID, parentCat.Name,
-			parentCat.Icon, parentCat.Level, parentCat.Path)
-		if parentErr != nil {
-			return nil, errors.Wrap(parentErr, "unmarshal parent category")
-		}
-		parentCategories = append(parentCategories, *parent)
-	}
-	cat.SetParents(&parentCategories)
-
-	return cat, nil
-}
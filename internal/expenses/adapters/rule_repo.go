@@ -0,0 +1,257 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const rulesCollectionName string = "rules"
+
+type ruleDbModel struct {
+	ID         primitive.ObjectID    `bson:"_id,omitempty"`
+	Name       string                `bson:"name"`
+	Priority   int                   `bson:"priority"`
+	Conditions ruleConditionsDbModel `bson:"conditions"`
+	Actions    ruleActionsDbModel    `bson:"actions"`
+}
+
+type ruleConditionsDbModel struct {
+	Comment   *string  `bson:"comment,omitempty"`
+	Merchant  *string  `bson:"merchant,omitempty"`
+	MinAmount *float64 `bson:"minAmount,omitempty"`
+	MaxAmount *float64 `bson:"maxAmount,omitempty"`
+	Currency  *string  `bson:"currency,omitempty"`
+	Weekdays  []int    `bson:"weekdays,omitempty"`
+}
+
+type ruleActionsDbModel struct {
+	CategoryID   *primitive.ObjectID `bson:"categoryId,omitempty"`
+	Tags         []string            `bson:"tags,omitempty"`
+	TripID       *primitive.ObjectID `bson:"tripId,omitempty"`
+	Reimbursable bool                `bson:"reimbursable,omitempty"`
+}
+
+// RuleRepository represents a struct to access rules MongoDB collection.
+type RuleRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// RuleRepoInterface defines a contract to persist rules in the database.
+type RuleRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.Rule, error)
+	GetOne(ctx context.Context, id string) (*domain.Rule, error)
+	Insert(ctx context.Context, rule domain.Rule) (*string, error)
+	Update(ctx context.Context, rule domain.Rule) (*domain.UpdateResult, error)
+	DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error)
+}
+
+// NewRuleRepo returns a RuleRepository.
+func NewRuleRepo(client *database.MongoClient, logger logger.LogInterface) *RuleRepository {
+	return &RuleRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *RuleRepository) collection() *mongo.Collection {
+	return r.client.Collection(rulesCollectionName)
+}
+
+// GetAll returns all rules from the database in priority order.
+func (r *RuleRepository) GetAll(ctx context.Context) ([]domain.Rule, error) {
+	ctx, span := tracer.NewSpan(ctx, "find rules in the database")
+	defer span.End()
+
+	opts := options.Find().SetSort(bson.D{{Key: "priority", Value: 1}, {Key: "name", Value: 1}})
+	cursor, findErr := r.collection().Find(ctx, bson.M{}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find rules")
+	}
+
+	var ruleDbModels []ruleDbModel
+	if allErr := cursor.All(ctx, &ruleDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	rules := make([]domain.Rule, 0, len(ruleDbModels))
+	for _, dbModel := range ruleDbModels {
+		rule, ruleErr := r.unmarshalRule(dbModel)
+		if ruleErr != nil {
+			return nil, ruleErr
+		}
+		rules = append(rules, *rule)
+	}
+
+	return rules, nil
+}
+
+// GetOne returns a single rule from the database.
+func (r *RuleRepository) GetOne(ctx context.Context, id string) (*domain.Rule, error) {
+	ctx, span := tracer.NewSpan(ctx, "find rule in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	dbModel := ruleDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"_id": objID}).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find rule")
+	}
+
+	return r.unmarshalRule(dbModel)
+}
+
+// Insert inserts a new rule into the database.
+func (r *RuleRepository) Insert(ctx context.Context, rule domain.Rule) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add rule to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalRule(rule))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert rule")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// Update updates a rule in the database, conditions and actions are replaced as a whole.
+func (r *RuleRepository) Update(ctx context.Context, rule domain.Rule) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update rule in the database")
+	span.SetAttributes(attribute.String("id", rule.ID()))
+	defer span.End()
+
+	dbModel := r.marshalRule(rule)
+	updResult, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": dbModel.ID}, bson.M{"$set": dbModel})
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update rule")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// DeleteOne deletes a single rule from the database.
+func (r *RuleRepository) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "delete rule from the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	delResult, delErr := r.collection().DeleteOne(ctx, bson.M{"_id": objID})
+	if delErr != nil {
+		tracer.AddSpanError(span, delErr)
+		return nil, errors.Wrap(delErr, "mongodb delete rule")
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(delResult.DeletedCount),
+	}
+
+	return result, nil
+}
+
+func (r RuleRepository) marshalRule(rule domain.Rule) ruleDbModel {
+	id, _ := primitive.ObjectIDFromHex(rule.ID())
+	conditions := rule.Conditions()
+	actions := rule.Actions()
+
+	var weekdays []int
+	for _, weekday := range conditions.Weekdays {
+		weekdays = append(weekdays, int(weekday))
+	}
+
+	var categoryID *primitive.ObjectID
+	if actions.CategoryID != nil {
+		if objID, objIDErr := primitive.ObjectIDFromHex(*actions.CategoryID); objIDErr == nil {
+			categoryID = &objID
+		}
+	}
+
+	return ruleDbModel{
+		ID:       id,
+		Name:     rule.Name(),
+		Priority: rule.Priority(),
+		Conditions: ruleConditionsDbModel{
+			Comment:   conditions.Comment,
+			Merchant:  conditions.Merchant,
+			MinAmount: conditions.MinAmount,
+			MaxAmount: conditions.MaxAmount,
+			Currency:  conditions.Currency,
+			Weekdays:  weekdays,
+		},
+		Actions: ruleActionsDbModel{
+			CategoryID:   categoryID,
+			Tags:         actions.Tags,
+			TripID:       marshalTripID(actions.TripID),
+			Reimbursable: actions.Reimbursable,
+		},
+	}
+}
+
+func (r RuleRepository) unmarshalRule(dbModel ruleDbModel) (*domain.Rule, error) {
+	weekdays := make([]time.Weekday, 0, len(dbModel.Conditions.Weekdays))
+	for _, weekday := range dbModel.Conditions.Weekdays {
+		weekdays = append(weekdays, time.Weekday(weekday))
+	}
+
+	var categoryID *string
+	if dbModel.Actions.CategoryID != nil {
+		hex := dbModel.Actions.CategoryID.Hex()
+		categoryID = &hex
+	}
+
+	rule, ruleErr := domain.NewRule(dbModel.ID.Hex(), domain.RuleParams{
+		Name:     dbModel.Name,
+		Priority: dbModel.Priority,
+		Conditions: domain.RuleConditions{
+			Comment:   dbModel.Conditions.Comment,
+			Merchant:  dbModel.Conditions.Merchant,
+			MinAmount: dbModel.Conditions.MinAmount,
+			MaxAmount: dbModel.Conditions.MaxAmount,
+			Currency:  dbModel.Conditions.Currency,
+			Weekdays:  weekdays,
+		},
+		Actions: domain.RuleActions{
+			CategoryID:   categoryID,
+			Tags:         dbModel.Actions.Tags,
+			TripID:       unmarshalTripID(dbModel.Actions.TripID),
+			Reimbursable: dbModel.Actions.Reimbursable,
+		},
+	})
+	if ruleErr != nil {
+		return nil, errors.Wrap(ruleErr, "unmarshal rule")
+	}
+	return rule, nil
+}
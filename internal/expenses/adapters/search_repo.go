@@ -0,0 +1,295 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// textIndexes defines fields of the collections searched by text.
+var textIndexes = []struct {
+	collection string
+	field      string
+}{
+	{collection: expenseCollectionName, field: "comment"},
+	{collection: categoriesCollectionName, field: "name"},
+	{collection: tripsCollectionName, field: "name"},
+}
+
+type scoredCategoryDbModel struct {
+	categoryDbModel `bson:",inline"`
+	Score           float64 `bson:"score"`
+}
+
+type scoredTripDbModel struct {
+	ID    primitive.ObjectID `bson:"_id"`
+	Score float64            `bson:"score"`
+}
+
+type scoredExpenseDbModel struct {
+	expenseDbModel `bson:",inline"`
+	Score          float64 `bson:"score"`
+}
+
+// SearchRepository represents a struct to search expenses and categories with MongoDB text indexes.
+// Expenses are matched by comment as well as by names of their categories and trips,
+// an expense relevance is a sum of the relevance of all of them.
+type SearchRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// SearchRepoInterface defines a contract to search expenses and categories.
+type SearchRepoInterface interface {
+	EnsureIndexes(ctx context.Context) error
+	Search(ctx context.Context, filter domain.SearchFilter) (*domain.SearchResult, error)
+}
+
+// NewSearchRepo returns a SearchRepository.
+func NewSearchRepo(client *database.MongoClient, logger logger.LogInterface) *SearchRepository {
+	return &SearchRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// EnsureIndexes creates text indexes of the searched collections unless they exist already.
+func (r *SearchRepository) EnsureIndexes(ctx context.Context) error {
+	ctx, span := tracer.NewSpan(ctx, "ensure search indexes in the database")
+	defer span.End()
+
+	for _, index := range textIndexes {
+		model := mongo.IndexModel{
+			Keys:    bson.D{{Key: index.field, Value: "text"}},
+			Options: options.Index().SetName(index.field + "_text"),
+		}
+		if _, createErr := r.client.Collection(index.collection).Indexes().CreateOne(ctx, model); createErr != nil {
+			tracer.AddSpanError(span, createErr)
+			return errors.Wrapf(createErr, "mongodb create %s text index", index.collection)
+		}
+	}
+
+	return nil
+}
+
+// Search returns expenses and categories matching the filter text.
+func (r *SearchRepository) Search(ctx context.Context, filter domain.SearchFilter) (*domain.SearchResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "search expenses in the database")
+	span.SetAttributes(attribute.String("text", filter.Text()))
+	defer span.End()
+
+	categoryModels, categoriesErr := r.searchCategories(ctx, filter)
+	if categoriesErr != nil {
+		tracer.AddSpanError(span, categoriesErr)
+		return nil, categoriesErr
+	}
+	tripModels, tripsErr := r.searchTrips(ctx, filter)
+	if tripsErr != nil {
+		tracer.AddSpanError(span, tripsErr)
+		return nil, tripsErr
+	}
+	expenseModels, expensesErr := r.searchExpenses(ctx, filter, categoryModels, tripModels)
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, expensesErr
+	}
+
+	categoryScores := make(map[primitive.ObjectID]float64, len(categoryModels))
+	categories := make([]domain.CategoryMatch, 0, len(categoryModels))
+	for _, categoryModel := range categoryModels {
+		category, categoryErr := unmarshalExpenseCategory(categoryModel.categoryDbModel)
+		if categoryErr != nil {
+			return nil, categoryErr
+		}
+		categoryScores[categoryModel.ID] = categoryModel.Score
+		categories = append(categories, domain.CategoryMatch{Category: *category, Score: categoryModel.Score})
+	}
+
+	tripScores := make(map[primitive.ObjectID]float64, len(tripModels))
+	for _, tripModel := range tripModels {
+		tripScores[tripModel.ID] = tripModel.Score
+	}
+
+	expenses := make([]domain.ExpenseMatch, 0, len(expenseModels))
+	for _, expenseModel := range expenseModels {
+		expense, expenseErr := unmarshalExpense(expenseModel.expenseDbModel)
+		if expenseErr != nil {
+			return nil, expenseErr
+		}
+		score := expenseModel.Score + categoryScores[expenseModel.CategoryID]
+		if expenseModel.TripID != nil {
+			score += tripScores[*expenseModel.TripID]
+		}
+		expenses = append(expenses, domain.ExpenseMatch{Expense: *expense, Score: score})
+	}
+
+	result := domain.NewSearchResult(expenses, categories)
+
+	return &result, nil
+}
+
+// searchCategories returns categories matching the filter text.
+func (r *SearchRepository) searchCategories(
+	ctx context.Context,
+	filter domain.SearchFilter,
+) ([]scoredCategoryDbModel, error) {
+	query := bson.M{
+		"$text":     bson.M{"$search": filter.Text()},
+		"deletedAt": bson.M{"$exists": false},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}}).
+		SetSort(bson.M{"score": bson.M{"$meta": "textScore"}}).
+		SetLimit(int64(domain.SearchLimit))
+	cursor, findErr := r.client.Collection(categoriesCollectionName).Find(ctx, query, opts)
+	if findErr != nil {
+		return nil, errors.Wrap(findErr, "mongodb search categories")
+	}
+
+	var categoryModels []scoredCategoryDbModel
+	if allErr := cursor.All(ctx, &categoryModels); allErr != nil {
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	return categoryModels, nil
+}
+
+// searchTrips returns ids of trips matching the filter text.
+func (r *SearchRepository) searchTrips(ctx context.Context, filter domain.SearchFilter) ([]scoredTripDbModel, error) {
+	query := bson.M{"$text": bson.M{"$search": filter.Text()}}
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 1, "score": bson.M{"$meta": "textScore"}}).
+		SetSort(bson.M{"score": bson.M{"$meta": "textScore"}}).
+		SetLimit(int64(domain.SearchLimit))
+	cursor, findErr := r.client.Collection(tripsCollectionName).Find(ctx, query, opts)
+	if findErr != nil {
+		return nil, errors.Wrap(findErr, "mongodb search trips")
+	}
+
+	var tripModels []scoredTripDbModel
+	if allErr := cursor.All(ctx, &tripModels); allErr != nil {
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	return tripModels, nil
+}
+
+// searchExpenses returns expenses matching the filter text by comment
+// along with expenses of the matched categories and trips.
+func (r *SearchRepository) searchExpenses(
+	ctx context.Context,
+	filter domain.SearchFilter,
+	categoryModels []scoredCategoryDbModel,
+	tripModels []scoredTripDbModel,
+) ([]scoredExpenseDbModel, error) {
+	commentQuery := expenseSearchQuery(filter)
+	commentQuery["$text"] = bson.M{"$search": filter.Text()}
+	commentOperations := append([]bson.M{
+		{"$match": commentQuery},
+		{"$addFields": bson.M{"score": bson.M{"$meta": "textScore"}}},
+		{"$sort": bson.M{"score": -1}},
+		{"$limit": domain.SearchLimit},
+	}, categoryLookupStages()...)
+	expenseModels, commentErr := r.aggregateExpenses(ctx, commentOperations)
+	if commentErr != nil {
+		return nil, errors.Wrap(commentErr, "mongodb search expense comments")
+	}
+
+	categoryIDs := make([]primitive.ObjectID, 0, len(categoryModels))
+	for _, categoryModel := range categoryModels {
+		categoryIDs = append(categoryIDs, categoryModel.ID)
+	}
+	tripIDs := make([]primitive.ObjectID, 0, len(tripModels))
+	for _, tripModel := range tripModels {
+		tripIDs = append(tripIDs, tripModel.ID)
+	}
+	if len(categoryIDs) == 0 && len(tripIDs) == 0 {
+		return expenseModels, nil
+	}
+
+	linkedQuery := expenseSearchQuery(filter)
+	linkedQuery["$or"] = []bson.M{
+		{"categoryId": bson.M{"$in": categoryIDs}},
+		{"tripId": bson.M{"$in": tripIDs}},
+	}
+	linkedOperations := append([]bson.M{
+		{"$match": linkedQuery},
+		{"$sort": bson.D{{Key: "date", Value: -1}, {Key: "_id", Value: -1}}},
+		{"$limit": domain.SearchLimit},
+	}, categoryLookupStages()...)
+	linkedModels, linkedErr := r.aggregateExpenses(ctx, linkedOperations)
+	if linkedErr != nil {
+		return nil, errors.Wrap(linkedErr, "mongodb search category and trip expenses")
+	}
+
+	found := make(map[primitive.ObjectID]bool, len(expenseModels))
+	for _, expenseModel := range expenseModels {
+		found[expenseModel.ID] = true
+	}
+	for _, linkedModel := range linkedModels {
+		if !found[linkedModel.ID] {
+			expenseModels = append(expenseModels, linkedModel)
+		}
+	}
+
+	return expenseModels, nil
+}
+
+func (r *SearchRepository) aggregateExpenses(
+	ctx context.Context,
+	operations []bson.M,
+) ([]scoredExpenseDbModel, error) {
+	cursor, cursorErr := r.client.Collection(expenseCollectionName).Aggregate(ctx, operations)
+	if cursorErr != nil {
+		return nil, cursorErr
+	}
+
+	var expenseModels []scoredExpenseDbModel
+	if allErr := cursor.All(ctx, &expenseModels); allErr != nil {
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	return expenseModels, nil
+}
+
+// expenseSearchQuery returns a query of expenses within the date and amount ranges of the filter.
+func expenseSearchQuery(filter domain.SearchFilter) bson.M {
+	query := bson.M{"deletedAt": bson.M{"$exists": false}}
+
+	dateQuery := bson.M{}
+	if filter.From() != nil {
+		dateQuery["$gte"] = *filter.From()
+	}
+	if filter.To() != nil {
+		dateQuery["$lte"] = *filter.To()
+	}
+	if len(dateQuery) != 0 {
+		query["date"] = dateQuery
+	}
+
+	amount := bson.M{"$multiply": []string{"$price", "$quantity"}}
+	amountQuery := make([]bson.M, 0, 2)
+	if filter.MinAmount() != nil {
+		minAmount, _ := filter.MinAmount().Float64()
+		amountQuery = append(amountQuery, bson.M{"$gte": []interface{}{amount, minAmount}})
+	}
+	if filter.MaxAmount() != nil {
+		maxAmount, _ := filter.MaxAmount().Float64()
+		amountQuery = append(amountQuery, bson.M{"$lte": []interface{}{amount, maxAmount}})
+	}
+	if len(amountQuery) != 0 {
+		query["$expr"] = bson.M{"$and": amountQuery}
+	}
+
+	return query
+}
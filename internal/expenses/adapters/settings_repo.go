@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const settingsCollectionName string = "settings"
+
+type settingsDbModel struct {
+	User           string `bson:"user"`
+	PeriodStartDay int    `bson:"periodStartDay"`
+	ReportCurrency string `bson:"reportCurrency,omitempty"`
+}
+
+// SettingsRepository represents a struct to access settings MongoDB collection.
+type SettingsRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// SettingsRepoInterface defines a contract to persist user settings in the database.
+type SettingsRepoInterface interface {
+	GetOne(ctx context.Context, user string) (*domain.Settings, error)
+	Update(ctx context.Context, settings domain.Settings) (*domain.UpdateResult, error)
+}
+
+// NewSettingsRepo returns a SettingsRepository.
+func NewSettingsRepo(client *database.MongoClient, logger logger.LogInterface) *SettingsRepository {
+	return &SettingsRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *SettingsRepository) collection() *mongo.Collection {
+	return r.client.Collection(settingsCollectionName)
+}
+
+// GetOne returns settings of the user from the database.
+func (r *SettingsRepository) GetOne(ctx context.Context, user string) (*domain.Settings, error) {
+	ctx, span := tracer.NewSpan(ctx, "find settings in the database")
+	span.SetAttributes(attribute.String("user", user))
+	defer span.End()
+
+	dbModel := settingsDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"user": user}).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find settings")
+	}
+
+	return r.unmarshalSettings(dbModel)
+}
+
+// Update inserts or updates settings of the user in the database.
+func (r *SettingsRepository) Update(ctx context.Context, settings domain.Settings) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update settings in the database")
+	span.SetAttributes(attribute.String("user", settings.User()))
+	defer span.End()
+
+	dbModel := r.marshalSettings(settings)
+	opts := options.Update().SetUpsert(true)
+	updResult, updErr := r.collection().UpdateOne(ctx, bson.M{"user": dbModel.User}, bson.M{"$set": dbModel}, opts)
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update settings")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount + updResult.UpsertedCount),
+	}
+
+	return result, nil
+}
+
+func (r SettingsRepository) marshalSettings(settings domain.Settings) settingsDbModel {
+	return settingsDbModel{
+		User:           settings.User(),
+		PeriodStartDay: settings.PeriodStartDay(),
+		ReportCurrency: string(settings.ReportCurrency()),
+	}
+}
+
+func (r SettingsRepository) unmarshalSettings(dbModel settingsDbModel) (*domain.Settings, error) {
+	settings, settingsErr := domain.NewSettings(dbModel.User, domain.SettingsParams{
+		PeriodStartDay: dbModel.PeriodStartDay,
+		ReportCurrency: dbModel.ReportCurrency,
+	})
+	if settingsErr != nil {
+		return nil, errors.Wrap(settingsErr, "unmarshal settings")
+	}
+	return settings, nil
+}
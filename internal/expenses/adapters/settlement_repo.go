@@ -0,0 +1,113 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const settlementsCollectionName string = "settlements"
+
+type settlementDbModel struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	From     string             `bson:"from"`
+	To       string             `bson:"to"`
+	Amount   float64            `bson:"amount"`
+	Currency string             `bson:"currency"`
+	Date     time.Time          `bson:"date"`
+}
+
+// SettlementRepository represents a struct to access settlements MongoDB collection.
+type SettlementRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// SettlementRepoInterface defines a contract to persist settlements in the database.
+type SettlementRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.Settlement, error)
+	Insert(ctx context.Context, settlement domain.Settlement) (*string, error)
+}
+
+// NewSettlementRepo returns a SettlementRepository.
+func NewSettlementRepo(client *database.MongoClient, logger logger.LogInterface) *SettlementRepository {
+	return &SettlementRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *SettlementRepository) collection() *mongo.Collection {
+	return r.client.Collection(settlementsCollectionName)
+}
+
+// GetAll returns all settlements from the database sorted by date.
+func (r *SettlementRepository) GetAll(ctx context.Context) ([]domain.Settlement, error) {
+	ctx, span := tracer.NewSpan(ctx, "find settlements in the database")
+	defer span.End()
+
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, findErr := r.collection().Find(ctx, bson.M{}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find settlements")
+	}
+
+	var settlementDbModels []settlementDbModel
+	if allErr := cursor.All(ctx, &settlementDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	settlements := make([]domain.Settlement, 0, len(settlementDbModels))
+	for _, dbModel := range settlementDbModels {
+		settlement, settlementErr := domain.NewSettlement(dbModel.ID.Hex(), domain.SettlementParams{
+			From:     dbModel.From,
+			To:       dbModel.To,
+			Amount:   dbModel.Amount,
+			Currency: dbModel.Currency,
+			Date:     dbModel.Date,
+		})
+		if settlementErr != nil {
+			return nil, errors.Wrap(settlementErr, "unmarshal settlement")
+		}
+		settlements = append(settlements, *settlement)
+	}
+
+	return settlements, nil
+}
+
+// Insert inserts a new settlement into the database.
+func (r *SettlementRepository) Insert(ctx context.Context, settlement domain.Settlement) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add settlement to the database")
+	defer span.End()
+
+	dbModel := settlementDbModel{
+		From:     settlement.From(),
+		To:       settlement.To(),
+		Amount:   settlement.Amount(),
+		Currency: string(settlement.Currency()),
+		Date:     settlement.Date(),
+	}
+	insRes, insErr := r.collection().InsertOne(ctx, dbModel)
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert settlement")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
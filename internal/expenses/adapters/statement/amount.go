@@ -0,0 +1,31 @@
+package statement
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseAmount parses a statement amount written with either dot or comma decimal separator.
+// A separator is treated as a decimal one when it is the last one in the value, unless it is
+// a single comma followed by exactly three digits, e.g. 1,234.
+func parseAmount(value string) (float64, error) {
+	normalized := strings.TrimSpace(value)
+	normalized = strings.NewReplacer(" ", "", "'", "").Replace(normalized)
+
+	lastDot := strings.LastIndex(normalized, ".")
+	lastComma := strings.LastIndex(normalized, ",")
+	decimalComma := lastComma > lastDot && (lastDot != -1 || len(normalized)-lastComma-1 != 3)
+	if decimalComma {
+		normalized = strings.ReplaceAll(normalized, ".", "")
+		normalized = strings.ReplaceAll(normalized, ",", ".")
+	} else {
+		normalized = strings.ReplaceAll(normalized, ",", "")
+	}
+
+	amount, amountErr := strconv.ParseFloat(normalized, 64)
+	if amountErr != nil {
+		return 0, fmt.Errorf("%q is not an amount", value)
+	}
+	return amount, nil
+}
@@ -0,0 +1,156 @@
+package statement
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+// ofxTagPattern matches both SGML elements without closing tags (OFX 1.x) and XML elements (OFX 2.x).
+// nolint:gochecknoglobals
+var ofxTagPattern = regexp.MustCompile(`<(/?)([A-Za-z0-9._]+)>([^<]*)`)
+
+// ofxTransaction holds raw STMTTRN element values.
+type ofxTransaction map[string]string
+
+// ParseOFX parses bank and credit card statement transactions out of an OFX document.
+func ParseOFX(r io.Reader) ([]domain.StatementTransaction, error) {
+	content, readErr := io.ReadAll(r)
+	if readErr != nil {
+		return nil, errors.Wrap(readErr, "read ofx")
+	}
+
+	document := string(content)
+	start := strings.Index(strings.ToUpper(document), "<OFX>")
+	if start == -1 {
+		return nil, errors.New("not an OFX document")
+	}
+
+	transactions := make([]domain.StatementTransaction, 0)
+	var defaultCurrency string
+	var current ofxTransaction
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		transaction, transactionErr := current.toStatementTransaction(defaultCurrency)
+		if transactionErr != nil {
+			return errors.Wrapf(transactionErr, "transaction %d", len(transactions)+1)
+		}
+		transactions = append(transactions, *transaction)
+		current = nil
+		return nil
+	}
+
+	for _, match := range ofxTagPattern.FindAllStringSubmatch(document[start:], -1) {
+		closing := match[1] == "/"
+		tag := strings.ToUpper(match[2])
+		value := html.UnescapeString(strings.TrimSpace(match[3]))
+
+		switch {
+		case tag == "STMTTRN" && closing:
+			if finishErr := finish(); finishErr != nil {
+				return nil, finishErr
+			}
+		case tag == "STMTTRN":
+			// SGML documents are not guaranteed to close aggregates.
+			if finishErr := finish(); finishErr != nil {
+				return nil, finishErr
+			}
+			current = ofxTransaction{}
+		case tag == "BANKTRANLIST" && closing:
+			if finishErr := finish(); finishErr != nil {
+				return nil, finishErr
+			}
+		case closing || len(value) == 0:
+		case current != nil:
+			current[tag] = value
+		case tag == "CURDEF":
+			defaultCurrency = value
+		}
+	}
+	if finishErr := finish(); finishErr != nil {
+		return nil, finishErr
+	}
+
+	return transactions, nil
+}
+
+func (t ofxTransaction) toStatementTransaction(defaultCurrency string) (*domain.StatementTransaction, error) {
+	date, dateErr := parseOFXDate(t["DTPOSTED"])
+	if dateErr != nil {
+		return nil, dateErr
+	}
+
+	amount, amountErr := parseAmount(t["TRNAMT"])
+	if amountErr != nil {
+		return nil, amountErr
+	}
+
+	currency := defaultCurrency
+	if cursym, ok := t["CURSYM"]; ok {
+		currency = cursym
+	}
+
+	params := domain.StatementTransactionParams{
+		ExternalID: t["FITID"],
+		Date:       date,
+		Amount:     amount,
+		Currency:   optional(currency),
+		Payee:      optional(t["NAME"]),
+		Memo:       optional(t["MEMO"]),
+	}
+	return domain.NewStatementTransaction(params)
+}
+
+// parseOFXDate parses OFX dates like 20210701, 20210701120000 or 20210701120000.000[-5:EST].
+func parseOFXDate(value string) (time.Time, error) {
+	location := time.UTC
+	datetime := value
+	if zoneStart := strings.Index(value, "["); zoneStart != -1 {
+		datetime = value[:zoneStart]
+		zone := strings.TrimSuffix(value[zoneStart+1:], "]")
+		offset := strings.SplitN(zone, ":", 2)[0]
+		hours, hoursErr := strconv.ParseFloat(offset, 64)
+		if hoursErr != nil {
+			return time.Time{}, fmt.Errorf("invalid date %q time zone", value)
+		}
+		location = time.FixedZone(zone, int(hours*float64(time.Hour/time.Second)))
+	}
+	if fractionStart := strings.Index(datetime, "."); fractionStart != -1 {
+		datetime = datetime[:fractionStart]
+	}
+
+	var layout string
+	switch len(datetime) {
+	case len("20060102"):
+		layout = "20060102"
+	case len("200601021504"):
+		layout = "200601021504"
+	case len("20060102150405"):
+		layout = "20060102150405"
+	default:
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+
+	date, dateErr := time.ParseInLocation(layout, datetime, location)
+	if dateErr != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return date, nil
+}
+
+func optional(value string) *string {
+	if len(value) == 0 {
+		return nil
+	}
+	return &value
+}
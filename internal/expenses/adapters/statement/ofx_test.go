@@ -0,0 +1,112 @@
+package statement_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters/statement"
+)
+
+const sgmlStatement string = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>EUR
+<BANKACCTFROM><ACCTID>123</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20210701
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20210701120000.000[-5:EST]
+<TRNAMT>-12,50
+<FITID>fit1
+<NAME>Shop &amp; Co
+<MEMO>Card payment
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20210702
+<TRNAMT>100.00
+<FITID>fit2
+<NAME>Salary
+<CURRENCY><CURRATE>1.1<CURSYM>USD</CURRENCY>
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+const xmlStatement string = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX>
+  <CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+    <CURDEF>GBP</CURDEF>
+    <BANKTRANLIST>
+      <STMTTRN>
+        <TRNTYPE>DEBIT</TRNTYPE>
+        <DTPOSTED>20210703</DTPOSTED>
+        <TRNAMT>-5.25</TRNAMT>
+        <FITID>fit3</FITID>
+        <NAME>Cafe</NAME>
+      </STMTTRN>
+    </BANKTRANLIST>
+  </CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1>
+</OFX>
+`
+
+func TestParseOFX_SGMLStatement_ReturnsTransactions(t *testing.T) {
+	t.Parallel()
+	// Act
+	result, err := statement.ParseOFX(strings.NewReader(sgmlStatement))
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result, 2, "Should return all transactions.")
+	assert.Equal(t, "fit1", result[0].ExternalID())
+	assert.Equal(t, -12.5, result[0].Amount())
+	assert.Equal(t, "EUR", *result[0].Currency())
+	assert.Equal(t, "Shop & Co", *result[0].Payee())
+	assert.Equal(t, "Card payment", *result[0].Memo())
+	assert.True(t, time.Date(2021, 7, 1, 17, 0, 0, 0, time.UTC).Equal(result[0].Date()))
+	assert.Equal(t, "USD", *result[1].Currency())
+	assert.False(t, result[1].IsDebit())
+}
+
+func TestParseOFX_XMLStatement_ReturnsTransactions(t *testing.T) {
+	t.Parallel()
+	// Act
+	result, err := statement.ParseOFX(strings.NewReader(xmlStatement))
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result, 1, "Should return all transactions.")
+	assert.Equal(t, "fit3", result[0].ExternalID())
+	assert.Equal(t, -5.25, result[0].Amount())
+	assert.Equal(t, "GBP", *result[0].Currency())
+	assert.Equal(t, time.Date(2021, 7, 3, 0, 0, 0, 0, time.UTC), result[0].Date())
+}
+
+func TestParseOFX_InvalidDocument_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := []string{
+		"Date,Amount\n",
+		"<OFX><STMTTRN><DTPOSTED>2021<TRNAMT>-1<FITID>1</STMTTRN></OFX>",
+		"<OFX><STMTTRN><DTPOSTED>20210701<TRNAMT>abc<FITID>1</STMTTRN></OFX>",
+		"<OFX><STMTTRN><DTPOSTED>20210701<TRNAMT>-1</STMTTRN></OFX>",
+	}
+
+	for _, tc := range tests {
+		// Act
+		result, err := statement.ParseOFX(strings.NewReader(tc))
+
+		// Assert
+		assert.Nil(t, result, "Result should be nil.")
+		assert.NotNil(t, err, "Error result should not be nil.")
+	}
+}
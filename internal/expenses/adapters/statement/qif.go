@@ -0,0 +1,159 @@
+package statement
+
+import (
+	"bufio"
+	"crypto/sha1" // nolint:gosec
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+// DefaultQIFDateFormat is a date format used by Quicken.
+const DefaultQIFDateFormat string = "MM/dd/yy"
+
+// QIFOptions holds QIF parsing settings, QIF files do not define a date format.
+type QIFOptions struct {
+	DateFormat string
+}
+
+// qifRecord holds raw QIF record fields.
+type qifRecord map[byte]string
+
+// ParseQIF parses bank, cash and credit card transactions out of a QIF file.
+// QIF has no transaction IDs, so an ID is derived from the transaction fields. Identical
+// transactions within a file are told apart by their occurrence number.
+func ParseQIF(r io.Reader, options QIFOptions) ([]domain.StatementTransaction, error) {
+	dateFormat := options.DateFormat
+	if len(strings.TrimSpace(dateFormat)) == 0 {
+		dateFormat = DefaultQIFDateFormat
+	}
+	layout, layoutErr := domain.DateFormatToLayout(dateFormat)
+	if layoutErr != nil {
+		return nil, layoutErr
+	}
+	// Quicken does not pad months and days, so the layout should accept both forms.
+	layout = strings.NewReplacer("01", "1", "02", "2").Replace(layout)
+
+	transactions := make([]domain.StatementTransaction, 0)
+	occurrences := make(map[string]int)
+	transactional := false
+	hasType := false
+	record := qifRecord{}
+
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if line == 1 {
+			text = strings.TrimPrefix(text, "\ufeff")
+		}
+		if len(strings.TrimSpace(text)) == 0 {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(text, "!Type:"):
+			hasType = true
+			switch strings.ToLower(strings.TrimSpace(strings.TrimPrefix(text, "!Type:"))) {
+			case "bank", "cash", "ccard", "oth a", "oth l":
+				transactional = true
+			default:
+				transactional = false
+			}
+		case strings.HasPrefix(text, "!Account"):
+			transactional = false
+		case strings.HasPrefix(text, "!"):
+		case text[0] == '^':
+			if transactional && len(record) != 0 {
+				transaction, transactionErr := record.toStatementTransaction(layout, occurrences)
+				if transactionErr != nil {
+					return nil, errors.Wrapf(transactionErr, "record ending at line %d", line)
+				}
+				transactions = append(transactions, *transaction)
+			}
+			record = qifRecord{}
+		default:
+			// Split lines share codes with the transaction, the transaction total is used instead.
+			if _, ok := record[text[0]]; !ok {
+				record[text[0]] = strings.TrimSpace(text[1:])
+			}
+		}
+	}
+	if scanErr := scanner.Err(); scanErr != nil {
+		return nil, errors.Wrap(scanErr, "read qif")
+	}
+	if !hasType {
+		return nil, errors.New("not a QIF file")
+	}
+
+	return transactions, nil
+}
+
+func (r qifRecord) toStatementTransaction(
+	layout string,
+	occurrences map[string]int,
+) (*domain.StatementTransaction, error) {
+	date, dateErr := parseQIFDate(r['D'], layout)
+	if dateErr != nil {
+		return nil, dateErr
+	}
+
+	rawAmount, ok := r['T']
+	if !ok {
+		rawAmount = r['U']
+	}
+	amount, amountErr := parseAmount(rawAmount)
+	if amountErr != nil {
+		return nil, amountErr
+	}
+
+	key := strings.Join([]string{date.Format("2006-01-02"), fmt.Sprintf("%.2f", amount), r['P'], r['M'], r['N']}, "|")
+	occurrences[key]++
+	hash := sha1.Sum([]byte(fmt.Sprintf("%s|%d", key, occurrences[key]))) // nolint:gosec
+
+	params := domain.StatementTransactionParams{
+		ExternalID:   "qif:" + hex.EncodeToString(hash[:]),
+		Date:         date,
+		Amount:       amount,
+		Payee:        optional(r['P']),
+		Memo:         optional(r['M']),
+		CategoryHint: qifCategory(r['L']),
+	}
+	return domain.NewStatementTransaction(params)
+}
+
+// parseQIFDate parses a QIF date, e.g. 12/31'21 or " 1/ 5/2021".
+func parseQIFDate(value string, layout string) (time.Time, error) {
+	normalized := strings.ReplaceAll(strings.TrimSpace(value), "'", "/")
+	normalized = strings.ReplaceAll(normalized, " ", "")
+
+	// Quicken mixes two and four digit years within a file.
+	alternativeLayout := strings.Replace(layout, "06", "2006", 1)
+	if strings.Contains(layout, "2006") {
+		alternativeLayout = strings.Replace(layout, "2006", "06", 1)
+	}
+
+	date, dateErr := time.Parse(layout, normalized)
+	if dateErr != nil {
+		date, dateErr = time.Parse(alternativeLayout, normalized)
+	}
+	if dateErr != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", value)
+	}
+	return date, nil
+}
+
+// qifCategory converts QIF category, e.g. Food:Groceries/Class, into a category names path.
+// Transfers to other accounts, e.g. [Savings], have no category.
+func qifCategory(value string) *string {
+	if len(value) == 0 || strings.HasPrefix(value, "[") {
+		return nil
+	}
+	category := strings.SplitN(value, "/", 2)[0]
+	return optional(strings.ReplaceAll(category, ":", domain.CategoryNameSeparator))
+}
@@ -0,0 +1,94 @@
+package statement_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters/statement"
+)
+
+const qifStatement string = `!Type:Cat
+NFood
+^
+!Type:Bank
+D 7/ 1'21
+T-1,234.50
+PShop
+MWeekly
+LFood:Groceries/Home
+^
+D07/02/2021
+U-3.00
+PCafe
+L[Savings]
+^
+D07/02/2021
+T-3.00
+PCafe
+^
+`
+
+func TestParseQIF_BankStatement_ReturnsTransactions(t *testing.T) {
+	t.Parallel()
+	// Act
+	result, err := statement.ParseQIF(strings.NewReader(qifStatement), statement.QIFOptions{})
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result, 3, "Should return bank transactions only.")
+	assert.Equal(t, time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), result[0].Date())
+	assert.Equal(t, -1234.5, result[0].Amount())
+	assert.Equal(t, "Food/Groceries", *result[0].CategoryHint())
+	assert.Nil(t, result[0].Currency())
+	assert.Equal(t, time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC), result[1].Date())
+	assert.Nil(t, result[1].CategoryHint(), "Transfers should have no category.")
+	assert.NotEqual(t, result[1].ExternalID(), result[2].ExternalID(), "Identical transactions should differ.")
+}
+
+func TestParseQIF_SameFile_ReturnsSameIDs(t *testing.T) {
+	t.Parallel()
+	// Act
+	first, _ := statement.ParseQIF(strings.NewReader(qifStatement), statement.QIFOptions{})
+	second, _ := statement.ParseQIF(strings.NewReader(qifStatement), statement.QIFOptions{})
+
+	// Assert
+	for i := range first {
+		assert.Equal(t, first[i].ExternalID(), second[i].ExternalID(), "IDs should be stable.")
+	}
+}
+
+func TestParseQIF_DateFormat_ParsesDates(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	qif := "!Type:CCard\nD31.12.2021\nT-10,5\n^\n"
+
+	// Act
+	result, err := statement.ParseQIF(strings.NewReader(qif), statement.QIFOptions{DateFormat: "dd.MM.yyyy"})
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC), result[0].Date())
+	assert.Equal(t, -10.5, result[0].Amount())
+}
+
+func TestParseQIF_InvalidFile_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := []string{
+		"D07/01/21\nT-1\n^\n",
+		"!Type:Bank\nD2021-07-01\nT-1\n^\n",
+		"!Type:Bank\nD07/01/21\nTabc\n^\n",
+	}
+
+	for _, tc := range tests {
+		// Act
+		result, err := statement.ParseQIF(strings.NewReader(tc), statement.QIFOptions{})
+
+		// Assert
+		assert.Nil(t, result, "Result should be nil.")
+		assert.NotNil(t, err, "Error result should not be nil.")
+	}
+}
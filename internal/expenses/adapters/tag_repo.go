@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+type tagDbModel struct {
+	Name  string `bson:"_id"`
+	Count int    `bson:"count"`
+}
+
+// TagRepository represents a struct to access tags of expenses in MongoDB.
+type TagRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// TagRepoInterface defines a contract to manage expense tags in the database.
+type TagRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.Tag, error)
+	Replace(ctx context.Context, tags []string, replacement string) (*domain.UpdateResult, error)
+}
+
+// NewTagRepo returns a TagRepository.
+func NewTagRepo(client *database.MongoClient, logger logger.LogInterface) *TagRepository {
+	return &TagRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle, tags are stored within expenses.
+func (r *TagRepository) collection() *mongo.Collection {
+	return r.client.Collection(expenseCollectionName)
+}
+
+// GetAll returns tags of expenses which are not in the trash along with their usage counts sorted by name.
+func (r *TagRepository) GetAll(ctx context.Context) ([]domain.Tag, error) {
+	ctx, span := tracer.NewSpan(ctx, "find tags in the database")
+	defer span.End()
+
+	operations := []bson.M{
+		{"$match": bson.M{
+			"tags":      bson.M{"$exists": true},
+			"deletedAt": bson.M{"$exists": false},
+		}},
+		{"$unwind": "$tags"},
+		{"$group": bson.M{
+			"_id":   "$tags",
+			"count": bson.M{"$sum": 1},
+		}},
+		{"$sort": bson.M{"_id": 1}},
+	}
+
+	cursor, cursorErr := r.collection().Aggregate(ctx, operations)
+	if cursorErr != nil {
+		tracer.AddSpanError(span, cursorErr)
+		return nil, errors.Wrap(cursorErr, "mongodb aggregate tags")
+	}
+
+	var tagDbModels []tagDbModel
+	if allErr := cursor.All(ctx, &tagDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	tags := make([]domain.Tag, 0, len(tagDbModels))
+	for _, dbModel := range tagDbModels {
+		tags = append(tags, domain.Tag{
+			Name:  dbModel.Name,
+			Count: dbModel.Count,
+		})
+	}
+
+	return tags, nil
+}
+
+// Replace replaces the tags with the replacement tag in every expense, including the trashed ones,
+// so restored expenses keep consistent tags. Tags of expenses locked by reconciliation could not be replaced,
+// nothing is changed while any locked expense is tagged with the tags.
+func (r *TagRepository) Replace(
+	ctx context.Context,
+	tags []string,
+	replacement string,
+) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "replace tags in the database")
+	span.SetAttributes(attribute.StringSlice("tags", tags), attribute.String("replacement", replacement))
+	defer span.End()
+
+	updater := []bson.M{
+		{"$set": bson.M{
+			"tags": bson.M{"$setUnion": bson.A{
+				bson.M{"$setDifference": bson.A{"$tags", tags}},
+				bson.A{replacement},
+			}},
+		}},
+	}
+
+	result := &domain.UpdateResult{}
+	txErr := r.client.WithTransaction(ctx, func(ctx context.Context) error {
+		lockedCount, countErr := r.collection().CountDocuments(ctx, lockedTagsFilter(tags))
+		if countErr != nil {
+			return errors.Wrap(countErr, "mongodb count locked expenses")
+		}
+		if lockedCount > 0 {
+			return errors.Wrapf(domain.ErrExpenseLocked, "%d expenses with the tags", lockedCount)
+		}
+
+		updResult, updErr := r.collection().UpdateMany(ctx, bson.M{"tags": bson.M{"$in": tags}}, updater)
+		if updErr != nil {
+			return errors.Wrap(updErr, "mongodb replace tags")
+		}
+		result.UpdateCount = int(updResult.ModifiedCount)
+		return nil
+	})
+	if txErr != nil {
+		tracer.AddSpanError(span, txErr)
+		return nil, txErr
+	}
+
+	return result, nil
+}
+
+// lockedTagsFilter returns a filter of expenses tagged with any of the tags, which are locked by reconciliation.
+func lockedTagsFilter(tags []string) bson.M {
+	return bson.M{
+		"tags":             bson.M{"$in": tags},
+		"reconciliationId": bson.M{"$exists": true},
+	}
+}
@@ -0,0 +1,100 @@
+package adapters
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/gif" // registers GIF decoder
+	"image/jpeg"
+	_ "image/png" // registers PNG decoder
+
+	"github.com/pkg/errors"
+)
+
+const thumbnailQuality int = 80
+
+// ThumbnailGenerator makes JPEG thumbnails of images.
+type ThumbnailGenerator struct {
+	size int
+}
+
+// ThumbnailGeneratorInterface defines a contract to make image thumbnails.
+type ThumbnailGeneratorInterface interface {
+	Generate(content []byte) ([]byte, error)
+}
+
+// NewThumbnailGenerator returns a ThumbnailGenerator fitting thumbnails into a square of the size.
+func NewThumbnailGenerator(size int) ThumbnailGenerator {
+	return ThumbnailGenerator{
+		size: size,
+	}
+}
+
+// Generate decodes a JPEG, PNG or GIF image and scales it down to fit the thumbnail size keeping
+// the aspect ratio. Smaller images are not scaled up, transparent parts are filled with white.
+func (g ThumbnailGenerator) Generate(content []byte) ([]byte, error) {
+	source, _, decodeErr := image.Decode(bytes.NewReader(content))
+	if decodeErr != nil {
+		return nil, errors.Wrap(decodeErr, "decode image")
+	}
+
+	bounds := source.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return nil, errors.New("empty image")
+	}
+	if width > g.size || height > g.size {
+		if width >= height {
+			width, height = g.size, maxInt(1, height*g.size/bounds.Dx())
+		} else {
+			width, height = maxInt(1, width*g.size/bounds.Dy()), g.size
+		}
+	}
+
+	thumbnail := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		fromY := bounds.Min.Y + y*bounds.Dy()/height
+		toY := maxInt(fromY+1, bounds.Min.Y+(y+1)*bounds.Dy()/height)
+		for x := 0; x < width; x++ {
+			fromX := bounds.Min.X + x*bounds.Dx()/width
+			toX := maxInt(fromX+1, bounds.Min.X+(x+1)*bounds.Dx()/width)
+			thumbnail.Set(x, y, averageColor(source, fromX, toX, fromY, toY))
+		}
+	}
+
+	var buffer bytes.Buffer
+	if encodeErr := jpeg.Encode(&buffer, thumbnail, &jpeg.Options{Quality: thumbnailQuality}); encodeErr != nil {
+		return nil, errors.Wrap(encodeErr, "encode thumbnail")
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// averageColor returns an average color of the source pixels area blended over a white background.
+func averageColor(source image.Image, fromX, toX, fromY, toY int) color.RGBA {
+	var red, green, blue, count uint64
+	for y := fromY; y < toY; y++ {
+		for x := fromX; x < toX; x++ {
+			r, g, b, a := source.At(x, y).RGBA()
+			// Colors are alpha-premultiplied, adding the uncovered part of white flattens transparency.
+			red += uint64(r + 0xffff - a)
+			green += uint64(g + 0xffff - a)
+			blue += uint64(b + 0xffff - a)
+			count++
+		}
+	}
+
+	return color.RGBA{
+		R: uint8(red / count >> 8),
+		G: uint8(green / count >> 8),
+		B: uint8(blue / count >> 8),
+		A: 0xff,
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,80 @@
+package adapters_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+)
+
+func encodePNG(width, height int, fill color.Color) []byte {
+	source := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			source.Set(x, y, fill)
+		}
+	}
+	var buffer bytes.Buffer
+	_ = png.Encode(&buffer, source)
+	return buffer.Bytes()
+}
+
+func TestThumbnailGenerator_LargeImage_ScalesDownKeepingAspectRatio(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	content := encodePNG(400, 200, color.NRGBA{R: 200, G: 10, B: 10, A: 0xff})
+
+	// SUT
+	generator := adapters.NewThumbnailGenerator(100)
+
+	// Act
+	result, resultErr := generator.Generate(content)
+
+	// Assert
+	assert.Nil(t, resultErr, "Error should be nil.")
+	thumbnail, decodeErr := jpeg.Decode(bytes.NewReader(result))
+	assert.Nil(t, decodeErr, "Thumbnail should be a JPEG image.")
+	assert.Equal(t, image.Rect(0, 0, 100, 50), thumbnail.Bounds())
+	r, _, _, _ := thumbnail.At(50, 25).RGBA()
+	assert.InDelta(t, 200, r>>8, 5, "Thumbnail should keep image colors.")
+}
+
+func TestThumbnailGenerator_SmallTransparentImage_KeepsSizeOnWhite(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	content := encodePNG(20, 30, color.NRGBA{})
+
+	// SUT
+	generator := adapters.NewThumbnailGenerator(100)
+
+	// Act
+	result, resultErr := generator.Generate(content)
+
+	// Assert
+	assert.Nil(t, resultErr, "Error should be nil.")
+	thumbnail, _ := jpeg.Decode(bytes.NewReader(result))
+	assert.Equal(t, image.Rect(0, 0, 20, 30), thumbnail.Bounds())
+	r, g, b, _ := thumbnail.At(10, 10).RGBA()
+	assert.InDelta(t, 255, r>>8, 2, "Transparency should be filled with white.")
+	assert.InDelta(t, 255, g>>8, 2, "Transparency should be filled with white.")
+	assert.InDelta(t, 255, b>>8, 2, "Transparency should be filled with white.")
+}
+
+func TestThumbnailGenerator_NotImage_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// SUT
+	generator := adapters.NewThumbnailGenerator(100)
+
+	// Act
+	result, resultErr := generator.Generate([]byte("%PDF-1.4"))
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, resultErr, "Error should not be nil.")
+}
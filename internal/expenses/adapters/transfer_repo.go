@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// transfersCollectionName is kept apart from expenses, so transfers never count as spending.
+const transfersCollectionName string = "transfers"
+
+type transferDbModel struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty"`
+	FromAccountID primitive.ObjectID `bson:"fromAccountId"`
+	ToAccountID   primitive.ObjectID `bson:"toAccountId"`
+	Amount        float64            `bson:"amount"`
+	Rate          *float64           `bson:"rate,omitempty"`
+	Date          time.Time          `bson:"date"`
+	Comment       *string            `bson:"comment,omitempty"`
+}
+
+// TransferRepository represents a struct to access transfers MongoDB collection.
+type TransferRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// TransferRepoInterface defines a contract to persist transfers in the database.
+type TransferRepoInterface interface {
+	GetByAccount(ctx context.Context, accountID string, at time.Time) ([]domain.Transfer, error)
+	Insert(ctx context.Context, transfer domain.Transfer) (*string, error)
+}
+
+// NewTransferRepo returns a TransferRepository.
+func NewTransferRepo(client *database.MongoClient, logger logger.LogInterface) *TransferRepository {
+	return &TransferRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *TransferRepository) collection() *mongo.Collection {
+	return r.client.Collection(transfersCollectionName)
+}
+
+// GetByAccount returns transfers from or to the account up to the moment sorted by date.
+func (r *TransferRepository) GetByAccount(
+	ctx context.Context,
+	accountID string,
+	at time.Time,
+) ([]domain.Transfer, error) {
+	ctx, span := tracer.NewSpan(ctx, "find account transfers in the database")
+	span.SetAttributes(attribute.String("accountId", accountID))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(accountID)
+	if objIDErr != nil {
+		return nil, errors.Wrap(objIDErr, "invalid account id")
+	}
+
+	filter := bson.M{
+		"$or":  bson.A{bson.M{"fromAccountId": objID}, bson.M{"toAccountId": objID}},
+		"date": bson.M{"$lte": at},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "date", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, findErr := r.collection().Find(ctx, filter, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find transfers")
+	}
+
+	var transferDbModels []transferDbModel
+	if allErr := cursor.All(ctx, &transferDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	transfers := make([]domain.Transfer, 0, len(transferDbModels))
+	for _, dbModel := range transferDbModels {
+		transfer, transferErr := r.unmarshalTransfer(dbModel)
+		if transferErr != nil {
+			return nil, transferErr
+		}
+		transfers = append(transfers, *transfer)
+	}
+
+	return transfers, nil
+}
+
+// Insert inserts a new transfer into the database.
+func (r *TransferRepository) Insert(ctx context.Context, transfer domain.Transfer) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add transfer to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalTransfer(transfer))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert transfer")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+func (r TransferRepository) marshalTransfer(transfer domain.Transfer) transferDbModel {
+	id, _ := primitive.ObjectIDFromHex(transfer.ID())
+	fromAccountID, _ := primitive.ObjectIDFromHex(transfer.FromAccountID())
+	toAccountID, _ := primitive.ObjectIDFromHex(transfer.ToAccountID())
+
+	return transferDbModel{
+		ID:            id,
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        transfer.Amount(),
+		Rate:          transfer.Rate(),
+		Date:          transfer.Date(),
+		Comment:       transfer.Comment(),
+	}
+}
+
+func (r TransferRepository) unmarshalTransfer(dbModel transferDbModel) (*domain.Transfer, error) {
+	transfer, transferErr := domain.NewTransfer(dbModel.ID.Hex(), domain.TransferParams{
+		FromAccountID: dbModel.FromAccountID.Hex(),
+		ToAccountID:   dbModel.ToAccountID.Hex(),
+		Amount:        dbModel.Amount,
+		Rate:          dbModel.Rate,
+		Date:          dbModel.Date,
+		Comment:       dbModel.Comment,
+	})
+	if transferErr != nil {
+		return nil, errors.Wrap(transferErr, "unmarshal transfer")
+	}
+	return transfer, nil
+}
@@ -0,0 +1,329 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// TrashRepository represents a struct to access trashed expenses and categories.
+type TrashRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// TrashRepoInterface defines a contract to manage trashed items in the database.
+type TrashRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.TrashItem, error)
+	GetOne(ctx context.Context, id string) (*domain.TrashItem, error)
+	Restore(ctx context.Context, item domain.TrashItem) (*domain.UpdateResult, error)
+	Purge(ctx context.Context, deletedBefore time.Time) (*domain.DeleteResult, error)
+}
+
+// NewTrashRepo returns a TrashRepository.
+func NewTrashRepo(client *database.MongoClient, logger logger.LogInterface) *TrashRepository {
+	return &TrashRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// expenses returns expenses collection handle.
+func (r *TrashRepository) expenses() *mongo.Collection {
+	return r.client.Collection(expenseCollectionName)
+}
+
+// categories returns categories collection handle.
+func (r *TrashRepository) categories() *mongo.Collection {
+	return r.client.Collection(categoriesCollectionName)
+}
+
+// GetAll returns all trashed expenses and the roots of trashed category subtrees.
+func (r *TrashRepository) GetAll(ctx context.Context) ([]domain.TrashItem, error) {
+	ctx, span := tracer.NewSpan(ctx, "find trash items in the database")
+	defer span.End()
+
+	expenseCursor, expenseCursorErr := r.expenses().Find(ctx, bson.M{"deletedAt": bson.M{"$exists": true}})
+	if expenseCursorErr != nil {
+		tracer.AddSpanError(span, expenseCursorErr)
+		return nil, errors.Wrap(expenseCursorErr, "mongodb find trashed expenses")
+	}
+
+	var expenseDbModels []expenseDbModel
+	if allError := expenseCursor.All(ctx, &expenseDbModels); allError != nil {
+		tracer.AddSpanError(span, allError)
+		return nil, errors.Wrap(allError, "expenses cursor iteration")
+	}
+
+	// Only the roots are listed, the rest of a subtree is restored along with its root.
+	categoryQuery := bson.M{
+		"deletedAt": bson.M{"$exists": true},
+		"$expr":     bson.M{"$eq": []interface{}{"$_id", "$trashRootId"}},
+	}
+	categoryCursor, categoryCursorErr := r.categories().Find(ctx, categoryQuery)
+	if categoryCursorErr != nil {
+		tracer.AddSpanError(span, categoryCursorErr)
+		return nil, errors.Wrap(categoryCursorErr, "mongodb find trashed categories")
+	}
+
+	var categoryDbModels []categoryDbModel
+	if allError := categoryCursor.All(ctx, &categoryDbModels); allError != nil {
+		tracer.AddSpanError(span, allError)
+		return nil, errors.Wrap(allError, "categories cursor iteration")
+	}
+
+	span.AddEvent("fetched finished", trace.WithAttributes(
+		attribute.Int("expenses", len(expenseDbModels)),
+		attribute.Int("categories", len(categoryDbModels)),
+	))
+
+	items := make([]domain.TrashItem, 0, len(expenseDbModels)+len(categoryDbModels))
+	for _, expenseModel := range expenseDbModels {
+		item, itemErr := r.unmarshalTrashedExpense(expenseModel)
+		if itemErr != nil {
+			return nil, itemErr
+		}
+		items = append(items, *item)
+	}
+	for _, categoryModel := range categoryDbModels {
+		item, itemErr := r.unmarshalTrashedCategory(categoryModel)
+		if itemErr != nil {
+			return nil, itemErr
+		}
+		items = append(items, *item)
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt().After(items[j].DeletedAt())
+	})
+
+	return items, nil
+}
+
+// GetOne returns a trashed expense or a root of trashed category subtree.
+func (r *TrashRepository) GetOne(ctx context.Context, id string) (*domain.TrashItem, error) {
+	ctx, span := tracer.NewSpan(ctx, "find trash item in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	expenseModel := expenseDbModel{}
+	expenseFilter := bson.M{"_id": objID, "deletedAt": bson.M{"$exists": true}}
+	expenseErr := r.expenses().FindOne(ctx, expenseFilter).Decode(&expenseModel)
+	if expenseErr == nil {
+		return r.unmarshalTrashedExpense(expenseModel)
+	}
+	if !errors.Is(expenseErr, mongo.ErrNoDocuments) {
+		tracer.AddSpanError(span, expenseErr)
+		return nil, errors.Wrap(expenseErr, "find trashed expense")
+	}
+
+	categoryModel := categoryDbModel{}
+	categoryFilter := bson.M{"_id": objID, "trashRootId": objID}
+	categoryErr := r.categories().FindOne(ctx, categoryFilter).Decode(&categoryModel)
+	if categoryErr == nil {
+		return r.unmarshalTrashedCategory(categoryModel)
+	}
+	if !errors.Is(categoryErr, mongo.ErrNoDocuments) {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, errors.Wrap(categoryErr, "find trashed category")
+	}
+
+	return nil, nil
+}
+
+// Restore takes an expense or a whole category subtree out of the trash.
+func (r *TrashRepository) Restore(ctx context.Context, item domain.TrashItem) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "restore trash item in the database")
+	span.SetAttributes(attribute.String("id", item.ID()))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(item.ID())
+	if objIDErr != nil {
+		return nil, errors.Wrap(objIDErr, "parse trash item id")
+	}
+
+	var updResult *mongo.UpdateResult
+	var updErr error
+	if item.IsCategory() {
+		updater := bson.M{
+			"$unset": bson.M{"deletedAt": "", "deletedBy": "", "trashRootId": ""},
+		}
+		updResult, updErr = r.categories().UpdateMany(ctx, bson.M{"trashRootId": objID}, updater)
+	} else {
+		updater := bson.M{
+			"$unset": bson.M{"deletedAt": "", "deletedBy": ""},
+		}
+		updResult, updErr = r.expenses().UpdateOne(ctx, bson.M{"_id": objID}, updater)
+	}
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb restore trash item")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// Purge permanently deletes items that were trashed before the date.
+// Expenses of purged categories are deleted as well, since they could not be reported anymore.
+// Expenses locked by reconciliation are never purged, their categories and the category ancestors are kept.
+func (r *TrashRepository) Purge(ctx context.Context, deletedBefore time.Time) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "purge trash in the database")
+	span.SetAttributes(attribute.String("deletedBefore", deletedBefore.Format(time.RFC3339)))
+	defer span.End()
+
+	expiredFilter := bson.M{"deletedAt": bson.M{"$lt": deletedBefore}}
+
+	categoryCursor, categoryCursorErr := r.categories().Find(ctx, expiredFilter)
+	if categoryCursorErr != nil {
+		tracer.AddSpanError(span, categoryCursorErr)
+		return nil, errors.Wrap(categoryCursorErr, "mongodb find expired categories")
+	}
+
+	var categoryDbModels []categoryDbModel
+	if allError := categoryCursor.All(ctx, &categoryDbModels); allError != nil {
+		tracer.AddSpanError(span, allError)
+		return nil, errors.Wrap(allError, "categories cursor iteration")
+	}
+
+	expiredCategoryIDs := make([]primitive.ObjectID, 0, len(categoryDbModels))
+	for _, categoryModel := range categoryDbModels {
+		expiredCategoryIDs = append(expiredCategoryIDs, categoryModel.ID)
+	}
+	lockedCategoryIDs, lockedErr := r.expenses().Distinct(ctx, "categoryId", bson.M{
+		"categoryId":       bson.M{"$in": expiredCategoryIDs},
+		"reconciliationId": bson.M{"$exists": true},
+	})
+	if lockedErr != nil {
+		tracer.AddSpanError(span, lockedErr)
+		return nil, errors.Wrap(lockedErr, "mongodb find categories of locked expenses")
+	}
+	categoryIDs := purgeableCategoryIDs(categoryDbModels, lockedCategoryIDs)
+
+	expenseFilter := bson.M{
+		"$or": []bson.M{
+			expiredFilter,
+			{"categoryId": bson.M{"$in": categoryIDs}},
+		},
+		"reconciliationId": bson.M{"$exists": false},
+	}
+	expenseDelResult, expenseDelErr := r.expenses().DeleteMany(ctx, expenseFilter)
+	if expenseDelErr != nil {
+		tracer.AddSpanError(span, expenseDelErr)
+		return nil, errors.Wrap(expenseDelErr, "mongodb purge expenses")
+	}
+
+	categoryDelResult, categoryDelErr := r.categories().DeleteMany(ctx, bson.M{"_id": bson.M{"$in": categoryIDs}})
+	if categoryDelErr != nil {
+		tracer.AddSpanError(span, categoryDelErr)
+		return nil, errors.Wrap(categoryDelErr, "mongodb purge categories")
+	}
+
+	result := &domain.DeleteResult{
+		DeleteCount: int(expenseDelResult.DeletedCount + categoryDelResult.DeletedCount),
+	}
+
+	return result, nil
+}
+
+// purgeableCategoryIDs returns IDs of the categories except the categories of locked expenses and their ancestors.
+func purgeableCategoryIDs(categories []categoryDbModel, lockedCategoryIDs []interface{}) []primitive.ObjectID {
+	lockedIDs := make(map[primitive.ObjectID]bool, len(lockedCategoryIDs))
+	for _, lockedID := range lockedCategoryIDs {
+		if objID, ok := lockedID.(primitive.ObjectID); ok {
+			lockedIDs[objID] = true
+		}
+	}
+
+	// Category path lists IDs of the category ancestors and of the category itself.
+	keptIDs := make(map[string]bool)
+	for _, category := range categories {
+		if lockedIDs[category.ID] {
+			for _, pathID := range strings.Split(category.Path, "|") {
+				keptIDs[pathID] = true
+			}
+		}
+	}
+
+	categoryIDs := make([]primitive.ObjectID, 0, len(categories))
+	for _, category := range categories {
+		if !keptIDs[category.ID.Hex()] {
+			categoryIDs = append(categoryIDs, category.ID)
+		}
+	}
+	return categoryIDs
+}
+
+// unmarshalTrashedExpense unmarshalls trashed expense MongoDB model into trash item.
+func (r TrashRepository) unmarshalTrashedExpense(expenseModel expenseDbModel) (*domain.TrashItem, error) {
+	if expenseModel.DeletedAt == nil {
+		return nil, errors.Errorf("expense %s is not in the trash", expenseModel.ID.Hex())
+	}
+
+	name := fmt.Sprintf("%s %s", strconv.FormatFloat(expenseModel.Price, 'f', -1, 64), expenseModel.Currency)
+	if expenseModel.Comment != nil && len(*expenseModel.Comment) != 0 {
+		name = *expenseModel.Comment
+	}
+
+	var deletedBy string
+	if expenseModel.DeletedBy != nil {
+		deletedBy = *expenseModel.DeletedBy
+	}
+
+	item, itemErr := domain.NewTrashItem(expenseModel.ID.Hex(), domain.TrashItemTypeExpense, name,
+		nil, deletedBy, *expenseModel.DeletedAt)
+	if itemErr != nil {
+		return nil, errors.Wrap(itemErr, "unmarshal trashed expense")
+	}
+
+	return item, nil
+}
+
+// unmarshalTrashedCategory unmarshalls trashed category MongoDB model into trash item.
+func (r TrashRepository) unmarshalTrashedCategory(categoryModel categoryDbModel) (*domain.TrashItem, error) {
+	if categoryModel.DeletedAt == nil {
+		return nil, errors.Errorf("category %s is not in the trash", categoryModel.ID.Hex())
+	}
+
+	var parentID *string
+	if categoryModel.ParentID != nil && !categoryModel.ParentID.IsZero() {
+		parentIDHex := categoryModel.ParentID.Hex()
+		parentID = &parentIDHex
+	}
+
+	var deletedBy string
+	if categoryModel.DeletedBy != nil {
+		deletedBy = *categoryModel.DeletedBy
+	}
+
+	item, itemErr := domain.NewTrashItem(categoryModel.ID.Hex(), domain.TrashItemTypeCategory, categoryModel.Name,
+		parentID, deletedBy, *categoryModel.DeletedAt)
+	if itemErr != nil {
+		return nil, errors.Wrap(itemErr, "unmarshal trashed category")
+	}
+
+	return item, nil
+}
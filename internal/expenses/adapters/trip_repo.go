@@ -0,0 +1,337 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/database"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+const tripsCollectionName string = "trips"
+
+type tripDbModel struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	Name         string             `bson:"name"`
+	From         time.Time          `bson:"from"`
+	To           time.Time          `bson:"to"`
+	HomeCurrency string             `bson:"homeCurrency"`
+	Participants []string           `bson:"participants"`
+}
+
+type legacyTripDbModel struct {
+	ID struct {
+		Name     string `bson:"name"`
+		Currency string `bson:"currency"`
+	} `bson:"_id"`
+	From  time.Time `bson:"from"`
+	To    time.Time `bson:"to"`
+	Count int       `bson:"count"`
+}
+
+// TripRepository represents a struct to access trips MongoDB collection.
+type TripRepository struct {
+	client *database.MongoClient
+	logger logger.LogInterface
+}
+
+// TripRepoInterface defines a contract to persist trips in the database.
+type TripRepoInterface interface {
+	GetAll(ctx context.Context) ([]domain.Trip, error)
+	GetOne(ctx context.Context, id string) (*domain.Trip, error)
+	Insert(ctx context.Context, trip domain.Trip) (*string, error)
+	Update(ctx context.Context, trip domain.Trip) (*domain.UpdateResult, error)
+	DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error)
+	GetLegacyTrips(ctx context.Context) ([]domain.LegacyTripUsage, error)
+	LinkLegacyTrips(ctx context.Context, names []string, tripID string) (*domain.UpdateResult, error)
+}
+
+// NewTripRepo returns a TripRepository.
+func NewTripRepo(client *database.MongoClient, logger logger.LogInterface) *TripRepository {
+	return &TripRepository{
+		logger: logger,
+		client: client,
+	}
+}
+
+// collection returns collection handle.
+func (r *TripRepository) collection() *mongo.Collection {
+	return r.client.Collection(tripsCollectionName)
+}
+
+// GetAll returns all trips from the database sorted by start date.
+func (r *TripRepository) GetAll(ctx context.Context) ([]domain.Trip, error) {
+	ctx, span := tracer.NewSpan(ctx, "find trips in the database")
+	defer span.End()
+
+	opts := options.Find().SetSort(bson.D{{Key: "from", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, findErr := r.collection().Find(ctx, bson.M{}, opts)
+	if findErr != nil {
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "mongodb find trips")
+	}
+
+	var tripDbModels []tripDbModel
+	if allErr := cursor.All(ctx, &tripDbModels); allErr != nil {
+		tracer.AddSpanError(span, allErr)
+		return nil, errors.Wrap(allErr, "cursor iteration")
+	}
+
+	trips := make([]domain.Trip, 0, len(tripDbModels))
+	for _, dbModel := range tripDbModels {
+		trip, tripErr := r.unmarshalTrip(dbModel)
+		if tripErr != nil {
+			return nil, tripErr
+		}
+		trips = append(trips, *trip)
+	}
+
+	return trips, nil
+}
+
+// GetOne returns a single trip from the database.
+func (r *TripRepository) GetOne(ctx context.Context, id string) (*domain.Trip, error) {
+	ctx, span := tracer.NewSpan(ctx, "find trip in the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(id)
+	if objIDErr != nil {
+		return nil, nil
+	}
+
+	dbModel := tripDbModel{}
+	findErr := r.collection().FindOne(ctx, bson.M{"_id": objID}).Decode(&dbModel)
+	if findErr != nil {
+		if errors.Is(findErr, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		tracer.AddSpanError(span, findErr)
+		return nil, errors.Wrap(findErr, "find trip")
+	}
+
+	return r.unmarshalTrip(dbModel)
+}
+
+// Insert inserts a new trip into the database.
+func (r *TripRepository) Insert(ctx context.Context, trip domain.Trip) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "add trip to the database")
+	defer span.End()
+
+	insRes, insErr := r.collection().InsertOne(ctx, r.marshalTrip(trip))
+	if insErr != nil {
+		tracer.AddSpanError(span, insErr)
+		return nil, errors.Wrap(insErr, "mongodb insert trip")
+	}
+
+	objID, _ := insRes.InsertedID.(primitive.ObjectID)
+	objIDString := objID.Hex()
+
+	return &objIDString, nil
+}
+
+// Update updates a trip in the database.
+func (r *TripRepository) Update(ctx context.Context, trip domain.Trip) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "update trip in the database")
+	span.SetAttributes(attribute.String("id", trip.ID()))
+	defer span.End()
+
+	dbModel := r.marshalTrip(trip)
+	updResult, updErr := r.collection().UpdateOne(ctx, bson.M{"_id": dbModel.ID}, bson.M{"$set": dbModel})
+	if updErr != nil {
+		tracer.AddSpanError(span, updErr)
+		return nil, errors.Wrap(updErr, "mongodb update trip")
+	}
+
+	result := &domain.UpdateResult{
+		UpdateCount: int(updResult.ModifiedCount),
+	}
+
+	return result, nil
+}
+
+// DeleteOne deletes a single trip from the database. Expenses and recurring expenses
+// of the trip are kept, they are detached from the trip. The trip is not deleted while any expense
+// locked by reconciliation belongs to it.
+func (r *TripRepository) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "delete trip from the database")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+
+	objID, _ := primitive.ObjectIDFromHex(id)
+	result := &domain.DeleteResult{}
+	txErr := r.client.WithTransaction(ctx, func(ctx context.Context) error {
+		lockedCount, countErr := r.client.Collection(expenseCollectionName).CountDocuments(ctx,
+			bson.M{"tripId": objID, "reconciliationId": bson.M{"$exists": true}})
+		if countErr != nil {
+			return errors.Wrap(countErr, "mongodb count locked expenses")
+		}
+		if lockedCount > 0 {
+			return errors.Wrapf(domain.ErrExpenseLocked, "%d expenses of the trip", lockedCount)
+		}
+
+		delResult, delErr := r.collection().DeleteOne(ctx, bson.M{"_id": objID})
+		if delErr != nil {
+			return errors.Wrap(delErr, "mongodb delete trip")
+		}
+		result.DeleteCount = int(delResult.DeletedCount)
+		if delResult.DeletedCount == 0 {
+			return nil
+		}
+
+		for _, collectionName := range []string{expenseCollectionName, recurringExpensesCollectionName} {
+			_, updErr := r.client.Collection(collectionName).UpdateMany(ctx,
+				bson.M{"tripId": objID}, bson.M{"$unset": bson.M{"tripId": ""}})
+			if updErr != nil {
+				return errors.Wrapf(updErr, "mongodb detach %s from trip", collectionName)
+			}
+		}
+		return nil
+	})
+	if txErr != nil {
+		tracer.AddSpanError(span, txErr)
+		return nil, txErr
+	}
+
+	return result, nil
+}
+
+// GetLegacyTrips returns usage of free-text trip names by expenses and recurring expenses
+// which are not migrated to trip references yet.
+func (r *TripRepository) GetLegacyTrips(ctx context.Context) ([]domain.LegacyTripUsage, error) {
+	ctx, span := tracer.NewSpan(ctx, "find legacy trips in the database")
+	defer span.End()
+
+	dateFields := map[string]string{
+		expenseCollectionName:           "$date",
+		recurringExpensesCollectionName: "$schedule.start",
+	}
+
+	usages := make([]domain.LegacyTripUsage, 0)
+	for _, collectionName := range []string{expenseCollectionName, recurringExpensesCollectionName} {
+		operations := []bson.M{
+			{"$match": bson.M{"trip": bson.M{"$type": "string"}}},
+			{"$group": bson.M{
+				"_id":   bson.M{"name": "$trip", "currency": "$currency"},
+				"from":  bson.M{"$min": dateFields[collectionName]},
+				"to":    bson.M{"$max": dateFields[collectionName]},
+				"count": bson.M{"$sum": 1},
+			}},
+		}
+
+		cursor, cursorErr := r.client.Collection(collectionName).Aggregate(ctx, operations)
+		if cursorErr != nil {
+			tracer.AddSpanError(span, cursorErr)
+			return nil, errors.Wrapf(cursorErr, "mongodb aggregate legacy trips of %s", collectionName)
+		}
+
+		var legacyDbModels []legacyTripDbModel
+		if allErr := cursor.All(ctx, &legacyDbModels); allErr != nil {
+			tracer.AddSpanError(span, allErr)
+			return nil, errors.Wrap(allErr, "cursor iteration")
+		}
+
+		for _, dbModel := range legacyDbModels {
+			usages = append(usages, domain.LegacyTripUsage{
+				Name:     dbModel.ID.Name,
+				Currency: dbModel.ID.Currency,
+				From:     dbModel.From,
+				To:       dbModel.To,
+				Count:    dbModel.Count,
+			})
+		}
+	}
+
+	return usages, nil
+}
+
+// LinkLegacyTrips replaces free-text trip names of expenses and recurring expenses with the trip reference.
+func (r *TripRepository) LinkLegacyTrips(
+	ctx context.Context,
+	names []string,
+	tripID string,
+) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "link legacy trips in the database")
+	span.SetAttributes(attribute.String("tripId", tripID))
+	defer span.End()
+
+	objID, objIDErr := primitive.ObjectIDFromHex(tripID)
+	if objIDErr != nil {
+		return nil, errors.Wrap(objIDErr, "invalid trip id")
+	}
+
+	filter := bson.M{"trip": bson.M{"$in": names}}
+	updater := bson.M{
+		"$set":   bson.M{"tripId": objID},
+		"$unset": bson.M{"trip": ""},
+	}
+
+	result := &domain.UpdateResult{}
+	for _, collectionName := range []string{expenseCollectionName, recurringExpensesCollectionName} {
+		updResult, updErr := r.client.Collection(collectionName).UpdateMany(ctx, filter, updater)
+		if updErr != nil {
+			tracer.AddSpanError(span, updErr)
+			return nil, errors.Wrapf(updErr, "mongodb link legacy trips of %s", collectionName)
+		}
+		result.UpdateCount += int(updResult.ModifiedCount)
+	}
+
+	return result, nil
+}
+
+func (r TripRepository) marshalTrip(trip domain.Trip) tripDbModel {
+	id, _ := primitive.ObjectIDFromHex(trip.ID())
+
+	return tripDbModel{
+		ID:           id,
+		Name:         trip.Name(),
+		From:         trip.From(),
+		To:           trip.To(),
+		HomeCurrency: string(trip.HomeCurrency()),
+		Participants: trip.Participants(),
+	}
+}
+
+func (r TripRepository) unmarshalTrip(dbModel tripDbModel) (*domain.Trip, error) {
+	trip, tripErr := domain.NewTrip(dbModel.ID.Hex(), domain.TripParams{
+		Name:         dbModel.Name,
+		From:         dbModel.From,
+		To:           dbModel.To,
+		HomeCurrency: dbModel.HomeCurrency,
+		Participants: dbModel.Participants,
+	})
+	if tripErr != nil {
+		return nil, errors.Wrap(tripErr, "unmarshal trip")
+	}
+	return trip, nil
+}
+
+// marshalTripID converts an optional trip id to a trip reference, invalid ids are dropped.
+func marshalTripID(tripID *string) *primitive.ObjectID {
+	if tripID == nil {
+		return nil
+	}
+	objID, objIDErr := primitive.ObjectIDFromHex(*tripID)
+	if objIDErr != nil {
+		return nil
+	}
+	return &objID
+}
+
+// unmarshalTripID converts an optional trip reference to a trip id.
+func unmarshalTripID(tripID *primitive.ObjectID) *string {
+	if tripID == nil {
+		return nil
+	}
+	hex := tripID.Hex()
+	return &hex
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddAccountCommand defines an account command.
+type AddAccountCommand struct {
+	Name           string
+	Type           string
+	Currency       string
+	OpeningBalance float64
+}
+
+// AddAccountHandler defines a handler to add account.
+type AddAccountHandler struct {
+	repo   adapters.AccountRepoInterface
+	logger logger.LogInterface
+}
+
+// AddAccountHandlerInterface defines a contract to handle command.
+type AddAccountHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddAccountCommand) (*string, error)
+}
+
+// NewAddAccountHandler returns command handler.
+func NewAddAccountHandler(
+	repo adapters.AccountRepoInterface,
+	logger logger.LogInterface,
+) AddAccountHandler {
+	return AddAccountHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles add account command.
+func (h AddAccountHandler) Handle(ctx context.Context, cmd AddAccountCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add account command")
+	defer span.End()
+
+	account, accountErr := domain.NewAccount("", domain.AccountParams{
+		Name:           cmd.Name,
+		Type:           cmd.Type,
+		Currency:       cmd.Currency,
+		OpeningBalance: cmd.OpeningBalance,
+	})
+	if accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		return nil, errors.Wrap(domain.ErrInvalidAccount, accountErr.Error())
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *account)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert account")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,76 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAccount(id string, currency string) *domain.Account {
+	account, _ := domain.NewAccount(id, domain.AccountParams{Name: "Joint card", Type: "card", Currency: currency})
+	return account
+}
+
+func TestNewAddAccountHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddAccountHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddAccountHandler_InvalidAccount_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	// SUT
+	sut := command.NewAddAccountHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.AddAccountCommand{Name: "Wallet", Type: "crypto", Currency: "EUR"})
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidAccount, "Should return invalid account error.")
+}
+
+func TestAddAccountHandler_ValidAccount_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	id := "accountId"
+
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(account domain.Account) bool {
+		return account.Name() == "Wallet" && account.Type() == domain.AccountTypeCash && account.OpeningBalance() == 50
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddAccountHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.AddAccountCommand{
+		Name: "Wallet", Type: "cash", Currency: "EUR", OpeningBalance: 50,
+	})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, id, *result, "Should return account ID.")
+}
@@ -0,0 +1,155 @@
+package command
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddAttachmentCommand defines a command to attach a file to an expense.
+type AddAttachmentCommand struct {
+	ExpenseID string
+	FileName  string
+	Content   io.Reader
+	CreatedBy string
+}
+
+// AddAttachmentHandler defines a handler to add attachments.
+type AddAttachmentHandler struct {
+	expenseRepo    adapters.ExpenseRepoInterface
+	attachmentRepo adapters.AttachmentRepoInterface
+	blobStore      adapters.BlobStore
+	thumbnails     adapters.ThumbnailGeneratorInterface
+	maxSize        int64
+	logger         logger.LogInterface
+}
+
+// AddAttachmentHandlerInterface defines a contract to handle command.
+type AddAttachmentHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddAttachmentCommand) (*string, error)
+}
+
+// NewAddAttachmentHandler returns command handler. Attachments larger than the max size in bytes are rejected.
+func NewAddAttachmentHandler(
+	expenseRepo adapters.ExpenseRepoInterface,
+	attachmentRepo adapters.AttachmentRepoInterface,
+	blobStore adapters.BlobStore,
+	thumbnails adapters.ThumbnailGeneratorInterface,
+	maxSize int64,
+	logger logger.LogInterface,
+) AddAttachmentHandler {
+	return AddAttachmentHandler{
+		expenseRepo:    expenseRepo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		thumbnails:     thumbnails,
+		maxSize:        maxSize,
+		logger:         logger,
+	}
+}
+
+// Handle handles add attachment command. The content type is detected from the content itself,
+// images get a thumbnail. Blobs are removed again when the attachment could not be saved.
+func (h AddAttachmentHandler) Handle(ctx context.Context, cmd AddAttachmentCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add attachment command")
+	span.SetAttributes(attribute.String("expenseId", cmd.ExpenseID))
+	defer span.End()
+
+	expense, expenseErr := h.expenseRepo.GetOne(ctx, cmd.ExpenseID)
+	if expenseErr != nil {
+		tracer.AddSpanError(span, expenseErr)
+		return nil, errors.Wrap(expenseErr, "find expense")
+	}
+	if expense == nil {
+		return nil, errors.Wrapf(domain.ErrExpenseNotFound, "expense %s", cmd.ExpenseID)
+	}
+
+	content, readErr := ioutil.ReadAll(io.LimitReader(cmd.Content, h.maxSize+1))
+	if readErr != nil {
+		tracer.AddSpanError(span, readErr)
+		return nil, errors.Wrap(readErr, "read attachment")
+	}
+	if int64(len(content)) > h.maxSize {
+		return nil, errors.Wrapf(domain.ErrInvalidAttachment, "attachment exceeds %d bytes", h.maxSize)
+	}
+
+	contentType, contentTypeErr := domain.ParseAttachmentContentType(http.DetectContentType(content))
+	if contentTypeErr != nil {
+		return nil, errors.Wrap(domain.ErrInvalidAttachment, contentTypeErr.Error())
+	}
+
+	blobKey := fmt.Sprintf("%s/%s", expense.ID(), uuid.NewString())
+	var thumbnailKey *string
+	var thumbnail []byte
+	if domain.IsImageContentType(contentType) {
+		var thumbnailErr error
+		thumbnail, thumbnailErr = h.thumbnails.Generate(content)
+		if thumbnailErr != nil {
+			return nil, errors.Wrap(domain.ErrInvalidAttachment, thumbnailErr.Error())
+		}
+		key := blobKey + "-thumbnail"
+		thumbnailKey = &key
+	}
+
+	attachment, attachmentErr := domain.NewAttachment("", domain.AttachmentParams{
+		ExpenseID:    expense.ID(),
+		FileName:     cmd.FileName,
+		ContentType:  contentType,
+		Size:         int64(len(content)),
+		BlobKey:      blobKey,
+		ThumbnailKey: thumbnailKey,
+		CreatedBy:    cmd.CreatedBy,
+		CreatedAt:    time.Now(),
+	})
+	if attachmentErr != nil {
+		return nil, errors.Wrap(domain.ErrInvalidAttachment, attachmentErr.Error())
+	}
+
+	if putErr := h.putBlobs(ctx, *attachment, content, thumbnail); putErr != nil {
+		tracer.AddSpanError(span, putErr)
+		return nil, putErr
+	}
+
+	id, insertErr := h.attachmentRepo.Insert(ctx, *attachment)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		deleteBlobs(ctx, h.blobStore, h.logger, *attachment)
+		return nil, errors.Wrap(insertErr, "insert attachment")
+	}
+
+	return id, nil
+}
+
+// putBlobs uploads attachment content and thumbnail. Already uploaded blobs are deleted on failure.
+func (h AddAttachmentHandler) putBlobs(
+	ctx context.Context,
+	attachment domain.Attachment,
+	content []byte,
+	thumbnail []byte,
+) error {
+	if putErr := h.blobStore.Put(ctx, attachment.BlobKey(), bytes.NewReader(content)); putErr != nil {
+		return errors.Wrap(putErr, "put attachment blob")
+	}
+
+	if attachment.ThumbnailKey() != nil {
+		if putErr := h.blobStore.Put(ctx, *attachment.ThumbnailKey(), bytes.NewReader(thumbnail)); putErr != nil {
+			deleteBlobs(ctx, h.blobStore, h.logger, attachment)
+			return errors.Wrap(putErr, "put thumbnail blob")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+const (
+	pngContent string = "\x89PNG\r\n\x1a\n image"
+	pdfContent string = "%PDF-1.4 document"
+)
+
+type addAttachmentMocks struct {
+	expenseRepo    *mocks.ExpenseRepoInterface
+	attachmentRepo *mocks.AttachmentRepoInterface
+	blobStore      *mocks.BlobStore
+	thumbnails     *mocks.ThumbnailGeneratorInterface
+	log            *mocks.LogInterface
+}
+
+func newAddAttachmentMocks() addAttachmentMocks {
+	return addAttachmentMocks{
+		expenseRepo:    new(mocks.ExpenseRepoInterface),
+		attachmentRepo: new(mocks.AttachmentRepoInterface),
+		blobStore:      new(mocks.BlobStore),
+		thumbnails:     new(mocks.ThumbnailGeneratorInterface),
+		log:            new(mocks.LogInterface),
+	}
+}
+
+func (m addAttachmentMocks) handler(maxSize int64) command.AddAttachmentHandler {
+	return command.NewAddAttachmentHandler(m.expenseRepo, m.attachmentRepo, m.blobStore, m.thumbnails, maxSize, m.log)
+}
+
+func newAttachedExpense() *domain.Expense {
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "path")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil,
+		time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC))
+	return expense
+}
+
+func newAddAttachmentCommand(content string) command.AddAttachmentCommand {
+	return command.AddAttachmentCommand{
+		ExpenseID: "expenseId",
+		FileName:  "receipt",
+		Content:   strings.NewReader(content),
+		CreatedBy: "alice",
+	}
+}
+
+func TestNewAddAttachmentHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	m := newAddAttachmentMocks()
+
+	// Act
+	result := m.handler(1024)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddAttachmentHandler_ExpenseNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	m := newAddAttachmentMocks()
+	ctx := context.Background()
+
+	m.expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(nil, nil)
+
+	// SUT
+	sut := m.handler(1024)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddAttachmentCommand(pdfContent))
+
+	// Assert
+	m.blobStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrExpenseNotFound, "Should return expense not found error.")
+}
+
+func TestAddAttachmentHandler_TooLarge_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	m := newAddAttachmentMocks()
+	ctx := context.Background()
+
+	m.expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+
+	// SUT
+	sut := m.handler(8)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddAttachmentCommand(pdfContent))
+
+	// Assert
+	m.blobStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidAttachment, "Should return invalid attachment error.")
+}
+
+func TestAddAttachmentHandler_UnsupportedContent_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	m := newAddAttachmentMocks()
+	ctx := context.Background()
+
+	m.expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+
+	// SUT
+	sut := m.handler(1024)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddAttachmentCommand("<html></html>"))
+
+	// Assert
+	m.blobStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidAttachment, "Should return invalid attachment error.")
+}
+
+func TestAddAttachmentHandler_Document_SavesWithoutThumbnail(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	m := newAddAttachmentMocks()
+	ctx := context.Background()
+	id := "attachmentId"
+
+	matchFn := func(attachment domain.Attachment) bool {
+		return attachment.ExpenseID() == "expenseId" && attachment.ContentType() == "application/pdf" &&
+			attachment.Size() == int64(len(pdfContent)) && attachment.ThumbnailKey() == nil &&
+			strings.HasPrefix(attachment.BlobKey(), "expenseId/") && attachment.CreatedBy() == "alice"
+	}
+	m.expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+	m.blobStore.On("Put", mock.Anything, mock.AnythingOfType("string"), mock.Anything).Return(nil).Once()
+	m.attachmentRepo.On("Insert", mock.Anything, mock.MatchedBy(matchFn)).Return(&id, nil)
+
+	// SUT
+	sut := m.handler(1024)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddAttachmentCommand(pdfContent))
+
+	// Assert
+	m.blobStore.AssertExpectations(t)
+	m.attachmentRepo.AssertExpectations(t)
+	m.thumbnails.AssertNotCalled(t, "Generate", mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return attachment ID.")
+}
+
+func TestAddAttachmentHandler_Image_SavesThumbnail(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	m := newAddAttachmentMocks()
+	ctx := context.Background()
+	id := "attachmentId"
+
+	thumbnailFn := func(key string) bool { return strings.HasSuffix(key, "-thumbnail") }
+	matchFn := func(attachment domain.Attachment) bool {
+		return attachment.ContentType() == "image/png" && attachment.ThumbnailKey() != nil
+	}
+	m.expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+	m.thumbnails.On("Generate", []byte(pngContent)).Return([]byte("thumbnail"), nil)
+	m.blobStore.On("Put", mock.Anything, mock.MatchedBy(func(key string) bool { return !thumbnailFn(key) }),
+		mock.Anything).Return(nil)
+	m.blobStore.On("Put", mock.Anything, mock.MatchedBy(thumbnailFn), mock.Anything).Return(nil)
+	m.attachmentRepo.On("Insert", mock.Anything, mock.MatchedBy(matchFn)).Return(&id, nil)
+
+	// SUT
+	sut := m.handler(1024)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddAttachmentCommand(pngContent))
+
+	// Assert
+	m.thumbnails.AssertExpectations(t)
+	m.blobStore.AssertExpectations(t)
+	m.attachmentRepo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return attachment ID.")
+}
+
+func TestAddAttachmentHandler_RepoError_DeletesBlobs(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	m := newAddAttachmentMocks()
+	ctx := context.Background()
+
+	m.expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+	m.blobStore.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	m.blobStore.On("Delete", mock.Anything, mock.Anything).Return(nil).Once()
+	m.attachmentRepo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := m.handler(1024)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddAttachmentCommand(pdfContent))
+
+	// Assert
+	m.blobStore.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
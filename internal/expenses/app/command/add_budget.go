@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddBudgetCommand defines a budget command.
+type AddBudgetCommand struct {
+	CategoryID string
+	Period     string
+	Amount     float64
+	Currency   *string
+	Rollover   bool
+	Start      time.Time
+}
+
+// AddBudgetHandler defines a handler to add budget.
+type AddBudgetHandler struct {
+	repo         adapters.BudgetRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// AddBudgetHandlerInterface defines a contract to handle command.
+type AddBudgetHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddBudgetCommand) (*string, error)
+}
+
+// NewAddBudgetHandler returns command handler.
+func NewAddBudgetHandler(
+	repo adapters.BudgetRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) AddBudgetHandler {
+	return AddBudgetHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles add budget command. A category could have a single budget only.
+func (h AddBudgetHandler) Handle(ctx context.Context, cmd AddBudgetCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add budget command")
+	defer span.End()
+
+	budget, budgetErr := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: cmd.CategoryID,
+		Period:     cmd.Period,
+		Amount:     cmd.Amount,
+		Currency:   cmd.Currency,
+		Rollover:   cmd.Rollover,
+		Start:      cmd.Start,
+	})
+	if budgetErr != nil {
+		tracer.AddSpanError(span, budgetErr)
+		return nil, errors.Wrap(domain.ErrInvalidBudget, budgetErr.Error())
+	}
+
+	category, categoryErr := h.findCategory.Handle(ctx, query.FindCategoryQuery{CategoryID: cmd.CategoryID})
+	if categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, errors.Wrap(categoryErr, "get budget category")
+	}
+
+	if category == nil {
+		return nil, errors.Wrapf(domain.ErrCategoryNotFound, "category %s", cmd.CategoryID)
+	}
+
+	existing, existingErr := h.repo.GetByCategory(ctx, cmd.CategoryID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get category budget")
+	}
+
+	if existing != nil {
+		return nil, errors.Wrapf(domain.ErrBudgetExists, "category %s", cmd.CategoryID)
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *budget)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert budget")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,165 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAddBudgetCommand() command.AddBudgetCommand {
+	return command.AddBudgetCommand{
+		CategoryID: "categoryId",
+		Period:     "monthly",
+		Amount:     100,
+		Start:      time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewAddBudgetHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddBudgetHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddBudgetHandler_InvalidBudget_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddBudgetCommand()
+	cmd.Amount = 0
+
+	// SUT
+	sut := command.NewAddBudgetHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	findCategory.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidBudget, "Should return invalid budget error.")
+}
+
+func TestAddBudgetHandler_CategoryNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	// SUT
+	sut := command.NewAddBudgetHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddBudgetCommand())
+
+	// Assert
+	findCategory.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound, "Should return category not found error.")
+}
+
+func TestAddBudgetHandler_CategoryBudgetExists_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	existing, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     "yearly",
+		Amount:     1000,
+		Start:      time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("GetByCategory", mock.Anything, "categoryId").Return(existing, nil)
+
+	// SUT
+	sut := command.NewAddBudgetHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddBudgetCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrBudgetExists, "Should return budget exists error.")
+}
+
+func TestAddBudgetHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("GetByCategory", mock.Anything, "categoryId").Return(nil, nil)
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddBudgetHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddBudgetCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddBudgetHandler_RepoSuccess_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	id := "budgetId"
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("GetByCategory", mock.Anything, "categoryId").Return(nil, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(budget domain.Budget) bool {
+		return budget.CategoryID() == "categoryId" && budget.Period() == domain.BudgetPeriodMonthly
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddBudgetHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddBudgetCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return budget id.")
+}
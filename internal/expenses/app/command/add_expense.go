@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
 
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
@@ -13,48 +14,205 @@ import (
 )
 
 // AddExpenseCommand defines an expense command.
+// Expenses of recurring expense occurrences are added once per occurrence.
+// Shared expenses have both the paying user and the split set.
+// Expenses without a merchant are matched to merchants by comment.
+// Rules are applied to the expense before it is added.
+// Likely duplicates of the expense are returned as a warning, the expense is added anyway.
+// The expense is added even if the duplicates lookup fails, the result tells it was not checked.
 type AddExpenseCommand struct {
-	Category domain.Category
-	Price    float64
-	Quantity float64
-	Currency string
-	Date     time.Time
-	Comment  *string
-	Trip     *string
+	Category     domain.Category
+	Price        float64
+	Quantity     float64
+	Currency     string
+	Date         time.Time
+	Comment      *string
+	TripID       *string
+	MerchantID   *string
+	AccountID    *string
+	Tags         []string
+	PaidBy       *string
+	Split        *domain.SplitParams
+	Recurrence   *domain.Recurrence
+	Reimbursable bool
 }
 
 // AddExpenseHandler defines a handler to add expense.
 type AddExpenseHandler struct {
-	repo   adapters.ExpenseRepoInterface
-	logger logger.LogInterface
+	repo         adapters.ExpenseRepoInterface
+	merchantRepo adapters.MerchantRepoInterface
+	ruleRepo     adapters.RuleRepoInterface
+	categoryRepo adapters.ExpenseCategoryRepoInterface
+	logger       logger.LogInterface
 }
 
 // AddExpenseHandlerInterface defines a contract to handle command.
 type AddExpenseHandlerInterface interface {
-	Handle(ctx context.Context, cmd AddExpenseCommand) (*string, error)
+	Handle(ctx context.Context, cmd AddExpenseCommand) (*domain.AddExpenseResult, error)
 }
 
 // NewAddExpenseHandler returns command handler.
 func NewAddExpenseHandler(
 	repo adapters.ExpenseRepoInterface,
+	merchantRepo adapters.MerchantRepoInterface,
+	ruleRepo adapters.RuleRepoInterface,
+	categoryRepo adapters.ExpenseCategoryRepoInterface,
 	logger logger.LogInterface,
 ) AddExpenseHandler {
 	return AddExpenseHandler{
-		repo:   repo,
-		logger: logger,
+		repo:         repo,
+		merchantRepo: merchantRepo,
+		ruleRepo:     ruleRepo,
+		categoryRepo: categoryRepo,
+		logger:       logger,
 	}
 }
 
 // Handle handles add expense command.
-func (h AddExpenseHandler) Handle(ctx context.Context, cmd AddExpenseCommand) (*string, error) {
+func (h AddExpenseHandler) Handle(ctx context.Context, cmd AddExpenseCommand) (*domain.AddExpenseResult, error) {
 	ctx, span := tracer.NewSpan(ctx, "execute add expense command")
 	defer span.End()
 
+	opts := []func(*domain.Expense){domain.SetTags(cmd.Tags), domain.SetReimbursable(cmd.Reimbursable)}
+	if cmd.Recurrence != nil {
+		opts = append(opts, domain.SetRecurrence(*cmd.Recurrence))
+	}
+	splitOpts, splitErr := splitOptions(cmd.PaidBy, cmd.Split)
+	if splitErr != nil {
+		tracer.AddSpanError(span, splitErr)
+		return nil, errors.Wrap(domain.ErrInvalidExpense, splitErr.Error())
+	}
+	opts = append(opts, splitOpts...)
+
+	merchantID := cmd.MerchantID
+	if merchantID == nil && cmd.Comment != nil {
+		matcher, matcherErr := merchantMatcher(ctx, h.merchantRepo)
+		if matcherErr != nil {
+			tracer.AddSpanError(span, matcherErr)
+			return nil, matcherErr
+		}
+		if merchant := matcher.Match(*cmd.Comment); merchant != nil {
+			id := merchant.ID()
+			merchantID = &id
+		}
+	}
+	if merchantID != nil {
+		opts = append(opts, domain.SetMerchant(*merchantID))
+	}
+	if cmd.AccountID != nil {
+		opts = append(opts, domain.SetAccount(*cmd.AccountID))
+	}
+
 	expense, expenseErr := domain.NewExpense("", cmd.Category, cmd.Price, cmd.Currency, cmd.Quantity,
-		cmd.Comment, cmd.Trip, cmd.Date)
+		cmd.Comment, cmd.TripID, cmd.Date, opts...)
 	if expenseErr != nil {
-		return nil, errors.Wrap(expenseErr, "prepare expense failed")
+		tracer.AddSpanError(span, expenseErr)
+		return nil, errors.Wrap(domain.ErrInvalidExpense, expenseErr.Error())
 	}
 
-	return h.repo.Insert(ctx, *expense)
+	engine, engineErr := ruleEngine(ctx, h.ruleRepo, h.categoryRepo, h.merchantRepo)
+	if engineErr != nil {
+		tracer.AddSpanError(span, engineErr)
+		return nil, engineErr
+	}
+	applied, _ := engine.Apply(*expense)
+
+	duplicates, duplicatesErr := h.findDuplicates(ctx, applied)
+	if duplicatesErr != nil {
+		h.logger.Warnf(ctx, "Failed to find duplicates of the expense: %v. Skipping.", duplicatesErr)
+	}
+
+	expenseID, insertErr := h.repo.Insert(ctx, applied)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, insertErr
+	}
+
+	span.SetAttributes(attribute.Int("duplicates", len(duplicates)))
+
+	return &domain.AddExpenseResult{
+		ID:                   *expenseID,
+		Duplicates:           duplicates,
+		DuplicateCheckFailed: duplicatesErr != nil,
+	}, nil
+}
+
+// findDuplicates returns existing expenses which are likely duplicates of the expense, all candidates
+// within the duplicate window are checked.
+func (h AddExpenseHandler) findDuplicates(ctx context.Context, expense domain.Expense) ([]domain.Expense, error) {
+	criteria, criteriaErr := domain.NewDuplicateCriteria(domain.DuplicateCriteriaParams{})
+	if criteriaErr != nil {
+		return nil, criteriaErr
+	}
+
+	from, to := criteria.Window(expense.Date())
+	currency := expense.Currency()
+	limit := domain.MaxPageSize
+	sortBy := string(domain.SortFieldDate)
+	order := string(domain.SortOrderAsc)
+	filter, filterErr := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{
+		From:     &from,
+		To:       &to,
+		Currency: &currency,
+		SortBy:   &sortBy,
+		Order:    &order,
+		Limit:    &limit,
+	})
+	if filterErr != nil {
+		return nil, filterErr
+	}
+
+	candidates := make([]domain.Expense, 0)
+	for {
+		page, pageErr := h.repo.GetAll(ctx, *filter)
+		if pageErr != nil {
+			return nil, errors.Wrap(pageErr, "fetch expenses")
+		}
+		candidates = append(candidates, page.Expenses...)
+
+		if page.NextCursor == nil {
+			break
+		}
+		cursor, cursorErr := domain.DecodeExpenseCursor(*page.NextCursor)
+		if cursorErr != nil {
+			return nil, errors.Wrap(cursorErr, "decode next page cursor")
+		}
+		*filter = filter.WithCursor(*cursor)
+	}
+
+	duplicates := domain.NewDuplicateDetector(*criteria).Find(expense, candidates)
+	for index := range duplicates {
+		duplicates[index].CalculateTotal(nil)
+	}
+
+	return duplicates, nil
+}
+
+// splitOptions returns options sharing the expense, personal expenses have neither the paying user nor the split.
+func splitOptions(paidBy *string, params *domain.SplitParams) ([]func(*domain.Expense), error) {
+	if paidBy == nil && params == nil {
+		return nil, nil
+	}
+	if paidBy == nil || params == nil {
+		return nil, errors.New("shared expense should have both paying user and split")
+	}
+
+	split, splitErr := domain.NewSplit(*params)
+	if splitErr != nil {
+		return nil, splitErr
+	}
+
+	return []func(*domain.Expense){domain.SetSplit(*paidBy, *split)}, nil
+}
+
+// merchantMatcher returns a matcher of all merchants by their names and aliases.
+func merchantMatcher(ctx context.Context, repo adapters.MerchantRepoInterface) (*domain.MerchantMatcher, error) {
+	merchants, merchantsErr := repo.GetAll(ctx)
+	if merchantsErr != nil {
+		return nil, errors.Wrap(merchantsErr, "get merchants")
+	}
+
+	matcher := domain.NewMerchantMatcher(merchants)
+
+	return &matcher, nil
 }
@@ -20,9 +20,12 @@ func TestAddExpenseHandler_ReturnsHandler(t *testing.T) {
 	// Arrange
 	repo := new(mocks.ExpenseRepoInterface)
 	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
 
 	// Act
-	err := command.NewAddExpenseHandler(repo, log)
+	err := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
 
 	// Assert
 	assert.NotNil(t, err, "Error result should not be nil.")
@@ -33,12 +36,15 @@ func TestAddExpenseHandler_ExpenseError_ThrowsError(t *testing.T) {
 	// Arrange
 	repo := new(mocks.ExpenseRepoInterface)
 	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
 	ctx := context.Background()
 
 	cmd := command.AddExpenseCommand{}
 
 	// SUT
-	sut := command.NewAddExpenseHandler(repo, log)
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
 
 	// Act
 	query, err := sut.Handle(ctx, cmd)
@@ -54,6 +60,9 @@ func TestAddExpenseHandler_RepoError_ThrowsError(t *testing.T) {
 	// Arrange
 	repo := new(mocks.ExpenseRepoInterface)
 	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
 	ctx := context.Background()
 	comment := "comment"
 	parentID := "parentID"
@@ -72,11 +81,12 @@ func TestAddExpenseHandler_RepoError_ThrowsError(t *testing.T) {
 			cat.Price() == cmd.Price && cat.Currency() == cmd.Currency && cat.Quantity() == cmd.Quantity &&
 			cat.Comment() == cmd.Comment && cat.Date() == cmd.Date
 	}
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(&domain.ExpensePage{}, nil)
 	repo.On("Insert", mock.Anything,
 		mock.MatchedBy(matchExpenseFn)).Return(nil, errors.New("error"))
 
 	// SUT
-	sut := command.NewAddExpenseHandler(repo, log)
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
 
 	// Act
 	query, err := sut.Handle(ctx, cmd)
@@ -92,6 +102,9 @@ func TestAddExpenseHandler_RepoSuccess_ReturnsNewId(t *testing.T) {
 	// Arrange
 	repo := new(mocks.ExpenseRepoInterface)
 	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
 	ctx := context.Background()
 	expenseID := "expenseId"
 	comment := "comment"
@@ -111,11 +124,12 @@ func TestAddExpenseHandler_RepoSuccess_ReturnsNewId(t *testing.T) {
 			cat.Price() == cmd.Price && cat.Currency() == cmd.Currency && cat.Quantity() == cmd.Quantity &&
 			cat.Comment() == cmd.Comment && cat.Date() == cmd.Date
 	}
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(&domain.ExpensePage{}, nil)
 	repo.On("Insert", mock.Anything,
 		mock.MatchedBy(matchExpenseFn)).Return(&expenseID, nil)
 
 	// SUT
-	sut := command.NewAddExpenseHandler(repo, log)
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
 
 	// Act
 	query, err := sut.Handle(ctx, cmd)
@@ -123,6 +137,378 @@ func TestAddExpenseHandler_RepoSuccess_ReturnsNewId(t *testing.T) {
 	// Assert
 	repo.AssertExpectations(t)
 	assert.NotNil(t, query, "Result should not be nil.")
-	assert.Equal(t, &expenseID, query, "Should return expense id.")
+	assert.Equal(t, expenseID, query.ID, "Should return expense id.")
 	assert.Nil(t, err, "Error result should be nil.")
 }
+
+func TestAddExpenseHandler_Recurrence_LinksExpenseToOccurrence(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	category, _ := domain.NewCategory("categoryID", nil, "category", nil, 1, "|categoryID")
+	recurrence := domain.Recurrence{
+		RecurringExpenseID: "recurringExpenseId",
+		Date:               time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+	cmd := command.AddExpenseCommand{
+		Category:   *category,
+		Price:      500,
+		Currency:   "EUR",
+		Quantity:   1,
+		Date:       recurrence.Date,
+		Recurrence: &recurrence,
+	}
+
+	matchExpenseFn := func(expense domain.Expense) bool {
+		return expense.Recurrence() != nil && *expense.Recurrence() == recurrence
+	}
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(&domain.ExpensePage{}, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(matchExpenseFn)).Return(&expenseID, nil)
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Equal(t, expenseID, result.ID, "Should return expense id.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestAddExpenseHandler_Split_SharesExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	paidBy := "alice"
+	category, _ := domain.NewCategory("categoryID", nil, "category", nil, 1, "|categoryID")
+	cmd := command.AddExpenseCommand{
+		Category: *category,
+		Price:    30,
+		Currency: "EUR",
+		Quantity: 1,
+		Date:     time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+		PaidBy:   &paidBy,
+		Split: &domain.SplitParams{
+			Method: "equal",
+			Shares: []domain.SplitShareParams{{User: "alice"}, {User: "bob"}},
+		},
+	}
+
+	matchExpenseFn := func(expense domain.Expense) bool {
+		return expense.PaidBy() != nil && *expense.PaidBy() == paidBy &&
+			expense.Split() != nil && len(expense.Split().Shares()) == 2
+	}
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(&domain.ExpensePage{}, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(matchExpenseFn)).Return(&expenseID, nil)
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Equal(t, expenseID, result.ID, "Should return expense id.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestAddExpenseHandler_SplitWithoutPayer_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryID", nil, "category", nil, 1, "|categoryID")
+	cmd := command.AddExpenseCommand{
+		Category: *category,
+		Price:    30,
+		Currency: "EUR",
+		Quantity: 1,
+		Date:     time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+		Split: &domain.SplitParams{
+			Method: "equal",
+			Shares: []domain.SplitShareParams{{User: "alice"}, {User: "bob"}},
+		},
+	}
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidExpense, "Should return invalid expense error.")
+}
+
+func TestAddExpenseHandler_CommentMentionsMerchant_SetsMerchant(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo(newMerchant("shellId", "Shell"), newMerchant("reweId", "Rewe", "REWE Markt"))
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	comment := "Groceries at rewe markt"
+	category, _ := domain.NewCategory("categoryID", nil, "category", nil, 1, "|categoryID")
+	cmd := command.AddExpenseCommand{
+		Category: *category,
+		Price:    30,
+		Currency: "EUR",
+		Quantity: 1,
+		Comment:  &comment,
+		Date:     time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	matchExpenseFn := func(expense domain.Expense) bool {
+		return expense.MerchantID() != nil && *expense.MerchantID() == "reweId"
+	}
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(&domain.ExpensePage{}, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(matchExpenseFn)).Return(&expenseID, nil)
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Equal(t, expenseID, result.ID, "Should return expense id.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestAddExpenseHandler_ExplicitMerchant_SkipsMatching(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	comment := "Groceries at Rewe"
+	merchantID := "shellId"
+	category, _ := domain.NewCategory("categoryID", nil, "category", nil, 1, "|categoryID")
+	cmd := command.AddExpenseCommand{
+		Category:   *category,
+		Price:      30,
+		Currency:   "EUR",
+		Quantity:   1,
+		Comment:    &comment,
+		MerchantID: &merchantID,
+		Date:       time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	matchExpenseFn := func(expense domain.Expense) bool {
+		return expense.MerchantID() != nil && *expense.MerchantID() == merchantID
+	}
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(&domain.ExpensePage{}, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(matchExpenseFn)).Return(&expenseID, nil)
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	merchantRepo.AssertNotCalled(t, "GetAll", mock.Anything)
+	assert.Equal(t, expenseID, result.ID, "Should return expense id.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestAddExpenseHandler_RuleMatches_AppliesRule(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	tripID := "tripId"
+	ruleRepo := newRuleRepo(newRule("ruleId", "hotel", domain.RuleActions{TripID: &tripID, Reimbursable: true}))
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	comment := "Hotel Berlin"
+	category, _ := domain.NewCategory("categoryID", nil, "category", nil, 1, "|categoryID")
+	cmd := command.AddExpenseCommand{
+		Category: *category,
+		Price:    120,
+		Currency: "EUR",
+		Quantity: 1,
+		Comment:  &comment,
+		Tags:     []string{"travel"},
+		Date:     time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return([]domain.Category{*category}, nil)
+	matchExpenseFn := func(expense domain.Expense) bool {
+		return expense.TripID() != nil && *expense.TripID() == "tripId" && expense.Reimbursable() &&
+			expense.Category().ID() == "categoryID" && len(expense.Tags()) == 1
+	}
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(&domain.ExpensePage{}, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(matchExpenseFn)).Return(&expenseID, nil)
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Equal(t, expenseID, result.ID, "Should return expense id.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestAddExpenseHandler_LikelyDuplicate_ReturnsWarning(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	comment := "Dinner at Luigi"
+	partnerComment := "dinner"
+	date := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	logged, _ := domain.NewExpense("loggedId", *category, 45.5, "EUR", 1, &partnerComment, nil, date.AddDate(0, 0, -1))
+	other, _ := domain.NewExpense("otherId", *category, 12, "EUR", 1, &partnerComment, nil, date)
+	cmd := command.AddExpenseCommand{
+		Category: *category,
+		Price:    45.5,
+		Currency: "EUR",
+		Quantity: 1,
+		Comment:  &comment,
+		Date:     date,
+	}
+
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseListFilter) bool {
+		return filter.From().Equal(date.AddDate(0, 0, -domain.DefaultDuplicateDays)) &&
+			filter.To().Equal(date.AddDate(0, 0, domain.DefaultDuplicateDays)) && *filter.Currency() == "EUR"
+	})).Return(&domain.ExpensePage{Expenses: []domain.Expense{*logged, *other}}, nil)
+	repo.On("Insert", mock.Anything, mock.Anything).Return(&expenseID, nil)
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, expenseID, result.ID, "Should return expense id.")
+	assert.Len(t, result.Duplicates, 1, "Should return likely duplicates.")
+	assert.Equal(t, "loggedId", result.Duplicates[0].ID(), "Should return likely duplicates.")
+}
+
+func TestAddExpenseHandler_SeveralCandidatePages_ChecksAllPages(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	date := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	other, _ := domain.NewExpense("otherId", *category, 12, "EUR", 1, nil, nil, date.AddDate(0, 0, -1))
+	logged, _ := domain.NewExpense("loggedId", *category, 45.5, "EUR", 1, nil, nil, date)
+	cursor := domain.NewExpenseCursor(*other, domain.SortFieldDate).Encode()
+	cmd := command.AddExpenseCommand{
+		Category: *category,
+		Price:    45.5,
+		Currency: "EUR",
+		Quantity: 1,
+		Date:     date,
+	}
+
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseListFilter) bool {
+		return filter.Cursor() == nil
+	})).Return(&domain.ExpensePage{Expenses: []domain.Expense{*other}, NextCursor: &cursor}, nil).Once()
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseListFilter) bool {
+		return filter.Cursor() != nil
+	})).Return(&domain.ExpensePage{Expenses: []domain.Expense{*logged}}, nil).Once()
+	repo.On("Insert", mock.Anything, mock.Anything).Return(&expenseID, nil)
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result.Duplicates, 1, "Should return duplicates of the next page.")
+	assert.Equal(t, "loggedId", result.Duplicates[0].ID(), "Should return duplicates of the next page.")
+	assert.False(t, result.DuplicateCheckFailed, "Should check duplicates.")
+}
+
+func TestAddExpenseHandler_DuplicatesLookupFails_AddsExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	cmd := command.AddExpenseCommand{
+		Category: *category,
+		Price:    45.5,
+		Currency: "EUR",
+		Quantity: 1,
+		Date:     time.Now(),
+	}
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+	repo.On("Insert", mock.Anything, mock.Anything).Return(&expenseID, nil)
+	log.On("Warnf", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// SUT
+	sut := command.NewAddExpenseHandler(repo, merchantRepo, ruleRepo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	log.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, expenseID, result.ID, "Should return expense id.")
+	assert.Empty(t, result.Duplicates, "Should return no duplicates.")
+	assert.True(t, result.DuplicateCheckFailed, "Should tell duplicates were not checked.")
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddImportProfileCommand defines a command to save an import profile.
+type AddImportProfileCommand struct {
+	Profile domain.ImportProfileParams
+}
+
+// AddImportProfileHandler defines a handler to add import profile.
+type AddImportProfileHandler struct {
+	repo   adapters.ImportProfileRepoInterface
+	logger logger.LogInterface
+}
+
+// AddImportProfileHandlerInterface defines a contract to handle command.
+type AddImportProfileHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddImportProfileCommand) (*string, error)
+}
+
+// NewAddImportProfileHandler returns command handler.
+func NewAddImportProfileHandler(
+	repo adapters.ImportProfileRepoInterface,
+	logger logger.LogInterface,
+) AddImportProfileHandler {
+	return AddImportProfileHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles add import profile command.
+func (h AddImportProfileHandler) Handle(ctx context.Context, cmd AddImportProfileCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add import profile command")
+	defer span.End()
+
+	cmd.Profile.ID = ""
+	profile, profileErr := domain.NewImportProfile(cmd.Profile)
+	if profileErr != nil {
+		return nil, errors.Wrapf(domain.ErrInvalidImport, "prepare import profile: %s", profileErr)
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *profile)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert import profile")
+	}
+
+	return id, nil
+}
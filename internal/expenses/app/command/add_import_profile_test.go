@@ -0,0 +1,111 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func importProfileParams() domain.ImportProfileParams {
+	currency := "EUR"
+	return domain.ImportProfileParams{
+		Name: "bank",
+		Columns: domain.ImportColumns{
+			Date:     "Date",
+			Amount:   "Amount",
+			Category: "Category",
+		},
+		DateFormat:       "yyyy-MM-dd",
+		DecimalSeparator: ".",
+		DefaultCurrency:  &currency,
+	}
+}
+
+func TestNewAddImportProfileHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddImportProfileHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddImportProfileHandler_InvalidProfile_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	params := importProfileParams()
+	params.DateFormat = "dd.MM"
+	cmd := command.AddImportProfileCommand{Profile: params}
+
+	// SUT
+	sut := command.NewAddImportProfileHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.True(t, errors.Is(err, domain.ErrInvalidImport), "Should return invalid import error.")
+}
+
+func TestAddImportProfileHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.AddImportProfileCommand{Profile: importProfileParams()}
+
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddImportProfileHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddImportProfileHandler_ValidProfile_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.AddImportProfileCommand{Profile: importProfileParams()}
+	id := "profileId"
+
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(profile domain.ImportProfile) bool {
+		return profile.Name() == "bank" && profile.ID() == ""
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddImportProfileHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return inserted id.")
+}
@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddIncomeCommand defines a command to record income.
+type AddIncomeCommand struct {
+	Source     string
+	CategoryID string
+	Amount     float64
+	Currency   string
+	Date       time.Time
+	Comment    *string
+}
+
+// AddIncomeHandler defines a handler to add income.
+type AddIncomeHandler struct {
+	repo         adapters.IncomeRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// AddIncomeHandlerInterface defines a contract to handle command.
+type AddIncomeHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddIncomeCommand) (*string, error)
+}
+
+// NewAddIncomeHandler returns command handler.
+func NewAddIncomeHandler(
+	repo adapters.IncomeRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) AddIncomeHandler {
+	return AddIncomeHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles add income command.
+func (h AddIncomeHandler) Handle(ctx context.Context, cmd AddIncomeCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add income command")
+	defer span.End()
+
+	income, incomeErr := domain.NewIncome("", domain.IncomeParams{
+		Source:     cmd.Source,
+		CategoryID: cmd.CategoryID,
+		Amount:     cmd.Amount,
+		Currency:   cmd.Currency,
+		Date:       cmd.Date,
+		Comment:    cmd.Comment,
+	})
+	if incomeErr != nil {
+		tracer.AddSpanError(span, incomeErr)
+		return nil, errors.Wrap(domain.ErrInvalidIncome, incomeErr.Error())
+	}
+
+	if categoryErr := checkIncomeCategory(ctx, h.findCategory, *income); categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, categoryErr
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *income)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert income")
+	}
+
+	return id, nil
+}
+
+// checkIncomeCategory checks the category of the income exists and belongs to the income category tree.
+func checkIncomeCategory(
+	ctx context.Context,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	income domain.Income,
+) error {
+	category, categoryErr := findCategory.Handle(ctx, query.FindCategoryQuery{CategoryID: income.CategoryID()})
+	if categoryErr != nil {
+		return errors.Wrap(categoryErr, "get income category")
+	}
+
+	if category == nil {
+		return errors.Wrapf(domain.ErrCategoryNotFound, "category %s", income.CategoryID())
+	}
+
+	if !category.IsIncome() {
+		return errors.Wrapf(domain.ErrInvalidIncome, "category %s is not an income category", income.CategoryID())
+	}
+
+	return nil
+}
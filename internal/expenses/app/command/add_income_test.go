@@ -0,0 +1,161 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newIncomeCategory() *domain.Category {
+	category, _ := domain.NewCategory("salaryId", nil, "Salary", nil, 1, "|salaryId")
+	category.SetIncome(true)
+	return category
+}
+
+func newAddIncomeCommand() command.AddIncomeCommand {
+	return command.AddIncomeCommand{
+		Source:     "ACME",
+		CategoryID: "salaryId",
+		Amount:     3200,
+		Currency:   "EUR",
+		Date:       time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewAddIncomeHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddIncomeHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddIncomeHandler_InvalidIncome_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddIncomeCommand()
+	cmd.Amount = 0
+
+	// SUT
+	sut := command.NewAddIncomeHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidIncome, "Should return invalid income error.")
+}
+
+func TestAddIncomeHandler_CategoryNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	// SUT
+	sut := command.NewAddIncomeHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddIncomeCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound, "Should return category not found error.")
+}
+
+func TestAddIncomeHandler_ExpenseCategory_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("salaryId", nil, "Food", nil, 1, "|salaryId")
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+
+	// SUT
+	sut := command.NewAddIncomeHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddIncomeCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidIncome, "Should return invalid income error.")
+}
+
+func TestAddIncomeHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(newIncomeCategory(), nil)
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddIncomeHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddIncomeCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddIncomeHandler_RepoSuccess_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	id := "incomeId"
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(newIncomeCategory(), nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(income domain.Income) bool {
+		return income.Source() == "ACME" && income.CategoryID() == "salaryId"
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddIncomeHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddIncomeCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return income id.")
+}
@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddMerchantCommand defines a merchant command.
+type AddMerchantCommand struct {
+	Name              string
+	Aliases           []string
+	DefaultCategoryID *string
+	Location          *string
+}
+
+// AddMerchantHandler defines a handler to add merchant.
+type AddMerchantHandler struct {
+	repo         adapters.MerchantRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// AddMerchantHandlerInterface defines a contract to handle command.
+type AddMerchantHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddMerchantCommand) (*string, error)
+}
+
+// NewAddMerchantHandler returns command handler.
+func NewAddMerchantHandler(
+	repo adapters.MerchantRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) AddMerchantHandler {
+	return AddMerchantHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles add merchant command.
+func (h AddMerchantHandler) Handle(ctx context.Context, cmd AddMerchantCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add merchant command")
+	defer span.End()
+
+	merchant, merchantErr := domain.NewMerchant("", domain.MerchantParams{
+		Name:              cmd.Name,
+		Aliases:           cmd.Aliases,
+		DefaultCategoryID: cmd.DefaultCategoryID,
+		Location:          cmd.Location,
+	})
+	if merchantErr != nil {
+		tracer.AddSpanError(span, merchantErr)
+		return nil, errors.Wrap(domain.ErrInvalidMerchant, merchantErr.Error())
+	}
+
+	if categoryErr := checkMerchantCategory(ctx, h.findCategory, *merchant); categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, categoryErr
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *merchant)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert merchant")
+	}
+
+	return id, nil
+}
+
+// checkMerchantCategory checks the default category of the merchant exists.
+func checkMerchantCategory(
+	ctx context.Context,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	merchant domain.Merchant,
+) error {
+	if merchant.DefaultCategoryID() == nil {
+		return nil
+	}
+
+	categoryID := *merchant.DefaultCategoryID()
+	category, categoryErr := findCategory.Handle(ctx, query.FindCategoryQuery{CategoryID: categoryID})
+	if categoryErr != nil {
+		return errors.Wrap(categoryErr, "get merchant default category")
+	}
+
+	if category == nil {
+		return errors.Wrapf(domain.ErrCategoryNotFound, "category %s", categoryID)
+	}
+
+	return nil
+}
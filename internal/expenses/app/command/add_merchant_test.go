@@ -0,0 +1,139 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+// newMerchantRepo returns a merchant repository mock holding the merchants.
+func newMerchantRepo(merchants ...domain.Merchant) *mocks.MerchantRepoInterface {
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	merchantRepo.On("GetAll", mock.Anything).Return(merchants, nil)
+	return merchantRepo
+}
+
+func newAddMerchantCommand() command.AddMerchantCommand {
+	categoryID := "categoryId"
+	return command.AddMerchantCommand{
+		Name:              "Rewe",
+		Aliases:           []string{"REWE Markt"},
+		DefaultCategoryID: &categoryID,
+	}
+}
+
+func TestNewAddMerchantHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddMerchantHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddMerchantHandler_InvalidMerchant_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddMerchantCommand()
+	cmd.Name = " "
+
+	// SUT
+	sut := command.NewAddMerchantHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidMerchant, "Should return invalid merchant error.")
+}
+
+func TestAddMerchantHandler_CategoryNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	// SUT
+	sut := command.NewAddMerchantHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddMerchantCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound, "Should return category not found error.")
+}
+
+func TestAddMerchantHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddMerchantHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddMerchantCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddMerchantHandler_RepoSuccess_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	id := "merchantId"
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(merchant domain.Merchant) bool {
+		return merchant.Name() == "Rewe" && len(merchant.Aliases()) == 1
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddMerchantHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddMerchantCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return merchant id.")
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddReceiptCommand defines an itemized receipt command.
+// Line items share the receipt date and currency, line items without a comment get the receipt one.
+type AddReceiptCommand struct {
+	Date      time.Time
+	Comment   *string
+	Currency  string
+	Items     []AddReceiptItem
+	CreatedBy string
+}
+
+// AddReceiptItem defines a receipt line item.
+type AddReceiptItem struct {
+	Category domain.Category
+	Price    float64
+	Quantity float64
+	Comment  *string
+}
+
+// AddReceiptHandler defines a handler to add receipt.
+type AddReceiptHandler struct {
+	repo   adapters.ReceiptRepoInterface
+	logger logger.LogInterface
+}
+
+// AddReceiptHandlerInterface defines a contract to handle command.
+type AddReceiptHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddReceiptCommand) (*string, error)
+}
+
+// NewAddReceiptHandler returns command handler.
+func NewAddReceiptHandler(
+	repo adapters.ReceiptRepoInterface,
+	logger logger.LogInterface,
+) AddReceiptHandler {
+	return AddReceiptHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles add receipt command.
+func (h AddReceiptHandler) Handle(ctx context.Context, cmd AddReceiptCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add receipt command")
+	span.SetAttributes(attribute.Int("items", len(cmd.Items)))
+	defer span.End()
+
+	now := time.Now()
+	items := make([]domain.Expense, 0, len(cmd.Items))
+	for _, item := range cmd.Items {
+		comment := item.Comment
+		if comment == nil {
+			comment = cmd.Comment
+		}
+		expense, expenseErr := domain.NewExpense("", item.Category, item.Price, cmd.Currency, item.Quantity,
+			comment, nil, cmd.Date, domain.SetCreateMetadata(cmd.CreatedBy, now))
+		if expenseErr != nil {
+			tracer.AddSpanError(span, expenseErr)
+			return nil, errors.Wrap(domain.ErrInvalidReceipt, expenseErr.Error())
+		}
+		items = append(items, *expense)
+	}
+
+	receipt, receiptErr := domain.NewReceipt("", domain.ReceiptParams{
+		Date:     cmd.Date,
+		Comment:  cmd.Comment,
+		Currency: cmd.Currency,
+	}, items)
+	if receiptErr != nil {
+		tracer.AddSpanError(span, receiptErr)
+		return nil, errors.Wrap(domain.ErrInvalidReceipt, receiptErr.Error())
+	}
+
+	return h.repo.Insert(ctx, *receipt)
+}
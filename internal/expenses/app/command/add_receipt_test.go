@@ -0,0 +1,128 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAddReceiptCommand() command.AddReceiptCommand {
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	home, _ := domain.NewCategory("homeId", nil, "Home", nil, 1, "|homeId")
+	comment := "Grocery store"
+	soapComment := "Soap"
+	return command.AddReceiptCommand{
+		Date:     time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		Comment:  &comment,
+		Currency: "EUR",
+		Items: []command.AddReceiptItem{
+			{Category: *food, Price: 1.5, Quantity: 2},
+			{Category: *home, Price: 3, Quantity: 1, Comment: &soapComment},
+		},
+		CreatedBy: "alice",
+	}
+}
+
+func TestNewAddReceiptHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReceiptRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddReceiptHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddReceiptHandler_InvalidCommand_ThrowsInvalidReceiptError(t *testing.T) {
+	t.Parallel()
+	cases := map[string]func(cmd *command.AddReceiptCommand){
+		"no items":       func(cmd *command.AddReceiptCommand) { cmd.Items = nil },
+		"empty currency": func(cmd *command.AddReceiptCommand) { cmd.Currency = "" },
+		"invalid item":   func(cmd *command.AddReceiptCommand) { cmd.Items[0].Quantity = 0 },
+	}
+
+	for name, modify := range cases {
+		modify := modify
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			repo := new(mocks.ReceiptRepoInterface)
+			log := new(mocks.LogInterface)
+			ctx := context.Background()
+			cmd := newAddReceiptCommand()
+			modify(&cmd)
+
+			// SUT
+			sut := command.NewAddReceiptHandler(repo, log)
+
+			// Act
+			result, err := sut.Handle(ctx, cmd)
+
+			// Assert
+			repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+			assert.Nil(t, result, "Result should be nil.")
+			assert.True(t, errors.Is(err, domain.ErrInvalidReceipt), "Should return invalid receipt error.")
+		})
+	}
+}
+
+func TestAddReceiptHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReceiptRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddReceiptHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddReceiptCommand())
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddReceiptHandler_ValidCommand_InsertsReceiptWithLineItems(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReceiptRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddReceiptCommand()
+	id := "receiptId"
+
+	matchReceiptFn := func(receipt domain.Receipt) bool {
+		items := receipt.Items()
+		return len(items) == 2 && receipt.Date() == cmd.Date && receipt.Currency() == "EUR" &&
+			items[0].Category().ID() == "foodId" && *items[0].Comment() == "Grocery store" &&
+			items[1].Category().ID() == "homeId" && *items[1].Comment() == "Soap" &&
+			items[0].Date() == cmd.Date && items[1].Currency() == "EUR" && items[0].CreatedBy() == "alice"
+	}
+	repo.On("Insert", mock.Anything, mock.MatchedBy(matchReceiptFn)).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddReceiptHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result)
+}
@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddReconciliationCommand defines a command to start reconciling an account against a bank statement.
+type AddReconciliationCommand struct {
+	AccountID        string
+	StatementDate    time.Time
+	StatementBalance float64
+}
+
+// AddReconciliationHandler defines a handler to start reconciliation.
+type AddReconciliationHandler struct {
+	repo        adapters.ReconciliationRepoInterface
+	accountRepo adapters.AccountRepoInterface
+	logger      logger.LogInterface
+}
+
+// AddReconciliationHandlerInterface defines a contract to handle command.
+type AddReconciliationHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddReconciliationCommand) (*string, error)
+}
+
+// NewAddReconciliationHandler returns command handler.
+func NewAddReconciliationHandler(
+	repo adapters.ReconciliationRepoInterface,
+	accountRepo adapters.AccountRepoInterface,
+	logger logger.LogInterface,
+) AddReconciliationHandler {
+	return AddReconciliationHandler{
+		repo:        repo,
+		accountRepo: accountRepo,
+		logger:      logger,
+	}
+}
+
+// Handle handles add reconciliation command. An account could have only one open reconciliation.
+func (h AddReconciliationHandler) Handle(ctx context.Context, cmd AddReconciliationCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add reconciliation command")
+	defer span.End()
+
+	reconciliation, reconciliationErr := domain.NewReconciliation("", domain.ReconciliationParams{
+		AccountID:        cmd.AccountID,
+		StatementDate:    cmd.StatementDate,
+		StatementBalance: cmd.StatementBalance,
+		CreatedAt:        time.Now(),
+	})
+	if reconciliationErr != nil {
+		tracer.AddSpanError(span, reconciliationErr)
+		return nil, errors.Wrap(domain.ErrInvalidReconciliation, reconciliationErr.Error())
+	}
+
+	account, accountErr := h.accountRepo.GetOne(ctx, reconciliation.AccountID())
+	if accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		return nil, errors.Wrap(accountErr, "get reconciliation account")
+	}
+
+	if account == nil {
+		return nil, errors.Wrapf(domain.ErrAccountNotFound, "account %s", reconciliation.AccountID())
+	}
+
+	open, openErr := h.repo.GetOpen(ctx, account.ID())
+	if openErr != nil {
+		tracer.AddSpanError(span, openErr)
+		return nil, errors.Wrap(openErr, "get open reconciliation")
+	}
+
+	if open != nil {
+		return nil, errors.Wrapf(domain.ErrInvalidReconciliation, "reconciliation %s is not finished yet", open.ID())
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *reconciliation)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert reconciliation")
+	}
+
+	return id, nil
+}
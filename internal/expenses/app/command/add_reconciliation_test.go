@@ -0,0 +1,118 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newReconciliation(expenseIDs ...string) *domain.Reconciliation {
+	reconciliation, _ := domain.NewReconciliation("reconciliationId", domain.ReconciliationParams{
+		AccountID:        "cardId",
+		StatementDate:    time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC),
+		StatementBalance: 90,
+		ExpenseIDs:       expenseIDs,
+	})
+	return reconciliation
+}
+
+func newAddReconciliationCommand() command.AddReconciliationCommand {
+	return command.AddReconciliationCommand{
+		AccountID:        "cardId",
+		StatementDate:    time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC),
+		StatementBalance: 90,
+	}
+}
+
+func TestNewAddReconciliationHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddReconciliationHandler(repo, accountRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddReconciliationHandler_AccountNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	accountRepo.On("GetOne", mock.Anything, "cardId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewAddReconciliationHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddReconciliationCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrAccountNotFound, "Should return account not found error.")
+}
+
+func TestAddReconciliationHandler_OpenReconciliationExists_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	accountRepo.On("GetOne", mock.Anything, "cardId").Return(newAccount("cardId", "EUR"), nil)
+	repo.On("GetOpen", mock.Anything, "cardId").Return(newReconciliation(), nil)
+
+	// SUT
+	sut := command.NewAddReconciliationHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddReconciliationCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReconciliation, "Should return invalid reconciliation error.")
+}
+
+func TestAddReconciliationHandler_ValidCommand_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	id := "reconciliationId"
+
+	accountRepo.On("GetOne", mock.Anything, "cardId").Return(newAccount("cardId", "EUR"), nil)
+	repo.On("GetOpen", mock.Anything, "cardId").Return(nil, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(reconciliation domain.Reconciliation) bool {
+		return reconciliation.Status() == domain.ReconciliationStatusOpen && reconciliation.StatementBalance() == 90
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddReconciliationHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddReconciliationCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return reconciliation id.")
+}
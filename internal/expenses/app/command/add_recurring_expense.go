@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddRecurringExpenseCommand defines a recurring expense command.
+type AddRecurringExpenseCommand struct {
+	CategoryID string
+	Price      float64
+	Quantity   float64
+	Currency   string
+	Comment    *string
+	TripID     *string
+	Schedule   domain.Schedule
+}
+
+// AddRecurringExpenseHandler defines a handler to add recurring expense.
+type AddRecurringExpenseHandler struct {
+	repo         adapters.RecurringExpenseRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// AddRecurringExpenseHandlerInterface defines a contract to handle command.
+type AddRecurringExpenseHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddRecurringExpenseCommand) (*string, error)
+}
+
+// NewAddRecurringExpenseHandler returns command handler.
+func NewAddRecurringExpenseHandler(
+	repo adapters.RecurringExpenseRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) AddRecurringExpenseHandler {
+	return AddRecurringExpenseHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles add recurring expense command.
+func (h AddRecurringExpenseHandler) Handle(ctx context.Context, cmd AddRecurringExpenseCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add recurring expense command")
+	defer span.End()
+
+	category, categoryErr := h.findCategory.Handle(ctx, query.FindCategoryQuery{CategoryID: cmd.CategoryID})
+	if categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, errors.Wrap(categoryErr, "get recurring expense category")
+	}
+
+	if category == nil {
+		return nil, errors.Wrapf(domain.ErrCategoryNotFound, "category %s", cmd.CategoryID)
+	}
+
+	recurringExpense, recurringErr := domain.NewRecurringExpense("", *category, cmd.Price, cmd.Currency,
+		cmd.Quantity, cmd.Comment, cmd.TripID, cmd.Schedule)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, errors.Wrap(domain.ErrInvalidExpense, recurringErr.Error())
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *recurringExpense)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert recurring expense")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,144 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAddRecurringExpenseCommand(price float64) command.AddRecurringExpenseCommand {
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency: "monthly",
+		Start:     time.Date(2021, time.January, 5, 0, 0, 0, 0, time.UTC),
+	})
+	return command.AddRecurringExpenseCommand{
+		CategoryID: "categoryId",
+		Price:      price,
+		Quantity:   1,
+		Currency:   "EUR",
+		Schedule:   *schedule,
+	}
+}
+
+func TestNewAddRecurringExpenseHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddRecurringExpenseHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddRecurringExpenseHandler_CategoryNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddRecurringExpenseCommand(500)
+
+	findCategory.On("Handle", mock.Anything, query.FindCategoryQuery{CategoryID: "categoryId"}).Return(nil, nil)
+
+	// SUT
+	sut := command.NewAddRecurringExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	findCategory.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound, "Should return category not found error.")
+}
+
+func TestAddRecurringExpenseHandler_InvalidExpense_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddRecurringExpenseCommand(0)
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+
+	// SUT
+	sut := command.NewAddRecurringExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidExpense, "Should return invalid expense error.")
+}
+
+func TestAddRecurringExpenseHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddRecurringExpenseCommand(500)
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddRecurringExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddRecurringExpenseHandler_RepoSuccess_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddRecurringExpenseCommand(500)
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+	id := "recurringId"
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(recurringExpense domain.RecurringExpense) bool {
+		return recurringExpense.Price() == 500 && recurringExpense.Category().ID() == "categoryId"
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddRecurringExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return recurring expense ID.")
+}
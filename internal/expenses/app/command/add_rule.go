@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddRuleCommand defines a rule command.
+type AddRuleCommand struct {
+	Name       string
+	Priority   int
+	Conditions domain.RuleConditions
+	Actions    domain.RuleActions
+}
+
+// AddRuleHandler defines a handler to add rule.
+type AddRuleHandler struct {
+	repo         adapters.RuleRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// AddRuleHandlerInterface defines a contract to handle command.
+type AddRuleHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddRuleCommand) (*string, error)
+}
+
+// NewAddRuleHandler returns command handler.
+func NewAddRuleHandler(
+	repo adapters.RuleRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) AddRuleHandler {
+	return AddRuleHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles add rule command.
+func (h AddRuleHandler) Handle(ctx context.Context, cmd AddRuleCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add rule command")
+	defer span.End()
+
+	rule, ruleErr := domain.NewRule("", domain.RuleParams{
+		Name:       cmd.Name,
+		Priority:   cmd.Priority,
+		Conditions: cmd.Conditions,
+		Actions:    cmd.Actions,
+	})
+	if ruleErr != nil {
+		tracer.AddSpanError(span, ruleErr)
+		return nil, errors.Wrap(domain.ErrInvalidRule, ruleErr.Error())
+	}
+
+	if categoryErr := checkRuleCategory(ctx, h.findCategory, *rule); categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, categoryErr
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *rule)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert rule")
+	}
+
+	return id, nil
+}
+
+// checkRuleCategory checks the category set by the rule exists.
+func checkRuleCategory(
+	ctx context.Context,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	rule domain.Rule,
+) error {
+	if rule.Actions().CategoryID == nil {
+		return nil
+	}
+
+	categoryID := *rule.Actions().CategoryID
+	category, categoryErr := findCategory.Handle(ctx, query.FindCategoryQuery{CategoryID: categoryID})
+	if categoryErr != nil {
+		return errors.Wrap(categoryErr, "get rule category")
+	}
+
+	if category == nil {
+		return errors.Wrapf(domain.ErrCategoryNotFound, "category %s", categoryID)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+// newRuleRepo returns a rule repository mock holding the rules.
+func newRuleRepo(rules ...domain.Rule) *mocks.RuleRepoInterface {
+	ruleRepo := new(mocks.RuleRepoInterface)
+	ruleRepo.On("GetAll", mock.Anything).Return(rules, nil)
+	return ruleRepo
+}
+
+func newAddRuleCommand() command.AddRuleCommand {
+	comment := "coffee"
+	categoryID := "categoryId"
+	return command.AddRuleCommand{
+		Name:       "Coffee",
+		Priority:   1,
+		Conditions: domain.RuleConditions{Comment: &comment},
+		Actions:    domain.RuleActions{CategoryID: &categoryID, Tags: []string{"drinks"}},
+	}
+}
+
+func TestNewAddRuleHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddRuleHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddRuleHandler_InvalidRule_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddRuleCommand()
+	cmd.Name = " "
+
+	// SUT
+	sut := command.NewAddRuleHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidRule, "Should return invalid rule error.")
+}
+
+func TestAddRuleHandler_CategoryNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	// SUT
+	sut := command.NewAddRuleHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddRuleCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound, "Should return category not found error.")
+}
+
+func TestAddRuleHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddRuleHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddRuleCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddRuleHandler_RepoSuccess_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	id := "ruleId"
+
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(rule domain.Rule) bool {
+		return rule.Name() == "Coffee" && *rule.Actions().CategoryID == "categoryId"
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddRuleHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddRuleCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return rule id.")
+}
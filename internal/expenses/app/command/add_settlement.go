@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddSettlementCommand defines a command to record a settle-up payment.
+type AddSettlementCommand struct {
+	From     string
+	To       string
+	Amount   float64
+	Currency string
+	Date     time.Time
+}
+
+// AddSettlementHandler defines a handler to add settlement.
+type AddSettlementHandler struct {
+	repo   adapters.SettlementRepoInterface
+	logger logger.LogInterface
+}
+
+// AddSettlementHandlerInterface defines a contract to handle command.
+type AddSettlementHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddSettlementCommand) (*string, error)
+}
+
+// NewAddSettlementHandler returns command handler.
+func NewAddSettlementHandler(
+	repo adapters.SettlementRepoInterface,
+	logger logger.LogInterface,
+) AddSettlementHandler {
+	return AddSettlementHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles add settlement command.
+func (h AddSettlementHandler) Handle(ctx context.Context, cmd AddSettlementCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add settlement command")
+	defer span.End()
+
+	settlement, settlementErr := domain.NewSettlement("", domain.SettlementParams{
+		From:     cmd.From,
+		To:       cmd.To,
+		Amount:   cmd.Amount,
+		Currency: cmd.Currency,
+		Date:     cmd.Date,
+	})
+	if settlementErr != nil {
+		tracer.AddSpanError(span, settlementErr)
+		return nil, errors.Wrap(domain.ErrInvalidSettlement, settlementErr.Error())
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *settlement)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert settlement")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,104 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAddSettlementCommand() command.AddSettlementCommand {
+	return command.AddSettlementCommand{
+		From:     "bob",
+		To:       "alice",
+		Amount:   25,
+		Currency: "EUR",
+		Date:     time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewAddSettlementHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettlementRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddSettlementHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddSettlementHandler_InvalidSettlement_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettlementRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddSettlementCommand()
+	cmd.To = cmd.From
+
+	// SUT
+	sut := command.NewAddSettlementHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidSettlement, "Should return invalid settlement error.")
+}
+
+func TestAddSettlementHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettlementRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddSettlementHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddSettlementCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddSettlementHandler_RepoSuccess_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettlementRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	id := "settlementId"
+
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(settlement domain.Settlement) bool {
+		return settlement.From() == "bob" && settlement.To() == "alice" && settlement.Amount() == 25
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddSettlementHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddSettlementCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return settlement id.")
+}
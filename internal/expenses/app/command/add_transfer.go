@@ -0,0 +1,106 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddTransferCommand defines a transfer command. The amount is in the currency of the source account,
+// the rate is required when the accounts have different currencies.
+type AddTransferCommand struct {
+	FromAccountID string
+	ToAccountID   string
+	Amount        float64
+	Rate          *float64
+	Date          time.Time
+	Comment       *string
+}
+
+// AddTransferHandler defines a handler to add transfer.
+type AddTransferHandler struct {
+	repo        adapters.TransferRepoInterface
+	accountRepo adapters.AccountRepoInterface
+	logger      logger.LogInterface
+}
+
+// AddTransferHandlerInterface defines a contract to handle command.
+type AddTransferHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddTransferCommand) (*string, error)
+}
+
+// NewAddTransferHandler returns command handler.
+func NewAddTransferHandler(
+	repo adapters.TransferRepoInterface,
+	accountRepo adapters.AccountRepoInterface,
+	logger logger.LogInterface,
+) AddTransferHandler {
+	return AddTransferHandler{
+		repo:        repo,
+		accountRepo: accountRepo,
+		logger:      logger,
+	}
+}
+
+// Handle handles add transfer command.
+func (h AddTransferHandler) Handle(ctx context.Context, cmd AddTransferCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add transfer command")
+	defer span.End()
+
+	transfer, transferErr := domain.NewTransfer("", domain.TransferParams{
+		FromAccountID: cmd.FromAccountID,
+		ToAccountID:   cmd.ToAccountID,
+		Amount:        cmd.Amount,
+		Rate:          cmd.Rate,
+		Date:          cmd.Date,
+		Comment:       cmd.Comment,
+	})
+	if transferErr != nil {
+		tracer.AddSpanError(span, transferErr)
+		return nil, errors.Wrap(domain.ErrInvalidTransfer, transferErr.Error())
+	}
+
+	from, fromErr := h.account(ctx, transfer.FromAccountID())
+	if fromErr != nil {
+		tracer.AddSpanError(span, fromErr)
+		return nil, fromErr
+	}
+	to, toErr := h.account(ctx, transfer.ToAccountID())
+	if toErr != nil {
+		tracer.AddSpanError(span, toErr)
+		return nil, toErr
+	}
+
+	if accountsErr := transfer.CheckAccounts(*from, *to); accountsErr != nil {
+		tracer.AddSpanError(span, accountsErr)
+		return nil, errors.Wrap(domain.ErrInvalidTransfer, accountsErr.Error())
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *transfer)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert transfer")
+	}
+
+	return id, nil
+}
+
+// account returns a transfer account, a missing account is an error.
+func (h AddTransferHandler) account(ctx context.Context, id string) (*domain.Account, error) {
+	account, accountErr := h.accountRepo.GetOne(ctx, id)
+	if accountErr != nil {
+		return nil, errors.Wrap(accountErr, "get transfer account")
+	}
+
+	if account == nil {
+		return nil, errors.Wrapf(domain.ErrAccountNotFound, "account %s", id)
+	}
+
+	return account, nil
+}
@@ -0,0 +1,135 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAddTransferCommand() command.AddTransferCommand {
+	return command.AddTransferCommand{
+		FromAccountID: "cardId",
+		ToAccountID:   "cashId",
+		Amount:        100,
+		Date:          time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewAddTransferHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TransferRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddTransferHandler(repo, accountRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddTransferHandler_InvalidTransfer_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TransferRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddTransferCommand()
+	cmd.ToAccountID = cmd.FromAccountID
+
+	// SUT
+	sut := command.NewAddTransferHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidTransfer, "Should return invalid transfer error.")
+}
+
+func TestAddTransferHandler_AccountNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TransferRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	accountRepo.On("GetOne", mock.Anything, "cardId").Return(newAccount("cardId", "EUR"), nil)
+	accountRepo.On("GetOne", mock.Anything, "cashId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewAddTransferHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddTransferCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrAccountNotFound, "Should return account not found error.")
+}
+
+func TestAddTransferHandler_MissingRate_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TransferRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	accountRepo.On("GetOne", mock.Anything, "cardId").Return(newAccount("cardId", "EUR"), nil)
+	accountRepo.On("GetOne", mock.Anything, "cashId").Return(newAccount("cashId", "USD"), nil)
+
+	// SUT
+	sut := command.NewAddTransferHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddTransferCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidTransfer, "Should return invalid transfer error.")
+}
+
+func TestAddTransferHandler_ValidTransfer_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TransferRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	id := "transferId"
+	rate := 1.2
+	cmd := newAddTransferCommand()
+	cmd.Rate = &rate
+
+	accountRepo.On("GetOne", mock.Anything, "cardId").Return(newAccount("cardId", "EUR"), nil)
+	accountRepo.On("GetOne", mock.Anything, "cashId").Return(newAccount("cashId", "USD"), nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(transfer domain.Transfer) bool {
+		return transfer.ReceivedAmount().String() == "120"
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddTransferHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, id, *result, "Should return transfer ID.")
+}
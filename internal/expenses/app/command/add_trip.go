@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AddTripCommand defines a trip command.
+type AddTripCommand struct {
+	Name         string
+	From         time.Time
+	To           time.Time
+	HomeCurrency string
+	Participants []string
+}
+
+// AddTripHandler defines a handler to add trip.
+type AddTripHandler struct {
+	repo   adapters.TripRepoInterface
+	logger logger.LogInterface
+}
+
+// AddTripHandlerInterface defines a contract to handle command.
+type AddTripHandlerInterface interface {
+	Handle(ctx context.Context, cmd AddTripCommand) (*string, error)
+}
+
+// NewAddTripHandler returns command handler.
+func NewAddTripHandler(
+	repo adapters.TripRepoInterface,
+	logger logger.LogInterface,
+) AddTripHandler {
+	return AddTripHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles add trip command.
+func (h AddTripHandler) Handle(ctx context.Context, cmd AddTripCommand) (*string, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute add trip command")
+	defer span.End()
+
+	trip, tripErr := domain.NewTrip("", domain.TripParams{
+		Name:         cmd.Name,
+		From:         cmd.From,
+		To:           cmd.To,
+		HomeCurrency: cmd.HomeCurrency,
+		Participants: cmd.Participants,
+	})
+	if tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		return nil, errors.Wrap(domain.ErrInvalidTrip, tripErr.Error())
+	}
+
+	id, insertErr := h.repo.Insert(ctx, *trip)
+	if insertErr != nil {
+		tracer.AddSpanError(span, insertErr)
+		return nil, errors.Wrap(insertErr, "insert trip")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,104 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAddTripCommand() command.AddTripCommand {
+	return command.AddTripCommand{
+		Name:         "Paris 2021",
+		From:         time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2021, time.July, 5, 0, 0, 0, 0, time.UTC),
+		HomeCurrency: "EUR",
+		Participants: []string{"Alice", "Bob"},
+	}
+}
+
+func TestNewAddTripHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAddTripHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAddTripHandler_InvalidTrip_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newAddTripCommand()
+	cmd.From = cmd.To.AddDate(0, 0, 1)
+
+	// SUT
+	sut := command.NewAddTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidTrip, "Should return invalid trip error.")
+}
+
+func TestAddTripHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("Insert", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewAddTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddTripCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestAddTripHandler_RepoSuccess_ReturnsID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	id := "tripId"
+
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(trip domain.Trip) bool {
+		return trip.Name() == "Paris 2021" && len(trip.Participants()) == 2
+	})).Return(&id, nil)
+
+	// SUT
+	sut := command.NewAddTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newAddTripCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &id, result, "Should return trip id.")
+}
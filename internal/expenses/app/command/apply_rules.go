@@ -0,0 +1,153 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// ApplyRulesCommand defines a command to apply rules to expenses of the date range.
+type ApplyRulesCommand struct {
+	From      time.Time
+	To        time.Time
+	UpdatedBy string
+}
+
+// ApplyRulesHandler defines a handler to apply rules to existing expenses.
+type ApplyRulesHandler struct {
+	repo         adapters.ExpenseRepoInterface
+	ruleRepo     adapters.RuleRepoInterface
+	categoryRepo adapters.ExpenseCategoryRepoInterface
+	merchantRepo adapters.MerchantRepoInterface
+	logger       logger.LogInterface
+}
+
+// ApplyRulesHandlerInterface defines a contract to handle command.
+type ApplyRulesHandlerInterface interface {
+	Handle(ctx context.Context, cmd ApplyRulesCommand) (*domain.UpdateResult, error)
+}
+
+// NewApplyRulesHandler returns command handler.
+func NewApplyRulesHandler(
+	repo adapters.ExpenseRepoInterface,
+	ruleRepo adapters.RuleRepoInterface,
+	categoryRepo adapters.ExpenseCategoryRepoInterface,
+	merchantRepo adapters.MerchantRepoInterface,
+	logger logger.LogInterface,
+) ApplyRulesHandler {
+	return ApplyRulesHandler{
+		repo:         repo,
+		ruleRepo:     ruleRepo,
+		categoryRepo: categoryRepo,
+		merchantRepo: merchantRepo,
+		logger:       logger,
+	}
+}
+
+// Handle handles apply rules command. Expenses are fetched page by page, only expenses changed
+// by the rules are updated. Locked expenses are skipped.
+func (h ApplyRulesHandler) Handle(ctx context.Context, cmd ApplyRulesCommand) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute apply rules command")
+	span.SetAttributes(attribute.String("from", cmd.From.String()), attribute.String("to", cmd.To.String()))
+	defer span.End()
+
+	limit := domain.MaxPageSize
+	sortBy := string(domain.SortFieldDate)
+	order := string(domain.SortOrderAsc)
+	filter, filterErr := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{
+		From:   &cmd.From,
+		To:     &cmd.To,
+		SortBy: &sortBy,
+		Order:  &order,
+		Limit:  &limit,
+	})
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return nil, errors.Wrap(domain.ErrInvalidRule, filterErr.Error())
+	}
+
+	engine, engineErr := ruleEngine(ctx, h.ruleRepo, h.categoryRepo, h.merchantRepo)
+	if engineErr != nil {
+		tracer.AddSpanError(span, engineErr)
+		return nil, engineErr
+	}
+
+	result := &domain.UpdateResult{}
+	now := time.Now()
+	for {
+		page, pageErr := h.repo.GetAll(ctx, *filter)
+		if pageErr != nil {
+			tracer.AddSpanError(span, pageErr)
+			return nil, errors.Wrap(pageErr, "fetch expenses")
+		}
+
+		for _, expense := range page.Expenses {
+			if expense.Locked() {
+				continue
+			}
+			applied, changed := engine.Apply(expense)
+			if !changed {
+				continue
+			}
+			domain.SetUpdateMetadata(cmd.UpdatedBy, now)(&applied)
+			updateResult, updateErr := h.repo.Update(ctx, applied)
+			if updateErr != nil {
+				tracer.AddSpanError(span, updateErr)
+				return nil, errors.Wrapf(updateErr, "update expense %s", expense.ID())
+			}
+			result.UpdateCount += updateResult.UpdateCount
+		}
+
+		if page.NextCursor == nil {
+			break
+		}
+		cursor, cursorErr := domain.DecodeExpenseCursor(*page.NextCursor)
+		if cursorErr != nil {
+			tracer.AddSpanError(span, cursorErr)
+			return nil, errors.Wrap(cursorErr, "decode next page cursor")
+		}
+		*filter = filter.WithCursor(*cursor)
+	}
+
+	span.SetAttributes(attribute.Int("updated", result.UpdateCount))
+
+	return result, nil
+}
+
+// ruleEngine returns an engine of all rules. Categories and merchants are only fetched when there are rules.
+func ruleEngine(
+	ctx context.Context,
+	ruleRepo adapters.RuleRepoInterface,
+	categoryRepo adapters.ExpenseCategoryRepoInterface,
+	merchantRepo adapters.MerchantRepoInterface,
+) (*domain.RuleEngine, error) {
+	rules, rulesErr := ruleRepo.GetAll(ctx)
+	if rulesErr != nil {
+		return nil, errors.Wrap(rulesErr, "get rules")
+	}
+	if len(rules) == 0 {
+		engine := domain.NewRuleEngine(nil, nil, nil)
+		return &engine, nil
+	}
+
+	categories, categoriesErr := categoryRepo.GetAll(ctx)
+	if categoriesErr != nil {
+		return nil, errors.Wrap(categoriesErr, "get categories")
+	}
+
+	merchants, merchantsErr := merchantRepo.GetAll(ctx)
+	if merchantsErr != nil {
+		return nil, errors.Wrap(merchantsErr, "get merchants")
+	}
+
+	engine := domain.NewRuleEngine(rules, categories, merchants)
+
+	return &engine, nil
+}
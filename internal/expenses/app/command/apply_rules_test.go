@@ -0,0 +1,129 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newApplyRulesCommand() command.ApplyRulesCommand {
+	return command.ApplyRulesCommand{
+		From:      time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC),
+		UpdatedBy: "user",
+	}
+}
+
+func TestNewApplyRulesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	ruleRepo := new(mocks.RuleRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewApplyRulesHandler(repo, ruleRepo, categoryRepo, merchantRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestApplyRulesHandler_InvalidDateRange_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	ruleRepo := new(mocks.RuleRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newApplyRulesCommand()
+	cmd.From, cmd.To = cmd.To, cmd.From
+
+	// SUT
+	sut := command.NewApplyRulesHandler(repo, ruleRepo, categoryRepo, merchantRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidRule, "Should return invalid rule error.")
+}
+
+func TestApplyRulesHandler_RulesError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	ruleRepo := new(mocks.RuleRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	ruleRepo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewApplyRulesHandler(repo, ruleRepo, categoryRepo, merchantRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newApplyRulesCommand())
+
+	// Assert
+	ruleRepo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestApplyRulesHandler_MatchingExpenses_UpdatesChangedExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	merchantRepo := newMerchantRepo()
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	other, _ := domain.NewCategory("otherId", nil, "Other", nil, 1, "|otherId")
+	foodID := "foodId"
+	ruleRepo := newRuleRepo(newRule("ruleId", "coffee", domain.RuleActions{CategoryID: &foodID}))
+	date := time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC)
+	coffee, tea, cappuccino := "Coffee", "Tea", "Coffee"
+	changed, _ := domain.NewExpense("changedId", *other, 3, "EUR", 1, &coffee, nil, date)
+	unmatched, _ := domain.NewExpense("unmatchedId", *other, 2, "EUR", 1, &tea, nil, date)
+	unchanged, _ := domain.NewExpense("unchangedId", *food, 4, "EUR", 1, &cappuccino, nil, date)
+	locked, _ := domain.NewExpense("lockedId", *other, 3, "EUR", 1, &coffee, nil, date,
+		domain.SetReconciliation("reconciliationId"))
+
+	categoryRepo.On("GetAll", mock.Anything).Return([]domain.Category{*food, *other}, nil)
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseListFilter) bool {
+		return filter.From().Equal(newApplyRulesCommand().From) && filter.SortOrder() == domain.SortOrderAsc
+	})).Return(&domain.ExpensePage{Expenses: []domain.Expense{*changed, *unmatched, *unchanged, *locked}}, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(expense domain.Expense) bool {
+		return expense.ID() == "changedId" && expense.Category().ID() == "foodId" &&
+			*expense.UpdatedBy() == "user"
+	})).Return(&domain.UpdateResult{UpdateCount: 1}, nil).Once()
+
+	// SUT
+	sut := command.NewApplyRulesHandler(repo, ruleRepo, categoryRepo, merchantRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newApplyRulesCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 1, result.UpdateCount, "Should return number of updated expenses.")
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// AssignInboxCategoriesCommand defines a command to categorize expenses from the inbox.
+type AssignInboxCategoriesCommand struct {
+	Assignments []domain.CategoryAssignment
+	UpdatedBy   string
+}
+
+// AssignInboxCategoriesHandler defines a handler to categorize expenses from the inbox.
+type AssignInboxCategoriesHandler struct {
+	repo         adapters.ExpenseRepoInterface
+	categoryRepo adapters.ExpenseCategoryRepoInterface
+	logger       logger.LogInterface
+}
+
+// AssignInboxCategoriesHandlerInterface defines a contract to handle command.
+type AssignInboxCategoriesHandlerInterface interface {
+	Handle(ctx context.Context, cmd AssignInboxCategoriesCommand) (*domain.UpdateResult, error)
+}
+
+// NewAssignInboxCategoriesHandler returns command handler.
+func NewAssignInboxCategoriesHandler(
+	repo adapters.ExpenseRepoInterface,
+	categoryRepo adapters.ExpenseCategoryRepoInterface,
+	logger logger.LogInterface,
+) AssignInboxCategoriesHandler {
+	return AssignInboxCategoriesHandler{
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		logger:       logger,
+	}
+}
+
+// Handle handles assign inbox categories command.
+// Only expenses that are still in the inbox are moved.
+func (h AssignInboxCategoriesHandler) Handle(
+	ctx context.Context,
+	cmd AssignInboxCategoriesCommand,
+) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute assign inbox categories command")
+	span.SetAttributes(attribute.Int("items", len(cmd.Assignments)))
+	defer span.End()
+
+	inbox, inboxErr := h.categoryRepo.GetInbox(ctx)
+	if inboxErr != nil {
+		tracer.AddSpanError(span, inboxErr)
+		return nil, errors.Wrap(inboxErr, "get inbox category")
+	}
+	if inbox == nil {
+		return &domain.UpdateResult{}, nil
+	}
+
+	categories, categoriesErr := h.categoryRepo.GetAll(ctx)
+	if categoriesErr != nil {
+		tracer.AddSpanError(span, categoriesErr)
+		return nil, errors.Wrap(categoriesErr, "get categories")
+	}
+	known := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		known[category.ID()] = true
+	}
+
+	for _, assignment := range cmd.Assignments {
+		if !known[assignment.CategoryID] || assignment.CategoryID == inbox.ID() {
+			return nil, errors.Wrapf(domain.ErrCategoryNotFound, "category %s", assignment.CategoryID)
+		}
+	}
+
+	result, reassignErr := h.repo.ReassignCategories(ctx, inbox.ID(), cmd.Assignments, cmd.UpdatedBy, time.Now())
+	if reassignErr != nil {
+		tracer.AddSpanError(span, reassignErr)
+		return nil, errors.Wrap(reassignErr, "reassign categories")
+	}
+
+	return result, nil
+}
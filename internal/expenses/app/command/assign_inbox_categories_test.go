@@ -0,0 +1,116 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewAssignInboxCategoriesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewAssignInboxCategoriesHandler(repo, categoryRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestAssignInboxCategoriesHandler_NoInbox_ReturnsEmptyResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.AssignInboxCategoriesCommand{
+		Assignments: []domain.CategoryAssignment{{ExpenseID: "expenseId", CategoryID: "categoryId"}},
+	}
+
+	categoryRepo.On("GetInbox", mock.Anything).Return(nil, nil)
+
+	// SUT
+	sut := command.NewAssignInboxCategoriesHandler(repo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "ReassignCategories", mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 0, result.UpdateCount, "Should not update anything.")
+}
+
+func TestAssignInboxCategoriesHandler_UnknownCategory_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	inbox := inboxCategory()
+	tests := []string{"unknownId", inbox.ID()}
+
+	categoryRepo.On("GetInbox", mock.Anything).Return(inbox, nil)
+	categoryRepo.On("GetAll", mock.Anything).Return(append(importCategories(), *inbox), nil)
+
+	// SUT
+	sut := command.NewAssignInboxCategoriesHandler(repo, categoryRepo, log)
+
+	for _, tc := range tests {
+		cmd := command.AssignInboxCategoriesCommand{
+			Assignments: []domain.CategoryAssignment{{ExpenseID: "expenseId", CategoryID: tc}},
+		}
+
+		// Act
+		result, err := sut.Handle(ctx, cmd)
+
+		// Assert
+		assert.Nil(t, result, "Result should be nil.")
+		assert.True(t, errors.Is(err, domain.ErrCategoryNotFound), "Should return category not found error.")
+	}
+}
+
+func TestAssignInboxCategoriesHandler_KnownCategories_ReassignsExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	inbox := inboxCategory()
+	categoryID := importCategories()[0].ID()
+	cmd := command.AssignInboxCategoriesCommand{
+		Assignments: []domain.CategoryAssignment{{ExpenseID: "expenseId", CategoryID: categoryID}},
+		UpdatedBy:   "userId",
+	}
+	updateResult := &domain.UpdateResult{UpdateCount: 1}
+
+	categoryRepo.On("GetInbox", mock.Anything).Return(inbox, nil)
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	repo.On("ReassignCategories", mock.Anything, inbox.ID(), cmd.Assignments, "userId", mock.Anything).
+		Return(updateResult, nil)
+
+	// SUT
+	sut := command.NewAssignInboxCategoriesHandler(repo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, updateResult, result, "Should return update result.")
+}
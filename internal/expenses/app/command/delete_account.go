@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteAccountCommand defines an account delete command.
+type DeleteAccountCommand struct {
+	ID string
+}
+
+// DeleteAccountHandler defines a handler to delete account.
+type DeleteAccountHandler struct {
+	repo   adapters.AccountRepoInterface
+	logger logger.LogInterface
+}
+
+// DeleteAccountHandlerInterface defines a contract to handle command.
+type DeleteAccountHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteAccountCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteAccountHandler returns command handler.
+func NewDeleteAccountHandler(
+	repo adapters.AccountRepoInterface,
+	logger logger.LogInterface,
+) DeleteAccountHandler {
+	return DeleteAccountHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles delete account command.
+func (h DeleteAccountHandler) Handle(ctx context.Context, cmd DeleteAccountCommand) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete account command")
+	defer span.End()
+
+	deleteResult, deleteErr := h.repo.DeleteOne(ctx, cmd.ID)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, errors.Wrap(deleteErr, "delete account")
+	}
+
+	if deleteResult.DeleteCount == 0 {
+		return nil, nil
+	}
+
+	return deleteResult, nil
+}
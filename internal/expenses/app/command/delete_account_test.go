@@ -0,0 +1,68 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewDeleteAccountHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewDeleteAccountHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestDeleteAccountHandler_NothingDeleted_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("DeleteOne", mock.Anything, "accountId").Return(&domain.DeleteResult{}, nil)
+
+	// SUT
+	sut := command.NewDeleteAccountHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.DeleteAccountCommand{ID: "accountId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestDeleteAccountHandler_RepoSuccess_ReturnsResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("DeleteOne", mock.Anything, "accountId").Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	// SUT
+	sut := command.NewDeleteAccountHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.DeleteAccountCommand{ID: "accountId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 1, result.DeleteCount, "Should return delete result.")
+}
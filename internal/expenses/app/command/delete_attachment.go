@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteAttachmentCommand defines an attachment delete command.
+type DeleteAttachmentCommand struct {
+	ExpenseID    string
+	AttachmentID string
+}
+
+// DeleteAttachmentHandler defines a handler to delete attachments along with their blobs.
+type DeleteAttachmentHandler struct {
+	repo      adapters.AttachmentRepoInterface
+	blobStore adapters.BlobStore
+	logger    logger.LogInterface
+}
+
+// DeleteAttachmentHandlerInterface defines a contract to handle command.
+type DeleteAttachmentHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteAttachmentCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteAttachmentHandler returns command handler.
+func NewDeleteAttachmentHandler(
+	repo adapters.AttachmentRepoInterface,
+	blobStore adapters.BlobStore,
+	logger logger.LogInterface,
+) DeleteAttachmentHandler {
+	return DeleteAttachmentHandler{
+		repo:      repo,
+		blobStore: blobStore,
+		logger:    logger,
+	}
+}
+
+// Handle handles delete attachment command.
+func (h DeleteAttachmentHandler) Handle(
+	ctx context.Context,
+	cmd DeleteAttachmentCommand,
+) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete attachment command")
+	span.SetAttributes(attribute.String("expenseId", cmd.ExpenseID), attribute.String("id", cmd.AttachmentID))
+	defer span.End()
+
+	attachment, attachmentErr := h.repo.GetOne(ctx, cmd.ExpenseID, cmd.AttachmentID)
+	if attachmentErr != nil {
+		tracer.AddSpanError(span, attachmentErr)
+		return nil, errors.Wrap(attachmentErr, "find attachment")
+	}
+	if attachment == nil {
+		return nil, nil
+	}
+
+	deleteResult, deleteErr := deleteAttachments(ctx, h.repo, h.blobStore, h.logger, []domain.Attachment{*attachment})
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, deleteErr
+	}
+
+	return deleteResult, nil
+}
+
+// deleteAttachments deletes attachments from the database first and their blobs afterwards,
+// so an attachment never points to a missing blob.
+func deleteAttachments(
+	ctx context.Context,
+	repo adapters.AttachmentRepoInterface,
+	blobStore adapters.BlobStore,
+	log logger.LogInterface,
+	attachments []domain.Attachment,
+) (*domain.DeleteResult, error) {
+	ids := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		ids = append(ids, attachment.ID())
+	}
+
+	deleteResult, deleteErr := repo.DeleteMany(ctx, ids)
+	if deleteErr != nil {
+		return nil, errors.Wrap(deleteErr, "delete attachments")
+	}
+
+	for _, attachment := range attachments {
+		deleteBlobs(ctx, blobStore, log, attachment)
+	}
+
+	return deleteResult, nil
+}
+
+// deleteBlobs deletes all blobs of the attachment. Failures are logged only, since a leftover blob
+// is not reachable anymore once its attachment is gone.
+func deleteBlobs(
+	ctx context.Context,
+	blobStore adapters.BlobStore,
+	log logger.LogInterface,
+	attachment domain.Attachment,
+) {
+	for _, key := range attachment.BlobKeys() {
+		if deleteErr := blobStore.Delete(ctx, key); deleteErr != nil {
+			log.Error(ctx, fmt.Sprintf("Failed to delete blob %s", key), deleteErr)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newDeletedAttachment() *domain.Attachment {
+	attachment, _ := domain.NewAttachment("attachmentId", domain.AttachmentParams{
+		ExpenseID:   "expenseId",
+		FileName:    "receipt.pdf",
+		ContentType: "application/pdf",
+		Size:        1024,
+		BlobKey:     "expenseId/blobId",
+		CreatedAt:   time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	})
+	return attachment
+}
+
+func TestNewDeleteAttachmentHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewDeleteAttachmentHandler(repo, blobStore, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestDeleteAttachmentHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteAttachmentCommand{ExpenseID: "expenseId", AttachmentID: "attachmentId"}
+
+	repo.On("GetOne", mock.Anything, "expenseId", "attachmentId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewDeleteAttachmentHandler(repo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "DeleteMany", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestDeleteAttachmentHandler_RepoError_KeepsBlobs(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteAttachmentCommand{ExpenseID: "expenseId", AttachmentID: "attachmentId"}
+
+	repo.On("GetOne", mock.Anything, "expenseId", "attachmentId").Return(newDeletedAttachment(), nil)
+	repo.On("DeleteMany", mock.Anything, []string{"attachmentId"}).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewDeleteAttachmentHandler(repo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	blobStore.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestDeleteAttachmentHandler_BlobError_ReturnsResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteAttachmentCommand{ExpenseID: "expenseId", AttachmentID: "attachmentId"}
+	deleteResult := &domain.DeleteResult{DeleteCount: 1}
+
+	repo.On("GetOne", mock.Anything, "expenseId", "attachmentId").Return(newDeletedAttachment(), nil)
+	repo.On("DeleteMany", mock.Anything, []string{"attachmentId"}).Return(deleteResult, nil)
+	blobStore.On("Delete", mock.Anything, "expenseId/blobId").Return(errors.New("error"))
+	log.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// SUT
+	sut := command.NewDeleteAttachmentHandler(repo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	blobStore.AssertExpectations(t)
+	log.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, deleteResult, result, "Should return delete result.")
+}
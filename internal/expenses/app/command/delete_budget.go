@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteBudgetCommand defines a budget delete command.
+type DeleteBudgetCommand struct {
+	ID string
+}
+
+// DeleteBudgetHandler defines a handler to delete budget.
+type DeleteBudgetHandler struct {
+	repo   adapters.BudgetRepoInterface
+	logger logger.LogInterface
+}
+
+// DeleteBudgetHandlerInterface defines a contract to handle command.
+type DeleteBudgetHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteBudgetCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteBudgetHandler returns command handler.
+func NewDeleteBudgetHandler(
+	repo adapters.BudgetRepoInterface,
+	logger logger.LogInterface,
+) DeleteBudgetHandler {
+	return DeleteBudgetHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles delete budget command.
+func (h DeleteBudgetHandler) Handle(ctx context.Context, cmd DeleteBudgetCommand) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete budget command")
+	defer span.End()
+
+	deleteResult, deleteErr := h.repo.DeleteOne(ctx, cmd.ID)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, errors.Wrap(deleteErr, "delete budget")
+	}
+
+	if deleteResult.DeleteCount == 0 {
+		return nil, nil
+	}
+
+	return deleteResult, nil
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteExpenseCommand defines an expense delete command.
+type DeleteExpenseCommand struct {
+	ExpenseID string
+	DeletedBy string
+}
+
+// DeleteExpenseHandler defines a handler to move expense to the trash.
+type DeleteExpenseHandler struct {
+	repo   adapters.ExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// DeleteExpenseHandlerInterface defines a contract to handle command.
+type DeleteExpenseHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteExpenseCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteExpenseHandler returns command handler.
+func NewDeleteExpenseHandler(
+	repo adapters.ExpenseRepoInterface,
+	logger logger.LogInterface,
+) DeleteExpenseHandler {
+	return DeleteExpenseHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles delete expense command. Locked expenses could not be deleted until unlocked.
+func (h DeleteExpenseHandler) Handle(ctx context.Context, cmd DeleteExpenseCommand) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete expense command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ExpenseID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get expense for delete")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	if existing.Locked() {
+		return nil, errors.Wrapf(domain.ErrExpenseLocked, "expense %s", existing.ID())
+	}
+
+	deleteResult, deleteErr := h.repo.SoftDeleteOne(ctx, cmd.ExpenseID, cmd.DeletedBy)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, errors.Wrap(deleteErr, "delete expense")
+	}
+
+	if deleteResult.DeleteCount == 0 {
+		return nil, nil
+	}
+
+	return deleteResult, nil
+}
@@ -0,0 +1,143 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewDeleteExpenseHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewDeleteExpenseHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestDeleteExpenseHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteExpenseCommand{ExpenseID: "expenseId", DeletedBy: "user"}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+	repo.On("SoftDeleteOne", mock.Anything, "expenseId", "user").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewDeleteExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestDeleteExpenseHandler_NothingDeleted_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteExpenseCommand{ExpenseID: "expenseId", DeletedBy: "user"}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+	repo.On("SoftDeleteOne", mock.Anything, "expenseId", "user").Return(&domain.DeleteResult{DeleteCount: 0}, nil)
+
+	// SUT
+	sut := command.NewDeleteExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestDeleteExpenseHandler_RepoSuccess_ReturnsResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteExpenseCommand{ExpenseID: "expenseId", DeletedBy: "user"}
+	deleteResult := &domain.DeleteResult{DeleteCount: 1}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+	repo.On("SoftDeleteOne", mock.Anything, "expenseId", "user").Return(deleteResult, nil)
+
+	// SUT
+	sut := command.NewDeleteExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, deleteResult, result, "Should return delete result.")
+}
+
+func TestDeleteExpenseHandler_ExpenseNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteExpenseCommand{ExpenseID: "expenseId", DeletedBy: "user"}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewDeleteExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "SoftDeleteOne", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestDeleteExpenseHandler_LockedExpense_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteExpenseCommand{ExpenseID: "expenseId", DeletedBy: "user"}
+	expense := newAttachedExpense()
+	domain.SetReconciliation("reconciliationId")(expense)
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(expense, nil)
+
+	// SUT
+	sut := command.NewDeleteExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "SoftDeleteOne", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrExpenseLocked, "Should reject locked expense.")
+}
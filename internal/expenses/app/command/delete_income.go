@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteIncomeCommand defines an income delete command.
+type DeleteIncomeCommand struct {
+	ID string
+}
+
+// DeleteIncomeHandler defines a handler to delete income.
+type DeleteIncomeHandler struct {
+	repo   adapters.IncomeRepoInterface
+	logger logger.LogInterface
+}
+
+// DeleteIncomeHandlerInterface defines a contract to handle command.
+type DeleteIncomeHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteIncomeCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteIncomeHandler returns command handler.
+func NewDeleteIncomeHandler(
+	repo adapters.IncomeRepoInterface,
+	logger logger.LogInterface,
+) DeleteIncomeHandler {
+	return DeleteIncomeHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles delete income command.
+func (h DeleteIncomeHandler) Handle(ctx context.Context, cmd DeleteIncomeCommand) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete income command")
+	defer span.End()
+
+	deleteResult, deleteErr := h.repo.DeleteOne(ctx, cmd.ID)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, errors.Wrap(deleteErr, "delete income")
+	}
+
+	if deleteResult.DeleteCount == 0 {
+		return nil, nil
+	}
+
+	return deleteResult, nil
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteMerchantCommand defines a merchant delete command.
+type DeleteMerchantCommand struct {
+	ID string
+}
+
+// DeleteMerchantHandler defines a handler to delete merchant.
+type DeleteMerchantHandler struct {
+	repo   adapters.MerchantRepoInterface
+	logger logger.LogInterface
+}
+
+// DeleteMerchantHandlerInterface defines a contract to handle command.
+type DeleteMerchantHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteMerchantCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteMerchantHandler returns command handler.
+func NewDeleteMerchantHandler(
+	repo adapters.MerchantRepoInterface,
+	logger logger.LogInterface,
+) DeleteMerchantHandler {
+	return DeleteMerchantHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles delete merchant command. Expenses of the merchant are kept and detached from the merchant.
+func (h DeleteMerchantHandler) Handle(ctx context.Context, cmd DeleteMerchantCommand) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete merchant command")
+	defer span.End()
+
+	deleteResult, deleteErr := h.repo.DeleteOne(ctx, cmd.ID)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, errors.Wrap(deleteErr, "delete merchant")
+	}
+
+	if deleteResult.DeleteCount == 0 {
+		return nil, nil
+	}
+
+	return deleteResult, nil
+}
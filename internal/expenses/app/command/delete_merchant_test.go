@@ -0,0 +1,94 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewDeleteMerchantHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewDeleteMerchantHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestDeleteMerchantHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteMerchantCommand{ID: "merchantId"}
+
+	repo.On("DeleteOne", mock.Anything, "merchantId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewDeleteMerchantHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestDeleteMerchantHandler_NothingDeleted_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteMerchantCommand{ID: "merchantId"}
+
+	repo.On("DeleteOne", mock.Anything, "merchantId").Return(&domain.DeleteResult{DeleteCount: 0}, nil)
+
+	// SUT
+	sut := command.NewDeleteMerchantHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestDeleteMerchantHandler_RepoSuccess_ReturnsResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteMerchantCommand{ID: "merchantId"}
+	deleteResult := &domain.DeleteResult{DeleteCount: 1}
+
+	repo.On("DeleteOne", mock.Anything, "merchantId").Return(deleteResult, nil)
+
+	// SUT
+	sut := command.NewDeleteMerchantHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, deleteResult, result, "Should return delete result.")
+}
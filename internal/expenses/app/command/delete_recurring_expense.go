@@ -0,0 +1,61 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteRecurringExpenseCommand defines a recurring expense delete command.
+type DeleteRecurringExpenseCommand struct {
+	ID string
+}
+
+// DeleteRecurringExpenseHandler defines a handler to delete recurring expense.
+type DeleteRecurringExpenseHandler struct {
+	repo   adapters.RecurringExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// DeleteRecurringExpenseHandlerInterface defines a contract to handle command.
+type DeleteRecurringExpenseHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteRecurringExpenseCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteRecurringExpenseHandler returns command handler.
+func NewDeleteRecurringExpenseHandler(
+	repo adapters.RecurringExpenseRepoInterface,
+	logger logger.LogInterface,
+) DeleteRecurringExpenseHandler {
+	return DeleteRecurringExpenseHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles delete recurring expense command. The series stops, already materialized
+// expenses are kept.
+func (h DeleteRecurringExpenseHandler) Handle(
+	ctx context.Context,
+	cmd DeleteRecurringExpenseCommand,
+) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete recurring expense command")
+	defer span.End()
+
+	deleteResult, deleteErr := h.repo.DeleteOne(ctx, cmd.ID)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, errors.Wrap(deleteErr, "delete recurring expense")
+	}
+
+	if deleteResult.DeleteCount == 0 {
+		return nil, nil
+	}
+
+	return deleteResult, nil
+}
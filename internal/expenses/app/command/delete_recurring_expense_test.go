@@ -0,0 +1,94 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewDeleteRecurringExpenseHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewDeleteRecurringExpenseHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestDeleteRecurringExpenseHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteRecurringExpenseCommand{ID: "recurringId"}
+
+	repo.On("DeleteOne", mock.Anything, "recurringId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewDeleteRecurringExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestDeleteRecurringExpenseHandler_NothingDeleted_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteRecurringExpenseCommand{ID: "recurringId"}
+
+	repo.On("DeleteOne", mock.Anything, "recurringId").Return(&domain.DeleteResult{DeleteCount: 0}, nil)
+
+	// SUT
+	sut := command.NewDeleteRecurringExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestDeleteRecurringExpenseHandler_RepoSuccess_ReturnsResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.DeleteRecurringExpenseCommand{ID: "recurringId"}
+	deleteResult := &domain.DeleteResult{DeleteCount: 1}
+
+	repo.On("DeleteOne", mock.Anything, "recurringId").Return(deleteResult, nil)
+
+	// SUT
+	sut := command.NewDeleteRecurringExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, deleteResult, result, "Should return delete result.")
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteRuleCommand defines a rule delete command.
+type DeleteRuleCommand struct {
+	ID string
+}
+
+// DeleteRuleHandler defines a handler to delete rule.
+type DeleteRuleHandler struct {
+	repo   adapters.RuleRepoInterface
+	logger logger.LogInterface
+}
+
+// DeleteRuleHandlerInterface defines a contract to handle command.
+type DeleteRuleHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteRuleCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteRuleHandler returns command handler.
+func NewDeleteRuleHandler(
+	repo adapters.RuleRepoInterface,
+	logger logger.LogInterface,
+) DeleteRuleHandler {
+	return DeleteRuleHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles delete rule command. Expenses changed by the rule before are left untouched.
+func (h DeleteRuleHandler) Handle(ctx context.Context, cmd DeleteRuleCommand) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete rule command")
+	defer span.End()
+
+	deleteResult, deleteErr := h.repo.DeleteOne(ctx, cmd.ID)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, errors.Wrap(deleteErr, "delete rule")
+	}
+
+	if deleteResult.DeleteCount == 0 {
+		return nil, nil
+	}
+
+	return deleteResult, nil
+}
@@ -0,0 +1,57 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// DeleteTripCommand defines a trip delete command.
+type DeleteTripCommand struct {
+	ID string
+}
+
+// DeleteTripHandler defines a handler to delete trip.
+type DeleteTripHandler struct {
+	repo   adapters.TripRepoInterface
+	logger logger.LogInterface
+}
+
+// DeleteTripHandlerInterface defines a contract to handle command.
+type DeleteTripHandlerInterface interface {
+	Handle(ctx context.Context, cmd DeleteTripCommand) (*domain.DeleteResult, error)
+}
+
+// NewDeleteTripHandler returns command handler.
+func NewDeleteTripHandler(
+	repo adapters.TripRepoInterface,
+	logger logger.LogInterface,
+) DeleteTripHandler {
+	return DeleteTripHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles delete trip command. Expenses of the trip are kept and detached from the trip.
+func (h DeleteTripHandler) Handle(ctx context.Context, cmd DeleteTripCommand) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute delete trip command")
+	defer span.End()
+
+	deleteResult, deleteErr := h.repo.DeleteOne(ctx, cmd.ID)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		return nil, errors.Wrap(deleteErr, "delete trip")
+	}
+
+	if deleteResult.DeleteCount == 0 {
+		return nil, nil
+	}
+
+	return deleteResult, nil
+}
@@ -99,3 +99,11 @@ func getMissingRateDates(dates []time.Time, rates []domain.ExchangeRates) []time
 
 	return missingDates
 }
+
+// ExchangeRates returns exchange rates for every date of the range, fetching the missing ones.
+func (h FetchExchangeRatesHandler) ExchangeRates(
+	ctx context.Context,
+	dateRange domain.DateRange,
+) ([]domain.ExchangeRates, error) {
+	return h.Handle(ctx, FetchExchangeRatesCommand{DateRange: dateRange})
+}
@@ -250,3 +250,28 @@ func TestFetchExchangeRatesHandler_MissingRates_ReturnCombinedRates(t *testing.T
 	assert.Equal(t, append(rates, missingRates...), res)
 	assert.Nil(t, resErr)
 }
+
+func TestFetchExchangeRatesHandler_ExchangeRates_ReturnsRatesOfDateRange(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	fetcher := new(mocks.ExchangeRateFetcherInterface)
+	repo := new(mocks.ExchangeRateRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	date := time.Now()
+	dateRange, _ := domain.NewDateRange(date, date)
+	rate, _ := domain.NewExchageRate(date, "EUR", map[string]float64{"USD": 1})
+	rates := []domain.ExchangeRates{*rate}
+
+	repo.On("GetAll", mock.Anything, *dateRange).Return(rates, nil)
+
+	// SUT
+	sut := command.NewFetchExchangeRatesHandler(fetcher, repo, log)
+
+	// Act
+	res, resErr := sut.ExchangeRates(ctx, *dateRange)
+
+	// Assert
+	assert.Equal(t, rates, res)
+	assert.Nil(t, resErr)
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FinishReconciliationCommand defines a command to finish reconciliation.
+type FinishReconciliationCommand struct {
+	ID string
+}
+
+// FinishReconciliationHandler defines a handler to finish reconciliation.
+type FinishReconciliationHandler struct {
+	repo               adapters.ReconciliationRepoInterface
+	findReconciliation query.FindReconciliationHandlerInterface
+	logger             logger.LogInterface
+}
+
+// FinishReconciliationHandlerInterface defines a contract to handle command.
+type FinishReconciliationHandlerInterface interface {
+	Handle(ctx context.Context, cmd FinishReconciliationCommand) (*domain.Reconciliation, error)
+}
+
+// NewFinishReconciliationHandler returns command handler.
+func NewFinishReconciliationHandler(
+	repo adapters.ReconciliationRepoInterface,
+	findReconciliation query.FindReconciliationHandlerInterface,
+	logger logger.LogInterface,
+) FinishReconciliationHandler {
+	return FinishReconciliationHandler{
+		repo:               repo,
+		findReconciliation: findReconciliation,
+		logger:             logger,
+	}
+}
+
+// Handle handles finish reconciliation command. The statement balance should match the cleared balance,
+// the ticked off expenses are locked then.
+func (h FinishReconciliationHandler) Handle(
+	ctx context.Context,
+	cmd FinishReconciliationCommand,
+) (*domain.Reconciliation, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute finish reconciliation command")
+	defer span.End()
+
+	report, reportErr := h.findReconciliation.Handle(ctx, query.FindReconciliationQuery{ID: cmd.ID})
+	if reportErr != nil {
+		tracer.AddSpanError(span, reportErr)
+		return nil, errors.Wrap(reportErr, "get reconciliation report")
+	}
+
+	if report == nil {
+		return nil, nil
+	}
+
+	reconciliation, finishErr := report.Finish(time.Now())
+	if finishErr != nil {
+		tracer.AddSpanError(span, finishErr)
+		return nil, errors.Wrap(domain.ErrInvalidReconciliation, finishErr.Error())
+	}
+
+	_, updateErr := h.repo.Finish(ctx, *reconciliation)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "finish reconciliation")
+	}
+
+	return reconciliation, nil
+}
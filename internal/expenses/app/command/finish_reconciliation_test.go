@@ -0,0 +1,110 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newReconciliationReport(difference int64) *domain.ReconciliationReport {
+	return &domain.ReconciliationReport{
+		Reconciliation: *newReconciliation("groceriesId"),
+		Currency:       "EUR",
+		Difference:     decimal.NewFromInt(difference),
+	}
+}
+
+func TestNewFinishReconciliationHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	findReconciliation := new(mocks.FindReconciliationHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewFinishReconciliationHandler(repo, findReconciliation, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFinishReconciliationHandler_ReconciliationNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	findReconciliation := new(mocks.FindReconciliationHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	findReconciliation.On("Handle", mock.Anything, query.FindReconciliationQuery{ID: "reconciliationId"}).
+		Return(nil, nil)
+
+	// SUT
+	sut := command.NewFinishReconciliationHandler(repo, findReconciliation, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.FinishReconciliationCommand{ID: "reconciliationId"})
+
+	// Assert
+	repo.AssertNotCalled(t, "Finish", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFinishReconciliationHandler_Difference_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	findReconciliation := new(mocks.FindReconciliationHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	findReconciliation.On("Handle", mock.Anything, query.FindReconciliationQuery{ID: "reconciliationId"}).
+		Return(newReconciliationReport(5), nil)
+
+	// SUT
+	sut := command.NewFinishReconciliationHandler(repo, findReconciliation, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.FinishReconciliationCommand{ID: "reconciliationId"})
+
+	// Assert
+	repo.AssertNotCalled(t, "Finish", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReconciliation, "Should return invalid reconciliation error.")
+}
+
+func TestFinishReconciliationHandler_Balanced_FinishesReconciliation(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	findReconciliation := new(mocks.FindReconciliationHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	findReconciliation.On("Handle", mock.Anything, query.FindReconciliationQuery{ID: "reconciliationId"}).
+		Return(newReconciliationReport(0), nil)
+	repo.On("Finish", mock.Anything, mock.MatchedBy(func(reconciliation domain.Reconciliation) bool {
+		return reconciliation.Status() == domain.ReconciliationStatusFinished
+	})).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewFinishReconciliationHandler(repo, findReconciliation, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.FinishReconciliationCommand{ID: "reconciliationId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.NotNil(t, result.FinishedAt(), "Should finish reconciliation.")
+	assert.Equal(t, []string{"groceriesId"}, result.ExpenseIDs(), "Should keep ticked expenses.")
+}
@@ -0,0 +1,230 @@
+package command
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// MaxImportRows limits the number of data rows in a single import.
+const MaxImportRows int = 10000
+
+const byteOrderMark string = "\ufeff"
+
+// ImportExpensesCsvCommand defines a command to import expenses from a CSV file.
+// Either a saved profile ID or an inline profile should be provided.
+type ImportExpensesCsvCommand struct {
+	File      io.Reader
+	ProfileID *string
+	Profile   *domain.ImportProfileParams
+	Mode      domain.ImportMode
+	CreatedBy string
+}
+
+// ImportExpensesCsvHandler defines a handler to import expenses from CSV.
+type ImportExpensesCsvHandler struct {
+	repo         adapters.ExpenseRepoInterface
+	categoryRepo adapters.ExpenseCategoryRepoInterface
+	profileRepo  adapters.ImportProfileRepoInterface
+	merchantRepo adapters.MerchantRepoInterface
+	ruleRepo     adapters.RuleRepoInterface
+	logger       logger.LogInterface
+}
+
+// ImportExpensesCsvHandlerInterface defines a contract to handle command.
+type ImportExpensesCsvHandlerInterface interface {
+	Handle(ctx context.Context, cmd ImportExpensesCsvCommand) (*domain.ImportReport, error)
+}
+
+// NewImportExpensesCsvHandler returns command handler.
+func NewImportExpensesCsvHandler(
+	repo adapters.ExpenseRepoInterface,
+	categoryRepo adapters.ExpenseCategoryRepoInterface,
+	profileRepo adapters.ImportProfileRepoInterface,
+	merchantRepo adapters.MerchantRepoInterface,
+	ruleRepo adapters.RuleRepoInterface,
+	logger logger.LogInterface,
+) ImportExpensesCsvHandler {
+	return ImportExpensesCsvHandler{
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		profileRepo:  profileRepo,
+		merchantRepo: merchantRepo,
+		ruleRepo:     ruleRepo,
+		logger:       logger,
+	}
+}
+
+// Handle handles import expenses from CSV command.
+// Every data row is validated and reported, rows are matched to merchants by comment and rules are applied to them.
+// In the atomic mode accepted rows are saved only when no row is rejected, the dry run mode never saves anything.
+func (h ImportExpensesCsvHandler) Handle(
+	ctx context.Context,
+	cmd ImportExpensesCsvCommand,
+) (*domain.ImportReport, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute import expenses from csv command")
+	span.SetAttributes(attribute.String("mode", string(cmd.Mode)))
+	defer span.End()
+
+	if _, modeErr := domain.ParseImportMode(string(cmd.Mode)); modeErr != nil {
+		return nil, errors.Wrap(domain.ErrInvalidImport, modeErr.Error())
+	}
+
+	profile, profileErr := h.profile(ctx, cmd)
+	if profileErr != nil {
+		tracer.AddSpanError(span, profileErr)
+		return nil, profileErr
+	}
+
+	reader := csv.NewReader(cmd.File)
+	reader.Comma = profile.Delimiter()
+	reader.FieldsPerRecord = -1
+
+	header, headerErr := reader.Read()
+	if headerErr != nil {
+		return nil, errors.Wrapf(domain.ErrInvalidImport, "read header: %s", headerErr)
+	}
+	columnIndexes, columnsErr := mapHeader(header, profile.Columns())
+	if columnsErr != nil {
+		return nil, columnsErr
+	}
+
+	categories, categoriesErr := h.categoryRepo.GetAll(ctx)
+	if categoriesErr != nil {
+		tracer.AddSpanError(span, categoriesErr)
+		return nil, errors.Wrap(categoriesErr, "get categories")
+	}
+	resolver := domain.NewCategoryResolver(categories)
+
+	matcher, matcherErr := merchantMatcher(ctx, h.merchantRepo)
+	if matcherErr != nil {
+		tracer.AddSpanError(span, matcherErr)
+		return nil, matcherErr
+	}
+
+	engine, engineErr := ruleEngine(ctx, h.ruleRepo, h.categoryRepo, h.merchantRepo)
+	if engineErr != nil {
+		tracer.AddSpanError(span, engineErr)
+		return nil, engineErr
+	}
+
+	now := time.Now()
+	report := domain.NewImportReport(cmd.Mode)
+	for row := 1; ; row++ {
+		fields, fieldsErr := reader.Read()
+		if errors.Is(fieldsErr, io.EOF) {
+			break
+		}
+		if fieldsErr != nil {
+			return nil, errors.Wrapf(domain.ErrInvalidImport, "read row %d: %s", row, fieldsErr)
+		}
+		if row > MaxImportRows {
+			return nil, errors.Wrapf(domain.ErrInvalidImport, "more than %d rows", MaxImportRows)
+		}
+
+		record := make(map[string]string, len(columnIndexes))
+		for column, index := range columnIndexes {
+			if index < len(fields) {
+				record[column] = fields[index]
+			}
+		}
+
+		opts := []func(*domain.Expense){domain.SetCreateMetadata(cmd.CreatedBy, now)}
+		if comment := profile.Columns().Comment; comment != nil {
+			if merchant := matcher.Match(record[*comment]); merchant != nil {
+				opts = append(opts, domain.SetMerchant(merchant.ID()))
+			}
+		}
+
+		expense, expenseErr := profile.MapRecord(record, resolver, opts...)
+		if expenseErr != nil {
+			report.Reject(row, expenseErr.Error())
+			continue
+		}
+		applied, _ := engine.Apply(*expense)
+		report.Accept(row, applied)
+	}
+
+	span.SetAttributes(attribute.Int("accepted", report.Accepted), attribute.Int("rejected", report.Rejected))
+
+	if report.CanCommit() {
+		if _, insertErr := h.repo.InsertMany(ctx, report.AcceptedExpenses()); insertErr != nil {
+			tracer.AddSpanError(span, insertErr)
+			return nil, errors.Wrap(insertErr, "insert imported expenses")
+		}
+		report.Committed = true
+	}
+
+	return &report, nil
+}
+
+// profile returns a saved import profile or instantiates an inline one.
+func (h ImportExpensesCsvHandler) profile(
+	ctx context.Context,
+	cmd ImportExpensesCsvCommand,
+) (*domain.ImportProfile, error) {
+	if cmd.ProfileID != nil {
+		profile, profileErr := h.profileRepo.GetOne(ctx, *cmd.ProfileID)
+		if profileErr != nil {
+			return nil, errors.Wrap(profileErr, "get import profile")
+		}
+		if profile == nil {
+			return nil, errors.Wrapf(domain.ErrImportProfileNotFound, "profile %s", *cmd.ProfileID)
+		}
+		return profile, nil
+	}
+
+	if cmd.Profile == nil {
+		return nil, errors.Wrap(domain.ErrInvalidImport, "either profile id or profile is required")
+	}
+
+	profile, profileErr := domain.NewImportProfile(*cmd.Profile)
+	if profileErr != nil {
+		return nil, errors.Wrapf(domain.ErrInvalidImport, "prepare import profile: %s", profileErr)
+	}
+	return profile, nil
+}
+
+// mapHeader finds indexes of mapped columns in the CSV header.
+func mapHeader(header []string, columns domain.ImportColumns) (map[string]int, error) {
+	indexes := make(map[string]int, len(header))
+	for index, name := range header {
+		if index == 0 {
+			name = strings.TrimPrefix(name, byteOrderMark)
+		}
+		indexes[strings.TrimSpace(name)] = index
+	}
+
+	mapped := []string{columns.Date, columns.Amount, columns.Category}
+	for _, optional := range []*string{columns.Currency, columns.Quantity, columns.Comment} {
+		if optional != nil {
+			mapped = append(mapped, *optional)
+		}
+	}
+
+	columnIndexes := make(map[string]int, len(mapped))
+	missing := make([]string, 0)
+	for _, column := range mapped {
+		index, ok := indexes[column]
+		if !ok {
+			missing = append(missing, column)
+			continue
+		}
+		columnIndexes[column] = index
+	}
+	if len(missing) != 0 {
+		return nil, errors.Wrapf(domain.ErrInvalidImport, "missing columns: %s", strings.Join(missing, ", "))
+	}
+
+	return columnIndexes, nil
+}
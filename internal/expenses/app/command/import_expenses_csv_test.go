@@ -0,0 +1,270 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+const importCsv string = "\ufeffDate,Amount,Category\n2021-07-01,10.5,Food\n2021-07-02,3,Food\n"
+
+func importCategories() []domain.Category {
+	category, _ := domain.NewCategory("60e3f6a4c2a5b3a1f0c0a001", nil, "Food", nil, 1, "|60e3f6a4c2a5b3a1f0c0a001")
+	return []domain.Category{*category}
+}
+
+func TestNewImportExpensesCsvHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	profileRepo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+
+	// Act
+	result := command.NewImportExpensesCsvHandler(repo, categoryRepo, profileRepo, merchantRepo, ruleRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestImportExpensesCsvHandler_UnknownProfile_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	profileRepo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	profileID := "profileId"
+	cmd := command.ImportExpensesCsvCommand{
+		File:      strings.NewReader(importCsv),
+		ProfileID: &profileID,
+		Mode:      domain.ImportModeAtomic,
+	}
+
+	profileRepo.On("GetOne", mock.Anything, profileID).Return(nil, nil)
+
+	// SUT
+	sut := command.NewImportExpensesCsvHandler(repo, categoryRepo, profileRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	profileRepo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.True(t, errors.Is(err, domain.ErrImportProfileNotFound), "Should return profile not found error.")
+}
+
+func TestImportExpensesCsvHandler_MissingColumns_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	profileRepo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	params := importProfileParams()
+	cmd := command.ImportExpensesCsvCommand{
+		File:    strings.NewReader("Date,Price,Category\n2021-07-01,10.5,Food\n"),
+		Profile: &params,
+		Mode:    domain.ImportModeAtomic,
+	}
+
+	// SUT
+	sut := command.NewImportExpensesCsvHandler(repo, categoryRepo, profileRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	categoryRepo.AssertNotCalled(t, "GetAll", mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.True(t, errors.Is(err, domain.ErrInvalidImport), "Should return invalid import error.")
+}
+
+func TestImportExpensesCsvHandler_DryRun_ReturnsReportWithoutSaving(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	profileRepo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	params := importProfileParams()
+	cmd := command.ImportExpensesCsvCommand{
+		File:      strings.NewReader(importCsv),
+		Profile:   &params,
+		Mode:      domain.ImportModeDryRun,
+		CreatedBy: "userId",
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+
+	// SUT
+	sut := command.NewImportExpensesCsvHandler(repo, categoryRepo, profileRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "InsertMany", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.Accepted, "Should accept all rows.")
+	assert.False(t, result.Committed, "Should not commit dry run.")
+	assert.Equal(t, "userId", result.Rows[0].Expense.CreatedBy(), "Should set creator.")
+}
+
+func TestImportExpensesCsvHandler_AtomicWithRejectedRows_DoesNotSave(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	profileRepo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	params := importProfileParams()
+	cmd := command.ImportExpensesCsvCommand{
+		File:    strings.NewReader(importCsv + "2021-07-03,1,Unknown\n"),
+		Profile: &params,
+		Mode:    domain.ImportModeAtomic,
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+
+	// SUT
+	sut := command.NewImportExpensesCsvHandler(repo, categoryRepo, profileRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "InsertMany", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.Accepted, "Should accept valid rows.")
+	assert.Equal(t, 1, result.Rejected, "Should reject invalid row.")
+	assert.Equal(t, 3, result.Rows[2].Row, "Should report row number.")
+	assert.False(t, result.Committed, "Should not commit.")
+}
+
+func TestImportExpensesCsvHandler_AtomicWithValidRows_SavesExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	profileRepo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	profileID := "profileId"
+	profile, _ := domain.NewImportProfile(importProfileParams())
+	cmd := command.ImportExpensesCsvCommand{
+		File:      strings.NewReader(importCsv),
+		ProfileID: &profileID,
+		Mode:      domain.ImportModeAtomic,
+	}
+
+	profileRepo.On("GetOne", mock.Anything, profileID).Return(profile, nil)
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	repo.On("InsertMany", mock.Anything, mock.MatchedBy(func(expenses []domain.Expense) bool {
+		return len(expenses) == 2
+	})).Return([]string{"1", "2"}, nil)
+
+	// SUT
+	sut := command.NewImportExpensesCsvHandler(repo, categoryRepo, profileRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.True(t, result.Committed, "Should commit.")
+}
+
+func TestImportExpensesCsvHandler_InsertError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	profileRepo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	params := importProfileParams()
+	cmd := command.ImportExpensesCsvCommand{
+		File:    strings.NewReader(importCsv),
+		Profile: &params,
+		Mode:    domain.ImportModeAtomic,
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	repo.On("InsertMany", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewImportExpensesCsvHandler(repo, categoryRepo, profileRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestImportExpensesCsvHandler_CommentMentionsMerchant_SetsMerchant(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	profileRepo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	merchant, _ := domain.NewMerchant("reweId", domain.MerchantParams{Name: "Rewe", Aliases: []string{"REWE Markt"}})
+	merchantRepo := newMerchantRepo(*merchant)
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	params := importProfileParams()
+	comment := "Comment"
+	params.Columns.Comment = &comment
+	cmd := command.ImportExpensesCsvCommand{
+		File:    strings.NewReader("Date,Amount,Category,Comment\n2021-07-01,10.5,Food,REWE MARKT 123\n2021-07-02,3,Food,Cafe\n"),
+		Profile: &params,
+		Mode:    domain.ImportModeDryRun,
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+
+	// SUT
+	sut := command.NewImportExpensesCsvHandler(repo, categoryRepo, profileRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.Accepted, "Should accept all rows.")
+	assert.Equal(t, "reweId", *result.Rows[0].Expense.MerchantID(), "Should match merchant by alias.")
+	assert.Nil(t, result.Rows[1].Expense.MerchantID(), "Should not match unknown merchant.")
+}
@@ -0,0 +1,260 @@
+package command
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters/statement"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// ImportStatementCommand defines a command to import expenses from a bank statement.
+type ImportStatementCommand struct {
+	File            io.Reader
+	Format          domain.StatementFormat
+	Mode            domain.ImportMode
+	DefaultCurrency *string
+	DateFormat      *string
+	CreatedBy       string
+}
+
+// ImportStatementHandler defines a handler to import expenses from OFX and QIF bank statements.
+type ImportStatementHandler struct {
+	repo         adapters.ExpenseRepoInterface
+	categoryRepo adapters.ExpenseCategoryRepoInterface
+	merchantRepo adapters.MerchantRepoInterface
+	ruleRepo     adapters.RuleRepoInterface
+	logger       logger.LogInterface
+}
+
+// ImportStatementHandlerInterface defines a contract to handle command.
+type ImportStatementHandlerInterface interface {
+	Handle(ctx context.Context, cmd ImportStatementCommand) (*domain.ImportReport, error)
+}
+
+// NewImportStatementHandler returns command handler.
+func NewImportStatementHandler(
+	repo adapters.ExpenseRepoInterface,
+	categoryRepo adapters.ExpenseCategoryRepoInterface,
+	merchantRepo adapters.MerchantRepoInterface,
+	ruleRepo adapters.RuleRepoInterface,
+	logger logger.LogInterface,
+) ImportStatementHandler {
+	return ImportStatementHandler{
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		merchantRepo: merchantRepo,
+		ruleRepo:     ruleRepo,
+		logger:       logger,
+	}
+}
+
+// Handle handles import statement command.
+// Credits and already imported transactions are skipped. Transactions are matched to merchants by payee
+// or by memo. Debits without a known category get the default category of their merchant,
+// otherwise they land in the inbox category, so that they could be categorized later. Rules are applied to
+// the imported expenses, so they could move expenses out of the inbox.
+func (h ImportStatementHandler) Handle(ctx context.Context, cmd ImportStatementCommand) (*domain.ImportReport, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute import statement command")
+	span.SetAttributes(attribute.String("format", string(cmd.Format)), attribute.String("mode", string(cmd.Mode)))
+	defer span.End()
+
+	if _, modeErr := domain.ParseImportMode(string(cmd.Mode)); modeErr != nil {
+		return nil, errors.Wrap(domain.ErrInvalidImport, modeErr.Error())
+	}
+
+	transactions, parseErr := h.parse(cmd)
+	if parseErr != nil {
+		tracer.AddSpanError(span, parseErr)
+		return nil, errors.Wrapf(domain.ErrInvalidImport, "parse %s: %s", cmd.Format, parseErr)
+	}
+	if len(transactions) > MaxImportRows {
+		return nil, errors.Wrapf(domain.ErrInvalidImport, "more than %d transactions", MaxImportRows)
+	}
+
+	categories, categoriesErr := h.categoryRepo.GetAll(ctx)
+	if categoriesErr != nil {
+		tracer.AddSpanError(span, categoriesErr)
+		return nil, errors.Wrap(categoriesErr, "get categories")
+	}
+	resolver := domain.NewCategoryResolver(categories)
+	categoriesByID := make(map[string]domain.Category, len(categories))
+	for _, category := range categories {
+		categoriesByID[category.ID()] = category
+	}
+
+	matcher, matcherErr := merchantMatcher(ctx, h.merchantRepo)
+	if matcherErr != nil {
+		tracer.AddSpanError(span, matcherErr)
+		return nil, matcherErr
+	}
+
+	engine, engineErr := ruleEngine(ctx, h.ruleRepo, h.categoryRepo, h.merchantRepo)
+	if engineErr != nil {
+		tracer.AddSpanError(span, engineErr)
+		return nil, engineErr
+	}
+
+	inbox, inboxErr := h.inbox(ctx, cmd.Mode)
+	if inboxErr != nil {
+		tracer.AddSpanError(span, inboxErr)
+		return nil, inboxErr
+	}
+
+	externalIDs := make([]string, 0, len(transactions))
+	for _, transaction := range transactions {
+		externalIDs = append(externalIDs, transaction.ExternalID())
+	}
+	existingIDs, existingIDsErr := h.repo.GetExternalIDs(ctx, externalIDs)
+	if existingIDsErr != nil {
+		tracer.AddSpanError(span, existingIDsErr)
+		return nil, errors.Wrap(existingIDsErr, "get imported transactions")
+	}
+	imported := make(map[string]bool, len(existingIDs))
+	for _, existingID := range existingIDs {
+		imported[existingID] = true
+	}
+
+	var currency string
+	if cmd.DefaultCurrency != nil {
+		currency = *cmd.DefaultCurrency
+	}
+
+	now := time.Now()
+	report := domain.NewImportReport(cmd.Mode)
+	for index, transaction := range transactions {
+		row := index + 1
+		if imported[transaction.ExternalID()] {
+			report.Skip(row, "transaction "+transaction.ExternalID()+" is already imported")
+			continue
+		}
+		imported[transaction.ExternalID()] = true
+		if !transaction.IsDebit() {
+			report.Skip(row, "transaction "+transaction.ExternalID()+" is not a debit")
+			continue
+		}
+
+		opts := []func(*domain.Expense){domain.SetCreateMetadata(cmd.CreatedBy, now)}
+		category := *inbox
+		merchant := matchTransactionMerchant(matcher, transaction)
+		if merchant != nil {
+			opts = append(opts, domain.SetMerchant(merchant.ID()))
+			if merchant.DefaultCategoryID() != nil {
+				if defaultCategory, ok := categoriesByID[*merchant.DefaultCategoryID()]; ok {
+					category = defaultCategory
+				}
+			}
+		}
+		if transaction.CategoryHint() != nil {
+			if resolved, resolveErr := resolver.Resolve(*transaction.CategoryHint()); resolveErr == nil {
+				category = *resolved
+			}
+		}
+
+		expense, expenseErr := transaction.ToExpense(category, currency, opts...)
+		if expenseErr != nil {
+			report.Reject(row, expenseErr.Error())
+			continue
+		}
+		applied, _ := engine.Apply(*expense)
+		report.Accept(row, applied)
+	}
+
+	span.SetAttributes(attribute.Int("accepted", report.Accepted), attribute.Int("rejected", report.Rejected))
+
+	if report.CanCommit() {
+		if commitErr := h.commit(ctx, &report); commitErr != nil {
+			tracer.AddSpanError(span, commitErr)
+			return nil, commitErr
+		}
+	}
+
+	return &report, nil
+}
+
+// commit inserts accepted expenses of the report. Transactions imported concurrently after the report
+// was prepared are turned into skipped rows, then the rest of the expenses is inserted again.
+func (h ImportStatementHandler) commit(ctx context.Context, report *domain.ImportReport) error {
+	_, insertErr := h.repo.InsertMany(ctx, report.AcceptedExpenses())
+	if errors.Is(insertErr, domain.ErrExpenseImported) {
+		externalIDs := []string{}
+		for _, expense := range report.AcceptedExpenses() {
+			if expense.ExternalID() != nil {
+				externalIDs = append(externalIDs, *expense.ExternalID())
+			}
+		}
+		existingIDs, existingIDsErr := h.repo.GetExternalIDs(ctx, externalIDs)
+		if existingIDsErr != nil {
+			return errors.Wrap(existingIDsErr, "get imported transactions")
+		}
+		report.SkipImported(existingIDs)
+		if !report.CanCommit() {
+			return nil
+		}
+		_, insertErr = h.repo.InsertMany(ctx, report.AcceptedExpenses())
+	}
+	if insertErr != nil {
+		return errors.Wrap(insertErr, "insert imported expenses")
+	}
+
+	report.Committed = true
+	return nil
+}
+
+// parse parses statement transactions according to the statement format.
+func (h ImportStatementHandler) parse(cmd ImportStatementCommand) ([]domain.StatementTransaction, error) {
+	switch cmd.Format {
+	case domain.StatementFormatOFX:
+		return statement.ParseOFX(cmd.File)
+	case domain.StatementFormatQIF:
+		options := statement.QIFOptions{}
+		if cmd.DateFormat != nil {
+			options.DateFormat = *cmd.DateFormat
+		}
+		return statement.ParseQIF(cmd.File, options)
+	default:
+		return nil, errors.Errorf("unknown statement format %s", cmd.Format)
+	}
+}
+
+// matchTransactionMerchant returns a merchant of the transaction matched by payee, falling back to memo.
+func matchTransactionMerchant(
+	matcher *domain.MerchantMatcher,
+	transaction domain.StatementTransaction,
+) *domain.Merchant {
+	for _, text := range []*string{transaction.Payee(), transaction.Memo()} {
+		if text == nil {
+			continue
+		}
+		if merchant := matcher.Match(*text); merchant != nil {
+			return merchant
+		}
+	}
+	return nil
+}
+
+// inbox returns the inbox category. The dry run does not create it and uses a placeholder instead.
+func (h ImportStatementHandler) inbox(ctx context.Context, mode domain.ImportMode) (*domain.Category, error) {
+	var inbox *domain.Category
+	var inboxErr error
+	if mode == domain.ImportModeDryRun {
+		inbox, inboxErr = h.categoryRepo.GetInbox(ctx)
+	} else {
+		inbox, inboxErr = h.categoryRepo.EnsureInbox(ctx)
+	}
+	if inboxErr != nil {
+		return nil, errors.Wrap(inboxErr, "get inbox category")
+	}
+
+	if inbox == nil {
+		return domain.NewCategory("", nil, domain.InboxCategoryName, nil, 1, "")
+	}
+	return inbox, nil
+}
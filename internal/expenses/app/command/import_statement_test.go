@@ -0,0 +1,306 @@
+package command_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+const ofxStatement string = `<OFX><STMTRS><CURDEF>EUR<BANKTRANLIST>
+<STMTTRN><DTPOSTED>20210701<TRNAMT>-10.00<FITID>fit1<NAME>Shop</STMTTRN>
+<STMTTRN><DTPOSTED>20210702<TRNAMT>-5.00<FITID>fit2<NAME>Cafe</STMTTRN>
+<STMTTRN><DTPOSTED>20210703<TRNAMT>100.00<FITID>fit3<NAME>Salary</STMTTRN>
+</BANKTRANLIST></STMTRS></OFX>`
+
+const qifStatement string = `!Type:Bank
+D07/01/2021
+T-10.00
+LFood
+^
+D07/02/2021
+T-5.00
+LUnknown
+^
+`
+
+func inboxCategory() *domain.Category {
+	inbox, _ := domain.NewCategory("60e3f6a4c2a5b3a1f0c0a0ff", nil, domain.InboxCategoryName, nil, 1,
+		"|60e3f6a4c2a5b3a1f0c0a0ff")
+	return inbox
+}
+
+func TestNewImportStatementHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+
+	// Act
+	result := command.NewImportStatementHandler(repo, categoryRepo, merchantRepo, ruleRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestImportStatementHandler_MalformedFile_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	cmd := command.ImportStatementCommand{
+		File:   strings.NewReader("Date,Amount"),
+		Format: domain.StatementFormatOFX,
+		Mode:   domain.ImportModeAtomic,
+	}
+
+	// SUT
+	sut := command.NewImportStatementHandler(repo, categoryRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	categoryRepo.AssertNotCalled(t, "GetAll", mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.True(t, errors.Is(err, domain.ErrInvalidImport), "Should return invalid import error.")
+}
+
+func TestImportStatementHandler_OFXAtomic_SkipsDuplicatesAndCredits(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	cmd := command.ImportStatementCommand{
+		File:      strings.NewReader(ofxStatement),
+		Format:    domain.StatementFormatOFX,
+		Mode:      domain.ImportModeAtomic,
+		CreatedBy: "userId",
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	categoryRepo.On("EnsureInbox", mock.Anything).Return(inboxCategory(), nil)
+	repo.On("GetExternalIDs", mock.Anything, []string{"fit1", "fit2", "fit3"}).Return([]string{"fit2"}, nil)
+	repo.On("InsertMany", mock.Anything, mock.MatchedBy(func(expenses []domain.Expense) bool {
+		return len(expenses) == 1 && *expenses[0].ExternalID() == "fit1" &&
+			expenses[0].Category().ID() == inboxCategory().ID() && expenses[0].Currency() == "EUR"
+	})).Return([]string{"1"}, nil)
+
+	// SUT
+	sut := command.NewImportStatementHandler(repo, categoryRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	categoryRepo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 1, result.Accepted, "Should accept new debit.")
+	assert.Equal(t, 2, result.Skipped, "Should skip duplicate and credit.")
+	assert.True(t, result.Committed, "Should commit.")
+}
+
+func TestImportStatementHandler_ImportedConcurrently_SkipsImportedTransactions(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	cmd := command.ImportStatementCommand{
+		File:      strings.NewReader(ofxStatement),
+		Format:    domain.StatementFormatOFX,
+		Mode:      domain.ImportModeAtomic,
+		CreatedBy: "userId",
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	categoryRepo.On("EnsureInbox", mock.Anything).Return(inboxCategory(), nil)
+	repo.On("GetExternalIDs", mock.Anything, []string{"fit1", "fit2", "fit3"}).Return([]string{}, nil)
+	repo.On("InsertMany", mock.Anything, mock.MatchedBy(func(expenses []domain.Expense) bool {
+		return len(expenses) == 2
+	})).Return(nil, errors.Wrap(domain.ErrExpenseImported, "duplicate key"))
+	repo.On("GetExternalIDs", mock.Anything, []string{"fit1", "fit2"}).Return([]string{"fit2"}, nil)
+	repo.On("InsertMany", mock.Anything, mock.MatchedBy(func(expenses []domain.Expense) bool {
+		return len(expenses) == 1 && *expenses[0].ExternalID() == "fit1"
+	})).Return([]string{"1"}, nil)
+
+	// SUT
+	sut := command.NewImportStatementHandler(repo, categoryRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 1, result.Accepted, "Should accept the transaction imported once.")
+	assert.Equal(t, 2, result.Skipped, "Should skip the concurrently imported transaction and credit.")
+	assert.True(t, result.Committed, "Should commit.")
+}
+
+func TestImportStatementHandler_QIFDryRun_ResolvesCategoryHints(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	currency := "EUR"
+	cmd := command.ImportStatementCommand{
+		File:            strings.NewReader(qifStatement),
+		Format:          domain.StatementFormatQIF,
+		Mode:            domain.ImportModeDryRun,
+		DefaultCurrency: &currency,
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	categoryRepo.On("GetInbox", mock.Anything).Return(nil, nil)
+	repo.On("GetExternalIDs", mock.Anything, mock.Anything).Return([]string{}, nil)
+
+	// SUT
+	sut := command.NewImportStatementHandler(repo, categoryRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	categoryRepo.AssertNotCalled(t, "EnsureInbox", mock.Anything)
+	repo.AssertNotCalled(t, "InsertMany", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.Accepted, "Should accept all debits.")
+	assert.Equal(t, "Food", result.Rows[0].Expense.Category().Name(), "Should resolve category.")
+	assert.Equal(t, domain.InboxCategoryName, result.Rows[1].Expense.Category().Name(), "Should use inbox.")
+	assert.False(t, result.Committed, "Should not commit dry run.")
+}
+
+func TestImportStatementHandler_QIFWithoutCurrency_RejectsRows(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	cmd := command.ImportStatementCommand{
+		File:   strings.NewReader(qifStatement),
+		Format: domain.StatementFormatQIF,
+		Mode:   domain.ImportModeAtomic,
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	categoryRepo.On("EnsureInbox", mock.Anything).Return(inboxCategory(), nil)
+	repo.On("GetExternalIDs", mock.Anything, mock.Anything).Return([]string{}, nil)
+
+	// SUT
+	sut := command.NewImportStatementHandler(repo, categoryRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "InsertMany", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.Rejected, "Should reject rows without currency.")
+	assert.False(t, result.Committed, "Should not commit.")
+}
+
+func TestImportStatementHandler_PayeeMatchesMerchant_UsesMerchantCategory(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	foodID := importCategories()[0].ID()
+	cafe, _ := domain.NewMerchant("cafeId", domain.MerchantParams{Name: "Cafe", DefaultCategoryID: &foodID})
+	merchantRepo := newMerchantRepo(*cafe)
+	ruleRepo := newRuleRepo()
+	ctx := context.Background()
+	cmd := command.ImportStatementCommand{
+		File:   strings.NewReader(ofxStatement),
+		Format: domain.StatementFormatOFX,
+		Mode:   domain.ImportModeDryRun,
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	categoryRepo.On("GetInbox", mock.Anything).Return(inboxCategory(), nil)
+	repo.On("GetExternalIDs", mock.Anything, mock.Anything).Return([]string{}, nil)
+
+	// SUT
+	sut := command.NewImportStatementHandler(repo, categoryRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.Accepted, "Should accept all debits.")
+	assert.Nil(t, result.Rows[0].Expense.MerchantID(), "Should not match unknown payee.")
+	assert.Equal(t, domain.InboxCategoryName, result.Rows[0].Expense.Category().Name(), "Should use inbox.")
+	assert.Equal(t, "cafeId", *result.Rows[1].Expense.MerchantID(), "Should match merchant by payee.")
+	assert.Equal(t, "Food", result.Rows[1].Expense.Category().Name(), "Should use merchant category.")
+}
+
+func TestImportStatementHandler_RuleMatches_AppliesRule(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	merchantRepo := newMerchantRepo()
+	foodID := importCategories()[0].ID()
+	minAmount := 8.0
+	rule, _ := domain.NewRule("ruleId", domain.RuleParams{
+		Name:       "Large purchases",
+		Conditions: domain.RuleConditions{MinAmount: &minAmount},
+		Actions:    domain.RuleActions{CategoryID: &foodID, Tags: []string{"large"}},
+	})
+	ruleRepo := newRuleRepo(*rule)
+	ctx := context.Background()
+	cmd := command.ImportStatementCommand{
+		File:   strings.NewReader(ofxStatement),
+		Format: domain.StatementFormatOFX,
+		Mode:   domain.ImportModeDryRun,
+	}
+
+	categoryRepo.On("GetAll", mock.Anything).Return(importCategories(), nil)
+	categoryRepo.On("GetInbox", mock.Anything).Return(inboxCategory(), nil)
+	repo.On("GetExternalIDs", mock.Anything, mock.Anything).Return([]string{}, nil)
+
+	// SUT
+	sut := command.NewImportStatementHandler(repo, categoryRepo, merchantRepo, ruleRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.Accepted, "Should accept all debits.")
+	assert.Equal(t, "Food", result.Rows[0].Expense.Category().Name(), "Should use rule category.")
+	assert.Equal(t, []string{"large"}, result.Rows[0].Expense.Tags(), "Should add rule tags.")
+	assert.Equal(t, domain.InboxCategoryName, result.Rows[1].Expense.Category().Name(), "Should use inbox.")
+}
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// MaterializeRecurringExpensesCommand defines a command to add expenses of due occurrences.
+type MaterializeRecurringExpensesCommand struct {
+	Until time.Time
+}
+
+// MaterializeRecurringExpensesHandler defines a handler to materialize recurring expenses.
+type MaterializeRecurringExpensesHandler struct {
+	repo       adapters.RecurringExpenseRepoInterface
+	addExpense AddExpenseHandlerInterface
+	logger     logger.LogInterface
+}
+
+// MaterializeRecurringExpensesHandlerInterface defines a contract to handle command.
+type MaterializeRecurringExpensesHandlerInterface interface {
+	Handle(ctx context.Context, cmd MaterializeRecurringExpensesCommand) (*domain.InsertResult, error)
+}
+
+// NewMaterializeRecurringExpensesHandler returns command handler.
+func NewMaterializeRecurringExpensesHandler(
+	repo adapters.RecurringExpenseRepoInterface,
+	addExpense AddExpenseHandlerInterface,
+	logger logger.LogInterface,
+) MaterializeRecurringExpensesHandler {
+	return MaterializeRecurringExpensesHandler{
+		repo:       repo,
+		addExpense: addExpense,
+		logger:     logger,
+	}
+}
+
+// Handle adds expenses of all due occurrences except skipped ones. Every occurrence is added
+// once, so the command could be safely repeated. A failing series does not stop the others.
+func (h MaterializeRecurringExpensesHandler) Handle(
+	ctx context.Context,
+	cmd MaterializeRecurringExpensesCommand,
+) (*domain.InsertResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute materialize recurring expenses command")
+	defer span.End()
+
+	recurringExpenses, recurringErr := h.repo.GetAll(ctx)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, errors.Wrap(recurringErr, "get recurring expenses")
+	}
+
+	result := &domain.InsertResult{}
+	for _, recurringExpense := range recurringExpenses {
+		materialized, materializeErr := h.materialize(ctx, recurringExpense, cmd.Until)
+		result.InsertCount += materialized
+		if materializeErr != nil {
+			tracer.AddSpanError(span, materializeErr)
+			h.logger.Error(ctx, "Failed to materialize recurring expense "+recurringExpense.ID(), materializeErr)
+		}
+	}
+
+	return result, nil
+}
+
+func (h MaterializeRecurringExpensesHandler) materialize(
+	ctx context.Context,
+	recurringExpense domain.RecurringExpense,
+	until time.Time,
+) (int, error) {
+	occurrences := recurringExpense.DueOccurrences(until)
+	if len(occurrences) == 0 {
+		return 0, nil
+	}
+
+	materialized := 0
+	for _, occurrence := range occurrences {
+		if occurrence.Skipped {
+			continue
+		}
+
+		recurrence := recurringExpense.Recurrence(occurrence)
+		cmd := AddExpenseCommand{
+			Category:   recurringExpense.Category(),
+			Price:      occurrence.Price,
+			Quantity:   occurrence.Quantity,
+			Currency:   recurringExpense.Currency(),
+			Date:       occurrence.Date,
+			Comment:    occurrence.Comment,
+			TripID:     recurringExpense.TripID(),
+			Recurrence: &recurrence,
+		}
+		if _, addErr := h.addExpense.Handle(ctx, cmd); addErr != nil {
+			return materialized, errors.Wrapf(addErr, "add occurrence %s", occurrence.Date.Format("2006-01-02"))
+		}
+		materialized++
+	}
+
+	lastOccurrence := occurrences[len(occurrences)-1].Date
+	if updateErr := h.repo.UpdateMaterializedUntil(ctx, recurringExpense.ID(), lastOccurrence); updateErr != nil {
+		return materialized, errors.Wrap(updateErr, "update materialized until")
+	}
+
+	return materialized, nil
+}
@@ -0,0 +1,170 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newMonthlyRecurringExpense(id string, opts ...func(*domain.RecurringExpense)) domain.RecurringExpense {
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency: "monthly",
+		Start:     time.Date(2021, time.January, 5, 0, 0, 0, 0, time.UTC),
+	})
+	recurringExpense, _ := domain.NewRecurringExpense(id, *category, 500, "EUR", 1, nil, nil, *schedule, opts...)
+	return *recurringExpense
+}
+
+func TestNewMaterializeRecurringExpensesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	addExpense := new(mocks.AddExpenseHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewMaterializeRecurringExpensesHandler(repo, addExpense, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestMaterializeRecurringExpensesHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	addExpense := new(mocks.AddExpenseHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.MaterializeRecurringExpensesCommand{Until: time.Now()}
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewMaterializeRecurringExpensesHandler(repo, addExpense, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestMaterializeRecurringExpensesHandler_DueOccurrences_AddsExpensesExceptSkipped(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	addExpense := new(mocks.AddExpenseHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	materializedUntil := time.Date(2021, time.January, 5, 0, 0, 0, 0, time.UTC)
+	skipped, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date:    time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC),
+		Skipped: true,
+	})
+	recurringExpense := newMonthlyRecurringExpense("recurringId",
+		domain.SetMaterializedUntil(&materializedUntil),
+		domain.SetOccurrenceExceptions([]domain.OccurrenceException{*skipped}))
+	march := time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2021, time.April, 5, 0, 0, 0, 0, time.UTC)
+	cmd := command.MaterializeRecurringExpensesCommand{Until: time.Date(2021, time.April, 20, 0, 0, 0, 0, time.UTC)}
+	expenseID := "expenseId"
+
+	repo.On("GetAll", mock.Anything).Return([]domain.RecurringExpense{recurringExpense}, nil)
+	for _, day := range []time.Time{march, april} {
+		recurrence := domain.Recurrence{RecurringExpenseID: "recurringId", Date: day}
+		addExpense.On("Handle", mock.Anything, mock.MatchedBy(func(addCmd command.AddExpenseCommand) bool {
+			return addCmd.Date.Equal(recurrence.Date) && *addCmd.Recurrence == recurrence && addCmd.Price == 500
+		})).Return(&domain.AddExpenseResult{ID: expenseID}, nil).Once()
+	}
+	repo.On("UpdateMaterializedUntil", mock.Anything, "recurringId", april).Return(nil)
+
+	// SUT
+	sut := command.NewMaterializeRecurringExpensesHandler(repo, addExpense, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	addExpense.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &domain.InsertResult{InsertCount: 2}, result, "Should add non skipped occurrences.")
+}
+
+func TestMaterializeRecurringExpensesHandler_NothingDue_SkipsSeries(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	addExpense := new(mocks.AddExpenseHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	materializedUntil := time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC)
+	recurringExpense := newMonthlyRecurringExpense("recurringId", domain.SetMaterializedUntil(&materializedUntil))
+	cmd := command.MaterializeRecurringExpensesCommand{Until: time.Date(2021, time.March, 20, 0, 0, 0, 0, time.UTC)}
+
+	repo.On("GetAll", mock.Anything).Return([]domain.RecurringExpense{recurringExpense}, nil)
+
+	// SUT
+	sut := command.NewMaterializeRecurringExpensesHandler(repo, addExpense, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	addExpense.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "UpdateMaterializedUntil", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &domain.InsertResult{InsertCount: 0}, result, "Should not add expenses.")
+}
+
+func TestMaterializeRecurringExpensesHandler_SeriesFails_ContinuesWithOthers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	addExpense := new(mocks.AddExpenseHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	until := time.Date(2021, time.January, 10, 0, 0, 0, 0, time.UTC)
+	failing := newMonthlyRecurringExpense("failingId")
+	succeeding := newMonthlyRecurringExpense("succeedingId")
+	cmd := command.MaterializeRecurringExpensesCommand{Until: until}
+	expenseID := "expenseId"
+	january := time.Date(2021, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	repo.On("GetAll", mock.Anything).Return([]domain.RecurringExpense{failing, succeeding}, nil)
+	addExpense.On("Handle", mock.Anything, mock.MatchedBy(func(addCmd command.AddExpenseCommand) bool {
+		return addCmd.Recurrence.RecurringExpenseID == "failingId"
+	})).Return(nil, errors.New("error"))
+	addExpense.On("Handle", mock.Anything, mock.MatchedBy(func(addCmd command.AddExpenseCommand) bool {
+		return addCmd.Recurrence.RecurringExpenseID == "succeedingId"
+	})).Return(&domain.AddExpenseResult{ID: expenseID}, nil)
+	repo.On("UpdateMaterializedUntil", mock.Anything, "succeedingId", january).Return(nil)
+	log.On("Error", mock.Anything, mock.Anything, mock.Anything)
+
+	// SUT
+	sut := command.NewMaterializeRecurringExpensesHandler(repo, addExpense, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	addExpense.AssertExpectations(t)
+	log.AssertExpectations(t)
+	repo.AssertNotCalled(t, "UpdateMaterializedUntil", mock.Anything, "failingId", mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &domain.InsertResult{InsertCount: 1}, result, "Should add occurrences of other series.")
+}
@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// MergeMerchantsCommand defines a command to merge several merchants into a single one.
+type MergeMerchantsCommand struct {
+	ID          string
+	MerchantIDs []string
+}
+
+// MergeMerchantsHandler defines a handler to merge merchants.
+type MergeMerchantsHandler struct {
+	repo   adapters.MerchantRepoInterface
+	logger logger.LogInterface
+}
+
+// MergeMerchantsHandlerInterface defines a contract to handle command.
+type MergeMerchantsHandlerInterface interface {
+	Handle(ctx context.Context, cmd MergeMerchantsCommand) (*domain.Merchant, error)
+}
+
+// NewMergeMerchantsHandler returns command handler.
+func NewMergeMerchantsHandler(
+	repo adapters.MerchantRepoInterface,
+	logger logger.LogInterface,
+) MergeMerchantsHandler {
+	return MergeMerchantsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles merge merchants command. Names and aliases of the merged merchants become aliases
+// of the target merchant, their expenses are moved to the target merchant. Returns nil if the target is missing.
+func (h MergeMerchantsHandler) Handle(ctx context.Context, cmd MergeMerchantsCommand) (*domain.Merchant, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute merge merchants command")
+	span.SetAttributes(attribute.String("id", cmd.ID), attribute.StringSlice("merchantIds", cmd.MerchantIDs))
+	defer span.End()
+
+	if len(cmd.MerchantIDs) == 0 {
+		return nil, errors.Wrap(domain.ErrInvalidMerchant, "no merchants to merge")
+	}
+
+	target, targetErr := h.repo.GetOne(ctx, cmd.ID)
+	if targetErr != nil {
+		tracer.AddSpanError(span, targetErr)
+		return nil, errors.Wrap(targetErr, "get merchant for merge")
+	}
+
+	if target == nil {
+		return nil, nil
+	}
+
+	sourceIDs := make([]string, 0, len(cmd.MerchantIDs))
+	sources := make([]domain.Merchant, 0, len(cmd.MerchantIDs))
+	seen := make(map[string]bool, len(cmd.MerchantIDs))
+	for _, sourceID := range cmd.MerchantIDs {
+		if seen[sourceID] {
+			continue
+		}
+		seen[sourceID] = true
+
+		source, sourceErr := h.repo.GetOne(ctx, sourceID)
+		if sourceErr != nil {
+			tracer.AddSpanError(span, sourceErr)
+			return nil, errors.Wrap(sourceErr, "get merged merchant")
+		}
+
+		if source == nil {
+			return nil, errors.Wrapf(domain.ErrMerchantNotFound, "merchant %s", sourceID)
+		}
+
+		sourceIDs = append(sourceIDs, source.ID())
+		sources = append(sources, *source)
+	}
+
+	merchant, mergeErr := target.Merge(sources)
+	if mergeErr != nil {
+		tracer.AddSpanError(span, mergeErr)
+		return nil, errors.Wrap(domain.ErrInvalidMerchant, mergeErr.Error())
+	}
+
+	if _, updateErr := h.repo.Merge(ctx, *merchant, sourceIDs); updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "merge merchants")
+	}
+
+	return merchant, nil
+}
@@ -0,0 +1,170 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewMergeMerchantsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewMergeMerchantsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestMergeMerchantsHandler_NoMerchants_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.MergeMerchantsCommand{ID: "reweId"}
+
+	// SUT
+	sut := command.NewMergeMerchantsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "GetOne", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidMerchant, "Should return invalid merchant error.")
+}
+
+func TestMergeMerchantsHandler_TargetNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.MergeMerchantsCommand{ID: "reweId", MerchantIDs: []string{"reweCityId"}}
+
+	repo.On("GetOne", mock.Anything, "reweId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewMergeMerchantsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestMergeMerchantsHandler_SourceNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	target := newMerchant("reweId", "Rewe")
+	cmd := command.MergeMerchantsCommand{ID: "reweId", MerchantIDs: []string{"reweCityId"}}
+
+	repo.On("GetOne", mock.Anything, "reweId").Return(&target, nil)
+	repo.On("GetOne", mock.Anything, "reweCityId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewMergeMerchantsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrMerchantNotFound, "Should return merchant not found error.")
+}
+
+func TestMergeMerchantsHandler_MergeItself_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	target := newMerchant("reweId", "Rewe")
+	cmd := command.MergeMerchantsCommand{ID: "reweId", MerchantIDs: []string{"reweId"}}
+
+	repo.On("GetOne", mock.Anything, "reweId").Return(&target, nil)
+
+	// SUT
+	sut := command.NewMergeMerchantsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidMerchant, "Should return invalid merchant error.")
+}
+
+func TestMergeMerchantsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	target := newMerchant("reweId", "Rewe")
+	source := newMerchant("reweCityId", "Rewe City")
+	cmd := command.MergeMerchantsCommand{ID: "reweId", MerchantIDs: []string{"reweCityId"}}
+
+	repo.On("GetOne", mock.Anything, "reweId").Return(&target, nil)
+	repo.On("GetOne", mock.Anything, "reweCityId").Return(&source, nil)
+	repo.On("Merge", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewMergeMerchantsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestMergeMerchantsHandler_RepoSuccess_ReturnsMergedMerchant(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	target := newMerchant("reweId", "Rewe")
+	source := newMerchant("reweCityId", "Rewe City", "REWE CITY BERLIN")
+	cmd := command.MergeMerchantsCommand{ID: "reweId", MerchantIDs: []string{"reweCityId", "reweCityId"}}
+
+	repo.On("GetOne", mock.Anything, "reweId").Return(&target, nil)
+	repo.On("GetOne", mock.Anything, "reweCityId").Return(&source, nil).Once()
+	repo.On("Merge", mock.Anything, mock.MatchedBy(func(merchant domain.Merchant) bool {
+		return merchant.ID() == "reweId" && len(merchant.Aliases()) == 2
+	}), []string{"reweCityId"}).Return(&domain.UpdateResult{UpdateCount: 3}, nil)
+
+	// SUT
+	sut := command.NewMergeMerchantsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, []string{"Rewe City", "REWE CITY BERLIN"}, result.Aliases())
+}
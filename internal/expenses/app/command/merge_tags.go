@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// MergeTagsCommand defines a command to replace several tags of all expenses with a single tag.
+type MergeTagsCommand struct {
+	Tags []string
+	Into string
+}
+
+// MergeTagsHandler defines a handler to merge tags.
+type MergeTagsHandler struct {
+	repo   adapters.TagRepoInterface
+	logger logger.LogInterface
+}
+
+// MergeTagsHandlerInterface defines a contract to handle command.
+type MergeTagsHandlerInterface interface {
+	Handle(ctx context.Context, cmd MergeTagsCommand) (*domain.UpdateResult, error)
+}
+
+// NewMergeTagsHandler returns command handler.
+func NewMergeTagsHandler(
+	repo adapters.TagRepoInterface,
+	logger logger.LogInterface,
+) MergeTagsHandler {
+	return MergeTagsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles merge tags command. The target tag may be one of the merged tags.
+func (h MergeTagsHandler) Handle(ctx context.Context, cmd MergeTagsCommand) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute merge tags command")
+	span.SetAttributes(attribute.StringSlice("tags", cmd.Tags), attribute.String("into", cmd.Into))
+	defer span.End()
+
+	into := domain.NormalizeTag(cmd.Into)
+	if len(into) == 0 {
+		return nil, errors.Wrap(domain.ErrInvalidTag, "empty target tag")
+	}
+	tags := domain.NormalizeTags(cmd.Tags)
+	if len(tags) == 0 {
+		return nil, errors.Wrap(domain.ErrInvalidTag, "no tags to merge")
+	}
+
+	result, replaceErr := h.repo.Replace(ctx, tags, into)
+	if replaceErr != nil {
+		tracer.AddSpanError(span, replaceErr)
+		return nil, errors.Wrap(replaceErr, "replace tags")
+	}
+
+	return result, nil
+}
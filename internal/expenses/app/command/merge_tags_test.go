@@ -0,0 +1,98 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewMergeTagsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewMergeTagsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestMergeTagsHandler_InvalidTags_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmds := []command.MergeTagsCommand{
+		{Tags: []string{"business"}, Into: " "},
+		{Tags: []string{" "}, Into: "work"},
+		{Tags: nil, Into: "work"},
+	}
+
+	// SUT
+	sut := command.NewMergeTagsHandler(repo, log)
+
+	for _, cmd := range cmds {
+		// Act
+		result, err := sut.Handle(ctx, cmd)
+
+		// Assert
+		assert.Nil(t, result, "Result should be nil.")
+		assert.ErrorIs(t, err, domain.ErrInvalidTag, "Should return invalid tag error.")
+	}
+	repo.AssertNotCalled(t, "Replace", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMergeTagsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.MergeTagsCommand{Tags: []string{"job", "office"}, Into: "work"}
+
+	repo.On("Replace", mock.Anything, []string{"job", "office"}, "work").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewMergeTagsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestMergeTagsHandler_RepoSuccess_ReturnsResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.MergeTagsCommand{Tags: []string{"Office", "job", "work"}, Into: "Work"}
+	updateResult := &domain.UpdateResult{UpdateCount: 2}
+
+	repo.On("Replace", mock.Anything, []string{"job", "office", "work"}, "work").Return(updateResult, nil)
+
+	// SUT
+	sut := command.NewMergeTagsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, updateResult, result, "Should return update result.")
+}
@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// MigrateTripsCommand defines a command to convert free-text trip names into trip references.
+type MigrateTripsCommand struct{}
+
+// MigrateTripsHandler defines a handler to migrate trips.
+type MigrateTripsHandler struct {
+	repo   adapters.TripRepoInterface
+	logger logger.LogInterface
+}
+
+// MigrateTripsHandlerInterface defines a contract to handle command.
+type MigrateTripsHandlerInterface interface {
+	Handle(ctx context.Context, cmd MigrateTripsCommand) (*domain.UpdateResult, error)
+}
+
+// NewMigrateTripsHandler returns command handler.
+func NewMigrateTripsHandler(
+	repo adapters.TripRepoInterface,
+	logger logger.LogInterface,
+) MigrateTripsHandler {
+	return MigrateTripsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles migrate trips command. Trip names differing in case or surrounding spaces only are
+// merged into a single trip, an existing trip with the same name is reused. Migrated expenses no longer
+// hold free-text trip names, so running the command again changes nothing.
+func (h MigrateTripsHandler) Handle(ctx context.Context, cmd MigrateTripsCommand) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute migrate trips command")
+	defer span.End()
+
+	usages, usagesErr := h.repo.GetLegacyTrips(ctx)
+	if usagesErr != nil {
+		tracer.AddSpanError(span, usagesErr)
+		return nil, errors.Wrap(usagesErr, "get legacy trips")
+	}
+
+	result := &domain.UpdateResult{}
+	migrations := domain.NewLegacyTripMigrations(usages)
+	if len(migrations) == 0 {
+		return result, nil
+	}
+
+	trips, tripsErr := h.repo.GetAll(ctx)
+	if tripsErr != nil {
+		tracer.AddSpanError(span, tripsErr)
+		return nil, errors.Wrap(tripsErr, "get trips")
+	}
+	tripIDs := make(map[string]string, len(trips))
+	for _, trip := range trips {
+		tripIDs[trip.Key()] = trip.ID()
+	}
+
+	for _, migration := range migrations {
+		tripID, ok := tripIDs[migration.Trip.Key()]
+		if !ok {
+			insertedID, insertErr := h.repo.Insert(ctx, migration.Trip)
+			if insertErr != nil {
+				tracer.AddSpanError(span, insertErr)
+				return nil, errors.Wrapf(insertErr, "insert trip %s", migration.Trip.Name())
+			}
+			tripID = *insertedID
+			tripIDs[migration.Trip.Key()] = tripID
+		}
+
+		linkResult, linkErr := h.repo.LinkLegacyTrips(ctx, migration.Names, tripID)
+		if linkErr != nil {
+			tracer.AddSpanError(span, linkErr)
+			return nil, errors.Wrapf(linkErr, "link trip %s", migration.Trip.Name())
+		}
+		result.UpdateCount += linkResult.UpdateCount
+	}
+
+	return result, nil
+}
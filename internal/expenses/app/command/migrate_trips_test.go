@@ -0,0 +1,108 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewMigrateTripsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewMigrateTripsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestMigrateTripsHandler_NoLegacyTrips_ChangesNothing(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetLegacyTrips", mock.Anything).Return([]domain.LegacyTripUsage{}, nil)
+
+	// SUT
+	sut := command.NewMigrateTripsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.MigrateTripsCommand{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetAll", mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 0, result.UpdateCount, "Nothing should be linked.")
+}
+
+func TestMigrateTripsHandler_LegacyTrips_LinksToExistingAndNewTrips(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	date := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	usages := []domain.LegacyTripUsage{
+		{Name: "Paris 2021", Currency: "EUR", From: date, To: date, Count: 2},
+		{Name: "paris 2021", Currency: "EUR", From: date, To: date, Count: 1},
+		{Name: "Berlin", Currency: "EUR", From: date, To: date, Count: 1},
+	}
+	existing := newTrip("parisId", "PARIS 2021")
+	berlinID := "berlinId"
+
+	repo.On("GetLegacyTrips", mock.Anything).Return(usages, nil)
+	repo.On("GetAll", mock.Anything).Return([]domain.Trip{existing}, nil)
+	repo.On("Insert", mock.Anything, mock.MatchedBy(func(trip domain.Trip) bool {
+		return trip.Name() == "Berlin"
+	})).Return(&berlinID, nil).Once()
+	repo.On("LinkLegacyTrips", mock.Anything, []string{"Berlin"}, "berlinId").
+		Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+	repo.On("LinkLegacyTrips", mock.Anything, []string{"Paris 2021", "paris 2021"}, "parisId").
+		Return(&domain.UpdateResult{UpdateCount: 3}, nil)
+
+	// SUT
+	sut := command.NewMigrateTripsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.MigrateTripsCommand{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 4, result.UpdateCount, "Should count linked expenses.")
+}
+
+func TestMigrateTripsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetLegacyTrips", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewMigrateTripsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.MigrateTripsCommand{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
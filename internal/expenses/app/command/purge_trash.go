@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// PurgeTrashCommand defines a command to permanently delete expired trash items.
+type PurgeTrashCommand struct {
+	DeletedBefore time.Time
+}
+
+// PurgeTrashHandler defines a handler to purge trash.
+type PurgeTrashHandler struct {
+	repo           adapters.TrashRepoInterface
+	attachmentRepo adapters.AttachmentRepoInterface
+	blobStore      adapters.BlobStore
+	logger         logger.LogInterface
+}
+
+// PurgeTrashHandlerInterface defines a contract to handle command.
+type PurgeTrashHandlerInterface interface {
+	Handle(ctx context.Context, cmd PurgeTrashCommand) (*domain.DeleteResult, error)
+}
+
+// NewPurgeTrashHandler returns command handler.
+func NewPurgeTrashHandler(
+	repo adapters.TrashRepoInterface,
+	attachmentRepo adapters.AttachmentRepoInterface,
+	blobStore adapters.BlobStore,
+	logger logger.LogInterface,
+) PurgeTrashHandler {
+	return PurgeTrashHandler{
+		repo:           repo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		logger:         logger,
+	}
+}
+
+// Handle handles purge trash command. Attachments of purged expenses are deleted as well.
+func (h PurgeTrashHandler) Handle(ctx context.Context, cmd PurgeTrashCommand) (*domain.DeleteResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute purge trash command")
+	defer span.End()
+
+	if cmd.DeletedBefore.IsZero() {
+		return nil, errors.New("empty purge date")
+	}
+
+	purgeResult, purgeErr := h.repo.Purge(ctx, cmd.DeletedBefore)
+	if purgeErr != nil {
+		tracer.AddSpanError(span, purgeErr)
+		return nil, errors.Wrap(purgeErr, "purge trash")
+	}
+
+	// Orphans are looked up rather than collected from the purged expenses,
+	// so attachments left behind by a failed run are deleted by the next one.
+	orphans, orphansErr := h.attachmentRepo.GetOrphaned(ctx)
+	if orphansErr != nil {
+		tracer.AddSpanError(span, orphansErr)
+		return nil, errors.Wrap(orphansErr, "find orphaned attachments")
+	}
+	if len(orphans) != 0 {
+		if _, deleteErr := deleteAttachments(ctx, h.attachmentRepo, h.blobStore, h.logger, orphans); deleteErr != nil {
+			tracer.AddSpanError(span, deleteErr)
+			return nil, deleteErr
+		}
+	}
+
+	return purgeResult, nil
+}
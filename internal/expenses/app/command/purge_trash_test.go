@@ -0,0 +1,168 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewPurgeTrashHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewPurgeTrashHandler(repo, attachmentRepo, blobStore, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestPurgeTrashHandler_EmptyDate_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.PurgeTrashCommand{}
+
+	// SUT
+	sut := command.NewPurgeTrashHandler(repo, attachmentRepo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Purge", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestPurgeTrashHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.PurgeTrashCommand{DeletedBefore: time.Now()}
+
+	repo.On("Purge", mock.Anything, cmd.DeletedBefore).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewPurgeTrashHandler(repo, attachmentRepo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestPurgeTrashHandler_RepoSuccess_ReturnsResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.PurgeTrashCommand{DeletedBefore: time.Now()}
+	purgeResult := &domain.DeleteResult{DeleteCount: 5}
+
+	repo.On("Purge", mock.Anything, cmd.DeletedBefore).Return(purgeResult, nil)
+	attachmentRepo.On("GetOrphaned", mock.Anything).Return([]domain.Attachment{}, nil)
+
+	// SUT
+	sut := command.NewPurgeTrashHandler(repo, attachmentRepo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, purgeResult, result, "Should return purge result.")
+}
+
+func TestPurgeTrashHandler_OrphanedAttachments_DeletesAttachments(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.PurgeTrashCommand{DeletedBefore: time.Now()}
+	purgeResult := &domain.DeleteResult{DeleteCount: 1}
+	thumbnailKey := "expenseId/blobId-thumbnail"
+	attachment, _ := domain.NewAttachment("attachmentId", domain.AttachmentParams{
+		ExpenseID:    "expenseId",
+		FileName:     "receipt.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		BlobKey:      "expenseId/blobId",
+		ThumbnailKey: &thumbnailKey,
+		CreatedAt:    time.Now(),
+	})
+
+	repo.On("Purge", mock.Anything, cmd.DeletedBefore).Return(purgeResult, nil)
+	attachmentRepo.On("GetOrphaned", mock.Anything).Return([]domain.Attachment{*attachment}, nil)
+	attachmentRepo.On("DeleteMany", mock.Anything, []string{"attachmentId"}).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+	blobStore.On("Delete", mock.Anything, "expenseId/blobId").Return(nil)
+	blobStore.On("Delete", mock.Anything, thumbnailKey).Return(nil)
+
+	// SUT
+	sut := command.NewPurgeTrashHandler(repo, attachmentRepo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	attachmentRepo.AssertExpectations(t)
+	blobStore.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, purgeResult, result, "Should return purge result.")
+}
+
+func TestPurgeTrashHandler_OrphanedAttachmentsError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.PurgeTrashCommand{DeletedBefore: time.Now()}
+
+	repo.On("Purge", mock.Anything, cmd.DeletedBefore).Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+	attachmentRepo.On("GetOrphaned", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewPurgeTrashHandler(repo, attachmentRepo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	blobStore.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// RenameTagCommand defines a command to rename a tag of all expenses.
+type RenameTagCommand struct {
+	Name    string
+	NewName string
+}
+
+// RenameTagHandler defines a handler to rename tag.
+type RenameTagHandler struct {
+	repo   adapters.TagRepoInterface
+	logger logger.LogInterface
+}
+
+// RenameTagHandlerInterface defines a contract to handle command.
+type RenameTagHandlerInterface interface {
+	Handle(ctx context.Context, cmd RenameTagCommand) (*domain.UpdateResult, error)
+}
+
+// NewRenameTagHandler returns command handler.
+func NewRenameTagHandler(
+	repo adapters.TagRepoInterface,
+	logger logger.LogInterface,
+) RenameTagHandler {
+	return RenameTagHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles rename tag command. It returns nil when no expense is tagged with the tag.
+// Renaming a tag to an existing one merges them.
+func (h RenameTagHandler) Handle(ctx context.Context, cmd RenameTagCommand) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute rename tag command")
+	span.SetAttributes(attribute.String("name", cmd.Name), attribute.String("newName", cmd.NewName))
+	defer span.End()
+
+	name := domain.NormalizeTag(cmd.Name)
+	newName := domain.NormalizeTag(cmd.NewName)
+	if len(name) == 0 || len(newName) == 0 {
+		return nil, errors.Wrap(domain.ErrInvalidTag, "empty tag")
+	}
+	if name == newName {
+		return nil, errors.Wrap(domain.ErrInvalidTag, "tag is renamed to itself")
+	}
+
+	result, replaceErr := h.repo.Replace(ctx, []string{name}, newName)
+	if replaceErr != nil {
+		tracer.AddSpanError(span, replaceErr)
+		return nil, errors.Wrap(replaceErr, "replace tag")
+	}
+	if result.UpdateCount == 0 {
+		return nil, nil
+	}
+
+	return result, nil
+}
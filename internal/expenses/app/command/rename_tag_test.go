@@ -0,0 +1,120 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewRenameTagHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewRenameTagHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestRenameTagHandler_InvalidTags_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmds := []command.RenameTagCommand{
+		{Name: "business", NewName: " "},
+		{Name: "", NewName: "work"},
+		{Name: "Business", NewName: "business "},
+	}
+
+	// SUT
+	sut := command.NewRenameTagHandler(repo, log)
+
+	for _, cmd := range cmds {
+		// Act
+		result, err := sut.Handle(ctx, cmd)
+
+		// Assert
+		assert.Nil(t, result, "Result should be nil.")
+		assert.ErrorIs(t, err, domain.ErrInvalidTag, "Should return invalid tag error.")
+	}
+	repo.AssertNotCalled(t, "Replace", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRenameTagHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.RenameTagCommand{Name: "business", NewName: "work"}
+
+	repo.On("Replace", mock.Anything, []string{"business"}, "work").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewRenameTagHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestRenameTagHandler_UnknownTag_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.RenameTagCommand{Name: "business", NewName: "work"}
+
+	repo.On("Replace", mock.Anything, []string{"business"}, "work").Return(&domain.UpdateResult{}, nil)
+
+	// SUT
+	sut := command.NewRenameTagHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestRenameTagHandler_RepoSuccess_ReturnsResult(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.RenameTagCommand{Name: " Business", NewName: "Work"}
+	updateResult := &domain.UpdateResult{UpdateCount: 3}
+
+	repo.On("Replace", mock.Anything, []string{"business"}, "work").Return(updateResult, nil)
+
+	// SUT
+	sut := command.NewRenameTagHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, updateResult, result, "Should return update result.")
+}
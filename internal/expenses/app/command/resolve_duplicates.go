@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// ResolveDuplicatesCommand defines a command to keep one expense of a duplicate group.
+type ResolveDuplicatesCommand struct {
+	GroupID    string
+	KeepID     string
+	Resolution domain.DuplicateResolution
+	ResolvedBy string
+}
+
+// ResolveDuplicatesHandler defines a handler to resolve duplicate expenses.
+type ResolveDuplicatesHandler struct {
+	repo   adapters.ExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// ResolveDuplicatesHandlerInterface defines a contract to handle command.
+type ResolveDuplicatesHandlerInterface interface {
+	Handle(ctx context.Context, cmd ResolveDuplicatesCommand) (*domain.Expense, error)
+}
+
+// NewResolveDuplicatesHandler returns command handler.
+func NewResolveDuplicatesHandler(
+	repo adapters.ExpenseRepoInterface,
+	logger logger.LogInterface,
+) ResolveDuplicatesHandler {
+	return ResolveDuplicatesHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles resolve duplicates command. The kept expense is completed with the details of the
+// rest when they are merged, the rest is moved to the trash. Returns nil if any of the group expenses is missing.
+// Locked expenses could be kept as they are only.
+func (h ResolveDuplicatesHandler) Handle(ctx context.Context, cmd ResolveDuplicatesCommand) (*domain.Expense, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute resolve duplicates command")
+	span.SetAttributes(attribute.String("group", cmd.GroupID), attribute.String("keep", cmd.KeepID))
+	defer span.End()
+
+	if cmd.Resolution != domain.DuplicateResolutionDelete && cmd.Resolution != domain.DuplicateResolutionMerge {
+		return nil, errors.Wrapf(domain.ErrInvalidDuplicateResolution, "unknown resolution %s", cmd.Resolution)
+	}
+
+	ids, idsErr := domain.ParseDuplicateGroupID(cmd.GroupID)
+	if idsErr != nil {
+		tracer.AddSpanError(span, idsErr)
+		return nil, errors.Wrap(domain.ErrInvalidDuplicateResolution, idsErr.Error())
+	}
+
+	var keep *domain.Expense
+	duplicates := make([]domain.Expense, 0, len(ids)-1)
+	for _, id := range ids {
+		expense, expenseErr := h.repo.GetOne(ctx, id)
+		if expenseErr != nil {
+			tracer.AddSpanError(span, expenseErr)
+			return nil, errors.Wrapf(expenseErr, "get expense %s", id)
+		}
+		if expense == nil {
+			return nil, nil
+		}
+		if expense.Locked() && (id != cmd.KeepID || cmd.Resolution == domain.DuplicateResolutionMerge) {
+			return nil, errors.Wrapf(domain.ErrExpenseLocked, "expense %s", id)
+		}
+		if id == cmd.KeepID {
+			keep = expense
+			continue
+		}
+		duplicates = append(duplicates, *expense)
+	}
+
+	if keep == nil {
+		return nil, errors.Wrapf(domain.ErrInvalidDuplicateResolution,
+			"expense %s is not in the duplicate group", cmd.KeepID)
+	}
+
+	if cmd.Resolution == domain.DuplicateResolutionMerge {
+		merged := domain.MergeDuplicates(*keep, duplicates)
+		domain.SetUpdateMetadata(cmd.ResolvedBy, time.Now())(&merged)
+		if _, updateErr := h.repo.Update(ctx, merged); updateErr != nil {
+			tracer.AddSpanError(span, updateErr)
+			return nil, errors.Wrapf(updateErr, "update expense %s", merged.ID())
+		}
+		keep = &merged
+	}
+
+	for _, duplicate := range duplicates {
+		if _, deleteErr := h.repo.SoftDeleteOne(ctx, duplicate.ID(), cmd.ResolvedBy); deleteErr != nil {
+			tracer.AddSpanError(span, deleteErr)
+			return nil, errors.Wrapf(deleteErr, "delete expense %s", duplicate.ID())
+		}
+	}
+
+	keep.CalculateTotal(nil)
+
+	return keep, nil
+}
@@ -0,0 +1,212 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newDuplicateExpense(id string, comment *string, tags []string) *domain.Expense {
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	date := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	expense, _ := domain.NewExpense(id, *category, 45.5, "EUR", 1, comment, nil, date, domain.SetTags(tags))
+	return expense
+}
+
+func newResolveDuplicatesCommand(resolution domain.DuplicateResolution) command.ResolveDuplicatesCommand {
+	return command.ResolveDuplicatesCommand{
+		GroupID:    "keepId-otherId",
+		KeepID:     "keepId",
+		Resolution: resolution,
+		ResolvedBy: "user",
+	}
+}
+
+func TestNewResolveDuplicatesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewResolveDuplicatesHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestResolveDuplicatesHandler_InvalidCommand_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	unknownResolution := newResolveDuplicatesCommand("ignore")
+	malformedGroup := newResolveDuplicatesCommand(domain.DuplicateResolutionDelete)
+	malformedGroup.GroupID = "keepId"
+
+	// SUT
+	sut := command.NewResolveDuplicatesHandler(repo, log)
+
+	for _, cmd := range []command.ResolveDuplicatesCommand{unknownResolution, malformedGroup} {
+		// Act
+		result, err := sut.Handle(ctx, cmd)
+
+		// Assert
+		assert.Nil(t, result, "Result should be nil.")
+		assert.ErrorIs(t, err, domain.ErrInvalidDuplicateResolution, "Should return invalid resolution error.")
+	}
+	repo.AssertNotCalled(t, "GetOne", mock.Anything, mock.Anything)
+}
+
+func TestResolveDuplicatesHandler_KeptExpenseOutOfGroup_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newResolveDuplicatesCommand(domain.DuplicateResolutionDelete)
+	cmd.KeepID = "unknownId"
+
+	repo.On("GetOne", mock.Anything, "keepId").Return(newDuplicateExpense("keepId", nil, nil), nil)
+	repo.On("GetOne", mock.Anything, "otherId").Return(newDuplicateExpense("otherId", nil, nil), nil)
+
+	// SUT
+	sut := command.NewResolveDuplicatesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "SoftDeleteOne", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidDuplicateResolution, "Should return invalid resolution error.")
+}
+
+func TestResolveDuplicatesHandler_ExpenseMissing_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "keepId").Return(newDuplicateExpense("keepId", nil, nil), nil)
+	repo.On("GetOne", mock.Anything, "otherId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewResolveDuplicatesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newResolveDuplicatesCommand(domain.DuplicateResolutionDelete))
+
+	// Assert
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "SoftDeleteOne", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestResolveDuplicatesHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "keepId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewResolveDuplicatesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newResolveDuplicatesCommand(domain.DuplicateResolutionDelete))
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestResolveDuplicatesHandler_LockedDuplicate_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	locked := newDuplicateExpense("otherId", nil, nil)
+	domain.SetReconciliation("reconciliationId")(locked)
+
+	repo.On("GetOne", mock.Anything, "keepId").Return(newDuplicateExpense("keepId", nil, nil), nil)
+	repo.On("GetOne", mock.Anything, "otherId").Return(locked, nil)
+
+	// SUT
+	sut := command.NewResolveDuplicatesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newResolveDuplicatesCommand(domain.DuplicateResolutionDelete))
+
+	// Assert
+	repo.AssertNotCalled(t, "SoftDeleteOne", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrExpenseLocked, "Should not delete locked expense.")
+}
+
+func TestResolveDuplicatesHandler_Delete_DeletesDuplicates(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "keepId").Return(newDuplicateExpense("keepId", nil, nil), nil)
+	repo.On("GetOne", mock.Anything, "otherId").Return(newDuplicateExpense("otherId", nil, []string{"dinner"}), nil)
+	repo.On("SoftDeleteOne", mock.Anything, "otherId", "user").Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	// SUT
+	sut := command.NewResolveDuplicatesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newResolveDuplicatesCommand(domain.DuplicateResolutionDelete))
+
+	// Assert
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "keepId", result.ID(), "Should return kept expense.")
+	assert.Empty(t, result.Tags(), "Should keep the expense as is.")
+}
+
+func TestResolveDuplicatesHandler_Merge_CompletesKeptExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	comment := "Dinner at Luigi"
+
+	repo.On("GetOne", mock.Anything, "keepId").Return(newDuplicateExpense("keepId", nil, nil), nil)
+	repo.On("GetOne", mock.Anything, "otherId").Return(newDuplicateExpense("otherId", &comment, []string{"dinner"}), nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(expense domain.Expense) bool {
+		return expense.ID() == "keepId" && *expense.Comment() == comment && *expense.UpdatedBy() == "user"
+	})).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+	repo.On("SoftDeleteOne", mock.Anything, "otherId", "user").Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	// SUT
+	sut := command.NewResolveDuplicatesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newResolveDuplicatesCommand(domain.DuplicateResolutionMerge))
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, []string{"dinner"}, result.Tags(), "Should return merged expense.")
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// RestoreTrashItemCommand defines a command to restore an item from the trash.
+type RestoreTrashItemCommand struct {
+	ItemID string
+}
+
+// RestoreTrashItemHandler defines a handler to restore trashed expense or category subtree.
+type RestoreTrashItemHandler struct {
+	repo         adapters.TrashRepoInterface
+	categoryRepo adapters.ExpenseCategoryRepoInterface
+	logger       logger.LogInterface
+}
+
+// RestoreTrashItemHandlerInterface defines a contract to handle command.
+type RestoreTrashItemHandlerInterface interface {
+	Handle(ctx context.Context, cmd RestoreTrashItemCommand) (*domain.TrashItem, error)
+}
+
+// NewRestoreTrashItemHandler returns command handler.
+func NewRestoreTrashItemHandler(
+	repo adapters.TrashRepoInterface,
+	categoryRepo adapters.ExpenseCategoryRepoInterface,
+	logger logger.LogInterface,
+) RestoreTrashItemHandler {
+	return RestoreTrashItemHandler{
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		logger:       logger,
+	}
+}
+
+// Handle handles restore trash item command.
+func (h RestoreTrashItemHandler) Handle(ctx context.Context, cmd RestoreTrashItemCommand) (*domain.TrashItem, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute restore trash item command")
+	span.SetAttributes(attribute.String("id", cmd.ItemID))
+	defer span.End()
+
+	item, itemErr := h.repo.GetOne(ctx, cmd.ItemID)
+	if itemErr != nil {
+		tracer.AddSpanError(span, itemErr)
+		return nil, errors.Wrap(itemErr, "get trash item")
+	}
+
+	if item == nil {
+		return nil, nil
+	}
+
+	// A category subtree could not be restored under a parent that is gone.
+	if item.IsCategory() && item.ParentID() != nil {
+		parent, parentErr := h.categoryRepo.GetOne(ctx, *item.ParentID())
+		if parentErr != nil {
+			tracer.AddSpanError(span, parentErr)
+			return nil, errors.Wrap(parentErr, "get parent category")
+		}
+		if parent == nil {
+			return nil, errors.Wrapf(domain.ErrParentCategoryUnavailable, "restore category %s", item.ID())
+		}
+	}
+
+	if _, restoreErr := h.repo.Restore(ctx, *item); restoreErr != nil {
+		tracer.AddSpanError(span, restoreErr)
+		return nil, errors.Wrap(restoreErr, "restore trash item")
+	}
+
+	return item, nil
+}
@@ -0,0 +1,137 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewRestoreTrashItemHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewRestoreTrashItemHandler(repo, categoryRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestRestoreTrashItemHandler_ItemNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.RestoreTrashItemCommand{ItemID: "itemId"}
+
+	repo.On("GetOne", mock.Anything, "itemId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewRestoreTrashItemHandler(repo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestRestoreTrashItemHandler_ParentCategoryTrashed_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.RestoreTrashItemCommand{ItemID: "categoryId"}
+	parentID := "parentId"
+	item, _ := domain.NewTrashItem("categoryId", domain.TrashItemTypeCategory, "category", &parentID,
+		"user", time.Now())
+
+	repo.On("GetOne", mock.Anything, "categoryId").Return(item, nil)
+	categoryRepo.On("GetOne", mock.Anything, parentID).Return(nil, nil)
+
+	// SUT
+	sut := command.NewRestoreTrashItemHandler(repo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	categoryRepo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.True(t, errors.Is(err, domain.ErrParentCategoryUnavailable), "Should return parent unavailable error.")
+}
+
+func TestRestoreTrashItemHandler_RestoreFails_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.RestoreTrashItemCommand{ItemID: "expenseId"}
+	item, _ := domain.NewTrashItem("expenseId", domain.TrashItemTypeExpense, "expense", nil, "user", time.Now())
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(item, nil)
+	repo.On("Restore", mock.Anything, *item).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewRestoreTrashItemHandler(repo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestRestoreTrashItemHandler_CategoryWithAvailableParent_RestoresItem(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.RestoreTrashItemCommand{ItemID: "categoryId"}
+	parentID := "parentId"
+	item, _ := domain.NewTrashItem("categoryId", domain.TrashItemTypeCategory, "category", &parentID,
+		"user", time.Now())
+	parent, _ := domain.NewCategory(parentID, nil, "parent", nil, 1, "|parentId")
+
+	repo.On("GetOne", mock.Anything, "categoryId").Return(item, nil)
+	categoryRepo.On("GetOne", mock.Anything, parentID).Return(parent, nil)
+	repo.On("Restore", mock.Anything, *item).Return(&domain.UpdateResult{UpdateCount: 3}, nil)
+
+	// SUT
+	sut := command.NewRestoreTrashItemHandler(repo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	categoryRepo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, item, result, "Should return restored item.")
+}
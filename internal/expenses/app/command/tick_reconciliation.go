@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// TickReconciliationCommand defines a command to tick off expenses found in a bank statement.
+type TickReconciliationCommand struct {
+	ID         string
+	ExpenseIDs []string
+}
+
+// TickReconciliationHandler defines a handler to tick off reconciliation expenses.
+type TickReconciliationHandler struct {
+	repo        adapters.ReconciliationRepoInterface
+	accountRepo adapters.AccountRepoInterface
+	logger      logger.LogInterface
+}
+
+// TickReconciliationHandlerInterface defines a contract to handle command.
+type TickReconciliationHandlerInterface interface {
+	Handle(ctx context.Context, cmd TickReconciliationCommand) (*domain.Reconciliation, error)
+}
+
+// NewTickReconciliationHandler returns command handler.
+func NewTickReconciliationHandler(
+	repo adapters.ReconciliationRepoInterface,
+	accountRepo adapters.AccountRepoInterface,
+	logger logger.LogInterface,
+) TickReconciliationHandler {
+	return TickReconciliationHandler{
+		repo:        repo,
+		accountRepo: accountRepo,
+		logger:      logger,
+	}
+}
+
+// Handle handles tick reconciliation command. The expenses replace the ticked off ones.
+func (h TickReconciliationHandler) Handle(
+	ctx context.Context,
+	cmd TickReconciliationCommand,
+) (*domain.Reconciliation, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute tick reconciliation command")
+	defer span.End()
+
+	reconciliation, reconciliationErr := h.repo.GetOne(ctx, cmd.ID)
+	if reconciliationErr != nil {
+		tracer.AddSpanError(span, reconciliationErr)
+		return nil, errors.Wrap(reconciliationErr, "get reconciliation")
+	}
+
+	if reconciliation == nil {
+		return nil, nil
+	}
+
+	expenses, expensesErr := h.accountRepo.GetExpenses(ctx, reconciliation.AccountID(), reconciliation.StatementDate())
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch account expenses")
+	}
+
+	if tickErr := reconciliation.Tick(cmd.ExpenseIDs, expenses); tickErr != nil {
+		tracer.AddSpanError(span, tickErr)
+		return nil, errors.Wrap(domain.ErrInvalidReconciliation, tickErr.Error())
+	}
+
+	_, updateErr := h.repo.UpdateExpenses(ctx, *reconciliation)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update reconciliation expenses")
+	}
+
+	return reconciliation, nil
+}
@@ -0,0 +1,117 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newCardExpense(id string, opts ...func(*domain.Expense)) domain.Expense {
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense(id, *category, 10, "EUR", 1, nil, nil,
+		time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC), append(opts, domain.SetAccount("cardId"))...)
+	return *expense
+}
+
+func TestNewTickReconciliationHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewTickReconciliationHandler(repo, accountRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestTickReconciliationHandler_ReconciliationNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "reconciliationId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewTickReconciliationHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.TickReconciliationCommand{ID: "reconciliationId"})
+
+	// Assert
+	accountRepo.AssertNotCalled(t, "GetExpenses", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestTickReconciliationHandler_LockedExpense_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	reconciliation := newReconciliation()
+	locked := newCardExpense("lockedId", domain.SetReconciliation("otherId"))
+
+	repo.On("GetOne", mock.Anything, "reconciliationId").Return(reconciliation, nil)
+	accountRepo.On("GetExpenses", mock.Anything, "cardId", reconciliation.StatementDate()).
+		Return([]domain.Expense{locked}, nil)
+
+	// SUT
+	sut := command.NewTickReconciliationHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.TickReconciliationCommand{
+		ID:         "reconciliationId",
+		ExpenseIDs: []string{"lockedId"},
+	})
+
+	// Assert
+	repo.AssertNotCalled(t, "UpdateExpenses", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReconciliation, "Should return invalid reconciliation error.")
+}
+
+func TestTickReconciliationHandler_UnlockedExpenses_UpdatesTickedExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	reconciliation := newReconciliation("groceriesId")
+
+	repo.On("GetOne", mock.Anything, "reconciliationId").Return(reconciliation, nil)
+	accountRepo.On("GetExpenses", mock.Anything, "cardId", reconciliation.StatementDate()).
+		Return([]domain.Expense{newCardExpense("groceriesId"), newCardExpense("dinnerId")}, nil)
+	repo.On("UpdateExpenses", mock.Anything, mock.MatchedBy(func(reconciliation domain.Reconciliation) bool {
+		return assert.ObjectsAreEqual([]string{"dinnerId"}, reconciliation.ExpenseIDs())
+	})).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewTickReconciliationHandler(repo, accountRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.TickReconciliationCommand{
+		ID:         "reconciliationId",
+		ExpenseIDs: []string{"dinnerId"},
+	})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, []string{"dinnerId"}, result.ExpenseIDs(), "Should replace ticked expenses.")
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UnlockExpenseCommand defines a command to unlock a reconciled expense.
+type UnlockExpenseCommand struct {
+	ExpenseID string
+}
+
+// UnlockExpenseHandler defines a handler to unlock expense.
+type UnlockExpenseHandler struct {
+	repo        adapters.ReconciliationRepoInterface
+	expenseRepo adapters.ExpenseRepoInterface
+	logger      logger.LogInterface
+}
+
+// UnlockExpenseHandlerInterface defines a contract to handle command.
+type UnlockExpenseHandlerInterface interface {
+	Handle(ctx context.Context, cmd UnlockExpenseCommand) (*domain.UpdateResult, error)
+}
+
+// NewUnlockExpenseHandler returns command handler.
+func NewUnlockExpenseHandler(
+	repo adapters.ReconciliationRepoInterface,
+	expenseRepo adapters.ExpenseRepoInterface,
+	logger logger.LogInterface,
+) UnlockExpenseHandler {
+	return UnlockExpenseHandler{
+		repo:        repo,
+		expenseRepo: expenseRepo,
+		logger:      logger,
+	}
+}
+
+// Handle handles unlock expense command. Unlocking an expense which is not locked does nothing.
+func (h UnlockExpenseHandler) Handle(ctx context.Context, cmd UnlockExpenseCommand) (*domain.UpdateResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute unlock expense command")
+	defer span.End()
+
+	expense, expenseErr := h.expenseRepo.GetOne(ctx, cmd.ExpenseID)
+	if expenseErr != nil {
+		tracer.AddSpanError(span, expenseErr)
+		return nil, errors.Wrap(expenseErr, "get expense to unlock")
+	}
+
+	if expense == nil {
+		return nil, nil
+	}
+
+	if !expense.Locked() {
+		return &domain.UpdateResult{}, nil
+	}
+
+	result, unlockErr := h.repo.UnlockExpense(ctx, expense.ID())
+	if unlockErr != nil {
+		tracer.AddSpanError(span, unlockErr)
+		return nil, errors.Wrap(unlockErr, "unlock expense")
+	}
+
+	return result, nil
+}
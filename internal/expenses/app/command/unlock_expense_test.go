@@ -0,0 +1,97 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewUnlockExpenseHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	expenseRepo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUnlockExpenseHandler(repo, expenseRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUnlockExpenseHandler_ExpenseNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	expenseRepo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUnlockExpenseHandler(repo, expenseRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UnlockExpenseCommand{ExpenseID: "expenseId"})
+
+	// Assert
+	repo.AssertNotCalled(t, "UnlockExpense", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUnlockExpenseHandler_LockedExpense_UnlocksExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	expenseRepo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	expense := newAttachedExpense()
+	domain.SetReconciliation("reconciliationId")(expense)
+	unlockResult := &domain.UpdateResult{UpdateCount: 1}
+
+	expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(expense, nil)
+	repo.On("UnlockExpense", mock.Anything, "expenseId").Return(unlockResult, nil)
+
+	// SUT
+	sut := command.NewUnlockExpenseHandler(repo, expenseRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UnlockExpenseCommand{ExpenseID: "expenseId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, unlockResult, result, "Should return unlock result.")
+}
+
+func TestUnlockExpenseHandler_UnlockedExpense_DoesNothing(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	expenseRepo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(newAttachedExpense(), nil)
+
+	// SUT
+	sut := command.NewUnlockExpenseHandler(repo, expenseRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UnlockExpenseCommand{ExpenseID: "expenseId"})
+
+	// Assert
+	repo.AssertNotCalled(t, "UnlockExpense", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 0, result.UpdateCount, "Should not update expense.")
+}
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateAccountCommand defines an account update command.
+type UpdateAccountCommand struct {
+	ID             string
+	Name           string
+	Type           string
+	Currency       string
+	OpeningBalance float64
+}
+
+// UpdateAccountHandler defines a handler to update account.
+type UpdateAccountHandler struct {
+	repo   adapters.AccountRepoInterface
+	logger logger.LogInterface
+}
+
+// UpdateAccountHandlerInterface defines a contract to handle command.
+type UpdateAccountHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateAccountCommand) (*domain.Account, error)
+}
+
+// NewUpdateAccountHandler returns command handler.
+func NewUpdateAccountHandler(
+	repo adapters.AccountRepoInterface,
+	logger logger.LogInterface,
+) UpdateAccountHandler {
+	return UpdateAccountHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles update account command.
+func (h UpdateAccountHandler) Handle(ctx context.Context, cmd UpdateAccountCommand) (*domain.Account, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update account command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get account for update")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	account, accountErr := domain.NewAccount(existing.ID(), domain.AccountParams{
+		Name:           cmd.Name,
+		Type:           cmd.Type,
+		Currency:       cmd.Currency,
+		OpeningBalance: cmd.OpeningBalance,
+	})
+	if accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		return nil, errors.Wrap(domain.ErrInvalidAccount, accountErr.Error())
+	}
+
+	_, updateErr := h.repo.Update(ctx, *account)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update account")
+	}
+
+	return account, nil
+}
@@ -0,0 +1,74 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewUpdateAccountHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateAccountHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateAccountHandler_AccountNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "accountId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateAccountHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UpdateAccountCommand{
+		ID: "accountId", Name: "Wallet", Type: "cash", Currency: "EUR",
+	})
+
+	// Assert
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUpdateAccountHandler_ValidAccount_ReturnsAccount(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "accountId").Return(newAccount("accountId", "EUR"), nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateAccountHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UpdateAccountCommand{
+		ID: "accountId", Name: "Wallet", Type: "cash", Currency: "USD",
+	})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "accountId", result.ID(), "Should keep account ID.")
+	assert.Equal(t, domain.Currency("USD"), result.Currency(), "Should update account currency.")
+}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateBudgetCommand defines a budget update command. The budget category could not be changed.
+type UpdateBudgetCommand struct {
+	ID       string
+	Period   string
+	Amount   float64
+	Currency *string
+	Rollover bool
+	Start    time.Time
+}
+
+// UpdateBudgetHandler defines a handler to update budget.
+type UpdateBudgetHandler struct {
+	repo   adapters.BudgetRepoInterface
+	logger logger.LogInterface
+}
+
+// UpdateBudgetHandlerInterface defines a contract to handle command.
+type UpdateBudgetHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateBudgetCommand) (*domain.Budget, error)
+}
+
+// NewUpdateBudgetHandler returns command handler.
+func NewUpdateBudgetHandler(
+	repo adapters.BudgetRepoInterface,
+	logger logger.LogInterface,
+) UpdateBudgetHandler {
+	return UpdateBudgetHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles update budget command.
+func (h UpdateBudgetHandler) Handle(ctx context.Context, cmd UpdateBudgetCommand) (*domain.Budget, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update budget command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get budget for update")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	budget, budgetErr := domain.NewBudget(existing.ID(), domain.BudgetParams{
+		CategoryID: existing.CategoryID(),
+		Period:     cmd.Period,
+		Amount:     cmd.Amount,
+		Currency:   cmd.Currency,
+		Rollover:   cmd.Rollover,
+		Start:      cmd.Start,
+	})
+	if budgetErr != nil {
+		tracer.AddSpanError(span, budgetErr)
+		return nil, errors.Wrap(domain.ErrInvalidBudget, budgetErr.Error())
+	}
+
+	_, updateErr := h.repo.Update(ctx, *budget)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update budget")
+	}
+
+	return budget, nil
+}
@@ -0,0 +1,139 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newUpdateBudgetCommand() command.UpdateBudgetCommand {
+	return command.UpdateBudgetCommand{
+		ID:       "budgetId",
+		Period:   "yearly",
+		Amount:   1200,
+		Rollover: true,
+		Start:    time.Date(2021, time.March, 10, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewUpdateBudgetHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateBudgetHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateBudgetHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "budgetId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateBudgetHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newUpdateBudgetCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUpdateBudgetHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "budgetId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewUpdateBudgetHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newUpdateBudgetCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestUpdateBudgetHandler_InvalidBudget_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     "monthly",
+		Amount:     100,
+		Start:      time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	cmd := newUpdateBudgetCommand()
+	cmd.Period = "weekly"
+
+	repo.On("GetOne", mock.Anything, "budgetId").Return(existing, nil)
+
+	// SUT
+	sut := command.NewUpdateBudgetHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidBudget, "Should return invalid budget error.")
+}
+
+func TestUpdateBudgetHandler_RepoSuccess_KeepsCategory(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     "monthly",
+		Amount:     100,
+		Start:      time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	repo.On("GetOne", mock.Anything, "budgetId").Return(existing, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateBudgetHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newUpdateBudgetCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "categoryId", result.CategoryID(), "Should keep category.")
+	assert.Equal(t, domain.BudgetPeriodYearly, result.Period(), "Should update period.")
+	assert.Equal(t, 1200.0, result.Amount(), "Should update amount.")
+}
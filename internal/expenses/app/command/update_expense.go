@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateExpenseCommand defines an expense update command.
+type UpdateExpenseCommand struct {
+	ID           string
+	CategoryID   string
+	Price        float64
+	Quantity     float64
+	Currency     string
+	Date         time.Time
+	Comment      *string
+	TripID       *string
+	MerchantID   *string
+	AccountID    *string
+	Tags         []string
+	PaidBy       *string
+	Split        *domain.SplitParams
+	Reimbursable bool
+	UpdatedBy    string
+}
+
+// UpdateExpenseHandler defines a handler to update expense.
+type UpdateExpenseHandler struct {
+	repo         adapters.ExpenseRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// UpdateExpenseHandlerInterface defines a contract to handle command.
+type UpdateExpenseHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateExpenseCommand) (*domain.Expense, error)
+}
+
+// NewUpdateExpenseHandler returns command handler.
+func NewUpdateExpenseHandler(
+	repo adapters.ExpenseRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) UpdateExpenseHandler {
+	return UpdateExpenseHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles update expense command.
+func (h UpdateExpenseHandler) Handle(ctx context.Context, cmd UpdateExpenseCommand) (*domain.Expense, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update expense command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get expense for update")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	if existing.Locked() {
+		return nil, errors.Wrapf(domain.ErrExpenseLocked, "expense %s", existing.ID())
+	}
+
+	category, categoryErr := h.findCategory.Handle(ctx, query.FindCategoryQuery{CategoryID: cmd.CategoryID})
+	if categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, errors.Wrap(categoryErr, "get expense category")
+	}
+
+	if category == nil {
+		return nil, errors.Wrapf(domain.ErrCategoryNotFound, "category %s", cmd.CategoryID)
+	}
+
+	splitOpts, splitErr := splitOptions(cmd.PaidBy, cmd.Split)
+	if splitErr != nil {
+		tracer.AddSpanError(span, splitErr)
+		return nil, errors.Wrap(domain.ErrInvalidExpense, splitErr.Error())
+	}
+
+	opts := []func(*domain.Expense){
+		domain.SetCreateMetadata(existing.CreatedBy(), existing.CreatedAt()),
+		domain.SetUpdateMetadata(cmd.UpdatedBy, time.Now()),
+		domain.SetTags(cmd.Tags),
+		domain.SetReimbursable(cmd.Reimbursable),
+	}
+	if cmd.MerchantID != nil {
+		opts = append(opts, domain.SetMerchant(*cmd.MerchantID))
+	}
+	if cmd.AccountID != nil {
+		opts = append(opts, domain.SetAccount(*cmd.AccountID))
+	}
+	expense, expenseErr := domain.NewExpense(existing.ID(), *category, cmd.Price, cmd.Currency, cmd.Quantity,
+		cmd.Comment, cmd.TripID, cmd.Date, append(opts, splitOpts...)...)
+	if expenseErr != nil {
+		tracer.AddSpanError(span, expenseErr)
+		return nil, errors.Wrap(domain.ErrInvalidExpense, expenseErr.Error())
+	}
+
+	_, updateErr := h.repo.Update(ctx, *expense)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update expense")
+	}
+
+	expense.CalculateTotal(nil)
+
+	return expense, nil
+}
@@ -0,0 +1,200 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewUpdateExpenseHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateExpenseHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateExpenseHandler_ExpenseNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.UpdateExpenseCommand{ID: "expenseId"}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	findCategory.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUpdateExpenseHandler_LockedExpense_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	existing, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now(),
+		domain.SetReconciliation("reconciliationId"))
+	cmd := command.UpdateExpenseCommand{ID: "expenseId", CategoryID: "categoryId"}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(existing, nil)
+
+	// SUT
+	sut := command.NewUpdateExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	findCategory.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrExpenseLocked, "Should reject locked expense.")
+}
+
+func TestUpdateExpenseHandler_CategoryNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	existing, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now())
+	cmd := command.UpdateExpenseCommand{ID: "expenseId", CategoryID: "unknown"}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(existing, nil)
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	findCategory.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.True(t, errors.Is(err, domain.ErrCategoryNotFound), "Should return category not found error.")
+}
+
+func TestUpdateExpenseHandler_InvalidExpense_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	existing, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now())
+	cmd := command.UpdateExpenseCommand{ID: "expenseId", CategoryID: "categoryId", Price: -1}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(existing, nil)
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+
+	// SUT
+	sut := command.NewUpdateExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.True(t, errors.Is(err, domain.ErrInvalidExpense), "Should return invalid expense error.")
+}
+
+func TestUpdateExpenseHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	existing, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now())
+	cmd := command.UpdateExpenseCommand{
+		ID: "expenseId", CategoryID: "categoryId", Price: 20, Quantity: 1, Currency: "USD", Date: time.Now(),
+	}
+
+	repo.On("GetOne", mock.Anything, "expenseId").Return(existing, nil)
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewUpdateExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestUpdateExpenseHandler_RepoSuccess_ReturnsExpenseWithMetadata(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	createdAt := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	existing, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now(),
+		domain.SetCreateMetadata("creator", createdAt))
+	cmd := command.UpdateExpenseCommand{
+		ID: "expenseId", CategoryID: "categoryId", Price: 20, Quantity: 1, Currency: "USD",
+		Date: time.Now(), UpdatedBy: "updater",
+	}
+
+	matchExpenseFn := func(expense domain.Expense) bool {
+		return expense.ID() == "expenseId" && expense.Price() == 20 && expense.Currency() == "USD" &&
+			expense.CreatedBy() == "creator" && expense.CreatedAt() == createdAt &&
+			*expense.UpdatedBy() == "updater" && expense.UpdatedAt() != nil
+	}
+	repo.On("GetOne", mock.Anything, "expenseId").Return(existing, nil)
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(matchExpenseFn)).
+		Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "USD", result.Currency(), "Should return updated expense.")
+}
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateIncomeCommand defines an income update command.
+type UpdateIncomeCommand struct {
+	ID         string
+	Source     string
+	CategoryID string
+	Amount     float64
+	Currency   string
+	Date       time.Time
+	Comment    *string
+}
+
+// UpdateIncomeHandler defines a handler to update income.
+type UpdateIncomeHandler struct {
+	repo         adapters.IncomeRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// UpdateIncomeHandlerInterface defines a contract to handle command.
+type UpdateIncomeHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateIncomeCommand) (*domain.Income, error)
+}
+
+// NewUpdateIncomeHandler returns command handler.
+func NewUpdateIncomeHandler(
+	repo adapters.IncomeRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) UpdateIncomeHandler {
+	return UpdateIncomeHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles update income command.
+func (h UpdateIncomeHandler) Handle(ctx context.Context, cmd UpdateIncomeCommand) (*domain.Income, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update income command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get income for update")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	income, incomeErr := domain.NewIncome(existing.ID(), domain.IncomeParams{
+		Source:     cmd.Source,
+		CategoryID: cmd.CategoryID,
+		Amount:     cmd.Amount,
+		Currency:   cmd.Currency,
+		Date:       cmd.Date,
+		Comment:    cmd.Comment,
+	})
+	if incomeErr != nil {
+		tracer.AddSpanError(span, incomeErr)
+		return nil, errors.Wrap(domain.ErrInvalidIncome, incomeErr.Error())
+	}
+
+	if categoryErr := checkIncomeCategory(ctx, h.findCategory, *income); categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, categoryErr
+	}
+
+	_, updateErr := h.repo.Update(ctx, *income)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update income")
+	}
+
+	return income, nil
+}
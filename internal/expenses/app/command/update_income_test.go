@@ -0,0 +1,91 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newUpdateIncomeCommand() command.UpdateIncomeCommand {
+	return command.UpdateIncomeCommand{
+		ID:         "incomeId",
+		Source:     "ACME",
+		CategoryID: "salaryId",
+		Amount:     3500,
+		Currency:   "EUR",
+		Date:       time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewUpdateIncomeHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateIncomeHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateIncomeHandler_IncomeNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "incomeId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateIncomeHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newUpdateIncomeCommand())
+
+	// Assert
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUpdateIncomeHandler_RepoSuccess_ReturnsIncome(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newUpdateIncomeCommand()
+	existing, _ := domain.NewIncome("incomeId", domain.IncomeParams{
+		Source: "ACME", CategoryID: "salaryId", Amount: 3200, Currency: "EUR", Date: cmd.Date,
+	})
+
+	repo.On("GetOne", mock.Anything, "incomeId").Return(existing, nil)
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(newIncomeCategory(), nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(income domain.Income) bool {
+		return income.ID() == "incomeId" && income.Amount() == 3500
+	})).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateIncomeHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 3500.0, result.Amount(), "Should return updated income.")
+}
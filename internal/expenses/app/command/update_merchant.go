@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateMerchantCommand defines a merchant update command.
+type UpdateMerchantCommand struct {
+	ID                string
+	Name              string
+	Aliases           []string
+	DefaultCategoryID *string
+	Location          *string
+}
+
+// UpdateMerchantHandler defines a handler to update merchant.
+type UpdateMerchantHandler struct {
+	repo         adapters.MerchantRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// UpdateMerchantHandlerInterface defines a contract to handle command.
+type UpdateMerchantHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateMerchantCommand) (*domain.Merchant, error)
+}
+
+// NewUpdateMerchantHandler returns command handler.
+func NewUpdateMerchantHandler(
+	repo adapters.MerchantRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) UpdateMerchantHandler {
+	return UpdateMerchantHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles update merchant command.
+func (h UpdateMerchantHandler) Handle(ctx context.Context, cmd UpdateMerchantCommand) (*domain.Merchant, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update merchant command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get merchant for update")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	merchant, merchantErr := domain.NewMerchant(existing.ID(), domain.MerchantParams{
+		Name:              cmd.Name,
+		Aliases:           cmd.Aliases,
+		DefaultCategoryID: cmd.DefaultCategoryID,
+		Location:          cmd.Location,
+	})
+	if merchantErr != nil {
+		tracer.AddSpanError(span, merchantErr)
+		return nil, errors.Wrap(domain.ErrInvalidMerchant, merchantErr.Error())
+	}
+
+	if categoryErr := checkMerchantCategory(ctx, h.findCategory, *merchant); categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, categoryErr
+	}
+
+	_, updateErr := h.repo.Update(ctx, *merchant)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update merchant")
+	}
+
+	return merchant, nil
+}
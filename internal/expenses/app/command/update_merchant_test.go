@@ -0,0 +1,136 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newMerchant(id string, name string, aliases ...string) domain.Merchant {
+	merchant, _ := domain.NewMerchant(id, domain.MerchantParams{Name: name, Aliases: aliases})
+	return *merchant
+}
+
+func TestNewUpdateMerchantHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateMerchantHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateMerchantHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.UpdateMerchantCommand{ID: "merchantId", Name: "Rewe"}
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateMerchantHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUpdateMerchantHandler_InvalidMerchant_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing := newMerchant("merchantId", "Rewe")
+	cmd := command.UpdateMerchantCommand{ID: "merchantId", Name: " "}
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(&existing, nil)
+
+	// SUT
+	sut := command.NewUpdateMerchantHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidMerchant, "Should return invalid merchant error.")
+}
+
+func TestUpdateMerchantHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing := newMerchant("merchantId", "Rewe")
+	cmd := command.UpdateMerchantCommand{ID: "merchantId", Name: "Rewe City"}
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(&existing, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewUpdateMerchantHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestUpdateMerchantHandler_RepoSuccess_ReturnsMerchant(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing := newMerchant("merchantId", "Rewe")
+	categoryID := "categoryId"
+	category, _ := domain.NewCategory(categoryID, nil, "Food", nil, 1, "|categoryId")
+	cmd := command.UpdateMerchantCommand{ID: "merchantId", Name: "Rewe City", DefaultCategoryID: &categoryID}
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(&existing, nil)
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(merchant domain.Merchant) bool {
+		return merchant.ID() == "merchantId" && merchant.Name() == "Rewe City"
+	})).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateMerchantHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "Rewe City", result.Name())
+	assert.Equal(t, &categoryID, result.DefaultCategoryID())
+}
@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateOccurrenceCommand defines a command to skip or edit a single occurrence of a recurring expense.
+// An empty exception reverts the occurrence to the series values.
+type UpdateOccurrenceCommand struct {
+	RecurringExpenseID string
+	Date               time.Time
+	Exception          *domain.OccurrenceException
+}
+
+// UpdateOccurrenceHandler defines a handler to update a single occurrence.
+type UpdateOccurrenceHandler struct {
+	repo   adapters.RecurringExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// UpdateOccurrenceHandlerInterface defines a contract to handle command.
+type UpdateOccurrenceHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateOccurrenceCommand) (*domain.RecurringExpense, error)
+}
+
+// NewUpdateOccurrenceHandler returns command handler.
+func NewUpdateOccurrenceHandler(
+	repo adapters.RecurringExpenseRepoInterface,
+	logger logger.LogInterface,
+) UpdateOccurrenceHandler {
+	return UpdateOccurrenceHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles update occurrence command.
+func (h UpdateOccurrenceHandler) Handle(
+	ctx context.Context,
+	cmd UpdateOccurrenceCommand,
+) (*domain.RecurringExpense, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update occurrence command")
+	defer span.End()
+
+	recurringExpense, recurringErr := h.repo.GetOne(ctx, cmd.RecurringExpenseID)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, errors.Wrap(recurringErr, "get recurring expense")
+	}
+
+	if recurringExpense == nil {
+		return nil, errors.Wrapf(domain.ErrRecurringExpenseNotFound, "recurring expense %s", cmd.RecurringExpenseID)
+	}
+
+	if cmd.Exception == nil {
+		recurringExpense.RemoveException(cmd.Date)
+	} else if exceptionErr := recurringExpense.SetException(*cmd.Exception); exceptionErr != nil {
+		tracer.AddSpanError(span, exceptionErr)
+		return nil, errors.Wrapf(exceptionErr, "occurrence %s", cmd.Exception.Date().Format("2006-01-02"))
+	}
+
+	_, updateErr := h.repo.Update(ctx, *recurringExpense)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update recurring expense")
+	}
+
+	return recurringExpense, nil
+}
@@ -0,0 +1,129 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewUpdateOccurrenceHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateOccurrenceHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateOccurrenceHandler_NotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.UpdateOccurrenceCommand{RecurringExpenseID: "recurringId"}
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateOccurrenceHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrRecurringExpenseNotFound, "Should return not found error.")
+}
+
+func TestUpdateOccurrenceHandler_MaterializedOccurrence_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	day := time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)
+	recurringExpense := newMonthlyRecurringExpense("recurringId", domain.SetMaterializedUntil(&day))
+	exception, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{Date: day, Skipped: true})
+	cmd := command.UpdateOccurrenceCommand{RecurringExpenseID: "recurringId", Date: day, Exception: exception}
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(&recurringExpense, nil)
+
+	// SUT
+	sut := command.NewUpdateOccurrenceHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrOccurrenceMaterialized, "Should return materialized error.")
+}
+
+func TestUpdateOccurrenceHandler_Exception_SavesException(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	day := time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)
+	recurringExpense := newMonthlyRecurringExpense("recurringId")
+	exception, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{Date: day, Skipped: true})
+	cmd := command.UpdateOccurrenceCommand{RecurringExpenseID: "recurringId", Date: day, Exception: exception}
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(&recurringExpense, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(updated domain.RecurringExpense) bool {
+		return len(updated.Exceptions()) == 1
+	})).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateOccurrenceHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, []domain.OccurrenceException{*exception}, result.Exceptions(), "Should set exception.")
+}
+
+func TestUpdateOccurrenceHandler_NoException_RevertsOccurrence(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	day := time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)
+	exception, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{Date: day, Skipped: true})
+	recurringExpense := newMonthlyRecurringExpense("recurringId",
+		domain.SetOccurrenceExceptions([]domain.OccurrenceException{*exception}))
+	cmd := command.UpdateOccurrenceCommand{RecurringExpenseID: "recurringId", Date: day}
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(&recurringExpense, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateOccurrenceHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Empty(t, result.Exceptions(), "Should remove exception.")
+}
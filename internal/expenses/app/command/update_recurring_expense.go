@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateRecurringExpenseCommand defines a recurring expense update command.
+// Changes apply to occurrences that were not materialized yet.
+type UpdateRecurringExpenseCommand struct {
+	ID         string
+	CategoryID string
+	Price      float64
+	Quantity   float64
+	Currency   string
+	Comment    *string
+	TripID     *string
+	Schedule   domain.Schedule
+}
+
+// UpdateRecurringExpenseHandler defines a handler to update recurring expense.
+type UpdateRecurringExpenseHandler struct {
+	repo         adapters.RecurringExpenseRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// UpdateRecurringExpenseHandlerInterface defines a contract to handle command.
+type UpdateRecurringExpenseHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateRecurringExpenseCommand) (*domain.RecurringExpense, error)
+}
+
+// NewUpdateRecurringExpenseHandler returns command handler.
+func NewUpdateRecurringExpenseHandler(
+	repo adapters.RecurringExpenseRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) UpdateRecurringExpenseHandler {
+	return UpdateRecurringExpenseHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles update recurring expense command. Skipped and edited occurrences are kept
+// as long as they still match the schedule.
+func (h UpdateRecurringExpenseHandler) Handle(
+	ctx context.Context,
+	cmd UpdateRecurringExpenseCommand,
+) (*domain.RecurringExpense, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update recurring expense command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get recurring expense for update")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	category, categoryErr := h.findCategory.Handle(ctx, query.FindCategoryQuery{CategoryID: cmd.CategoryID})
+	if categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, errors.Wrap(categoryErr, "get recurring expense category")
+	}
+
+	if category == nil {
+		return nil, errors.Wrapf(domain.ErrCategoryNotFound, "category %s", cmd.CategoryID)
+	}
+
+	recurringExpense, recurringErr := domain.NewRecurringExpense(existing.ID(), *category, cmd.Price,
+		cmd.Currency, cmd.Quantity, cmd.Comment, cmd.TripID, cmd.Schedule,
+		domain.SetOccurrenceExceptions(existing.Exceptions()),
+		domain.SetMaterializedUntil(existing.MaterializedUntil()))
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, errors.Wrap(domain.ErrInvalidExpense, recurringErr.Error())
+	}
+
+	_, updateErr := h.repo.Update(ctx, *recurringExpense)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update recurring expense")
+	}
+
+	return recurringExpense, nil
+}
@@ -0,0 +1,119 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewUpdateRecurringExpenseHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateRecurringExpenseHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateRecurringExpenseHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.UpdateRecurringExpenseCommand{ID: "recurringId"}
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateRecurringExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	findCategory.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUpdateRecurringExpenseHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.UpdateRecurringExpenseCommand{ID: "recurringId"}
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewUpdateRecurringExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestUpdateRecurringExpenseHandler_RepoSuccess_KeepsExceptionsAndMaterializedDay(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	materializedUntil := time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)
+	skipped, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date:    time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC),
+		Skipped: true,
+	})
+	existing := newMonthlyRecurringExpense("recurringId",
+		domain.SetMaterializedUntil(&materializedUntil),
+		domain.SetOccurrenceExceptions([]domain.OccurrenceException{*skipped}))
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+	cmd := command.UpdateRecurringExpenseCommand{
+		ID:         "recurringId",
+		CategoryID: "categoryId",
+		Price:      600,
+		Quantity:   1,
+		Currency:   "EUR",
+		Schedule:   existing.Schedule(),
+	}
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(&existing, nil)
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateRecurringExpenseHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 600.0, result.Price(), "Should update price.")
+	assert.Equal(t, existing.Exceptions(), result.Exceptions(), "Should keep exceptions.")
+	assert.Equal(t, &materializedUntil, result.MaterializedUntil(), "Should keep materialized day.")
+}
@@ -0,0 +1,87 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateRuleCommand defines a rule update command.
+type UpdateRuleCommand struct {
+	ID         string
+	Name       string
+	Priority   int
+	Conditions domain.RuleConditions
+	Actions    domain.RuleActions
+}
+
+// UpdateRuleHandler defines a handler to update rule.
+type UpdateRuleHandler struct {
+	repo         adapters.RuleRepoInterface
+	findCategory query.FindExpenseCategoryHandlerInterface
+	logger       logger.LogInterface
+}
+
+// UpdateRuleHandlerInterface defines a contract to handle command.
+type UpdateRuleHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateRuleCommand) (*domain.Rule, error)
+}
+
+// NewUpdateRuleHandler returns command handler.
+func NewUpdateRuleHandler(
+	repo adapters.RuleRepoInterface,
+	findCategory query.FindExpenseCategoryHandlerInterface,
+	logger logger.LogInterface,
+) UpdateRuleHandler {
+	return UpdateRuleHandler{
+		repo:         repo,
+		findCategory: findCategory,
+		logger:       logger,
+	}
+}
+
+// Handle handles update rule command.
+func (h UpdateRuleHandler) Handle(ctx context.Context, cmd UpdateRuleCommand) (*domain.Rule, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update rule command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get rule for update")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	rule, ruleErr := domain.NewRule(existing.ID(), domain.RuleParams{
+		Name:       cmd.Name,
+		Priority:   cmd.Priority,
+		Conditions: cmd.Conditions,
+		Actions:    cmd.Actions,
+	})
+	if ruleErr != nil {
+		tracer.AddSpanError(span, ruleErr)
+		return nil, errors.Wrap(domain.ErrInvalidRule, ruleErr.Error())
+	}
+
+	if categoryErr := checkRuleCategory(ctx, h.findCategory, *rule); categoryErr != nil {
+		tracer.AddSpanError(span, categoryErr)
+		return nil, categoryErr
+	}
+
+	_, updateErr := h.repo.Update(ctx, *rule)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update rule")
+	}
+
+	return rule, nil
+}
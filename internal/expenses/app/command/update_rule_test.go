@@ -0,0 +1,146 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newRule(id string, comment string, actions domain.RuleActions) domain.Rule {
+	rule, _ := domain.NewRule(id, domain.RuleParams{
+		Name:       "Rule " + id,
+		Conditions: domain.RuleConditions{Comment: &comment},
+		Actions:    actions,
+	})
+	return *rule
+}
+
+func newUpdateRuleCommand() command.UpdateRuleCommand {
+	comment := "tea"
+	return command.UpdateRuleCommand{
+		ID:         "ruleId",
+		Name:       "Tea",
+		Priority:   2,
+		Conditions: domain.RuleConditions{Comment: &comment},
+		Actions:    domain.RuleActions{Reimbursable: true},
+	}
+}
+
+func TestNewUpdateRuleHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateRuleHandler(repo, findCategory, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateRuleHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "ruleId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateRuleHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newUpdateRuleCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUpdateRuleHandler_InvalidRule_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := newUpdateRuleCommand()
+	cmd.Actions = domain.RuleActions{}
+	existing := newRule("ruleId", "coffee", domain.RuleActions{Reimbursable: true})
+
+	repo.On("GetOne", mock.Anything, "ruleId").Return(&existing, nil)
+
+	// SUT
+	sut := command.NewUpdateRuleHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidRule, "Should return invalid rule error.")
+}
+
+func TestUpdateRuleHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing := newRule("ruleId", "coffee", domain.RuleActions{Reimbursable: true})
+
+	repo.On("GetOne", mock.Anything, "ruleId").Return(&existing, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewUpdateRuleHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newUpdateRuleCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestUpdateRuleHandler_RepoSuccess_ReturnsRule(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing := newRule("ruleId", "coffee", domain.RuleActions{Reimbursable: true})
+
+	repo.On("GetOne", mock.Anything, "ruleId").Return(&existing, nil)
+	repo.On("Update", mock.Anything, mock.MatchedBy(func(rule domain.Rule) bool {
+		return rule.ID() == "ruleId" && rule.Name() == "Tea" && rule.Priority() == 2
+	})).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateRuleHandler(repo, findCategory, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newUpdateRuleCommand())
+
+	// Assert
+	repo.AssertExpectations(t)
+	findCategory.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "Tea", result.Name(), "Should return updated rule.")
+}
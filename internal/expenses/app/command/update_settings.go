@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateSettingsCommand defines a user settings update command.
+type UpdateSettingsCommand struct {
+	User           string
+	PeriodStartDay int
+	ReportCurrency string
+}
+
+// UpdateSettingsHandler defines a handler to update user settings.
+type UpdateSettingsHandler struct {
+	repo   adapters.SettingsRepoInterface
+	logger logger.LogInterface
+}
+
+// UpdateSettingsHandlerInterface defines a contract to handle command.
+type UpdateSettingsHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateSettingsCommand) (*domain.Settings, error)
+}
+
+// NewUpdateSettingsHandler returns command handler.
+func NewUpdateSettingsHandler(
+	repo adapters.SettingsRepoInterface,
+	logger logger.LogInterface,
+) UpdateSettingsHandler {
+	return UpdateSettingsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles update settings command.
+func (h UpdateSettingsHandler) Handle(ctx context.Context, cmd UpdateSettingsCommand) (*domain.Settings, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update settings command")
+	defer span.End()
+
+	settings, settingsErr := domain.NewSettings(cmd.User, domain.SettingsParams{
+		PeriodStartDay: cmd.PeriodStartDay,
+		ReportCurrency: cmd.ReportCurrency,
+	})
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		return nil, errors.Wrap(domain.ErrInvalidSettings, settingsErr.Error())
+	}
+
+	_, updateErr := h.repo.Update(ctx, *settings)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update settings")
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,91 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewUpdateSettingsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettingsRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateSettingsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateSettingsHandler_InvalidSettings_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettingsRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	// SUT
+	sut := command.NewUpdateSettingsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UpdateSettingsCommand{User: "user", PeriodStartDay: 31})
+
+	// Assert
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidSettings)
+}
+
+func TestUpdateSettingsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettingsRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("Update", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewUpdateSettingsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UpdateSettingsCommand{User: "user", PeriodStartDay: 25})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestUpdateSettingsHandler_ValidSettings_ReturnsSettings(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettingsRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	matchFn := func(settings domain.Settings) bool {
+		return settings.User() == "user" && settings.PeriodStartDay() == 25 && settings.ReportCurrency() == "USD"
+	}
+	repo.On("Update", mock.Anything, mock.MatchedBy(matchFn)).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateSettingsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UpdateSettingsCommand{User: "user", PeriodStartDay: 25, ReportCurrency: "usd"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 25, result.PeriodStartDay(), "Should update period start day.")
+}
@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// UpdateTripCommand defines a trip update command.
+type UpdateTripCommand struct {
+	ID           string
+	Name         string
+	From         time.Time
+	To           time.Time
+	HomeCurrency string
+	Participants []string
+}
+
+// UpdateTripHandler defines a handler to update trip.
+type UpdateTripHandler struct {
+	repo   adapters.TripRepoInterface
+	logger logger.LogInterface
+}
+
+// UpdateTripHandlerInterface defines a contract to handle command.
+type UpdateTripHandlerInterface interface {
+	Handle(ctx context.Context, cmd UpdateTripCommand) (*domain.Trip, error)
+}
+
+// NewUpdateTripHandler returns command handler.
+func NewUpdateTripHandler(
+	repo adapters.TripRepoInterface,
+	logger logger.LogInterface,
+) UpdateTripHandler {
+	return UpdateTripHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles update trip command.
+func (h UpdateTripHandler) Handle(ctx context.Context, cmd UpdateTripCommand) (*domain.Trip, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute update trip command")
+	defer span.End()
+
+	existing, existingErr := h.repo.GetOne(ctx, cmd.ID)
+	if existingErr != nil {
+		tracer.AddSpanError(span, existingErr)
+		return nil, errors.Wrap(existingErr, "get trip for update")
+	}
+
+	if existing == nil {
+		return nil, nil
+	}
+
+	trip, tripErr := domain.NewTrip(existing.ID(), domain.TripParams{
+		Name:         cmd.Name,
+		From:         cmd.From,
+		To:           cmd.To,
+		HomeCurrency: cmd.HomeCurrency,
+		Participants: cmd.Participants,
+	})
+	if tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		return nil, errors.Wrap(domain.ErrInvalidTrip, tripErr.Error())
+	}
+
+	_, updateErr := h.repo.Update(ctx, *trip)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		return nil, errors.Wrap(updateErr, "update trip")
+	}
+
+	return trip, nil
+}
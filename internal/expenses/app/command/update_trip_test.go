@@ -0,0 +1,136 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newTrip(id string, name string) domain.Trip {
+	trip, _ := domain.NewTrip(id, domain.TripParams{
+		Name:         name,
+		From:         time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2021, time.July, 5, 0, 0, 0, 0, time.UTC),
+		HomeCurrency: "EUR",
+	})
+	return *trip
+}
+
+func TestNewUpdateTripHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := command.NewUpdateTripHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestUpdateTripHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	cmd := command.UpdateTripCommand{ID: "tripId"}
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(nil, nil)
+
+	// SUT
+	sut := command.NewUpdateTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestUpdateTripHandler_InvalidTrip_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing := newTrip("tripId", "Paris 2021")
+	cmd := command.UpdateTripCommand{ID: "tripId", From: existing.From(), To: existing.To(), HomeCurrency: "EUR"}
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(&existing, nil)
+
+	// SUT
+	sut := command.NewUpdateTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidTrip, "Should return invalid trip error.")
+}
+
+func TestUpdateTripHandler_RepoSuccess_ReturnsTrip(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	existing := newTrip("tripId", "Paris 2021")
+	cmd := command.UpdateTripCommand{
+		ID:           "tripId",
+		Name:         "Paris",
+		From:         existing.From(),
+		To:           existing.To(),
+		HomeCurrency: "USD",
+	}
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(&existing, nil)
+	repo.On("Update", mock.Anything, mock.Anything).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	// SUT
+	sut := command.NewUpdateTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, cmd)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "tripId", result.ID(), "Should keep trip id.")
+	assert.Equal(t, "Paris", result.Name(), "Should update name.")
+	assert.Equal(t, domain.Currency("USD"), result.HomeCurrency(), "Should update home currency.")
+}
+
+func TestUpdateTripHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := command.NewUpdateTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, command.UpdateTripCommand{ID: "tripId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
@@ -3,6 +3,8 @@ package app
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
@@ -19,21 +21,117 @@ type Application struct {
 	Logger   logger.LogInterface
 	Config   config.Config
 	Tracer   tracer.TraceInterface
+	runners  []Runner
+}
+
+// Runner defines a contract of a background job running until the context is done.
+type Runner interface {
+	Run(ctx context.Context)
+}
+
+// Start starts background jobs of the application, they stop once the context is done.
+func (a *Application) Start(ctx context.Context) {
+	for _, runner := range a.runners {
+		go runner.Run(ctx)
+	}
 }
 
 // Commands struct holds available application commands.
 type Commands struct {
-	AddExpense         command.AddExpenseHandlerInterface
-	FetchExchangeRates command.FetchExchangeRatesHandlerInterface
+	AddExpense           command.AddExpenseHandlerInterface
+	UpdateExpense        command.UpdateExpenseHandlerInterface
+	DeleteExpense        command.DeleteExpenseHandlerInterface
+	FetchExchangeRates   command.FetchExchangeRatesHandlerInterface
+	RestoreTrashItem     command.RestoreTrashItemHandlerInterface
+	PurgeTrash           command.PurgeTrashHandlerInterface
+	ImportExpensesCsv    command.ImportExpensesCsvHandlerInterface
+	AddImportProfile     command.AddImportProfileHandlerInterface
+	ImportStatement      command.ImportStatementHandlerInterface
+	AssignInbox          command.AssignInboxCategoriesHandlerInterface
+	AddRecurring         command.AddRecurringExpenseHandlerInterface
+	UpdateRecurring      command.UpdateRecurringExpenseHandlerInterface
+	DeleteRecurring      command.DeleteRecurringExpenseHandlerInterface
+	UpdateOccurrence     command.UpdateOccurrenceHandlerInterface
+	AddBudget            command.AddBudgetHandlerInterface
+	UpdateBudget         command.UpdateBudgetHandlerInterface
+	DeleteBudget         command.DeleteBudgetHandlerInterface
+	AddTrip              command.AddTripHandlerInterface
+	UpdateTrip           command.UpdateTripHandlerInterface
+	DeleteTrip           command.DeleteTripHandlerInterface
+	RenameTag            command.RenameTagHandlerInterface
+	MergeTags            command.MergeTagsHandlerInterface
+	AddSettlement        command.AddSettlementHandlerInterface
+	AddAttachment        command.AddAttachmentHandlerInterface
+	DeleteAttachment     command.DeleteAttachmentHandlerInterface
+	AddReceipt           command.AddReceiptHandlerInterface
+	AddMerchant          command.AddMerchantHandlerInterface
+	UpdateMerchant       command.UpdateMerchantHandlerInterface
+	DeleteMerchant       command.DeleteMerchantHandlerInterface
+	MergeMerchants       command.MergeMerchantsHandlerInterface
+	AddRule              command.AddRuleHandlerInterface
+	UpdateRule           command.UpdateRuleHandlerInterface
+	DeleteRule           command.DeleteRuleHandlerInterface
+	ApplyRules           command.ApplyRulesHandlerInterface
+	ResolveDuplicates    command.ResolveDuplicatesHandlerInterface
+	AddIncome            command.AddIncomeHandlerInterface
+	UpdateIncome         command.UpdateIncomeHandlerInterface
+	DeleteIncome         command.DeleteIncomeHandlerInterface
+	AddAccount           command.AddAccountHandlerInterface
+	UpdateAccount        command.UpdateAccountHandlerInterface
+	DeleteAccount        command.DeleteAccountHandlerInterface
+	AddTransfer          command.AddTransferHandlerInterface
+	AddReconciliation    command.AddReconciliationHandlerInterface
+	TickReconciliation   command.TickReconciliationHandlerInterface
+	FinishReconciliation command.FinishReconciliationHandlerInterface
+	UnlockExpense        command.UnlockExpenseHandlerInterface
+	UpdateSettings       command.UpdateSettingsHandlerInterface
 }
 
 // Queries struct holds available application queries.
 type Queries struct {
-	FindExpenses query.FindExpensesHandlerInterface
-	FindCategory query.FindExpenseCategoryHandlerInterface
+	FindExpenses        query.FindExpensesHandlerInterface
+	FindCategoryReport  query.FindCategoryReportHandlerInterface
+	FindComparison      query.FindComparisonReportHandlerInterface
+	FindCategory        query.FindExpenseCategoryHandlerInterface
+	ListExpenses        query.ListExpensesHandlerInterface
+	FindExpense         query.FindExpenseHandlerInterface
+	FindTrashItems      query.FindTrashItemsHandlerInterface
+	FindImportProfiles  query.FindImportProfilesHandlerInterface
+	FindInboxExpenses   query.FindInboxExpensesHandlerInterface
+	ExportExpenses      query.ExportExpensesHandlerInterface
+	FindRecurring       query.FindRecurringExpensesHandlerInterface
+	FindRecurringByID   query.FindRecurringExpenseHandlerInterface
+	PreviewOccurrences  query.PreviewOccurrencesHandlerInterface
+	FindBudgets         query.FindBudgetsHandlerInterface
+	FindBudgetStatus    query.FindBudgetStatusHandlerInterface
+	FindTrips           query.FindTripsHandlerInterface
+	FindTrip            query.FindTripHandlerInterface
+	FindTripReport      query.FindTripReportHandlerInterface
+	FindTags            query.FindTagsHandlerInterface
+	FindBalances        query.FindBalancesHandlerInterface
+	FindAttachments     query.FindAttachmentsHandlerInterface
+	FindAttachment      query.FindAttachmentContentHandlerInterface
+	FindReceipt         query.FindReceiptHandlerInterface
+	Search              query.SearchHandlerInterface
+	FindMerchants       query.FindMerchantsHandlerInterface
+	FindMerchant        query.FindMerchantHandlerInterface
+	FindMerchantStats   query.FindMerchantStatsHandlerInterface
+	FindRules           query.FindRulesHandlerInterface
+	FindRule            query.FindRuleHandlerInterface
+	TestRule            query.TestRuleHandlerInterface
+	FindDuplicates      query.FindDuplicatesHandlerInterface
+	FindIncomes         query.FindIncomesHandlerInterface
+	FindIncome          query.FindIncomeHandlerInterface
+	FindCashFlow        query.FindCashFlowHandlerInterface
+	FindAccounts        query.FindAccountsHandlerInterface
+	FindAccount         query.FindAccountHandlerInterface
+	FindAccountBalance  query.FindAccountBalanceHandlerInterface
+	FindReconciliations query.FindReconciliationsHandlerInterface
+	FindReconciliation  query.FindReconciliationHandlerInterface
+	FindSettings        query.FindSettingsHandlerInterface
 }
 
-// NewApplication returns application instance.
+// NewApplication returns application instance. Background jobs of the application are launched by Start.
 func NewApplication(
 	ctx context.Context,
 	cancel context.CancelFunc,
@@ -48,18 +146,151 @@ func NewApplication(
 	categoryRepo := adapters.NewCategoryRepo(mongoClient, logger)
 	rateRepo := adapters.NewExchangeRateRepo(mongoClient, logger)
 	rateFetcher := adapters.NewExchangeRateFetcher(rateConfig)
+	trashRepo := adapters.NewTrashRepo(mongoClient, logger)
+	importProfileRepo := adapters.NewImportProfileRepo(mongoClient, logger)
+	recurringRepo := adapters.NewRecurringExpenseRepo(mongoClient, logger)
+	budgetRepo := adapters.NewBudgetRepo(mongoClient, logger)
+	tripRepo := adapters.NewTripRepo(mongoClient, logger)
+	tagRepo := adapters.NewTagRepo(mongoClient, logger)
+	settlementRepo := adapters.NewSettlementRepo(mongoClient, logger)
+	attachmentRepo := adapters.NewAttachmentRepo(mongoClient, logger)
+	receiptRepo := adapters.NewReceiptRepo(mongoClient, logger)
+	merchantRepo := adapters.NewMerchantRepo(mongoClient, logger)
+	ruleRepo := adapters.NewRuleRepo(mongoClient, logger)
+	incomeRepo := adapters.NewIncomeRepo(mongoClient, logger)
+	accountRepo := adapters.NewAccountRepo(mongoClient, logger)
+	transferRepo := adapters.NewTransferRepo(mongoClient, logger)
+	reconciliationRepo := adapters.NewReconciliationRepo(mongoClient, logger)
+	settingsRepo := adapters.NewSettingsRepo(mongoClient, logger)
+	searchRepo := adapters.NewSearchRepo(mongoClient, logger)
+	if indexErr := searchRepo.EnsureIndexes(ctx); indexErr != nil {
+		return nil, errors.Wrap(indexErr, "search indexes")
+	}
+	if indexErr := expenseRepo.EnsureIndexes(ctx); indexErr != nil {
+		return nil, errors.Wrap(indexErr, "expense indexes")
+	}
+	if indexErr := categoryRepo.EnsureIndexes(ctx); indexErr != nil {
+		return nil, errors.Wrap(indexErr, "category indexes")
+	}
+	blobStore, blobStoreErr := adapters.NewBlobStore(config.Attachments, mongoClient, logger)
+	if blobStoreErr != nil {
+		return nil, errors.Wrap(blobStoreErr, "blob store")
+	}
+	thumbnails := adapters.NewThumbnailGenerator(config.Attachments.ThumbnailSize)
+	maxAttachmentSize := int64(config.Attachments.MaxSizeMB) << 20
+	addAttachment := command.NewAddAttachmentHandler(expenseRepo, attachmentRepo, blobStore, thumbnails,
+		maxAttachmentSize, logger)
+	findCategory := query.NewFindCategoryHandler(categoryRepo, logger)
+	fetchExchangeRates := command.NewFetchExchangeRatesHandler(rateFetcher, rateRepo, logger)
+	purgeTrash := command.NewPurgeTrashHandler(trashRepo, attachmentRepo, blobStore, logger)
+	addExpense := command.NewAddExpenseHandler(expenseRepo, merchantRepo, ruleRepo, categoryRepo, logger)
+	materializeRecurring := command.NewMaterializeRecurringExpensesHandler(recurringRepo, addExpense, logger)
+	findBudgetStatus := query.NewFindBudgetStatusHandler(budgetRepo, reportRepo, fetchExchangeRates, logger)
+	importExpensesCsv := command.NewImportExpensesCsvHandler(expenseRepo, categoryRepo, importProfileRepo,
+		merchantRepo, ruleRepo, logger)
+	importStatement := command.NewImportStatementHandler(expenseRepo, categoryRepo, merchantRepo, ruleRepo, logger)
+	findReconciliation := query.NewFindReconciliationHandler(reconciliationRepo, accountRepo, transferRepo,
+		fetchExchangeRates, logger)
 
 	return &Application{
 		Commands: Commands{
-			AddExpense:         command.NewAddExpenseHandler(expenseRepo, logger),
-			FetchExchangeRates: command.NewFetchExchangeRatesHandler(rateFetcher, rateRepo, logger),
+			AddExpense:           addExpense,
+			UpdateExpense:        command.NewUpdateExpenseHandler(expenseRepo, findCategory, logger),
+			DeleteExpense:        command.NewDeleteExpenseHandler(expenseRepo, logger),
+			FetchExchangeRates:   fetchExchangeRates,
+			RestoreTrashItem:     command.NewRestoreTrashItemHandler(trashRepo, categoryRepo, logger),
+			PurgeTrash:           purgeTrash,
+			ImportExpensesCsv:    importExpensesCsv,
+			AddImportProfile:     command.NewAddImportProfileHandler(importProfileRepo, logger),
+			ImportStatement:      importStatement,
+			AssignInbox:          command.NewAssignInboxCategoriesHandler(expenseRepo, categoryRepo, logger),
+			AddRecurring:         command.NewAddRecurringExpenseHandler(recurringRepo, findCategory, logger),
+			UpdateRecurring:      command.NewUpdateRecurringExpenseHandler(recurringRepo, findCategory, logger),
+			DeleteRecurring:      command.NewDeleteRecurringExpenseHandler(recurringRepo, logger),
+			UpdateOccurrence:     command.NewUpdateOccurrenceHandler(recurringRepo, logger),
+			AddBudget:            command.NewAddBudgetHandler(budgetRepo, findCategory, logger),
+			UpdateBudget:         command.NewUpdateBudgetHandler(budgetRepo, logger),
+			DeleteBudget:         command.NewDeleteBudgetHandler(budgetRepo, logger),
+			AddTrip:              command.NewAddTripHandler(tripRepo, logger),
+			UpdateTrip:           command.NewUpdateTripHandler(tripRepo, logger),
+			DeleteTrip:           command.NewDeleteTripHandler(tripRepo, logger),
+			RenameTag:            command.NewRenameTagHandler(tagRepo, logger),
+			MergeTags:            command.NewMergeTagsHandler(tagRepo, logger),
+			AddSettlement:        command.NewAddSettlementHandler(settlementRepo, logger),
+			AddAttachment:        addAttachment,
+			DeleteAttachment:     command.NewDeleteAttachmentHandler(attachmentRepo, blobStore, logger),
+			AddReceipt:           command.NewAddReceiptHandler(receiptRepo, logger),
+			AddMerchant:          command.NewAddMerchantHandler(merchantRepo, findCategory, logger),
+			UpdateMerchant:       command.NewUpdateMerchantHandler(merchantRepo, findCategory, logger),
+			DeleteMerchant:       command.NewDeleteMerchantHandler(merchantRepo, logger),
+			MergeMerchants:       command.NewMergeMerchantsHandler(merchantRepo, logger),
+			AddRule:              command.NewAddRuleHandler(ruleRepo, findCategory, logger),
+			UpdateRule:           command.NewUpdateRuleHandler(ruleRepo, findCategory, logger),
+			DeleteRule:           command.NewDeleteRuleHandler(ruleRepo, logger),
+			ApplyRules:           command.NewApplyRulesHandler(expenseRepo, ruleRepo, categoryRepo, merchantRepo, logger),
+			ResolveDuplicates:    command.NewResolveDuplicatesHandler(expenseRepo, logger),
+			AddIncome:            command.NewAddIncomeHandler(incomeRepo, findCategory, logger),
+			UpdateIncome:         command.NewUpdateIncomeHandler(incomeRepo, findCategory, logger),
+			DeleteIncome:         command.NewDeleteIncomeHandler(incomeRepo, logger),
+			AddAccount:           command.NewAddAccountHandler(accountRepo, logger),
+			UpdateAccount:        command.NewUpdateAccountHandler(accountRepo, logger),
+			DeleteAccount:        command.NewDeleteAccountHandler(accountRepo, logger),
+			AddTransfer:          command.NewAddTransferHandler(transferRepo, accountRepo, logger),
+			AddReconciliation:    command.NewAddReconciliationHandler(reconciliationRepo, accountRepo, logger),
+			TickReconciliation:   command.NewTickReconciliationHandler(reconciliationRepo, accountRepo, logger),
+			FinishReconciliation: command.NewFinishReconciliationHandler(reconciliationRepo, findReconciliation, logger),
+			UnlockExpense:        command.NewUnlockExpenseHandler(reconciliationRepo, expenseRepo, logger),
+			UpdateSettings:       command.NewUpdateSettingsHandler(settingsRepo, logger),
 		},
 		Queries: Queries{
-			FindExpenses: query.NewFindExpensesHandler(reportRepo, logger),
-			FindCategory: query.NewFindCategoryHandler(categoryRepo, logger),
+			FindExpenses:        query.NewFindExpensesHandler(reportRepo, findBudgetStatus, logger),
+			FindCategoryReport:  query.NewFindCategoryReportHandler(reportRepo, logger),
+			FindComparison:      query.NewFindComparisonReportHandler(reportRepo, logger),
+			FindCategory:        findCategory,
+			ListExpenses:        query.NewListExpensesHandler(expenseRepo, logger),
+			FindExpense:         query.NewFindExpenseHandler(expenseRepo, logger),
+			FindTrashItems:      query.NewFindTrashItemsHandler(trashRepo, logger),
+			FindImportProfiles:  query.NewFindImportProfilesHandler(importProfileRepo, logger),
+			FindInboxExpenses:   query.NewFindInboxExpensesHandler(expenseRepo, categoryRepo, logger),
+			ExportExpenses:      query.NewExportExpensesHandler(expenseRepo, tripRepo, fetchExchangeRates, logger),
+			FindRecurring:       query.NewFindRecurringExpensesHandler(recurringRepo, logger),
+			FindRecurringByID:   query.NewFindRecurringExpenseHandler(recurringRepo, logger),
+			PreviewOccurrences:  query.NewPreviewOccurrencesHandler(recurringRepo, logger),
+			FindBudgets:         query.NewFindBudgetsHandler(budgetRepo, logger),
+			FindBudgetStatus:    findBudgetStatus,
+			FindTrips:           query.NewFindTripsHandler(tripRepo, logger),
+			FindTrip:            query.NewFindTripHandler(tripRepo, logger),
+			FindTripReport:      query.NewFindTripReportHandler(tripRepo, reportRepo, fetchExchangeRates, logger),
+			FindTags:            query.NewFindTagsHandler(tagRepo, logger),
+			FindBalances:        query.NewFindBalancesHandler(reportRepo, settlementRepo, fetchExchangeRates, logger),
+			FindAttachments:     query.NewFindAttachmentsHandler(expenseRepo, attachmentRepo, logger),
+			FindAttachment:      query.NewFindAttachmentContentHandler(attachmentRepo, blobStore, logger),
+			FindReceipt:         query.NewFindReceiptHandler(receiptRepo, attachmentRepo, logger),
+			Search:              query.NewSearchHandler(searchRepo, logger),
+			FindMerchants:       query.NewFindMerchantsHandler(merchantRepo, logger),
+			FindMerchant:        query.NewFindMerchantHandler(merchantRepo, logger),
+			FindMerchantStats:   query.NewFindMerchantStatsHandler(merchantRepo, logger),
+			FindRules:           query.NewFindRulesHandler(ruleRepo, logger),
+			FindRule:            query.NewFindRuleHandler(ruleRepo, logger),
+			TestRule:            query.NewTestRuleHandler(expenseRepo, merchantRepo, logger),
+			FindDuplicates:      query.NewFindDuplicatesHandler(expenseRepo, logger),
+			FindIncomes:         query.NewFindIncomesHandler(incomeRepo, logger),
+			FindIncome:          query.NewFindIncomeHandler(incomeRepo, logger),
+			FindCashFlow:        query.NewFindCashFlowHandler(reportRepo, incomeRepo, logger),
+			FindAccounts:        query.NewFindAccountsHandler(accountRepo, logger),
+			FindAccount:         query.NewFindAccountHandler(accountRepo, logger),
+			FindAccountBalance:  query.NewFindAccountBalanceHandler(accountRepo, transferRepo, fetchExchangeRates, logger),
+			FindReconciliations: query.NewFindReconciliationsHandler(reconciliationRepo, logger),
+			FindReconciliation:  findReconciliation,
+			FindSettings:        query.NewFindSettingsHandler(settingsRepo, logger),
 		},
 		Logger: logger,
 		Config: *config,
 		Tracer: tracer,
+		runners: []Runner{
+			NewTripMigrator(command.NewMigrateTripsHandler(tripRepo, logger), logger),
+			NewTrashPurger(purgeTrash, logger, config.Trash),
+			NewRecurringScheduler(materializeRecurring, logger, config.Recurring),
+		},
 	}, nil
 }
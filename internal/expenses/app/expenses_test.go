@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type runnerFunc func(ctx context.Context)
+
+func (f runnerFunc) Run(ctx context.Context) {
+	f(ctx)
+}
+
+func TestApplicationStart_RunsRunnersUntilContextIsDone(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	runner := runnerFunc(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		close(stopped)
+	})
+
+	// SUT
+	sut := &Application{runners: []Runner{runner}}
+
+	// Act
+	sut.Start(ctx)
+
+	// Assert
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		assert.Fail(t, "Runner should be started.")
+	}
+	cancel()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		assert.Fail(t, "Runner should be stopped.")
+	}
+}
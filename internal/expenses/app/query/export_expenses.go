@@ -0,0 +1,181 @@
+package query
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters/export"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// ExportExpensesQuery defines a query to export expenses matching the filter.
+// The filter limit defines how many expenses are fetched at once. Totals are converted into Currency,
+// the default report currency is used when it is empty.
+type ExportExpensesQuery struct {
+	Filter   domain.ExpenseListFilter
+	Format   domain.ExportFormat
+	Output   io.Writer
+	Currency string
+}
+
+// ExchangeRatesProviderInterface defines a contract to provide exchange rates for every date of the range.
+type ExchangeRatesProviderInterface interface {
+	ExchangeRates(ctx context.Context, dateRange domain.DateRange) ([]domain.ExchangeRates, error)
+}
+
+// ExportExpensesHandler defines a handler to export expenses.
+type ExportExpensesHandler struct {
+	repo   adapters.ExpenseRepoInterface
+	trips  adapters.TripRepoInterface
+	rates  ExchangeRatesProviderInterface
+	logger logger.LogInterface
+}
+
+// ExportExpensesHandlerInterface defines a contract to handle query.
+type ExportExpensesHandlerInterface interface {
+	Handle(ctx context.Context, query ExportExpensesQuery) (int, error)
+}
+
+// NewExportExpensesHandler returns query handler.
+func NewExportExpensesHandler(
+	repo adapters.ExpenseRepoInterface,
+	trips adapters.TripRepoInterface,
+	rates ExchangeRatesProviderInterface,
+	logger logger.LogInterface,
+) ExportExpensesHandler {
+	return ExportExpensesHandler{
+		repo:   repo,
+		trips:  trips,
+		rates:  rates,
+		logger: logger,
+	}
+}
+
+// Handle streams expenses page by page into the output and returns the number of exported expenses.
+// Nothing is written to the output until the first page is fetched.
+func (h ExportExpensesHandler) Handle(ctx context.Context, query ExportExpensesQuery) (int, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute export expenses query")
+	defer span.End()
+
+	writer, writerErr := export.NewWriter(query.Format, query.Output)
+	if writerErr != nil {
+		tracer.AddSpanError(span, writerErr)
+		return 0, errors.Wrap(writerErr, "prepare export writer")
+	}
+
+	trips, tripsErr := h.trips.GetAll(ctx)
+	if tripsErr != nil {
+		tracer.AddSpanError(span, tripsErr)
+		return 0, errors.Wrap(tripsErr, "fetch trips")
+	}
+	tripsByID := make(map[string]*domain.Trip, len(trips))
+	for index := range trips {
+		tripsByID[trips[index].ID()] = &trips[index]
+	}
+
+	currency := reportCurrency(query.Currency)
+	exported := 0
+	filter := query.Filter
+	for {
+		page, pageErr := h.repo.GetAll(ctx, filter)
+		if pageErr != nil {
+			tracer.AddSpanError(span, pageErr)
+			return exported, errors.Wrap(pageErr, "fetch expenses")
+		}
+
+		totalsErr := h.calculateTotals(ctx, page.Expenses, currency)
+		if totalsErr != nil {
+			tracer.AddSpanError(span, totalsErr)
+			return exported, errors.Wrap(totalsErr, "calculate totals")
+		}
+
+		for _, expense := range page.Expenses {
+			var trip *domain.Trip
+			if expense.TripID() != nil {
+				trip = tripsByID[*expense.TripID()]
+			}
+			if err := writer.Write(domain.NewExportRow(expense, trip)); err != nil {
+				tracer.AddSpanError(span, err)
+				return exported, errors.Wrap(err, "write expense")
+			}
+			exported++
+		}
+
+		if page.NextCursor == nil {
+			break
+		}
+		cursor, cursorErr := domain.DecodeExpenseCursor(*page.NextCursor)
+		if cursorErr != nil {
+			tracer.AddSpanError(span, cursorErr)
+			return exported, errors.Wrap(cursorErr, "decode next page cursor")
+		}
+		filter = filter.WithCursor(*cursor)
+	}
+
+	if err := writer.Close(); err != nil {
+		tracer.AddSpanError(span, err)
+		return exported, errors.Wrap(err, "complete export")
+	}
+
+	return exported, nil
+}
+
+// calculateTotals converts expenses into the currency using exchange rates of the expense date.
+func (h ExportExpensesHandler) calculateTotals(
+	ctx context.Context,
+	expenses []domain.Expense,
+	currency domain.Currency,
+) error {
+	if len(expenses) == 0 {
+		return nil
+	}
+
+	from, to := expenseDay(expenses[0]), expenseDay(expenses[0])
+	for _, expense := range expenses {
+		day := expenseDay(expense)
+		if day.Before(from) {
+			from = day
+		}
+		if day.After(to) {
+			to = day
+		}
+	}
+	dateRange, dateRangeErr := domain.NewDateRange(from, to)
+	if dateRangeErr != nil {
+		return dateRangeErr
+	}
+
+	rates, ratesErr := h.rates.ExchangeRates(ctx, *dateRange)
+	if ratesErr != nil {
+		return errors.Wrap(ratesErr, "fetch exchange rates")
+	}
+	if currencyErr := domain.CheckReportCurrency(currency, rates); currencyErr != nil {
+		return errors.Wrap(domain.ErrInvalidReportCurrency, currencyErr.Error())
+	}
+	dateRates := make(map[time.Time]domain.ExchangeRates, len(rates))
+	for _, rate := range rates {
+		dateRates[rate.Date()] = rate.ChangeBaseCurrency(currency)
+	}
+
+	for index := range expenses {
+		rate, ok := dateRates[expenseDay(expenses[index])]
+		if !ok {
+			expenses[index].CalculateTotal(nil)
+			continue
+		}
+		expenses[index].CalculateTotal(&rate)
+	}
+
+	return nil
+}
+
+func expenseDay(expense domain.Expense) time.Time {
+	year, month, day := expense.Date().UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
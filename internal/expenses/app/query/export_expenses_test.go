@@ -0,0 +1,188 @@
+package query_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewExportExpensesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	trips := new(mocks.TripRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewExportExpensesHandler(repo, trips, rates, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestExportExpensesHandle_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	trips := new(mocks.TripRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{})
+	output := &bytes.Buffer{}
+	exportQuery := query.ExportExpensesQuery{
+		Filter: *filter,
+		Format: domain.ExportFormatCSV,
+		Output: output,
+	}
+
+	trips.On("GetAll", mock.Anything).Return([]domain.Trip{}, nil)
+	repo.On("GetAll", mock.Anything, *filter).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewExportExpensesHandler(repo, trips, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, exportQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Equal(t, 0, result, "Nothing should be exported.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+	assert.Empty(t, output.String(), "Nothing should be written.")
+}
+
+func TestExportExpensesHandle_TwoPages_ExportsAllExpensesWithConvertedTotals(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	trips := new(mocks.TripRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	limit := 1
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{Limit: &limit})
+	output := &bytes.Buffer{}
+	exportQuery := query.ExportExpensesQuery{
+		Filter: *filter,
+		Format: domain.ExportFormatCSV,
+		Output: output,
+	}
+	date := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	trip, _ := domain.NewTrip("tripId", domain.TripParams{Name: "Paris 2021", From: date, To: date, HomeCurrency: "EUR"})
+	tripID := trip.ID()
+	first, _ := domain.NewExpense("firstId", *category, 10, "USD", 2, nil, &tripID, date)
+	second, _ := domain.NewExpense("secondId", *category, 5, "EUR", 1, nil, nil, date.AddDate(0, 0, -1))
+	firstPage := domain.NewExpensePage([]domain.Expense{*first, *second}, limit, domain.SortFieldDate)
+	secondPage := domain.NewExpensePage([]domain.Expense{*second}, limit, domain.SortFieldDate)
+	dateRates, _ := domain.NewExchageRate(date, "EUR", map[string]float64{"USD": 2})
+
+	trips.On("GetAll", mock.Anything).Return([]domain.Trip{*trip}, nil)
+	repo.On("GetAll", mock.Anything, *filter).Return(&firstPage, nil).Once()
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(f domain.ExpenseListFilter) bool {
+		return f.Cursor() != nil && f.Cursor().ID() == "firstId"
+	})).Return(&secondPage, nil).Once()
+	rates.On("ExchangeRates", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{*dateRates}, nil)
+
+	// SUT
+	sut := query.NewExportExpensesHandler(repo, trips, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, exportQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result, "All expenses should be exported.")
+	assert.Contains(t, output.String(), "firstId,2021-07-01,categoryId,Food,,Paris 2021,10,2,20,USD,10,EUR,2\n",
+		"Should export converted total and trip name.")
+	assert.Contains(t, output.String(), "secondId,2021-06-30,categoryId,Food,,,5,1,5,EUR,,,\n",
+		"Should export expense without a rate.")
+}
+
+func TestExportExpensesHandle_Currency_ConvertsIntoCurrency(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	trips := new(mocks.TripRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{})
+	output := &bytes.Buffer{}
+	exportQuery := query.ExportExpensesQuery{
+		Filter:   *filter,
+		Format:   domain.ExportFormatCSV,
+		Output:   output,
+		Currency: "usd",
+	}
+	date := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, date)
+	page := domain.NewExpensePage([]domain.Expense{*expense}, domain.DefaultPageSize, domain.SortFieldDate)
+	dateRates, _ := domain.NewExchageRate(date, "EUR", map[string]float64{"USD": 2})
+
+	trips.On("GetAll", mock.Anything).Return([]domain.Trip{}, nil)
+	repo.On("GetAll", mock.Anything, *filter).Return(&page, nil)
+	rates.On("ExchangeRates", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{*dateRates}, nil)
+
+	// SUT
+	sut := query.NewExportExpensesHandler(repo, trips, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, exportQuery)
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 1, result, "Expense should be exported.")
+	assert.Contains(t, output.String(), ",20,USD,", "Should export total converted into the currency.")
+}
+
+func TestExportExpensesHandle_UnknownCurrency_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	trips := new(mocks.TripRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{})
+	output := &bytes.Buffer{}
+	exportQuery := query.ExportExpensesQuery{
+		Filter:   *filter,
+		Format:   domain.ExportFormatCSV,
+		Output:   output,
+		Currency: "XYZ",
+	}
+	date := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, date)
+	page := domain.NewExpensePage([]domain.Expense{*expense}, domain.DefaultPageSize, domain.SortFieldDate)
+	dateRates, _ := domain.NewExchageRate(date, "EUR", map[string]float64{"USD": 2})
+
+	trips.On("GetAll", mock.Anything).Return([]domain.Trip{}, nil)
+	repo.On("GetAll", mock.Anything, *filter).Return(&page, nil)
+	rates.On("ExchangeRates", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{*dateRates}, nil)
+
+	// SUT
+	sut := query.NewExportExpensesHandler(repo, trips, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, exportQuery)
+
+	// Assert
+	assert.Equal(t, 0, result, "Nothing should be exported.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReportCurrency)
+	assert.Empty(t, output.String(), "Nothing should be written.")
+}
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindAccountQuery defines a single account query.
+type FindAccountQuery struct {
+	ID string
+}
+
+// FindAccountHandler defines a handler to fetch a single account.
+type FindAccountHandler struct {
+	repo   adapters.AccountRepoInterface
+	logger logger.LogInterface
+}
+
+// FindAccountHandlerInterface defines a contract to handle query.
+type FindAccountHandlerInterface interface {
+	Handle(ctx context.Context, query FindAccountQuery) (*domain.Account, error)
+}
+
+// NewFindAccountHandler returns query handler.
+func NewFindAccountHandler(
+	repo adapters.AccountRepoInterface,
+	logger logger.LogInterface,
+) FindAccountHandler {
+	return FindAccountHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find account query.
+func (h FindAccountHandler) Handle(ctx context.Context, query FindAccountQuery) (*domain.Account, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find account query")
+	defer span.End()
+
+	account, accountErr := h.repo.GetOne(ctx, query.ID)
+	if accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		return nil, errors.Wrap(accountErr, "get account")
+	}
+
+	return account, nil
+}
@@ -0,0 +1,141 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindAccountBalanceQuery defines an account balance query, the current balance is calculated
+// when the moment is not set.
+type FindAccountBalanceQuery struct {
+	ID string
+	At *time.Time
+}
+
+// FindAccountBalanceHandler defines a handler to calculate an account balance.
+type FindAccountBalanceHandler struct {
+	repo         adapters.AccountRepoInterface
+	transferRepo adapters.TransferRepoInterface
+	rates        ExchangeRatesProviderInterface
+	logger       logger.LogInterface
+}
+
+// FindAccountBalanceHandlerInterface defines a contract to handle query.
+type FindAccountBalanceHandlerInterface interface {
+	Handle(ctx context.Context, query FindAccountBalanceQuery) (*domain.AccountBalance, error)
+}
+
+// NewFindAccountBalanceHandler returns query handler.
+func NewFindAccountBalanceHandler(
+	repo adapters.AccountRepoInterface,
+	transferRepo adapters.TransferRepoInterface,
+	rates ExchangeRatesProviderInterface,
+	logger logger.LogInterface,
+) FindAccountBalanceHandler {
+	return FindAccountBalanceHandler{
+		repo:         repo,
+		transferRepo: transferRepo,
+		rates:        rates,
+		logger:       logger,
+	}
+}
+
+// Handle handles find account balance query.
+func (h FindAccountBalanceHandler) Handle(
+	ctx context.Context,
+	query FindAccountBalanceQuery,
+) (*domain.AccountBalance, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find account balance query")
+	span.SetAttributes(attribute.String("id", query.ID))
+	defer span.End()
+
+	account, accountErr := h.repo.GetOne(ctx, query.ID)
+	if accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		return nil, errors.Wrap(accountErr, "get account")
+	}
+
+	if account == nil {
+		return nil, nil
+	}
+
+	at := time.Now()
+	if query.At != nil {
+		at = *query.At
+	}
+
+	expenses, expensesErr := h.repo.GetExpenses(ctx, account.ID(), at)
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch account expenses")
+	}
+
+	transfers, transfersErr := h.transferRepo.GetByAccount(ctx, account.ID(), at)
+	if transfersErr != nil {
+		tracer.AddSpanError(span, transfersErr)
+		return nil, errors.Wrap(transfersErr, "fetch account transfers")
+	}
+
+	rates, ratesErr := accountExchangeRates(ctx, h.rates, *account, expenses)
+	if ratesErr != nil {
+		tracer.AddSpanError(span, ratesErr)
+		return nil, ratesErr
+	}
+
+	balance, balanceErr := domain.NewAccountBalance(*account, at, expenses, transfers, rates)
+	if balanceErr != nil {
+		tracer.AddSpanError(span, balanceErr)
+		return nil, errors.Wrap(balanceErr, "calculate account balance")
+	}
+
+	return balance, nil
+}
+
+// accountExchangeRates fetches exchange rates only when some expenses of the account are in other currencies,
+// for every day from the first such expense to the last one.
+func accountExchangeRates(
+	ctx context.Context,
+	provider ExchangeRatesProviderInterface,
+	account domain.Account,
+	expenses []domain.Expense,
+) ([]domain.ExchangeRates, error) {
+	days := make([]time.Time, 0, len(expenses))
+	for _, expense := range expenses {
+		if domain.Currency(expense.Currency()) != account.Currency() {
+			days = append(days, expenseDay(expense))
+		}
+	}
+
+	if len(days) == 0 {
+		return nil, nil
+	}
+
+	from, to := days[0], days[0]
+	for _, day := range days {
+		if day.Before(from) {
+			from = day
+		}
+		if day.After(to) {
+			to = day
+		}
+	}
+	dateRange, dateRangeErr := domain.NewDateRange(from, to)
+	if dateRangeErr != nil {
+		return nil, errors.Wrap(dateRangeErr, "prepare balance date range")
+	}
+
+	rates, ratesErr := provider.ExchangeRates(ctx, *dateRange)
+	if ratesErr != nil {
+		return nil, errors.Wrap(ratesErr, "fetch exchange rates")
+	}
+
+	return rates, nil
+}
@@ -0,0 +1,126 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAccountExpense(price float64, currency string, date time.Time) domain.Expense {
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, _ := domain.NewExpense("", *category, price, currency, 1, nil, nil, date, domain.SetAccount("accountId"))
+	return *expense
+}
+
+func TestNewFindAccountBalanceHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	transferRepo := new(mocks.TransferRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindAccountBalanceHandler(repo, transferRepo, rates, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindAccountBalanceHandler_AccountNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	transferRepo := new(mocks.TransferRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "accountId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindAccountBalanceHandler(repo, transferRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAccountBalanceQuery{ID: "accountId"})
+
+	// Assert
+	repo.AssertNotCalled(t, "GetExpenses", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindAccountBalanceHandler_SameCurrency_SkipsExchangeRates(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	transferRepo := new(mocks.TransferRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	account := newAccount()
+	at := time.Date(2021, time.July, 5, 0, 0, 0, 0, time.UTC)
+	transfer, _ := domain.NewTransfer("", domain.TransferParams{
+		FromAccountID: "accountId", ToAccountID: "cashId", Amount: 20, Date: at,
+	})
+
+	repo.On("GetOne", mock.Anything, "accountId").Return(&account, nil)
+	repo.On("GetExpenses", mock.Anything, "accountId", at).
+		Return([]domain.Expense{newAccountExpense(30, "EUR", at)}, nil)
+	transferRepo.On("GetByAccount", mock.Anything, "accountId", at).Return([]domain.Transfer{*transfer}, nil)
+
+	// SUT
+	sut := query.NewFindAccountBalanceHandler(repo, transferRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAccountBalanceQuery{ID: "accountId", At: &at})
+
+	// Assert
+	repo.AssertExpectations(t)
+	transferRepo.AssertExpectations(t)
+	rates.AssertNotCalled(t, "ExchangeRates", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "-50", result.Balance.String(), "Should subtract expenses and transfers.")
+}
+
+func TestFindAccountBalanceHandler_OtherCurrency_FetchesRatesOfExpenseDays(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	transferRepo := new(mocks.TransferRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	account := newAccount()
+	at := time.Date(2021, time.July, 5, 0, 0, 0, 0, time.UTC)
+	day := time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC)
+	dayRates, _ := domain.NewExchageRate(day, "EUR", map[string]float64{"USD": 2})
+
+	repo.On("GetOne", mock.Anything, "accountId").Return(&account, nil)
+	repo.On("GetExpenses", mock.Anything, "accountId", at).Return([]domain.Expense{
+		newAccountExpense(30, "EUR", time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)),
+		newAccountExpense(60, "USD", day),
+	}, nil)
+	transferRepo.On("GetByAccount", mock.Anything, "accountId", at).Return([]domain.Transfer{}, nil)
+	rates.On("ExchangeRates", mock.Anything, mock.MatchedBy(func(dateRange domain.DateRange) bool {
+		return dateRange.From().Equal(day) && dateRange.To().Equal(day)
+	})).Return([]domain.ExchangeRates{*dayRates}, nil)
+
+	// SUT
+	sut := query.NewFindAccountBalanceHandler(repo, transferRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAccountBalanceQuery{ID: "accountId", At: &at})
+
+	// Assert
+	rates.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "60", result.Expenses.String(), "Should convert expenses into the account currency.")
+}
@@ -0,0 +1,69 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindAccountHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindAccountHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindAccountHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "accountId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindAccountHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAccountQuery{ID: "accountId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindAccountHandler_RepoSuccess_ReturnsAccount(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	account := newAccount()
+
+	repo.On("GetOne", mock.Anything, "accountId").Return(&account, nil)
+
+	// SUT
+	sut := query.NewFindAccountHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAccountQuery{ID: "accountId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &account, result, "Should return account.")
+}
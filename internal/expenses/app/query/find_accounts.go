@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindAccountsQuery defines an accounts query.
+type FindAccountsQuery struct{}
+
+// FindAccountsHandler defines a handler to fetch accounts.
+type FindAccountsHandler struct {
+	repo   adapters.AccountRepoInterface
+	logger logger.LogInterface
+}
+
+// FindAccountsHandlerInterface defines a contract to handle query.
+type FindAccountsHandlerInterface interface {
+	Handle(ctx context.Context, query FindAccountsQuery) ([]domain.Account, error)
+}
+
+// NewFindAccountsHandler returns query handler.
+func NewFindAccountsHandler(
+	repo adapters.AccountRepoInterface,
+	logger logger.LogInterface,
+) FindAccountsHandler {
+	return FindAccountsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find accounts query.
+func (h FindAccountsHandler) Handle(ctx context.Context, query FindAccountsQuery) ([]domain.Account, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find accounts query")
+	defer span.End()
+
+	accounts, accountsErr := h.repo.GetAll(ctx)
+	if accountsErr != nil {
+		tracer.AddSpanError(span, accountsErr)
+		return nil, errors.Wrap(accountsErr, "get accounts")
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,79 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newAccount() domain.Account {
+	account, _ := domain.NewAccount("accountId", domain.AccountParams{
+		Name:     "Joint card",
+		Type:     "card",
+		Currency: "EUR",
+	})
+	return *account
+}
+
+func TestNewFindAccountsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindAccountsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindAccountsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindAccountsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAccountsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindAccountsHandler_RepoSuccess_ReturnsAccounts(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AccountRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	accounts := []domain.Account{newAccount()}
+
+	repo.On("GetAll", mock.Anything).Return(accounts, nil)
+
+	// SUT
+	sut := query.NewFindAccountsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAccountsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, accounts, result, "Should return accounts.")
+}
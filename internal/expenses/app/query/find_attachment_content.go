@@ -0,0 +1,97 @@
+package query
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindAttachmentContentQuery defines an attachment download query.
+type FindAttachmentContentQuery struct {
+	ExpenseID    string
+	AttachmentID string
+	Thumbnail    bool
+}
+
+// AttachmentContent holds an attachment along with its content, the caller should close the content.
+type AttachmentContent struct {
+	Attachment  domain.Attachment
+	ContentType string
+	Content     io.ReadCloser
+}
+
+// FindAttachmentContentHandler defines a handler to download attachments.
+type FindAttachmentContentHandler struct {
+	repo      adapters.AttachmentRepoInterface
+	blobStore adapters.BlobStore
+	logger    logger.LogInterface
+}
+
+// FindAttachmentContentHandlerInterface defines a contract to handle query.
+type FindAttachmentContentHandlerInterface interface {
+	Handle(ctx context.Context, query FindAttachmentContentQuery) (*AttachmentContent, error)
+}
+
+// NewFindAttachmentContentHandler returns query handler.
+func NewFindAttachmentContentHandler(
+	repo adapters.AttachmentRepoInterface,
+	blobStore adapters.BlobStore,
+	logger logger.LogInterface,
+) FindAttachmentContentHandler {
+	return FindAttachmentContentHandler{
+		repo:      repo,
+		blobStore: blobStore,
+		logger:    logger,
+	}
+}
+
+// Handle handles find attachment content query. Nil is returned when the attachment does not exist
+// or a thumbnail is requested for an attachment that is not an image.
+func (h FindAttachmentContentHandler) Handle(
+	ctx context.Context,
+	query FindAttachmentContentQuery,
+) (*AttachmentContent, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find attachment content query")
+	span.SetAttributes(
+		attribute.String("expenseId", query.ExpenseID),
+		attribute.String("id", query.AttachmentID),
+		attribute.Bool("thumbnail", query.Thumbnail),
+	)
+	defer span.End()
+
+	attachment, attachmentErr := h.repo.GetOne(ctx, query.ExpenseID, query.AttachmentID)
+	if attachmentErr != nil {
+		tracer.AddSpanError(span, attachmentErr)
+		return nil, errors.Wrap(attachmentErr, "get attachment")
+	}
+	if attachment == nil {
+		return nil, nil
+	}
+
+	key, contentType := attachment.BlobKey(), attachment.ContentType()
+	if query.Thumbnail {
+		if attachment.ThumbnailKey() == nil {
+			return nil, nil
+		}
+		key, contentType = *attachment.ThumbnailKey(), domain.ThumbnailContentType
+	}
+
+	content, contentErr := h.blobStore.Get(ctx, key)
+	if contentErr != nil {
+		tracer.AddSpanError(span, contentErr)
+		return nil, errors.Wrapf(contentErr, "get blob %s", key)
+	}
+
+	return &AttachmentContent{
+		Attachment:  *attachment,
+		ContentType: contentType,
+		Content:     content,
+	}, nil
+}
@@ -0,0 +1,124 @@
+package query_test
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindAttachmentContentHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindAttachmentContentHandler(repo, blobStore, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindAttachmentContentHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	queryArgs := query.FindAttachmentContentQuery{ExpenseID: "expenseId", AttachmentID: "attachmentId"}
+
+	repo.On("GetOne", mock.Anything, "expenseId", "attachmentId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindAttachmentContentHandler(repo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, queryArgs)
+
+	// Assert
+	blobStore.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindAttachmentContentHandler_NoThumbnail_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	queryArgs := query.FindAttachmentContentQuery{ExpenseID: "expenseId", AttachmentID: "attachmentId", Thumbnail: true}
+
+	repo.On("GetOne", mock.Anything, "expenseId", "attachmentId").Return(newQueriedAttachment(nil), nil)
+
+	// SUT
+	sut := query.NewFindAttachmentContentHandler(repo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, queryArgs)
+
+	// Assert
+	blobStore.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindAttachmentContentHandler_BlobMissing_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	queryArgs := query.FindAttachmentContentQuery{ExpenseID: "expenseId", AttachmentID: "attachmentId"}
+
+	repo.On("GetOne", mock.Anything, "expenseId", "attachmentId").Return(newQueriedAttachment(nil), nil)
+	blobStore.On("Get", mock.Anything, "expenseId/blobId").Return(nil, adapters.ErrBlobNotFound)
+
+	// SUT
+	sut := query.NewFindAttachmentContentHandler(repo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, queryArgs)
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, adapters.ErrBlobNotFound, "Should return blob not found error.")
+}
+
+func TestFindAttachmentContentHandler_Thumbnail_ReturnsThumbnailContent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.AttachmentRepoInterface)
+	blobStore := new(mocks.BlobStore)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	queryArgs := query.FindAttachmentContentQuery{ExpenseID: "expenseId", AttachmentID: "attachmentId", Thumbnail: true}
+	thumbnailKey := "expenseId/blobId-thumbnail"
+
+	repo.On("GetOne", mock.Anything, "expenseId", "attachmentId").Return(newQueriedAttachment(&thumbnailKey), nil)
+	blobStore.On("Get", mock.Anything, thumbnailKey).Return(ioutil.NopCloser(strings.NewReader("thumbnail")), nil)
+
+	// SUT
+	sut := query.NewFindAttachmentContentHandler(repo, blobStore, log)
+
+	// Act
+	result, err := sut.Handle(ctx, queryArgs)
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "image/jpeg", result.ContentType, "Should return thumbnail content type.")
+	content, _ := ioutil.ReadAll(result.Content)
+	assert.Equal(t, "thumbnail", string(content), "Should return thumbnail content.")
+}
@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindAttachmentsQuery defines an expense attachments query.
+type FindAttachmentsQuery struct {
+	ExpenseID string
+}
+
+// FindAttachmentsHandler defines a handler to fetch attachments of an expense.
+type FindAttachmentsHandler struct {
+	expenseRepo    adapters.ExpenseRepoInterface
+	attachmentRepo adapters.AttachmentRepoInterface
+	logger         logger.LogInterface
+}
+
+// FindAttachmentsHandlerInterface defines a contract to handle query.
+type FindAttachmentsHandlerInterface interface {
+	Handle(ctx context.Context, query FindAttachmentsQuery) ([]domain.Attachment, error)
+}
+
+// NewFindAttachmentsHandler returns query handler.
+func NewFindAttachmentsHandler(
+	expenseRepo adapters.ExpenseRepoInterface,
+	attachmentRepo adapters.AttachmentRepoInterface,
+	logger logger.LogInterface,
+) FindAttachmentsHandler {
+	return FindAttachmentsHandler{
+		expenseRepo:    expenseRepo,
+		attachmentRepo: attachmentRepo,
+		logger:         logger,
+	}
+}
+
+// Handle handles find attachments query. Nil is returned when the expense does not exist.
+func (h FindAttachmentsHandler) Handle(ctx context.Context, query FindAttachmentsQuery) ([]domain.Attachment, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find attachments query")
+	span.SetAttributes(attribute.String("expenseId", query.ExpenseID))
+	defer span.End()
+
+	expense, expenseErr := h.expenseRepo.GetOne(ctx, query.ExpenseID)
+	if expenseErr != nil {
+		tracer.AddSpanError(span, expenseErr)
+		return nil, errors.Wrap(expenseErr, "get expense")
+	}
+	if expense == nil {
+		return nil, nil
+	}
+
+	attachments, attachmentsErr := h.attachmentRepo.GetAll(ctx, query.ExpenseID)
+	if attachmentsErr != nil {
+		tracer.AddSpanError(span, attachmentsErr)
+		return nil, errors.Wrap(attachmentsErr, "get attachments")
+	}
+
+	return attachments, nil
+}
@@ -0,0 +1,113 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newQueriedAttachment(thumbnailKey *string) *domain.Attachment {
+	attachment, _ := domain.NewAttachment("attachmentId", domain.AttachmentParams{
+		ExpenseID:    "expenseId",
+		FileName:     "receipt.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		BlobKey:      "expenseId/blobId",
+		ThumbnailKey: thumbnailKey,
+		CreatedAt:    time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	})
+	return attachment
+}
+
+func TestNewFindAttachmentsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenseRepo := new(mocks.ExpenseRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindAttachmentsHandler(expenseRepo, attachmentRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindAttachmentsHandler_ExpenseNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenseRepo := new(mocks.ExpenseRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindAttachmentsHandler(expenseRepo, attachmentRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAttachmentsQuery{ExpenseID: "expenseId"})
+
+	// Assert
+	attachmentRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindAttachmentsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenseRepo := new(mocks.ExpenseRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "path")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now())
+
+	expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(expense, nil)
+	attachmentRepo.On("GetAll", mock.Anything, "expenseId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindAttachmentsHandler(expenseRepo, attachmentRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAttachmentsQuery{ExpenseID: "expenseId"})
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindAttachmentsHandler_RepoSuccess_ReturnsAttachments(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenseRepo := new(mocks.ExpenseRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "path")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now())
+	attachments := []domain.Attachment{*newQueriedAttachment(nil)}
+
+	expenseRepo.On("GetOne", mock.Anything, "expenseId").Return(expense, nil)
+	attachmentRepo.On("GetAll", mock.Anything, "expenseId").Return(attachments, nil)
+
+	// SUT
+	sut := query.NewFindAttachmentsHandler(expenseRepo, attachmentRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindAttachmentsQuery{ExpenseID: "expenseId"})
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, attachments, result, "Should return attachments.")
+}
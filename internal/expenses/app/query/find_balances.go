@@ -0,0 +1,112 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindBalancesQuery defines a balances query, balances are calculated in the default report currency
+// when the currency is not set.
+type FindBalancesQuery struct {
+	Currency string
+}
+
+// FindBalancesHandler defines a handler to calculate who owes whom.
+type FindBalancesHandler struct {
+	expenseRepo    adapters.ReportRepoInterface
+	settlementRepo adapters.SettlementRepoInterface
+	rates          ExchangeRatesProviderInterface
+	logger         logger.LogInterface
+}
+
+// FindBalancesHandlerInterface defines a contract to handle query.
+type FindBalancesHandlerInterface interface {
+	Handle(ctx context.Context, query FindBalancesQuery) (*domain.BalanceSheet, error)
+}
+
+// NewFindBalancesHandler returns query handler.
+func NewFindBalancesHandler(
+	expenseRepo adapters.ReportRepoInterface,
+	settlementRepo adapters.SettlementRepoInterface,
+	rates ExchangeRatesProviderInterface,
+	logger logger.LogInterface,
+) FindBalancesHandler {
+	return FindBalancesHandler{
+		expenseRepo:    expenseRepo,
+		settlementRepo: settlementRepo,
+		rates:          rates,
+		logger:         logger,
+	}
+}
+
+// Handle handles find balances query. Exchange rates are fetched for every day from the first
+// shared expense or settlement to the last one.
+func (h FindBalancesHandler) Handle(ctx context.Context, query FindBalancesQuery) (*domain.BalanceSheet, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find balances query")
+	defer span.End()
+
+	currency := reportCurrency(query.Currency)
+	span.SetAttributes(attribute.String("currency", string(currency)))
+
+	expenses, expensesErr := h.expenseRepo.GetShared(ctx)
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch shared expenses")
+	}
+
+	settlements, settlementsErr := h.settlementRepo.GetAll(ctx)
+	if settlementsErr != nil {
+		tracer.AddSpanError(span, settlementsErr)
+		return nil, errors.Wrap(settlementsErr, "fetch settlements")
+	}
+
+	days := make([]time.Time, 0, len(expenses)+len(settlements))
+	for _, expense := range expenses {
+		days = append(days, expenseDay(expense))
+	}
+	for _, settlement := range settlements {
+		year, month, day := settlement.Date().UTC().Date()
+		days = append(days, time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	}
+
+	var rates []domain.ExchangeRates
+	if len(days) != 0 {
+		from, to := days[0], days[0]
+		for _, day := range days {
+			if day.Before(from) {
+				from = day
+			}
+			if day.After(to) {
+				to = day
+			}
+		}
+		dateRange, dateRangeErr := domain.NewDateRange(from, to)
+		if dateRangeErr != nil {
+			tracer.AddSpanError(span, dateRangeErr)
+			return nil, errors.Wrap(dateRangeErr, "prepare balances date range")
+		}
+
+		var ratesErr error
+		rates, ratesErr = h.rates.ExchangeRates(ctx, *dateRange)
+		if ratesErr != nil {
+			tracer.AddSpanError(span, ratesErr)
+			return nil, errors.Wrap(ratesErr, "fetch exchange rates")
+		}
+	}
+
+	sheet, sheetErr := domain.NewBalanceSheet(currency, expenses, settlements, rates)
+	if sheetErr != nil {
+		tracer.AddSpanError(span, sheetErr)
+		return nil, errors.Wrap(sheetErr, "calculate balances")
+	}
+
+	return sheet, nil
+}
@@ -0,0 +1,121 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindBalancesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenseRepo := new(mocks.ReportRepoInterface)
+	settlementRepo := new(mocks.SettlementRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindBalancesHandler(expenseRepo, settlementRepo, rates, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindBalancesHandler_ExpensesError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenseRepo := new(mocks.ReportRepoInterface)
+	settlementRepo := new(mocks.SettlementRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	expenseRepo.On("GetShared", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindBalancesHandler(expenseRepo, settlementRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindBalancesQuery{})
+
+	// Assert
+	expenseRepo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindBalancesHandler_NothingShared_ReturnsEmptyBalances(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenseRepo := new(mocks.ReportRepoInterface)
+	settlementRepo := new(mocks.SettlementRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	expenseRepo.On("GetShared", mock.Anything).Return([]domain.Expense{}, nil)
+	settlementRepo.On("GetAll", mock.Anything).Return([]domain.Settlement{}, nil)
+
+	// SUT
+	sut := query.NewFindBalancesHandler(expenseRepo, settlementRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindBalancesQuery{})
+
+	// Assert
+	rates.AssertNotCalled(t, "ExchangeRates", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, domain.DefaultReportCurrency, result.Currency, "Should use default currency.")
+	assert.Empty(t, result.Balances, "Should return no balances.")
+}
+
+func TestFindBalancesHandler_Shared_FetchesRatesOfSharedDays(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenseRepo := new(mocks.ReportRepoInterface)
+	settlementRepo := new(mocks.SettlementRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	currency := "usd"
+	expenseDate := time.Date(2021, time.July, 3, 15, 0, 0, 0, time.UTC)
+	settlementDate := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	split, _ := domain.NewSplit(domain.SplitParams{
+		Method: "equal",
+		Shares: []domain.SplitShareParams{{User: "alice"}, {User: "bob"}},
+	})
+	expense, _ := domain.NewExpense("expenseId", *category, 20, "USD", 1, nil, nil, expenseDate,
+		domain.SetSplit("alice", *split))
+	settlement, _ := domain.NewSettlement("settlementId", domain.SettlementParams{
+		From: "bob", To: "alice", Amount: 4, Currency: "USD", Date: settlementDate,
+	})
+
+	expenseRepo.On("GetShared", mock.Anything).Return([]domain.Expense{*expense}, nil)
+	settlementRepo.On("GetAll", mock.Anything).Return([]domain.Settlement{*settlement}, nil)
+	rates.On("ExchangeRates", mock.Anything, mock.MatchedBy(func(dateRange domain.DateRange) bool {
+		return dateRange.From().Equal(settlementDate) &&
+			dateRange.To().Equal(time.Date(2021, time.July, 3, 0, 0, 0, 0, time.UTC))
+	})).Return([]domain.ExchangeRates{}, nil)
+
+	// SUT
+	sut := query.NewFindBalancesHandler(expenseRepo, settlementRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindBalancesQuery{Currency: currency})
+
+	// Assert
+	rates.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, domain.Currency("USD"), result.Currency, "Should use requested currency.")
+	assert.Equal(t, "bob alice 6", result.Debts[0].From+" "+result.Debts[0].To+" "+result.Debts[0].Amount.String(),
+		"Bob owes half of 20 less 4 settled.")
+}
@@ -0,0 +1,133 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindBudgetStatusQuery defines a budget status query for budget periods containing the dates.
+// Budget periods begin on the period start day, the first day of the month by default.
+// Budget figures are reported in the currency, the default report currency when it is empty.
+type FindBudgetStatusQuery struct {
+	Dates          []time.Time
+	PeriodStartDay int
+	Currency       string
+}
+
+// FindBudgetStatusHandler defines a handler to calculate budget figures.
+type FindBudgetStatusHandler struct {
+	repo        adapters.BudgetRepoInterface
+	expenseRepo adapters.ReportRepoInterface
+	rates       ExchangeRatesProviderInterface
+	logger      logger.LogInterface
+}
+
+// FindBudgetStatusHandlerInterface defines a contract to handle query.
+type FindBudgetStatusHandlerInterface interface {
+	Handle(ctx context.Context, query FindBudgetStatusQuery) ([]domain.BudgetStatus, error)
+}
+
+// NewFindBudgetStatusHandler returns query handler.
+func NewFindBudgetStatusHandler(
+	repo adapters.BudgetRepoInterface,
+	expenseRepo adapters.ReportRepoInterface,
+	rates ExchangeRatesProviderInterface,
+	logger logger.LogInterface,
+) FindBudgetStatusHandler {
+	return FindBudgetStatusHandler{
+		repo:        repo,
+		expenseRepo: expenseRepo,
+		rates:       rates,
+		logger:      logger,
+	}
+}
+
+// Handle handles find budget status query. Budgets that have not started by a date are skipped.
+func (h FindBudgetStatusHandler) Handle(
+	ctx context.Context,
+	query FindBudgetStatusQuery,
+) ([]domain.BudgetStatus, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find budget status query")
+	defer span.End()
+
+	periodStartDay := query.PeriodStartDay
+	if periodStartDay == 0 {
+		periodStartDay = domain.DefaultPeriodStartDay
+	}
+
+	budgets, budgetsErr := h.repo.GetAll(ctx)
+	if budgetsErr != nil {
+		tracer.AddSpanError(span, budgetsErr)
+		return nil, errors.Wrap(budgetsErr, "get budgets")
+	}
+
+	// Collect budget periods of every date to fetch expenses once.
+	type budgetDate struct {
+		budget domain.Budget
+		date   time.Time
+	}
+	budgetDates := make([]budgetDate, 0)
+	seen := make(map[string]bool)
+	var from, to time.Time
+	for _, budget := range budgets {
+		for _, date := range query.Dates {
+			if !budget.IsActive(date, periodStartDay) {
+				continue
+			}
+			period := budget.PeriodRange(date, periodStartDay)
+			key := budget.ID() + period.From().String()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			budgetDates = append(budgetDates, budgetDate{budget: budget, date: date})
+
+			first := budget.Periods(date, periodStartDay)[0]
+			if from.IsZero() || first.From().Before(from) {
+				from = first.From()
+			}
+			if period.To().After(to) {
+				to = period.To()
+			}
+		}
+	}
+
+	if len(budgetDates) == 0 {
+		return []domain.BudgetStatus{}, nil
+	}
+
+	dateRange, _ := domain.NewDateRange(from, to)
+	filter, _ := domain.NewExpenseFilter(from, to, string(domain.IntervalDay))
+	expenses, expensesErr := h.expenseRepo.GetAll(ctx, *filter)
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch budget expenses")
+	}
+
+	rates, ratesErr := h.rates.ExchangeRates(ctx, *dateRange)
+	if ratesErr != nil {
+		tracer.AddSpanError(span, ratesErr)
+		return nil, errors.Wrap(ratesErr, "fetch budget exchange rates")
+	}
+
+	currency := reportCurrency(query.Currency)
+	if currencyErr := domain.CheckReportCurrency(currency, rates); currencyErr != nil {
+		tracer.AddSpanError(span, currencyErr)
+		return nil, errors.Wrap(domain.ErrInvalidReportCurrency, currencyErr.Error())
+	}
+
+	tracker := domain.NewBudgetTracker(expenses, rates, periodStartDay, currency)
+	statuses := make([]domain.BudgetStatus, 0, len(budgetDates))
+	for _, budgetDate := range budgetDates {
+		statuses = append(statuses, tracker.Status(budgetDate.budget, budgetDate.date))
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,169 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindBudgetStatusHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindBudgetStatusHandler(repo, expenseRepo, rates, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindBudgetStatusHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	statusQuery := query.FindBudgetStatusQuery{Dates: []time.Time{time.Now()}}
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindBudgetStatusHandler(repo, expenseRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, statusQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindBudgetStatusHandler_NotStartedBudget_ReturnsEmpty(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	statusQuery := query.FindBudgetStatusQuery{
+		Dates: []time.Time{time.Date(2020, time.December, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	repo.On("GetAll", mock.Anything).Return([]domain.Budget{newMonthlyBudget(false)}, nil)
+
+	// SUT
+	sut := query.NewFindBudgetStatusHandler(repo, expenseRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, statusQuery)
+
+	// Assert
+	expenseRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Empty(t, result, "Should skip budgets that have not started.")
+}
+
+func TestFindBudgetStatusHandler_ExpensesError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	statusQuery := query.FindBudgetStatusQuery{
+		Dates: []time.Time{time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	repo.On("GetAll", mock.Anything).Return([]domain.Budget{newMonthlyBudget(false)}, nil)
+	expenseRepo.On("GetAll", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindBudgetStatusHandler(repo, expenseRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, statusQuery)
+
+	// Assert
+	expenseRepo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindBudgetStatusHandler_RolloverBudget_FetchesExpensesSinceStart(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	day := time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC)
+	statusQuery := query.FindBudgetStatusQuery{Dates: []time.Time{day, day.AddDate(0, 0, 1)}}
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 40, "EUR", 1, nil, nil, day)
+
+	repo.On("GetAll", mock.Anything).Return([]domain.Budget{newMonthlyBudget(true)}, nil)
+	expenseRepo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseFilter) bool {
+		return filter.From().Equal(time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)) &&
+			filter.To().Equal(time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC).Add(-time.Nanosecond))
+	})).Return([]domain.Expense{*expense}, nil)
+	rates.On("ExchangeRates", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+
+	// SUT
+	sut := query.NewFindBudgetStatusHandler(repo, expenseRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, statusQuery)
+
+	// Assert
+	expenseRepo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result, 1, "Should return a single status per budget period.")
+	assert.True(t, decimal.NewFromInt(300).Equal(result[0].Amount), "Should roll over unused amounts.")
+	assert.True(t, decimal.NewFromInt(40).Equal(result[0].Spent), "Should sum spent amount.")
+}
+
+func TestFindBudgetStatusHandler_UnknownCurrency_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	day := time.Date(2021, time.March, 15, 0, 0, 0, 0, time.UTC)
+	statusQuery := query.FindBudgetStatusQuery{Dates: []time.Time{day}, Currency: "XYZ"}
+	rate, _ := domain.NewExchageRate(day, "EUR", map[string]float64{"USD": 2})
+
+	repo.On("GetAll", mock.Anything).Return([]domain.Budget{newMonthlyBudget(false)}, nil)
+	expenseRepo.On("GetAll", mock.Anything, mock.Anything).Return([]domain.Expense{}, nil)
+	rates.On("ExchangeRates", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{*rate}, nil)
+
+	// SUT
+	sut := query.NewFindBudgetStatusHandler(repo, expenseRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, statusQuery)
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReportCurrency)
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindBudgetsQuery defines a budgets query.
+type FindBudgetsQuery struct{}
+
+// FindBudgetsHandler defines a handler to fetch budgets.
+type FindBudgetsHandler struct {
+	repo   adapters.BudgetRepoInterface
+	logger logger.LogInterface
+}
+
+// FindBudgetsHandlerInterface defines a contract to handle query.
+type FindBudgetsHandlerInterface interface {
+	Handle(ctx context.Context, query FindBudgetsQuery) ([]domain.Budget, error)
+}
+
+// NewFindBudgetsHandler returns query handler.
+func NewFindBudgetsHandler(
+	repo adapters.BudgetRepoInterface,
+	logger logger.LogInterface,
+) FindBudgetsHandler {
+	return FindBudgetsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find budgets query.
+func (h FindBudgetsHandler) Handle(ctx context.Context, query FindBudgetsQuery) ([]domain.Budget, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find budgets query")
+	defer span.End()
+
+	budgets, budgetsErr := h.repo.GetAll(ctx)
+	if budgetsErr != nil {
+		tracer.AddSpanError(span, budgetsErr)
+		return nil, errors.Wrap(budgetsErr, "get budgets")
+	}
+
+	return budgets, nil
+}
@@ -0,0 +1,82 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newMonthlyBudget(rollover bool) domain.Budget {
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     "monthly",
+		Amount:     100,
+		Rollover:   rollover,
+		Start:      time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	return *budget
+}
+
+func TestNewFindBudgetsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindBudgetsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindBudgetsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindBudgetsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindBudgetsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindBudgetsHandler_RepoSuccess_ReturnsBudgets(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.BudgetRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	budgets := []domain.Budget{newMonthlyBudget(false)}
+
+	repo.On("GetAll", mock.Anything).Return(budgets, nil)
+
+	// SUT
+	sut := query.NewFindBudgetsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindBudgetsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, budgets, result, "Should return budgets.")
+}
@@ -0,0 +1,80 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindCashFlowQuery defines a cash flow query.
+type FindCashFlowQuery struct {
+	DateRange      domain.DateRange
+	Interval       string
+	ExchangeRates  []domain.ExchangeRates
+	PeriodStartDay int
+	Currency       string
+}
+
+// FindCashFlowHandler defines a handler to fetch cash flow.
+type FindCashFlowHandler struct {
+	reportRepo adapters.ReportRepoInterface
+	incomeRepo adapters.IncomeRepoInterface
+	logger     logger.LogInterface
+}
+
+// FindCashFlowHandlerInterface defines a contract to handle query.
+type FindCashFlowHandlerInterface interface {
+	Handle(ctx context.Context, query FindCashFlowQuery) (*domain.CashFlowReport, error)
+}
+
+// NewFindCashFlowHandler returns a query handler.
+func NewFindCashFlowHandler(
+	reportRepo adapters.ReportRepoInterface,
+	incomeRepo adapters.IncomeRepoInterface,
+	logger logger.LogInterface,
+) FindCashFlowHandler {
+	return FindCashFlowHandler{
+		reportRepo: reportRepo,
+		incomeRepo: incomeRepo,
+		logger:     logger,
+	}
+}
+
+// Handle handles query to find income, expenses and net per interval.
+func (h FindCashFlowHandler) Handle(ctx context.Context, query FindCashFlowQuery) (*domain.CashFlowReport, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find cash flow query")
+	defer span.End()
+
+	currency := reportCurrency(query.Currency)
+	if currencyErr := domain.CheckReportCurrency(currency, query.ExchangeRates); currencyErr != nil {
+		tracer.AddSpanError(span, currencyErr)
+		return nil, errors.Wrap(domain.ErrInvalidReportCurrency, currencyErr.Error())
+	}
+
+	filter, filterErr := domain.NewExpenseFilter(query.DateRange.From(), query.DateRange.To(), query.Interval,
+		domain.SetPeriodStartDay(query.PeriodStartDay))
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return nil, errors.Wrap(filterErr, "prepare filter")
+	}
+
+	expenses, expensesErr := h.reportRepo.GetAll(ctx, *filter)
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch expenses")
+	}
+
+	incomes, incomesErr := h.incomeRepo.GetAll(ctx, query.DateRange)
+	if incomesErr != nil {
+		tracer.AddSpanError(span, incomesErr)
+		return nil, errors.Wrap(incomesErr, "fetch incomes")
+	}
+
+	report := domain.NewCashFlowReport(incomes, expenses, *filter, query.ExchangeRates, currency)
+	return &report, nil
+}
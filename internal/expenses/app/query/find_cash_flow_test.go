@@ -0,0 +1,107 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindCashFlowHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	reportRepo := new(mocks.ReportRepoInterface)
+	incomeRepo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindCashFlowHandler(reportRepo, incomeRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindCashFlowHandler_InvalidInterval_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	reportRepo := new(mocks.ReportRepoInterface)
+	incomeRepo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	dateRange, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC))
+
+	// SUT
+	sut := query.NewFindCashFlowHandler(reportRepo, incomeRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindCashFlowQuery{DateRange: *dateRange, Interval: "century"})
+
+	// Assert
+	reportRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindCashFlowHandler_IncomeRepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	reportRepo := new(mocks.ReportRepoInterface)
+	incomeRepo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	dateRange, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC))
+
+	reportRepo.On("GetAll", mock.Anything, mock.Anything).Return([]domain.Expense{}, nil)
+	incomeRepo.On("GetAll", mock.Anything, *dateRange).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindCashFlowHandler(reportRepo, incomeRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindCashFlowQuery{DateRange: *dateRange, Interval: "month"})
+
+	// Assert
+	incomeRepo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindCashFlowHandler_ReposSuccess_ReturnsReport(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	reportRepo := new(mocks.ReportRepoInterface)
+	incomeRepo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	dateRange, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC))
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, _ := domain.NewExpense("expenseId", *category, 800, "EUR", 1, nil, nil, dateRange.From())
+
+	reportRepo.On("GetAll", mock.Anything, mock.Anything).Return([]domain.Expense{*expense}, nil)
+	incomeRepo.On("GetAll", mock.Anything, *dateRange).Return([]domain.Income{newIncome(dateRange.From())}, nil)
+
+	// SUT
+	sut := query.NewFindCashFlowHandler(reportRepo, incomeRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindCashFlowQuery{DateRange: *dateRange, Interval: "month"})
+
+	// Assert
+	reportRepo.AssertExpectations(t)
+	incomeRepo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result.Periods, 1)
+	assert.True(t, decimal.NewFromInt(2400).Equal(result.Net.Sum), "Net should be income less expenses.")
+	assert.Equal(t, "75", result.SavingsRate.String())
+}
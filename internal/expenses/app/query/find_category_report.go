@@ -0,0 +1,81 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindCategoryReportQuery defines a category report query. Expenses are filtered the same way
+// FindExpensesQuery filters them.
+type FindCategoryReportQuery struct {
+	ReportFilter
+	DateRange      domain.DateRange
+	Interval       string
+	Tags           []string
+	ExcludedTags   []string
+	ExchangeRates  []domain.ExchangeRates
+	PeriodStartDay int
+	Currency       string
+}
+
+// FindCategoryReportHandler defines a handler to fetch category report.
+type FindCategoryReportHandler struct {
+	repo   adapters.ReportRepoInterface
+	logger logger.LogInterface
+}
+
+// FindCategoryReportHandlerInterface defines a contract to handle query.
+type FindCategoryReportHandlerInterface interface {
+	Handle(ctx context.Context, query FindCategoryReportQuery) (*domain.ReportByCategory, error)
+}
+
+// NewFindCategoryReportHandler returns a query handler.
+func NewFindCategoryReportHandler(
+	repo adapters.ReportRepoInterface,
+	logger logger.LogInterface,
+) FindCategoryReportHandler {
+	return FindCategoryReportHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles query to find category totals per interval.
+func (h FindCategoryReportHandler) Handle(
+	ctx context.Context,
+	query FindCategoryReportQuery,
+) (*domain.ReportByCategory, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find category report query")
+	defer span.End()
+
+	currency := reportCurrency(query.Currency)
+	if currencyErr := domain.CheckReportCurrency(currency, query.ExchangeRates); currencyErr != nil {
+		tracer.AddSpanError(span, currencyErr)
+		return nil, errors.Wrap(domain.ErrInvalidReportCurrency, currencyErr.Error())
+	}
+
+	opts := append(query.ReportFilter.options(),
+		domain.SetTagFilter(query.Tags, query.ExcludedTags), domain.SetPeriodStartDay(query.PeriodStartDay))
+	filter, filterErr := domain.NewExpenseFilter(query.DateRange.From(), query.DateRange.To(), query.Interval, opts...)
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return nil, errors.Wrap(domain.ErrInvalidReportFilter, filterErr.Error())
+	}
+
+	expenses, expensesErr := h.repo.GetAll(ctx, *filter)
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch expenses")
+	}
+
+	reportGenerator := domain.NewReportGenerator(expenses, *filter, query.ExchangeRates, currency)
+	report := reportGenerator.GenerateByCategoryReport()
+
+	return &report, nil
+}
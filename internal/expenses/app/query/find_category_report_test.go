@@ -0,0 +1,99 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindCategoryReportHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindCategoryReportHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindCategoryReportHandler_InvalidInterval_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	dateRange, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC))
+
+	// SUT
+	sut := query.NewFindCategoryReportHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindCategoryReportQuery{DateRange: *dateRange, Interval: "century"})
+
+	// Assert
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindCategoryReportHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	dateRange, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC))
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindCategoryReportHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindCategoryReportQuery{DateRange: *dateRange, Interval: "month"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindCategoryReportHandler_RepoSuccess_ReturnsReport(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	dateRange, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC))
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, _ := domain.NewExpense("expenseId", *category, 800, "EUR", 1, nil, nil, dateRange.From())
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return([]domain.Expense{*expense}, nil)
+
+	// SUT
+	sut := query.NewFindCategoryReportHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindCategoryReportQuery{DateRange: *dateRange, Interval: "month"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result.Dates, 2, "Should contain every month of the range.")
+	assert.Len(t, result.SubCategories, 1)
+	assert.Len(t, result.SubCategories[0].Series, 2)
+}
@@ -0,0 +1,102 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindComparisonReportQuery defines a comparison report query. The base period is compared with every
+// comparison period, expenses are filtered the same way FindExpensesQuery filters them. Period totals
+// are averaged per AverageBy interval when it is set.
+type FindComparisonReportQuery struct {
+	ReportFilter
+	Base           domain.DateRange
+	Comparisons    []domain.DateRange
+	AverageBy      string
+	Tags           []string
+	ExcludedTags   []string
+	ExchangeRates  []domain.ExchangeRates
+	PeriodStartDay int
+	Currency       string
+}
+
+// FindComparisonReportHandler defines a handler to fetch comparison report.
+type FindComparisonReportHandler struct {
+	repo   adapters.ReportRepoInterface
+	logger logger.LogInterface
+}
+
+// FindComparisonReportHandlerInterface defines a contract to handle query.
+type FindComparisonReportHandlerInterface interface {
+	Handle(ctx context.Context, query FindComparisonReportQuery) (*domain.ComparisonReport, error)
+}
+
+// NewFindComparisonReportHandler returns a query handler.
+func NewFindComparisonReportHandler(
+	repo adapters.ReportRepoInterface,
+	logger logger.LogInterface,
+) FindComparisonReportHandler {
+	return FindComparisonReportHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles query to compare category totals of the periods.
+func (h FindComparisonReportHandler) Handle(
+	ctx context.Context,
+	query FindComparisonReportQuery,
+) (*domain.ComparisonReport, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find comparison report query")
+	defer span.End()
+
+	currency := reportCurrency(query.Currency)
+	if currencyErr := domain.CheckReportCurrency(currency, query.ExchangeRates); currencyErr != nil {
+		tracer.AddSpanError(span, currencyErr)
+		return nil, errors.Wrap(domain.ErrInvalidReportCurrency, currencyErr.Error())
+	}
+
+	if len(query.Comparisons) == 0 {
+		tracer.AddSpanError(span, domain.ErrInvalidReportFilter)
+		return nil, errors.Wrap(domain.ErrInvalidReportFilter, "no comparison periods")
+	}
+
+	// Intervals only matter to average period totals.
+	interval, average := query.AverageBy, true
+	if len(interval) == 0 {
+		interval, average = string(domain.IntervalMonth), false
+	}
+
+	generators := make([]domain.ReportGenerator, 0, len(query.Comparisons)+1)
+	for _, period := range append([]domain.DateRange{query.Base}, query.Comparisons...) {
+		opts := append(query.ReportFilter.options(),
+			domain.SetTagFilter(query.Tags, query.ExcludedTags), domain.SetPeriodStartDay(query.PeriodStartDay))
+		filter, filterErr := domain.NewExpenseFilter(period.From(), period.To(), interval, opts...)
+		if filterErr != nil {
+			tracer.AddSpanError(span, filterErr)
+			return nil, errors.Wrap(domain.ErrInvalidReportFilter, filterErr.Error())
+		}
+
+		expenses, expensesErr := h.repo.GetAll(ctx, *filter)
+		if expensesErr != nil {
+			tracer.AddSpanError(span, expensesErr)
+			return nil, errors.Wrap(expensesErr, "fetch expenses")
+		}
+
+		generators = append(generators, domain.NewReportGenerator(expenses, *filter, query.ExchangeRates, currency))
+	}
+
+	report, reportErr := domain.NewComparisonReport(generators[0], generators[1:], average)
+	if reportErr != nil {
+		tracer.AddSpanError(span, reportErr)
+		return nil, errors.Wrap(reportErr, "compare periods")
+	}
+
+	return report, nil
+}
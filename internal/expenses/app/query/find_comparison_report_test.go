@@ -0,0 +1,148 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindComparisonReportHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindComparisonReportHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindComparisonReportHandler_NoComparisonPeriods_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	base, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC))
+
+	// SUT
+	sut := query.NewFindComparisonReportHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindComparisonReportQuery{Base: *base})
+
+	// Assert
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReportFilter)
+}
+
+func TestFindComparisonReportHandler_InvalidAverageInterval_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	base, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC))
+	comparison, _ := domain.NewDateRange(time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.July, 31, 0, 0, 0, 0, time.UTC))
+
+	// SUT
+	sut := query.NewFindComparisonReportHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindComparisonReportQuery{
+		Base:        *base,
+		Comparisons: []domain.DateRange{*comparison},
+		AverageBy:   "century",
+	})
+
+	// Assert
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReportFilter)
+}
+
+func TestFindComparisonReportHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	base, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC))
+	comparison, _ := domain.NewDateRange(time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.July, 31, 0, 0, 0, 0, time.UTC))
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindComparisonReportHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindComparisonReportQuery{
+		Base:        *base,
+		Comparisons: []domain.DateRange{*comparison},
+	})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindComparisonReportHandler_RepoSuccess_ReturnsReport(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	base, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC))
+	lastYear, _ := domain.NewDateRange(time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.July, 31, 0, 0, 0, 0, time.UTC))
+	trailing, _ := domain.NewDateRange(time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC))
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, _ := domain.NewExpense("expenseId", *category, 800, "EUR", 1, nil, nil, base.From())
+
+	matchPeriodFn := func(period domain.DateRange) func(domain.ExpenseFilter) bool {
+		return func(filter domain.ExpenseFilter) bool {
+			return filter.From().Equal(period.From()) && filter.To().Equal(period.To()) &&
+				filter.Interval() == domain.IntervalMonth
+		}
+	}
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(matchPeriodFn(*base))).Return([]domain.Expense{*expense}, nil)
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(matchPeriodFn(*lastYear))).Return([]domain.Expense{}, nil)
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(matchPeriodFn(*trailing))).Return([]domain.Expense{}, nil)
+
+	// SUT
+	sut := query.NewFindComparisonReportHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindComparisonReportQuery{
+		Base:        *base,
+		Comparisons: []domain.DateRange{*lastYear, *trailing},
+		AverageBy:   "month",
+	})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.True(t, result.Average)
+	assert.Len(t, result.Periods, 2)
+	assert.Len(t, result.SubCategories, 1)
+	assert.Len(t, result.SubCategories[0].Comparisons, 2)
+}
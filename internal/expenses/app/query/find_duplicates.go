@@ -0,0 +1,107 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindDuplicatesQuery defines a query to find likely duplicate expenses of the date range.
+type FindDuplicatesQuery struct {
+	From      time.Time
+	To        time.Time
+	Days      *int
+	Tolerance *float64
+}
+
+// FindDuplicatesHandler defines a handler to find duplicate expenses.
+type FindDuplicatesHandler struct {
+	repo   adapters.ExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// FindDuplicatesHandlerInterface defines a contract to handle query.
+type FindDuplicatesHandlerInterface interface {
+	Handle(ctx context.Context, query FindDuplicatesQuery) ([]domain.DuplicateGroup, error)
+}
+
+// NewFindDuplicatesHandler returns query handler.
+func NewFindDuplicatesHandler(
+	repo adapters.ExpenseRepoInterface,
+	logger logger.LogInterface,
+) FindDuplicatesHandler {
+	return FindDuplicatesHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find duplicates query. Expenses of the date range are fetched page by page and grouped
+// once all of them are fetched.
+func (h FindDuplicatesHandler) Handle(ctx context.Context, query FindDuplicatesQuery) ([]domain.DuplicateGroup, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find duplicates query")
+	span.SetAttributes(attribute.String("from", query.From.String()), attribute.String("to", query.To.String()))
+	defer span.End()
+
+	criteria, criteriaErr := domain.NewDuplicateCriteria(domain.DuplicateCriteriaParams{
+		Days:      query.Days,
+		Tolerance: query.Tolerance,
+	})
+	if criteriaErr != nil {
+		tracer.AddSpanError(span, criteriaErr)
+		return nil, errors.Wrap(domain.ErrInvalidDuplicateCriteria, criteriaErr.Error())
+	}
+
+	limit := domain.MaxPageSize
+	sortBy := string(domain.SortFieldDate)
+	order := string(domain.SortOrderAsc)
+	filter, filterErr := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{
+		From:   &query.From,
+		To:     &query.To,
+		SortBy: &sortBy,
+		Order:  &order,
+		Limit:  &limit,
+	})
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return nil, errors.Wrap(domain.ErrInvalidDuplicateCriteria, filterErr.Error())
+	}
+
+	expenses := make([]domain.Expense, 0)
+	for {
+		page, pageErr := h.repo.GetAll(ctx, *filter)
+		if pageErr != nil {
+			tracer.AddSpanError(span, pageErr)
+			return nil, errors.Wrap(pageErr, "fetch expenses")
+		}
+		expenses = append(expenses, page.Expenses...)
+
+		if page.NextCursor == nil {
+			break
+		}
+		cursor, cursorErr := domain.DecodeExpenseCursor(*page.NextCursor)
+		if cursorErr != nil {
+			tracer.AddSpanError(span, cursorErr)
+			return nil, errors.Wrap(cursorErr, "decode next page cursor")
+		}
+		*filter = filter.WithCursor(*cursor)
+	}
+
+	groups := domain.NewDuplicateDetector(*criteria).Groups(expenses)
+	for _, group := range groups {
+		for index := range group.Expenses {
+			group.Expenses[index].CalculateTotal(nil)
+		}
+	}
+
+	span.SetAttributes(attribute.Int("checked", len(expenses)), attribute.Int("groups", len(groups)))
+
+	return groups, nil
+}
@@ -0,0 +1,117 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newFindDuplicatesQuery() query.FindDuplicatesQuery {
+	return query.FindDuplicatesQuery{
+		From: time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewFindDuplicatesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindDuplicatesHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindDuplicatesHandler_InvalidCriteria_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	days := -1
+	invalidDays := newFindDuplicatesQuery()
+	invalidDays.Days = &days
+	invalidRange := newFindDuplicatesQuery()
+	invalidRange.From, invalidRange.To = invalidRange.To, invalidRange.From
+
+	// SUT
+	sut := query.NewFindDuplicatesHandler(repo, log)
+
+	for _, findQuery := range []query.FindDuplicatesQuery{invalidDays, invalidRange} {
+		// Act
+		result, err := sut.Handle(ctx, findQuery)
+
+		// Assert
+		assert.Nil(t, result, "Result should be nil.")
+		assert.ErrorIs(t, err, domain.ErrInvalidDuplicateCriteria, "Should return invalid criteria error.")
+	}
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+}
+
+func TestFindDuplicatesHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindDuplicatesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newFindDuplicatesQuery())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindDuplicatesHandler_AllPages_ReturnsGroups(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	date := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	comment := "Dinner"
+	first, _ := domain.NewExpense("firstId", *category, 45.5, "EUR", 1, &comment, nil, date)
+	second, _ := domain.NewExpense("secondId", *category, 45.5, "EUR", 1, &comment, nil, date.AddDate(0, 0, 1))
+	single, _ := domain.NewExpense("singleId", *category, 12, "EUR", 1, &comment, nil, date)
+	cursor := domain.NewExpenseCursor(*first, domain.SortFieldDate).Encode()
+
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseListFilter) bool {
+		return filter.Cursor() == nil
+	})).Return(&domain.ExpensePage{Expenses: []domain.Expense{*first, *single}, NextCursor: &cursor}, nil).Once()
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseListFilter) bool {
+		return filter.Cursor() != nil
+	})).Return(&domain.ExpensePage{Expenses: []domain.Expense{*second}}, nil).Once()
+
+	// SUT
+	sut := query.NewFindDuplicatesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newFindDuplicatesQuery())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result, 1, "Should return duplicate groups.")
+	assert.Equal(t, "firstId-secondId", result[0].ID, "Should group duplicates of both pages.")
+	assert.Equal(t, "45.5", result[0].Expenses[0].TotalInfo().OriginalTotal.Sum.String(), "Should calculate totals.")
+}
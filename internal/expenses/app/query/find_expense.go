@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindExpenseQuery defines a single expense query.
+type FindExpenseQuery struct {
+	ExpenseID string
+}
+
+// FindExpenseHandler defines a handler to fetch a single expense.
+type FindExpenseHandler struct {
+	repo   adapters.ExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// FindExpenseHandlerInterface defines a contract to handle query.
+type FindExpenseHandlerInterface interface {
+	Handle(ctx context.Context, query FindExpenseQuery) (*domain.Expense, error)
+}
+
+// NewFindExpenseHandler returns query handler.
+func NewFindExpenseHandler(
+	repo adapters.ExpenseRepoInterface,
+	logger logger.LogInterface,
+) FindExpenseHandler {
+	return FindExpenseHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find expense query.
+func (h FindExpenseHandler) Handle(ctx context.Context, query FindExpenseQuery) (*domain.Expense, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find expense query")
+	defer span.End()
+
+	expense, expenseErr := h.repo.GetOne(ctx, query.ExpenseID)
+	if expenseErr != nil {
+		tracer.AddSpanError(span, expenseErr)
+		return nil, errors.Wrap(expenseErr, "get expense")
+	}
+
+	if expense == nil {
+		return nil, nil
+	}
+
+	expense.CalculateTotal(nil)
+
+	return expense, nil
+}
@@ -0,0 +1,106 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindExpenseHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindExpenseHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindExpenseHandle_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	findQuery := query.FindExpenseQuery{
+		ExpenseID: expenseID,
+	}
+
+	repo.On("GetOne", mock.Anything, expenseID).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindExpenseHandle_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	findQuery := query.FindExpenseQuery{
+		ExpenseID: expenseID,
+	}
+
+	repo.On("GetOne", mock.Anything, expenseID).Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindExpenseHandle_RepoSuccess_ReturnsExpenseWithTotal(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	expenseID := "expenseId"
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense(expenseID, *category, 10, "EUR", 2, nil, nil, time.Now())
+	findQuery := query.FindExpenseQuery{
+		ExpenseID: expenseID,
+	}
+
+	repo.On("GetOne", mock.Anything, expenseID).Return(expense, nil)
+
+	// SUT
+	sut := query.NewFindExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, expenseID, result.ID(), "Should return expense.")
+	assert.Equal(t, "20", result.TotalInfo().OriginalTotal.Sum.String(), "Should calculate total.")
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -11,17 +12,49 @@ import (
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
 )
 
-// FindExpensesQuery defines an expense query.
+// FindExpensesQuery defines an expense query. Expenses are limited to the ones tagged with any of Tags
+// and none of ExcludedTags when they are set. Months, quarters and years begin on PeriodStartDay when it is set.
+// Totals are converted into Currency, the default report currency is used when it is empty.
 type FindExpensesQuery struct {
-	DateRange     domain.DateRange
-	Interval      string
-	ExchangeRates []domain.ExchangeRates
+	ReportFilter
+	DateRange      domain.DateRange
+	Interval       string
+	Tags           []string
+	ExcludedTags   []string
+	ExchangeRates  []domain.ExchangeRates
+	PeriodStartDay int
+	Currency       string
+}
+
+// ReportFilter defines optional filters of reported expenses. Expenses are limited to the subtrees
+// of Categories and none of ExcludedCategories, to OriginalCurrencies they are paid in, to the trip,
+// to totals between MinAmount and MaxAmount in the original currency and to comments containing Comment.
+type ReportFilter struct {
+	Categories         []string
+	ExcludedCategories []string
+	OriginalCurrencies []string
+	TripID             string
+	MinAmount          *float64
+	MaxAmount          *float64
+	Comment            string
+}
+
+// options returns expense filter options of the report filter.
+func (f ReportFilter) options() []func(*domain.ExpenseFilter) {
+	return []func(*domain.ExpenseFilter){
+		domain.SetCategoryFilter(f.Categories, f.ExcludedCategories),
+		domain.SetCurrencyFilter(f.OriginalCurrencies),
+		domain.SetTripFilter(f.TripID),
+		domain.SetAmountFilter(f.MinAmount, f.MaxAmount),
+		domain.SetCommentFilter(f.Comment),
+	}
 }
 
 // FindExpensesHandler defines a handler to fetch expenses.
 type FindExpensesHandler struct {
-	repo   adapters.ReportRepoInterface
-	logger logger.LogInterface
+	repo    adapters.ReportRepoInterface
+	budgets FindBudgetStatusHandlerInterface
+	logger  logger.LogInterface
 }
 
 // FindExpensesHandlerInterface defines a contract to handle query.
@@ -32,15 +65,18 @@ type FindExpensesHandlerInterface interface {
 // NewFindExpensesHandler returns a query handler.
 func NewFindExpensesHandler(
 	repo adapters.ReportRepoInterface,
+	budgets FindBudgetStatusHandlerInterface,
 	logger logger.LogInterface,
 ) FindExpensesHandler {
 	return FindExpensesHandler{
-		repo:   repo,
-		logger: logger,
+		repo:    repo,
+		budgets: budgets,
+		logger:  logger,
 	}
 }
 
-// Handle handles query to find expenses.
+// Handle handles query to find expenses. Category expenses carry budget figures of the budget
+// period containing the report date.
 func (h FindExpensesHandler) Handle(
 	ctx context.Context,
 	query FindExpensesQuery,
@@ -48,10 +84,18 @@ func (h FindExpensesHandler) Handle(
 	ctx, span := tracer.NewSpan(ctx, "execute find expenses query")
 	defer span.End()
 
-	filter, filterErr := domain.NewExpenseFilter(query.DateRange.From(), query.DateRange.To(), query.Interval)
+	currency := reportCurrency(query.Currency)
+	if currencyErr := domain.CheckReportCurrency(currency, query.ExchangeRates); currencyErr != nil {
+		tracer.AddSpanError(span, currencyErr)
+		return nil, errors.Wrap(domain.ErrInvalidReportCurrency, currencyErr.Error())
+	}
+
+	opts := append(query.ReportFilter.options(),
+		domain.SetTagFilter(query.Tags, query.ExcludedTags), domain.SetPeriodStartDay(query.PeriodStartDay))
+	filter, filterErr := domain.NewExpenseFilter(query.DateRange.From(), query.DateRange.To(), query.Interval, opts...)
 	if filterErr != nil {
 		tracer.AddSpanError(span, filterErr)
-		return nil, errors.Wrap(filterErr, "prepare filter")
+		return nil, errors.Wrap(domain.ErrInvalidReportFilter, filterErr.Error())
 	}
 
 	expenses, expensesErr := h.repo.GetAll(ctx, *filter)
@@ -60,8 +104,35 @@ func (h FindExpensesHandler) Handle(
 		return nil, errors.Wrap(expensesErr, "fetch expenses")
 	}
 
-	reportGenerator := domain.NewReportGenerator(expenses, *filter, query.ExchangeRates)
+	reportGenerator := domain.NewReportGenerator(expenses, *filter, query.ExchangeRates, currency)
 	report := reportGenerator.GenerateByDateReport()
 
+	statuses, statusesErr := h.budgets.Handle(ctx, FindBudgetStatusQuery{
+		Dates:          report.Dates(),
+		PeriodStartDay: filter.PeriodStartDay(),
+		Currency:       string(currency),
+	})
+	if statusesErr != nil {
+		tracer.AddSpanError(span, statusesErr)
+		return nil, errors.Wrap(statusesErr, "fetch budget status")
+	}
+
+	reportStatuses := make([]domain.BudgetStatus, 0, len(statuses))
+	for _, status := range statuses {
+		if status.Budget.Covers(filter.Interval()) {
+			reportStatuses = append(reportStatuses, status)
+		}
+	}
+	report.SetBudgets(reportStatuses)
+
 	return &report, nil
 }
+
+// reportCurrency returns the currency report totals are converted into.
+func reportCurrency(currency string) domain.Currency {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if len(currency) == 0 {
+		return domain.DefaultReportCurrency
+	}
+	return domain.Currency(currency)
+}
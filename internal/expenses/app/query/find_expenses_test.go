@@ -3,9 +3,12 @@ package query_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -18,10 +21,11 @@ func TestNewFindExpensesHandler_ReturnsHandler(t *testing.T) {
 	t.Parallel()
 	// Arrange
 	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
 	log := new(mocks.LogInterface)
 
 	// Act
-	err := query.NewFindExpensesHandler(repo, log)
+	err := query.NewFindExpensesHandler(repo, budgets, log)
 
 	// Assert
 	assert.NotNil(t, err, "Error result should not be nil.")
@@ -31,6 +35,7 @@ func TestFindExpensesHandle_FilterError_ThrowsError(t *testing.T) {
 	t.Parallel()
 	// Arrange
 	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
 	log := new(mocks.LogInterface)
 	ctx := context.Background()
 	from := time.Date(2021, time.July, 3, 0, 0, 0, 0, time.UTC)
@@ -43,7 +48,7 @@ func TestFindExpensesHandle_FilterError_ThrowsError(t *testing.T) {
 	}
 
 	// SUT
-	sut := query.NewFindExpensesHandler(repo, log)
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
 
 	// Act
 	query, err := sut.Handle(ctx, findQuery)
@@ -58,6 +63,7 @@ func TestFindExpensesHandle_RepoError_ThrowsError(t *testing.T) {
 	t.Parallel()
 	// Arrange
 	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
 	log := new(mocks.LogInterface)
 	ctx := context.Background()
 	from := time.Date(2021, time.July, 3, 0, 0, 0, 0, time.UTC)
@@ -78,7 +84,7 @@ func TestFindExpensesHandle_RepoError_ThrowsError(t *testing.T) {
 		mock.MatchedBy(matchFilterFn)).Return(nil, errors.New("error"))
 
 	// SUT
-	sut := query.NewFindExpensesHandler(repo, log)
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
 
 	// Act
 	query, err := sut.Handle(ctx, findQuery)
@@ -93,6 +99,7 @@ func TestFindExpensesHandle_RepoSuccess_ReturnsExpenses(t *testing.T) {
 	t.Parallel()
 	// Arrange
 	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
 	log := new(mocks.LogInterface)
 	ctx := context.Background()
 	from := time.Date(2021, time.July, 3, 0, 0, 0, 0, time.UTC)
@@ -112,9 +119,10 @@ func TestFindExpensesHandle_RepoSuccess_ReturnsExpenses(t *testing.T) {
 	}
 	repo.On("GetAll", mock.Anything,
 		mock.MatchedBy(matchFilterFn)).Return(expenses, nil)
+	budgets.On("Handle", mock.Anything, mock.Anything).Return([]domain.BudgetStatus{}, nil)
 
 	// SUT
-	sut := query.NewFindExpensesHandler(repo, log)
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
 
 	// Act
 	query, err := sut.Handle(ctx, findQuery)
@@ -124,3 +132,333 @@ func TestFindExpensesHandle_RepoSuccess_ReturnsExpenses(t *testing.T) {
 	assert.NotNil(t, query, "Result should not be nil.")
 	assert.Nil(t, err, "Error result should be nil.")
 }
+
+func TestFindExpensesHandle_Tags_FiltersByTags(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	findQuery := query.FindExpensesQuery{
+		DateRange:    *dataRange,
+		Interval:     "month",
+		Tags:         []string{"Business", "reimbursable"},
+		ExcludedTags: []string{"gift"},
+	}
+
+	matchFilterFn := func(filter domain.ExpenseFilter) bool {
+		return reflect.DeepEqual(filter.Tags(), []string{"business", "reimbursable"}) &&
+			reflect.DeepEqual(filter.ExcludedTags(), []string{"gift"})
+	}
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(matchFilterFn)).Return([]domain.Expense{}, nil)
+	budgets.On("Handle", mock.Anything, mock.Anything).Return([]domain.BudgetStatus{}, nil)
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.NotNil(t, result, "Result should not be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindExpensesHandle_ReportFilter_FiltersExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	minAmount, maxAmount := 10.0, 100.0
+	findQuery := query.FindExpensesQuery{
+		ReportFilter: query.ReportFilter{
+			Categories:         []string{"foodId"},
+			ExcludedCategories: []string{"restaurantsId"},
+			OriginalCurrencies: []string{"eur"},
+			TripID:             "60e1b5f1c8a4d53c9a1f3b2e",
+			MinAmount:          &minAmount,
+			MaxAmount:          &maxAmount,
+			Comment:            " pizza ",
+		},
+		DateRange: *dataRange,
+		Interval:  "year",
+	}
+
+	matchFilterFn := func(filter domain.ExpenseFilter) bool {
+		return reflect.DeepEqual(filter.Categories(), []string{"foodId"}) &&
+			reflect.DeepEqual(filter.ExcludedCategories(), []string{"restaurantsId"}) &&
+			reflect.DeepEqual(filter.Currencies(), []string{"EUR"}) &&
+			*filter.TripID() == "60e1b5f1c8a4d53c9a1f3b2e" && *filter.MinAmount() == minAmount &&
+			*filter.MaxAmount() == maxAmount && *filter.Comment() == "pizza"
+	}
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(matchFilterFn)).Return([]domain.Expense{}, nil)
+	budgets.On("Handle", mock.Anything, mock.Anything).Return([]domain.BudgetStatus{}, nil)
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.NotNil(t, result, "Result should not be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindExpensesHandle_InvalidAmountRange_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	minAmount, maxAmount := 100.0, 10.0
+	findQuery := query.FindExpensesQuery{
+		ReportFilter: query.ReportFilter{MinAmount: &minAmount, MaxAmount: &maxAmount},
+		DateRange:    *dataRange,
+		Interval:     "month",
+	}
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReportFilter)
+}
+
+func TestFindExpensesHandle_InvalidTripID_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	findQuery := query.FindExpensesQuery{
+		ReportFilter: query.ReportFilter{TripID: "tripId"},
+		DateRange:    *dataRange,
+		Interval:     "month",
+	}
+	repoErr := fmt.Errorf("%w: trip id tripId", domain.ErrInvalidReportFilter)
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(nil, repoErr)
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	budgets.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReportFilter)
+}
+
+func TestFindExpensesHandle_UnknownCurrency_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.July, 3, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.August, 3, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	rates, _ := domain.NewExchageRate(from, "EUR", map[string]float64{"USD": 1.2})
+	findQuery := query.FindExpensesQuery{
+		DateRange:     *dataRange,
+		Interval:      "month",
+		Currency:      "gbp",
+		ExchangeRates: []domain.ExchangeRates{*rates},
+	}
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidReportCurrency)
+}
+
+func TestFindExpensesHandle_Currency_ConvertsIntoCurrency(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.July, 3, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.August, 3, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	rates, _ := domain.NewExchageRate(from, "EUR", map[string]float64{"USD": 2})
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, from)
+	findQuery := query.FindExpensesQuery{
+		DateRange:     *dataRange,
+		Interval:      "month",
+		Currency:      "usd",
+		ExchangeRates: []domain.ExchangeRates{*rates},
+	}
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return([]domain.Expense{*expense}, nil)
+	budgets.On("Handle", mock.Anything, mock.Anything).Return([]domain.BudgetStatus{}, nil)
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, domain.Currency("USD"), result.Currency, "Should convert into the currency.")
+	assert.Equal(t, "20", result.GrandTotal.Total.Sum.String())
+}
+
+func TestFindExpensesHandle_BudgetStatusError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	findQuery := query.FindExpensesQuery{
+		DateRange: *dataRange,
+		Interval:  "month",
+	}
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return([]domain.Expense{}, nil)
+	budgets.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	budgets.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindExpensesHandle_Budgets_SetsCategoryBudgets(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	findQuery := query.FindExpensesQuery{
+		DateRange: *dataRange,
+		Interval:  "month",
+	}
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, from.AddDate(0, 0, 4))
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     string(domain.BudgetPeriodMonthly),
+		Amount:     100,
+		Start:      from,
+	})
+	status := domain.NewBudgetTracker([]domain.Expense{*expense}, nil, 1, domain.DefaultReportCurrency).Status(*budget, from)
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return([]domain.Expense{*expense}, nil)
+	budgets.On("Handle", mock.Anything, query.FindBudgetStatusQuery{
+		Dates:          []time.Time{from},
+		PeriodStartDay: 1,
+		Currency:       string(domain.DefaultReportCurrency),
+	}).
+		Return([]domain.BudgetStatus{status}, nil)
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	budgets.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &status, result.CategoryByDate[0].SubCategories[0].Budget, "Should set category budget.")
+}
+
+func TestFindExpensesHandle_PeriodStartDay_SetsBudgetsOfShiftedPeriods(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReportRepoInterface)
+	budgets := new(mocks.FindBudgetStatusHandlerInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	from := time.Date(2021, time.June, 25, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 24, 0, 0, 0, 0, time.UTC)
+	dataRange, _ := domain.NewDateRange(from, to)
+	findQuery := query.FindExpensesQuery{
+		DateRange:      *dataRange,
+		Interval:       "month",
+		PeriodStartDay: 25,
+	}
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, from.AddDate(0, 0, 10))
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     string(domain.BudgetPeriodMonthly),
+		Amount:     100,
+		Start:      from,
+	})
+	status := domain.NewBudgetTracker([]domain.Expense{*expense}, nil, 25, domain.DefaultReportCurrency).Status(*budget, from)
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return([]domain.Expense{*expense}, nil)
+	budgets.On("Handle", mock.Anything, query.FindBudgetStatusQuery{
+		Dates:          []time.Time{from},
+		PeriodStartDay: 25,
+		Currency:       string(domain.DefaultReportCurrency),
+	}).
+		Return([]domain.BudgetStatus{status}, nil)
+
+	// SUT
+	sut := query.NewFindExpensesHandler(repo, budgets, log)
+
+	// Act
+	result, err := sut.Handle(ctx, findQuery)
+
+	// Assert
+	budgets.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, from, status.From, "Budget period should begin on the period start day.")
+	assert.True(t, decimal.NewFromInt(10).Equal(status.Spent), "Should track expenses of the shifted period.")
+	assert.Equal(t, &status, result.CategoryByDate[0].SubCategories[0].Budget, "Should set category budget.")
+}
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindImportProfilesQuery defines an import profiles query.
+type FindImportProfilesQuery struct{}
+
+// FindImportProfilesHandler defines a handler to fetch import profiles.
+type FindImportProfilesHandler struct {
+	repo   adapters.ImportProfileRepoInterface
+	logger logger.LogInterface
+}
+
+// FindImportProfilesHandlerInterface defines a contract to handle query.
+type FindImportProfilesHandlerInterface interface {
+	Handle(ctx context.Context, query FindImportProfilesQuery) ([]domain.ImportProfile, error)
+}
+
+// NewFindImportProfilesHandler returns query handler.
+func NewFindImportProfilesHandler(
+	repo adapters.ImportProfileRepoInterface,
+	logger logger.LogInterface,
+) FindImportProfilesHandler {
+	return FindImportProfilesHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find import profiles query.
+func (h FindImportProfilesHandler) Handle(
+	ctx context.Context,
+	query FindImportProfilesQuery,
+) ([]domain.ImportProfile, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find import profiles query")
+	defer span.End()
+
+	profiles, profilesErr := h.repo.GetAll(ctx)
+	if profilesErr != nil {
+		tracer.AddSpanError(span, profilesErr)
+		return nil, errors.Wrap(profilesErr, "get import profiles")
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,78 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindImportProfilesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindImportProfilesHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindImportProfilesHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindImportProfilesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindImportProfilesQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindImportProfilesHandler_RepoSuccess_ReturnsProfiles(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ImportProfileRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	currency := "EUR"
+	profile, _ := domain.NewImportProfile(domain.ImportProfileParams{
+		Name:             "bank",
+		Columns:          domain.ImportColumns{Date: "Date", Amount: "Amount", Category: "Category"},
+		DateFormat:       "yyyy-MM-dd",
+		DecimalSeparator: ".",
+		DefaultCurrency:  &currency,
+	})
+	profiles := []domain.ImportProfile{*profile}
+
+	repo.On("GetAll", mock.Anything).Return(profiles, nil)
+
+	// SUT
+	sut := query.NewFindImportProfilesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindImportProfilesQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, profiles, result, "Should return import profiles.")
+}
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindInboxExpensesQuery defines a query to list imported expenses waiting for a category.
+// The filter category is replaced with the inbox category.
+type FindInboxExpensesQuery struct {
+	Filter domain.ExpenseListFilter
+}
+
+// FindInboxExpensesHandler defines a handler to list inbox expenses.
+type FindInboxExpensesHandler struct {
+	repo         adapters.ExpenseRepoInterface
+	categoryRepo adapters.ExpenseCategoryRepoInterface
+	logger       logger.LogInterface
+}
+
+// FindInboxExpensesHandlerInterface defines a contract to handle query.
+type FindInboxExpensesHandlerInterface interface {
+	Handle(ctx context.Context, query FindInboxExpensesQuery) (*domain.ExpensePage, error)
+}
+
+// NewFindInboxExpensesHandler returns query handler.
+func NewFindInboxExpensesHandler(
+	repo adapters.ExpenseRepoInterface,
+	categoryRepo adapters.ExpenseCategoryRepoInterface,
+	logger logger.LogInterface,
+) FindInboxExpensesHandler {
+	return FindInboxExpensesHandler{
+		repo:         repo,
+		categoryRepo: categoryRepo,
+		logger:       logger,
+	}
+}
+
+// Handle handles find inbox expenses query.
+func (h FindInboxExpensesHandler) Handle(
+	ctx context.Context,
+	query FindInboxExpensesQuery,
+) (*domain.ExpensePage, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find inbox expenses query")
+	defer span.End()
+
+	inbox, inboxErr := h.categoryRepo.GetInbox(ctx)
+	if inboxErr != nil {
+		tracer.AddSpanError(span, inboxErr)
+		return nil, errors.Wrap(inboxErr, "get inbox category")
+	}
+	if inbox == nil {
+		page := domain.NewExpensePage([]domain.Expense{}, 0, domain.SortFieldDate)
+		return &page, nil
+	}
+
+	page, pageErr := h.repo.GetAll(ctx, query.Filter.WithCategoryID(inbox.ID()))
+	if pageErr != nil {
+		tracer.AddSpanError(span, pageErr)
+		return nil, errors.Wrap(pageErr, "get inbox expenses")
+	}
+
+	return page, nil
+}
@@ -0,0 +1,80 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindInboxExpensesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindInboxExpensesHandler(repo, categoryRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindInboxExpensesHandler_NoInbox_ReturnsEmptyPage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{})
+
+	categoryRepo.On("GetInbox", mock.Anything).Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindInboxExpensesHandler(repo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindInboxExpensesQuery{Filter: *filter})
+
+	// Assert
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Empty(t, result.Expenses, "Should return empty page.")
+}
+
+func TestFindInboxExpensesHandler_Inbox_ListsInboxExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	categoryRepo := new(mocks.ExpenseCategoryRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{})
+	inbox, _ := domain.NewCategory("inboxId", nil, domain.InboxCategoryName, nil, 1, "|inboxId")
+	expense, _ := domain.NewExpense("expenseId", *inbox, 10, "EUR", 1, nil, nil, time.Now())
+	page := domain.NewExpensePage([]domain.Expense{*expense}, domain.DefaultPageSize, domain.SortFieldDate)
+
+	categoryRepo.On("GetInbox", mock.Anything).Return(inbox, nil)
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseListFilter) bool {
+		return *filter.CategoryID() == "inboxId"
+	})).Return(&page, nil)
+
+	// SUT
+	sut := query.NewFindInboxExpensesHandler(repo, categoryRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindInboxExpensesQuery{Filter: *filter})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &page, result, "Should return inbox page.")
+}
@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindIncomeQuery defines an income query.
+type FindIncomeQuery struct {
+	ID string
+}
+
+// FindIncomeHandler defines a handler to fetch income.
+type FindIncomeHandler struct {
+	repo   adapters.IncomeRepoInterface
+	logger logger.LogInterface
+}
+
+// FindIncomeHandlerInterface defines a contract to handle query.
+type FindIncomeHandlerInterface interface {
+	Handle(ctx context.Context, query FindIncomeQuery) (*domain.Income, error)
+}
+
+// NewFindIncomeHandler returns query handler.
+func NewFindIncomeHandler(
+	repo adapters.IncomeRepoInterface,
+	logger logger.LogInterface,
+) FindIncomeHandler {
+	return FindIncomeHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find income query.
+func (h FindIncomeHandler) Handle(ctx context.Context, query FindIncomeQuery) (*domain.Income, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find income query")
+	defer span.End()
+
+	income, incomeErr := h.repo.GetOne(ctx, query.ID)
+	if incomeErr != nil {
+		tracer.AddSpanError(span, incomeErr)
+		return nil, errors.Wrap(incomeErr, "get income")
+	}
+
+	return income, nil
+}
@@ -0,0 +1,48 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindIncomeHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindIncomeHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindIncomeHandler_RepoSuccess_ReturnsIncome(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	income := newIncome(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC))
+
+	repo.On("GetOne", mock.Anything, "incomeId").Return(&income, nil)
+
+	// SUT
+	sut := query.NewFindIncomeHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindIncomeQuery{ID: "incomeId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &income, result, "Should return income.")
+}
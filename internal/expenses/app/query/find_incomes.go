@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindIncomesQuery defines an incomes query.
+type FindIncomesQuery struct {
+	DateRange domain.DateRange
+}
+
+// FindIncomesHandler defines a handler to fetch incomes.
+type FindIncomesHandler struct {
+	repo   adapters.IncomeRepoInterface
+	logger logger.LogInterface
+}
+
+// FindIncomesHandlerInterface defines a contract to handle query.
+type FindIncomesHandlerInterface interface {
+	Handle(ctx context.Context, query FindIncomesQuery) ([]domain.Income, error)
+}
+
+// NewFindIncomesHandler returns query handler.
+func NewFindIncomesHandler(
+	repo adapters.IncomeRepoInterface,
+	logger logger.LogInterface,
+) FindIncomesHandler {
+	return FindIncomesHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find incomes query.
+func (h FindIncomesHandler) Handle(ctx context.Context, query FindIncomesQuery) ([]domain.Income, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find incomes query")
+	defer span.End()
+
+	incomes, incomesErr := h.repo.GetAll(ctx, query.DateRange)
+	if incomesErr != nil {
+		tracer.AddSpanError(span, incomesErr)
+		return nil, errors.Wrap(incomesErr, "get incomes")
+	}
+
+	return incomes, nil
+}
@@ -0,0 +1,82 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newIncome(date time.Time) domain.Income {
+	income, _ := domain.NewIncome("incomeId", domain.IncomeParams{
+		Source: "ACME", CategoryID: "salaryId", Amount: 3200, Currency: "EUR", Date: date,
+	})
+	return *income
+}
+
+func TestNewFindIncomesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindIncomesHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindIncomesHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	dateRange, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC))
+
+	repo.On("GetAll", mock.Anything, *dateRange).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindIncomesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindIncomesQuery{DateRange: *dateRange})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindIncomesHandler_RepoSuccess_ReturnsIncomes(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.IncomeRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	dateRange, _ := domain.NewDateRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC))
+	incomes := []domain.Income{newIncome(dateRange.From())}
+
+	repo.On("GetAll", mock.Anything, *dateRange).Return(incomes, nil)
+
+	// SUT
+	sut := query.NewFindIncomesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindIncomesQuery{DateRange: *dateRange})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, incomes, result, "Should return incomes.")
+}
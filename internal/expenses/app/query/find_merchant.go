@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindMerchantQuery defines a single merchant query.
+type FindMerchantQuery struct {
+	ID string
+}
+
+// FindMerchantHandler defines a handler to fetch a single merchant.
+type FindMerchantHandler struct {
+	repo   adapters.MerchantRepoInterface
+	logger logger.LogInterface
+}
+
+// FindMerchantHandlerInterface defines a contract to handle query.
+type FindMerchantHandlerInterface interface {
+	Handle(ctx context.Context, query FindMerchantQuery) (*domain.Merchant, error)
+}
+
+// NewFindMerchantHandler returns query handler.
+func NewFindMerchantHandler(
+	repo adapters.MerchantRepoInterface,
+	logger logger.LogInterface,
+) FindMerchantHandler {
+	return FindMerchantHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find merchant query.
+func (h FindMerchantHandler) Handle(ctx context.Context, query FindMerchantQuery) (*domain.Merchant, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find merchant query")
+	defer span.End()
+
+	merchant, merchantErr := h.repo.GetOne(ctx, query.ID)
+	if merchantErr != nil {
+		tracer.AddSpanError(span, merchantErr)
+		return nil, errors.Wrap(merchantErr, "get merchant")
+	}
+
+	return merchant, nil
+}
@@ -0,0 +1,68 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindMerchantStatsQuery defines a merchant stats query.
+type FindMerchantStatsQuery struct {
+	ID string
+}
+
+// FindMerchantStatsHandler defines a handler to calculate merchant spending history.
+type FindMerchantStatsHandler struct {
+	repo   adapters.MerchantRepoInterface
+	logger logger.LogInterface
+}
+
+// FindMerchantStatsHandlerInterface defines a contract to handle query.
+type FindMerchantStatsHandlerInterface interface {
+	Handle(ctx context.Context, query FindMerchantStatsQuery) (*domain.MerchantStats, error)
+}
+
+// NewFindMerchantStatsHandler returns query handler.
+func NewFindMerchantStatsHandler(
+	repo adapters.MerchantRepoInterface,
+	logger logger.LogInterface,
+) FindMerchantStatsHandler {
+	return FindMerchantStatsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find merchant stats query. Returns nil if the merchant is missing.
+func (h FindMerchantStatsHandler) Handle(
+	ctx context.Context,
+	query FindMerchantStatsQuery,
+) (*domain.MerchantStats, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find merchant stats query")
+	defer span.End()
+
+	merchant, merchantErr := h.repo.GetOne(ctx, query.ID)
+	if merchantErr != nil {
+		tracer.AddSpanError(span, merchantErr)
+		return nil, errors.Wrap(merchantErr, "get merchant")
+	}
+
+	if merchant == nil {
+		return nil, nil
+	}
+
+	expenses, expensesErr := h.repo.GetExpenses(ctx, merchant.ID())
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch merchant expenses")
+	}
+
+	stats := domain.NewMerchantStats(*merchant, expenses)
+
+	return &stats, nil
+}
@@ -0,0 +1,101 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindMerchantStatsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindMerchantStatsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindMerchantStatsHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindMerchantStatsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindMerchantStatsQuery{ID: "merchantId"})
+
+	// Assert
+	repo.AssertNotCalled(t, "GetExpenses", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindMerchantStatsHandler_ExpensesError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	merchant := newMerchant()
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(&merchant, nil)
+	repo.On("GetExpenses", mock.Anything, "merchantId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindMerchantStatsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindMerchantStatsQuery{ID: "merchantId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindMerchantStatsHandler_RepoSuccess_ReturnsStats(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	merchant := newMerchant()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	date := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	first, _ := domain.NewExpense("firstId", *category, 10, "EUR", 1, nil, nil, date)
+	second, _ := domain.NewExpense("secondId", *category, 20, "EUR", 1, nil, nil, date.AddDate(0, 0, 1))
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(&merchant, nil)
+	repo.On("GetExpenses", mock.Anything, "merchantId").Return([]domain.Expense{*first, *second}, nil)
+
+	// SUT
+	sut := query.NewFindMerchantStatsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindMerchantStatsQuery{ID: "merchantId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.VisitCount, "Should count visits.")
+	assert.Equal(t, "15", result.AverageTicket[0].Sum.String(), "Should calculate average ticket.")
+	assert.Equal(t, date.AddDate(0, 0, 1), *result.LastVisit, "Should return last visit.")
+}
@@ -0,0 +1,69 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindMerchantHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindMerchantHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindMerchantHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindMerchantHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindMerchantQuery{ID: "merchantId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindMerchantHandler_RepoSuccess_ReturnsMerchant(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	merchant := newMerchant()
+
+	repo.On("GetOne", mock.Anything, "merchantId").Return(&merchant, nil)
+
+	// SUT
+	sut := query.NewFindMerchantHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindMerchantQuery{ID: "merchantId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &merchant, result, "Should return merchant.")
+}
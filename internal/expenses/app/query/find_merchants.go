@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindMerchantsQuery defines a merchants query.
+type FindMerchantsQuery struct{}
+
+// FindMerchantsHandler defines a handler to fetch merchants.
+type FindMerchantsHandler struct {
+	repo   adapters.MerchantRepoInterface
+	logger logger.LogInterface
+}
+
+// FindMerchantsHandlerInterface defines a contract to handle query.
+type FindMerchantsHandlerInterface interface {
+	Handle(ctx context.Context, query FindMerchantsQuery) ([]domain.Merchant, error)
+}
+
+// NewFindMerchantsHandler returns query handler.
+func NewFindMerchantsHandler(
+	repo adapters.MerchantRepoInterface,
+	logger logger.LogInterface,
+) FindMerchantsHandler {
+	return FindMerchantsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find merchants query.
+func (h FindMerchantsHandler) Handle(ctx context.Context, query FindMerchantsQuery) ([]domain.Merchant, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find merchants query")
+	defer span.End()
+
+	merchants, merchantsErr := h.repo.GetAll(ctx)
+	if merchantsErr != nil {
+		tracer.AddSpanError(span, merchantsErr)
+		return nil, errors.Wrap(merchantsErr, "get merchants")
+	}
+
+	return merchants, nil
+}
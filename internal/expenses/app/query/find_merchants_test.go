@@ -0,0 +1,78 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newMerchant() domain.Merchant {
+	merchant, _ := domain.NewMerchant("merchantId", domain.MerchantParams{
+		Name:    "Rewe",
+		Aliases: []string{"REWE Markt"},
+	})
+	return *merchant
+}
+
+func TestNewFindMerchantsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindMerchantsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindMerchantsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindMerchantsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindMerchantsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindMerchantsHandler_RepoSuccess_ReturnsMerchants(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	merchants := []domain.Merchant{newMerchant()}
+
+	repo.On("GetAll", mock.Anything).Return(merchants, nil)
+
+	// SUT
+	sut := query.NewFindMerchantsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindMerchantsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, merchants, result, "Should return merchants.")
+}
@@ -0,0 +1,72 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindReceiptQuery defines a receipt query.
+type FindReceiptQuery struct {
+	ReceiptID string
+}
+
+// FindReceiptHandler defines a handler to fetch a receipt with its line items and attachments.
+type FindReceiptHandler struct {
+	repo           adapters.ReceiptRepoInterface
+	attachmentRepo adapters.AttachmentRepoInterface
+	logger         logger.LogInterface
+}
+
+// FindReceiptHandlerInterface defines a contract to handle query.
+type FindReceiptHandlerInterface interface {
+	Handle(ctx context.Context, query FindReceiptQuery) (*domain.Receipt, error)
+}
+
+// NewFindReceiptHandler returns query handler.
+func NewFindReceiptHandler(
+	repo adapters.ReceiptRepoInterface,
+	attachmentRepo adapters.AttachmentRepoInterface,
+	logger logger.LogInterface,
+) FindReceiptHandler {
+	return FindReceiptHandler{
+		repo:           repo,
+		attachmentRepo: attachmentRepo,
+		logger:         logger,
+	}
+}
+
+// Handle handles find receipt query. Receipt attachments are the ones of its line items.
+func (h FindReceiptHandler) Handle(ctx context.Context, query FindReceiptQuery) (*domain.Receipt, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find receipt query")
+	span.SetAttributes(attribute.String("id", query.ReceiptID))
+	defer span.End()
+
+	receipt, receiptErr := h.repo.GetOne(ctx, query.ReceiptID)
+	if receiptErr != nil {
+		tracer.AddSpanError(span, receiptErr)
+		return nil, errors.Wrap(receiptErr, "get receipt")
+	}
+	if receipt == nil {
+		return nil, nil
+	}
+
+	attachments := []domain.Attachment{}
+	for _, item := range receipt.Items() {
+		itemAttachments, attachmentsErr := h.attachmentRepo.GetAll(ctx, item.ID())
+		if attachmentsErr != nil {
+			tracer.AddSpanError(span, attachmentsErr)
+			return nil, errors.Wrap(attachmentsErr, "get attachments")
+		}
+		attachments = append(attachments, itemAttachments...)
+	}
+	receipt.SetAttachments(attachments)
+
+	return receipt, nil
+}
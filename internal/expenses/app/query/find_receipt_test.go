@@ -0,0 +1,110 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newQueriedReceipt() *domain.Receipt {
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "path")
+	date := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	bread, _ := domain.NewExpense("breadId", *category, 1.5, "EUR", 2, nil, nil, date, domain.SetReceipt("receiptId"))
+	milk, _ := domain.NewExpense("milkId", *category, 0.9, "EUR", 1, nil, nil, date, domain.SetReceipt("receiptId"))
+	receipt, _ := domain.NewReceipt("receiptId", domain.ReceiptParams{Date: date, Currency: "EUR"},
+		[]domain.Expense{*bread, *milk})
+	return receipt
+}
+
+func TestNewFindReceiptHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReceiptRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindReceiptHandler(repo, attachmentRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindReceiptHandler_ReceiptNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReceiptRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "receiptId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindReceiptHandler(repo, attachmentRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindReceiptQuery{ReceiptID: "receiptId"})
+
+	// Assert
+	attachmentRepo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindReceiptHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReceiptRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "receiptId").Return(newQueriedReceipt(), nil)
+	attachmentRepo.On("GetAll", mock.Anything, "breadId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindReceiptHandler(repo, attachmentRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindReceiptQuery{ReceiptID: "receiptId"})
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindReceiptHandler_RepoSuccess_ReturnsReceiptWithItemAttachments(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReceiptRepoInterface)
+	attachmentRepo := new(mocks.AttachmentRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	attachments := []domain.Attachment{*newQueriedAttachment(nil)}
+
+	repo.On("GetOne", mock.Anything, "receiptId").Return(newQueriedReceipt(), nil)
+	attachmentRepo.On("GetAll", mock.Anything, "breadId").Return(attachments, nil)
+	attachmentRepo.On("GetAll", mock.Anything, "milkId").Return([]domain.Attachment{}, nil)
+
+	// SUT
+	sut := query.NewFindReceiptHandler(repo, attachmentRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindReceiptQuery{ReceiptID: "receiptId"})
+
+	// Assert
+	attachmentRepo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result.Items(), 2)
+	assert.Equal(t, attachments, result.Attachments(), "Should return line item attachments.")
+	assert.Equal(t, "3.9", result.Total().Sum.String())
+}
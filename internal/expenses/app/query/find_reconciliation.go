@@ -0,0 +1,106 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindReconciliationQuery defines a single reconciliation query.
+type FindReconciliationQuery struct {
+	ID string
+}
+
+// FindReconciliationHandler defines a handler to compare a reconciliation with the account balance.
+type FindReconciliationHandler struct {
+	repo         adapters.ReconciliationRepoInterface
+	accountRepo  adapters.AccountRepoInterface
+	transferRepo adapters.TransferRepoInterface
+	rates        ExchangeRatesProviderInterface
+	logger       logger.LogInterface
+}
+
+// FindReconciliationHandlerInterface defines a contract to handle query.
+type FindReconciliationHandlerInterface interface {
+	Handle(ctx context.Context, query FindReconciliationQuery) (*domain.ReconciliationReport, error)
+}
+
+// NewFindReconciliationHandler returns query handler.
+func NewFindReconciliationHandler(
+	repo adapters.ReconciliationRepoInterface,
+	accountRepo adapters.AccountRepoInterface,
+	transferRepo adapters.TransferRepoInterface,
+	rates ExchangeRatesProviderInterface,
+	logger logger.LogInterface,
+) FindReconciliationHandler {
+	return FindReconciliationHandler{
+		repo:         repo,
+		accountRepo:  accountRepo,
+		transferRepo: transferRepo,
+		rates:        rates,
+		logger:       logger,
+	}
+}
+
+// Handle handles find reconciliation query. The report lists unreconciled expenses of the account
+// up to the statement date and the difference between the statement and the cleared balance.
+func (h FindReconciliationHandler) Handle(
+	ctx context.Context,
+	query FindReconciliationQuery,
+) (*domain.ReconciliationReport, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find reconciliation query")
+	span.SetAttributes(attribute.String("id", query.ID))
+	defer span.End()
+
+	reconciliation, reconciliationErr := h.repo.GetOne(ctx, query.ID)
+	if reconciliationErr != nil {
+		tracer.AddSpanError(span, reconciliationErr)
+		return nil, errors.Wrap(reconciliationErr, "get reconciliation")
+	}
+
+	if reconciliation == nil {
+		return nil, nil
+	}
+
+	account, accountErr := h.accountRepo.GetOne(ctx, reconciliation.AccountID())
+	if accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		return nil, errors.Wrap(accountErr, "get reconciliation account")
+	}
+
+	if account == nil {
+		return nil, errors.Wrapf(domain.ErrAccountNotFound, "account %s", reconciliation.AccountID())
+	}
+
+	expenses, expensesErr := h.accountRepo.GetExpenses(ctx, account.ID(), reconciliation.StatementDate())
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch account expenses")
+	}
+
+	transfers, transfersErr := h.transferRepo.GetByAccount(ctx, account.ID(), reconciliation.StatementDate())
+	if transfersErr != nil {
+		tracer.AddSpanError(span, transfersErr)
+		return nil, errors.Wrap(transfersErr, "fetch account transfers")
+	}
+
+	rates, ratesErr := accountExchangeRates(ctx, h.rates, *account, expenses)
+	if ratesErr != nil {
+		tracer.AddSpanError(span, ratesErr)
+		return nil, ratesErr
+	}
+
+	report, reportErr := domain.NewReconciliationReport(*reconciliation, *account, expenses, transfers, rates)
+	if reportErr != nil {
+		tracer.AddSpanError(span, reportErr)
+		return nil, errors.Wrap(reportErr, "compare reconciliation")
+	}
+
+	return report, nil
+}
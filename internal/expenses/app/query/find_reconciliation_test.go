@@ -0,0 +1,115 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindReconciliationHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	transferRepo := new(mocks.TransferRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindReconciliationHandler(repo, accountRepo, transferRepo, rates, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindReconciliationHandler_ReconciliationNotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	transferRepo := new(mocks.TransferRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "reconciliationId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindReconciliationHandler(repo, accountRepo, transferRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindReconciliationQuery{ID: "reconciliationId"})
+
+	// Assert
+	accountRepo.AssertNotCalled(t, "GetOne", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindReconciliationHandler_AccountNotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	transferRepo := new(mocks.TransferRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	reconciliation := newReconciliation(0)
+
+	repo.On("GetOne", mock.Anything, "reconciliationId").Return(&reconciliation, nil)
+	accountRepo.On("GetOne", mock.Anything, "accountId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindReconciliationHandler(repo, accountRepo, transferRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindReconciliationQuery{ID: "reconciliationId"})
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrAccountNotFound, "Should return account not found error.")
+}
+
+func TestFindReconciliationHandler_TickedExpenses_ReturnsDifference(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	accountRepo := new(mocks.AccountRepoInterface)
+	transferRepo := new(mocks.TransferRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	account := newAccount()
+	date := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	groceries := newAccountExpense(30, "EUR", date)
+	domain.SetReconciliation("otherId")(&groceries)
+	reconciliation := newReconciliation(-50)
+
+	repo.On("GetOne", mock.Anything, "reconciliationId").Return(&reconciliation, nil)
+	accountRepo.On("GetOne", mock.Anything, "accountId").Return(&account, nil)
+	accountRepo.On("GetExpenses", mock.Anything, "accountId", reconciliation.StatementDate()).
+		Return([]domain.Expense{groceries, newAccountExpense(20, "EUR", date)}, nil)
+	transferRepo.On("GetByAccount", mock.Anything, "accountId", reconciliation.StatementDate()).
+		Return([]domain.Transfer{}, nil)
+
+	// SUT
+	sut := query.NewFindReconciliationHandler(repo, accountRepo, transferRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindReconciliationQuery{ID: "reconciliationId"})
+
+	// Assert
+	rates.AssertNotCalled(t, "ExchangeRates", mock.Anything, mock.Anything)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, "-30", result.ClearedBalance.String(), "Should count reconciled expenses only.")
+	assert.Equal(t, "-20", result.Difference.String(), "Should return statement difference.")
+	assert.Len(t, result.Items, 1, "Should list unreconciled expenses.")
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindReconciliationsQuery defines a reconciliation history query.
+type FindReconciliationsQuery struct {
+	AccountID string
+}
+
+// FindReconciliationsHandler defines a handler to fetch reconciliation history of an account.
+type FindReconciliationsHandler struct {
+	repo   adapters.ReconciliationRepoInterface
+	logger logger.LogInterface
+}
+
+// FindReconciliationsHandlerInterface defines a contract to handle query.
+type FindReconciliationsHandlerInterface interface {
+	Handle(ctx context.Context, query FindReconciliationsQuery) ([]domain.Reconciliation, error)
+}
+
+// NewFindReconciliationsHandler returns query handler.
+func NewFindReconciliationsHandler(
+	repo adapters.ReconciliationRepoInterface,
+	logger logger.LogInterface,
+) FindReconciliationsHandler {
+	return FindReconciliationsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find reconciliations query.
+func (h FindReconciliationsHandler) Handle(
+	ctx context.Context,
+	query FindReconciliationsQuery,
+) ([]domain.Reconciliation, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find reconciliations query")
+	span.SetAttributes(attribute.String("accountId", query.AccountID))
+	defer span.End()
+
+	reconciliations, reconciliationsErr := h.repo.GetByAccount(ctx, query.AccountID)
+	if reconciliationsErr != nil {
+		tracer.AddSpanError(span, reconciliationsErr)
+		return nil, errors.Wrap(reconciliationsErr, "get reconciliations")
+	}
+
+	return reconciliations, nil
+}
@@ -0,0 +1,80 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newReconciliation(statementBalance float64, expenseIDs ...string) domain.Reconciliation {
+	reconciliation, _ := domain.NewReconciliation("reconciliationId", domain.ReconciliationParams{
+		AccountID:        "accountId",
+		StatementDate:    time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC),
+		StatementBalance: statementBalance,
+		ExpenseIDs:       expenseIDs,
+	})
+	return *reconciliation
+}
+
+func TestNewFindReconciliationsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindReconciliationsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindReconciliationsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetByAccount", mock.Anything, "accountId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindReconciliationsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindReconciliationsQuery{AccountID: "accountId"})
+
+	// Assert
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindReconciliationsHandler_RepoSuccess_ReturnsHistory(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ReconciliationRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	reconciliations := []domain.Reconciliation{newReconciliation(100)}
+
+	repo.On("GetByAccount", mock.Anything, "accountId").Return(reconciliations, nil)
+
+	// SUT
+	sut := query.NewFindReconciliationsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindReconciliationsQuery{AccountID: "accountId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, reconciliations, result, "Should return reconciliation history.")
+}
@@ -0,0 +1,56 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindRecurringExpenseQuery defines a single recurring expense query.
+type FindRecurringExpenseQuery struct {
+	ID string
+}
+
+// FindRecurringExpenseHandler defines a handler to fetch a single recurring expense.
+type FindRecurringExpenseHandler struct {
+	repo   adapters.RecurringExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// FindRecurringExpenseHandlerInterface defines a contract to handle query.
+type FindRecurringExpenseHandlerInterface interface {
+	Handle(ctx context.Context, query FindRecurringExpenseQuery) (*domain.RecurringExpense, error)
+}
+
+// NewFindRecurringExpenseHandler returns query handler.
+func NewFindRecurringExpenseHandler(
+	repo adapters.RecurringExpenseRepoInterface,
+	logger logger.LogInterface,
+) FindRecurringExpenseHandler {
+	return FindRecurringExpenseHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find recurring expense query.
+func (h FindRecurringExpenseHandler) Handle(
+	ctx context.Context,
+	query FindRecurringExpenseQuery,
+) (*domain.RecurringExpense, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find recurring expense query")
+	defer span.End()
+
+	recurringExpense, recurringErr := h.repo.GetOne(ctx, query.ID)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, errors.Wrap(recurringErr, "get recurring expense")
+	}
+
+	return recurringExpense, nil
+}
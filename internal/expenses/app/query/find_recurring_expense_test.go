@@ -0,0 +1,69 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindRecurringExpenseHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindRecurringExpenseHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindRecurringExpenseHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindRecurringExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindRecurringExpenseQuery{ID: "recurringId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindRecurringExpenseHandler_RepoSuccess_ReturnsRecurringExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	recurringExpense := newMonthlyRecurringExpense()
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(&recurringExpense, nil)
+
+	// SUT
+	sut := query.NewFindRecurringExpenseHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindRecurringExpenseQuery{ID: "recurringId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &recurringExpense, result, "Should return recurring expense.")
+}
@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindRecurringExpensesQuery defines a recurring expenses query.
+type FindRecurringExpensesQuery struct{}
+
+// FindRecurringExpensesHandler defines a handler to fetch recurring expenses.
+type FindRecurringExpensesHandler struct {
+	repo   adapters.RecurringExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// FindRecurringExpensesHandlerInterface defines a contract to handle query.
+type FindRecurringExpensesHandlerInterface interface {
+	Handle(ctx context.Context, query FindRecurringExpensesQuery) ([]domain.RecurringExpense, error)
+}
+
+// NewFindRecurringExpensesHandler returns query handler.
+func NewFindRecurringExpensesHandler(
+	repo adapters.RecurringExpenseRepoInterface,
+	logger logger.LogInterface,
+) FindRecurringExpensesHandler {
+	return FindRecurringExpensesHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find recurring expenses query.
+func (h FindRecurringExpensesHandler) Handle(
+	ctx context.Context,
+	query FindRecurringExpensesQuery,
+) ([]domain.RecurringExpense, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find recurring expenses query")
+	defer span.End()
+
+	recurringExpenses, recurringErr := h.repo.GetAll(ctx)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, errors.Wrap(recurringErr, "get recurring expenses")
+	}
+
+	return recurringExpenses, nil
+}
@@ -0,0 +1,81 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newMonthlyRecurringExpense() domain.RecurringExpense {
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency: "monthly",
+		Start:     time.Date(2021, time.January, 5, 0, 0, 0, 0, time.UTC),
+	})
+	recurringExpense, _ := domain.NewRecurringExpense("recurringId", *category, 500, "EUR", 1, nil, nil, *schedule)
+	return *recurringExpense
+}
+
+func TestNewFindRecurringExpensesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindRecurringExpensesHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindRecurringExpensesHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindRecurringExpensesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindRecurringExpensesQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindRecurringExpensesHandler_RepoSuccess_ReturnsRecurringExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	recurringExpenses := []domain.RecurringExpense{newMonthlyRecurringExpense()}
+
+	repo.On("GetAll", mock.Anything).Return(recurringExpenses, nil)
+
+	// SUT
+	sut := query.NewFindRecurringExpensesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindRecurringExpensesQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, recurringExpenses, result, "Should return recurring expenses.")
+}
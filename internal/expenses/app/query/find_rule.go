@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindRuleQuery defines a single rule query.
+type FindRuleQuery struct {
+	ID string
+}
+
+// FindRuleHandler defines a handler to fetch a single rule.
+type FindRuleHandler struct {
+	repo   adapters.RuleRepoInterface
+	logger logger.LogInterface
+}
+
+// FindRuleHandlerInterface defines a contract to handle query.
+type FindRuleHandlerInterface interface {
+	Handle(ctx context.Context, query FindRuleQuery) (*domain.Rule, error)
+}
+
+// NewFindRuleHandler returns query handler.
+func NewFindRuleHandler(
+	repo adapters.RuleRepoInterface,
+	logger logger.LogInterface,
+) FindRuleHandler {
+	return FindRuleHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find rule query.
+func (h FindRuleHandler) Handle(ctx context.Context, query FindRuleQuery) (*domain.Rule, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find rule query")
+	defer span.End()
+
+	rule, ruleErr := h.repo.GetOne(ctx, query.ID)
+	if ruleErr != nil {
+		tracer.AddSpanError(span, ruleErr)
+		return nil, errors.Wrap(ruleErr, "get rule")
+	}
+
+	return rule, nil
+}
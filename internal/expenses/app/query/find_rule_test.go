@@ -0,0 +1,69 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindRuleHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindRuleHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindRuleHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "ruleId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindRuleHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindRuleQuery{ID: "ruleId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindRuleHandler_RepoSuccess_ReturnsRule(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	rule := newRule()
+
+	repo.On("GetOne", mock.Anything, "ruleId").Return(&rule, nil)
+
+	// SUT
+	sut := query.NewFindRuleHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindRuleQuery{ID: "ruleId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &rule, result, "Should return rule.")
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindRulesQuery defines a rules query.
+type FindRulesQuery struct{}
+
+// FindRulesHandler defines a handler to fetch rules.
+type FindRulesHandler struct {
+	repo   adapters.RuleRepoInterface
+	logger logger.LogInterface
+}
+
+// FindRulesHandlerInterface defines a contract to handle query.
+type FindRulesHandlerInterface interface {
+	Handle(ctx context.Context, query FindRulesQuery) ([]domain.Rule, error)
+}
+
+// NewFindRulesHandler returns query handler.
+func NewFindRulesHandler(
+	repo adapters.RuleRepoInterface,
+	logger logger.LogInterface,
+) FindRulesHandler {
+	return FindRulesHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find rules query.
+func (h FindRulesHandler) Handle(ctx context.Context, query FindRulesQuery) ([]domain.Rule, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find rules query")
+	defer span.End()
+
+	rules, rulesErr := h.repo.GetAll(ctx)
+	if rulesErr != nil {
+		tracer.AddSpanError(span, rulesErr)
+		return nil, errors.Wrap(rulesErr, "get rules")
+	}
+
+	return rules, nil
+}
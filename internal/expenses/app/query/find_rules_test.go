@@ -0,0 +1,80 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newRule() domain.Rule {
+	comment := "coffee"
+	rule, _ := domain.NewRule("ruleId", domain.RuleParams{
+		Name:       "Coffee",
+		Conditions: domain.RuleConditions{Comment: &comment},
+		Actions:    domain.RuleActions{Tags: []string{"drinks"}},
+	})
+	return *rule
+}
+
+func TestNewFindRulesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindRulesHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindRulesHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindRulesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindRulesQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindRulesHandler_RepoSuccess_ReturnsRules(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RuleRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	rules := []domain.Rule{newRule()}
+
+	repo.On("GetAll", mock.Anything).Return(rules, nil)
+
+	// SUT
+	sut := query.NewFindRulesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindRulesQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, rules, result, "Should return rules.")
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindSettingsQuery defines a user settings query.
+type FindSettingsQuery struct {
+	User string
+}
+
+// FindSettingsHandler defines a handler to fetch user settings.
+type FindSettingsHandler struct {
+	repo   adapters.SettingsRepoInterface
+	logger logger.LogInterface
+}
+
+// FindSettingsHandlerInterface defines a contract to handle query.
+type FindSettingsHandlerInterface interface {
+	Handle(ctx context.Context, query FindSettingsQuery) (*domain.Settings, error)
+}
+
+// NewFindSettingsHandler returns query handler.
+func NewFindSettingsHandler(
+	repo adapters.SettingsRepoInterface,
+	logger logger.LogInterface,
+) FindSettingsHandler {
+	return FindSettingsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find settings query. Users who have not changed settings get the default ones.
+func (h FindSettingsHandler) Handle(ctx context.Context, query FindSettingsQuery) (*domain.Settings, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find settings query")
+	defer span.End()
+
+	settings, settingsErr := h.repo.GetOne(ctx, query.User)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		return nil, errors.Wrap(settingsErr, "get settings")
+	}
+
+	if settings == nil {
+		defaultSettings := domain.DefaultSettings(query.User)
+		return &defaultSettings, nil
+	}
+
+	return settings, nil
+}
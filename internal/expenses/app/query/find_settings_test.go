@@ -0,0 +1,91 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindSettingsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettingsRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindSettingsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindSettingsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettingsRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "user").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindSettingsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindSettingsQuery{User: "user"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindSettingsHandler_NoSettings_ReturnsDefaultSettings(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettingsRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "user").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindSettingsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindSettingsQuery{User: "user"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, domain.DefaultSettings("user"), *result, "Should return default settings.")
+}
+
+func TestFindSettingsHandler_RepoSuccess_ReturnsSettings(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SettingsRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	settings, _ := domain.NewSettings("user", domain.SettingsParams{PeriodStartDay: 25})
+
+	repo.On("GetOne", mock.Anything, "user").Return(settings, nil)
+
+	// SUT
+	sut := query.NewFindSettingsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindSettingsQuery{User: "user"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, settings, result, "Should return settings.")
+}
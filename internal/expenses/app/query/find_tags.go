@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindTagsQuery defines a tags query.
+type FindTagsQuery struct{}
+
+// FindTagsHandler defines a handler to fetch tags with their usage counts.
+type FindTagsHandler struct {
+	repo   adapters.TagRepoInterface
+	logger logger.LogInterface
+}
+
+// FindTagsHandlerInterface defines a contract to handle query.
+type FindTagsHandlerInterface interface {
+	Handle(ctx context.Context, query FindTagsQuery) ([]domain.Tag, error)
+}
+
+// NewFindTagsHandler returns query handler.
+func NewFindTagsHandler(
+	repo adapters.TagRepoInterface,
+	logger logger.LogInterface,
+) FindTagsHandler {
+	return FindTagsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find tags query.
+func (h FindTagsHandler) Handle(ctx context.Context, query FindTagsQuery) ([]domain.Tag, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find tags query")
+	defer span.End()
+
+	tags, tagsErr := h.repo.GetAll(ctx)
+	if tagsErr != nil {
+		tracer.AddSpanError(span, tagsErr)
+		return nil, errors.Wrap(tagsErr, "get tags")
+	}
+
+	return tags, nil
+}
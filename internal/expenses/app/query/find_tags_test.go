@@ -0,0 +1,70 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindTagsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindTagsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindTagsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindTagsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTagsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindTagsHandler_RepoSuccess_ReturnsTags(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TagRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	tags := []domain.Tag{{Name: "business", Count: 2}}
+
+	repo.On("GetAll", mock.Anything).Return(tags, nil)
+
+	// SUT
+	sut := query.NewFindTagsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTagsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, tags, result, "Should return tags.")
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindTrashItemsQuery defines a trash items query.
+type FindTrashItemsQuery struct{}
+
+// FindTrashItemsHandler defines a handler to fetch trash items.
+type FindTrashItemsHandler struct {
+	repo   adapters.TrashRepoInterface
+	logger logger.LogInterface
+}
+
+// FindTrashItemsHandlerInterface defines a contract to handle query.
+type FindTrashItemsHandlerInterface interface {
+	Handle(ctx context.Context, query FindTrashItemsQuery) ([]domain.TrashItem, error)
+}
+
+// NewFindTrashItemsHandler returns query handler.
+func NewFindTrashItemsHandler(
+	repo adapters.TrashRepoInterface,
+	logger logger.LogInterface,
+) FindTrashItemsHandler {
+	return FindTrashItemsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find trash items query.
+func (h FindTrashItemsHandler) Handle(ctx context.Context, query FindTrashItemsQuery) ([]domain.TrashItem, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find trash items query")
+	defer span.End()
+
+	items, itemsErr := h.repo.GetAll(ctx)
+	if itemsErr != nil {
+		tracer.AddSpanError(span, itemsErr)
+		return nil, errors.Wrap(itemsErr, "get trash items")
+	}
+
+	return items, nil
+}
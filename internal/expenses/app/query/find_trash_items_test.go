@@ -0,0 +1,72 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindTrashItemsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindTrashItemsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindTrashItemsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindTrashItemsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTrashItemsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindTrashItemsHandler_RepoSuccess_ReturnsItems(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TrashRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	item, _ := domain.NewTrashItem("expenseId", domain.TrashItemTypeExpense, "expense", nil, "user", time.Now())
+	items := []domain.TrashItem{*item}
+
+	repo.On("GetAll", mock.Anything).Return(items, nil)
+
+	// SUT
+	sut := query.NewFindTrashItemsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTrashItemsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, items, result, "Should return trash items.")
+}
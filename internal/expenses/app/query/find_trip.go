@@ -0,0 +1,53 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindTripQuery defines a single trip query.
+type FindTripQuery struct {
+	ID string
+}
+
+// FindTripHandler defines a handler to fetch a single trip.
+type FindTripHandler struct {
+	repo   adapters.TripRepoInterface
+	logger logger.LogInterface
+}
+
+// FindTripHandlerInterface defines a contract to handle query.
+type FindTripHandlerInterface interface {
+	Handle(ctx context.Context, query FindTripQuery) (*domain.Trip, error)
+}
+
+// NewFindTripHandler returns query handler.
+func NewFindTripHandler(
+	repo adapters.TripRepoInterface,
+	logger logger.LogInterface,
+) FindTripHandler {
+	return FindTripHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find trip query.
+func (h FindTripHandler) Handle(ctx context.Context, query FindTripQuery) (*domain.Trip, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find trip query")
+	defer span.End()
+
+	trip, tripErr := h.repo.GetOne(ctx, query.ID)
+	if tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		return nil, errors.Wrap(tripErr, "get trip")
+	}
+
+	return trip, nil
+}
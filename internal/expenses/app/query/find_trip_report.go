@@ -0,0 +1,94 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindTripReportQuery defines a trip report query.
+type FindTripReportQuery struct {
+	ID string
+}
+
+// FindTripReportHandler defines a handler to generate trip report.
+type FindTripReportHandler struct {
+	repo        adapters.TripRepoInterface
+	expenseRepo adapters.ReportRepoInterface
+	rates       ExchangeRatesProviderInterface
+	logger      logger.LogInterface
+}
+
+// FindTripReportHandlerInterface defines a contract to handle query.
+type FindTripReportHandlerInterface interface {
+	Handle(ctx context.Context, query FindTripReportQuery) (*domain.TripReport, error)
+}
+
+// NewFindTripReportHandler returns query handler.
+func NewFindTripReportHandler(
+	repo adapters.TripRepoInterface,
+	expenseRepo adapters.ReportRepoInterface,
+	rates ExchangeRatesProviderInterface,
+	logger logger.LogInterface,
+) FindTripReportHandler {
+	return FindTripReportHandler{
+		repo:        repo,
+		expenseRepo: expenseRepo,
+		rates:       rates,
+		logger:      logger,
+	}
+}
+
+// Handle handles find trip report query. Expenses are converted using exchange rates of the expense date,
+// so rates are fetched for trip expenses made outside of the trip dates too.
+func (h FindTripReportHandler) Handle(ctx context.Context, query FindTripReportQuery) (*domain.TripReport, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find trip report query")
+	defer span.End()
+
+	trip, tripErr := h.repo.GetOne(ctx, query.ID)
+	if tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		return nil, errors.Wrap(tripErr, "get trip")
+	}
+
+	if trip == nil {
+		return nil, nil
+	}
+
+	expenses, expensesErr := h.expenseRepo.GetByTrip(ctx, trip.ID())
+	if expensesErr != nil {
+		tracer.AddSpanError(span, expensesErr)
+		return nil, errors.Wrap(expensesErr, "fetch trip expenses")
+	}
+
+	from, to := trip.From(), trip.To()
+	for _, expense := range expenses {
+		day := expenseDay(expense)
+		if day.Before(from) {
+			from = day
+		}
+		if day.After(to) {
+			to = day
+		}
+	}
+	dateRange, dateRangeErr := domain.NewDateRange(from, to)
+	if dateRangeErr != nil {
+		tracer.AddSpanError(span, dateRangeErr)
+		return nil, errors.Wrap(dateRangeErr, "prepare trip date range")
+	}
+
+	rates, ratesErr := h.rates.ExchangeRates(ctx, *dateRange)
+	if ratesErr != nil {
+		tracer.AddSpanError(span, ratesErr)
+		return nil, errors.Wrap(ratesErr, "fetch trip exchange rates")
+	}
+
+	report := domain.NewTripReport(*trip, expenses, rates)
+
+	return &report, nil
+}
@@ -0,0 +1,113 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindTripReportHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindTripReportHandler(repo, expenseRepo, rates, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindTripReportHandler_NotFound_ReturnsNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewFindTripReportHandler(repo, expenseRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTripReportQuery{ID: "tripId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	expenseRepo.AssertNotCalled(t, "GetByTrip", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.Nil(t, err, "Error result should be nil.")
+}
+
+func TestFindTripReportHandler_ExpensesError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	trip := newTrip()
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(&trip, nil)
+	expenseRepo.On("GetByTrip", mock.Anything, "tripId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindTripReportHandler(repo, expenseRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTripReportQuery{ID: "tripId"})
+
+	// Assert
+	expenseRepo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindTripReportHandler_ExpenseOutsideTrip_FetchesRatesOfExpenseDay(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	expenseRepo := new(mocks.ReportRepoInterface)
+	rates := new(mocks.ExchangeRatesProviderInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	trip := newTrip()
+	tripID := trip.ID()
+	before := time.Date(2021, time.June, 20, 10, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "Tickets", nil, 1, "|categoryId")
+	tickets, _ := domain.NewExpense("expenseId", *category, 100, "EUR", 1, nil, &tripID, before)
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(&trip, nil)
+	expenseRepo.On("GetByTrip", mock.Anything, "tripId").Return([]domain.Expense{*tickets}, nil)
+	rates.On("ExchangeRates", mock.Anything, mock.MatchedBy(func(dateRange domain.DateRange) bool {
+		return dateRange.From().Equal(time.Date(2021, time.June, 20, 0, 0, 0, 0, time.UTC)) &&
+			dateRange.To().Equal(trip.To())
+	})).Return([]domain.ExchangeRates{}, nil)
+
+	// SUT
+	sut := query.NewFindTripReportHandler(repo, expenseRepo, rates, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTripReportQuery{ID: "tripId"})
+
+	// Assert
+	rates.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result.Categories, 1, "Should report trip expense category.")
+	assert.Equal(t, "50", result.DailyAverage.Sum.String(), "Should spread spending over trip days.")
+}
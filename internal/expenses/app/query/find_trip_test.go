@@ -0,0 +1,69 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewFindTripHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindTripHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindTripHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTripQuery{ID: "tripId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindTripHandler_RepoSuccess_ReturnsTrip(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	trip := newTrip()
+
+	repo.On("GetOne", mock.Anything, "tripId").Return(&trip, nil)
+
+	// SUT
+	sut := query.NewFindTripHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTripQuery{ID: "tripId"})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, &trip, result, "Should return trip.")
+}
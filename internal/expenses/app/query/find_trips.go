@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// FindTripsQuery defines a trips query.
+type FindTripsQuery struct{}
+
+// FindTripsHandler defines a handler to fetch trips.
+type FindTripsHandler struct {
+	repo   adapters.TripRepoInterface
+	logger logger.LogInterface
+}
+
+// FindTripsHandlerInterface defines a contract to handle query.
+type FindTripsHandlerInterface interface {
+	Handle(ctx context.Context, query FindTripsQuery) ([]domain.Trip, error)
+}
+
+// NewFindTripsHandler returns query handler.
+func NewFindTripsHandler(
+	repo adapters.TripRepoInterface,
+	logger logger.LogInterface,
+) FindTripsHandler {
+	return FindTripsHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles find trips query.
+func (h FindTripsHandler) Handle(ctx context.Context, query FindTripsQuery) ([]domain.Trip, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute find trips query")
+	defer span.End()
+
+	trips, tripsErr := h.repo.GetAll(ctx)
+	if tripsErr != nil {
+		tracer.AddSpanError(span, tripsErr)
+		return nil, errors.Wrap(tripsErr, "get trips")
+	}
+
+	return trips, nil
+}
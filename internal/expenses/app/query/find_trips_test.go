@@ -0,0 +1,81 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newTrip() domain.Trip {
+	trip, _ := domain.NewTrip("tripId", domain.TripParams{
+		Name:         "Paris 2021",
+		From:         time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC),
+		HomeCurrency: "EUR",
+	})
+	return *trip
+}
+
+func TestNewFindTripsHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewFindTripsHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestFindTripsHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewFindTripsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTripsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestFindTripsHandler_RepoSuccess_ReturnsTrips(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.TripRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	trips := []domain.Trip{newTrip()}
+
+	repo.On("GetAll", mock.Anything).Return(trips, nil)
+
+	// SUT
+	sut := query.NewFindTripsHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.FindTripsQuery{})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, trips, result, "Should return trips.")
+}
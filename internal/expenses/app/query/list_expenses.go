@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// ListExpensesQuery defines an expense list query.
+type ListExpensesQuery struct {
+	Filter domain.ExpenseListFilter
+}
+
+// ListExpensesHandler defines a handler to list expenses.
+type ListExpensesHandler struct {
+	repo   adapters.ExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// ListExpensesHandlerInterface defines a contract to handle query.
+type ListExpensesHandlerInterface interface {
+	Handle(ctx context.Context, query ListExpensesQuery) (*domain.ExpensePage, error)
+}
+
+// NewListExpensesHandler returns query handler.
+func NewListExpensesHandler(
+	repo adapters.ExpenseRepoInterface,
+	logger logger.LogInterface,
+) ListExpensesHandler {
+	return ListExpensesHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles list expenses query.
+func (h ListExpensesHandler) Handle(ctx context.Context, query ListExpensesQuery) (*domain.ExpensePage, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute list expenses query")
+	defer span.End()
+
+	page, pageErr := h.repo.GetAll(ctx, query.Filter)
+	if pageErr != nil {
+		tracer.AddSpanError(span, pageErr)
+		return nil, errors.Wrap(pageErr, "fetch expenses")
+	}
+
+	for index := range page.Expenses {
+		page.Expenses[index].CalculateTotal(nil)
+	}
+
+	return page, nil
+}
@@ -0,0 +1,84 @@
+package query_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewListExpensesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewListExpensesHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestListExpensesHandle_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{})
+	listQuery := query.ListExpensesQuery{
+		Filter: *filter,
+	}
+
+	repo.On("GetAll", mock.Anything, *filter).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewListExpensesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, listQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestListExpensesHandle_RepoSuccess_ReturnsPage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{})
+	listQuery := query.ListExpensesQuery{
+		Filter: *filter,
+	}
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 3, nil, nil, time.Now())
+	page := &domain.ExpensePage{
+		Expenses: []domain.Expense{*expense},
+	}
+
+	repo.On("GetAll", mock.Anything, *filter).Return(page, nil)
+
+	// SUT
+	sut := query.NewListExpensesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, listQuery)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result.Expenses, 1, "Should return expenses.")
+	assert.Equal(t, "30", result.Expenses[0].TotalInfo().OriginalTotal.Sum.String(), "Should calculate total.")
+}
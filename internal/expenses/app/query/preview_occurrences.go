@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// PreviewOccurrencesQuery defines a query to list upcoming occurrences of a recurring expense.
+type PreviewOccurrencesQuery struct {
+	RecurringExpenseID string
+	From               time.Time
+	Limit              int
+}
+
+// PreviewOccurrencesHandler defines a handler to preview occurrences without persisting them.
+type PreviewOccurrencesHandler struct {
+	repo   adapters.RecurringExpenseRepoInterface
+	logger logger.LogInterface
+}
+
+// PreviewOccurrencesHandlerInterface defines a contract to handle query.
+type PreviewOccurrencesHandlerInterface interface {
+	Handle(ctx context.Context, query PreviewOccurrencesQuery) ([]domain.Occurrence, error)
+}
+
+// NewPreviewOccurrencesHandler returns query handler.
+func NewPreviewOccurrencesHandler(
+	repo adapters.RecurringExpenseRepoInterface,
+	logger logger.LogInterface,
+) PreviewOccurrencesHandler {
+	return PreviewOccurrencesHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles preview occurrences query.
+func (h PreviewOccurrencesHandler) Handle(
+	ctx context.Context,
+	query PreviewOccurrencesQuery,
+) ([]domain.Occurrence, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute preview occurrences query")
+	defer span.End()
+
+	recurringExpense, recurringErr := h.repo.GetOne(ctx, query.RecurringExpenseID)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		return nil, errors.Wrap(recurringErr, "get recurring expense")
+	}
+
+	if recurringExpense == nil {
+		return nil, errors.Wrapf(domain.ErrRecurringExpenseNotFound, "recurring expense %s", query.RecurringExpenseID)
+	}
+
+	return recurringExpense.Upcoming(query.From, query.Limit), nil
+}
@@ -0,0 +1,78 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewPreviewOccurrencesHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewPreviewOccurrencesHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestPreviewOccurrencesHandler_NotFound_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(nil, nil)
+
+	// SUT
+	sut := query.NewPreviewOccurrencesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.PreviewOccurrencesQuery{RecurringExpenseID: "recurringId", Limit: 3})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrRecurringExpenseNotFound, "Should return not found error.")
+}
+
+func TestPreviewOccurrencesHandler_RepoSuccess_ReturnsUpcomingOccurrences(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.RecurringExpenseRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	recurringExpense := newMonthlyRecurringExpense()
+	queryArgs := query.PreviewOccurrencesQuery{
+		RecurringExpenseID: "recurringId",
+		From:               time.Date(2021, time.March, 6, 0, 0, 0, 0, time.UTC),
+		Limit:              2,
+	}
+
+	repo.On("GetOne", mock.Anything, "recurringId").Return(&recurringExpense, nil)
+
+	// SUT
+	sut := query.NewPreviewOccurrencesHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, queryArgs)
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, []domain.Occurrence{
+		{Date: time.Date(2021, time.April, 5, 0, 0, 0, 0, time.UTC), Price: 500, Quantity: 1},
+		{Date: time.Date(2021, time.May, 5, 0, 0, 0, 0, time.UTC), Price: 500, Quantity: 1},
+	}, result, "Should return upcoming occurrences.")
+}
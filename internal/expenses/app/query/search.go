@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// SearchQuery defines a full-text search query.
+type SearchQuery struct {
+	Filter domain.SearchFilter
+}
+
+// SearchHandler defines a handler to search expenses and categories.
+type SearchHandler struct {
+	repo   adapters.SearchRepoInterface
+	logger logger.LogInterface
+}
+
+// SearchHandlerInterface defines a contract to handle query.
+type SearchHandlerInterface interface {
+	Handle(ctx context.Context, query SearchQuery) (*domain.SearchResult, error)
+}
+
+// NewSearchHandler returns query handler.
+func NewSearchHandler(
+	repo adapters.SearchRepoInterface,
+	logger logger.LogInterface,
+) SearchHandler {
+	return SearchHandler{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Handle handles search query.
+func (h SearchHandler) Handle(ctx context.Context, query SearchQuery) (*domain.SearchResult, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute search query")
+	span.SetAttributes(attribute.String("text", query.Filter.Text()))
+	defer span.End()
+
+	result, searchErr := h.repo.Search(ctx, query.Filter)
+	if searchErr != nil {
+		tracer.AddSpanError(span, searchErr)
+		return nil, errors.Wrap(searchErr, "search")
+	}
+
+	for index := range result.Expenses {
+		result.Expenses[index].Expense.CalculateTotal(nil)
+	}
+
+	return result, nil
+}
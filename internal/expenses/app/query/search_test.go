@@ -0,0 +1,75 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestNewSearchHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SearchRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewSearchHandler(repo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestSearchHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.SearchRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	filter, _ := domain.NewSearchFilter(domain.SearchFilterParams{Text: "dentist"})
+
+	repo.On("Search", mock.Anything, *filter).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewSearchHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.SearchQuery{Filter: *filter})
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestSearchHandler_RepoSuccess_ReturnsMatchesWithTotals(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	category, _ := domain.NewCategory("healthId", nil, "Health", nil, 1, "|healthId")
+	comment := "Dentist"
+	expense, _ := domain.NewExpense("expenseId", *category, 60, "EUR", 2, &comment, nil, time.Now())
+	repo := adapters.NewInMemorySearchRepo([]domain.Category{*category}, nil, []domain.Expense{*expense})
+	filter, _ := domain.NewSearchFilter(domain.SearchFilterParams{Text: "dentist"})
+
+	// SUT
+	sut := query.NewSearchHandler(repo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, query.SearchQuery{Filter: *filter})
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Len(t, result.Expenses, 1)
+	assert.Empty(t, result.Categories)
+	assert.Equal(t, "120", result.Expenses[0].Expense.TotalInfo().OriginalTotal.Sum.String(), "Should calculate total.")
+}
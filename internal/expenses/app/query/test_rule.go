@@ -0,0 +1,87 @@
+package query
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/adapters"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
+)
+
+// TestRuleQuery defines a query to test a rule against the last added expenses, the rule is not saved.
+type TestRuleQuery struct {
+	Rule  domain.RuleParams
+	Limit *int
+}
+
+// TestRuleHandler defines a handler to test rule.
+type TestRuleHandler struct {
+	repo         adapters.ExpenseRepoInterface
+	merchantRepo adapters.MerchantRepoInterface
+	logger       logger.LogInterface
+}
+
+// TestRuleHandlerInterface defines a contract to handle query.
+type TestRuleHandlerInterface interface {
+	Handle(ctx context.Context, query TestRuleQuery) (*domain.RuleTest, error)
+}
+
+// NewTestRuleHandler returns query handler.
+func NewTestRuleHandler(
+	repo adapters.ExpenseRepoInterface,
+	merchantRepo adapters.MerchantRepoInterface,
+	logger logger.LogInterface,
+) TestRuleHandler {
+	return TestRuleHandler{
+		repo:         repo,
+		merchantRepo: merchantRepo,
+		logger:       logger,
+	}
+}
+
+// Handle handles test rule query. The limit defaults to the default expense list page size.
+func (h TestRuleHandler) Handle(ctx context.Context, query TestRuleQuery) (*domain.RuleTest, error) {
+	ctx, span := tracer.NewSpan(ctx, "execute test rule query")
+	defer span.End()
+
+	rule, ruleErr := domain.NewRule("", query.Rule)
+	if ruleErr != nil {
+		tracer.AddSpanError(span, ruleErr)
+		return nil, errors.Wrap(domain.ErrInvalidRule, ruleErr.Error())
+	}
+
+	sortBy := string(domain.SortFieldCreatedAt)
+	filter, filterErr := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{
+		SortBy: &sortBy,
+		Limit:  query.Limit,
+	})
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return nil, errors.Wrap(domain.ErrInvalidRule, filterErr.Error())
+	}
+
+	page, pageErr := h.repo.GetAll(ctx, *filter)
+	if pageErr != nil {
+		tracer.AddSpanError(span, pageErr)
+		return nil, errors.Wrap(pageErr, "fetch expenses")
+	}
+
+	merchants, merchantsErr := h.merchantRepo.GetAll(ctx)
+	if merchantsErr != nil {
+		tracer.AddSpanError(span, merchantsErr)
+		return nil, errors.Wrap(merchantsErr, "get merchants")
+	}
+
+	test := domain.NewRuleEngine([]domain.Rule{*rule}, nil, merchants).Test(page.Expenses)
+	for index := range test.Matches {
+		test.Matches[index].CalculateTotal(nil)
+	}
+
+	span.SetAttributes(attribute.Int("checked", test.Checked), attribute.Int("matches", len(test.Matches)))
+
+	return &test, nil
+}
@@ -0,0 +1,121 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func newTestRuleQuery() query.TestRuleQuery {
+	merchant := "^rewe"
+	limit := 20
+	return query.TestRuleQuery{
+		Rule: domain.RuleParams{
+			Name:       "Groceries",
+			Conditions: domain.RuleConditions{Merchant: &merchant},
+			Actions:    domain.RuleActions{Tags: []string{"groceries"}},
+		},
+		Limit: &limit,
+	}
+}
+
+func TestNewTestRuleHandler_ReturnsHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+
+	// Act
+	result := query.NewTestRuleHandler(repo, merchantRepo, log)
+
+	// Assert
+	assert.NotNil(t, result, "Result should not be nil.")
+}
+
+func TestTestRuleHandler_InvalidRule_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	testQuery := newTestRuleQuery()
+	testQuery.Rule.Conditions = domain.RuleConditions{}
+
+	// SUT
+	sut := query.NewTestRuleHandler(repo, merchantRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, testQuery)
+
+	// Assert
+	repo.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.ErrorIs(t, err, domain.ErrInvalidRule, "Should return invalid rule error.")
+}
+
+func TestTestRuleHandler_RepoError_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+
+	repo.On("GetAll", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	// SUT
+	sut := query.NewTestRuleHandler(repo, merchantRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newTestRuleQuery())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, result, "Result should be nil.")
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestTestRuleHandler_LastExpenses_ReturnsMatches(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	repo := new(mocks.ExpenseRepoInterface)
+	merchantRepo := new(mocks.MerchantRepoInterface)
+	log := new(mocks.LogInterface)
+	ctx := context.Background()
+	rewe, _ := domain.NewMerchant("reweId", domain.MerchantParams{Name: "Rewe"})
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	date := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	matching, _ := domain.NewExpense("matchingId", *category, 10, "EUR", 2, nil, nil, date,
+		domain.SetMerchant("reweId"))
+	other, _ := domain.NewExpense("otherId", *category, 5, "EUR", 1, nil, nil, date)
+
+	repo.On("GetAll", mock.Anything, mock.MatchedBy(func(filter domain.ExpenseListFilter) bool {
+		return filter.Limit() == 20 && filter.SortField() == domain.SortFieldCreatedAt &&
+			filter.SortOrder() == domain.SortOrderDesc
+	})).Return(&domain.ExpensePage{Expenses: []domain.Expense{*matching, *other}}, nil)
+	merchantRepo.On("GetAll", mock.Anything).Return([]domain.Merchant{*rewe}, nil)
+
+	// SUT
+	sut := query.NewTestRuleHandler(repo, merchantRepo, log)
+
+	// Act
+	result, err := sut.Handle(ctx, newTestRuleQuery())
+
+	// Assert
+	repo.AssertExpectations(t)
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, 2, result.Checked, "Should check all fetched expenses.")
+	assert.Len(t, result.Matches, 1, "Should return matching expenses.")
+	assert.Equal(t, "matchingId", result.Matches[0].ID(), "Should return matching expenses.")
+	assert.Equal(t, "20", result.Matches[0].TotalInfo().OriginalTotal.Sum.String(), "Should calculate totals.")
+}
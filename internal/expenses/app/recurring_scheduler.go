@@ -0,0 +1,62 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/config"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+)
+
+// RecurringScheduler periodically adds expenses of due recurring expense occurrences.
+type RecurringScheduler struct {
+	materialize command.MaterializeRecurringExpensesHandlerInterface
+	logger      logger.LogInterface
+	interval    time.Duration
+	nowFn       func() time.Time
+}
+
+// NewRecurringScheduler returns recurring expenses scheduler.
+func NewRecurringScheduler(
+	materialize command.MaterializeRecurringExpensesHandlerInterface,
+	logger logger.LogInterface,
+	config config.Recurring,
+) RecurringScheduler {
+	return RecurringScheduler{
+		materialize: materialize,
+		logger:      logger,
+		interval:    time.Duration(config.ScheduleIntervalHours) * time.Hour,
+		nowFn:       time.Now,
+	}
+}
+
+// Run materializes due occurrences right away and then on every interval until the context is done.
+func (s RecurringScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.materializeDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s RecurringScheduler) materializeDue(ctx context.Context) {
+	cmd := command.MaterializeRecurringExpensesCommand{
+		Until: s.nowFn(),
+	}
+	materializeResult, materializeErr := s.materialize.Handle(ctx, cmd)
+	if materializeErr != nil {
+		s.logger.Error(ctx, "Failed to materialize recurring expenses", materializeErr)
+		return
+	}
+
+	s.logger.Infof(ctx, "Materialized %d recurring expense occurrences due by %s",
+		materializeResult.InsertCount, cmd.Until)
+}
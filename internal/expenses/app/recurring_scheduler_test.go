@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/config"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestRecurringScheduler_Run_MaterializesOccurrencesDueNow(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	materialize := new(mocks.MaterializeRecurringExpensesHandlerInterface)
+	log := new(mocks.LogInterface)
+	now := time.Date(2021, 7, 31, 0, 0, 0, 0, time.UTC)
+	cfg := config.Recurring{ScheduleIntervalHours: 1}
+
+	matchCmdFn := func(cmd command.MaterializeRecurringExpensesCommand) bool {
+		return cmd.Until.Equal(now)
+	}
+	materialize.On("Handle", mock.Anything, mock.MatchedBy(matchCmdFn)).
+		Return(&domain.InsertResult{InsertCount: 1}, nil).
+		Run(func(args mock.Arguments) { cancel() })
+	log.On("Infof", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// SUT
+	sut := NewRecurringScheduler(materialize, log, cfg)
+	sut.nowFn = func() time.Time { return now }
+
+	// Act
+	sut.Run(ctx)
+
+	// Assert
+	materialize.AssertExpectations(t)
+	log.AssertExpectations(t)
+}
+
+func TestRecurringScheduler_Run_LogsMaterializeFailure(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	materialize := new(mocks.MaterializeRecurringExpensesHandlerInterface)
+	log := new(mocks.LogInterface)
+	cfg := config.Recurring{ScheduleIntervalHours: 1}
+
+	materialize.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, errors.New("error")).
+		Run(func(args mock.Arguments) { cancel() })
+	log.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// SUT
+	sut := NewRecurringScheduler(materialize, log, cfg)
+
+	// Act
+	sut.Run(ctx)
+
+	// Assert
+	materialize.AssertExpectations(t)
+	log.AssertExpectations(t)
+}
@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/config"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+)
+
+// TrashPurger periodically purges items that stayed in the trash longer than the retention period.
+type TrashPurger struct {
+	purge     command.PurgeTrashHandlerInterface
+	logger    logger.LogInterface
+	retention time.Duration
+	interval  time.Duration
+	nowFn     func() time.Time
+}
+
+// NewTrashPurger returns trash purger.
+func NewTrashPurger(
+	purge command.PurgeTrashHandlerInterface,
+	logger logger.LogInterface,
+	config config.Trash,
+) TrashPurger {
+	return TrashPurger{
+		purge:     purge,
+		logger:    logger,
+		retention: time.Duration(config.RetentionDays) * 24 * time.Hour,
+		interval:  time.Duration(config.PurgeIntervalHours) * time.Hour,
+		nowFn:     time.Now,
+	}
+}
+
+// Run purges the trash right away and then on every interval until the context is done.
+func (p TrashPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purgeExpired(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p TrashPurger) purgeExpired(ctx context.Context) {
+	cmd := command.PurgeTrashCommand{
+		DeletedBefore: p.nowFn().Add(-p.retention),
+	}
+	purgeResult, purgeErr := p.purge.Handle(ctx, cmd)
+	if purgeErr != nil {
+		p.logger.Error(ctx, "Failed to purge trash", purgeErr)
+		return
+	}
+
+	p.logger.Infof(ctx, "Purged %d trash items deleted before %s", purgeResult.DeleteCount, cmd.DeletedBefore)
+}
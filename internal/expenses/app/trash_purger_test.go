@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/config"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestTrashPurger_Run_PurgesItemsOlderThanRetention(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	purge := new(mocks.PurgeTrashHandlerInterface)
+	log := new(mocks.LogInterface)
+	now := time.Date(2021, 7, 31, 0, 0, 0, 0, time.UTC)
+	cfg := config.Trash{RetentionDays: 30, PurgeIntervalHours: 24}
+
+	matchCmdFn := func(cmd command.PurgeTrashCommand) bool {
+		return cmd.DeletedBefore.Equal(time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC))
+	}
+	purge.On("Handle", mock.Anything, mock.MatchedBy(matchCmdFn)).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil).
+		Run(func(args mock.Arguments) { cancel() })
+	log.On("Infof", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// SUT
+	sut := NewTrashPurger(purge, log, cfg)
+	sut.nowFn = func() time.Time { return now }
+
+	// Act
+	sut.Run(ctx)
+
+	// Assert
+	purge.AssertExpectations(t)
+	log.AssertExpectations(t)
+}
+
+func TestTrashPurger_Run_LogsPurgeFailure(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	purge := new(mocks.PurgeTrashHandlerInterface)
+	log := new(mocks.LogInterface)
+	cfg := config.Trash{RetentionDays: 30, PurgeIntervalHours: 24}
+
+	purge.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, errors.New("error")).
+		Run(func(args mock.Arguments) { cancel() })
+	log.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// SUT
+	sut := NewTrashPurger(purge, log, cfg)
+
+	// Act
+	sut.Run(ctx)
+
+	// Assert
+	purge.AssertExpectations(t)
+	log.AssertExpectations(t)
+	assert.NotNil(t, ctx.Err(), "Context should be cancelled.")
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/logger"
+)
+
+// TripMigrator converts free-text trip names left by previous versions into trip references.
+type TripMigrator struct {
+	migrate command.MigrateTripsHandlerInterface
+	logger  logger.LogInterface
+}
+
+// NewTripMigrator returns trip migrator.
+func NewTripMigrator(
+	migrate command.MigrateTripsHandlerInterface,
+	logger logger.LogInterface,
+) TripMigrator {
+	return TripMigrator{
+		migrate: migrate,
+		logger:  logger,
+	}
+}
+
+// Run migrates trips once.
+func (m TripMigrator) Run(ctx context.Context) {
+	migrateResult, migrateErr := m.migrate.Handle(ctx, command.MigrateTripsCommand{})
+	if migrateErr != nil {
+		m.logger.Error(ctx, "Failed to migrate trips", migrateErr)
+		return
+	}
+
+	if migrateResult.UpdateCount > 0 {
+		m.logger.Infof(ctx, "Linked %d expenses to trips", migrateResult.UpdateCount)
+	}
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/testing/mocks"
+)
+
+func TestTripMigrator_Run_LogsLinkedExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	migrate := new(mocks.MigrateTripsHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	migrate.On("Handle", mock.Anything, mock.Anything).Return(&domain.UpdateResult{UpdateCount: 3}, nil)
+	log.On("Infof", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// SUT
+	sut := NewTripMigrator(migrate, log)
+
+	// Act
+	sut.Run(ctx)
+
+	// Assert
+	migrate.AssertExpectations(t)
+	log.AssertExpectations(t)
+}
+
+func TestTripMigrator_Run_NothingLinked_LogsNothing(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	migrate := new(mocks.MigrateTripsHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	migrate.On("Handle", mock.Anything, mock.Anything).Return(&domain.UpdateResult{}, nil)
+
+	// SUT
+	sut := NewTripMigrator(migrate, log)
+
+	// Act
+	sut.Run(ctx)
+
+	// Assert
+	migrate.AssertExpectations(t)
+	log.AssertNotCalled(t, "Infof", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestTripMigrator_Run_LogsMigrationFailure(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	migrate := new(mocks.MigrateTripsHandlerInterface)
+	log := new(mocks.LogInterface)
+
+	migrate.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+	log.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// SUT
+	sut := NewTripMigrator(migrate, log)
+
+	// Act
+	sut.Run(ctx)
+
+	// Assert
+	migrate.AssertExpectations(t)
+	log.AssertExpectations(t)
+}
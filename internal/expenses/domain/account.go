@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Defines values for AccountType.
+const (
+	AccountTypeCash AccountType = "cash"
+
+	AccountTypeCard AccountType = "card"
+
+	AccountTypeBank AccountType = "bank"
+)
+
+// AccountType defines what kind of money an account holds.
+type AccountType string
+
+// AccountParams holds raw account values.
+type AccountParams struct {
+	Name           string
+	Type           string
+	Currency       string
+	OpeningBalance float64
+}
+
+// Account represents a wallet expenses are paid from, e.g. a joint card, a personal card or cash.
+type Account struct {
+	id             string
+	name           string
+	accountType    AccountType
+	currency       Currency
+	openingBalance decimal.Decimal
+}
+
+// NewAccount instantiates account. The opening balance may be negative, e.g. for a credit card.
+func NewAccount(id string, params AccountParams) (*Account, error) {
+	name := strings.TrimSpace(params.Name)
+	if len(name) == 0 {
+		return nil, errors.New("empty name")
+	}
+
+	accountType := AccountType(params.Type)
+	switch accountType {
+	case AccountTypeCash, AccountTypeCard, AccountTypeBank:
+	default:
+		return nil, errors.Errorf("unknown account type %s", params.Type)
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(params.Currency))
+	if len(currency) == 0 {
+		return nil, errors.New("empty currency")
+	}
+
+	return &Account{
+		id:             id,
+		name:           name,
+		accountType:    accountType,
+		currency:       Currency(currency),
+		openingBalance: decimal.NewFromFloat(params.OpeningBalance),
+	}, nil
+}
+
+// ID returns account id.
+func (a Account) ID() string {
+	return a.id
+}
+
+// Name returns account name.
+func (a Account) Name() string {
+	return a.name
+}
+
+// Type returns account type.
+func (a Account) Type() AccountType {
+	return a.accountType
+}
+
+// Currency returns account currency.
+func (a Account) Currency() Currency {
+	return a.currency
+}
+
+// OpeningBalance returns account balance before any expense or transfer.
+func (a Account) OpeningBalance() float64 {
+	openingBalance, _ := a.openingBalance.Float64()
+	return openingBalance
+}
+
+// AccountBalance represents money on an account at a moment in the account currency.
+type AccountBalance struct {
+	AccountID      string
+	Currency       Currency
+	At             time.Time
+	OpeningBalance decimal.Decimal
+	Expenses       decimal.Decimal
+	TransfersIn    decimal.Decimal
+	TransfersOut   decimal.Decimal
+	Balance        decimal.Decimal
+}
+
+// NewAccountBalance calculates the account balance at the moment out of the opening balance, expenses
+// paid from the account and transfers. Expenses in other currencies are converted using exchange rates
+// of their dates, incoming transfers are converted at their own rates.
+// Expenses and transfers of other accounts or after the moment are skipped.
+func NewAccountBalance(
+	account Account,
+	at time.Time,
+	expenses []Expense,
+	transfers []Transfer,
+	rates []ExchangeRates,
+) (*AccountBalance, error) {
+	converter := newBalanceConverter(account.currency, rates)
+	balance := AccountBalance{
+		AccountID:      account.id,
+		Currency:       account.currency,
+		At:             at,
+		OpeningBalance: account.openingBalance,
+	}
+
+	for _, expense := range expenses {
+		if expense.accountID == nil || *expense.accountID != account.id || expense.date.After(at) {
+			continue
+		}
+		converted, convertErr := converter.convert(expense.price.Mul(expense.quantity),
+			Currency(expense.currency), expense.date)
+		if convertErr != nil {
+			return nil, convertErr
+		}
+		balance.Expenses = balance.Expenses.Add(converted)
+	}
+
+	for _, transfer := range transfers {
+		if transfer.date.After(at) {
+			continue
+		}
+		if transfer.fromAccountID == account.id {
+			balance.TransfersOut = balance.TransfersOut.Add(transfer.amount)
+		}
+		if transfer.toAccountID == account.id {
+			balance.TransfersIn = balance.TransfersIn.Add(transfer.ReceivedAmount())
+		}
+	}
+
+	balance.Expenses = balance.Expenses.Round(splitPrecision)
+	balance.TransfersIn = balance.TransfersIn.Round(splitPrecision)
+	balance.Balance = balance.OpeningBalance.
+		Sub(balance.Expenses).
+		Add(balance.TransfersIn).
+		Sub(balance.TransfersOut)
+
+	return &balance, nil
+}
@@ -0,0 +1,111 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newAccount(t *testing.T, id string, currency string, openingBalance float64) domain.Account {
+	t.Helper()
+	account, accountErr := domain.NewAccount(id, domain.AccountParams{
+		Name: "Joint card", Type: "card", Currency: currency, OpeningBalance: openingBalance,
+	})
+	assert.Nil(t, accountErr)
+	return *account
+}
+
+func newAccountExpense(t *testing.T, price float64, currency string, date time.Time, accountID string) domain.Expense {
+	t.Helper()
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, expenseErr := domain.NewExpense("", *category, price, currency, 1, nil, nil, date,
+		domain.SetAccount(accountID))
+	assert.Nil(t, expenseErr)
+	return *expense
+}
+
+func TestNewAccount_ValidParams_ReturnsAccount(t *testing.T) {
+	t.Parallel()
+	// Act
+	res, err := domain.NewAccount("accountId", domain.AccountParams{
+		Name: " Wallet ", Type: "cash", Currency: "eur", OpeningBalance: -10.5,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "Wallet", res.Name())
+	assert.Equal(t, domain.AccountTypeCash, res.Type())
+	assert.Equal(t, domain.Currency("EUR"), res.Currency())
+	assert.Equal(t, -10.5, res.OpeningBalance())
+}
+
+func TestNewAccount_InvalidParams_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := []domain.AccountParams{
+		{Name: " ", Type: "cash", Currency: "EUR"},
+		{Name: "Wallet", Type: "crypto", Currency: "EUR"},
+		{Name: "Wallet", Type: "cash", Currency: " "},
+	}
+
+	for _, params := range tests {
+		// Act
+		res, err := domain.NewAccount("accountId", params)
+
+		// Assert
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestNewAccountBalance_ExpensesAndTransfers_CalculatesBalance(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	account := newAccount(t, "cardId", "EUR", 1000)
+	expenses := []domain.Expense{
+		newAccountExpense(t, 100, "EUR", utcDate(2021, time.July, 1), "cardId"),
+		newAccountExpense(t, 60, "USD", utcDate(2021, time.July, 2), "cardId"),
+		newAccountExpense(t, 500, "EUR", utcDate(2021, time.July, 2), "cashId"),
+		newAccountExpense(t, 500, "EUR", utcDate(2021, time.July, 10), "cardId"),
+	}
+	rate := 0.5
+	transfers := make([]domain.Transfer, 0)
+	for _, params := range []domain.TransferParams{
+		{FromAccountID: "cardId", ToAccountID: "cashId", Amount: 200, Date: utcDate(2021, time.July, 3)},
+		{FromAccountID: "usdId", ToAccountID: "cardId", Amount: 100, Rate: &rate, Date: utcDate(2021, time.July, 4)},
+		{FromAccountID: "cashId", ToAccountID: "cardId", Amount: 300, Date: utcDate(2021, time.July, 10)},
+	} {
+		transfer, _ := domain.NewTransfer("", params)
+		transfers = append(transfers, *transfer)
+	}
+	rates, _ := domain.NewExchageRate(utcDate(2021, time.July, 2), "EUR", map[string]float64{"USD": 2})
+
+	// Act
+	res, err := domain.NewAccountBalance(account, utcDate(2021, time.July, 5), expenses, transfers,
+		[]domain.ExchangeRates{*rates})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, domain.Currency("EUR"), res.Currency)
+	assert.Equal(t, "130", res.Expenses.String())
+	assert.Equal(t, "50", res.TransfersIn.String())
+	assert.Equal(t, "200", res.TransfersOut.String())
+	assert.Equal(t, "720", res.Balance.String())
+}
+
+func TestNewAccountBalance_MissingExchangeRate_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	account := newAccount(t, "cardId", "EUR", 0)
+	expenses := []domain.Expense{newAccountExpense(t, 60, "USD", utcDate(2021, time.July, 2), "cardId")}
+
+	// Act
+	res, err := domain.NewAccountBalance(account, utcDate(2021, time.July, 5), expenses, nil, nil)
+
+	// Assert
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, domain.ErrExchangeRateNotFound)
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ThumbnailContentType is a content type of generated attachment thumbnails.
+const ThumbnailContentType string = "image/jpeg"
+
+// attachmentContentTypes holds content types accepted as attachments, images get a thumbnail.
+// nolint:gochecknoglobals
+var attachmentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"application/pdf": false,
+}
+
+// AttachmentParams holds raw attachment values.
+type AttachmentParams struct {
+	ExpenseID    string
+	FileName     string
+	ContentType  string
+	Size         int64
+	BlobKey      string
+	ThumbnailKey *string
+	CreatedBy    string
+	CreatedAt    time.Time
+}
+
+// Attachment represents a receipt or another document attached to an expense.
+// The content itself is kept in a blob store under the blob key.
+type Attachment struct {
+	id           string
+	expenseID    string
+	fileName     string
+	contentType  string
+	size         int64
+	blobKey      string
+	thumbnailKey *string
+	createdBy    string
+	createdAt    time.Time
+}
+
+// NewAttachment instantiates attachment. The file name is reduced to its base name.
+func NewAttachment(id string, params AttachmentParams) (*Attachment, error) {
+	if len(params.ExpenseID) == 0 {
+		return nil, errors.New("empty expense ID")
+	}
+
+	fileName := strings.TrimSpace(path.Base(strings.ReplaceAll(params.FileName, "\\", "/")))
+	if len(fileName) == 0 || fileName == "." || fileName == "/" {
+		return nil, errors.New("empty file name")
+	}
+
+	contentType, contentTypeErr := ParseAttachmentContentType(params.ContentType)
+	if contentTypeErr != nil {
+		return nil, contentTypeErr
+	}
+
+	if params.Size <= 0 {
+		return nil, errors.New("empty attachment")
+	}
+	if len(params.BlobKey) == 0 {
+		return nil, errors.New("empty blob key")
+	}
+	if params.CreatedAt.IsZero() {
+		return nil, errors.New("empty creation date")
+	}
+
+	return &Attachment{
+		id:           id,
+		expenseID:    params.ExpenseID,
+		fileName:     fileName,
+		contentType:  contentType,
+		size:         params.Size,
+		blobKey:      params.BlobKey,
+		thumbnailKey: params.ThumbnailKey,
+		createdBy:    params.CreatedBy,
+		createdAt:    params.CreatedAt,
+	}, nil
+}
+
+// ParseAttachmentContentType parses a content type and checks that it could be attached.
+func ParseAttachmentContentType(contentType string) (string, error) {
+	mediaType, _, mediaTypeErr := mime.ParseMediaType(contentType)
+	if mediaTypeErr != nil {
+		return "", fmt.Errorf("invalid content type %s", contentType)
+	}
+	if _, ok := attachmentContentTypes[mediaType]; !ok {
+		return "", fmt.Errorf("content type %s is not supported", mediaType)
+	}
+	return mediaType, nil
+}
+
+// IsImageContentType checks whether the attachment content type is an image a thumbnail could be made of.
+func IsImageContentType(contentType string) bool {
+	return attachmentContentTypes[contentType]
+}
+
+// ID returns attachment id.
+func (a Attachment) ID() string {
+	return a.id
+}
+
+// ExpenseID returns an ID of the expense the attachment belongs to.
+func (a Attachment) ExpenseID() string {
+	return a.expenseID
+}
+
+// FileName returns original file name.
+func (a Attachment) FileName() string {
+	return a.fileName
+}
+
+// ContentType returns attachment content type.
+func (a Attachment) ContentType() string {
+	return a.contentType
+}
+
+// Size returns attachment size in bytes.
+func (a Attachment) Size() int64 {
+	return a.size
+}
+
+// BlobKey returns a key of the attachment content in the blob store.
+func (a Attachment) BlobKey() string {
+	return a.blobKey
+}
+
+// ThumbnailKey returns a key of the thumbnail in the blob store, only images have a thumbnail.
+func (a Attachment) ThumbnailKey() *string {
+	return a.thumbnailKey
+}
+
+// CreatedBy returns a user who attached the file.
+func (a Attachment) CreatedBy() string {
+	return a.createdBy
+}
+
+// CreatedAt returns attachment date.
+func (a Attachment) CreatedAt() time.Time {
+	return a.createdAt
+}
+
+// BlobKeys returns keys of all blobs of the attachment.
+func (a Attachment) BlobKeys() []string {
+	keys := []string{a.blobKey}
+	if a.thumbnailKey != nil {
+		keys = append(keys, *a.thumbnailKey)
+	}
+	return keys
+}
@@ -0,0 +1,81 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newAttachmentParams() domain.AttachmentParams {
+	thumbnailKey := "expenseId/blobId-thumbnail"
+	return domain.AttachmentParams{
+		ExpenseID:    "expenseId",
+		FileName:     `C:\Users\alice\receipt.jpg`,
+		ContentType:  "image/jpeg; charset=binary",
+		Size:         1024,
+		BlobKey:      "expenseId/blobId",
+		ThumbnailKey: &thumbnailKey,
+		CreatedBy:    "alice",
+		CreatedAt:    time.Date(2021, time.July, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestNewAttachment_ValidParams_NormalizesValues(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := newAttachmentParams()
+
+	// Act
+	res, resErr := domain.NewAttachment("attachmentId", params)
+
+	// Assert
+	assert.Nil(t, resErr, "Error should be nil.")
+	assert.Equal(t, "attachmentId", res.ID())
+	assert.Equal(t, "expenseId", res.ExpenseID())
+	assert.Equal(t, "receipt.jpg", res.FileName())
+	assert.Equal(t, "image/jpeg", res.ContentType())
+	assert.Equal(t, int64(1024), res.Size())
+	assert.Equal(t, "alice", res.CreatedBy())
+	assert.Equal(t, []string{"expenseId/blobId", "expenseId/blobId-thumbnail"}, res.BlobKeys())
+}
+
+func TestNewAttachment_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	cases := map[string]func(params *domain.AttachmentParams){
+		"empty expense":        func(params *domain.AttachmentParams) { params.ExpenseID = "" },
+		"empty file name":      func(params *domain.AttachmentParams) { params.FileName = " " },
+		"unsupported type":     func(params *domain.AttachmentParams) { params.ContentType = "text/html" },
+		"invalid content type": func(params *domain.AttachmentParams) { params.ContentType = "" },
+		"empty file":           func(params *domain.AttachmentParams) { params.Size = 0 },
+		"empty blob key":       func(params *domain.AttachmentParams) { params.BlobKey = "" },
+		"empty date":           func(params *domain.AttachmentParams) { params.CreatedAt = time.Time{} },
+	}
+
+	for name, modify := range cases {
+		modify := modify
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			params := newAttachmentParams()
+			modify(&params)
+
+			// Act
+			res, resErr := domain.NewAttachment("attachmentId", params)
+
+			// Assert
+			assert.Nil(t, res, "Result should be nil.")
+			assert.NotNil(t, resErr, "Error should not be nil.")
+		})
+	}
+}
+
+func TestIsImageContentType_ReturnsWhetherThumbnailCouldBeMade(t *testing.T) {
+	t.Parallel()
+	// Assert
+	assert.True(t, domain.IsImageContentType("image/png"))
+	assert.False(t, domain.IsImageContentType("application/pdf"))
+	assert.False(t, domain.IsImageContentType("text/plain"))
+}
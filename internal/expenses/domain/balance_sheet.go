@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Balance represents a net position of a household member, positive balances are owed to the member.
+type Balance struct {
+	User string
+	Net  decimal.Decimal
+}
+
+// Debt represents a payment which settles balances up.
+type Debt struct {
+	From   string
+	To     string
+	Amount decimal.Decimal
+}
+
+// BalanceSheet represents who owes whom across shared expenses and settlements.
+type BalanceSheet struct {
+	Currency Currency
+	Balances []Balance
+	Debts    []Debt
+}
+
+// NewBalanceSheet calculates balances of shared expenses and settlements in the currency. Amounts are
+// converted using exchange rates of their dates, debts settle balances up with the fewest payments
+// the greedy matching of the largest balances finds.
+func NewBalanceSheet(
+	currency Currency,
+	expenses []Expense,
+	settlements []Settlement,
+	rates []ExchangeRates,
+) (*BalanceSheet, error) {
+	converter := newBalanceConverter(currency, rates)
+	nets := make(map[string]decimal.Decimal)
+
+	for _, expense := range expenses {
+		if expense.split == nil {
+			continue
+		}
+		amounts, amountsErr := expense.SplitAmounts()
+		if amountsErr != nil {
+			return nil, errors.Wrapf(amountsErr, "split expense %s", expense.id)
+		}
+		for _, amount := range amounts {
+			converted, convertErr := converter.convert(amount.Amount, Currency(expense.currency), expense.date)
+			if convertErr != nil {
+				return nil, convertErr
+			}
+			nets[*expense.paidBy] = nets[*expense.paidBy].Add(converted)
+			nets[amount.User] = nets[amount.User].Sub(converted)
+		}
+	}
+
+	for _, settlement := range settlements {
+		converted, convertErr := converter.convert(settlement.amount, settlement.currency, settlement.date)
+		if convertErr != nil {
+			return nil, convertErr
+		}
+		nets[settlement.from] = nets[settlement.from].Add(converted)
+		nets[settlement.to] = nets[settlement.to].Sub(converted)
+	}
+
+	balances := make([]Balance, 0, len(nets))
+	for user, net := range nets {
+		balances = append(balances, Balance{User: user, Net: net.Round(splitPrecision)})
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].User < balances[j].User
+	})
+
+	return &BalanceSheet{
+		Currency: currency,
+		Balances: balances,
+		Debts:    settleUp(balances),
+	}, nil
+}
+
+// settleUp matches members owing the most with members owed the most, ties go in user order.
+func settleUp(balances []Balance) []Debt {
+	debtors := make([]Balance, 0, len(balances))
+	creditors := make([]Balance, 0, len(balances))
+	for _, balance := range balances {
+		if balance.Net.IsNegative() {
+			debtors = append(debtors, Balance{User: balance.User, Net: balance.Net.Neg()})
+		} else if balance.Net.IsPositive() {
+			creditors = append(creditors, balance)
+		}
+	}
+	byNet := func(members []Balance) func(i, j int) bool {
+		return func(i, j int) bool {
+			return members[i].Net.GreaterThan(members[j].Net)
+		}
+	}
+	sort.SliceStable(debtors, byNet(debtors))
+	sort.SliceStable(creditors, byNet(creditors))
+
+	debts := make([]Debt, 0)
+	for d, c := 0, 0; d < len(debtors) && c < len(creditors); {
+		amount := decimal.Min(debtors[d].Net, creditors[c].Net)
+		debts = append(debts, Debt{From: debtors[d].User, To: creditors[c].User, Amount: amount})
+
+		debtors[d].Net = debtors[d].Net.Sub(amount)
+		creditors[c].Net = creditors[c].Net.Sub(amount)
+		if !debtors[d].Net.IsPositive() {
+			d++
+		}
+		if !creditors[c].Net.IsPositive() {
+			c++
+		}
+	}
+
+	return debts
+}
+
+// balanceConverter converts amounts into the balance currency using exchange rates of the day.
+type balanceConverter struct {
+	currency Currency
+	dayRates map[time.Time]ExchangeRates
+}
+
+func newBalanceConverter(currency Currency, rates []ExchangeRates) balanceConverter {
+	dayRates := make(map[time.Time]ExchangeRates, len(rates))
+	for _, rate := range rates {
+		dayRates[truncateToDay(rate.Date())] = rate
+	}
+	return balanceConverter{
+		currency: currency,
+		dayRates: dayRates,
+	}
+}
+
+func (c balanceConverter) convert(amount decimal.Decimal, currency Currency, date time.Time) (decimal.Decimal, error) {
+	if currency == c.currency {
+		return amount, nil
+	}
+
+	if dayRate, ok := c.dayRates[truncateToDay(date)]; ok {
+		if rates := dayRate.inCurrency(c.currency); rates != nil {
+			if rate, ok := rates.rates[currency]; ok {
+				return amount.Div(rate), nil
+			}
+		}
+	}
+
+	return decimal.Zero, errors.Wrapf(ErrExchangeRateNotFound, "%s to %s on %s",
+		currency, c.currency, date.Format("2006-01-02"))
+}
@@ -0,0 +1,88 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newSharedExpense(t *testing.T, price float64, currency string, date time.Time, paidBy string,
+	params domain.SplitParams) domain.Expense {
+	t.Helper()
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	split, splitErr := domain.NewSplit(params)
+	assert.Nil(t, splitErr)
+	expense, expenseErr := domain.NewExpense("", *category, price, currency, 1, nil, nil, date,
+		domain.SetSplit(paidBy, *split))
+	assert.Nil(t, expenseErr)
+	return *expense
+}
+
+func debtString(debt domain.Debt) string {
+	return debt.From + " " + debt.To + " " + debt.Amount.String()
+}
+
+func TestNewBalanceSheet_SharedExpenses_CalculatesBalancesAndDebts(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	everyone := domain.SplitParams{
+		Method: "equal",
+		Shares: []domain.SplitShareParams{{User: "alice"}, {User: "bob"}, {User: "carol"}},
+	}
+	groceries := newSharedExpense(t, 90, "EUR", utcDate(2021, time.July, 1), "alice", everyone)
+	dinner := newSharedExpense(t, 60, "USD", utcDate(2021, time.July, 2), "bob", everyone)
+	settlement, _ := domain.NewSettlement("", domain.SettlementParams{
+		From: "carol", To: "alice", Amount: 10, Currency: "EUR", Date: utcDate(2021, time.July, 3),
+	})
+	rates, _ := domain.NewExchageRate(utcDate(2021, time.July, 2), "EUR", map[string]float64{"USD": 2})
+
+	// Act
+	res, resErr := domain.NewBalanceSheet("EUR", []domain.Expense{groceries, dinner},
+		[]domain.Settlement{*settlement}, []domain.ExchangeRates{*rates})
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, domain.Currency("EUR"), res.Currency)
+	balances := make(map[string]string, len(res.Balances))
+	for _, balance := range res.Balances {
+		balances[balance.User] = balance.Net.String()
+	}
+	assert.Equal(t, map[string]string{"alice": "40", "bob": "-10", "carol": "-30"}, balances,
+		"alice: 90-30-10-10, bob: 30-30-10, carol: -30-10+10.")
+	assert.Equal(t, "alice", res.Balances[0].User, "Balances should be sorted by user.")
+	assert.Len(t, res.Debts, 2)
+	assert.Equal(t, "carol alice 30", debtString(res.Debts[0]), "The largest debt should be settled first.")
+	assert.Equal(t, "bob alice 10", debtString(res.Debts[1]))
+}
+
+func TestNewBalanceSheet_NoExchangeRate_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	split := domain.SplitParams{Method: "equal", Shares: []domain.SplitShareParams{{User: "alice"}, {User: "bob"}}}
+	expense := newSharedExpense(t, 60, "USD", utcDate(2021, time.July, 2), "bob", split)
+
+	// Act
+	res, resErr := domain.NewBalanceSheet("EUR", []domain.Expense{expense}, nil, nil)
+
+	// Assert
+	assert.ErrorIs(t, resErr, domain.ErrExchangeRateNotFound)
+	assert.Nil(t, res)
+}
+
+func TestNewBalanceSheet_PersonalExpenses_AreSkipped(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, _ := domain.NewExpense("", *category, 10, "USD", 1, nil, nil, utcDate(2021, time.July, 2))
+
+	// Act
+	res, resErr := domain.NewBalanceSheet("EUR", []domain.Expense{*expense}, nil, nil)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Empty(t, res.Balances)
+	assert.Empty(t, res.Debts)
+}
@@ -0,0 +1,178 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Defines values for BudgetPeriod.
+const (
+	BudgetPeriodMonthly BudgetPeriod = "monthly"
+
+	BudgetPeriodYearly BudgetPeriod = "yearly"
+)
+
+// BudgetPeriod defines how often a budget renews.
+type BudgetPeriod string
+
+// BudgetParams holds raw budget values.
+type BudgetParams struct {
+	CategoryID string
+	Period     string
+	Amount     float64
+	Currency   *string
+	Rollover   bool
+	Start      time.Time
+}
+
+// Budget represents a spending limit of a category subtree renewing every period.
+type Budget struct {
+	id         string
+	categoryID string
+	period     BudgetPeriod
+	amount     decimal.Decimal
+	currency   *Currency
+	rollover   bool
+	start      time.Time
+}
+
+// NewBudget instantiates budget. The start date is aligned to the beginning of its calendar period,
+// the budget starts with the period containing the start date.
+func NewBudget(id string, params BudgetParams) (*Budget, error) {
+	if len(strings.TrimSpace(params.CategoryID)) == 0 {
+		return nil, errors.New("empty category")
+	}
+
+	period := BudgetPeriod(params.Period)
+	switch period {
+	case BudgetPeriodMonthly, BudgetPeriodYearly:
+	default:
+		return nil, fmt.Errorf("unknown budget period %s", params.Period)
+	}
+
+	if params.Amount <= 0 {
+		return nil, errors.New("amount should be grater than zero")
+	}
+
+	var currency *Currency
+	if trimmed := trimmedOrNil(params.Currency); trimmed != nil {
+		value := Currency(*trimmed)
+		currency = &value
+	}
+
+	if params.Start.IsZero() {
+		return nil, errors.New("empty start date")
+	}
+
+	budget := &Budget{
+		id:         id,
+		categoryID: params.CategoryID,
+		period:     period,
+		amount:     decimal.NewFromFloat(params.Amount),
+		currency:   currency,
+		rollover:   params.Rollover,
+	}
+	budget.start = budget.PeriodRange(params.Start, DefaultPeriodStartDay).From()
+
+	return budget, nil
+}
+
+// ID returns budget id.
+func (b Budget) ID() string {
+	return b.id
+}
+
+// CategoryID returns an id of the category subtree the budget limits.
+func (b Budget) CategoryID() string {
+	return b.categoryID
+}
+
+// Period returns budget period.
+func (b Budget) Period() BudgetPeriod {
+	return b.period
+}
+
+// Amount returns budget amount per period.
+func (b Budget) Amount() float64 {
+	amount, _ := b.amount.Float64()
+	return amount
+}
+
+// Currency returns budget currency, it is nil for budgets in the report currency of the user.
+func (b Budget) Currency() *Currency {
+	return b.currency
+}
+
+// TrackedCurrency returns a currency spending is tracked in, budgets without a currency
+// are tracked in the report currency.
+func (b Budget) TrackedCurrency(reportCurrency Currency) Currency {
+	if b.currency == nil {
+		return reportCurrency
+	}
+	return *b.currency
+}
+
+// Rollover indicates whether unused amount is carried over to the next period.
+func (b Budget) Rollover() bool {
+	return b.rollover
+}
+
+// Start returns the beginning of the first budget period.
+func (b Budget) Start() time.Time {
+	return b.start
+}
+
+// IsActive indicates whether the budget has started by the end of the period of the day.
+func (b Budget) IsActive(date time.Time, periodStartDay int) bool {
+	return !b.PeriodRange(date, periodStartDay).To().Before(b.start)
+}
+
+// PeriodRange returns the budget period containing the day. Months and years begin on the period start day,
+// the same way report intervals do.
+func (b Budget) PeriodRange(date time.Time, periodStartDay int) DateRange {
+	interval := IntervalMonth
+	if b.period == BudgetPeriodYearly {
+		interval = IntervalYear
+	}
+	from := intervalDate(date.UTC(), interval, periodStartDay)
+
+	return DateRange{
+		from: from,
+		to:   nextIntervalDate(from, interval).Add(-time.Nanosecond),
+	}
+}
+
+// Periods returns budget periods the status of the day depends on. Budgets with rollover
+// depend on every period since the start, other budgets depend on the period of the day only.
+func (b Budget) Periods(date time.Time, periodStartDay int) []DateRange {
+	last := b.PeriodRange(date, periodStartDay)
+	if !b.rollover || !b.IsActive(date, periodStartDay) {
+		return []DateRange{last}
+	}
+
+	periods := make([]DateRange, 0)
+	for period := b.PeriodRange(b.start, periodStartDay); !period.From().After(last.From()); {
+		periods = append(periods, period)
+		period = b.PeriodRange(period.To().Add(time.Nanosecond), periodStartDay)
+	}
+
+	return periods
+}
+
+// Covers indicates whether a budget period holds whole report intervals, so the budget
+// figures could be shown alongside interval expenses. Budget periods begin on the same period start day
+// as report intervals, weeks spanning two periods are not covered.
+func (b Budget) Covers(interval Interval) bool {
+	switch interval {
+	case IntervalDay, IntervalMonth:
+		return true
+	case IntervalQuarter, IntervalYear:
+		return b.period == BudgetPeriodYearly
+	default:
+		return false
+	}
+}
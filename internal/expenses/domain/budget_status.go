@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// BudgetStatus represents budget figures of a single period.
+type BudgetStatus struct {
+	Budget      Budget
+	From        time.Time
+	To          time.Time
+	Currency    Currency
+	Amount      decimal.Decimal
+	RolledOver  decimal.Decimal
+	Spent       decimal.Decimal
+	Remaining   decimal.Decimal
+	PercentUsed decimal.Decimal
+}
+
+// NewBudgetStatus calculates budget figures of the period in the currency spending is tracked in. The amount
+// available in the period is the budget amount increased by the amount rolled over from previous periods.
+func NewBudgetStatus(
+	budget Budget,
+	period DateRange,
+	currency Currency,
+	rolledOver decimal.Decimal,
+	spent decimal.Decimal,
+) BudgetStatus {
+	amount := budget.amount.Add(rolledOver)
+	percentUsed := decimal.Zero
+	if amount.IsPositive() {
+		percentUsed = spent.Div(amount).Mul(decimal.NewFromInt(100)).Round(2)
+	}
+
+	return BudgetStatus{
+		Budget:      budget,
+		From:        period.from,
+		To:          period.to,
+		Currency:    currency,
+		Amount:      amount,
+		RolledOver:  rolledOver,
+		Spent:       spent,
+		Remaining:   amount.Sub(spent),
+		PercentUsed: percentUsed,
+	}
+}
+
+// Contains indicates whether the status period contains the day.
+func (s BudgetStatus) Contains(date time.Time) bool {
+	return !date.Before(s.From) && !date.After(s.To)
+}
+
+// convertedInto returns budget figures converted into the currency with exchange rates based on
+// the status currency, the percent used is kept.
+func (s BudgetStatus) convertedInto(currency Currency, rates ExchangeRates) BudgetStatus {
+	rate := rates.rates[currency]
+	s.Currency = currency
+	s.Amount = s.Amount.Mul(rate).Round(2)
+	s.RolledOver = s.RolledOver.Mul(rate).Round(2)
+	s.Spent = s.Spent.Mul(rate).Round(2)
+	s.Remaining = s.Remaining.Mul(rate).Round(2)
+	return s
+}
+
+// Unused returns amount left unspent in the period, overspending is not carried over.
+func (s BudgetStatus) Unused() decimal.Decimal {
+	if s.Remaining.IsNegative() {
+		return decimal.Zero
+	}
+	return s.Remaining
+}
@@ -0,0 +1,50 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewBudgetStatus_CalculatesFigures(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+	period := budget.PeriodRange(utcDate(2021, time.February, 10), 1)
+
+	// Act
+	res := domain.NewBudgetStatus(*budget, period, "USD", decimal.NewFromInt(50), decimal.NewFromInt(50))
+
+	// Assert
+	assert.Equal(t, period.From(), res.From)
+	assert.Equal(t, period.To(), res.To)
+	assert.Equal(t, domain.Currency("USD"), res.Currency)
+	assert.True(t, decimal.NewFromInt(150).Equal(res.Amount))
+	assert.True(t, decimal.NewFromInt(100).Equal(res.Remaining))
+	assert.Equal(t, "33.33", res.PercentUsed.String())
+	assert.True(t, res.Contains(utcDate(2021, time.February, 28)))
+	assert.False(t, res.Contains(utcDate(2021, time.March, 1)))
+}
+
+func TestBudgetStatus_Unused_OverspentReturnsZero(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+	period := budget.PeriodRange(utcDate(2021, time.January, 10), 1)
+
+	// Act
+	res := domain.NewBudgetStatus(*budget, period, domain.DefaultReportCurrency, decimal.Zero, decimal.NewFromInt(120))
+
+	// Assert
+	assert.True(t, decimal.NewFromInt(-20).Equal(res.Remaining))
+	assert.True(t, decimal.NewFromInt(120).Equal(res.PercentUsed))
+	assert.True(t, res.Unused().IsZero())
+}
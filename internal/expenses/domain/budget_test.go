@@ -0,0 +1,210 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewBudget_ValidParams_AlignsStartToPeriod(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	currency := " USD "
+	params := domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     "yearly",
+		Amount:     1200,
+		Currency:   &currency,
+		Rollover:   true,
+		Start:      time.Date(2021, time.March, 10, 15, 30, 0, 0, time.UTC),
+	}
+
+	// Act
+	res, resErr := domain.NewBudget("budgetId", params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "budgetId", res.ID())
+	assert.Equal(t, "categoryId", res.CategoryID())
+	assert.Equal(t, domain.BudgetPeriodYearly, res.Period())
+	assert.Equal(t, 1200.0, res.Amount())
+	assert.Equal(t, domain.Currency("USD"), *res.Currency())
+	assert.Equal(t, domain.Currency("USD"), res.TrackedCurrency(domain.DefaultReportCurrency))
+	assert.True(t, res.Rollover())
+	assert.Equal(t, utcDate(2021, time.January, 1), res.Start())
+}
+
+func TestNewBudget_NoCurrency_TracksReportCurrency(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     "monthly",
+		Amount:     100,
+		Start:      utcDate(2021, time.March, 10),
+	}
+
+	// Act
+	res, resErr := domain.NewBudget("budgetId", params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Nil(t, res.Currency())
+	assert.Equal(t, domain.Currency("USD"), res.TrackedCurrency("USD"))
+	assert.Equal(t, utcDate(2021, time.March, 1), res.Start())
+}
+
+func TestNewBudget_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	valid := domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     "monthly",
+		Amount:     100,
+		Start:      utcDate(2021, time.March, 10),
+	}
+	tests := map[string]func(params *domain.BudgetParams){
+		"empty category":  func(params *domain.BudgetParams) { params.CategoryID = " " },
+		"unknown period":  func(params *domain.BudgetParams) { params.Period = "weekly" },
+		"zero amount":     func(params *domain.BudgetParams) { params.Amount = 0 },
+		"negative amount": func(params *domain.BudgetParams) { params.Amount = -10 },
+		"empty start":     func(params *domain.BudgetParams) { params.Start = time.Time{} },
+	}
+
+	for name, modify := range tests {
+		modify := modify
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			params := valid
+			modify(&params)
+
+			// Act
+			res, resErr := domain.NewBudget("budgetId", params)
+
+			// Assert
+			assert.Nil(t, res)
+			assert.NotNil(t, resErr)
+		})
+	}
+}
+
+func TestBudget_PeriodRange_ReturnsPeriodOfTheDay(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	monthly, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+	yearly, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "yearly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+	day := time.Date(2021, time.February, 10, 12, 0, 0, 0, time.UTC)
+
+	// Act
+	monthRange := monthly.PeriodRange(day, 1)
+	yearRange := yearly.PeriodRange(day, 1)
+
+	// Assert
+	assert.Equal(t, utcDate(2021, time.February, 1), monthRange.From())
+	assert.Equal(t, utcDate(2021, time.March, 1).Add(-time.Nanosecond), monthRange.To())
+	assert.Equal(t, utcDate(2021, time.January, 1), yearRange.From())
+	assert.Equal(t, utcDate(2022, time.January, 1).Add(-time.Nanosecond), yearRange.To())
+}
+
+func TestBudget_PeriodRange_PeriodStartDay_ReturnsShiftedPeriod(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	monthly, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+	yearly, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "yearly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+
+	// Act
+	beforePayday := monthly.PeriodRange(utcDate(2021, time.February, 10), 25)
+	afterPayday := monthly.PeriodRange(utcDate(2021, time.February, 25), 25)
+	yearRange := yearly.PeriodRange(utcDate(2021, time.January, 10), 25)
+
+	// Assert
+	assert.Equal(t, utcDate(2021, time.January, 25), beforePayday.From())
+	assert.Equal(t, utcDate(2021, time.February, 25).Add(-time.Nanosecond), beforePayday.To())
+	assert.Equal(t, utcDate(2021, time.February, 25), afterPayday.From())
+	assert.Equal(t, utcDate(2021, time.March, 25).Add(-time.Nanosecond), afterPayday.To())
+	assert.Equal(t, utcDate(2020, time.January, 25), yearRange.From())
+	assert.Equal(t, utcDate(2021, time.January, 25).Add(-time.Nanosecond), yearRange.To())
+}
+
+func TestBudget_IsActive_ChecksStart(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	budget, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.March, 20),
+	})
+
+	// Act & Assert
+	assert.False(t, budget.IsActive(utcDate(2021, time.February, 28), 1))
+	assert.True(t, budget.IsActive(utcDate(2021, time.March, 1), 1))
+	assert.True(t, budget.IsActive(utcDate(2021, time.April, 1), 1))
+}
+
+func TestBudget_Periods_RolloverReturnsPeriodsSinceStart(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	rollover, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Rollover: true, Start: utcDate(2021, time.January, 5),
+	})
+	single, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.January, 5),
+	})
+	day := utcDate(2021, time.March, 15)
+
+	// Act
+	rolloverPeriods := rollover.Periods(day, 1)
+	singlePeriods := single.Periods(day, 1)
+
+	// Assert
+	assert.Len(t, rolloverPeriods, 3)
+	assert.Equal(t, utcDate(2021, time.January, 1), rolloverPeriods[0].From())
+	assert.Equal(t, utcDate(2021, time.March, 1), rolloverPeriods[2].From())
+	assert.Len(t, singlePeriods, 1)
+	assert.Equal(t, utcDate(2021, time.March, 1), singlePeriods[0].From())
+}
+
+func TestBudget_Periods_PeriodStartDay_ReturnsShiftedPeriodsSinceStart(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	budget, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Rollover: true, Start: utcDate(2021, time.January, 5),
+	})
+
+	// Act
+	periods := budget.Periods(utcDate(2021, time.March, 26), 25)
+
+	// Assert
+	assert.Len(t, periods, 4, "The period containing the start date should be the first one.")
+	assert.Equal(t, utcDate(2020, time.December, 25), periods[0].From())
+	assert.Equal(t, utcDate(2021, time.March, 25), periods[3].From())
+}
+
+func TestBudget_Covers_ChecksReportInterval(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	monthly, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+	yearly, _ := domain.NewBudget("", domain.BudgetParams{
+		CategoryID: "categoryId", Period: "yearly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+
+	// Act & Assert
+	assert.True(t, monthly.Covers(domain.IntervalDay))
+	assert.True(t, monthly.Covers(domain.IntervalMonth))
+	assert.False(t, monthly.Covers(domain.IntervalYear))
+	assert.True(t, yearly.Covers(domain.IntervalYear))
+	assert.False(t, monthly.Covers(domain.IntervalWeek))
+	assert.False(t, monthly.Covers(domain.IntervalQuarter))
+	assert.True(t, yearly.Covers(domain.IntervalQuarter))
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// BudgetTracker calculates budget figures from expenses of budget periods.
+type BudgetTracker struct {
+	expenses       []Expense
+	rates          map[time.Time]ExchangeRates
+	periodStartDay int
+	currency       Currency
+}
+
+// NewBudgetTracker instantiates budget tracker with expenses and exchange rates of budget periods.
+// Budget periods begin on the period start day, budget figures are reported in the currency.
+func NewBudgetTracker(
+	expenses []Expense,
+	rates []ExchangeRates,
+	periodStartDay int,
+	currency Currency,
+) BudgetTracker {
+	dateRates := make(map[time.Time]ExchangeRates, len(rates))
+	for _, rate := range rates {
+		dateRates[truncateToDay(rate.Date())] = rate
+	}
+
+	return BudgetTracker{
+		expenses:       expenses,
+		rates:          dateRates,
+		periodStartDay: periodStartDay,
+		currency:       currency,
+	}
+}
+
+// Status returns budget figures of the period containing the day. Spending is tracked in the budget currency,
+// the figures are converted into the report currency with exchange rates of the period beginning.
+// Figures stay in the budget currency when the rates are missing.
+func (t BudgetTracker) Status(budget Budget, date time.Time) BudgetStatus {
+	currency := budget.TrackedCurrency(t.currency)
+	var status BudgetStatus
+	rolledOver := decimal.Zero
+	for _, period := range budget.Periods(date, t.periodStartDay) {
+		status = NewBudgetStatus(budget, period, currency, rolledOver, t.spent(budget, period, currency))
+		if budget.rollover {
+			rolledOver = status.Unused()
+		}
+	}
+
+	if currency == t.currency {
+		return status
+	}
+	rates := t.rate(status.From, currency)
+	if rates == nil {
+		return status
+	}
+	if _, ok := rates.rates[t.currency]; !ok {
+		return status
+	}
+	return status.convertedInto(t.currency, *rates)
+}
+
+// spent returns the category subtree total of the period in the currency.
+func (t BudgetTracker) spent(budget Budget, period DateRange, currency Currency) decimal.Decimal {
+	expenses := make([]Expense, 0)
+	for _, expense := range t.expenses {
+		if expense.date.Before(period.from) || expense.date.After(period.to) {
+			continue
+		}
+		expense.CalculateTotal(t.rate(expense.date, currency))
+		expenses = append(expenses, expense)
+	}
+
+	root := buildCategoryHierarchy(buildCategoryFlatMap(expenses))
+	categoryExpenses := root.Find(budget.categoryID)
+	if categoryExpenses == nil {
+		return decimal.Zero
+	}
+
+	return categoryExpenses.CalculateTotal().Sum(currency)
+}
+
+// rate returns exchange rates of the day based on the currency, if the currency could be converted.
+func (t BudgetTracker) rate(date time.Time, currency Currency) *ExchangeRates {
+	rates, ok := t.rates[truncateToDay(date)]
+	if !ok {
+		return nil
+	}
+	return rates.inCurrency(currency)
+}
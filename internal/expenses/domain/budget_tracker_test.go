@@ -0,0 +1,116 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newBudgetCategories() (domain.Category, domain.Category) {
+	parentID := "foodId"
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	groceries, _ := domain.NewCategory("groceriesId", &parentID, "Groceries", nil, 2, "|foodId|groceriesId")
+	groceries.SetParents(&[]domain.Category{*food})
+	return *food, *groceries
+}
+
+func TestBudgetTracker_Status_IncludesSubcategoriesAndConvertsCurrencies(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, groceries := newBudgetCategories()
+	day := utcDate(2021, time.March, 10)
+	own, _ := domain.NewExpense("ownId", food, 20, "EUR", 1, nil, nil, day)
+	sub, _ := domain.NewExpense("subId", groceries, 30, "EUR", 1, nil, nil, day)
+	converted, _ := domain.NewExpense("usdId", groceries, 20, "USD", 1, nil, nil, day)
+	outside, _ := domain.NewExpense("outsideId", groceries, 100, "EUR", 1, nil, nil, utcDate(2021, time.April, 1))
+	rates, _ := domain.NewExchageRate(day, "EUR", map[string]float64{"USD": 2})
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "foodId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+	sut := domain.NewBudgetTracker([]domain.Expense{*own, *sub, *converted, *outside}, []domain.ExchangeRates{*rates}, 1,
+		domain.DefaultReportCurrency)
+
+	// Act
+	res := sut.Status(*budget, day)
+
+	// Assert
+	assert.True(t, decimal.NewFromInt(60).Equal(res.Spent), "Should sum subcategories in budget currency.")
+	assert.True(t, decimal.NewFromInt(40).Equal(res.Remaining))
+	assert.True(t, decimal.NewFromInt(60).Equal(res.PercentUsed))
+}
+
+func TestBudgetTracker_Status_RollsOverUnusedAmount(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, _ := newBudgetCategories()
+	january, _ := domain.NewExpense("januaryId", food, 40, "EUR", 1, nil, nil, utcDate(2021, time.January, 10))
+	february, _ := domain.NewExpense("februaryId", food, 200, "EUR", 1, nil, nil, utcDate(2021, time.February, 10))
+	march, _ := domain.NewExpense("marchId", food, 30, "EUR", 1, nil, nil, utcDate(2021, time.March, 10))
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "foodId", Period: "monthly", Amount: 100, Rollover: true, Start: utcDate(2021, time.January, 1),
+	})
+	sut := domain.NewBudgetTracker([]domain.Expense{*january, *february, *march}, nil, 1,
+		domain.DefaultReportCurrency)
+
+	// Act
+	february20 := sut.Status(*budget, utcDate(2021, time.February, 20))
+	march20 := sut.Status(*budget, utcDate(2021, time.March, 20))
+
+	// Assert
+	assert.True(t, decimal.NewFromInt(60).Equal(february20.RolledOver), "Should roll over unused amount.")
+	assert.True(t, decimal.NewFromInt(-40).Equal(february20.Remaining))
+	assert.True(t, march20.RolledOver.IsZero(), "Should not roll over overspending.")
+	assert.True(t, decimal.NewFromInt(70).Equal(march20.Remaining))
+}
+
+func TestBudgetTracker_Status_ConvertsFiguresIntoReportCurrency(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, _ := newBudgetCategories()
+	periodStart := utcDate(2021, time.March, 1)
+	day := utcDate(2021, time.March, 10)
+	expense, _ := domain.NewExpense("expenseId", food, 20, "EUR", 1, nil, nil, day)
+	startRates, _ := domain.NewExchageRate(periodStart, "EUR", map[string]float64{"USD": 2})
+	dayRates, _ := domain.NewExchageRate(day, "EUR", map[string]float64{"USD": 2})
+	currency := "USD"
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "foodId", Period: "monthly", Amount: 100, Currency: &currency, Start: periodStart,
+	})
+	sut := domain.NewBudgetTracker([]domain.Expense{*expense}, []domain.ExchangeRates{*startRates, *dayRates}, 1,
+		domain.DefaultReportCurrency)
+
+	// Act
+	res := sut.Status(*budget, day)
+
+	// Assert
+	assert.Equal(t, domain.DefaultReportCurrency, res.Currency, "Should report figures in the report currency.")
+	assert.True(t, decimal.NewFromInt(50).Equal(res.Amount))
+	assert.True(t, decimal.NewFromInt(20).Equal(res.Spent))
+	assert.True(t, decimal.NewFromInt(30).Equal(res.Remaining))
+	assert.True(t, decimal.NewFromInt(40).Equal(res.PercentUsed), "Should keep percent used in the budget currency.")
+}
+
+func TestBudgetTracker_Status_NoBudgetCurrency_TracksReportCurrency(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, _ := newBudgetCategories()
+	day := utcDate(2021, time.March, 10)
+	expense, _ := domain.NewExpense("expenseId", food, 20, "EUR", 1, nil, nil, day)
+	rates, _ := domain.NewExchageRate(day, "EUR", map[string]float64{"USD": 2})
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "foodId", Period: "monthly", Amount: 100, Start: utcDate(2021, time.January, 1),
+	})
+	sut := domain.NewBudgetTracker([]domain.Expense{*expense}, []domain.ExchangeRates{*rates}, 1, "USD")
+
+	// Act
+	res := sut.Status(*budget, day)
+
+	// Assert
+	assert.Equal(t, domain.Currency("USD"), res.Currency)
+	assert.True(t, decimal.NewFromInt(40).Equal(res.Spent), "Should track spending in the report currency.")
+	assert.True(t, decimal.NewFromInt(60).Equal(res.Remaining))
+}
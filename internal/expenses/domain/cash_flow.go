@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CashFlowPeriod represents income and expenses of a report interval.
+type CashFlowPeriod struct {
+	Date     time.Time
+	Income   GrandTotal
+	Expenses GrandTotal
+	Net      Total
+	// SavingsRate is a percentage of the income left after expenses, it is nil for periods without income.
+	SavingsRate *decimal.Decimal
+}
+
+// CashFlowReport represents income, expenses and net per interval in the report currency.
+type CashFlowReport struct {
+	Report
+	Currency    Currency
+	Periods     []CashFlowPeriod
+	Income      GrandTotal
+	Expenses    GrandTotal
+	Net         Total
+	SavingsRate *decimal.Decimal
+}
+
+// NewCashFlowReport generates cash flow report. Incomes and expenses are bucketed by the interval
+// and converted into the currency using exchange rates of their dates like expense reports are.
+func NewCashFlowReport(
+	incomes []Income,
+	expenses []Expense,
+	filter ExpenseFilter,
+	rates []ExchangeRates,
+	currency Currency,
+) CashFlowReport {
+	dateRatesMap := make(map[time.Time]ExchangeRates)
+	for _, rate := range rates {
+		dateRatesMap[rate.Date()] = rate
+	}
+
+	periods := make(map[time.Time]*CashFlowPeriod)
+	period := func(date time.Time) *CashFlowPeriod {
+		date = intervalDate(date, filter.Interval(), filter.PeriodStartDay())
+		if _, ok := periods[date]; !ok {
+			periods[date] = &CashFlowPeriod{Date: date}
+		}
+		return periods[date]
+	}
+
+	for _, income := range incomes {
+		rate := dateRatesMap[income.date].ChangeBaseCurrency(currency)
+		incomePeriod := period(income.date)
+		incomePeriod.Income = incomePeriod.Income.Add(income.CalculateTotal(&rate))
+	}
+	for _, expense := range expenses {
+		rate := dateRatesMap[expense.date].ChangeBaseCurrency(currency)
+		expensePeriod := period(expense.date)
+		expensePeriod.Expenses = expensePeriod.Expenses.Add(expense.CalculateTotal(&rate))
+	}
+
+	report := CashFlowReport{
+		Report: Report{
+			From: filter.From(),
+			To:   filter.To(),
+		},
+		Currency: currency,
+		Periods:  make([]CashFlowPeriod, 0, len(periods)),
+	}
+	for _, cashFlowPeriod := range periods {
+		cashFlowPeriod.Net, cashFlowPeriod.SavingsRate = netCashFlow(cashFlowPeriod.Income, cashFlowPeriod.Expenses, currency)
+		report.Periods = append(report.Periods, *cashFlowPeriod)
+		report.Income = report.Income.Combine(cashFlowPeriod.Income)
+		report.Expenses = report.Expenses.Combine(cashFlowPeriod.Expenses)
+	}
+	sort.Slice(report.Periods, func(i, j int) bool {
+		return report.Periods[i].Date.Before(report.Periods[j].Date)
+	})
+	report.Net, report.SavingsRate = netCashFlow(report.Income, report.Expenses, currency)
+
+	return report
+}
+
+// netCashFlow returns income left after expenses and its percentage of the income in the currency.
+func netCashFlow(income GrandTotal, expenses GrandTotal, currency Currency) (Total, *decimal.Decimal) {
+	incomeSum := income.Sum(currency)
+	net := Total{
+		Sum:      incomeSum.Sub(expenses.Sum(currency)),
+		Currency: currency,
+	}
+	if !incomeSum.IsPositive() {
+		return net, nil
+	}
+
+	savingsRate := net.Sum.Div(incomeSum).Mul(decimal.NewFromInt(100)).Round(2)
+	return net, &savingsRate
+}
@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newCashFlowIncome(amount float64, currency string, date time.Time) domain.Income {
+	income, _ := domain.NewIncome("", domain.IncomeParams{
+		Source: "ACME", CategoryID: "salaryId", Amount: amount, Currency: currency, Date: date,
+	})
+	return *income
+}
+
+func newCashFlowExpense(price float64, currency string, date time.Time) domain.Expense {
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, _ := domain.NewExpense("", *category, price, currency, 1, nil, nil, date)
+	return *expense
+}
+
+func TestNewCashFlowReport_BucketsByIntervalAndConvertsCurrencies(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	july, august := utcDate(2021, time.July, 5), utcDate(2021, time.August, 3)
+	julyRates, _ := domain.NewExchageRate(july, "USD", map[string]float64{"EUR": 0.5})
+	augustRates, _ := domain.NewExchageRate(august, "USD", map[string]float64{"EUR": 0.5})
+	filter, _ := domain.NewExpenseFilter(utcDate(2021, time.July, 1), utcDate(2021, time.September, 1), "month")
+	incomes := []domain.Income{
+		newCashFlowIncome(1000, "EUR", august),
+		newCashFlowIncome(1000, "EUR", july),
+		newCashFlowIncome(400, "USD", july),
+	}
+	expenses := []domain.Expense{
+		newCashFlowExpense(300, "EUR", july),
+		newCashFlowExpense(200, "USD", july),
+		newCashFlowExpense(1100, "EUR", august),
+	}
+
+	// Act
+	res := domain.NewCashFlowReport(incomes, expenses, *filter,
+		[]domain.ExchangeRates{*julyRates, *augustRates}, domain.DefaultReportCurrency)
+
+	// Assert
+	assert.Len(t, res.Periods, 2)
+	assert.Equal(t, domain.DefaultReportCurrency, res.Currency)
+
+	julyPeriod := res.Periods[0]
+	assert.Equal(t, utcDate(2021, time.July, 1), julyPeriod.Date, "Periods should be sorted by date.")
+	assert.True(t, decimal.NewFromInt(1200).Equal(julyPeriod.Income.Sum(domain.DefaultReportCurrency)))
+	assert.True(t, decimal.NewFromInt(400).Equal(julyPeriod.Expenses.Sum(domain.DefaultReportCurrency)))
+	assert.True(t, decimal.NewFromInt(800).Equal(julyPeriod.Net.Sum))
+	assert.Equal(t, "66.67", julyPeriod.SavingsRate.String())
+
+	augustPeriod := res.Periods[1]
+	assert.True(t, decimal.NewFromInt(-100).Equal(augustPeriod.Net.Sum))
+	assert.Equal(t, "-10", augustPeriod.SavingsRate.String())
+
+	assert.True(t, decimal.NewFromInt(700).Equal(res.Net.Sum))
+	assert.Equal(t, "31.82", res.SavingsRate.String())
+}
+
+func TestNewCashFlowReport_NoIncome_ReturnsNilSavingsRate(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := utcDate(2021, time.July, 5)
+	filter, _ := domain.NewExpenseFilter(utcDate(2021, time.July, 1), utcDate(2021, time.August, 1), "day")
+
+	// Act
+	res := domain.NewCashFlowReport(nil, []domain.Expense{newCashFlowExpense(20, "EUR", date)}, *filter, nil,
+		domain.DefaultReportCurrency)
+
+	// Assert
+	assert.Len(t, res.Periods, 1)
+	assert.Equal(t, date, res.Periods[0].Date)
+	assert.True(t, decimal.NewFromInt(-20).Equal(res.Periods[0].Net.Sum))
+	assert.Nil(t, res.Periods[0].SavingsRate, "Savings rate should be nil without income.")
+	assert.Nil(t, res.SavingsRate, "Savings rate should be nil without income.")
+}
@@ -1,11 +1,15 @@
 package domain
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
 )
 
+// InboxCategoryName is a name of the category holding imported expenses that could not be categorized.
+const InboxCategoryName string = "Uncategorized"
+
 // Category represents category.
 type Category struct {
 	id       string
@@ -14,6 +18,7 @@ type Category struct {
 	icon     *string
 	level    int
 	path     string
+	income   bool
 	parents  *[]Category
 }
 
@@ -62,6 +67,16 @@ func (c Category) Path() string {
 	return c.path
 }
 
+// IsIncome indicates if category belongs to the income category tree.
+func (c Category) IsIncome() bool {
+	return c.income
+}
+
+// SetIncome sets whether category belongs to the income category tree.
+func (c *Category) SetIncome(income bool) {
+	c.income = income
+}
+
 // Parents returns category parents.
 func (c Category) Parents() *[]Category {
 	return c.parents
@@ -76,3 +91,21 @@ func (c *Category) SetParents(parents *[]Category) {
 func (c Category) IsRoot() bool {
 	return c.parentID == nil || *c.parentID == ""
 }
+
+// PathNames returns names of the category parents followed by the category name, top level first.
+func (c Category) PathNames() []string {
+	ancestors := make([]Category, 0)
+	if c.parents != nil {
+		ancestors = append(ancestors, *c.parents...)
+	}
+	sort.SliceStable(ancestors, func(i, j int) bool {
+		return ancestors[i].level < ancestors[j].level
+	})
+
+	names := make([]string, 0, len(ancestors)+1)
+	for _, ancestor := range ancestors {
+		names = append(names, ancestor.name)
+	}
+
+	return append(names, c.name)
+}
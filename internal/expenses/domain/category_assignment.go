@@ -0,0 +1,7 @@
+package domain
+
+// CategoryAssignment holds a category to assign to an expense.
+type CategoryAssignment struct {
+	ExpenseID  string
+	CategoryID string
+}
@@ -1,11 +1,14 @@
 package domain
 
+import "time"
+
 // CategoryExpenses holds category expenses.
 type CategoryExpenses struct {
 	Category      Category
 	Expenses      *[]Expense
 	SubCategories []*CategoryExpenses
 	GrandTotal    GrandTotal
+	Budget        *BudgetStatus
 }
 
 // CalculateTotal calculates category expenses total.
@@ -25,3 +28,30 @@ func (c *CategoryExpenses) CalculateTotal() GrandTotal {
 	c.GrandTotal = grandTotal
 	return c.GrandTotal
 }
+
+// Find returns expenses of the category from the subtree.
+func (c *CategoryExpenses) Find(categoryID string) *CategoryExpenses {
+	if c.Category.id == categoryID {
+		return c
+	}
+	for _, subCategory := range c.SubCategories {
+		if found := subCategory.Find(categoryID); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// SetBudgets sets figures of category budgets in the subtree for the period containing the day.
+func (c *CategoryExpenses) SetBudgets(date time.Time, statuses []BudgetStatus) {
+	for i := range statuses {
+		if statuses[i].Budget.categoryID == c.Category.id && statuses[i].Contains(date) {
+			c.Budget = &statuses[i]
+			break
+		}
+	}
+	for _, subCategory := range c.SubCategories {
+		subCategory.SetBudgets(date, statuses)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -53,3 +54,36 @@ func TestCategoryExpenses_CalculateTotal_ReturnsTotalAmountIncludingChildren(t *
 	assert.True(t, total.OriginalTotal.Equal(res.SubTotals["EUR"].OriginalTotal))
 	assert.True(t, total.ConvertedTotal.Equal(res.Total))
 }
+
+func TestCategoryExpenses_SetBudgets_SetsStatusOfTheDayPeriod(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	parent, _ := NewCategory("parentId", nil, "parent", nil, 1, "|parentId")
+	child, _ := NewCategory("childId", nil, "child", nil, 2, "|parentId|childId")
+	budget, _ := NewBudget("budgetId", BudgetParams{
+		CategoryID: "childId", Period: "monthly", Amount: 100, Start: time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	})
+	july := budget.PeriodRange(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC), 1)
+	august := budget.PeriodRange(time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC), 1)
+	statuses := []BudgetStatus{
+		NewBudgetStatus(*budget, july, DefaultReportCurrency, decimal.Zero, decimal.Zero),
+		NewBudgetStatus(*budget, august, DefaultReportCurrency, decimal.Zero, decimal.Zero),
+	}
+
+	// SUT
+	sut := CategoryExpenses{
+		Category: *parent,
+		SubCategories: []*CategoryExpenses{
+			{Category: *child},
+		},
+	}
+
+	// Act
+	sut.SetBudgets(time.Date(2021, time.August, 10, 0, 0, 0, 0, time.UTC), statuses)
+
+	// Assert
+	assert.Nil(t, sut.Budget)
+	assert.Equal(t, &statuses[1], sut.SubCategories[0].Budget)
+	assert.Equal(t, sut.SubCategories[0], sut.Find("childId"))
+	assert.Nil(t, sut.Find("unknownId"))
+}
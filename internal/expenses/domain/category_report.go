@@ -0,0 +1,40 @@
+package domain
+
+import "time"
+
+// ReportByCategory represents report by category, it is the by date report transposed for trend analysis.
+type ReportByCategory struct {
+	Report
+	Currency      Currency
+	Dates         []time.Time
+	SubCategories []*CategorySeries
+	GrandTotal    GrandTotal
+}
+
+// CategorySeries holds totals of a category subtree for every report interval.
+type CategorySeries struct {
+	Category      Category
+	Series        []IntervalTotal
+	SubCategories []*CategorySeries
+	GrandTotal    GrandTotal
+}
+
+// IntervalTotal represents a total of an interval starting at the date.
+type IntervalTotal struct {
+	Date       time.Time
+	GrandTotal GrandTotal
+}
+
+// CalculateTotal calculates category series total.
+func (c *CategorySeries) CalculateTotal() GrandTotal {
+	var grandTotal GrandTotal
+	for _, intervalTotal := range c.Series {
+		grandTotal = grandTotal.Combine(intervalTotal.GrandTotal)
+	}
+	for _, subCategory := range c.SubCategories {
+		subCategory.CalculateTotal()
+	}
+
+	c.GrandTotal = grandTotal
+	return c.GrandTotal
+}
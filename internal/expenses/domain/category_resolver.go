@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CategoryNameSeparator separates category names in a human readable category path, e.g. Food/Groceries.
+const CategoryNameSeparator string = "/"
+
+// CategoryResolver resolves categories by their names path.
+type CategoryResolver struct {
+	byPath map[string]Category
+	byName map[string][]Category
+}
+
+// NewCategoryResolver builds a resolver out of all available categories.
+func NewCategoryResolver(categories []Category) CategoryResolver {
+	byID := make(map[string]Category, len(categories))
+	for _, category := range categories {
+		byID[category.id] = category
+	}
+
+	resolver := CategoryResolver{
+		byPath: make(map[string]Category, len(categories)),
+		byName: make(map[string][]Category, len(categories)),
+	}
+	for _, category := range categories {
+		names := make([]string, 0, category.level)
+		complete := true
+		for _, id := range strings.Split(category.path, "|") {
+			if len(id) == 0 {
+				continue
+			}
+			pathCategory, ok := byID[id]
+			if !ok {
+				complete = false
+				break
+			}
+			names = append(names, pathCategory.name)
+		}
+		// Categories with unknown ancestors are not reachable by path.
+		if complete && len(names) != 0 {
+			resolver.byPath[categoryKey(names)] = category
+		}
+
+		nameKey := categoryKey([]string{category.name})
+		resolver.byName[nameKey] = append(resolver.byName[nameKey], category)
+	}
+
+	return resolver
+}
+
+// Resolve finds a category by its names path, e.g. Food/Groceries.
+// A single name is resolved as long as it is unique.
+func (r CategoryResolver) Resolve(value string) (*Category, error) {
+	names := make([]string, 0)
+	for _, name := range strings.Split(value, CategoryNameSeparator) {
+		if trimmed := strings.TrimSpace(name); len(trimmed) != 0 {
+			names = append(names, trimmed)
+		}
+	}
+	if len(names) == 0 {
+		return nil, errors.New("empty category")
+	}
+
+	key := categoryKey(names)
+	if category, ok := r.byPath[key]; ok {
+		return &category, nil
+	}
+
+	if len(names) == 1 {
+		switch matches := r.byName[key]; len(matches) {
+		case 0:
+		case 1:
+			return &matches[0], nil
+		default:
+			return nil, fmt.Errorf("category name %q is ambiguous, use a full path", value)
+		}
+	}
+
+	return nil, fmt.Errorf("category %q not found", value)
+}
+
+func categoryKey(names []string) string {
+	return strings.ToLower(strings.Join(names, CategoryNameSeparator))
+}
@@ -0,0 +1,56 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestCategoryResolver_Resolve_FindsCategoryByPathOrUniqueName(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	foodID := "foodId"
+	travelID := "travelId"
+	food, _ := domain.NewCategory(foodID, nil, "Food", nil, 1, "|foodId")
+	groceries, _ := domain.NewCategory("groceriesId", &foodID, "Groceries", nil, 2, "|foodId|groceriesId")
+	travel, _ := domain.NewCategory(travelID, nil, "Travel", nil, 1, "|travelId")
+	travelFood, _ := domain.NewCategory("travelFoodId", &travelID, "Food", nil, 2, "|travelId|travelFoodId")
+	resolver := domain.NewCategoryResolver([]domain.Category{*food, *groceries, *travel, *travelFood})
+
+	// Act
+	byPath, byPathErr := resolver.Resolve("travel/FOOD")
+	byName, byNameErr := resolver.Resolve(" Groceries ")
+	root, rootErr := resolver.Resolve("Food")
+
+	// Assert
+	assert.Nil(t, byPathErr)
+	assert.Equal(t, "travelFoodId", byPath.ID())
+	assert.Nil(t, byNameErr)
+	assert.Equal(t, "groceriesId", byName.ID())
+	assert.Nil(t, rootErr)
+	assert.Equal(t, foodID, root.ID())
+}
+
+func TestCategoryResolver_Resolve_UnknownOrAmbiguous_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	foodID := "foodId"
+	travelID := "travelId"
+	food, _ := domain.NewCategory(foodID, nil, "Food", nil, 1, "|foodId")
+	foodOther, _ := domain.NewCategory("foodOtherId", &foodID, "Other", nil, 2, "|foodId|foodOtherId")
+	travel, _ := domain.NewCategory(travelID, nil, "Travel", nil, 1, "|travelId")
+	travelOther, _ := domain.NewCategory("travelOtherId", &travelID, "Other", nil, 2, "|travelId|travelOtherId")
+	resolver := domain.NewCategoryResolver([]domain.Category{*food, *foodOther, *travel, *travelOther})
+	tests := []string{"", " / ", "Other", "Unknown", "Food/Unknown"}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := resolver.Resolve(tc)
+
+		// Assert
+		assert.Nil(t, res)
+		assert.NotNil(t, resErr)
+	}
+}
@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// ComparisonReport compares category totals of the base period with totals of the comparison periods,
+// e.g. a month with the same month a year ago and with the trailing three months.
+type ComparisonReport struct {
+	Currency      Currency
+	Base          Report
+	Periods       []Report
+	Average       bool
+	Total         Total
+	Comparisons   []TotalDelta
+	SubCategories []*CategoryComparison
+}
+
+// CategoryComparison holds totals of a category subtree in the base period and in every comparison period.
+type CategoryComparison struct {
+	Category      Category
+	Total         Total
+	Comparisons   []TotalDelta
+	SubCategories []*CategoryComparison
+}
+
+// TotalDelta holds a comparison period total and how much the base period total differs from it.
+// Percent is nil when the comparison period total is zero.
+type TotalDelta struct {
+	Total   Total
+	Delta   Total
+	Percent *decimal.Decimal
+}
+
+// NewComparisonReport compares the base period with the comparison periods, every generator holds expenses
+// of its period. Categories with expenses in any of the periods are reported, they get zero totals
+// in the periods without expenses. Totals are averaged per filter interval of the period when average is set,
+// so a month could be compared with the trailing three months.
+func NewComparisonReport(
+	base ReportGenerator,
+	comparisons []ReportGenerator,
+	average bool,
+) (*ComparisonReport, error) {
+	if len(comparisons) == 0 {
+		return nil, errors.New("no comparison periods")
+	}
+	currency := base.currency
+	for _, comparison := range comparisons {
+		if comparison.currency != currency {
+			return nil, errors.New("periods should be reported in the same currency")
+		}
+	}
+
+	report := &ComparisonReport{
+		Currency:      currency,
+		Base:          Report{From: base.filter.From(), To: base.filter.To()},
+		Periods:       make([]Report, 0, len(comparisons)),
+		Average:       average,
+		SubCategories: make([]*CategoryComparison, 0),
+	}
+	for _, comparison := range comparisons {
+		report.Periods = append(report.Periods, Report{From: comparison.filter.From(), To: comparison.filter.To()})
+	}
+
+	periodTotals := make([]Total, 0, len(comparisons)+1)
+	comparisonMap := make(map[string]*CategoryComparison)
+	var merge func(parent *CategoryComparison, period int, divisor decimal.Decimal, categories []*CategoryExpenses)
+	merge = func(parent *CategoryComparison, period int, divisor decimal.Decimal, categories []*CategoryExpenses) {
+		for _, categoryExpenses := range categories {
+			comparison, ok := comparisonMap[categoryExpenses.Category.id]
+			if !ok {
+				comparison = newCategoryComparison(categoryExpenses.Category, currency, len(comparisons))
+				comparisonMap[categoryExpenses.Category.id] = comparison
+				if parent == nil {
+					report.SubCategories = append(report.SubCategories, comparison)
+				} else {
+					parent.SubCategories = append(parent.SubCategories, comparison)
+				}
+			}
+			comparison.setPeriodTotal(period, periodTotal(categoryExpenses.GrandTotal, currency, divisor))
+			merge(comparison, period, divisor, categoryExpenses.SubCategories)
+		}
+	}
+	for period, generator := range append([]ReportGenerator{base}, comparisons...) {
+		divisor := decimal.NewFromInt(1)
+		if average {
+			divisor = decimal.NewFromInt(int64(len(intervalDates(generator.filter, nil))))
+		}
+		root := generator.categoryTotals()
+		periodTotals = append(periodTotals, periodTotal(root.GrandTotal, currency, divisor))
+		merge(nil, period, divisor, root.SubCategories)
+	}
+
+	report.Total = periodTotals[0]
+	report.Comparisons = make([]TotalDelta, 0, len(comparisons))
+	for _, comparisonTotal := range periodTotals[1:] {
+		report.Comparisons = append(report.Comparisons, newTotalDelta(report.Total, comparisonTotal))
+	}
+
+	sortCategoryComparisons(report.SubCategories)
+	for _, comparison := range report.SubCategories {
+		comparison.CalculateDeltas()
+	}
+
+	return report, nil
+}
+
+// newCategoryComparison returns a category comparison with zero totals in every period.
+func newCategoryComparison(category Category, currency Currency, comparisons int) *CategoryComparison {
+	zero := Total{Sum: decimal.Zero, Currency: currency}
+	comparison := &CategoryComparison{
+		Category:      category,
+		Total:         zero,
+		Comparisons:   make([]TotalDelta, comparisons),
+		SubCategories: make([]*CategoryComparison, 0),
+	}
+	for i := range comparison.Comparisons {
+		comparison.Comparisons[i] = TotalDelta{Total: zero, Delta: zero}
+	}
+	return comparison
+}
+
+// setPeriodTotal sets the total of the period, the base period comes first.
+func (c *CategoryComparison) setPeriodTotal(period int, total Total) {
+	if period == 0 {
+		c.Total = total
+		return
+	}
+	c.Comparisons[period-1].Total = total
+}
+
+// CalculateDeltas calculates deltas of the base period total against comparison period totals in the subtree.
+func (c *CategoryComparison) CalculateDeltas() {
+	for i, comparison := range c.Comparisons {
+		c.Comparisons[i] = newTotalDelta(c.Total, comparison.Total)
+	}
+	for _, subCategory := range c.SubCategories {
+		subCategory.CalculateDeltas()
+	}
+}
+
+// newTotalDelta compares the base total with the comparison total.
+func newTotalDelta(base Total, comparison Total) TotalDelta {
+	delta := TotalDelta{
+		Total: comparison,
+		Delta: Total{Sum: base.Sum.Sub(comparison.Sum), Currency: base.Currency},
+	}
+	if !comparison.Sum.IsZero() {
+		percent := delta.Delta.Sum.Div(comparison.Sum).Mul(decimal.NewFromInt(100)).Round(2)
+		delta.Percent = &percent
+	}
+	return delta
+}
+
+// periodTotal returns the grand total in the currency divided by the number of period intervals.
+func periodTotal(grandTotal GrandTotal, currency Currency, divisor decimal.Decimal) Total {
+	sum := grandTotal.Sum(currency)
+	if !divisor.Equal(decimal.NewFromInt(1)) {
+		sum = sum.Div(divisor).Round(2)
+	}
+	return Total{Sum: sum, Currency: currency}
+}
+
+// sortCategoryComparisons sorts category comparisons by category name in the whole subtree.
+func sortCategoryComparisons(categories []*CategoryComparison) {
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Category.name < categories[j].Category.name
+	})
+	for _, category := range categories {
+		sortCategoryComparisons(category.SubCategories)
+	}
+}
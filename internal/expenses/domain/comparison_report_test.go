@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewComparisonReport_ComparesCategoryTotals(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	foodID, restaurantsID, travelID := "foodId", "restaurantsId", "travelId"
+	food, _ := domain.NewCategory(foodID, nil, "Food", nil, 1, "|foodId")
+	restaurants, _ := domain.NewCategory(restaurantsID, &foodID, "Restaurants", nil, 2, "|foodId|restaurantsId")
+	restaurants.SetParents(&[]domain.Category{*food})
+	travel, _ := domain.NewCategory(travelID, nil, "Travel", nil, 1, "|travelId")
+
+	baseFrom := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	baseTo := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	lastYearFrom := time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC)
+	lastYearTo := time.Date(2020, time.July, 31, 0, 0, 0, 0, time.UTC)
+
+	baseFood, _ := domain.NewExpense("1", *food, 150, "EUR", 1, nil, nil, baseFrom)
+	baseRestaurants, _ := domain.NewExpense("2", *restaurants, 50, "EUR", 1, nil, nil, baseFrom)
+	lastYearFood, _ := domain.NewExpense("3", *food, 100, "EUR", 1, nil, nil, lastYearFrom)
+	lastYearTravel, _ := domain.NewExpense("4", *travel, 300, "EUR", 1, nil, nil, lastYearFrom)
+
+	baseFilter, _ := domain.NewExpenseFilter(baseFrom, baseTo, "month")
+	lastYearFilter, _ := domain.NewExpenseFilter(lastYearFrom, lastYearTo, "month")
+	base := domain.NewReportGenerator([]domain.Expense{*baseFood, *baseRestaurants}, *baseFilter,
+		nil, domain.DefaultReportCurrency)
+	lastYear := domain.NewReportGenerator([]domain.Expense{*lastYearFood, *lastYearTravel}, *lastYearFilter,
+		nil, domain.DefaultReportCurrency)
+
+	// Act
+	report, reportErr := domain.NewComparisonReport(base, []domain.ReportGenerator{lastYear}, false)
+
+	// Assert
+	assert.Nil(t, reportErr)
+	assert.Equal(t, domain.DefaultReportCurrency, report.Currency)
+	assert.Equal(t, domain.Report{From: lastYearFrom, To: lastYearTo}, report.Periods[0])
+	assert.True(t, decimal.NewFromInt(200).Equal(report.Total.Sum))
+	assert.True(t, decimal.NewFromInt(400).Equal(report.Comparisons[0].Total.Sum))
+	assert.True(t, decimal.NewFromInt(-200).Equal(report.Comparisons[0].Delta.Sum))
+	assert.True(t, decimal.NewFromInt(-50).Equal(*report.Comparisons[0].Percent))
+
+	assert.Len(t, report.SubCategories, 2, "Categories of any period should be reported.")
+	foodComparison := report.SubCategories[0]
+	assert.Equal(t, foodID, foodComparison.Category.ID())
+	assert.True(t, decimal.NewFromInt(200).Equal(foodComparison.Total.Sum))
+	assert.True(t, decimal.NewFromInt(100).Equal(foodComparison.Comparisons[0].Delta.Sum))
+	assert.True(t, decimal.NewFromInt(100).Equal(*foodComparison.Comparisons[0].Percent))
+
+	restaurantsComparison := foodComparison.SubCategories[0]
+	assert.Equal(t, restaurantsID, restaurantsComparison.Category.ID())
+	assert.True(t, decimal.Zero.Equal(restaurantsComparison.Comparisons[0].Total.Sum))
+	assert.True(t, decimal.NewFromInt(50).Equal(restaurantsComparison.Comparisons[0].Delta.Sum))
+	assert.Nil(t, restaurantsComparison.Comparisons[0].Percent, "Percent should be nil for zero comparison total.")
+
+	travelComparison := report.SubCategories[1]
+	assert.Equal(t, travelID, travelComparison.Category.ID())
+	assert.True(t, decimal.Zero.Equal(travelComparison.Total.Sum))
+	assert.True(t, decimal.NewFromInt(-100).Equal(*travelComparison.Comparisons[0].Percent))
+}
+
+func TestNewComparisonReport_Average_AveragesTotalsPerInterval(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	baseFrom := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	baseTo := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	trailingFrom := time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC)
+	trailingTo := time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+	baseFood, _ := domain.NewExpense("1", *food, 120, "EUR", 1, nil, nil, baseFrom)
+	trailingFood, _ := domain.NewExpense("2", *food, 300, "EUR", 1, nil, nil, trailingFrom)
+
+	baseFilter, _ := domain.NewExpenseFilter(baseFrom, baseTo, "month")
+	trailingFilter, _ := domain.NewExpenseFilter(trailingFrom, trailingTo, "month")
+	base := domain.NewReportGenerator([]domain.Expense{*baseFood}, *baseFilter, nil, domain.DefaultReportCurrency)
+	trailing := domain.NewReportGenerator([]domain.Expense{*trailingFood}, *trailingFilter,
+		nil, domain.DefaultReportCurrency)
+
+	// Act
+	report, reportErr := domain.NewComparisonReport(base, []domain.ReportGenerator{trailing}, true)
+
+	// Assert
+	assert.Nil(t, reportErr)
+	assert.True(t, report.Average)
+	assert.True(t, decimal.NewFromInt(120).Equal(report.SubCategories[0].Total.Sum))
+	assert.True(t, decimal.NewFromInt(100).Equal(report.SubCategories[0].Comparisons[0].Total.Sum))
+	assert.True(t, decimal.NewFromInt(20).Equal(*report.SubCategories[0].Comparisons[0].Percent))
+}
+
+func TestNewComparisonReport_NoComparisonPeriods_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	filter, _ := domain.NewExpenseFilter(from, to, "month")
+	base := domain.NewReportGenerator([]domain.Expense{}, *filter, nil, domain.DefaultReportCurrency)
+
+	// Act
+	report, reportErr := domain.NewComparisonReport(base, nil, false)
+
+	// Assert
+	assert.NotNil(t, reportErr)
+	assert.Nil(t, report)
+}
@@ -11,6 +11,7 @@ type Report struct {
 // ReportByDate represents report by date.
 type ReportByDate struct {
 	Report
+	Currency       Currency
 	CategoryByDate []*DateExpenses
 	GrandTotal     GrandTotal
 }
@@ -26,3 +27,25 @@ func (c *ReportByDate) CalculateTotal() GrandTotal {
 
 	return c.GrandTotal
 }
+
+// SetBudgets sets budget figures of every date category for the budget period containing the date.
+func (c *ReportByDate) SetBudgets(statuses []BudgetStatus) {
+	if len(statuses) == 0 {
+		return
+	}
+	for _, byDate := range c.CategoryByDate {
+		for _, categoryExpenses := range byDate.SubCategories {
+			categoryExpenses.SetBudgets(byDate.Date, statuses)
+		}
+	}
+}
+
+// Dates returns report dates.
+func (c ReportByDate) Dates() []time.Time {
+	dates := make([]time.Time, 0, len(c.CategoryByDate))
+	for _, byDate := range c.CategoryByDate {
+		dates = append(dates, byDate.Date)
+	}
+
+	return dates
+}
@@ -0,0 +1,303 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Defines duplicate detection settings.
+const (
+	DefaultDuplicateDays      int     = 2
+	MaxDuplicateDays          int     = 31
+	DefaultDuplicateTolerance float64 = 0.01
+	// DuplicateCommentSimilarity is the minimal share of words the shorter comment has in common with the longer one.
+	DuplicateCommentSimilarity float64 = 0.5
+	// DuplicateGroupIDSeparator separates expense IDs in a duplicate group ID.
+	DuplicateGroupIDSeparator string = "-"
+)
+
+// Defines values for DuplicateResolution.
+const (
+	DuplicateResolutionDelete DuplicateResolution = "delete"
+
+	DuplicateResolutionMerge DuplicateResolution = "merge"
+)
+
+// DuplicateResolution defines what happens to the duplicates of the kept expense.
+type DuplicateResolution string
+
+// DuplicateCriteria defines how close expenses should be to be likely duplicates.
+type DuplicateCriteria struct {
+	days      int
+	tolerance float64
+}
+
+// DuplicateCriteriaParams holds raw duplicate criteria values.
+type DuplicateCriteriaParams struct {
+	Days      *int
+	Tolerance *float64
+}
+
+// NewDuplicateCriteria instantiates duplicate criteria. The tolerance is a share of the larger amount.
+func NewDuplicateCriteria(params DuplicateCriteriaParams) (*DuplicateCriteria, error) {
+	criteria := DuplicateCriteria{
+		days:      DefaultDuplicateDays,
+		tolerance: DefaultDuplicateTolerance,
+	}
+	if params.Days != nil {
+		if *params.Days < 0 || *params.Days > MaxDuplicateDays {
+			return nil, errors.Errorf("days should be between 0 and %d", MaxDuplicateDays)
+		}
+		criteria.days = *params.Days
+	}
+	if params.Tolerance != nil {
+		if *params.Tolerance < 0 || *params.Tolerance >= 1 {
+			return nil, errors.New("tolerance should be between 0 and 1")
+		}
+		criteria.tolerance = *params.Tolerance
+	}
+
+	return &criteria, nil
+}
+
+// Days returns the maximal number of days between duplicates.
+func (c DuplicateCriteria) Days() int {
+	return c.days
+}
+
+// Tolerance returns the maximal amount difference between duplicates as a share of the larger amount.
+func (c DuplicateCriteria) Tolerance() float64 {
+	return c.tolerance
+}
+
+// Window returns the date range duplicates of an expense of the date fall into.
+func (c DuplicateCriteria) Window(date time.Time) (time.Time, time.Time) {
+	return date.AddDate(0, 0, -c.days), date.AddDate(0, 0, c.days)
+}
+
+// DuplicateGroup represents expenses which are likely the same expense logged several times.
+type DuplicateGroup struct {
+	ID       string
+	Expenses []Expense
+}
+
+// DuplicateGroupID returns a group ID out of the IDs of the group expenses.
+func DuplicateGroupID(expenseIDs []string) string {
+	ids := append([]string{}, expenseIDs...)
+	sort.Strings(ids)
+	return strings.Join(ids, DuplicateGroupIDSeparator)
+}
+
+// ParseDuplicateGroupID returns the IDs of the group expenses.
+func ParseDuplicateGroupID(groupID string) ([]string, error) {
+	ids := strings.Split(groupID, DuplicateGroupIDSeparator)
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if len(id) == 0 || seen[id] {
+			return nil, errors.Errorf("malformed duplicate group ID %s", groupID)
+		}
+		seen[id] = true
+	}
+	if len(ids) < 2 {
+		return nil, errors.Errorf("duplicate group %s should have at least two expenses", groupID)
+	}
+	return ids, nil
+}
+
+// DuplicateDetector detects likely duplicate expenses.
+type DuplicateDetector struct {
+	criteria DuplicateCriteria
+}
+
+// NewDuplicateDetector creates a detector using the criteria.
+func NewDuplicateDetector(criteria DuplicateCriteria) DuplicateDetector {
+	return DuplicateDetector{criteria: criteria}
+}
+
+// IsDuplicate reports whether the expenses are likely the same expense. Duplicates belong to the same
+// category subtree, have the same currency, close amounts and dates and similar comments.
+// Expenses of the same receipt and imported expenses with different external IDs are never duplicates.
+func (d DuplicateDetector) IsDuplicate(a Expense, b Expense) bool {
+	if a.id == b.id && len(a.id) != 0 {
+		return false
+	}
+	if a.receiptID != nil && b.receiptID != nil && *a.receiptID == *b.receiptID {
+		return false
+	}
+	if a.externalID != nil && b.externalID != nil && *a.externalID != *b.externalID {
+		return false
+	}
+	if a.currency != b.currency || !sameCategorySubtree(a.category, b.category) {
+		return false
+	}
+	if d.dateDistance(a.date, b.date) > time.Duration(d.criteria.days)*24*time.Hour {
+		return false
+	}
+
+	amountA, amountB := a.price.Mul(a.quantity).Abs(), b.price.Mul(b.quantity).Abs()
+	allowed := decimal.Max(amountA, amountB).Mul(decimal.NewFromFloat(d.criteria.tolerance))
+	if amountA.Sub(amountB).Abs().GreaterThan(allowed) {
+		return false
+	}
+
+	return similarComments(a.comment, b.comment)
+}
+
+// Find returns the candidates which are likely duplicates of the expense.
+func (d DuplicateDetector) Find(expense Expense, candidates []Expense) []Expense {
+	duplicates := make([]Expense, 0)
+	for _, candidate := range candidates {
+		if d.IsDuplicate(expense, candidate) {
+			duplicates = append(duplicates, candidate)
+		}
+	}
+	return duplicates
+}
+
+// Groups returns groups of likely duplicates. Expenses are grouped transitively, i.e. expenses
+// are in the same group as long as there is a chain of duplicates between them.
+func (d DuplicateDetector) Groups(expenses []Expense) []DuplicateGroup {
+	sorted := append([]Expense{}, expenses...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].date.Before(sorted[j].date)
+	})
+
+	parents := make([]int, len(sorted))
+	for index := range parents {
+		parents[index] = index
+	}
+	var root func(index int) int
+	root = func(index int) int {
+		if parents[index] != index {
+			parents[index] = root(parents[index])
+		}
+		return parents[index]
+	}
+
+	window := time.Duration(d.criteria.days) * 24 * time.Hour
+	for i := range sorted {
+		for j := i + 1; j < len(sorted) && sorted[j].date.Sub(sorted[i].date) <= window; j++ {
+			if d.IsDuplicate(sorted[i], sorted[j]) {
+				parents[root(j)] = root(i)
+			}
+		}
+	}
+
+	members := make(map[int][]Expense)
+	roots := make([]int, 0)
+	for index, expense := range sorted {
+		groupRoot := root(index)
+		if _, ok := members[groupRoot]; !ok {
+			roots = append(roots, groupRoot)
+		}
+		members[groupRoot] = append(members[groupRoot], expense)
+	}
+
+	groups := make([]DuplicateGroup, 0)
+	for _, groupRoot := range roots {
+		groupExpenses := members[groupRoot]
+		if len(groupExpenses) < 2 {
+			continue
+		}
+		ids := make([]string, 0, len(groupExpenses))
+		for _, expense := range groupExpenses {
+			ids = append(ids, expense.id)
+		}
+		groups = append(groups, DuplicateGroup{
+			ID:       DuplicateGroupID(ids),
+			Expenses: groupExpenses,
+		})
+	}
+
+	return groups
+}
+
+func (d DuplicateDetector) dateDistance(a time.Time, b time.Time) time.Duration {
+	if a.After(b) {
+		return a.Sub(b)
+	}
+	return b.Sub(a)
+}
+
+// MergeDuplicates returns the kept expense completed with the details of its duplicates. Tags are
+// united, the comment, the trip, the merchant and the account are taken from the first duplicate
+// having them when the kept expense has none.
+func MergeDuplicates(keep Expense, duplicates []Expense) Expense {
+	merged := keep
+	tags := append([]string{}, keep.tags...)
+	for _, duplicate := range duplicates {
+		if merged.comment == nil && duplicate.comment != nil {
+			comment := *duplicate.comment
+			merged.comment = &comment
+		}
+		if merged.tripID == nil && duplicate.tripID != nil {
+			tripID := *duplicate.tripID
+			merged.tripID = &tripID
+		}
+		if merged.merchantID == nil && duplicate.merchantID != nil {
+			merchantID := *duplicate.merchantID
+			merged.merchantID = &merchantID
+		}
+		if merged.accountID == nil && duplicate.accountID != nil {
+			accountID := *duplicate.accountID
+			merged.accountID = &accountID
+		}
+		tags = append(tags, duplicate.tags...)
+		merged.reimbursable = merged.reimbursable || duplicate.reimbursable
+	}
+	merged.tags = NormalizeTags(tags)
+
+	return merged
+}
+
+// sameCategorySubtree reports whether one category is the other one or its ancestor.
+func sameCategorySubtree(a Category, b Category) bool {
+	return a.id == b.id || pathContains(a.path, b.id) || pathContains(b.path, a.id)
+}
+
+func pathContains(path string, id string) bool {
+	for _, pathID := range strings.Split(path, "|") {
+		if pathID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// similarComments reports whether the comments share enough words, a missing comment is similar to any comment.
+func similarComments(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	wordsA, wordsB := commentWords(*a), commentWords(*b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return true
+	}
+	if len(wordsA) > len(wordsB) {
+		wordsA, wordsB = wordsB, wordsA
+	}
+
+	common := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			common++
+		}
+	}
+
+	return float64(common)/float64(len(wordsA)) >= DuplicateCommentSimilarity
+}
+
+func commentWords(comment string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(comment), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		words[word] = true
+	}
+	return words
+}
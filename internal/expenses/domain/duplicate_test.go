@@ -0,0 +1,176 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+var duplicateDate = time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+
+func newDuplicateExpense(
+	id string, category domain.Category, price float64, comment string, date time.Time,
+	opts ...func(*domain.Expense),
+) domain.Expense {
+	expense, _ := domain.NewExpense(id, category, price, "EUR", 1, &comment, nil, date, opts...)
+	return *expense
+}
+
+func newDuplicateDetector() domain.DuplicateDetector {
+	criteria, _ := domain.NewDuplicateCriteria(domain.DuplicateCriteriaParams{})
+	return domain.NewDuplicateDetector(*criteria)
+}
+
+func TestNewDuplicateCriteria_NoParams_ReturnsDefaults(t *testing.T) {
+	t.Parallel()
+	// Act
+	res, err := domain.NewDuplicateCriteria(domain.DuplicateCriteriaParams{})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, domain.DefaultDuplicateDays, res.Days())
+	assert.Equal(t, domain.DefaultDuplicateTolerance, res.Tolerance())
+}
+
+func TestNewDuplicateCriteria_InvalidParams_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	days, tolerance := 40, 1.5
+
+	// Act
+	daysRes, daysErr := domain.NewDuplicateCriteria(domain.DuplicateCriteriaParams{Days: &days})
+	toleranceRes, toleranceErr := domain.NewDuplicateCriteria(domain.DuplicateCriteriaParams{Tolerance: &tolerance})
+
+	// Assert
+	assert.Nil(t, daysRes)
+	assert.NotNil(t, daysErr)
+	assert.Nil(t, toleranceRes)
+	assert.NotNil(t, toleranceErr)
+}
+
+func TestParseDuplicateGroupID_ValidID_ReturnsExpenseIDs(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	groupID := domain.DuplicateGroupID([]string{"secondId", "firstId"})
+
+	// Act
+	res, err := domain.ParseDuplicateGroupID(groupID)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "firstId-secondId", groupID)
+	assert.Equal(t, []string{"firstId", "secondId"}, res)
+}
+
+func TestParseDuplicateGroupID_MalformedID_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := []string{"firstId", "firstId-firstId", "firstId--secondId"}
+
+	for _, groupID := range tests {
+		// Act
+		res, err := domain.ParseDuplicateGroupID(groupID)
+
+		// Assert
+		assert.Nil(t, res, groupID)
+		assert.NotNil(t, err, groupID)
+	}
+}
+
+func TestDuplicateDetector_IsDuplicate_ChecksCriteria(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	parentID := "foodId"
+	restaurants, _ := domain.NewCategory("restaurantsId", &parentID, "Restaurants", nil, 2, "|foodId|restaurantsId")
+	travel, _ := domain.NewCategory("travelId", nil, "Travel", nil, 1, "|travelId")
+	dinner := newDuplicateExpense("dinnerId", *restaurants, 45.5, "Dinner at Luigi", duplicateDate)
+	tests := []struct {
+		name     string
+		expense  domain.Expense
+		expected bool
+	}{
+		{"parent category", newDuplicateExpense("otherId", *food, 45.5, "dinner", duplicateDate.AddDate(0, 0, 1)), true},
+		{"amount within tolerance", newDuplicateExpense("otherId", *restaurants, 45.2, "luigi", duplicateDate), true},
+		{"amount out of tolerance", newDuplicateExpense("otherId", *restaurants, 44, "dinner", duplicateDate), false},
+		{"other category", newDuplicateExpense("otherId", *travel, 45.5, "dinner", duplicateDate), false},
+		{"date out of window", newDuplicateExpense("otherId", *food, 45.5, "dinner", duplicateDate.AddDate(0, 0, 3)), false},
+		{"other comment", newDuplicateExpense("otherId", *food, 45.5, "Taxi to the airport", duplicateDate), false},
+		{"same expense", newDuplicateExpense("dinnerId", *food, 45.5, "dinner", duplicateDate), false},
+	}
+
+	for _, test := range tests {
+		// Act
+		res := newDuplicateDetector().IsDuplicate(dinner, test.expense)
+
+		// Assert
+		assert.Equal(t, test.expected, res, test.name)
+	}
+}
+
+func TestDuplicateDetector_IsDuplicate_DifferentExternalIDs_ReturnsFalse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	first := newDuplicateExpense("firstId", *category, 3, "Coffee", duplicateDate, domain.SetExternalID("first"))
+	second := newDuplicateExpense("secondId", *category, 3, "Coffee", duplicateDate, domain.SetExternalID("second"))
+	manual := newDuplicateExpense("manualId", *category, 3, "Coffee", duplicateDate)
+
+	// Act
+	imported := newDuplicateDetector().IsDuplicate(first, second)
+	logged := newDuplicateDetector().IsDuplicate(first, manual)
+
+	// Assert
+	assert.False(t, imported)
+	assert.True(t, logged)
+}
+
+func TestDuplicateDetector_Groups_GroupsDuplicatesTransitively(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expenses := []domain.Expense{
+		newDuplicateExpense("thirdId", *category, 10, "Dinner", duplicateDate.AddDate(0, 0, 4)),
+		newDuplicateExpense("firstId", *category, 10, "Dinner", duplicateDate),
+		newDuplicateExpense("singleId", *category, 25, "Groceries", duplicateDate),
+		newDuplicateExpense("secondId", *category, 10, "Dinner", duplicateDate.AddDate(0, 0, 2)),
+	}
+
+	// Act
+	res := newDuplicateDetector().Groups(expenses)
+
+	// Assert
+	assert.Len(t, res, 1)
+	assert.Equal(t, "firstId-secondId-thirdId", res[0].ID)
+	assert.Equal(t, "firstId", res[0].Expenses[0].ID(), "Group expenses should be sorted by date.")
+	assert.Len(t, res[0].Expenses, 3)
+}
+
+func TestMergeDuplicates_CompletesKeptExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	keep, _ := domain.NewExpense("keepId", *category, 10, "EUR", 1, nil, nil, duplicateDate,
+		domain.SetTags([]string{"dinner"}))
+	tripID := "tripId"
+	comment := "Dinner at Luigi"
+	duplicate, _ := domain.NewExpense("duplicateId", *category, 10, "EUR", 1, &comment, &tripID, duplicateDate,
+		domain.SetTags([]string{"italian", "dinner"}), domain.SetMerchant("merchantId"), domain.SetAccount("accountId"),
+		domain.SetReimbursable(true))
+
+	// Act
+	res := domain.MergeDuplicates(*keep, []domain.Expense{*duplicate})
+
+	// Assert
+	assert.Equal(t, "keepId", res.ID())
+	assert.Equal(t, "Dinner at Luigi", *res.Comment())
+	assert.Equal(t, "tripId", *res.TripID())
+	assert.Equal(t, "merchantId", *res.MerchantID())
+	assert.Equal(t, "accountId", *res.AccountID())
+	assert.Equal(t, []string{"dinner", "italian"}, res.Tags())
+	assert.True(t, res.Reimbursable())
+	assert.Nil(t, keep.Comment(), "Kept expense should not be changed.")
+}
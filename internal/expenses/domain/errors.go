@@ -0,0 +1,40 @@
+package domain
+
+import "github.com/pkg/errors"
+
+// Errors.
+var (
+	ErrInvalidExpense             = errors.New("invalid expense")
+	ErrCategoryNotFound           = errors.New("category not found")
+	ErrParentCategoryUnavailable  = errors.New("parent category is missing or in the trash")
+	ErrInvalidImport              = errors.New("invalid import")
+	ErrImportProfileNotFound      = errors.New("import profile not found")
+	ErrRecurringExpenseNotFound   = errors.New("recurring expense not found")
+	ErrOccurrenceNotFound         = errors.New("occurrence not found")
+	ErrOccurrenceMaterialized     = errors.New("occurrence is materialized already")
+	ErrInvalidBudget              = errors.New("invalid budget")
+	ErrBudgetExists               = errors.New("category budget exists already")
+	ErrInvalidTrip                = errors.New("invalid trip")
+	ErrTripNotFound               = errors.New("trip not found")
+	ErrInvalidTag                 = errors.New("invalid tag")
+	ErrInvalidSettlement          = errors.New("invalid settlement")
+	ErrExchangeRateNotFound       = errors.New("exchange rate not found")
+	ErrExpenseNotFound            = errors.New("expense not found")
+	ErrInvalidAttachment          = errors.New("invalid attachment")
+	ErrInvalidReceipt             = errors.New("invalid receipt")
+	ErrInvalidMerchant            = errors.New("invalid merchant")
+	ErrMerchantNotFound           = errors.New("merchant not found")
+	ErrInvalidRule                = errors.New("invalid rule")
+	ErrInvalidDuplicateCriteria   = errors.New("invalid duplicate criteria")
+	ErrInvalidDuplicateResolution = errors.New("invalid duplicate resolution")
+	ErrInvalidIncome              = errors.New("invalid income")
+	ErrInvalidAccount             = errors.New("invalid account")
+	ErrAccountNotFound            = errors.New("account not found")
+	ErrInvalidTransfer            = errors.New("invalid transfer")
+	ErrInvalidReconciliation      = errors.New("invalid reconciliation")
+	ErrInvalidSettings            = errors.New("invalid settings")
+	ErrInvalidReportCurrency      = errors.New("invalid report currency")
+	ErrInvalidReportFilter        = errors.New("invalid report filter")
+	ErrExpenseLocked              = errors.New("expense is locked by reconciliation")
+	ErrExpenseImported            = errors.New("expense is imported already")
+)
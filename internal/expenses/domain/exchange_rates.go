@@ -76,3 +76,13 @@ func (er ExchangeRates) ChangeBaseCurrency(targetCurrency Currency) ExchangeRate
 
 	return newRate
 }
+
+// inCurrency returns exchange rates based on the currency, if the currency could be converted.
+func (er ExchangeRates) inCurrency(currency Currency) *ExchangeRates {
+	if _, known := er.rates[currency]; !known && er.baseCurrency != currency {
+		return nil
+	}
+
+	rates := er.ChangeBaseCurrency(currency)
+	return &rates
+}
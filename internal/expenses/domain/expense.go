@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -9,19 +10,29 @@ import (
 
 // Expense represents a domain object.
 type Expense struct {
-	id        string
-	category  Category
-	price     decimal.Decimal
-	currency  string
-	quantity  decimal.Decimal
-	comment   *string
-	trip      *string
-	date      time.Time
-	createdAt time.Time
-	createdBy string
-	updatedAt *time.Time
-	updatedBy *string
-	totalInfo TotalInfo
+	id               string
+	category         Category
+	price            decimal.Decimal
+	currency         string
+	quantity         decimal.Decimal
+	comment          *string
+	tripID           *string
+	date             time.Time
+	createdAt        time.Time
+	createdBy        string
+	updatedAt        *time.Time
+	updatedBy        *string
+	externalID       *string
+	recurrence       *Recurrence
+	tags             []string
+	paidBy           *string
+	split            *Split
+	receiptID        *string
+	merchantID       *string
+	accountID        *string
+	reconciliationID *string
+	reimbursable     bool
+	totalInfo        TotalInfo
 }
 
 // Currency holds currency string representation.
@@ -35,7 +46,7 @@ func NewExpense(
 	currency string,
 	quantity float64,
 	comment *string,
-	trip *string,
+	tripID *string,
 	date time.Time,
 	opts ...func(*Expense),
 ) (*Expense, error) {
@@ -48,6 +59,9 @@ func NewExpense(
 	if currency == "" {
 		return nil, errors.New("currency should not be empty")
 	}
+	if category.income {
+		return nil, errors.New("income category could not be used for an expense")
+	}
 
 	decPrice := decimal.NewFromFloat(price)
 	decQuantity := decimal.NewFromFloat(quantity)
@@ -59,7 +73,7 @@ func NewExpense(
 		currency: currency,
 		quantity: decQuantity,
 		comment:  comment,
-		trip:     trip,
+		tripID:   tripID,
 		date:     date,
 	}
 
@@ -67,6 +81,15 @@ func NewExpense(
 		opt(expense)
 	}
 
+	if expense.split != nil {
+		if len(strings.TrimSpace(*expense.paidBy)) == 0 {
+			return nil, errors.New("shared expense should be paid by someone")
+		}
+		if _, splitErr := expense.SplitAmounts(); splitErr != nil {
+			return nil, splitErr
+		}
+	}
+
 	return expense, nil
 }
 
@@ -102,9 +125,9 @@ func (e Expense) Comment() *string {
 	return e.comment
 }
 
-// Trip returns expense trip.
-func (e Expense) Trip() *string {
-	return e.trip
+// TripID returns an id of the trip the expense belongs to.
+func (e Expense) TripID() *string {
+	return e.tripID
 }
 
 // Date returns expense date.
@@ -132,6 +155,69 @@ func (e Expense) UpdatedBy() *string {
 	return e.updatedBy
 }
 
+// ExternalID returns an ID of the imported bank transaction, used to skip duplicates.
+func (e Expense) ExternalID() *string {
+	return e.externalID
+}
+
+// Recurrence returns the occurrence of a recurring expense the expense was materialized from.
+func (e Expense) Recurrence() *Recurrence {
+	return e.recurrence
+}
+
+// Tags returns expense tags.
+func (e Expense) Tags() []string {
+	return e.tags
+}
+
+// PaidBy returns a user who paid the shared expense, it is nil for personal expenses.
+func (e Expense) PaidBy() *string {
+	return e.paidBy
+}
+
+// Split returns a division of the shared expense between participants, it is nil for personal expenses.
+func (e Expense) Split() *Split {
+	return e.split
+}
+
+// SplitAmounts returns amounts participants owe for the shared expense in the expense currency.
+func (e Expense) SplitAmounts() ([]SplitAmount, error) {
+	if e.split == nil {
+		return nil, nil
+	}
+	return e.split.Amounts(e.price.Mul(e.quantity))
+}
+
+// ReceiptID returns an ID of the receipt the expense is a line item of.
+func (e Expense) ReceiptID() *string {
+	return e.receiptID
+}
+
+// MerchantID returns an ID of the merchant the expense is paid to.
+func (e Expense) MerchantID() *string {
+	return e.merchantID
+}
+
+// AccountID returns an ID of the account the expense is paid from.
+func (e Expense) AccountID() *string {
+	return e.accountID
+}
+
+// ReconciliationID returns an ID of the reconciliation the expense is reconciled in.
+func (e Expense) ReconciliationID() *string {
+	return e.reconciliationID
+}
+
+// Locked returns whether the expense is reconciled, locked expenses could not be edited until unlocked.
+func (e Expense) Locked() bool {
+	return e.reconciliationID != nil
+}
+
+// Reimbursable returns whether the expense is to be reimbursed, e.g. by the employer.
+func (e Expense) Reimbursable() bool {
+	return e.reimbursable
+}
+
 // TotalInfo returns total.
 func (e Expense) TotalInfo() TotalInfo {
 	return e.totalInfo
@@ -153,34 +239,72 @@ func SetUpdateMetadata(updatedBy string, updatedAt time.Time) func(*Expense) {
 	}
 }
 
-// CalculateTotal calculates expense totals values.
-func (e *Expense) CalculateTotal(exchangeRate *ExchangeRates) TotalInfo {
-	e.totalInfo = TotalInfo{
-		OriginalTotal: Total{
-			Sum:      e.price.Mul(e.quantity),
-			Currency: Currency(e.currency),
-		},
+// SetExternalID sets an ID of the imported bank transaction.
+func SetExternalID(externalID string) func(*Expense) {
+	return func(e *Expense) {
+		e.externalID = &externalID
 	}
+}
 
-	if exchangeRate == nil {
-		return e.totalInfo
+// SetRecurrence links the expense to the occurrence of a recurring expense.
+func SetRecurrence(recurrence Recurrence) func(*Expense) {
+	return func(e *Expense) {
+		e.recurrence = &recurrence
 	}
+}
 
-	rate, ok := exchangeRate.rates[Currency(e.currency)]
-	if !ok {
-		return e.totalInfo
+// SetTags sets expense tags, tags are normalized and deduplicated.
+func SetTags(tags []string) func(*Expense) {
+	return func(e *Expense) {
+		e.tags = NormalizeTags(tags)
 	}
+}
 
-	e.totalInfo.ExchangeRate = &ExchangeRate{
-		date:           exchangeRate.date,
-		baseCurrency:   exchangeRate.baseCurrency,
-		targetCurrency: Currency(e.currency),
-		rate:           rate,
+// SetSplit shares the expense paid by the user between participants.
+func SetSplit(paidBy string, split Split) func(*Expense) {
+	return func(e *Expense) {
+		e.paidBy = &paidBy
+		e.split = &split
 	}
-	e.totalInfo.ConvertedTotal = &Total{
-		Currency: exchangeRate.baseCurrency,
-		Sum:      e.totalInfo.OriginalTotal.Sum.Div(rate),
+}
+
+// SetReceipt makes the expense a line item of the receipt.
+func SetReceipt(receiptID string) func(*Expense) {
+	return func(e *Expense) {
+		e.receiptID = &receiptID
 	}
+}
+
+// SetMerchant sets the merchant the expense is paid to.
+func SetMerchant(merchantID string) func(*Expense) {
+	return func(e *Expense) {
+		e.merchantID = &merchantID
+	}
+}
 
+// SetAccount sets the account the expense is paid from.
+func SetAccount(accountID string) func(*Expense) {
+	return func(e *Expense) {
+		e.accountID = &accountID
+	}
+}
+
+// SetReconciliation marks the expense reconciled in the reconciliation.
+func SetReconciliation(reconciliationID string) func(*Expense) {
+	return func(e *Expense) {
+		e.reconciliationID = &reconciliationID
+	}
+}
+
+// SetReimbursable marks whether the expense is to be reimbursed.
+func SetReimbursable(reimbursable bool) func(*Expense) {
+	return func(e *Expense) {
+		e.reimbursable = reimbursable
+	}
+}
+
+// CalculateTotal calculates expense totals values.
+func (e *Expense) CalculateTotal(exchangeRate *ExchangeRates) TotalInfo {
+	e.totalInfo = newTotalInfo(e.price.Mul(e.quantity), Currency(e.currency), exchangeRate)
 	return e.totalInfo
 }
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -9,13 +10,28 @@ import (
 
 // ExpenseFilter represents expense filter.
 type ExpenseFilter struct {
-	from     time.Time
-	to       time.Time
-	interval Interval
+	from               time.Time
+	to                 time.Time
+	interval           Interval
+	periodStartDay     int
+	tags               []string
+	excludedTags       []string
+	categories         []string
+	excludedCategories []string
+	currencies         []string
+	tripID             *string
+	minAmount          *float64
+	maxAmount          *float64
+	comment            *string
 }
 
 // NewExpenseFilter instantiates expense filter.
-func NewExpenseFilter(from time.Time, to time.Time, intervalString string) (*ExpenseFilter, error) {
+func NewExpenseFilter(
+	from time.Time,
+	to time.Time,
+	intervalString string,
+	opts ...func(*ExpenseFilter),
+) (*ExpenseFilter, error) {
 	if from.After(to) {
 		return nil, errors.New("'from' date could not be after 'to' date")
 	}
@@ -27,8 +43,12 @@ func NewExpenseFilter(from time.Time, to time.Time, intervalString string) (*Exp
 	switch intervalString {
 	case "day":
 		interval = IntervalDay
+	case "week":
+		interval = IntervalWeek
 	case "month":
 		interval = IntervalMonth
+	case "quarter":
+		interval = IntervalQuarter
 	case "year":
 		interval = IntervalYear
 	default:
@@ -36,9 +56,22 @@ func NewExpenseFilter(from time.Time, to time.Time, intervalString string) (*Exp
 	}
 
 	filter := &ExpenseFilter{
-		from:     from,
-		to:       to,
-		interval: interval,
+		from:           from,
+		to:             to,
+		interval:       interval,
+		periodStartDay: DefaultPeriodStartDay,
+	}
+
+	for _, opt := range opts {
+		opt(filter)
+	}
+
+	if filter.periodStartDay < 1 || filter.periodStartDay > MaxPeriodStartDay {
+		return nil, fmt.Errorf("period start day should be between 1 and %d", MaxPeriodStartDay)
+	}
+
+	if filter.minAmount != nil && filter.maxAmount != nil && *filter.minAmount > *filter.maxAmount {
+		return nil, errors.New("min amount could not be greater than max amount")
 	}
 
 	return filter, nil
@@ -49,6 +82,11 @@ func (f ExpenseFilter) Interval() Interval {
 	return f.interval
 }
 
+// PeriodStartDay returns the day of month months, quarters and years begin on.
+func (f ExpenseFilter) PeriodStartDay() int {
+	return f.periodStartDay
+}
+
 // From returns expense filter from date.
 func (f ExpenseFilter) From() time.Time {
 	return f.from
@@ -58,3 +96,119 @@ func (f ExpenseFilter) From() time.Time {
 func (f ExpenseFilter) To() time.Time {
 	return f.to
 }
+
+// Tags returns tags an expense should have at least one of, any expense matches when it is empty.
+func (f ExpenseFilter) Tags() []string {
+	return f.tags
+}
+
+// ExcludedTags returns tags an expense should have none of.
+func (f ExpenseFilter) ExcludedTags() []string {
+	return f.excludedTags
+}
+
+// Categories returns categories an expense should belong to the subtree of any of,
+// any expense matches when it is empty.
+func (f ExpenseFilter) Categories() []string {
+	return f.categories
+}
+
+// ExcludedCategories returns categories an expense should belong to the subtree of none of.
+func (f ExpenseFilter) ExcludedCategories() []string {
+	return f.excludedCategories
+}
+
+// Currencies returns original currencies an expense should be paid in any of, any expense matches when it is empty.
+func (f ExpenseFilter) Currencies() []string {
+	return f.currencies
+}
+
+// TripID returns expense filter trip id.
+func (f ExpenseFilter) TripID() *string {
+	return f.tripID
+}
+
+// MinAmount returns the lowest expense total in the original currency.
+func (f ExpenseFilter) MinAmount() *float64 {
+	return f.minAmount
+}
+
+// MaxAmount returns the highest expense total in the original currency.
+func (f ExpenseFilter) MaxAmount() *float64 {
+	return f.maxAmount
+}
+
+// Comment returns a text to match expense comment against.
+func (f ExpenseFilter) Comment() *string {
+	return f.comment
+}
+
+// SetTagFilter limits expenses to the ones tagged with any of the tags and none of the excluded tags.
+func SetTagFilter(tags []string, excludedTags []string) func(*ExpenseFilter) {
+	return func(f *ExpenseFilter) {
+		f.tags = NormalizeTags(tags)
+		f.excludedTags = NormalizeTags(excludedTags)
+	}
+}
+
+// SetPeriodStartDay shifts months, quarters and years to begin on the day of month, e.g. on payday.
+// Zero day keeps calendar periods.
+func SetPeriodStartDay(day int) func(*ExpenseFilter) {
+	return func(f *ExpenseFilter) {
+		if day != 0 {
+			f.periodStartDay = day
+		}
+	}
+}
+
+// SetCategoryFilter limits expenses to the subtrees of any of the categories and none of the excluded ones,
+// e.g. food excluding restaurants.
+func SetCategoryFilter(categories []string, excludedCategories []string) func(*ExpenseFilter) {
+	return func(f *ExpenseFilter) {
+		f.categories = trimmedValues(categories)
+		f.excludedCategories = trimmedValues(excludedCategories)
+	}
+}
+
+// SetCurrencyFilter limits expenses to the ones paid in any of the currencies.
+func SetCurrencyFilter(currencies []string) func(*ExpenseFilter) {
+	return func(f *ExpenseFilter) {
+		f.currencies = trimmedValues(currencies)
+		for i, currency := range f.currencies {
+			f.currencies[i] = strings.ToUpper(currency)
+		}
+	}
+}
+
+// SetTripFilter limits expenses to the ones of the trip. Empty trip keeps expenses of any trip.
+func SetTripFilter(tripID string) func(*ExpenseFilter) {
+	return func(f *ExpenseFilter) {
+		f.tripID = trimmedOrNil(&tripID)
+	}
+}
+
+// SetAmountFilter limits expenses to the ones with total within the range, both bounds are optional
+// and inclusive. Totals are compared in the original currency of the expense.
+func SetAmountFilter(minAmount *float64, maxAmount *float64) func(*ExpenseFilter) {
+	return func(f *ExpenseFilter) {
+		f.minAmount = minAmount
+		f.maxAmount = maxAmount
+	}
+}
+
+// SetCommentFilter limits expenses to the ones with comment containing the text, case is ignored.
+func SetCommentFilter(text string) func(*ExpenseFilter) {
+	return func(f *ExpenseFilter) {
+		f.comment = trimmedOrNil(&text)
+	}
+}
+
+func trimmedValues(values []string) []string {
+	trimmed := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); len(value) != 0 {
+			trimmed = append(trimmed, value)
+		}
+	}
+	return trimmed
+}
@@ -21,10 +21,18 @@ func TestNewExpenseFilter_ValidArgs_InstantiatesFilter(t *testing.T) {
 			intervalString: "day",
 			interval:       IntervalDay,
 		},
+		{
+			intervalString: "week",
+			interval:       IntervalWeek,
+		},
 		{
 			intervalString: "month",
 			interval:       IntervalMonth,
 		},
+		{
+			intervalString: "quarter",
+			interval:       IntervalQuarter,
+		},
 		{
 			intervalString: "year",
 			interval:       IntervalYear,
@@ -79,3 +87,216 @@ func TestNewExpenseFilter_InvalidArgs_ThrowsError(t *testing.T) {
 		assert.Nil(t, res)
 	}
 }
+
+func TestSetTagFilter_Tags_SetsNormalizedTags(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	res, resErr := NewExpenseFilter(from, to, "day", SetTagFilter([]string{"Business"}, []string{" gift"}))
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, []string{"business"}, res.Tags())
+	assert.Equal(t, []string{"gift"}, res.ExcludedTags())
+}
+
+func TestNewExpenseFilter_NoTagFilter_MatchesAllTags(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	res, resErr := NewExpenseFilter(from, to, "day")
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Empty(t, res.Tags())
+	assert.Empty(t, res.ExcludedTags())
+}
+
+func TestNewExpenseFilter_PeriodStartDay_SetsDay(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	defaultFilter, defaultErr := NewExpenseFilter(from, to, "month")
+	shiftedFilter, shiftedErr := NewExpenseFilter(from, to, "month", SetPeriodStartDay(25))
+
+	// Assert
+	assert.Nil(t, defaultErr)
+	assert.Nil(t, shiftedErr)
+	assert.Equal(t, DefaultPeriodStartDay, defaultFilter.PeriodStartDay())
+	assert.Equal(t, 25, shiftedFilter.PeriodStartDay())
+}
+
+func TestNewExpenseFilter_InvalidPeriodStartDay_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+
+	for _, day := range []int{29, -1} {
+		// Act
+		res, resErr := NewExpenseFilter(from, to, "month", SetPeriodStartDay(day))
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
+
+func TestNewExpenseFilter_ReportFilters_SetsFilters(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC)
+	minAmount, maxAmount := 10.0, 100.0
+
+	// Act
+	res, resErr := NewExpenseFilter(from, to, "month",
+		SetCategoryFilter([]string{" foodId", ""}, []string{"restaurantsId"}),
+		SetCurrencyFilter([]string{"eur", " usd "}),
+		SetTripFilter(" 60e1b5f1c8a4d53c9a1f3b2e "),
+		SetAmountFilter(&minAmount, &maxAmount),
+		SetCommentFilter(" pizza "))
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, []string{"foodId"}, res.Categories())
+	assert.Equal(t, []string{"restaurantsId"}, res.ExcludedCategories())
+	assert.Equal(t, []string{"EUR", "USD"}, res.Currencies())
+	assert.Equal(t, "60e1b5f1c8a4d53c9a1f3b2e", *res.TripID())
+	assert.Equal(t, minAmount, *res.MinAmount())
+	assert.Equal(t, maxAmount, *res.MaxAmount())
+	assert.Equal(t, "pizza", *res.Comment())
+}
+
+func TestNewExpenseFilter_EmptyReportFilters_MatchesAllExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	res, resErr := NewExpenseFilter(from, to, "day",
+		SetCategoryFilter(nil, nil), SetCurrencyFilter(nil), SetTripFilter(" "),
+		SetAmountFilter(nil, nil), SetCommentFilter(""))
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Empty(t, res.Categories())
+	assert.Empty(t, res.ExcludedCategories())
+	assert.Empty(t, res.Currencies())
+	assert.Nil(t, res.TripID())
+	assert.Nil(t, res.MinAmount())
+	assert.Nil(t, res.MaxAmount())
+	assert.Nil(t, res.Comment())
+}
+
+func TestNewExpenseFilter_MinAmountAboveMaxAmount_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+	minAmount, maxAmount := 100.0, 10.0
+
+	// Act
+	res, resErr := NewExpenseFilter(from, to, "month", SetAmountFilter(&minAmount, &maxAmount))
+
+	// Assert
+	assert.NotNil(t, resErr)
+	assert.Nil(t, res)
+}
+
+func TestIntervalDate_ReturnsIntervalStart(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	type test struct {
+		date           time.Time
+		interval       Interval
+		periodStartDay int
+		expected       time.Time
+	}
+	tests := []test{
+		{
+			date:           time.Date(2021, 7, 15, 0, 0, 0, 0, time.UTC),
+			interval:       IntervalWeek,
+			periodStartDay: 1,
+			expected:       time.Date(2021, 7, 12, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			date:           time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC),
+			interval:       IntervalWeek,
+			periodStartDay: 1,
+			expected:       time.Date(2020, 12, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			date:           time.Date(2021, 8, 15, 0, 0, 0, 0, time.UTC),
+			interval:       IntervalQuarter,
+			periodStartDay: 1,
+			expected:       time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			date:           time.Date(2021, 7, 24, 0, 0, 0, 0, time.UTC),
+			interval:       IntervalMonth,
+			periodStartDay: 25,
+			expected:       time.Date(2021, 6, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			date:           time.Date(2021, 7, 25, 0, 0, 0, 0, time.UTC),
+			interval:       IntervalMonth,
+			periodStartDay: 25,
+			expected:       time.Date(2021, 7, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			date:           time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+			interval:       IntervalMonth,
+			periodStartDay: 25,
+			expected:       time.Date(2020, 12, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			date:           time.Date(2021, 4, 20, 0, 0, 0, 0, time.UTC),
+			interval:       IntervalQuarter,
+			periodStartDay: 25,
+			expected:       time.Date(2021, 1, 25, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			date:           time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC),
+			interval:       IntervalYear,
+			periodStartDay: 25,
+			expected:       time.Date(2020, 1, 25, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res := intervalDate(tc.date, tc.interval, tc.periodStartDay)
+
+		// Assert
+		assert.Equal(t, tc.expected, res)
+	}
+}
+
+func TestIntervalDates_ShiftedMonths_FillsRange(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 31, 0, 0, 0, 0, time.UTC)
+	filter, _ := NewExpenseFilter(from, to, "month", SetPeriodStartDay(25))
+
+	// Act
+	res := intervalDates(*filter, nil)
+
+	// Assert
+	assert.Equal(t, []time.Time{
+		time.Date(2021, 6, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 7, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 8, 25, 0, 0, 0, 0, time.UTC),
+	}, res)
+}
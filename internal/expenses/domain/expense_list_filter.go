@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Defines values for SortField.
+const (
+	SortFieldDate SortField = "date"
+
+	SortFieldPrice SortField = "price"
+
+	SortFieldCreatedAt SortField = "createdAt"
+)
+
+// Defines values for SortOrder.
+const (
+	SortOrderAsc SortOrder = "asc"
+
+	SortOrderDesc SortOrder = "desc"
+)
+
+// Defines expense list page size limits.
+const (
+	DefaultPageSize int = 50
+	MaxPageSize     int = 200
+)
+
+// SortField defines a field to sort expenses by.
+type SortField string
+
+// SortOrder defines expenses sort order.
+type SortOrder string
+
+// ExpenseListFilter represents a filter to list expenses.
+type ExpenseListFilter struct {
+	from       *time.Time
+	to         *time.Time
+	categoryID *string
+	currency   *string
+	tripID     *string
+	text       *string
+	sortField  SortField
+	sortOrder  SortOrder
+	limit      int
+	cursor     *ExpenseCursor
+}
+
+// ExpenseListFilterParams holds raw expense list filter values.
+type ExpenseListFilterParams struct {
+	From       *time.Time
+	To         *time.Time
+	CategoryID *string
+	Currency   *string
+	TripID     *string
+	Text       *string
+	SortBy     *string
+	Order      *string
+	Limit      *int
+	Cursor     *string
+}
+
+// NewExpenseListFilter instantiates expense list filter.
+func NewExpenseListFilter(params ExpenseListFilterParams) (*ExpenseListFilter, error) {
+	if params.From != nil && params.To != nil && params.From.After(*params.To) {
+		return nil, errors.New("'from' date could not be after 'to' date")
+	}
+
+	sortField := SortFieldDate
+	if params.SortBy != nil {
+		switch SortField(*params.SortBy) {
+		case SortFieldDate, SortFieldPrice, SortFieldCreatedAt:
+			sortField = SortField(*params.SortBy)
+		default:
+			return nil, fmt.Errorf("unknown sort field %s", *params.SortBy)
+		}
+	}
+
+	sortOrder := SortOrderDesc
+	if params.Order != nil {
+		switch SortOrder(*params.Order) {
+		case SortOrderAsc, SortOrderDesc:
+			sortOrder = SortOrder(*params.Order)
+		default:
+			return nil, fmt.Errorf("unknown sort order %s", *params.Order)
+		}
+	}
+
+	limit := DefaultPageSize
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > MaxPageSize {
+			return nil, fmt.Errorf("limit should be between 1 and %d", MaxPageSize)
+		}
+		limit = *params.Limit
+	}
+
+	var cursor *ExpenseCursor
+	if params.Cursor != nil && len(*params.Cursor) != 0 {
+		decoded, decodedErr := DecodeExpenseCursor(*params.Cursor)
+		if decodedErr != nil {
+			return nil, errors.Wrap(decodedErr, "decode cursor")
+		}
+		if decoded.SortField() != sortField {
+			return nil, errors.New("cursor does not match sort field")
+		}
+		cursor = decoded
+	}
+
+	filter := &ExpenseListFilter{
+		from:       params.From,
+		to:         params.To,
+		categoryID: trimmedOrNil(params.CategoryID),
+		currency:   trimmedOrNil(params.Currency),
+		tripID:     trimmedOrNil(params.TripID),
+		text:       trimmedOrNil(params.Text),
+		sortField:  sortField,
+		sortOrder:  sortOrder,
+		limit:      limit,
+		cursor:     cursor,
+	}
+
+	return filter, nil
+}
+
+// From returns expense list filter from date.
+func (f ExpenseListFilter) From() *time.Time {
+	return f.from
+}
+
+// To returns expense list filter to date.
+func (f ExpenseListFilter) To() *time.Time {
+	return f.to
+}
+
+// CategoryID returns the root of the category subtree to filter by.
+func (f ExpenseListFilter) CategoryID() *string {
+	return f.categoryID
+}
+
+// WithCategoryID returns a copy of the filter limited to the category.
+func (f ExpenseListFilter) WithCategoryID(categoryID string) ExpenseListFilter {
+	f.categoryID = &categoryID
+	return f
+}
+
+// Currency returns expense list filter currency.
+func (f ExpenseListFilter) Currency() *string {
+	return f.currency
+}
+
+// TripID returns expense list filter trip id.
+func (f ExpenseListFilter) TripID() *string {
+	return f.tripID
+}
+
+// Text returns a text to match expense comment against.
+func (f ExpenseListFilter) Text() *string {
+	return f.text
+}
+
+// SortField returns expense list filter sort field.
+func (f ExpenseListFilter) SortField() SortField {
+	return f.sortField
+}
+
+// SortOrder returns expense list filter sort order.
+func (f ExpenseListFilter) SortOrder() SortOrder {
+	return f.sortOrder
+}
+
+// Limit returns expense list filter page size.
+func (f ExpenseListFilter) Limit() int {
+	return f.limit
+}
+
+// Cursor returns a cursor to continue listing from.
+func (f ExpenseListFilter) Cursor() *ExpenseCursor {
+	return f.cursor
+}
+
+// WithCursor returns a copy of the filter continuing from the cursor.
+func (f ExpenseListFilter) WithCursor(cursor ExpenseCursor) ExpenseListFilter {
+	f.cursor = &cursor
+	return f
+}
+
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	return &trimmed
+}
@@ -0,0 +1,133 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewExpenseListFilter_EmptyParams_UsesDefaults(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := domain.ExpenseListFilterParams{}
+
+	// Act
+	res, resErr := domain.NewExpenseListFilter(params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.NotNil(t, res)
+	assert.Equal(t, domain.SortFieldDate, res.SortField())
+	assert.Equal(t, domain.SortOrderDesc, res.SortOrder())
+	assert.Equal(t, domain.DefaultPageSize, res.Limit())
+	assert.Nil(t, res.Cursor())
+	assert.Nil(t, res.From())
+	assert.Nil(t, res.To())
+}
+
+func TestNewExpenseListFilter_ValidParams_InstantiatesFilter(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+	categoryID := "categoryId"
+	currency := " USD "
+	tripID := "tripId"
+	text := ""
+	sortBy := "price"
+	order := "asc"
+	limit := 10
+	params := domain.ExpenseListFilterParams{
+		From:       &from,
+		To:         &to,
+		CategoryID: &categoryID,
+		Currency:   &currency,
+		TripID:     &tripID,
+		Text:       &text,
+		SortBy:     &sortBy,
+		Order:      &order,
+		Limit:      &limit,
+	}
+
+	// Act
+	res, resErr := domain.NewExpenseListFilter(params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.NotNil(t, res)
+	assert.Equal(t, from, *res.From())
+	assert.Equal(t, to, *res.To())
+	assert.Equal(t, categoryID, *res.CategoryID())
+	assert.Equal(t, "USD", *res.Currency())
+	assert.Equal(t, tripID, *res.TripID())
+	assert.Nil(t, res.Text())
+	assert.Equal(t, domain.SortFieldPrice, res.SortField())
+	assert.Equal(t, domain.SortOrderAsc, res.SortOrder())
+	assert.Equal(t, limit, res.Limit())
+}
+
+func TestNewExpenseListFilter_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	from := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	unknown := "unknown"
+	tooBig := domain.MaxPageSize + 1
+	zero := 0
+	malformed := "%%%"
+	priceSort := "price"
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, from)
+	dateCursor := domain.NewExpenseCursor(*expense, domain.SortFieldDate).Encode()
+	tests := []domain.ExpenseListFilterParams{
+		{From: &from, To: &to},
+		{SortBy: &unknown},
+		{Order: &unknown},
+		{Limit: &tooBig},
+		{Limit: &zero},
+		{Cursor: &malformed},
+		{Cursor: &dateCursor, SortBy: &priceSort},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewExpenseListFilter(tc)
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
+
+func TestExpenseListFilter_WithCategoryID_ReplacesCategory(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	categoryID := "categoryId"
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{CategoryID: &categoryID})
+
+	// Act
+	res := filter.WithCategoryID("inboxId")
+
+	// Assert
+	assert.Equal(t, "inboxId", *res.CategoryID())
+	assert.Equal(t, categoryID, *filter.CategoryID(), "Should not change the original filter.")
+}
+
+func TestExpenseListFilter_WithCursor_ReplacesCursor(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	filter, _ := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{})
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now())
+	cursor := domain.NewExpenseCursor(*expense, domain.SortFieldDate)
+
+	// Act
+	res := filter.WithCursor(cursor)
+
+	// Assert
+	assert.Equal(t, "expenseId", res.Cursor().ID())
+	assert.Nil(t, filter.Cursor(), "Should not change the original filter.")
+}
@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const cursorSeparator string = "|"
+
+// ExpenseCursor points to the last expense of the listed page.
+type ExpenseCursor struct {
+	sortField SortField
+	value     string
+	id        string
+}
+
+// NewExpenseCursor creates a cursor pointing to the expense.
+func NewExpenseCursor(expense Expense, sortField SortField) ExpenseCursor {
+	var value string
+	switch sortField {
+	case SortFieldPrice:
+		value = expense.price.String()
+	case SortFieldCreatedAt:
+		value = expense.createdAt.UTC().Format(time.RFC3339Nano)
+	case SortFieldDate:
+		value = expense.date.UTC().Format(time.RFC3339Nano)
+	}
+
+	return ExpenseCursor{
+		sortField: sortField,
+		value:     value,
+		id:        expense.id,
+	}
+}
+
+// DecodeExpenseCursor decodes an opaque cursor string.
+func DecodeExpenseCursor(encoded string) (*ExpenseCursor, error) {
+	raw, rawErr := base64.RawURLEncoding.DecodeString(encoded)
+	if rawErr != nil {
+		return nil, errors.Wrap(rawErr, "decode base64")
+	}
+
+	parts := strings.Split(string(raw), cursorSeparator)
+	if len(parts) != 3 || len(parts[1]) == 0 || len(parts[2]) == 0 {
+		return nil, errors.New("malformed cursor")
+	}
+
+	cursor := &ExpenseCursor{
+		sortField: SortField(parts[0]),
+		value:     parts[1],
+		id:        parts[2],
+	}
+
+	return cursor, nil
+}
+
+// Encode returns an opaque cursor string.
+func (c ExpenseCursor) Encode() string {
+	raw := fmt.Sprintf("%s%s%s%s%s", c.sortField, cursorSeparator, c.value, cursorSeparator, c.id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// SortField returns the field the cursor was created for.
+func (c ExpenseCursor) SortField() SortField {
+	return c.sortField
+}
+
+// Value returns the sort field value of the last expense.
+func (c ExpenseCursor) Value() string {
+	return c.value
+}
+
+// ID returns the id of the last expense.
+func (c ExpenseCursor) ID() string {
+	return c.id
+}
+
+// ExpensePage holds a single page of listed expenses.
+type ExpensePage struct {
+	Expenses   []Expense
+	NextCursor *string
+}
+
+// NewExpensePage builds a page out of fetched expenses. The expenses are expected
+// to be fetched with one extra item to detect whether there is a next page.
+func NewExpensePage(expenses []Expense, limit int, sortField SortField) ExpensePage {
+	if len(expenses) <= limit {
+		return ExpensePage{
+			Expenses: expenses,
+		}
+	}
+
+	pageExpenses := expenses[:limit]
+	nextCursor := NewExpenseCursor(pageExpenses[len(pageExpenses)-1], sortField).Encode()
+
+	return ExpensePage{
+		Expenses:   pageExpenses,
+		NextCursor: &nextCursor,
+	}
+}
@@ -0,0 +1,89 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestExpenseCursor_EncodeDecode_ReturnsSameCursor(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := time.Date(2021, 7, 2, 10, 30, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 12.5, "EUR", 1, nil, nil, date)
+	type test struct {
+		sortField domain.SortField
+		value     string
+	}
+	tests := []test{
+		{sortField: domain.SortFieldDate, value: "2021-07-02T10:30:00Z"},
+		{sortField: domain.SortFieldPrice, value: "12.5"},
+	}
+
+	for _, tc := range tests {
+		// Act
+		encoded := domain.NewExpenseCursor(*expense, tc.sortField).Encode()
+		res, resErr := domain.DecodeExpenseCursor(encoded)
+
+		// Assert
+		assert.Nil(t, resErr)
+		assert.Equal(t, tc.sortField, res.SortField())
+		assert.Equal(t, tc.value, res.Value())
+		assert.Equal(t, "expenseId", res.ID())
+	}
+}
+
+func TestDecodeExpenseCursor_MalformedCursor_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := []string{"%%%", "ZGF0ZQ", "ZGF0ZXx8"}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.DecodeExpenseCursor(tc)
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
+
+func TestNewExpensePage_MoreExpensesThanLimit_ReturnsNextCursor(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense1, _ := domain.NewExpense("expense1", *category, 10, "EUR", 1, nil, nil, date)
+	expense2, _ := domain.NewExpense("expense2", *category, 10, "EUR", 1, nil, nil, date)
+	expense3, _ := domain.NewExpense("expense3", *category, 10, "EUR", 1, nil, nil, date)
+	expenses := []domain.Expense{*expense1, *expense2, *expense3}
+
+	// Act
+	page := domain.NewExpensePage(expenses, 2, domain.SortFieldDate)
+
+	// Assert
+	assert.Len(t, page.Expenses, 2)
+	assert.NotNil(t, page.NextCursor)
+	cursor, _ := domain.DecodeExpenseCursor(*page.NextCursor)
+	assert.Equal(t, "expense2", cursor.ID())
+}
+
+func TestNewExpensePage_LessExpensesThanLimit_ReturnsNoCursor(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense1, _ := domain.NewExpense("expense1", *category, 10, "EUR", 1, nil, nil, date)
+	expenses := []domain.Expense{*expense1}
+
+	// Act
+	page := domain.NewExpensePage(expenses, 2, domain.SortFieldDate)
+
+	// Assert
+	assert.Len(t, page.Expenses, 1)
+	assert.Nil(t, page.NextCursor)
+}
@@ -16,7 +16,7 @@ func TestNewExpense_ValidArgs_InstantiatesExpense(t *testing.T) {
 	quantity := 2.0
 	currency := "RUB"
 	category := Category{id: "catID"}
-	trip := "trip"
+	tripID := "tripId"
 	comment := "comment"
 	date := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
 	createdBy := "createdBy"
@@ -25,7 +25,7 @@ func TestNewExpense_ValidArgs_InstantiatesExpense(t *testing.T) {
 	updated := time.Date(2019, 8, 2, 0, 0, 0, 0, time.UTC)
 
 	// Act
-	res, resErr := NewExpense(id, category, price, currency, quantity, &comment, &trip, date,
+	res, resErr := NewExpense(id, category, price, currency, quantity, &comment, &tripID, date,
 		SetCreateMetadata(createdBy, created), SetUpdateMetadata(updatedBy, updated))
 
 	// Assert
@@ -37,7 +37,7 @@ func TestNewExpense_ValidArgs_InstantiatesExpense(t *testing.T) {
 	assert.Equal(t, currency, res.Currency())
 	assert.Equal(t, quantity, res.Quantity())
 	assert.Equal(t, &comment, res.Comment())
-	assert.Equal(t, &trip, res.Trip())
+	assert.Equal(t, &tripID, res.TripID())
 	assert.Equal(t, date, res.Date())
 	assert.Equal(t, createdBy, res.CreatedBy())
 	assert.Equal(t, created, res.CreatedAt())
@@ -109,6 +109,16 @@ func TestNewExpense_InvalidArgs_ThrowsError(t *testing.T) {
 			comment:  nil,
 			trip:     nil,
 		},
+		{
+			id:       "id",
+			price:    10,
+			quantity: 2,
+			currency: "USD",
+			category: Category{id: "cat", income: true},
+			date:     time.Now(),
+			comment:  nil,
+			trip:     nil,
+		},
 	}
 
 	for _, tc := range tests {
@@ -204,3 +214,77 @@ func TestCalculateTotal_WithExchangeRate_ReturnOriginalAndConvertedTotal(t *test
 		res.ConvertedTotal.Sum)
 	assert.Equal(t, exchangeRates.baseCurrency, res.ConvertedTotal.Currency)
 }
+
+func TestCalculateTotal_BaseCurrency_ReturnsTotalConvertedAtRateOfOne(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	exchangeRates := &ExchangeRates{
+		baseCurrency: "EUR",
+		rates: map[Currency]decimal.Decimal{
+			"USD": decimal.NewFromFloat(1.2),
+		},
+	}
+
+	// SUT
+	sut, _ := NewExpense("id", Category{}, 20, "EUR", 2, nil, nil, time.Now())
+
+	// Act
+	res := sut.CalculateTotal(exchangeRates)
+
+	// Assert
+	assert.True(t, decimal.NewFromInt(40).Equal(res.ConvertedTotal.Sum))
+	assert.Equal(t, Currency("EUR"), res.ConvertedTotal.Currency)
+	assert.True(t, decimal.NewFromInt(1).Equal(res.ExchangeRate.rate))
+}
+
+func TestSetTags_Tags_SetsNormalizedTags(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category := Category{id: "catID"}
+	date := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	res, resErr := NewExpense("id", category, 20, "EUR", 1, nil, nil, date,
+		SetTags([]string{"Reimbursable", "business", "reimbursable"}))
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, []string{"business", "reimbursable"}, res.Tags())
+}
+
+func TestSetReceipt_ReceiptID_SetsReceiptReference(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category := Category{id: "catID"}
+	date := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	res, resErr := NewExpense("id", category, 20, "EUR", 1, nil, nil, date, SetReceipt("receiptId"))
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "receiptId", *res.ReceiptID())
+}
+
+func TestSetSplit_InvalidSplit_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category := Category{id: "catID"}
+	date := time.Date(2021, 8, 2, 0, 0, 0, 0, time.UTC)
+	split, _ := NewSplit(SplitParams{
+		Method: "exact",
+		Shares: []SplitShareParams{{User: "alice", Value: 5}, {User: "bob", Value: 5}},
+	})
+
+	// Act
+	_, mismatchErr := NewExpense("id", category, 20, "EUR", 1, nil, nil, date, SetSplit("alice", *split))
+	_, noPayerErr := NewExpense("id", category, 10, "EUR", 1, nil, nil, date, SetSplit(" ", *split))
+	res, resErr := NewExpense("id", category, 5, "EUR", 2, nil, nil, date, SetSplit("alice", *split))
+
+	// Assert
+	assert.NotNil(t, mismatchErr, "Exact amounts should add up to the total.")
+	assert.NotNil(t, noPayerErr, "Shared expense should be paid by someone.")
+	assert.Nil(t, resErr)
+	assert.Equal(t, "alice", *res.PaidBy())
+	assert.Equal(t, split, res.Split())
+}
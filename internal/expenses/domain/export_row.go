@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCSV ExportFormat = "csv"
+
+	ExportFormatXLSX ExportFormat = "xlsx"
+
+	ExportFormatJSON ExportFormat = "json"
+)
+
+// ExportFormat defines a file format of exported expenses.
+type ExportFormat string
+
+// ParseExportFormat parses export format.
+func ParseExportFormat(format string) (ExportFormat, error) {
+	switch ExportFormat(format) {
+	case ExportFormatCSV, ExportFormatXLSX, ExportFormatJSON:
+		return ExportFormat(format), nil
+	default:
+		return "", fmt.Errorf("unknown export format %s", format)
+	}
+}
+
+// ExportRow represents a single flat exported expense.
+type ExportRow struct {
+	ID                string
+	Date              time.Time
+	CategoryID        string
+	CategoryPath      []string
+	Comment           *string
+	Trip              *string
+	Price             decimal.Decimal
+	Quantity          decimal.Decimal
+	Total             decimal.Decimal
+	Currency          Currency
+	ConvertedTotal    *decimal.Decimal
+	ConvertedCurrency *Currency
+	ExchangeRate      *decimal.Decimal
+}
+
+// NewExportRow flattens an expense with calculated totals and its trip into an export row.
+func NewExportRow(expense Expense, trip *Trip) ExportRow {
+	row := ExportRow{
+		ID:           expense.id,
+		Date:         expense.date,
+		CategoryID:   expense.category.id,
+		CategoryPath: expense.category.PathNames(),
+		Comment:      expense.comment,
+		Price:        expense.price,
+		Quantity:     expense.quantity,
+		Total:        expense.totalInfo.OriginalTotal.Sum,
+		Currency:     expense.totalInfo.OriginalTotal.Currency,
+	}
+
+	if trip != nil {
+		name := trip.name
+		row.Trip = &name
+	}
+	if converted := expense.totalInfo.ConvertedTotal; converted != nil {
+		sum := converted.Sum
+		currency := converted.Currency
+		row.ConvertedTotal = &sum
+		row.ConvertedCurrency = &currency
+	}
+	if rate := expense.totalInfo.ExchangeRate; rate != nil {
+		value := rate.rate
+		row.ExchangeRate = &value
+	}
+
+	return row
+}
@@ -0,0 +1,75 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestParseExportFormat_KnownFormat_ReturnsFormat(t *testing.T) {
+	t.Parallel()
+	// Act
+	result, err := domain.ParseExportFormat("xlsx")
+
+	// Assert
+	assert.Nil(t, err, "Error result should be nil.")
+	assert.Equal(t, domain.ExportFormatXLSX, result, "Should return the format.")
+}
+
+func TestParseExportFormat_UnknownFormat_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Act
+	_, err := domain.ParseExportFormat("pdf")
+
+	// Assert
+	assert.NotNil(t, err, "Error result should not be nil.")
+}
+
+func TestNewExportRow_ConvertedExpense_ReturnsFlatRow(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	parentID := "foodId"
+	root, _ := domain.NewCategory("rootId", nil, "Root", nil, 1, "|rootId")
+	food, _ := domain.NewCategory(parentID, nil, "Food", nil, 2, "|rootId|foodId")
+	category, _ := domain.NewCategory("groceriesId", &parentID, "Groceries", nil, 3, "|rootId|foodId|groceriesId")
+	category.SetParents(&[]domain.Category{*food, *root})
+	date := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	tripID := "tripId"
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "USD", 2, nil, &tripID, date)
+	trip, _ := domain.NewTrip(tripID, domain.TripParams{Name: "Paris 2021", From: date, To: date, HomeCurrency: "EUR"})
+	rates, _ := domain.NewExchageRate(date, "EUR", map[string]float64{"USD": 2})
+	expense.CalculateTotal(rates)
+
+	// Act
+	result := domain.NewExportRow(*expense, trip)
+
+	// Assert
+	assert.Equal(t, "expenseId", result.ID, "Should return expense id.")
+	assert.Equal(t, "Paris 2021", *result.Trip, "Should return trip name.")
+	assert.Equal(t, []string{"Root", "Food", "Groceries"}, result.CategoryPath, "Should return top level first.")
+	assert.True(t, decimal.NewFromInt(20).Equal(result.Total), "Should return original total.")
+	assert.True(t, decimal.NewFromInt(10).Equal(*result.ConvertedTotal), "Should return converted total.")
+	assert.Equal(t, domain.Currency("EUR"), *result.ConvertedCurrency, "Should return converted currency.")
+	assert.True(t, decimal.NewFromInt(2).Equal(*result.ExchangeRate), "Should return exchange rate.")
+}
+
+func TestNewExportRow_NotConvertedExpense_ReturnsRowWithoutConversion(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "USD", 1, nil, nil, time.Now())
+	expense.CalculateTotal(nil)
+
+	// Act
+	result := domain.NewExportRow(*expense, nil)
+
+	// Assert
+	assert.Nil(t, result.Trip, "Trip should be nil.")
+	assert.Equal(t, []string{"Food"}, result.CategoryPath, "Should return category name.")
+	assert.Nil(t, result.ConvertedTotal, "Converted total should be nil.")
+	assert.Nil(t, result.ExchangeRate, "Exchange rate should be nil.")
+}
@@ -1,11 +1,21 @@
 package domain
 
+import "github.com/shopspring/decimal"
+
 // GrandTotal holds multi total amounts.
 type GrandTotal struct {
 	SubTotals map[Currency]TotalInfo
 	Total     Total
 }
 
+// newGrandTotal returns zero grand total in the currency.
+func newGrandTotal(currency Currency) GrandTotal {
+	return GrandTotal{
+		SubTotals: make(map[Currency]TotalInfo),
+		Total:     Total{Sum: decimal.Zero, Currency: currency},
+	}
+}
+
 // Combine combines two grand total structs together.
 func (gt GrandTotal) Combine(grandTotal GrandTotal) GrandTotal {
 	if gt.SubTotals == nil {
@@ -33,3 +43,19 @@ func (gt GrandTotal) Add(t TotalInfo) GrandTotal {
 
 	return gt
 }
+
+// Sum returns the total in the currency. Subtotals in other currencies are counted
+// only when they are converted into the currency.
+func (gt GrandTotal) Sum(currency Currency) decimal.Decimal {
+	sum := decimal.Zero
+	for subTotalCurrency, subTotal := range gt.SubTotals {
+		switch {
+		case subTotalCurrency == currency:
+			sum = sum.Add(subTotal.OriginalTotal.Sum)
+		case subTotal.ConvertedTotal != nil && subTotal.ConvertedTotal.Currency == currency:
+			sum = sum.Add(subTotal.ConvertedTotal.Sum)
+		}
+	}
+
+	return sum
+}
@@ -105,3 +105,28 @@ func TestAdd_AddsTotalToGrandTotal(t *testing.T) {
 	assert.Equal(t, decimal.NewFromInt(75), result.SubTotals["USD"].OriginalTotal.Sum)
 	assert.Equal(t, decimal.NewFromInt(100), result.SubTotals["SEK"].OriginalTotal.Sum)
 }
+
+func TestSum_SumsTotalsInCurrency(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	gt := domain.GrandTotal{
+		SubTotals: map[domain.Currency]domain.TotalInfo{
+			domain.Currency("EUR"): {
+				OriginalTotal: domain.Total{Sum: decimal.NewFromInt(10), Currency: "EUR"},
+			},
+			domain.Currency("USD"): {
+				OriginalTotal:  domain.Total{Sum: decimal.NewFromInt(20), Currency: "USD"},
+				ConvertedTotal: &domain.Total{Sum: decimal.NewFromInt(15), Currency: "EUR"},
+			},
+			domain.Currency("SEK"): {
+				OriginalTotal: domain.Total{Sum: decimal.NewFromInt(100), Currency: "SEK"},
+			},
+		},
+	}
+
+	// Act
+	result := gt.Sum("EUR")
+
+	// Assert
+	assert.True(t, decimal.NewFromInt(25).Equal(result), "Should skip subtotals that were not converted.")
+}
@@ -0,0 +1,285 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// Defines supported decimal separators.
+const (
+	DecimalSeparatorDot   string = "."
+	DecimalSeparatorComma string = ","
+)
+
+const defaultImportDelimiter string = ","
+
+// dateFormatTokens maps human friendly date format tokens to Go layout elements.
+// Longer tokens go first, so that "yyyy" is not consumed as two "yy".
+// nolint:gochecknoglobals
+var dateFormatTokens = []struct {
+	token  string
+	layout string
+}{
+	{"yyyy", "2006"},
+	{"yy", "06"},
+	{"MM", "01"},
+	{"dd", "02"},
+	{"HH", "15"},
+	{"mm", "04"},
+	{"ss", "05"},
+}
+
+// ImportColumns defines CSV column names holding expense fields.
+type ImportColumns struct {
+	Date     string
+	Amount   string
+	Currency *string
+	Quantity *string
+	Comment  *string
+	Category string
+}
+
+// ImportProfileParams holds raw import profile values.
+type ImportProfileParams struct {
+	ID               string
+	Name             string
+	Columns          ImportColumns
+	DateFormat       string
+	DecimalSeparator string
+	Delimiter        *string
+	DefaultCurrency  *string
+}
+
+// ImportProfile represents a reusable mapping of CSV columns to expense fields.
+type ImportProfile struct {
+	id               string
+	name             string
+	columns          ImportColumns
+	dateFormat       string
+	dateLayout       string
+	decimalSeparator string
+	delimiter        rune
+	defaultCurrency  *string
+}
+
+// NewImportProfile instantiates import profile.
+func NewImportProfile(params ImportProfileParams) (*ImportProfile, error) {
+	name := strings.TrimSpace(params.Name)
+	if len(name) == 0 {
+		return nil, errors.New("empty name")
+	}
+
+	columns := ImportColumns{
+		Date:     strings.TrimSpace(params.Columns.Date),
+		Amount:   strings.TrimSpace(params.Columns.Amount),
+		Currency: trimmedOrNil(params.Columns.Currency),
+		Quantity: trimmedOrNil(params.Columns.Quantity),
+		Comment:  trimmedOrNil(params.Columns.Comment),
+		Category: strings.TrimSpace(params.Columns.Category),
+	}
+	if len(columns.Date) == 0 || len(columns.Amount) == 0 || len(columns.Category) == 0 {
+		return nil, errors.New("date, amount and category columns are required")
+	}
+
+	defaultCurrency := trimmedOrNil(params.DefaultCurrency)
+	if columns.Currency == nil && defaultCurrency == nil {
+		return nil, errors.New("either currency column or default currency is required")
+	}
+
+	dateLayout, dateLayoutErr := DateFormatToLayout(params.DateFormat)
+	if dateLayoutErr != nil {
+		return nil, dateLayoutErr
+	}
+
+	switch params.DecimalSeparator {
+	case DecimalSeparatorDot, DecimalSeparatorComma:
+	default:
+		return nil, fmt.Errorf("unsupported decimal separator %q", params.DecimalSeparator)
+	}
+
+	delimiter := defaultImportDelimiter
+	if params.Delimiter != nil && len(*params.Delimiter) != 0 {
+		delimiter = *params.Delimiter
+	}
+	if utf8.RuneCountInString(delimiter) != 1 || delimiter == "\"" || delimiter == "\n" || delimiter == "\r" {
+		return nil, fmt.Errorf("unsupported delimiter %q", delimiter)
+	}
+	delimiterRune, _ := utf8.DecodeRuneInString(delimiter)
+	if string(delimiterRune) == params.DecimalSeparator {
+		return nil, errors.New("delimiter could not be the same as decimal separator")
+	}
+
+	profile := &ImportProfile{
+		id:               params.ID,
+		name:             name,
+		columns:          columns,
+		dateFormat:       params.DateFormat,
+		dateLayout:       dateLayout,
+		decimalSeparator: params.DecimalSeparator,
+		delimiter:        delimiterRune,
+		defaultCurrency:  defaultCurrency,
+	}
+
+	return profile, nil
+}
+
+// ID returns import profile id.
+func (p ImportProfile) ID() string {
+	return p.id
+}
+
+// Name returns import profile name.
+func (p ImportProfile) Name() string {
+	return p.name
+}
+
+// Columns returns CSV columns mapping.
+func (p ImportProfile) Columns() ImportColumns {
+	return p.columns
+}
+
+// DateFormat returns date format, e.g. dd.MM.yyyy.
+func (p ImportProfile) DateFormat() string {
+	return p.dateFormat
+}
+
+// DecimalSeparator returns amounts decimal separator.
+func (p ImportProfile) DecimalSeparator() string {
+	return p.decimalSeparator
+}
+
+// Delimiter returns CSV fields delimiter.
+func (p ImportProfile) Delimiter() rune {
+	return p.delimiter
+}
+
+// DefaultCurrency returns a currency to use when the currency column is not mapped or empty.
+func (p ImportProfile) DefaultCurrency() *string {
+	return p.defaultCurrency
+}
+
+// ParseDate parses a date according to the profile date format.
+func (p ImportProfile) ParseDate(value string) (time.Time, error) {
+	date, dateErr := time.Parse(p.dateLayout, strings.TrimSpace(value))
+	if dateErr != nil {
+		return time.Time{}, fmt.Errorf("date %q does not match format %s", value, p.dateFormat)
+	}
+	return date, nil
+}
+
+// ParseDecimal parses a number according to the profile decimal separator.
+// The other separator is treated as a thousands separator.
+func (p ImportProfile) ParseDecimal(value string) (float64, error) {
+	thousandsSeparator := DecimalSeparatorComma
+	if p.decimalSeparator == DecimalSeparatorComma {
+		thousandsSeparator = DecimalSeparatorDot
+	}
+
+	normalized := strings.TrimSpace(value)
+	normalized = strings.ReplaceAll(normalized, " ", "")
+	normalized = strings.ReplaceAll(normalized, thousandsSeparator, "")
+	normalized = strings.ReplaceAll(normalized, p.decimalSeparator, ".")
+
+	number, numberErr := strconv.ParseFloat(normalized, 64)
+	if numberErr != nil {
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+	return number, nil
+}
+
+// MapRecord maps CSV record fields keyed by column name into a new expense.
+func (p ImportProfile) MapRecord(
+	record map[string]string,
+	resolver CategoryResolver,
+	opts ...func(*Expense),
+) (*Expense, error) {
+	problems := make([]string, 0)
+
+	date, dateErr := p.ParseDate(record[p.columns.Date])
+	if dateErr != nil {
+		problems = append(problems, dateErr.Error())
+	}
+
+	price, priceErr := p.ParseDecimal(record[p.columns.Amount])
+	if priceErr != nil {
+		problems = append(problems, fmt.Sprintf("amount: %s", priceErr))
+	}
+
+	quantity := 1.0
+	if p.columns.Quantity != nil && len(strings.TrimSpace(record[*p.columns.Quantity])) != 0 {
+		var quantityErr error
+		quantity, quantityErr = p.ParseDecimal(record[*p.columns.Quantity])
+		if quantityErr != nil {
+			problems = append(problems, fmt.Sprintf("quantity: %s", quantityErr))
+		}
+	}
+
+	var currency string
+	if p.columns.Currency != nil {
+		currency = strings.ToUpper(strings.TrimSpace(record[*p.columns.Currency]))
+	}
+	if len(currency) == 0 && p.defaultCurrency != nil {
+		currency = *p.defaultCurrency
+	}
+
+	var comment *string
+	if p.columns.Comment != nil {
+		commentValue := record[*p.columns.Comment]
+		comment = trimmedOrNil(&commentValue)
+	}
+
+	category, categoryErr := resolver.Resolve(record[p.columns.Category])
+	if categoryErr != nil {
+		problems = append(problems, categoryErr.Error())
+	}
+
+	if len(problems) != 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpense, strings.Join(problems, "; "))
+	}
+
+	expense, expenseErr := NewExpense("", *category, price, currency, quantity, comment, nil, date, opts...)
+	if expenseErr != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpense, expenseErr)
+	}
+
+	return expense, nil
+}
+
+// DateFormatToLayout converts a date format like dd.MM.yyyy into Go time layout.
+func DateFormatToLayout(format string) (string, error) {
+	if len(strings.TrimSpace(format)) == 0 {
+		return "", errors.New("empty date format")
+	}
+
+	var layout strings.Builder
+	var hasYear, hasMonth, hasDay bool
+	for rest := format; len(rest) != 0; {
+		matched := false
+		for _, dateToken := range dateFormatTokens {
+			if strings.HasPrefix(rest, dateToken.token) {
+				layout.WriteString(dateToken.layout)
+				rest = rest[len(dateToken.token):]
+				hasYear = hasYear || dateToken.token[0] == 'y'
+				hasMonth = hasMonth || dateToken.token == "MM"
+				hasDay = hasDay || dateToken.token == "dd"
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			layout.WriteByte(rest[0])
+			rest = rest[1:]
+		}
+	}
+
+	if !hasYear || !hasMonth || !hasDay {
+		return "", fmt.Errorf("date format %s should contain year, month and day", format)
+	}
+
+	return layout.String(), nil
+}
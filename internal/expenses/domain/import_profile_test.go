@@ -0,0 +1,171 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func validImportProfileParams() domain.ImportProfileParams {
+	currency := "Currency"
+	comment := "Comment"
+	delimiter := ";"
+	return domain.ImportProfileParams{
+		Name: " bank ",
+		Columns: domain.ImportColumns{
+			Date:     "Date",
+			Amount:   "Amount",
+			Currency: &currency,
+			Comment:  &comment,
+			Category: "Category",
+		},
+		DateFormat:       "dd.MM.yyyy",
+		DecimalSeparator: ",",
+		Delimiter:        &delimiter,
+	}
+}
+
+func TestNewImportProfile_ValidParams_InstantiatesProfile(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := validImportProfileParams()
+
+	// Act
+	res, resErr := domain.NewImportProfile(params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.NotNil(t, res)
+	assert.Equal(t, "bank", res.Name())
+	assert.Equal(t, ';', res.Delimiter())
+	assert.Equal(t, "dd.MM.yyyy", res.DateFormat())
+	assert.Equal(t, ",", res.DecimalSeparator())
+}
+
+func TestNewImportProfile_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	doubleSemicolon := ";;"
+	comma := ","
+	noName := validImportProfileParams()
+	noName.Name = " "
+	noDateColumn := validImportProfileParams()
+	noDateColumn.Columns.Date = ""
+	noCurrency := validImportProfileParams()
+	noCurrency.Columns.Currency = nil
+	noDay := validImportProfileParams()
+	noDay.DateFormat = "MM.yyyy"
+	unknownSeparator := validImportProfileParams()
+	unknownSeparator.DecimalSeparator = "'"
+	longDelimiter := validImportProfileParams()
+	longDelimiter.Delimiter = &doubleSemicolon
+	sameDelimiter := validImportProfileParams()
+	sameDelimiter.Delimiter = &comma
+	tests := []domain.ImportProfileParams{
+		noName, noDateColumn, noCurrency, noDay, unknownSeparator, longDelimiter, sameDelimiter,
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewImportProfile(tc)
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
+
+func TestImportProfile_ParseDate_UsesDateFormat(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := validImportProfileParams()
+	params.DateFormat = "yyyy/MM/dd HH:mm"
+	profile, _ := domain.NewImportProfile(params)
+
+	// Act
+	res, resErr := profile.ParseDate(" 2021/07/02 13:45 ")
+	_, invalidErr := profile.ParseDate("02.07.2021")
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, time.Date(2021, 7, 2, 13, 45, 0, 0, time.UTC), res)
+	assert.NotNil(t, invalidErr)
+}
+
+func TestImportProfile_ParseDecimal_UsesDecimalSeparator(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	commaProfile, _ := domain.NewImportProfile(validImportProfileParams())
+	dotParams := validImportProfileParams()
+	dotParams.DecimalSeparator = "."
+	dotParams.Delimiter = nil
+	dotProfile, _ := domain.NewImportProfile(dotParams)
+
+	// Act
+	commaRes, commaErr := commaProfile.ParseDecimal("1.234,56")
+	dotRes, dotErr := dotProfile.ParseDecimal("1,234.56")
+	_, invalidErr := dotProfile.ParseDecimal("abc")
+
+	// Assert
+	assert.Nil(t, commaErr)
+	assert.Equal(t, 1234.56, commaRes)
+	assert.Nil(t, dotErr)
+	assert.Equal(t, 1234.56, dotRes)
+	assert.NotNil(t, invalidErr)
+}
+
+func TestImportProfile_MapRecord_ValidRecord_ReturnsExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	profile, _ := domain.NewImportProfile(validImportProfileParams())
+	parentID := "parentId"
+	parent, _ := domain.NewCategory(parentID, nil, "Food", nil, 1, "|parentId")
+	category, _ := domain.NewCategory("categoryId", &parentID, "Groceries", nil, 2, "|parentId|categoryId")
+	resolver := domain.NewCategoryResolver([]domain.Category{*parent, *category})
+	record := map[string]string{
+		"Date":     "02.07.2021",
+		"Amount":   "12,50",
+		"Currency": "eur",
+		"Comment":  " milk ",
+		"Category": "Food / Groceries",
+	}
+
+	// Act
+	res, resErr := profile.MapRecord(record, resolver)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "categoryId", res.Category().ID())
+	assert.Equal(t, 12.5, res.Price())
+	assert.Equal(t, 1.0, res.Quantity())
+	assert.Equal(t, "EUR", res.Currency())
+	assert.Equal(t, "milk", *res.Comment())
+	assert.Equal(t, time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC), res.Date())
+}
+
+func TestImportProfile_MapRecord_InvalidRecord_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	profile, _ := domain.NewImportProfile(validImportProfileParams())
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	resolver := domain.NewCategoryResolver([]domain.Category{*category})
+	tests := []map[string]string{
+		{"Date": "2021-07-02", "Amount": "12,50", "Currency": "EUR", "Category": "Food"},
+		{"Date": "02.07.2021", "Amount": "-12,50", "Currency": "EUR", "Category": "Food"},
+		{"Date": "02.07.2021", "Amount": "12,50", "Currency": "EUR", "Category": "Unknown"},
+		{"Date": "02.07.2021", "Amount": "12,50", "Currency": "", "Category": "Food"},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := profile.MapRecord(tc, resolver)
+
+		// Assert
+		assert.Nil(t, res)
+		assert.True(t, errors.Is(resErr, domain.ErrInvalidExpense))
+	}
+}
@@ -0,0 +1,130 @@
+package domain
+
+import "fmt"
+
+// Defines values for ImportMode.
+const (
+	ImportModeDryRun ImportMode = "dryRun"
+
+	ImportModeAtomic ImportMode = "atomic"
+)
+
+// Defines values for ImportRowStatus.
+const (
+	ImportRowStatusAccepted ImportRowStatus = "accepted"
+
+	ImportRowStatusRejected ImportRowStatus = "rejected"
+
+	ImportRowStatusSkipped ImportRowStatus = "skipped"
+)
+
+// ImportMode defines how imported expenses are committed.
+// Dry run only validates rows, atomic commits all rows or none of them.
+type ImportMode string
+
+// ImportRowStatus defines whether an imported row was accepted.
+// Skipped rows, e.g. already imported transactions, do not prevent an atomic commit.
+type ImportRowStatus string
+
+// ImportRow holds an outcome of a single imported row.
+type ImportRow struct {
+	Row     int
+	Status  ImportRowStatus
+	Reason  *string
+	Expense *Expense
+}
+
+// ImportReport holds a per-row outcome of an import.
+type ImportReport struct {
+	Mode      ImportMode
+	Rows      []ImportRow
+	Accepted  int
+	Rejected  int
+	Skipped   int
+	Committed bool
+}
+
+// ParseImportMode parses import mode.
+func ParseImportMode(mode string) (ImportMode, error) {
+	switch ImportMode(mode) {
+	case ImportModeDryRun, ImportModeAtomic:
+		return ImportMode(mode), nil
+	default:
+		return "", fmt.Errorf("unknown import mode %s", mode)
+	}
+}
+
+// NewImportReport creates an empty import report.
+func NewImportReport(mode ImportMode) ImportReport {
+	return ImportReport{
+		Mode: mode,
+		Rows: []ImportRow{},
+	}
+}
+
+// Accept adds an accepted row to the report.
+func (r *ImportReport) Accept(row int, expense Expense) {
+	r.Rows = append(r.Rows, ImportRow{
+		Row:     row,
+		Status:  ImportRowStatusAccepted,
+		Expense: &expense,
+	})
+	r.Accepted++
+}
+
+// Reject adds a rejected row to the report.
+func (r *ImportReport) Reject(row int, reason string) {
+	r.Rows = append(r.Rows, ImportRow{
+		Row:    row,
+		Status: ImportRowStatusRejected,
+		Reason: &reason,
+	})
+	r.Rejected++
+}
+
+// Skip adds a skipped row to the report.
+func (r *ImportReport) Skip(row int, reason string) {
+	r.Rows = append(r.Rows, ImportRow{
+		Row:    row,
+		Status: ImportRowStatusSkipped,
+		Reason: &reason,
+	})
+	r.Skipped++
+}
+
+// SkipImported turns accepted rows of the already imported transactions into skipped rows.
+func (r *ImportReport) SkipImported(externalIDs []string) {
+	imported := make(map[string]bool, len(externalIDs))
+	for _, externalID := range externalIDs {
+		imported[externalID] = true
+	}
+	for index, row := range r.Rows {
+		if row.Expense == nil || row.Expense.ExternalID() == nil || !imported[*row.Expense.ExternalID()] {
+			continue
+		}
+		reason := "transaction " + *row.Expense.ExternalID() + " is already imported"
+		r.Rows[index] = ImportRow{
+			Row:    row.Row,
+			Status: ImportRowStatusSkipped,
+			Reason: &reason,
+		}
+		r.Accepted--
+		r.Skipped++
+	}
+}
+
+// CanCommit indicates whether accepted expenses should be persisted.
+func (r ImportReport) CanCommit() bool {
+	return r.Mode == ImportModeAtomic && r.Rejected == 0 && r.Accepted != 0
+}
+
+// AcceptedExpenses returns expenses of accepted rows.
+func (r ImportReport) AcceptedExpenses() []Expense {
+	expenses := make([]Expense, 0, r.Accepted)
+	for _, row := range r.Rows {
+		if row.Expense != nil {
+			expenses = append(expenses, *row.Expense)
+		}
+	}
+	return expenses
+}
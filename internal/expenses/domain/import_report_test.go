@@ -0,0 +1,102 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestParseImportMode_KnownMode_ReturnsMode(t *testing.T) {
+	t.Parallel()
+	// Act
+	dryRun, dryRunErr := domain.ParseImportMode("dryRun")
+	atomic, atomicErr := domain.ParseImportMode("atomic")
+	_, unknownErr := domain.ParseImportMode("unknown")
+
+	// Assert
+	assert.Nil(t, dryRunErr)
+	assert.Equal(t, domain.ImportModeDryRun, dryRun)
+	assert.Nil(t, atomicErr)
+	assert.Equal(t, domain.ImportModeAtomic, atomic)
+	assert.NotNil(t, unknownErr)
+}
+
+func TestImportReport_AcceptAndReject_CountsRows(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("", *category, 10, "EUR", 1, nil, nil, time.Now())
+	report := domain.NewImportReport(domain.ImportModeAtomic)
+
+	// Act
+	report.Accept(1, *expense)
+	report.Reject(2, "reason")
+
+	// Assert
+	assert.Equal(t, 1, report.Accepted)
+	assert.Equal(t, 1, report.Rejected)
+	assert.Len(t, report.Rows, 2)
+	assert.Equal(t, domain.ImportRowStatusRejected, report.Rows[1].Status)
+	assert.Equal(t, "reason", *report.Rows[1].Reason)
+	assert.Len(t, report.AcceptedExpenses(), 1)
+	assert.False(t, report.CanCommit())
+}
+
+func TestImportReport_CanCommit_OnlyAtomicWithoutRejectedRows(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("", *category, 10, "EUR", 1, nil, nil, time.Now())
+	atomic := domain.NewImportReport(domain.ImportModeAtomic)
+	atomic.Accept(1, *expense)
+	dryRun := domain.NewImportReport(domain.ImportModeDryRun)
+	dryRun.Accept(1, *expense)
+	empty := domain.NewImportReport(domain.ImportModeAtomic)
+
+	// Assert
+	assert.True(t, atomic.CanCommit())
+	assert.False(t, dryRun.CanCommit())
+	assert.False(t, empty.CanCommit())
+}
+
+func TestImportReport_Skip_DoesNotPreventCommit(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("", *category, 10, "EUR", 1, nil, nil, time.Now())
+	report := domain.NewImportReport(domain.ImportModeAtomic)
+
+	// Act
+	report.Accept(1, *expense)
+	report.Skip(2, "duplicate")
+
+	// Assert
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, domain.ImportRowStatusSkipped, report.Rows[1].Status)
+	assert.Len(t, report.AcceptedExpenses(), 1)
+	assert.True(t, report.CanCommit())
+}
+
+func TestImportReport_SkipImported_SkipsAcceptedRowsOfTheTransactions(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	imported, _ := domain.NewExpense("", *category, 10, "EUR", 1, nil, nil, time.Now(), domain.SetExternalID("fit1"))
+	fresh, _ := domain.NewExpense("", *category, 5, "EUR", 1, nil, nil, time.Now(), domain.SetExternalID("fit2"))
+	report := domain.NewImportReport(domain.ImportModeAtomic)
+	report.Accept(1, *imported)
+	report.Accept(2, *fresh)
+
+	// Act
+	report.SkipImported([]string{"fit1"})
+
+	// Assert
+	assert.Equal(t, 1, report.Accepted)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, domain.ImportRowStatusSkipped, report.Rows[0].Status)
+	assert.Nil(t, report.Rows[0].Expense)
+	assert.Equal(t, "fit2", *report.AcceptedExpenses()[0].ExternalID())
+}
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// IncomeParams holds raw income values.
+type IncomeParams struct {
+	Source     string
+	CategoryID string
+	Amount     float64
+	Currency   string
+	Date       time.Time
+	Comment    *string
+}
+
+// Income represents money received by the household, e.g. a salary or a refund.
+type Income struct {
+	id         string
+	source     string
+	categoryID string
+	amount     decimal.Decimal
+	currency   Currency
+	date       time.Time
+	comment    *string
+	totalInfo  TotalInfo
+}
+
+// NewIncome instantiates income. The category belongs to the income category tree.
+func NewIncome(id string, params IncomeParams) (*Income, error) {
+	source := strings.TrimSpace(params.Source)
+	if len(source) == 0 {
+		return nil, errors.New("empty income source")
+	}
+
+	categoryID := strings.TrimSpace(params.CategoryID)
+	if len(categoryID) == 0 {
+		return nil, errors.New("empty income category")
+	}
+
+	if params.Amount <= 0 {
+		return nil, errors.New("amount should be grater than zero")
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(params.Currency))
+	if len(currency) == 0 {
+		return nil, errors.New("empty currency")
+	}
+
+	if params.Date.IsZero() {
+		return nil, errors.New("empty date")
+	}
+
+	var comment *string
+	if params.Comment != nil {
+		trimmedComment := strings.TrimSpace(*params.Comment)
+		if len(trimmedComment) != 0 {
+			comment = &trimmedComment
+		}
+	}
+
+	return &Income{
+		id:         id,
+		source:     source,
+		categoryID: categoryID,
+		amount:     decimal.NewFromFloat(params.Amount),
+		currency:   Currency(currency),
+		date:       params.Date,
+		comment:    comment,
+	}, nil
+}
+
+// ID returns income id.
+func (i Income) ID() string {
+	return i.id
+}
+
+// Source returns who the income is received from.
+func (i Income) Source() string {
+	return i.source
+}
+
+// CategoryID returns income category id.
+func (i Income) CategoryID() string {
+	return i.categoryID
+}
+
+// Amount returns income amount.
+func (i Income) Amount() float64 {
+	amount, _ := i.amount.Float64()
+	return amount
+}
+
+// Currency returns income currency.
+func (i Income) Currency() Currency {
+	return i.currency
+}
+
+// Date returns income date.
+func (i Income) Date() time.Time {
+	return i.date
+}
+
+// Comment returns income comment.
+func (i Income) Comment() *string {
+	return i.comment
+}
+
+// TotalInfo returns income total info calculated last.
+func (i Income) TotalInfo() TotalInfo {
+	return i.totalInfo
+}
+
+// CalculateTotal calculates income totals values.
+func (i *Income) CalculateTotal(exchangeRate *ExchangeRates) TotalInfo {
+	i.totalInfo = newTotalInfo(i.amount, i.currency, exchangeRate)
+	return i.totalInfo
+}
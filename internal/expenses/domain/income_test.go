@@ -0,0 +1,79 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewIncome_ValidArgs_InstantiatesIncome(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := utcDate(2021, time.July, 1)
+	comment := " July salary "
+
+	// Act
+	res, resErr := domain.NewIncome("incomeId", domain.IncomeParams{
+		Source:     " ACME ",
+		CategoryID: "salaryId",
+		Amount:     3200.5,
+		Currency:   "eur",
+		Date:       date,
+		Comment:    &comment,
+	})
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "incomeId", res.ID())
+	assert.Equal(t, "ACME", res.Source())
+	assert.Equal(t, "salaryId", res.CategoryID())
+	assert.Equal(t, 3200.5, res.Amount())
+	assert.Equal(t, domain.Currency("EUR"), res.Currency())
+	assert.Equal(t, date, res.Date())
+	assert.Equal(t, "July salary", *res.Comment())
+}
+
+func TestNewIncome_InvalidArgs_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := utcDate(2021, time.July, 1)
+	tests := []domain.IncomeParams{
+		{Source: " ", CategoryID: "salaryId", Amount: 1, Currency: "EUR", Date: date},
+		{Source: "ACME", CategoryID: "", Amount: 1, Currency: "EUR", Date: date},
+		{Source: "ACME", CategoryID: "salaryId", Amount: 0, Currency: "EUR", Date: date},
+		{Source: "ACME", CategoryID: "salaryId", Amount: 1, Currency: "", Date: date},
+		{Source: "ACME", CategoryID: "salaryId", Amount: 1, Currency: "EUR"},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewIncome("", tc)
+
+		// Assert
+		assert.Nil(t, res)
+		assert.NotNil(t, resErr)
+	}
+}
+
+func TestIncome_CalculateTotal_WithExchangeRate_ReturnsConvertedTotal(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := utcDate(2021, time.July, 1)
+	income, _ := domain.NewIncome("incomeId", domain.IncomeParams{
+		Source: "ACME", CategoryID: "salaryId", Amount: 100, Currency: "USD", Date: date,
+	})
+	rates, _ := domain.NewExchageRate(date, "EUR", map[string]float64{"USD": 2})
+
+	// Act
+	res := income.CalculateTotal(rates)
+
+	// Assert
+	assert.True(t, decimal.NewFromInt(100).Equal(res.OriginalTotal.Sum))
+	assert.True(t, decimal.NewFromInt(50).Equal(res.ConvertedTotal.Sum))
+	assert.Equal(t, domain.Currency("EUR"), res.ConvertedTotal.Currency)
+	assert.Equal(t, res, income.TotalInfo())
+}
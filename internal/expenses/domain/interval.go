@@ -4,10 +4,22 @@ package domain
 const (
 	IntervalDay Interval = "day"
 
+	IntervalWeek Interval = "week"
+
 	IntervalMonth Interval = "month"
 
+	IntervalQuarter Interval = "quarter"
+
 	IntervalYear Interval = "year"
 )
 
+// Defines limits of the period start day.
+const (
+	DefaultPeriodStartDay = 1
+
+	// MaxPeriodStartDay is the last day every month has.
+	MaxPeriodStartDay = 28
+)
+
 // Interval defines model for Interval.
 type Interval string
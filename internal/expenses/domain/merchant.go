@@ -0,0 +1,176 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// MerchantParams holds raw merchant values.
+type MerchantParams struct {
+	Name              string
+	Aliases           []string
+	DefaultCategoryID *string
+	Location          *string
+}
+
+// Merchant represents a shop or a payee expenses are paid to.
+// Aliases are alternative names the merchant appears under, e.g. in bank statements.
+type Merchant struct {
+	id                string
+	name              string
+	aliases           []string
+	defaultCategoryID *string
+	location          *string
+}
+
+// NewMerchant instantiates merchant. Aliases are trimmed and deduplicated case-insensitively,
+// an alias equal to the merchant name is dropped.
+func NewMerchant(id string, params MerchantParams) (*Merchant, error) {
+	name := strings.TrimSpace(params.Name)
+	if len(name) == 0 {
+		return nil, errors.New("empty name")
+	}
+	if len(splitWords(name)) == 0 {
+		return nil, errors.New("name has no words")
+	}
+
+	aliases := make([]string, 0, len(params.Aliases))
+	seen := map[string]bool{MerchantKey(name): true}
+	for _, alias := range params.Aliases {
+		alias = strings.TrimSpace(alias)
+		if len(splitWords(alias)) == 0 {
+			return nil, errors.Errorf("alias %q has no words", alias)
+		}
+		if seen[MerchantKey(alias)] {
+			continue
+		}
+		seen[MerchantKey(alias)] = true
+		aliases = append(aliases, alias)
+	}
+
+	return &Merchant{
+		id:                id,
+		name:              name,
+		aliases:           aliases,
+		defaultCategoryID: trimmedOrNil(params.DefaultCategoryID),
+		location:          trimmedOrNil(params.Location),
+	}, nil
+}
+
+// ID returns merchant id.
+func (m Merchant) ID() string {
+	return m.id
+}
+
+// Name returns merchant name.
+func (m Merchant) Name() string {
+	return m.name
+}
+
+// Aliases returns alternative merchant names.
+func (m Merchant) Aliases() []string {
+	return m.aliases
+}
+
+// DefaultCategoryID returns a category of expenses paid to the merchant unless known otherwise.
+func (m Merchant) DefaultCategoryID() *string {
+	return m.defaultCategoryID
+}
+
+// Location returns merchant location, e.g. an address.
+func (m Merchant) Location() *string {
+	return m.location
+}
+
+// Merge returns the merchant absorbing other merchants, their names and aliases become aliases of the merchant.
+// The default category and location of the merchant are kept unless missing.
+func (m Merchant) Merge(others []Merchant) (*Merchant, error) {
+	params := MerchantParams{
+		Name:              m.name,
+		Aliases:           append([]string{}, m.aliases...),
+		DefaultCategoryID: m.defaultCategoryID,
+		Location:          m.location,
+	}
+	for _, other := range others {
+		if other.id == m.id {
+			return nil, errors.New("merchant could not be merged into itself")
+		}
+		params.Aliases = append(params.Aliases, other.name)
+		params.Aliases = append(params.Aliases, other.aliases...)
+		if params.DefaultCategoryID == nil {
+			params.DefaultCategoryID = other.defaultCategoryID
+		}
+		if params.Location == nil {
+			params.Location = other.location
+		}
+	}
+
+	return NewMerchant(m.id, params)
+}
+
+// MerchantKey returns a key of the merchant name or alias, names differing in case,
+// punctuation and spacing share the key.
+func MerchantKey(name string) string {
+	return strings.Join(splitWords(name), " ")
+}
+
+// MerchantMatcher finds merchants by their names and aliases mentioned in a text,
+// e.g. a bank statement payee like "REWE SAGT DANKE 1234" matches the "Rewe" alias.
+type MerchantMatcher struct {
+	patterns []merchantPattern
+}
+
+type merchantPattern struct {
+	words    []string
+	merchant Merchant
+}
+
+// NewMerchantMatcher builds a matcher out of all available merchants.
+// Longer names and aliases take precedence as more specific ones.
+func NewMerchantMatcher(merchants []Merchant) MerchantMatcher {
+	patterns := make([]merchantPattern, 0, len(merchants))
+	for _, merchant := range merchants {
+		for _, name := range append([]string{merchant.name}, merchant.aliases...) {
+			patterns = append(patterns, merchantPattern{words: splitWords(name), merchant: merchant})
+		}
+	}
+	sort.SliceStable(patterns, func(i, j int) bool {
+		return len(patterns[i].words) > len(patterns[j].words)
+	})
+
+	return MerchantMatcher{patterns: patterns}
+}
+
+// Match returns a merchant whose name or alias words appear in the text in a row, nil if none does.
+func (m MerchantMatcher) Match(text string) *Merchant {
+	words := splitWords(text)
+	for _, pattern := range m.patterns {
+		if containsWords(words, pattern.words) {
+			merchant := pattern.merchant
+			return &merchant
+		}
+	}
+	return nil
+}
+
+// containsWords checks whether the words contain the sequence.
+func containsWords(words []string, sequence []string) bool {
+	if len(sequence) == 0 {
+		return false
+	}
+	for start := 0; start+len(sequence) <= len(words); start++ {
+		matched := true
+		for index, word := range sequence {
+			if words[start+index] != word {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MerchantStats represents spending history of a merchant. Totals are kept per currency as expenses
+// paid to the same merchant could be in different currencies.
+// A visit is a receipt, an expense without a receipt is a visit on its own.
+type MerchantStats struct {
+	Merchant      Merchant
+	TotalSpent    []Total
+	AverageTicket []Total
+	VisitCount    int
+	LastVisit     *time.Time
+}
+
+// NewMerchantStats calculates merchant stats out of the expenses paid to the merchant.
+func NewMerchantStats(merchant Merchant, expenses []Expense) MerchantStats {
+	sums := make(map[Currency]decimal.Decimal)
+	visits := make(map[Currency]map[string]bool)
+	allVisits := make(map[string]bool)
+	var lastVisit *time.Time
+	for _, expense := range expenses {
+		total := expense.CalculateTotal(nil).OriginalTotal
+		sums[total.Currency] = sums[total.Currency].Add(total.Sum)
+
+		visit := "expense:" + expense.id
+		if expense.receiptID != nil {
+			visit = "receipt:" + *expense.receiptID
+		}
+		if visits[total.Currency] == nil {
+			visits[total.Currency] = make(map[string]bool)
+		}
+		visits[total.Currency][visit] = true
+		allVisits[visit] = true
+
+		if lastVisit == nil || expense.date.After(*lastVisit) {
+			date := expense.date
+			lastVisit = &date
+		}
+	}
+
+	currencies := make([]Currency, 0, len(sums))
+	for currency := range sums {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i] < currencies[j]
+	})
+
+	stats := MerchantStats{
+		Merchant:      merchant,
+		TotalSpent:    make([]Total, 0, len(currencies)),
+		AverageTicket: make([]Total, 0, len(currencies)),
+		VisitCount:    len(allVisits),
+		LastVisit:     lastVisit,
+	}
+	for _, currency := range currencies {
+		stats.TotalSpent = append(stats.TotalSpent, Total{Sum: sums[currency], Currency: currency})
+		stats.AverageTicket = append(stats.AverageTicket, Total{
+			Sum:      sums[currency].Div(decimal.NewFromInt(int64(len(visits[currency])))).Round(2),
+			Currency: currency,
+		})
+	}
+
+	return stats
+}
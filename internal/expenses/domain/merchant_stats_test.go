@@ -0,0 +1,52 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewMerchantStats_Expenses_CountsReceiptsAsVisits(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	bread, _ := domain.NewExpense("breadId", *food, 1.25, "EUR", 2, nil, nil,
+		utcDate(2021, time.July, 1), domain.SetReceipt("receiptId"))
+	milk, _ := domain.NewExpense("milkId", *food, 1.5, "EUR", 1, nil, nil,
+		utcDate(2021, time.July, 1), domain.SetReceipt("receiptId"))
+	water, _ := domain.NewExpense("waterId", *food, 2, "EUR", 1, nil, nil, utcDate(2021, time.July, 3))
+	snack, _ := domain.NewExpense("snackId", *food, 3, "USD", 1, nil, nil, utcDate(2021, time.June, 20))
+	merchant := newMerchant("reweId", "Rewe")
+
+	// Act
+	res := domain.NewMerchantStats(merchant, []domain.Expense{*bread, *milk, *water, *snack})
+
+	// Assert
+	assert.Equal(t, "reweId", res.Merchant.ID())
+	assert.Equal(t, 3, res.VisitCount)
+	assert.Equal(t, utcDate(2021, time.July, 3), *res.LastVisit)
+	assert.Len(t, res.TotalSpent, 2)
+	assert.True(t, res.TotalSpent[0].Equal(domain.Total{Sum: decimal.NewFromInt(6), Currency: "EUR"}))
+	assert.True(t, res.TotalSpent[1].Equal(domain.Total{Sum: decimal.NewFromInt(3), Currency: "USD"}))
+	assert.True(t, res.AverageTicket[0].Equal(domain.Total{Sum: decimal.NewFromInt(3), Currency: "EUR"}))
+	assert.True(t, res.AverageTicket[1].Equal(domain.Total{Sum: decimal.NewFromInt(3), Currency: "USD"}))
+}
+
+func TestNewMerchantStats_NoExpenses_ReturnsEmptyStats(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	merchant := newMerchant("reweId", "Rewe")
+
+	// Act
+	res := domain.NewMerchantStats(merchant, nil)
+
+	// Assert
+	assert.Equal(t, 0, res.VisitCount)
+	assert.Nil(t, res.LastVisit)
+	assert.Empty(t, res.TotalSpent)
+	assert.Empty(t, res.AverageTicket)
+}
@@ -0,0 +1,125 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newMerchant(id, name string, aliases ...string) domain.Merchant {
+	merchant, _ := domain.NewMerchant(id, domain.MerchantParams{Name: name, Aliases: aliases})
+	return *merchant
+}
+
+func TestNewMerchant_ValidParams_NormalizesValues(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	categoryID := " foodId "
+	location := " "
+	params := domain.MerchantParams{
+		Name:              " Rewe ",
+		Aliases:           []string{" REWE Markt ", "rewe", "Rewe-Markt", "Rewe City"},
+		DefaultCategoryID: &categoryID,
+		Location:          &location,
+	}
+
+	// Act
+	res, resErr := domain.NewMerchant("merchantId", params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "merchantId", res.ID())
+	assert.Equal(t, "Rewe", res.Name())
+	assert.Equal(t, []string{"REWE Markt", "Rewe City"}, res.Aliases())
+	assert.Equal(t, "foodId", *res.DefaultCategoryID())
+	assert.Nil(t, res.Location())
+}
+
+func TestNewMerchant_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	for name, params := range map[string]domain.MerchantParams{
+		"empty name":    {Name: " "},
+		"no name words": {Name: "--"},
+		"empty alias":   {Name: "Rewe", Aliases: []string{" "}},
+	} {
+		// Act
+		res, resErr := domain.NewMerchant("merchantId", params)
+
+		// Assert
+		assert.Nil(t, res, name)
+		assert.NotNil(t, resErr, name)
+	}
+}
+
+func TestMerchant_Merge_AddsNamesAsAliases(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	categoryID := "foodId"
+	location := "Main St. 1"
+	target := newMerchant("reweId", "Rewe", "Rewe Markt")
+	source, _ := domain.NewMerchant("reweCityId", domain.MerchantParams{
+		Name:              "REWE City",
+		Aliases:           []string{"rewe markt", "Rewe To Go"},
+		DefaultCategoryID: &categoryID,
+		Location:          &location,
+	})
+
+	// Act
+	res, resErr := target.Merge([]domain.Merchant{*source})
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "reweId", res.ID())
+	assert.Equal(t, "Rewe", res.Name())
+	assert.Equal(t, []string{"Rewe Markt", "REWE City", "Rewe To Go"}, res.Aliases())
+	assert.Equal(t, &categoryID, res.DefaultCategoryID())
+	assert.Equal(t, &location, res.Location())
+}
+
+func TestMerchant_MergeItself_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	target := newMerchant("reweId", "Rewe")
+
+	// Act
+	res, resErr := target.Merge([]domain.Merchant{target})
+
+	// Assert
+	assert.Nil(t, res)
+	assert.NotNil(t, resErr)
+}
+
+func TestMerchantMatcher_Match_ReturnsLongestAliasMerchant(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	matcher := domain.NewMerchantMatcher([]domain.Merchant{
+		newMerchant("reweId", "Rewe"),
+		newMerchant("reweToGoId", "Rewe To Go", "REWE TOGO"),
+		newMerchant("shellId", "Shell"),
+	})
+
+	for text, expected := range map[string]string{
+		"REWE SAGT DANKE 1234":     "reweId",
+		"Kartenzahlung REWE-To-Go": "reweToGoId",
+		"rewe togo berlin":         "reweToGoId",
+		"SHELL 4711 // fuel":       "shellId",
+		"Shellfish market":         "",
+		"Rewe to":                  "reweId",
+		"":                         "",
+	} {
+		// Act
+		res := matcher.Match(text)
+
+		// Assert
+		if expected == "" {
+			assert.Nil(t, res, text)
+			continue
+		}
+		if assert.NotNil(t, res, text) {
+			assert.Equal(t, expected, res.ID(), text)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// ReceiptParams holds raw receipt values shared by all line items.
+type ReceiptParams struct {
+	Date     time.Time
+	Comment  *string
+	Currency string
+}
+
+// Receipt represents a single purchase split into line items. Line items are regular expenses
+// of their own categories, so reports treat them as individual expenses.
+type Receipt struct {
+	id          string
+	date        time.Time
+	comment     *string
+	currency    Currency
+	items       []Expense
+	attachments []Attachment
+}
+
+// NewReceipt instantiates receipt with line item totals calculated.
+// Line items should be paid in the receipt currency.
+func NewReceipt(id string, params ReceiptParams, items []Expense) (*Receipt, error) {
+	if params.Date.IsZero() {
+		return nil, errors.New("empty receipt date")
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(params.Currency))
+	if len(currency) == 0 {
+		return nil, errors.New("empty receipt currency")
+	}
+
+	if len(items) == 0 {
+		return nil, errors.New("receipt has no line items")
+	}
+	receiptItems := make([]Expense, 0, len(items))
+	for _, item := range items {
+		if Currency(item.Currency()) != Currency(currency) {
+			return nil, errors.Errorf("line item currency %s differs from receipt currency %s",
+				item.Currency(), currency)
+		}
+		item.CalculateTotal(nil)
+		receiptItems = append(receiptItems, item)
+	}
+
+	return &Receipt{
+		id:       id,
+		date:     params.Date,
+		comment:  params.Comment,
+		currency: Currency(currency),
+		items:    receiptItems,
+	}, nil
+}
+
+// ID returns receipt id.
+func (r Receipt) ID() string {
+	return r.id
+}
+
+// Date returns purchase date.
+func (r Receipt) Date() time.Time {
+	return r.date
+}
+
+// Comment returns receipt comment, e.g. a shop name.
+func (r Receipt) Comment() *string {
+	return r.comment
+}
+
+// Currency returns receipt currency.
+func (r Receipt) Currency() Currency {
+	return r.currency
+}
+
+// Items returns receipt line items.
+func (r Receipt) Items() []Expense {
+	return r.items
+}
+
+// Attachments returns files attached to the receipt line items, e.g. a photo of the receipt.
+func (r Receipt) Attachments() []Attachment {
+	return r.attachments
+}
+
+// SetAttachments sets files attached to the receipt line items.
+func (r *Receipt) SetAttachments(attachments []Attachment) {
+	r.attachments = attachments
+}
+
+// Total returns the receipt total, a sum of line item totals.
+func (r Receipt) Total() Total {
+	sum := decimal.Zero
+	for _, item := range r.items {
+		sum = sum.Add(item.TotalInfo().OriginalTotal.Sum)
+	}
+	return Total{
+		Sum:      sum,
+		Currency: r.currency,
+	}
+}
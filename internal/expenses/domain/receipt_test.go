@@ -0,0 +1,70 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newReceiptItems(currency string) []domain.Expense {
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	home, _ := domain.NewCategory("homeId", nil, "Home", nil, 1, "|homeId")
+	date := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	bread, _ := domain.NewExpense("breadId", *food, 1.25, currency, 2, nil, nil, date)
+	soap, _ := domain.NewExpense("soapId", *home, 3.1, currency, 1, nil, nil, date)
+	return []domain.Expense{*bread, *soap}
+}
+
+func TestNewReceipt_ValidParams_ReturnsReceiptWithTotal(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	comment := "Grocery store"
+	params := domain.ReceiptParams{
+		Date:     time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		Comment:  &comment,
+		Currency: " eur ",
+	}
+
+	// Act
+	res, resErr := domain.NewReceipt("receiptId", params, newReceiptItems("EUR"))
+
+	// Assert
+	assert.Nil(t, resErr, "Error should be nil.")
+	assert.Equal(t, "receiptId", res.ID())
+	assert.Equal(t, params.Date, res.Date())
+	assert.Equal(t, &comment, res.Comment())
+	assert.Equal(t, domain.Currency("EUR"), res.Currency())
+	assert.Len(t, res.Items(), 2)
+	assert.Equal(t, "5.6", res.Total().Sum.String())
+	assert.Equal(t, domain.Currency("EUR"), res.Total().Currency)
+}
+
+func TestNewReceipt_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	date := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		params domain.ReceiptParams
+		items  []domain.Expense
+	}{
+		"empty date":         {params: domain.ReceiptParams{Currency: "EUR"}, items: newReceiptItems("EUR")},
+		"empty currency":     {params: domain.ReceiptParams{Date: date}, items: newReceiptItems("EUR")},
+		"no items":           {params: domain.ReceiptParams{Date: date, Currency: "EUR"}, items: nil},
+		"different currency": {params: domain.ReceiptParams{Date: date, Currency: "EUR"}, items: newReceiptItems("USD")},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			res, resErr := domain.NewReceipt("receiptId", tc.params, tc.items)
+
+			// Assert
+			assert.Nil(t, res, "Result should be nil.")
+			assert.NotNil(t, resErr, "Error should not be nil.")
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Defines values for ReconciliationStatus.
+const (
+	ReconciliationStatusOpen ReconciliationStatus = "open"
+
+	ReconciliationStatusFinished ReconciliationStatus = "finished"
+)
+
+// ReconciliationStatus defines whether expenses could still be ticked off in a reconciliation.
+type ReconciliationStatus string
+
+// ReconciliationParams holds raw reconciliation values.
+type ReconciliationParams struct {
+	AccountID        string
+	StatementDate    time.Time
+	StatementBalance float64
+	ExpenseIDs       []string
+	CreatedAt        time.Time
+	FinishedAt       *time.Time
+}
+
+// Reconciliation represents a session of checking expenses of an account against a bank statement.
+// Expenses ticked off in the session are locked when it is finished.
+type Reconciliation struct {
+	id               string
+	accountID        string
+	statementDate    time.Time
+	statementBalance decimal.Decimal
+	expenseIDs       []string
+	createdAt        time.Time
+	finishedAt       *time.Time
+}
+
+// NewReconciliation instantiates reconciliation, it is finished once it has the finish date.
+func NewReconciliation(id string, params ReconciliationParams) (*Reconciliation, error) {
+	accountID := strings.TrimSpace(params.AccountID)
+	if len(accountID) == 0 {
+		return nil, errors.New("empty account")
+	}
+
+	if params.StatementDate.IsZero() {
+		return nil, errors.New("empty statement date")
+	}
+
+	expenseIDs := make([]string, 0, len(params.ExpenseIDs))
+	seen := make(map[string]bool, len(params.ExpenseIDs))
+	for _, expenseID := range params.ExpenseIDs {
+		if seen[expenseID] {
+			continue
+		}
+		seen[expenseID] = true
+		expenseIDs = append(expenseIDs, expenseID)
+	}
+
+	return &Reconciliation{
+		id:               id,
+		accountID:        accountID,
+		statementDate:    params.StatementDate,
+		statementBalance: decimal.NewFromFloat(params.StatementBalance),
+		expenseIDs:       expenseIDs,
+		createdAt:        params.CreatedAt,
+		finishedAt:       params.FinishedAt,
+	}, nil
+}
+
+// ID returns reconciliation id.
+func (r Reconciliation) ID() string {
+	return r.id
+}
+
+// AccountID returns an ID of the reconciled account.
+func (r Reconciliation) AccountID() string {
+	return r.accountID
+}
+
+// StatementDate returns the end date of the bank statement.
+func (r Reconciliation) StatementDate() time.Time {
+	return r.statementDate
+}
+
+// StatementBalance returns the account balance at the end of the bank statement.
+func (r Reconciliation) StatementBalance() float64 {
+	statementBalance, _ := r.statementBalance.Float64()
+	return statementBalance
+}
+
+// ExpenseIDs returns IDs of the ticked off expenses.
+func (r Reconciliation) ExpenseIDs() []string {
+	return r.expenseIDs
+}
+
+// CreatedAt returns reconciliation start date.
+func (r Reconciliation) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// FinishedAt returns reconciliation finish date.
+func (r Reconciliation) FinishedAt() *time.Time {
+	return r.finishedAt
+}
+
+// Status returns reconciliation status.
+func (r Reconciliation) Status() ReconciliationStatus {
+	if r.finishedAt != nil {
+		return ReconciliationStatusFinished
+	}
+	return ReconciliationStatusOpen
+}
+
+// Tick replaces the ticked off expenses. Only unlocked expenses of the account up to the statement date
+// could be ticked off, the expenses are the candidates.
+func (r *Reconciliation) Tick(expenseIDs []string, expenses []Expense) error {
+	if r.finishedAt != nil {
+		return errors.New("reconciliation is finished already")
+	}
+
+	candidates := make(map[string]Expense, len(expenses))
+	for _, expense := range expenses {
+		candidates[expense.id] = expense
+	}
+
+	ticked := make([]string, 0, len(expenseIDs))
+	seen := make(map[string]bool, len(expenseIDs))
+	for _, expenseID := range expenseIDs {
+		if seen[expenseID] {
+			continue
+		}
+		seen[expenseID] = true
+
+		expense, ok := candidates[expenseID]
+		if !ok || !r.isCandidate(expense) {
+			return errors.Errorf("expense %s could not be reconciled", expenseID)
+		}
+		ticked = append(ticked, expenseID)
+	}
+	r.expenseIDs = ticked
+
+	return nil
+}
+
+func (r Reconciliation) isCandidate(expense Expense) bool {
+	return expense.accountID != nil && *expense.accountID == r.accountID &&
+		!expense.date.After(r.statementDate) && !expense.Locked()
+}
+
+func (r Reconciliation) ticked(expenseID string) bool {
+	for _, id := range r.expenseIDs {
+		if id == expenseID {
+			return true
+		}
+	}
+	return false
+}
+
+// ReconciliationItem represents an unreconciled expense of a reconciliation.
+type ReconciliationItem struct {
+	Expense Expense
+	Ticked  bool
+}
+
+// ReconciliationReport represents a reconciliation compared with the recorded account balance.
+type ReconciliationReport struct {
+	Reconciliation Reconciliation
+	Currency       Currency
+	Items          []ReconciliationItem
+	ClearedBalance decimal.Decimal
+	Difference     decimal.Decimal
+}
+
+// NewReconciliationReport compares the statement balance with the cleared balance of the account at the
+// statement date. Locked and ticked off expenses and all transfers are cleared, unlocked expenses up to
+// the statement date are listed as the reconciliation items.
+func NewReconciliationReport(
+	reconciliation Reconciliation,
+	account Account,
+	expenses []Expense,
+	transfers []Transfer,
+	rates []ExchangeRates,
+) (*ReconciliationReport, error) {
+	items := make([]ReconciliationItem, 0)
+	cleared := make([]Expense, 0, len(expenses))
+	for _, expense := range expenses {
+		if expense.date.After(reconciliation.statementDate) {
+			continue
+		}
+		ticked := reconciliation.ticked(expense.id)
+		if expense.Locked() || ticked {
+			cleared = append(cleared, expense)
+		}
+		if !expense.Locked() {
+			items = append(items, ReconciliationItem{Expense: expense, Ticked: ticked})
+		}
+	}
+
+	balance, balanceErr := NewAccountBalance(account, reconciliation.statementDate, cleared, transfers, rates)
+	if balanceErr != nil {
+		return nil, balanceErr
+	}
+
+	return &ReconciliationReport{
+		Reconciliation: reconciliation,
+		Currency:       account.currency,
+		Items:          items,
+		ClearedBalance: balance.Balance,
+		Difference:     reconciliation.statementBalance.Sub(balance.Balance),
+	}, nil
+}
+
+// Finish returns the reconciliation finished at the moment. The statement balance should match
+// the cleared balance.
+func (r ReconciliationReport) Finish(at time.Time) (*Reconciliation, error) {
+	if r.Reconciliation.finishedAt != nil {
+		return nil, errors.New("reconciliation is finished already")
+	}
+	if !r.Difference.IsZero() {
+		return nil, errors.Errorf("difference %s %s should be zero", r.Difference.StringFixed(2), r.Currency)
+	}
+
+	finished := r.Reconciliation
+	finished.finishedAt = &at
+	return &finished, nil
+}
@@ -0,0 +1,130 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newReconciliation(t *testing.T, statementBalance float64) domain.Reconciliation {
+	t.Helper()
+	reconciliation, reconciliationErr := domain.NewReconciliation("reconciliationId", domain.ReconciliationParams{
+		AccountID:        "cardId",
+		StatementDate:    utcDate(2021, time.July, 31),
+		StatementBalance: statementBalance,
+	})
+	assert.Nil(t, reconciliationErr)
+	return *reconciliation
+}
+
+func newReconciliationExpense(
+	t *testing.T, id string, price float64, date time.Time, opts ...func(*domain.Expense),
+) domain.Expense {
+	t.Helper()
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	expense, expenseErr := domain.NewExpense(id, *category, price, "EUR", 1, nil, nil, date,
+		append(opts, domain.SetAccount("cardId"))...)
+	assert.Nil(t, expenseErr)
+	return *expense
+}
+
+func TestNewReconciliation_InvalidParams_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := []domain.ReconciliationParams{
+		{AccountID: " ", StatementDate: utcDate(2021, time.July, 31)},
+		{AccountID: "cardId"},
+	}
+
+	for _, params := range tests {
+		// Act
+		res, err := domain.NewReconciliation("reconciliationId", params)
+
+		// Assert
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestReconciliation_Tick_AcceptsUnlockedExpensesUpToStatementDate(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expenses := []domain.Expense{
+		newReconciliationExpense(t, "groceriesId", 50, utcDate(2021, time.July, 10)),
+		newReconciliationExpense(t, "lockedId", 20, utcDate(2021, time.July, 5), domain.SetReconciliation("otherId")),
+		newReconciliationExpense(t, "laterId", 10, utcDate(2021, time.August, 2)),
+	}
+	tests := []struct {
+		ids   []string
+		valid bool
+	}{
+		{[]string{"groceriesId", "groceriesId"}, true},
+		{[]string{"lockedId"}, false},
+		{[]string{"laterId"}, false},
+		{[]string{"unknownId"}, false},
+	}
+
+	for _, test := range tests {
+		reconciliation := newReconciliation(t, 0)
+
+		// Act
+		err := reconciliation.Tick(test.ids, expenses)
+
+		// Assert
+		assert.Equal(t, test.valid, err == nil, test.ids)
+		if test.valid {
+			assert.Equal(t, []string{"groceriesId"}, reconciliation.ExpenseIDs())
+		}
+	}
+}
+
+func TestNewReconciliationReport_TickedExpenses_CalculatesDifference(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	account := newAccount(t, "cardId", "EUR", 1000)
+	expenses := []domain.Expense{
+		newReconciliationExpense(t, "lockedId", 100, utcDate(2021, time.July, 5), domain.SetReconciliation("otherId")),
+		newReconciliationExpense(t, "groceriesId", 50, utcDate(2021, time.July, 10)),
+		newReconciliationExpense(t, "dinnerId", 30, utcDate(2021, time.July, 20)),
+	}
+	transfer, _ := domain.NewTransfer("", domain.TransferParams{
+		FromAccountID: "cardId", ToAccountID: "cashId", Amount: 200, Date: utcDate(2021, time.July, 15),
+	})
+	reconciliation := newReconciliation(t, 650)
+	_ = reconciliation.Tick([]string{"groceriesId"}, expenses)
+
+	// Act
+	res, err := domain.NewReconciliationReport(reconciliation, account, expenses,
+		[]domain.Transfer{*transfer}, nil)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "650", res.ClearedBalance.String())
+	assert.True(t, res.Difference.IsZero())
+	assert.Len(t, res.Items, 2, "Locked expenses should not be listed.")
+	assert.True(t, res.Items[0].Ticked)
+	assert.False(t, res.Items[1].Ticked)
+}
+
+func TestReconciliationReport_Finish_RequiresZeroDifference(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	account := newAccount(t, "cardId", "EUR", 100)
+	at := utcDate(2021, time.August, 1)
+	balanced, _ := domain.NewReconciliationReport(newReconciliation(t, 100), account, nil, nil, nil)
+	unbalanced, _ := domain.NewReconciliationReport(newReconciliation(t, 90), account, nil, nil, nil)
+
+	// Act
+	finished, finishedErr := balanced.Finish(at)
+	unfinished, unfinishedErr := unbalanced.Finish(at)
+
+	// Assert
+	assert.Nil(t, finishedErr)
+	assert.Equal(t, domain.ReconciliationStatusFinished, finished.Status())
+	assert.Equal(t, at, *finished.FinishedAt())
+	assert.Nil(t, unfinished)
+	assert.NotNil(t, unfinishedErr)
+}
@@ -0,0 +1,321 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Defines occurrences preview size limits.
+const (
+	DefaultPreviewSize int = 12
+	MaxPreviewSize     int = 100
+)
+
+// OccurrenceExceptionParams holds raw values of a skipped or edited single occurrence.
+type OccurrenceExceptionParams struct {
+	Date     time.Time
+	Skipped  bool
+	Price    *float64
+	Quantity *float64
+	Comment  *string
+}
+
+// OccurrenceException represents a single occurrence that differs from the rest of the series.
+type OccurrenceException struct {
+	date     time.Time
+	skipped  bool
+	price    *float64
+	quantity *float64
+	comment  *string
+}
+
+// NewOccurrenceException instantiates occurrence exception.
+func NewOccurrenceException(params OccurrenceExceptionParams) (*OccurrenceException, error) {
+	if params.Date.IsZero() {
+		return nil, errors.New("empty occurrence date")
+	}
+	if params.Skipped && (params.Price != nil || params.Quantity != nil || params.Comment != nil) {
+		return nil, errors.New("skipped occurrence could not be edited")
+	}
+	if !params.Skipped && params.Price == nil && params.Quantity == nil && params.Comment == nil {
+		return nil, errors.New("occurrence should be either skipped or edited")
+	}
+	if params.Price != nil && *params.Price <= 0 {
+		return nil, errors.New("price should be grater than zero")
+	}
+	if params.Quantity != nil && *params.Quantity <= 0 {
+		return nil, errors.New("quantity should be grater than zero")
+	}
+
+	exception := &OccurrenceException{
+		date:     truncateToDay(params.Date),
+		skipped:  params.Skipped,
+		price:    params.Price,
+		quantity: params.Quantity,
+		comment:  params.Comment,
+	}
+
+	return exception, nil
+}
+
+// Date returns occurrence date.
+func (e OccurrenceException) Date() time.Time {
+	return e.date
+}
+
+// Skipped indicates whether the occurrence should not be materialized.
+func (e OccurrenceException) Skipped() bool {
+	return e.skipped
+}
+
+// Price returns occurrence price overriding the series price.
+func (e OccurrenceException) Price() *float64 {
+	return e.price
+}
+
+// Quantity returns occurrence quantity overriding the series quantity.
+func (e OccurrenceException) Quantity() *float64 {
+	return e.quantity
+}
+
+// Comment returns occurrence comment overriding the series comment.
+func (e OccurrenceException) Comment() *string {
+	return e.comment
+}
+
+// Occurrence represents a single occurrence of a recurring expense with exceptions applied.
+type Occurrence struct {
+	Date     time.Time
+	Price    float64
+	Quantity float64
+	Comment  *string
+	Skipped  bool
+	Edited   bool
+}
+
+// Recurrence links an expense to the occurrence of a recurring expense it was materialized from.
+type Recurrence struct {
+	RecurringExpenseID string
+	Date               time.Time
+}
+
+// RecurringExpense represents a series of expenses repeating on a schedule.
+type RecurringExpense struct {
+	id                string
+	category          Category
+	price             float64
+	currency          string
+	quantity          float64
+	comment           *string
+	tripID            *string
+	schedule          Schedule
+	exceptions        []OccurrenceException
+	materializedUntil *time.Time
+}
+
+// NewRecurringExpense creates a new recurring expense domain object.
+func NewRecurringExpense(
+	id string,
+	category Category,
+	price float64,
+	currency string,
+	quantity float64,
+	comment *string,
+	tripID *string,
+	schedule Schedule,
+	opts ...func(*RecurringExpense),
+) (*RecurringExpense, error) {
+	// Every occurrence becomes an expense, so the series should hold a valid expense.
+	_, expenseErr := NewExpense(id, category, price, currency, quantity, comment, tripID, schedule.Start())
+	if expenseErr != nil {
+		return nil, expenseErr
+	}
+
+	recurringExpense := &RecurringExpense{
+		id:         id,
+		category:   category,
+		price:      price,
+		currency:   currency,
+		quantity:   quantity,
+		comment:    comment,
+		tripID:     tripID,
+		schedule:   schedule,
+		exceptions: make([]OccurrenceException, 0),
+	}
+
+	for _, opt := range opts {
+		opt(recurringExpense)
+	}
+
+	return recurringExpense, nil
+}
+
+// ID returns recurring expense id.
+func (r RecurringExpense) ID() string {
+	return r.id
+}
+
+// Category returns recurring expense category.
+func (r RecurringExpense) Category() Category {
+	return r.category
+}
+
+// Price returns recurring expense price.
+func (r RecurringExpense) Price() float64 {
+	return r.price
+}
+
+// Currency returns recurring expense currency.
+func (r RecurringExpense) Currency() string {
+	return r.currency
+}
+
+// Quantity returns recurring expense quantity.
+func (r RecurringExpense) Quantity() float64 {
+	return r.quantity
+}
+
+// Comment returns recurring expense comment.
+func (r RecurringExpense) Comment() *string {
+	return r.comment
+}
+
+// TripID returns an id of the trip occurrence expenses belong to.
+func (r RecurringExpense) TripID() *string {
+	return r.tripID
+}
+
+// Schedule returns recurring expense schedule.
+func (r RecurringExpense) Schedule() Schedule {
+	return r.schedule
+}
+
+// Exceptions returns skipped and edited occurrences sorted by date.
+func (r RecurringExpense) Exceptions() []OccurrenceException {
+	return r.exceptions
+}
+
+// MaterializedUntil returns the last day occurrences were materialized for.
+func (r RecurringExpense) MaterializedUntil() *time.Time {
+	return r.materializedUntil
+}
+
+// SetOccurrenceExceptions sets skipped and edited occurrences.
+// Exceptions not matching the schedule are dropped.
+func SetOccurrenceExceptions(exceptions []OccurrenceException) func(*RecurringExpense) {
+	return func(r *RecurringExpense) {
+		for _, exception := range exceptions {
+			if r.schedule.IsOccurrence(exception.date) {
+				r.exceptions = append(r.exceptions, exception)
+			}
+		}
+		r.sortExceptions()
+	}
+}
+
+// SetMaterializedUntil sets the last day occurrences were materialized for.
+func SetMaterializedUntil(materializedUntil *time.Time) func(*RecurringExpense) {
+	return func(r *RecurringExpense) {
+		r.materializedUntil = materializedUntil
+	}
+}
+
+// SetException skips or edits a single occurrence, replacing its previous exception.
+// Materialized occurrences could not be changed, their expenses should be changed instead.
+func (r *RecurringExpense) SetException(exception OccurrenceException) error {
+	if !r.schedule.IsOccurrence(exception.date) {
+		return ErrOccurrenceNotFound
+	}
+	if r.materializedUntil != nil && !exception.date.After(truncateToDay(*r.materializedUntil)) {
+		return ErrOccurrenceMaterialized
+	}
+
+	r.RemoveException(exception.date)
+	r.exceptions = append(r.exceptions, exception)
+	r.sortExceptions()
+
+	return nil
+}
+
+// RemoveException reverts a single occurrence to the series values.
+func (r *RecurringExpense) RemoveException(date time.Time) {
+	date = truncateToDay(date)
+	exceptions := make([]OccurrenceException, 0, len(r.exceptions))
+	for _, exception := range r.exceptions {
+		if !exception.date.Equal(date) {
+			exceptions = append(exceptions, exception)
+		}
+	}
+	r.exceptions = exceptions
+}
+
+// Occurrences returns occurrences within the from and to days inclusively.
+func (r RecurringExpense) Occurrences(from time.Time, to time.Time) []Occurrence {
+	return r.applyExceptions(r.schedule.Occurrences(from, to))
+}
+
+// Upcoming returns up to limit occurrences starting from the day.
+func (r RecurringExpense) Upcoming(from time.Time, limit int) []Occurrence {
+	return r.applyExceptions(r.schedule.Next(from, limit))
+}
+
+// DueOccurrences returns occurrences up to the day, that were not materialized yet.
+func (r RecurringExpense) DueOccurrences(now time.Time) []Occurrence {
+	from := r.schedule.Start()
+	if r.materializedUntil != nil {
+		from = truncateToDay(*r.materializedUntil).AddDate(0, 0, 1)
+	}
+	if from.After(now) {
+		return []Occurrence{}
+	}
+	return r.Occurrences(from, now)
+}
+
+// Recurrence returns a link of the occurrence expense to the series.
+func (r RecurringExpense) Recurrence(occurrence Occurrence) Recurrence {
+	return Recurrence{
+		RecurringExpenseID: r.id,
+		Date:               occurrence.Date,
+	}
+}
+
+func (r RecurringExpense) applyExceptions(dates []time.Time) []Occurrence {
+	exceptions := make(map[time.Time]OccurrenceException, len(r.exceptions))
+	for _, exception := range r.exceptions {
+		exceptions[exception.date] = exception
+	}
+
+	occurrences := make([]Occurrence, 0, len(dates))
+	for _, date := range dates {
+		occurrence := Occurrence{
+			Date:     date,
+			Price:    r.price,
+			Quantity: r.quantity,
+			Comment:  r.comment,
+		}
+		if exception, ok := exceptions[date]; ok {
+			occurrence.Skipped = exception.skipped
+			occurrence.Edited = !exception.skipped
+			if exception.price != nil {
+				occurrence.Price = *exception.price
+			}
+			if exception.quantity != nil {
+				occurrence.Quantity = *exception.quantity
+			}
+			if exception.comment != nil {
+				occurrence.Comment = exception.comment
+			}
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences
+}
+
+func (r *RecurringExpense) sortExceptions() {
+	sort.SliceStable(r.exceptions, func(i, j int) bool {
+		return r.exceptions[i].date.Before(r.exceptions[j].date)
+	})
+}
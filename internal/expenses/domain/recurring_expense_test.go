@@ -0,0 +1,243 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func monthlyRecurringExpense(opts ...func(*domain.RecurringExpense)) *domain.RecurringExpense {
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency: "monthly",
+		Start:     utcDate(2021, time.January, 5),
+	})
+	recurringExpense, _ := domain.NewRecurringExpense("recurringId", *category, 500, "EUR", 1, nil, nil,
+		*schedule, opts...)
+	return recurringExpense
+}
+
+func TestNewRecurringExpense_ValidParams_InstantiatesRecurringExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{Frequency: "monthly", Start: utcDate(2021, time.January, 5)})
+	comment := "Flat"
+
+	// Act
+	res, resErr := domain.NewRecurringExpense("recurringId", *category, 500, "EUR", 1, &comment, nil, *schedule)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.NotNil(t, res)
+	assert.Equal(t, "recurringId", res.ID())
+	assert.Equal(t, 500.0, res.Price())
+	assert.Equal(t, "EUR", res.Currency())
+	assert.Equal(t, &comment, res.Comment())
+	assert.Empty(t, res.Exceptions())
+	assert.Nil(t, res.MaterializedUntil())
+}
+
+func TestNewRecurringExpense_InvalidExpense_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{Frequency: "monthly", Start: utcDate(2021, time.January, 5)})
+
+	// Act
+	res, resErr := domain.NewRecurringExpense("recurringId", *category, 0, "EUR", 1, nil, nil, *schedule)
+
+	// Assert
+	assert.NotNil(t, resErr)
+	assert.Nil(t, res)
+}
+
+func TestNewOccurrenceException_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	price := 10.0
+	negative := -1.0
+	day := utcDate(2021, time.February, 5)
+	tests := []domain.OccurrenceExceptionParams{
+		{Skipped: true},
+		{Date: day},
+		{Date: day, Skipped: true, Price: &price},
+		{Date: day, Price: &negative},
+		{Date: day, Quantity: &negative},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewOccurrenceException(tc)
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
+
+func TestSetOccurrenceExceptions_ExceptionOffSchedule_DropsException(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	onSchedule, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.March, 5), Skipped: true,
+	})
+	offSchedule, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.March, 6), Skipped: true,
+	})
+
+	// Act
+	res := monthlyRecurringExpense(domain.SetOccurrenceExceptions(
+		[]domain.OccurrenceException{*offSchedule, *onSchedule}))
+
+	// Assert
+	assert.Equal(t, []domain.OccurrenceException{*onSchedule}, res.Exceptions())
+}
+
+func TestRecurringExpense_SetException_AppliesToSingleOccurrence(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	price := 550.0
+	comment := "Rent with heating"
+	sut := monthlyRecurringExpense()
+	skipped, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.February, 5), Skipped: true,
+	})
+	edited, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.March, 5), Price: &price, Comment: &comment,
+	})
+
+	// Act
+	skippedErr := sut.SetException(*skipped)
+	editedErr := sut.SetException(*edited)
+	res := sut.Occurrences(utcDate(2021, time.January, 1), utcDate(2021, time.April, 30))
+
+	// Assert
+	assert.Nil(t, skippedErr)
+	assert.Nil(t, editedErr)
+	assert.Equal(t, []domain.Occurrence{
+		{Date: utcDate(2021, time.January, 5), Price: 500, Quantity: 1},
+		{Date: utcDate(2021, time.February, 5), Price: 500, Quantity: 1, Skipped: true},
+		{Date: utcDate(2021, time.March, 5), Price: 550, Quantity: 1, Comment: &comment, Edited: true},
+		{Date: utcDate(2021, time.April, 5), Price: 500, Quantity: 1},
+	}, res)
+}
+
+func TestRecurringExpense_SetException_ReplacesPreviousException(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	price := 550.0
+	sut := monthlyRecurringExpense()
+	skipped, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.February, 5), Skipped: true,
+	})
+	edited, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.February, 5), Price: &price,
+	})
+	_ = sut.SetException(*skipped)
+
+	// Act
+	err := sut.SetException(*edited)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.OccurrenceException{*edited}, sut.Exceptions())
+}
+
+func TestRecurringExpense_SetException_NotOccurrence_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sut := monthlyRecurringExpense()
+	exception, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.February, 6), Skipped: true,
+	})
+
+	// Act
+	err := sut.SetException(*exception)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrOccurrenceNotFound)
+	assert.Empty(t, sut.Exceptions())
+}
+
+func TestRecurringExpense_SetException_Materialized_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	materializedUntil := utcDate(2021, time.February, 5)
+	sut := monthlyRecurringExpense(domain.SetMaterializedUntil(&materializedUntil))
+	exception, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.February, 5), Skipped: true,
+	})
+
+	// Act
+	err := sut.SetException(*exception)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrOccurrenceMaterialized)
+}
+
+func TestRecurringExpense_RemoveException_RevertsOccurrence(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	exception, _ := domain.NewOccurrenceException(domain.OccurrenceExceptionParams{
+		Date: utcDate(2021, time.February, 5), Skipped: true,
+	})
+	sut := monthlyRecurringExpense(domain.SetOccurrenceExceptions([]domain.OccurrenceException{*exception}))
+
+	// Act
+	sut.RemoveException(time.Date(2021, time.February, 5, 10, 0, 0, 0, time.UTC))
+
+	// Assert
+	assert.Empty(t, sut.Exceptions())
+}
+
+func TestRecurringExpense_Upcoming_ReturnsLimitedOccurrences(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sut := monthlyRecurringExpense()
+
+	// Act
+	res := sut.Upcoming(utcDate(2021, time.March, 6), 2)
+
+	// Assert
+	assert.Len(t, res, 2)
+	assert.Equal(t, utcDate(2021, time.April, 5), res[0].Date)
+	assert.Equal(t, utcDate(2021, time.May, 5), res[1].Date)
+}
+
+func TestRecurringExpense_DueOccurrences_StartsAfterMaterializedDay(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	materializedUntil := utcDate(2021, time.February, 5)
+	notMaterialized := monthlyRecurringExpense()
+	materialized := monthlyRecurringExpense(domain.SetMaterializedUntil(&materializedUntil))
+	upToDate := monthlyRecurringExpense(domain.SetMaterializedUntil(&materializedUntil))
+	now := utcDate(2021, time.March, 10)
+
+	// Act
+	all := notMaterialized.DueOccurrences(now)
+	rest := materialized.DueOccurrences(now)
+	none := upToDate.DueOccurrences(utcDate(2021, time.February, 5))
+
+	// Assert
+	assert.Len(t, all, 3)
+	assert.Len(t, rest, 1)
+	assert.Equal(t, utcDate(2021, time.March, 5), rest[0].Date)
+	assert.Empty(t, none)
+}
+
+func TestRecurringExpense_Recurrence_LinksOccurrence(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sut := monthlyRecurringExpense()
+	occurrence := domain.Occurrence{Date: utcDate(2021, time.February, 5)}
+
+	// Act
+	res := sut.Recurrence(occurrence)
+
+	// Assert
+	assert.Equal(t, domain.Recurrence{RecurringExpenseID: "recurringId", Date: utcDate(2021, time.February, 5)}, res)
+}
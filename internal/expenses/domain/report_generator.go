@@ -1,48 +1,73 @@
 package domain
 
 import (
+	"sort"
 	"time"
+
+	"github.com/pkg/errors"
 )
 
+// DefaultReportCurrency is a currency report totals are converted into unless another one is chosen.
+const DefaultReportCurrency Currency = "EUR"
+
 // ReportGenerator represents expense report generator.
 type ReportGenerator struct {
 	expenses []Expense
 	filter   ExpenseFilter
 	rates    []ExchangeRates
+	currency Currency
 }
 
-// NewReportGenerator instantiates a new report.
-func NewReportGenerator(expenses []Expense, filter ExpenseFilter, rates []ExchangeRates) ReportGenerator {
+// NewReportGenerator instantiates a new report. Totals are converted into the currency, the exchange rates
+// should be checked with CheckReportCurrency beforehand.
+func NewReportGenerator(
+	expenses []Expense,
+	filter ExpenseFilter,
+	rates []ExchangeRates,
+	currency Currency,
+) ReportGenerator {
 	return ReportGenerator{
 		expenses: expenses,
 		filter:   filter,
 		rates:    rates,
+		currency: currency,
 	}
 }
 
+// CheckReportCurrency checks that every exchange rates could convert totals into the currency.
+func CheckReportCurrency(currency Currency, rates []ExchangeRates) error {
+	if len(currency) == 0 {
+		return errors.New("empty report currency")
+	}
+	for _, rate := range rates {
+		if rate.inCurrency(currency) == nil {
+			return errors.Errorf("no %s exchange rate on %s", currency, rate.date.Format("2006-01-02"))
+		}
+	}
+	return nil
+}
+
 // GenerateByDateReport generates report.
 func (r ReportGenerator) GenerateByDateReport() ReportByDate {
-	dateRatesMap := make(map[time.Time]ExchangeRates)
-	for _, rate := range r.rates {
-		dateRatesMap[rate.Date()] = rate
-	}
+	dateRatesMap := r.dateRatesMap()
 
 	dateCategoryExpenses := make([]*DateExpenses, 0)
-	dateExpensesMap := r.prepareDateExpensesMap(r.expenses, r.filter.Interval(), dateRatesMap)
+	dateExpensesMap := r.prepareDateExpensesMap(r.expenses, r.filter, dateRatesMap)
 	for date, expenses := range dateExpensesMap {
-		categoryExpensesMap := r.buildCategoryFlatMap(expenses)
-		rootCategoryExpense := r.buildCategoryHierarchy(categoryExpensesMap)
+		categoryExpensesMap := buildCategoryFlatMap(expenses)
+		rootCategoryExpense := buildCategoryHierarchy(categoryExpensesMap)
 		dateRates := dateRatesMap[date]
 
 		dateExpense := &DateExpenses{
 			Date:          date,
 			SubCategories: rootCategoryExpense.SubCategories,
-			ExchangeRate:  dateRates.ChangeBaseCurrency("EUR"),
+			ExchangeRate:  dateRates.ChangeBaseCurrency(r.currency),
 		}
 		dateCategoryExpenses = append(dateCategoryExpenses, dateExpense)
 	}
 
 	report := ReportByDate{
+		Currency:       r.currency,
 		CategoryByDate: dateCategoryExpenses,
 	}
 	report.CalculateTotal()
@@ -50,22 +75,85 @@ func (r ReportGenerator) GenerateByDateReport() ReportByDate {
 	return report
 }
 
+// GenerateByCategoryReport generates report by category. Every category carries totals of all report
+// intervals, intervals without expenses of the category get zero totals.
+func (r ReportGenerator) GenerateByCategoryReport() ReportByCategory {
+	byDate := r.GenerateByDateReport()
+
+	dates := intervalDates(r.filter, byDate.Dates())
+	dateIndexes := make(map[time.Time]int, len(dates))
+	for i, date := range dates {
+		dateIndexes[date] = i
+	}
+
+	report := ReportByCategory{
+		Report: Report{
+			From: r.filter.From(),
+			To:   r.filter.To(),
+		},
+		Currency:      r.currency,
+		Dates:         dates,
+		SubCategories: make([]*CategorySeries, 0),
+		GrandTotal:    byDate.GrandTotal,
+	}
+
+	seriesMap := make(map[string]*CategorySeries)
+	var transpose func(parent *CategorySeries, date time.Time, categories []*CategoryExpenses)
+	transpose = func(parent *CategorySeries, date time.Time, categories []*CategoryExpenses) {
+		for _, categoryExpenses := range categories {
+			series, ok := seriesMap[categoryExpenses.Category.id]
+			if !ok {
+				series = &CategorySeries{
+					Category:      categoryExpenses.Category,
+					Series:        make([]IntervalTotal, 0, len(dates)),
+					SubCategories: make([]*CategorySeries, 0),
+				}
+				for _, seriesDate := range dates {
+					series.Series = append(series.Series, IntervalTotal{
+						Date:       seriesDate,
+						GrandTotal: newGrandTotal(r.currency),
+					})
+				}
+				seriesMap[categoryExpenses.Category.id] = series
+				if parent == nil {
+					report.SubCategories = append(report.SubCategories, series)
+				} else {
+					parent.SubCategories = append(parent.SubCategories, series)
+				}
+			}
+			series.Series[dateIndexes[date]].GrandTotal = categoryExpenses.GrandTotal
+			transpose(series, date, categoryExpenses.SubCategories)
+		}
+	}
+	for _, dateExpenses := range byDate.CategoryByDate {
+		transpose(nil, dateExpenses.Date, dateExpenses.SubCategories)
+	}
+
+	sortCategorySeries(report.SubCategories)
+	for _, series := range report.SubCategories {
+		series.CalculateTotal()
+	}
+
+	return report
+}
+
+// categoryTotals returns the category hierarchy of all expenses with totals converted into the report currency.
+func (r ReportGenerator) categoryTotals() CategoryExpenses {
+	expenses := r.calculateTotals(r.expenses, r.dateRatesMap())
+	root := buildCategoryHierarchy(buildCategoryFlatMap(expenses))
+	root.CalculateTotal()
+
+	return root
+}
+
 func (r ReportGenerator) prepareDateExpensesMap(
 	expenses []Expense,
-	interval Interval,
+	filter ExpenseFilter,
 	rates map[time.Time]ExchangeRates,
 ) map[time.Time][]Expense {
 	dateExpensesMap := make(map[time.Time][]Expense)
-	for _, expense := range expenses {
-		date := expense.date
-		rate := rates[date]
-		rate = rate.ChangeBaseCurrency("EUR")
-		expense.CalculateTotal(&rate)
-		if interval == IntervalMonth {
-			date = time.Date(expense.date.Year(), expense.date.Month(), 1, 0, 0, 0, 0, time.UTC)
-		} else if interval == IntervalYear {
-			date = time.Date(expense.date.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
-		}
+	for _, expense := range r.calculateTotals(expenses, rates) {
+		date := intervalDate(expense.date, filter.Interval(), filter.PeriodStartDay())
 		dateExpenses := dateExpensesMap[date]
 		if dateExpenses == nil {
 			dateExpenses = make([]Expense, 0)
@@ -77,7 +165,105 @@ func (r ReportGenerator) prepareDateExpensesMap(
 	return dateExpensesMap
 }
 
-func (r ReportGenerator) buildCategoryFlatMap(expenses []Expense) map[string]*CategoryExpenses {
+// dateRatesMap returns exchange rates by date.
+func (r ReportGenerator) dateRatesMap() map[time.Time]ExchangeRates {
+	dateRatesMap := make(map[time.Time]ExchangeRates)
+	for _, rate := range r.rates {
+		dateRatesMap[rate.Date()] = rate
+	}
+	return dateRatesMap
+}
+
+// calculateTotals returns copies of the expenses with totals converted into the report currency
+// with exchange rates of the expense dates.
+func (r ReportGenerator) calculateTotals(expenses []Expense, rates map[time.Time]ExchangeRates) []Expense {
+	calculated := make([]Expense, 0, len(expenses))
+	for _, expense := range expenses {
+		rate := rates[expense.date]
+		rate = rate.ChangeBaseCurrency(r.currency)
+		expense.CalculateTotal(&rate)
+		calculated = append(calculated, expense)
+	}
+	return calculated
+}
+
+// intervalDate returns the first date of the interval the date falls into. Weeks are ISO weeks beginning
+// on Monday, months, quarters and years begin on the period start day.
+func intervalDate(date time.Time, interval Interval, periodStartDay int) time.Time {
+	switch interval {
+	case IntervalWeek:
+		day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case IntervalMonth, IntervalQuarter, IntervalYear:
+		month := date.Month()
+		if date.Day() < periodStartDay {
+			month--
+		}
+		start := time.Date(date.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+		start = start.AddDate(0, -(int(start.Month())-1)%intervalMonths(interval), 0)
+		return time.Date(start.Year(), start.Month(), periodStartDay, 0, 0, 0, 0, time.UTC)
+	default:
+		return date
+	}
+}
+
+// nextIntervalDate returns the first date of the interval following the one starting at the date.
+func nextIntervalDate(date time.Time, interval Interval) time.Time {
+	switch interval {
+	case IntervalWeek:
+		return date.AddDate(0, 0, 7)
+	case IntervalMonth, IntervalQuarter, IntervalYear:
+		return date.AddDate(0, intervalMonths(interval), 0)
+	default:
+		return date.AddDate(0, 0, 1)
+	}
+}
+
+// intervalMonths returns the number of months in the interval.
+func intervalMonths(interval Interval) int {
+	switch interval {
+	case IntervalQuarter:
+		return 3
+	case IntervalYear:
+		return 12
+	default:
+		return 1
+	}
+}
+
+// intervalDates returns first dates of all intervals of the filter range along with the extra dates sorted.
+func intervalDates(filter ExpenseFilter, extra []time.Time) []time.Time {
+	datesMap := make(map[time.Time]bool)
+	from := intervalDate(filter.From(), filter.Interval(), filter.PeriodStartDay())
+	for date := from; !date.After(filter.To()); date = nextIntervalDate(date, filter.Interval()) {
+		datesMap[date] = true
+	}
+	for _, date := range extra {
+		datesMap[date] = true
+	}
+
+	dates := make([]time.Time, 0, len(datesMap))
+	for date := range datesMap {
+		dates = append(dates, date)
+	}
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	return dates
+}
+
+// sortCategorySeries sorts category series by category name in the whole subtree.
+func sortCategorySeries(categories []*CategorySeries) {
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Category.name < categories[j].Category.name
+	})
+	for _, category := range categories {
+		sortCategorySeries(category.SubCategories)
+	}
+}
+
+func buildCategoryFlatMap(expenses []Expense) map[string]*CategoryExpenses {
 	categoryExpensesMap := make(map[string]*CategoryExpenses)
 	for _, expense := range expenses {
 		// Process category expenses.
@@ -98,6 +284,10 @@ func (r ReportGenerator) buildCategoryFlatMap(expenses []Expense) map[string]*Ca
 			continue
 		}
 		for _, parentCategory := range *expense.category.parents {
+			// Keep parents that already hold own expenses or other subcategories.
+			if _, ok := categoryExpensesMap[parentCategory.id]; ok {
+				continue
+			}
 			parentExpenses := &CategoryExpenses{
 				Category:      parentCategory,
 				SubCategories: make([]*CategoryExpenses, 0),
@@ -110,7 +300,7 @@ func (r ReportGenerator) buildCategoryFlatMap(expenses []Expense) map[string]*Ca
 	return categoryExpensesMap
 }
 
-func (r ReportGenerator) buildCategoryHierarchy(flatCategoryExpensesMap map[string]*CategoryExpenses) CategoryExpenses {
+func buildCategoryHierarchy(flatCategoryExpensesMap map[string]*CategoryExpenses) CategoryExpenses {
 	rootCategories := make([]*CategoryExpenses, 0)
 	for _, categoryExpenses := range flatCategoryExpensesMap {
 		if categoryExpenses.Category.IsRoot() {
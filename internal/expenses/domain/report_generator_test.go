@@ -78,7 +78,7 @@ func TestGenerateByDateReport(t *testing.T) {
 	filter, _ := domain.NewExpenseFilter(from, to, interval)
 
 	// SUT
-	sut := domain.NewReportGenerator(expenses, *filter, []domain.ExchangeRates{*rates})
+	sut := domain.NewReportGenerator(expenses, *filter, []domain.ExchangeRates{*rates}, domain.DefaultReportCurrency)
 
 	// Act
 	result := sut.GenerateByDateReport()
@@ -176,3 +176,136 @@ func TestGenerateByDateReport(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateByDateReport_ParentWithOwnExpenses_KeepsParentExpenses(t *testing.T) {
+	t.Parallel()
+	parentID := uuid.NewString()
+	parent, _ := domain.NewCategory(parentID, nil, "parent", nil, 1, fmt.Sprintf("|%s", parentID))
+	childID := uuid.NewString()
+	child, _ := domain.NewCategory(childID, &parentID, "child", nil, 2, fmt.Sprintf("|%s|%s", parentID, childID))
+	child.SetParents(&[]domain.Category{*parent})
+
+	date1 := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	parentExpense, _ := domain.NewExpense(uuid.NewString(), *parent, 10, "EUR", 1, nil, nil, date1)
+	childExpense, _ := domain.NewExpense(uuid.NewString(), *child, 20, "EUR", 1, nil, nil, date1)
+	filter, _ := domain.NewExpenseFilter(date1, date1.AddDate(0, 0, 1), "day")
+
+	// Parent expenses were dropped when a subcategory expense came after them.
+	tests := map[string][]domain.Expense{
+		"parent expense first": {*parentExpense, *childExpense},
+		"child expense first":  {*childExpense, *parentExpense},
+	}
+
+	for name, expenses := range tests {
+		expenses := expenses
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// SUT
+			sut := domain.NewReportGenerator(expenses, *filter, nil, domain.DefaultReportCurrency)
+
+			// Act
+			result := sut.GenerateByDateReport()
+
+			// Assert
+			assert.Len(t, result.CategoryByDate, 1)
+			assert.Len(t, result.CategoryByDate[0].SubCategories, 1)
+			parentExpenses := result.CategoryByDate[0].SubCategories[0]
+			assert.Len(t, *parentExpenses.Expenses, 1, "Parent should keep own expenses.")
+			assert.Equal(t, parentExpense.ID(), (*parentExpenses.Expenses)[0].ID())
+			assert.Len(t, parentExpenses.SubCategories, 1)
+			assert.Equal(t, childID, parentExpenses.SubCategories[0].Category.ID())
+			assert.Equal(t, "30", parentExpenses.GrandTotal.SubTotals["EUR"].OriginalTotal.Sum.String())
+		})
+	}
+}
+
+func TestGenerateByCategoryReport_ZeroFillsIntervalsWithoutExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	groceriesParentID := "foodId"
+	groceries, _ := domain.NewCategory("groceriesId", &groceriesParentID, "Groceries", nil, 2, "|foodId|groceriesId")
+	groceries.SetParents(&[]domain.Category{*food})
+	car, _ := domain.NewCategory("carId", nil, "Car", nil, 1, "|carId")
+
+	july := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	september := time.Date(2021, time.September, 5, 0, 0, 0, 0, time.UTC)
+	julyGroceries, _ := domain.NewExpense("julyId", *groceries, 10, "EUR", 1, nil, nil, july)
+	septemberGroceries, _ := domain.NewExpense("septemberId", *groceries, 20, "EUR", 1, nil, nil, september)
+	julyCar, _ := domain.NewExpense("carId", *car, 50, "EUR", 1, nil, nil, july)
+	expenses := []domain.Expense{*julyGroceries, *septemberGroceries, *julyCar}
+
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.September, 30, 0, 0, 0, 0, time.UTC)
+	filter, _ := domain.NewExpenseFilter(from, to, "month")
+
+	// SUT
+	sut := domain.NewReportGenerator(expenses, *filter, nil, domain.DefaultReportCurrency)
+
+	// Act
+	report := sut.GenerateByCategoryReport()
+
+	// Assert
+	assert.Equal(t, []time.Time{
+		time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.September, 1, 0, 0, 0, 0, time.UTC),
+	}, report.Dates, "Should contain every month of the range.")
+	assert.Len(t, report.SubCategories, 2, "Should contain root categories.")
+
+	carSeries, foodSeries := report.SubCategories[0], report.SubCategories[1]
+	assert.Equal(t, "Car", carSeries.Category.Name(), "Should sort categories by name.")
+	assert.Len(t, carSeries.Series, 3, "Should zero-fill intervals without expenses.")
+	assert.True(t, carSeries.Series[1].GrandTotal.Sum("EUR").IsZero())
+
+	assert.Len(t, foodSeries.SubCategories, 1, "Should keep category hierarchy.")
+	groceriesSeries := foodSeries.SubCategories[0]
+	assert.Equal(t, "10", groceriesSeries.Series[0].GrandTotal.Sum("EUR").String())
+	assert.True(t, groceriesSeries.Series[1].GrandTotal.Sum("EUR").IsZero())
+	assert.Equal(t, "20", groceriesSeries.Series[2].GrandTotal.Sum("EUR").String())
+	assert.Equal(t, "30", foodSeries.GrandTotal.Sum("EUR").String(), "Should roll up parent totals.")
+	assert.Equal(t, "80", report.GrandTotal.Sum("EUR").String(), "Should calculate report total.")
+}
+
+func TestGenerateByDateReport_ReportCurrency_ConvertsTotalsIntoCurrency(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	date := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	eurExpense, _ := domain.NewExpense("eurId", *category, 10, "EUR", 1, nil, nil, date)
+	usdExpense, _ := domain.NewExpense("usdId", *category, 5, "USD", 1, nil, nil, date)
+	rates, _ := domain.NewExchageRate(date, "EUR", map[string]float64{"USD": 2})
+	filter, _ := domain.NewExpenseFilter(date.AddDate(0, 0, -1), date.AddDate(0, 0, 1), "month")
+
+	// SUT
+	sut := domain.NewReportGenerator([]domain.Expense{*eurExpense, *usdExpense}, *filter,
+		[]domain.ExchangeRates{*rates}, "USD")
+
+	// Act
+	result := sut.GenerateByDateReport()
+
+	// Assert
+	assert.Equal(t, domain.Currency("USD"), result.Currency)
+	assert.Equal(t, domain.Currency("USD"), result.GrandTotal.Total.Currency, "Should convert total into USD.")
+	assert.Equal(t, "25", result.GrandTotal.Total.Sum.String(), "Should sum totals converted into USD.")
+	assert.Equal(t, "25", result.CategoryByDate[0].SubCategories[0].GrandTotal.Total.Sum.String())
+}
+
+func TestCheckReportCurrency_ChecksRates(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := time.Date(2021, time.July, 10, 0, 0, 0, 0, time.UTC)
+	rates, _ := domain.NewExchageRate(date, "EUR", map[string]float64{"USD": 2})
+
+	// Act
+	baseErr := domain.CheckReportCurrency("EUR", []domain.ExchangeRates{*rates})
+	knownErr := domain.CheckReportCurrency("USD", []domain.ExchangeRates{*rates})
+	unknownErr := domain.CheckReportCurrency("GBP", []domain.ExchangeRates{*rates})
+	emptyErr := domain.CheckReportCurrency("", nil)
+
+	// Assert
+	assert.Nil(t, baseErr)
+	assert.Nil(t, knownErr)
+	assert.NotNil(t, unknownErr, "Should fail without rates of the currency.")
+	assert.NotNil(t, emptyErr, "Should fail without currency.")
+}
@@ -14,3 +14,11 @@ type UpdateResult struct {
 type DeleteResult struct {
 	DeleteCount int
 }
+
+// AddExpenseResult represents a struct with added expense ID, likely duplicates of the expense are warnings only.
+// The duplicate check failure does not prevent adding the expense either.
+type AddExpenseResult struct {
+	ID                   string
+	Duplicates           []Expense
+	DuplicateCheckFailed bool
+}
@@ -0,0 +1,219 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// RuleConditions holds conditions an expense should meet for the rule to apply, all of them should be met.
+// Comment and merchant conditions are regular expressions matched case-insensitively, the merchant
+// condition is matched against the merchant name. Amount is a total of the expense in its own currency,
+// the range includes its bounds.
+type RuleConditions struct {
+	Comment   *string
+	Merchant  *string
+	MinAmount *float64
+	MaxAmount *float64
+	Currency  *string
+	Weekdays  []time.Weekday
+}
+
+// RuleActions holds changes a rule makes to the matching expense.
+type RuleActions struct {
+	CategoryID   *string
+	Tags         []string
+	TripID       *string
+	Reimbursable bool
+}
+
+// RuleParams holds raw rule values.
+type RuleParams struct {
+	Name       string
+	Priority   int
+	Conditions RuleConditions
+	Actions    RuleActions
+}
+
+// Rule represents a rule categorizing expenses automatically.
+type Rule struct {
+	id              string
+	name            string
+	priority        int
+	conditions      RuleConditions
+	actions         RuleActions
+	commentPattern  *regexp.Regexp
+	merchantPattern *regexp.Regexp
+}
+
+// NewRule instantiates a rule. A rule should have at least one condition and at least one action.
+func NewRule(id string, params RuleParams) (*Rule, error) {
+	name := strings.TrimSpace(params.Name)
+	if len(name) == 0 {
+		return nil, errors.New("empty name")
+	}
+
+	conditions := RuleConditions{
+		Comment:   trimmedOrNil(params.Conditions.Comment),
+		Merchant:  trimmedOrNil(params.Conditions.Merchant),
+		MinAmount: params.Conditions.MinAmount,
+		MaxAmount: params.Conditions.MaxAmount,
+	}
+	if currency := trimmedOrNil(params.Conditions.Currency); currency != nil {
+		upper := strings.ToUpper(*currency)
+		conditions.Currency = &upper
+	}
+	if weekdaysErr := conditions.setWeekdays(params.Conditions.Weekdays); weekdaysErr != nil {
+		return nil, weekdaysErr
+	}
+	if amountErr := conditions.checkAmounts(); amountErr != nil {
+		return nil, amountErr
+	}
+	if conditions.isEmpty() {
+		return nil, errors.New("rule should have at least one condition")
+	}
+
+	actions := RuleActions{
+		CategoryID:   trimmedOrNil(params.Actions.CategoryID),
+		Tags:         NormalizeTags(params.Actions.Tags),
+		TripID:       trimmedOrNil(params.Actions.TripID),
+		Reimbursable: params.Actions.Reimbursable,
+	}
+	if actions.CategoryID == nil && len(actions.Tags) == 0 && actions.TripID == nil && !actions.Reimbursable {
+		return nil, errors.New("rule should have at least one action")
+	}
+
+	rule := &Rule{
+		id:         id,
+		name:       name,
+		priority:   params.Priority,
+		conditions: conditions,
+		actions:    actions,
+	}
+
+	var patternErr error
+	if rule.commentPattern, patternErr = compileRulePattern(conditions.Comment); patternErr != nil {
+		return nil, errors.Wrap(patternErr, "comment condition")
+	}
+	if rule.merchantPattern, patternErr = compileRulePattern(conditions.Merchant); patternErr != nil {
+		return nil, errors.Wrap(patternErr, "merchant condition")
+	}
+
+	return rule, nil
+}
+
+// ParseWeekday parses an English weekday name, e.g. monday.
+func ParseWeekday(value string) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(strings.TrimSpace(value), day.String()) {
+			return day, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("unknown weekday %s", value)
+}
+
+// ID returns rule id.
+func (r Rule) ID() string {
+	return r.id
+}
+
+// Name returns rule name.
+func (r Rule) Name() string {
+	return r.name
+}
+
+// Priority returns rule priority, rules with lower values are evaluated first.
+func (r Rule) Priority() int {
+	return r.priority
+}
+
+// Conditions returns rule conditions.
+func (r Rule) Conditions() RuleConditions {
+	return r.conditions
+}
+
+// Actions returns rule actions.
+func (r Rule) Actions() RuleActions {
+	return r.actions
+}
+
+// Matches checks whether the expense paid to the merchant meets all conditions of the rule.
+// Expenses without a merchant never meet the merchant condition.
+func (r Rule) Matches(expense Expense, merchant *Merchant) bool {
+	if r.commentPattern != nil && (expense.comment == nil || !r.commentPattern.MatchString(*expense.comment)) {
+		return false
+	}
+	if r.merchantPattern != nil && (merchant == nil || !r.merchantPattern.MatchString(merchant.Name())) {
+		return false
+	}
+	if r.conditions.Currency != nil && !strings.EqualFold(*r.conditions.Currency, expense.currency) {
+		return false
+	}
+
+	amount := expense.price.Mul(expense.quantity)
+	if r.conditions.MinAmount != nil && amount.LessThan(decimal.NewFromFloat(*r.conditions.MinAmount)) {
+		return false
+	}
+	if r.conditions.MaxAmount != nil && amount.GreaterThan(decimal.NewFromFloat(*r.conditions.MaxAmount)) {
+		return false
+	}
+
+	if len(r.conditions.Weekdays) != 0 {
+		weekday := expense.date.Weekday()
+		for _, day := range r.conditions.Weekdays {
+			if day == weekday {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+func (c *RuleConditions) setWeekdays(weekdays []time.Weekday) error {
+	seen := make(map[time.Weekday]bool, len(weekdays))
+	for _, day := range weekdays {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("unknown weekday %d", day)
+		}
+		if !seen[day] {
+			seen[day] = true
+			c.Weekdays = append(c.Weekdays, day)
+		}
+	}
+	sort.Slice(c.Weekdays, func(i, j int) bool {
+		return c.Weekdays[i] < c.Weekdays[j]
+	})
+	return nil
+}
+
+func (c RuleConditions) checkAmounts() error {
+	if c.MinAmount != nil && *c.MinAmount < 0 {
+		return errors.New("minimal amount should not be negative")
+	}
+	if c.MaxAmount != nil && *c.MaxAmount < 0 {
+		return errors.New("maximal amount should not be negative")
+	}
+	if c.MinAmount != nil && c.MaxAmount != nil && *c.MinAmount > *c.MaxAmount {
+		return errors.New("minimal amount should not be greater than maximal amount")
+	}
+	return nil
+}
+
+func (c RuleConditions) isEmpty() bool {
+	return c.Comment == nil && c.Merchant == nil && c.MinAmount == nil && c.MaxAmount == nil &&
+		c.Currency == nil && len(c.Weekdays) == 0
+}
+
+func compileRulePattern(pattern *string) (*regexp.Regexp, error) {
+	if pattern == nil {
+		return nil, nil
+	}
+	return regexp.Compile("(?i)" + *pattern)
+}
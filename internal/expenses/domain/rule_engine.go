@@ -0,0 +1,117 @@
+package domain
+
+import "sort"
+
+// RuleTest holds expenses the rules match out of the tested ones.
+type RuleTest struct {
+	Checked int
+	Matches []Expense
+}
+
+// RuleEngine applies rules to expenses.
+type RuleEngine struct {
+	rules      []Rule
+	categories map[string]Category
+	merchants  map[string]Merchant
+}
+
+// NewRuleEngine creates an engine evaluating rules in priority order, rules of the same priority are
+// evaluated by name. Categories and merchants are used to resolve category actions and merchant
+// conditions, actions setting an unknown category are ignored.
+func NewRuleEngine(rules []Rule, categories []Category, merchants []Merchant) RuleEngine {
+	engine := RuleEngine{
+		rules:      append([]Rule{}, rules...),
+		categories: make(map[string]Category, len(categories)),
+		merchants:  make(map[string]Merchant, len(merchants)),
+	}
+	sort.SliceStable(engine.rules, func(i, j int) bool {
+		if engine.rules[i].priority != engine.rules[j].priority {
+			return engine.rules[i].priority < engine.rules[j].priority
+		}
+		return engine.rules[i].name < engine.rules[j].name
+	})
+	for _, category := range categories {
+		engine.categories[category.id] = category
+	}
+	for _, merchant := range merchants {
+		engine.merchants[merchant.id] = merchant
+	}
+
+	return engine
+}
+
+// Match returns rules the expense meets in priority order.
+func (e RuleEngine) Match(expense Expense) []Rule {
+	var merchant *Merchant
+	if expense.merchantID != nil {
+		if found, ok := e.merchants[*expense.merchantID]; ok {
+			merchant = &found
+		}
+	}
+
+	matched := make([]Rule, 0)
+	for _, rule := range e.rules {
+		if rule.Matches(expense, merchant) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// Test returns expenses meeting any of the rules, the expenses are not changed.
+func (e RuleEngine) Test(expenses []Expense) RuleTest {
+	test := RuleTest{
+		Checked: len(expenses),
+		Matches: make([]Expense, 0),
+	}
+	for _, expense := range expenses {
+		if len(e.Match(expense)) != 0 {
+			test.Matches = append(test.Matches, expense)
+		}
+	}
+	return test
+}
+
+// Apply applies actions of all matching rules to the expense and reports whether the expense has changed.
+// The category and the trip are set by the first matching rule having such an action, tags of all
+// matching rules are added to the expense tags.
+func (e RuleEngine) Apply(expense Expense) (Expense, bool) {
+	matched := e.Match(expense)
+	if len(matched) == 0 {
+		return expense, false
+	}
+
+	result := expense
+	categorySet, tripSet := false, false
+	tags := append([]string{}, expense.tags...)
+	for _, rule := range matched {
+		if rule.actions.CategoryID != nil && !categorySet {
+			if category, ok := e.categories[*rule.actions.CategoryID]; ok {
+				result.category = category
+				categorySet = true
+			}
+		}
+		if rule.actions.TripID != nil && !tripSet {
+			tripID := *rule.actions.TripID
+			result.tripID = &tripID
+			tripSet = true
+		}
+		tags = append(tags, rule.actions.Tags...)
+		result.reimbursable = result.reimbursable || rule.actions.Reimbursable
+	}
+	result.tags = NormalizeTags(tags)
+
+	changed := result.category.id != expense.category.id ||
+		!equalStringPointers(result.tripID, expense.tripID) ||
+		len(result.tags) != len(expense.tags) ||
+		result.reimbursable != expense.reimbursable
+
+	return result, changed
+}
+
+func equalStringPointers(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
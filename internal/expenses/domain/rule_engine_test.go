@@ -0,0 +1,99 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestRuleEngine_Apply_AppliesRulesInPriorityOrder(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	work, _ := domain.NewCategory("workId", nil, "Work", nil, 1, "|workId")
+	coffee, lunch := "coffee", "lunch|coffee"
+	foodID, workID, tripID := "foodId", "workId", "tripId"
+	engine := domain.NewRuleEngine([]domain.Rule{
+		newRule("work", 20, domain.RuleConditions{Comment: &lunch},
+			domain.RuleActions{CategoryID: &workID, Tags: []string{"office"}, Reimbursable: true}),
+		newRule("food", 10, domain.RuleConditions{Comment: &coffee},
+			domain.RuleActions{CategoryID: &foodID, Tags: []string{"drinks"}, TripID: &tripID}),
+	}, []domain.Category{*food, *work}, nil)
+	expense := newRuleExpense("Coffee with colleagues", 3, "EUR", time.Now())
+
+	// Act
+	res, changed := engine.Apply(expense)
+
+	// Assert
+	assert.True(t, changed)
+	assert.Equal(t, "foodId", res.Category().ID())
+	assert.Equal(t, "tripId", *res.TripID())
+	assert.Equal(t, []string{"drinks", "office"}, res.Tags())
+	assert.True(t, res.Reimbursable())
+	assert.Equal(t, "otherId", expense.Category().ID(), "Original expense should not be changed.")
+	assert.Nil(t, expense.Tags(), "Original expense should not be changed.")
+}
+
+func TestRuleEngine_Apply_MatchesMerchantName(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	pattern := "^rewe$"
+	engine := domain.NewRuleEngine([]domain.Rule{
+		newRule("ruleId", 1, domain.RuleConditions{Merchant: &pattern},
+			domain.RuleActions{Tags: []string{"groceries"}}),
+	}, nil, []domain.Merchant{newMerchant("merchantId", "Rewe")})
+	category, _ := domain.NewCategory("otherId", nil, "Other", nil, 1, "|otherId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now(),
+		domain.SetMerchant("merchantId"))
+
+	// Act
+	res, changed := engine.Apply(*expense)
+
+	// Assert
+	assert.True(t, changed)
+	assert.Equal(t, []string{"groceries"}, res.Tags())
+	assert.Len(t, engine.Match(newRuleExpense("Rewe", 10, "EUR", time.Now())), 0)
+}
+
+func TestRuleEngine_Apply_NothingChanges_ReturnsUnchanged(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	coffee, unknownID := "coffee", "unknownId"
+	engine := domain.NewRuleEngine([]domain.Rule{
+		newRule("ruleId", 1, domain.RuleConditions{Comment: &coffee}, domain.RuleActions{CategoryID: &unknownID}),
+	}, nil, nil)
+	expense := newRuleExpense("Coffee", 3, "EUR", time.Now())
+
+	// Act
+	res, changed := engine.Apply(expense)
+
+	// Assert
+	assert.False(t, changed)
+	assert.Equal(t, "otherId", res.Category().ID())
+	assert.Len(t, engine.Match(expense), 1)
+}
+
+func TestRuleEngine_Test_ReturnsMatchingExpenses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	coffee := "coffee"
+	engine := domain.NewRuleEngine([]domain.Rule{
+		newRule("ruleId", 1, domain.RuleConditions{Comment: &coffee}, domain.RuleActions{Reimbursable: true}),
+	}, nil, nil)
+	expenses := []domain.Expense{
+		newRuleExpense("Coffee", 3, "EUR", time.Now()),
+		newRuleExpense("Tea", 2, "EUR", time.Now()),
+	}
+
+	// Act
+	res := engine.Test(expenses)
+
+	// Assert
+	assert.Equal(t, 2, res.Checked)
+	assert.Len(t, res.Matches, 1)
+	assert.Equal(t, "Coffee", *res.Matches[0].Comment())
+	assert.False(t, res.Matches[0].Reimbursable(), "Matching expense should not be changed.")
+}
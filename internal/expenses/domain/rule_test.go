@@ -0,0 +1,158 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func newRule(id string, priority int, conditions domain.RuleConditions, actions domain.RuleActions) domain.Rule {
+	rule, _ := domain.NewRule(id, domain.RuleParams{
+		Name:       "Rule " + id,
+		Priority:   priority,
+		Conditions: conditions,
+		Actions:    actions,
+	})
+	return *rule
+}
+
+func newRuleExpense(comment string, price float64, currency string, date time.Time) domain.Expense {
+	category, _ := domain.NewCategory("otherId", nil, "Other", nil, 1, "|otherId")
+	expense, _ := domain.NewExpense("expenseId", *category, price, currency, 1, &comment, nil, date)
+	return *expense
+}
+
+func TestNewRule_ValidParams_NormalizesValues(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	comment := " coffee "
+	currency := " eur "
+	categoryID := " foodId "
+	params := domain.RuleParams{
+		Name:     " Coffee ",
+		Priority: 10,
+		Conditions: domain.RuleConditions{
+			Comment:  &comment,
+			Currency: &currency,
+			Weekdays: []time.Weekday{time.Friday, time.Monday, time.Friday},
+		},
+		Actions: domain.RuleActions{
+			CategoryID: &categoryID,
+			Tags:       []string{"Work", "work"},
+		},
+	}
+
+	// Act
+	res, resErr := domain.NewRule("ruleId", params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "ruleId", res.ID())
+	assert.Equal(t, "Coffee", res.Name())
+	assert.Equal(t, 10, res.Priority())
+	assert.Equal(t, "coffee", *res.Conditions().Comment)
+	assert.Equal(t, "EUR", *res.Conditions().Currency)
+	assert.Equal(t, []time.Weekday{time.Monday, time.Friday}, res.Conditions().Weekdays)
+	assert.Equal(t, "foodId", *res.Actions().CategoryID)
+	assert.Equal(t, []string{"work"}, res.Actions().Tags)
+}
+
+func TestNewRule_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	pattern := "coffee"
+	invalidPattern := "coffee("
+	minAmount, maxAmount, negative := 10.0, 5.0, -1.0
+	for name, params := range map[string]domain.RuleParams{
+		"empty name": {
+			Name:       " ",
+			Conditions: domain.RuleConditions{Comment: &pattern},
+			Actions:    domain.RuleActions{Reimbursable: true},
+		},
+		"no conditions": {
+			Name:    "Rule",
+			Actions: domain.RuleActions{Reimbursable: true},
+		},
+		"no actions": {
+			Name:       "Rule",
+			Conditions: domain.RuleConditions{Comment: &pattern},
+			Actions:    domain.RuleActions{Tags: []string{" "}},
+		},
+		"invalid comment pattern": {
+			Name:       "Rule",
+			Conditions: domain.RuleConditions{Comment: &invalidPattern},
+			Actions:    domain.RuleActions{Reimbursable: true},
+		},
+		"invalid merchant pattern": {
+			Name:       "Rule",
+			Conditions: domain.RuleConditions{Merchant: &invalidPattern},
+			Actions:    domain.RuleActions{Reimbursable: true},
+		},
+		"inverted amount range": {
+			Name:       "Rule",
+			Conditions: domain.RuleConditions{MinAmount: &minAmount, MaxAmount: &maxAmount},
+			Actions:    domain.RuleActions{Reimbursable: true},
+		},
+		"negative amount": {
+			Name:       "Rule",
+			Conditions: domain.RuleConditions{MinAmount: &negative},
+			Actions:    domain.RuleActions{Reimbursable: true},
+		},
+		"unknown weekday": {
+			Name:       "Rule",
+			Conditions: domain.RuleConditions{Weekdays: []time.Weekday{7}},
+			Actions:    domain.RuleActions{Reimbursable: true},
+		},
+	} {
+		// Act
+		res, resErr := domain.NewRule("ruleId", params)
+
+		// Assert
+		assert.Nil(t, res, name)
+		assert.NotNil(t, resErr, name)
+	}
+}
+
+func TestParseWeekday_WeekdayName_ReturnsWeekday(t *testing.T) {
+	t.Parallel()
+	// Act
+	res, resErr := domain.ParseWeekday(" Monday")
+	_, unknownErr := domain.ParseWeekday("someday")
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, time.Monday, res)
+	assert.NotNil(t, unknownErr)
+}
+
+func TestRule_Matches_ChecksAllConditions(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	comment := "^coffee"
+	merchantPattern := "star"
+	currency := "EUR"
+	minAmount, maxAmount := 2.0, 5.0
+	rule := newRule("ruleId", 1, domain.RuleConditions{
+		Comment:   &comment,
+		Merchant:  &merchantPattern,
+		Currency:  &currency,
+		MinAmount: &minAmount,
+		MaxAmount: &maxAmount,
+		Weekdays:  []time.Weekday{time.Friday},
+	}, domain.RuleActions{Reimbursable: true})
+	merchant := newMerchant("merchantId", "Starbucks")
+	friday := time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC)
+	saturday := friday.AddDate(0, 0, 1)
+
+	// Act & Assert
+	assert.True(t, rule.Matches(newRuleExpense("Coffee to go", 5, "EUR", friday), &merchant))
+	assert.False(t, rule.Matches(newRuleExpense("Coffee to go", 5, "EUR", friday), nil), "No merchant.")
+	assert.False(t, rule.Matches(newRuleExpense("Hot coffee", 5, "EUR", friday), &merchant), "Comment.")
+	assert.False(t, rule.Matches(newRuleExpense("Coffee to go", 5, "USD", friday), &merchant), "Currency.")
+	assert.False(t, rule.Matches(newRuleExpense("Coffee to go", 5.5, "EUR", friday), &merchant), "Amount.")
+	assert.False(t, rule.Matches(newRuleExpense("Coffee to go", 1, "EUR", friday), &merchant), "Amount.")
+	assert.False(t, rule.Matches(newRuleExpense("Coffee to go", 5, "EUR", saturday), &merchant), "Weekday.")
+}
@@ -0,0 +1,235 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Defines values for Frequency.
+const (
+	FrequencyDaily Frequency = "daily"
+
+	FrequencyWeekly Frequency = "weekly"
+
+	FrequencyMonthly Frequency = "monthly"
+
+	FrequencyYearly Frequency = "yearly"
+)
+
+// maxScheduleIterations guards against endless iteration over a series.
+const maxScheduleIterations int = 100000
+
+// Frequency defines how often a schedule repeats.
+type Frequency string
+
+// ScheduleParams holds raw schedule values.
+type ScheduleParams struct {
+	Frequency  string
+	Interval   *int
+	DayOfMonth *int
+	Start      time.Time
+	Until      *time.Time
+	Count      *int
+}
+
+// Schedule represents an RRULE-like repetition rule. Weekly and yearly schedules repeat on
+// the start date weekday and day of the year. Monthly schedules repeat on a day of month,
+// falling back to the last day of shorter months.
+type Schedule struct {
+	frequency  Frequency
+	interval   int
+	dayOfMonth int
+	start      time.Time
+	until      *time.Time
+	count      *int
+}
+
+// NewSchedule instantiates schedule.
+func NewSchedule(params ScheduleParams) (*Schedule, error) {
+	frequency := Frequency(params.Frequency)
+	switch frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	default:
+		return nil, fmt.Errorf("unknown frequency %s", params.Frequency)
+	}
+
+	if params.Start.IsZero() {
+		return nil, errors.New("empty start date")
+	}
+	start := truncateToDay(params.Start)
+
+	interval := 1
+	if params.Interval != nil {
+		if *params.Interval <= 0 {
+			return nil, errors.New("interval should be greater than zero")
+		}
+		interval = *params.Interval
+	}
+
+	dayOfMonth := 0
+	if frequency == FrequencyMonthly {
+		dayOfMonth = start.Day()
+	}
+	if params.DayOfMonth != nil {
+		if frequency != FrequencyMonthly {
+			return nil, errors.New("day of month is supported by monthly schedules only")
+		}
+		if *params.DayOfMonth < 1 || *params.DayOfMonth > 31 {
+			return nil, errors.New("day of month should be between 1 and 31")
+		}
+		dayOfMonth = *params.DayOfMonth
+	}
+
+	if params.Until != nil && params.Count != nil {
+		return nil, errors.New("either end date or count could be set")
+	}
+
+	var until *time.Time
+	if params.Until != nil {
+		untilDay := truncateToDay(*params.Until)
+		if untilDay.Before(start) {
+			return nil, errors.New("end date could not be before start date")
+		}
+		until = &untilDay
+	}
+
+	if params.Count != nil && *params.Count <= 0 {
+		return nil, errors.New("count should be greater than zero")
+	}
+
+	schedule := &Schedule{
+		frequency:  frequency,
+		interval:   interval,
+		dayOfMonth: dayOfMonth,
+		start:      start,
+		until:      until,
+		count:      params.Count,
+	}
+
+	return schedule, nil
+}
+
+// Frequency returns schedule frequency.
+func (s Schedule) Frequency() Frequency {
+	return s.frequency
+}
+
+// Interval returns how many frequency periods there are between occurrences.
+func (s Schedule) Interval() int {
+	return s.interval
+}
+
+// DayOfMonth returns a day of month monthly schedules repeat on, it is nil for other schedules.
+func (s Schedule) DayOfMonth() *int {
+	if s.frequency != FrequencyMonthly {
+		return nil
+	}
+	dayOfMonth := s.dayOfMonth
+	return &dayOfMonth
+}
+
+// Start returns the first day of the schedule.
+func (s Schedule) Start() time.Time {
+	return s.start
+}
+
+// Until returns the last day of the schedule.
+func (s Schedule) Until() *time.Time {
+	return s.until
+}
+
+// Count returns total number of occurrences.
+func (s Schedule) Count() *int {
+	return s.count
+}
+
+// Occurrences returns occurrence dates within the from and to days inclusively.
+func (s Schedule) Occurrences(from time.Time, to time.Time) []time.Time {
+	from, to = truncateToDay(from), truncateToDay(to)
+	dates := make([]time.Time, 0)
+	s.iterate(func(date time.Time) bool {
+		if date.After(to) {
+			return false
+		}
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+		return true
+	})
+	return dates
+}
+
+// Next returns up to limit occurrence dates starting from the day.
+func (s Schedule) Next(from time.Time, limit int) []time.Time {
+	from = truncateToDay(from)
+	dates := make([]time.Time, 0, limit)
+	s.iterate(func(date time.Time) bool {
+		if len(dates) >= limit {
+			return false
+		}
+		if !date.Before(from) {
+			dates = append(dates, date)
+		}
+		return len(dates) < limit
+	})
+	return dates
+}
+
+// IsOccurrence indicates whether the series occurs on the day.
+func (s Schedule) IsOccurrence(date time.Time) bool {
+	return len(s.Occurrences(date, date)) == 1
+}
+
+// iterate calls fn with every occurrence date until fn returns false or the schedule ends.
+func (s Schedule) iterate(fn func(date time.Time) bool) {
+	occurred := 0
+	for period := 0; period < maxScheduleIterations; period++ {
+		date := s.periodDate(period * s.interval)
+		// Monthly schedules may fall before the start date within the very first month.
+		if date.Before(s.start) {
+			continue
+		}
+		if s.until != nil && date.After(*s.until) {
+			return
+		}
+		if s.count != nil && occurred >= *s.count {
+			return
+		}
+		occurred++
+		if !fn(date) {
+			return
+		}
+	}
+}
+
+// periodDate returns a date of the schedule shifted by the number of frequency periods.
+func (s Schedule) periodDate(periods int) time.Time {
+	switch s.frequency {
+	case FrequencyWeekly:
+		return s.start.AddDate(0, 0, 7*periods)
+	case FrequencyMonthly:
+		return dateInMonth(s.start.Year(), s.start.Month()+time.Month(periods), s.dayOfMonth)
+	case FrequencyYearly:
+		return dateInMonth(s.start.Year()+periods, s.start.Month(), s.start.Day())
+	default:
+		return s.start.AddDate(0, 0, periods)
+	}
+}
+
+// dateInMonth returns the day of the month, or the last day of the month when the month is shorter.
+func dateInMonth(year int, month time.Month, day int) time.Time {
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstDay.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(firstDay.Year(), firstDay.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+// truncateToDay returns the UTC day of the time.
+func truncateToDay(date time.Time) time.Time {
+	year, month, day := date.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
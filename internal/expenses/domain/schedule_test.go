@@ -0,0 +1,204 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func utcDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewSchedule_ValidParams_InstantiatesSchedule(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	interval := 2
+	count := 5
+	params := domain.ScheduleParams{
+		Frequency: "weekly",
+		Interval:  &interval,
+		Start:     time.Date(2021, time.March, 3, 15, 30, 0, 0, time.UTC),
+		Count:     &count,
+	}
+
+	// Act
+	res, resErr := domain.NewSchedule(params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.NotNil(t, res)
+	assert.Equal(t, domain.FrequencyWeekly, res.Frequency())
+	assert.Equal(t, 2, res.Interval())
+	assert.Equal(t, utcDate(2021, time.March, 3), res.Start())
+	assert.Nil(t, res.DayOfMonth())
+	assert.Nil(t, res.Until())
+	assert.Equal(t, &count, res.Count())
+}
+
+func TestNewSchedule_MonthlyWithoutDay_UsesStartDay(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := domain.ScheduleParams{
+		Frequency: "monthly",
+		Start:     utcDate(2021, time.March, 15),
+	}
+
+	// Act
+	res, resErr := domain.NewSchedule(params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, 15, *res.DayOfMonth())
+	assert.Equal(t, 1, res.Interval())
+}
+
+func TestNewSchedule_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	zero := 0
+	day := 32
+	validDay := 10
+	count := 3
+	start := utcDate(2021, time.March, 15)
+	until := utcDate(2021, time.March, 14)
+	later := utcDate(2021, time.June, 1)
+	tests := []domain.ScheduleParams{
+		{Frequency: "hourly", Start: start},
+		{Frequency: "daily"},
+		{Frequency: "daily", Start: start, Interval: &zero},
+		{Frequency: "monthly", Start: start, DayOfMonth: &day},
+		{Frequency: "weekly", Start: start, DayOfMonth: &validDay},
+		{Frequency: "daily", Start: start, Until: &later, Count: &count},
+		{Frequency: "daily", Start: start, Until: &until},
+		{Frequency: "daily", Start: start, Count: &zero},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewSchedule(tc)
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
+
+func TestSchedule_Occurrences_ReturnsDatesWithinRange(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	interval := 2
+	until := utcDate(2021, time.March, 31)
+	tests := []struct {
+		params   domain.ScheduleParams
+		from     time.Time
+		to       time.Time
+		expected []time.Time
+	}{
+		{
+			params:   domain.ScheduleParams{Frequency: "daily", Start: utcDate(2021, time.March, 1), Interval: &interval},
+			from:     utcDate(2021, time.March, 4),
+			to:       utcDate(2021, time.March, 9),
+			expected: []time.Time{utcDate(2021, time.March, 5), utcDate(2021, time.March, 7), utcDate(2021, time.March, 9)},
+		},
+		{
+			params:   domain.ScheduleParams{Frequency: "weekly", Start: utcDate(2021, time.March, 1), Until: &until},
+			from:     utcDate(2021, time.March, 20),
+			to:       utcDate(2021, time.April, 30),
+			expected: []time.Time{utcDate(2021, time.March, 22), utcDate(2021, time.March, 29)},
+		},
+		{
+			params:   domain.ScheduleParams{Frequency: "yearly", Start: utcDate(2020, time.February, 29)},
+			from:     utcDate(2020, time.January, 1),
+			to:       utcDate(2022, time.December, 31),
+			expected: []time.Time{utcDate(2020, time.February, 29), utcDate(2021, time.February, 28), utcDate(2022, time.February, 28)},
+		},
+	}
+
+	for _, tc := range tests {
+		schedule, _ := domain.NewSchedule(tc.params)
+
+		// Act
+		res := schedule.Occurrences(tc.from, tc.to)
+
+		// Assert
+		assert.Equal(t, tc.expected, res)
+	}
+}
+
+func TestSchedule_Occurrences_MonthlyOnDay_FallsBackToLastDayOfMonth(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	day := 31
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency:  "monthly",
+		DayOfMonth: &day,
+		Start:      utcDate(2021, time.January, 10),
+	})
+	expected := []time.Time{
+		utcDate(2021, time.January, 31),
+		utcDate(2021, time.February, 28),
+		utcDate(2021, time.March, 31),
+		utcDate(2021, time.April, 30),
+	}
+
+	// Act
+	res := schedule.Occurrences(utcDate(2021, time.January, 1), utcDate(2021, time.April, 30))
+
+	// Assert
+	assert.Equal(t, expected, res)
+}
+
+func TestSchedule_Occurrences_MonthlyDayBeforeStart_StartsNextMonth(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	day := 5
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency:  "monthly",
+		DayOfMonth: &day,
+		Start:      utcDate(2021, time.January, 10),
+	})
+
+	// Act
+	res := schedule.Next(utcDate(2021, time.January, 1), 2)
+
+	// Assert
+	assert.Equal(t, []time.Time{utcDate(2021, time.February, 5), utcDate(2021, time.March, 5)}, res)
+}
+
+func TestSchedule_Next_CountLimitsOccurrences(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	count := 3
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency: "daily",
+		Start:     utcDate(2021, time.March, 1),
+		Count:     &count,
+	})
+
+	// Act
+	res := schedule.Next(utcDate(2021, time.March, 2), 10)
+
+	// Assert
+	assert.Equal(t, []time.Time{utcDate(2021, time.March, 2), utcDate(2021, time.March, 3)}, res)
+}
+
+func TestSchedule_IsOccurrence_ChecksDay(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency: "weekly",
+		Start:     utcDate(2021, time.March, 1),
+	})
+
+	// Act
+	occurs := schedule.IsOccurrence(time.Date(2021, time.March, 8, 12, 0, 0, 0, time.UTC))
+	notOccurs := schedule.IsOccurrence(utcDate(2021, time.March, 9))
+
+	// Assert
+	assert.True(t, occurs)
+	assert.False(t, notOccurs)
+}
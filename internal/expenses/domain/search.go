@@ -0,0 +1,196 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// SearchLimit defines a maximum number of expenses and categories returned by search.
+const SearchLimit int = 50
+
+// SearchFilterParams holds raw search values.
+type SearchFilterParams struct {
+	Text      string
+	From      *time.Time
+	To        *time.Time
+	MinAmount *float64
+	MaxAmount *float64
+}
+
+// SearchFilter represents a full-text search of expenses by comment, category and trip name.
+// Date and amount ranges apply to expenses only, amount is price multiplied by quantity.
+type SearchFilter struct {
+	text      string
+	terms     []string
+	from      *time.Time
+	to        *time.Time
+	minAmount *decimal.Decimal
+	maxAmount *decimal.Decimal
+}
+
+// NewSearchFilter instantiates search filter.
+func NewSearchFilter(params SearchFilterParams) (*SearchFilter, error) {
+	text := strings.TrimSpace(params.Text)
+	terms := Tokenize(text)
+	if len(terms) == 0 {
+		return nil, errors.New("empty search text")
+	}
+
+	if params.From != nil && params.To != nil && params.From.After(*params.To) {
+		return nil, errors.New("'from' date could not be after 'to' date")
+	}
+
+	var minAmount, maxAmount *decimal.Decimal
+	if params.MinAmount != nil {
+		if *params.MinAmount < 0 {
+			return nil, errors.New("negative minimal amount")
+		}
+		amount := decimal.NewFromFloat(*params.MinAmount)
+		minAmount = &amount
+	}
+	if params.MaxAmount != nil {
+		if *params.MaxAmount < 0 {
+			return nil, errors.New("negative maximal amount")
+		}
+		amount := decimal.NewFromFloat(*params.MaxAmount)
+		maxAmount = &amount
+	}
+	if minAmount != nil && maxAmount != nil && minAmount.GreaterThan(*maxAmount) {
+		return nil, errors.New("minimal amount could not be greater than maximal amount")
+	}
+
+	return &SearchFilter{
+		text:      text,
+		terms:     terms,
+		from:      params.From,
+		to:        params.To,
+		minAmount: minAmount,
+		maxAmount: maxAmount,
+	}, nil
+}
+
+// Text returns search text.
+func (f SearchFilter) Text() string {
+	return f.text
+}
+
+// Terms returns tokenized search text.
+func (f SearchFilter) Terms() []string {
+	return f.terms
+}
+
+// From returns start date of the expenses.
+func (f SearchFilter) From() *time.Time {
+	return f.from
+}
+
+// To returns end date of the expenses.
+func (f SearchFilter) To() *time.Time {
+	return f.to
+}
+
+// MinAmount returns minimal expense amount.
+func (f SearchFilter) MinAmount() *decimal.Decimal {
+	return f.minAmount
+}
+
+// MaxAmount returns maximal expense amount.
+func (f SearchFilter) MaxAmount() *decimal.Decimal {
+	return f.maxAmount
+}
+
+// MatchesExpense checks whether the expense is within the date and amount ranges of the filter.
+func (f SearchFilter) MatchesExpense(expense Expense) bool {
+	if f.from != nil && expense.date.Before(*f.from) {
+		return false
+	}
+	if f.to != nil && expense.date.After(*f.to) {
+		return false
+	}
+
+	amount := expense.price.Mul(expense.quantity)
+	if f.minAmount != nil && amount.LessThan(*f.minAmount) {
+		return false
+	}
+	if f.maxAmount != nil && amount.GreaterThan(*f.maxAmount) {
+		return false
+	}
+
+	return true
+}
+
+// Tokenize splits text into distinct lower-cased words.
+func Tokenize(text string) []string {
+	words := splitWords(text)
+
+	seen := make(map[string]bool, len(words))
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		if seen[word] {
+			continue
+		}
+		seen[word] = true
+		tokens = append(tokens, word)
+	}
+
+	return tokens
+}
+
+// splitWords splits text into lower-cased words keeping their order and repetitions.
+func splitWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// ExpenseMatch represents an expense found by search along with its relevance.
+type ExpenseMatch struct {
+	Expense Expense
+	Score   float64
+}
+
+// CategoryMatch represents a category found by search along with its relevance.
+type CategoryMatch struct {
+	Category Category
+	Score    float64
+}
+
+// SearchResult represents ranked search matches.
+type SearchResult struct {
+	Expenses   []ExpenseMatch
+	Categories []CategoryMatch
+}
+
+// NewSearchResult ranks matches by relevance and keeps SearchLimit best ones of each kind.
+// Expenses of the same relevance go from the most recent one, categories of the same relevance go by name.
+func NewSearchResult(expenses []ExpenseMatch, categories []CategoryMatch) SearchResult {
+	sort.SliceStable(expenses, func(i, j int) bool {
+		if expenses[i].Score != expenses[j].Score {
+			return expenses[i].Score > expenses[j].Score
+		}
+		return expenses[i].Expense.date.After(expenses[j].Expense.date)
+	})
+	if len(expenses) > SearchLimit {
+		expenses = expenses[:SearchLimit]
+	}
+
+	sort.SliceStable(categories, func(i, j int) bool {
+		if categories[i].Score != categories[j].Score {
+			return categories[i].Score > categories[j].Score
+		}
+		return categories[i].Category.name < categories[j].Category.name
+	})
+	if len(categories) > SearchLimit {
+		categories = categories[:SearchLimit]
+	}
+
+	return SearchResult{
+		Expenses:   expenses,
+		Categories: categories,
+	}
+}
@@ -0,0 +1,107 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewSearchFilter_ValidParams_TokenizesText(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	minAmount, maxAmount := 10.0, 100.0
+	params := domain.SearchFilterParams{
+		Text:      "  Dentist payment, dentist!  ",
+		MinAmount: &minAmount,
+		MaxAmount: &maxAmount,
+	}
+
+	// Act
+	res, resErr := domain.NewSearchFilter(params)
+
+	// Assert
+	assert.Nil(t, resErr, "Error should be nil.")
+	assert.Equal(t, "Dentist payment, dentist!", res.Text())
+	assert.Equal(t, []string{"dentist", "payment"}, res.Terms())
+	assert.Equal(t, "10", res.MinAmount().String())
+	assert.Equal(t, "100", res.MaxAmount().String())
+}
+
+func TestNewSearchFilter_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	negative, small, big := -1.0, 10.0, 100.0
+	cases := map[string]domain.SearchFilterParams{
+		"empty text":      {Text: " ,. "},
+		"invalid dates":   {Text: "dentist", From: &from, To: &to},
+		"negative amount": {Text: "dentist", MinAmount: &negative},
+		"invalid amounts": {Text: "dentist", MinAmount: &big, MaxAmount: &small},
+	}
+
+	for name, params := range cases {
+		params := params
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			res, resErr := domain.NewSearchFilter(params)
+
+			// Assert
+			assert.Nil(t, res, "Result should be nil.")
+			assert.NotNil(t, resErr, "Error should not be nil.")
+		})
+	}
+}
+
+func TestSearchFilter_MatchesExpense_ChecksDateAndAmountRanges(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("healthId", nil, "Health", nil, 1, "|healthId")
+	from := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.May, 31, 0, 0, 0, 0, time.UTC)
+	minAmount, maxAmount := 50.0, 150.0
+	sut, _ := domain.NewSearchFilter(domain.SearchFilterParams{
+		Text: "dentist", From: &from, To: &to, MinAmount: &minAmount, MaxAmount: &maxAmount,
+	})
+	spring := time.Date(2021, time.April, 10, 0, 0, 0, 0, time.UTC)
+	matching, _ := domain.NewExpense("1", *category, 60, "EUR", 2, nil, nil, spring)
+	tooCheap, _ := domain.NewExpense("2", *category, 20, "EUR", 2, nil, nil, spring)
+	tooLate, _ := domain.NewExpense("3", *category, 60, "EUR", 2, nil, nil, to.AddDate(0, 0, 1))
+
+	// Assert
+	assert.True(t, sut.MatchesExpense(*matching))
+	assert.False(t, sut.MatchesExpense(*tooCheap))
+	assert.False(t, sut.MatchesExpense(*tooLate))
+}
+
+func TestNewSearchResult_RanksMatches(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("healthId", nil, "Health", nil, 1, "|healthId")
+	other, _ := domain.NewCategory("dentistId", nil, "Dentist", nil, 1, "|dentistId")
+	older, _ := domain.NewExpense("older", *category, 10, "EUR", 1, nil, nil, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC))
+	newer, _ := domain.NewExpense("newer", *category, 10, "EUR", 1, nil, nil, time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC))
+	best, _ := domain.NewExpense("best", *category, 10, "EUR", 1, nil, nil, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Act
+	res := domain.NewSearchResult(
+		[]domain.ExpenseMatch{{Expense: *older, Score: 1}, {Expense: *best, Score: 2}, {Expense: *newer, Score: 1}},
+		[]domain.CategoryMatch{{Category: *category, Score: 1}, {Category: *other, Score: 1}},
+	)
+
+	// Assert
+	assert.Equal(t, "best", res.Expenses[0].Expense.ID())
+	assert.Equal(t, "newer", res.Expenses[1].Expense.ID())
+	assert.Equal(t, "older", res.Expenses[2].Expense.ID())
+	assert.Equal(t, "Dentist", res.Categories[0].Category.Name())
+}
+
+func TestTokenize_Text_ReturnsDistinctLowerCasedWords(t *testing.T) {
+	t.Parallel()
+	// Assert
+	assert.Equal(t, []string{"zahnarzt", "müller", "2021"}, domain.Tokenize("Zahnarzt Müller, 2021 — zahnarzt"))
+	assert.Empty(t, domain.Tokenize(" - "))
+}
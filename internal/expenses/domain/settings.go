@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SettingsParams holds raw settings values.
+type SettingsParams struct {
+	PeriodStartDay int
+	ReportCurrency string
+}
+
+// Settings represents personal report settings of a user.
+type Settings struct {
+	user           string
+	periodStartDay int
+	reportCurrency Currency
+}
+
+// NewSettings instantiates settings of the user. The period start day is a day of month budgeting
+// months begin on, e.g. payday. Reports are converted into the default currency when the report currency
+// is empty.
+func NewSettings(user string, params SettingsParams) (*Settings, error) {
+	user = strings.TrimSpace(user)
+	if len(user) == 0 {
+		return nil, errors.New("empty user")
+	}
+
+	if params.PeriodStartDay < 1 || params.PeriodStartDay > MaxPeriodStartDay {
+		return nil, errors.Errorf("period start day should be between 1 and %d", MaxPeriodStartDay)
+	}
+
+	reportCurrency := Currency(strings.ToUpper(strings.TrimSpace(params.ReportCurrency)))
+	if len(reportCurrency) == 0 {
+		reportCurrency = DefaultReportCurrency
+	}
+
+	return &Settings{
+		user:           user,
+		periodStartDay: params.PeriodStartDay,
+		reportCurrency: reportCurrency,
+	}, nil
+}
+
+// DefaultSettings returns settings of the user who has not changed them, periods are calendar ones.
+func DefaultSettings(user string) Settings {
+	return Settings{
+		user:           user,
+		periodStartDay: DefaultPeriodStartDay,
+		reportCurrency: DefaultReportCurrency,
+	}
+}
+
+// User returns a name of the user the settings belong to.
+func (s Settings) User() string {
+	return s.user
+}
+
+// PeriodStartDay returns the day of month months, quarters and years begin on in reports.
+func (s Settings) PeriodStartDay() int {
+	return s.periodStartDay
+}
+
+// ReportCurrency returns the currency reports are converted into by default.
+func (s Settings) ReportCurrency() Currency {
+	return s.reportCurrency
+}
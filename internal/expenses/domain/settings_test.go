@@ -0,0 +1,65 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewSettings_ValidParams_ReturnsSettings(t *testing.T) {
+	t.Parallel()
+	// Act
+	res, err := domain.NewSettings(" user ", domain.SettingsParams{PeriodStartDay: 25, ReportCurrency: " usd "})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "user", res.User())
+	assert.Equal(t, 25, res.PeriodStartDay())
+	assert.Equal(t, domain.Currency("USD"), res.ReportCurrency())
+}
+
+func TestNewSettings_EmptyReportCurrency_ReturnsDefaultCurrency(t *testing.T) {
+	t.Parallel()
+	// Act
+	res, err := domain.NewSettings("user", domain.SettingsParams{PeriodStartDay: 1})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, domain.DefaultReportCurrency, res.ReportCurrency())
+}
+
+func TestNewSettings_InvalidParams_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	type test struct {
+		user   string
+		params domain.SettingsParams
+	}
+	tests := []test{
+		{user: " ", params: domain.SettingsParams{PeriodStartDay: 1}},
+		{user: "user", params: domain.SettingsParams{PeriodStartDay: 0}},
+		{user: "user", params: domain.SettingsParams{PeriodStartDay: 29}},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, err := domain.NewSettings(tc.user, tc.params)
+
+		// Assert
+		assert.NotNil(t, err)
+		assert.Nil(t, res)
+	}
+}
+
+func TestDefaultSettings_ReturnsCalendarPeriods(t *testing.T) {
+	t.Parallel()
+	// Act
+	res := domain.DefaultSettings("user")
+
+	// Assert
+	assert.Equal(t, "user", res.User())
+	assert.Equal(t, domain.DefaultPeriodStartDay, res.PeriodStartDay())
+	assert.Equal(t, domain.DefaultReportCurrency, res.ReportCurrency())
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// SettlementParams holds raw settlement values.
+type SettlementParams struct {
+	From     string
+	To       string
+	Amount   float64
+	Currency string
+	Date     time.Time
+}
+
+// Settlement represents a settle-up payment between household members.
+type Settlement struct {
+	id       string
+	from     string
+	to       string
+	amount   decimal.Decimal
+	currency Currency
+	date     time.Time
+}
+
+// NewSettlement instantiates settlement.
+func NewSettlement(id string, params SettlementParams) (*Settlement, error) {
+	from, to := strings.TrimSpace(params.From), strings.TrimSpace(params.To)
+	if len(from) == 0 || len(to) == 0 {
+		return nil, errors.New("empty settlement user")
+	}
+	if from == to {
+		return nil, errors.New("user could not settle up with themselves")
+	}
+
+	if params.Amount <= 0 {
+		return nil, errors.New("amount should be grater than zero")
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(params.Currency))
+	if len(currency) == 0 {
+		return nil, errors.New("empty currency")
+	}
+
+	if params.Date.IsZero() {
+		return nil, errors.New("empty date")
+	}
+
+	return &Settlement{
+		id:       id,
+		from:     from,
+		to:       to,
+		amount:   decimal.NewFromFloat(params.Amount),
+		currency: Currency(currency),
+		date:     params.Date,
+	}, nil
+}
+
+// ID returns settlement id.
+func (s Settlement) ID() string {
+	return s.id
+}
+
+// From returns a user who paid the settlement.
+func (s Settlement) From() string {
+	return s.from
+}
+
+// To returns a user who received the settlement.
+func (s Settlement) To() string {
+	return s.to
+}
+
+// Amount returns settlement amount.
+func (s Settlement) Amount() float64 {
+	amount, _ := s.amount.Float64()
+	return amount
+}
+
+// Currency returns settlement currency.
+func (s Settlement) Currency() Currency {
+	return s.currency
+}
+
+// Date returns settlement date.
+func (s Settlement) Date() time.Time {
+	return s.date
+}
@@ -0,0 +1,56 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewSettlement_ValidArgs_InstantiatesSettlement(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := utcDate(2021, time.July, 1)
+
+	// Act
+	res, resErr := domain.NewSettlement("settlementId", domain.SettlementParams{
+		From:     " bob",
+		To:       "alice ",
+		Amount:   25.5,
+		Currency: "eur",
+		Date:     date,
+	})
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "settlementId", res.ID())
+	assert.Equal(t, "bob", res.From())
+	assert.Equal(t, "alice", res.To())
+	assert.Equal(t, 25.5, res.Amount())
+	assert.Equal(t, domain.Currency("EUR"), res.Currency())
+	assert.Equal(t, date, res.Date())
+}
+
+func TestNewSettlement_InvalidArgs_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := utcDate(2021, time.July, 1)
+	tests := []domain.SettlementParams{
+		{From: "", To: "alice", Amount: 1, Currency: "EUR", Date: date},
+		{From: "alice", To: "alice", Amount: 1, Currency: "EUR", Date: date},
+		{From: "bob", To: "alice", Amount: 0, Currency: "EUR", Date: date},
+		{From: "bob", To: "alice", Amount: 1, Currency: " ", Date: date},
+		{From: "bob", To: "alice", Amount: 1, Currency: "EUR"},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewSettlement("", tc)
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
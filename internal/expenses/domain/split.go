@@ -0,0 +1,182 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Defines values for SplitMethod.
+const (
+	SplitMethodEqual SplitMethod = "equal"
+
+	SplitMethodShares SplitMethod = "shares"
+
+	SplitMethodExact SplitMethod = "exact"
+
+	SplitMethodPercent SplitMethod = "percent"
+)
+
+// splitPrecision is a number of decimal places split amounts are rounded to.
+const splitPrecision int32 = 2
+
+// SplitMethod defines how an expense total is divided between participants.
+type SplitMethod string
+
+// SplitShareParams holds raw values of a participant share. The value is ignored by the equal split,
+// it is a weight, an exact amount or a percent of the total for the other methods.
+type SplitShareParams struct {
+	User  string
+	Value float64
+}
+
+// SplitParams holds raw split values.
+type SplitParams struct {
+	Method string
+	Shares []SplitShareParams
+}
+
+// SplitShare represents a participant share of the expense.
+type SplitShare struct {
+	User  string
+	Value decimal.Decimal
+}
+
+// SplitAmount represents an amount a participant owes for the expense.
+type SplitAmount struct {
+	User   string
+	Amount decimal.Decimal
+}
+
+// Split represents a division of an expense total between participants.
+type Split struct {
+	method SplitMethod
+	shares []SplitShare
+}
+
+// NewSplit instantiates split. Shares are sorted by user, so splitting is deterministic.
+func NewSplit(params SplitParams) (*Split, error) {
+	method := SplitMethod(params.Method)
+	switch method {
+	case SplitMethodEqual, SplitMethodShares, SplitMethodExact, SplitMethodPercent:
+	default:
+		return nil, fmt.Errorf("unknown split method %s", params.Method)
+	}
+
+	if len(params.Shares) == 0 {
+		return nil, errors.New("split has no participants")
+	}
+
+	shares := make([]SplitShare, 0, len(params.Shares))
+	seen := make(map[string]bool, len(params.Shares))
+	for _, share := range params.Shares {
+		user := strings.TrimSpace(share.User)
+		if len(user) == 0 {
+			return nil, errors.New("empty split participant")
+		}
+		if seen[user] {
+			return nil, fmt.Errorf("participant %s is split more than once", user)
+		}
+		seen[user] = true
+
+		value := decimal.NewFromFloat(share.Value)
+		if method == SplitMethodEqual {
+			value = decimal.Zero
+		}
+		if value.IsNegative() || (method == SplitMethodShares && !value.IsPositive()) {
+			return nil, fmt.Errorf("invalid share of participant %s", user)
+		}
+		shares = append(shares, SplitShare{User: user, Value: value})
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].User < shares[j].User
+	})
+
+	if method == SplitMethodPercent {
+		percents := decimal.Zero
+		for _, share := range shares {
+			percents = percents.Add(share.Value)
+		}
+		if !percents.Equal(decimal.NewFromInt(100)) {
+			return nil, errors.New("split percents should add up to 100")
+		}
+	}
+
+	return &Split{
+		method: method,
+		shares: shares,
+	}, nil
+}
+
+// Method returns split method.
+func (s Split) Method() SplitMethod {
+	return s.method
+}
+
+// Shares returns participant shares sorted by user.
+func (s Split) Shares() []SplitShare {
+	return s.shares
+}
+
+// Amounts divides the total between participants. Amounts are rounded down to cents and the remaining
+// cents go one by one to participants with the largest rounded off parts, ties go in user order.
+// Exact amounts should add up to the total.
+func (s Split) Amounts(total decimal.Decimal) ([]SplitAmount, error) {
+	total = total.Round(splitPrecision)
+
+	if s.method == SplitMethodExact {
+		amounts := make([]SplitAmount, 0, len(s.shares))
+		sum := decimal.Zero
+		for _, share := range s.shares {
+			amount := share.Value.Round(splitPrecision)
+			sum = sum.Add(amount)
+			amounts = append(amounts, SplitAmount{User: share.User, Amount: amount})
+		}
+		if !sum.Equal(total) {
+			return nil, fmt.Errorf("split amounts add up to %s instead of %s", sum, total)
+		}
+		return amounts, nil
+	}
+
+	weights := make([]decimal.Decimal, 0, len(s.shares))
+	weightSum := decimal.Zero
+	for _, share := range s.shares {
+		weight := share.Value
+		if s.method == SplitMethodEqual {
+			weight = decimal.NewFromInt(1)
+		}
+		weights = append(weights, weight)
+		weightSum = weightSum.Add(weight)
+	}
+
+	amounts := make([]SplitAmount, 0, len(s.shares))
+	remainders := make([]decimal.Decimal, 0, len(s.shares))
+	allocated := decimal.Zero
+	for i, share := range s.shares {
+		exact := total.Mul(weights[i]).DivRound(weightSum, 16)
+		amount := exact.RoundFloor(splitPrecision)
+		allocated = allocated.Add(amount)
+		amounts = append(amounts, SplitAmount{User: share.User, Amount: amount})
+		remainders = append(remainders, exact.Sub(amount))
+	}
+
+	order := make([]int, len(amounts))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]].GreaterThan(remainders[order[j]])
+	})
+
+	cent := decimal.New(1, -splitPrecision)
+	for i := 0; allocated.LessThan(total); i++ {
+		index := order[i%len(order)]
+		amounts[index].Amount = amounts[index].Amount.Add(cent)
+		allocated = allocated.Add(cent)
+	}
+
+	return amounts, nil
+}
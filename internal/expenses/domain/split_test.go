@@ -0,0 +1,125 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func splitAmounts(t *testing.T, params domain.SplitParams, total float64) map[string]string {
+	t.Helper()
+	split, splitErr := domain.NewSplit(params)
+	assert.Nil(t, splitErr)
+	amounts, amountsErr := split.Amounts(decimal.NewFromFloat(total))
+	assert.Nil(t, amountsErr)
+
+	res := make(map[string]string, len(amounts))
+	for _, amount := range amounts {
+		res[amount.User] = amount.Amount.StringFixed(2)
+	}
+	return res
+}
+
+func TestNewSplit_InvalidArgs_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := []domain.SplitParams{
+		{Method: "unknown", Shares: []domain.SplitShareParams{{User: "alice"}}},
+		{Method: "equal"},
+		{Method: "equal", Shares: []domain.SplitShareParams{{User: " "}}},
+		{Method: "equal", Shares: []domain.SplitShareParams{{User: "alice"}, {User: "alice"}}},
+		{Method: "shares", Shares: []domain.SplitShareParams{{User: "alice", Value: 0}}},
+		{Method: "exact", Shares: []domain.SplitShareParams{{User: "alice", Value: -1}}},
+		{Method: "percent", Shares: []domain.SplitShareParams{{User: "alice", Value: 60}, {User: "bob", Value: 30}}},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewSplit(tc)
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
+
+func TestNewSplit_ValidArgs_SortsSharesByUser(t *testing.T) {
+	t.Parallel()
+	// Act
+	res, resErr := domain.NewSplit(domain.SplitParams{
+		Method: "shares",
+		Shares: []domain.SplitShareParams{{User: "carol", Value: 1}, {User: " alice ", Value: 2}},
+	})
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, domain.SplitMethodShares, res.Method())
+	assert.Equal(t, "alice", res.Shares()[0].User)
+	assert.Equal(t, "carol", res.Shares()[1].User)
+}
+
+func TestSplitAmounts_Equal_AssignsRemainderInUserOrder(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := domain.SplitParams{
+		Method: "equal",
+		Shares: []domain.SplitShareParams{{User: "carol"}, {User: "bob"}, {User: "alice"}},
+	}
+
+	// Act
+	res := splitAmounts(t, params, 100)
+
+	// Assert
+	assert.Equal(t, map[string]string{"alice": "33.34", "bob": "33.33", "carol": "33.33"}, res)
+}
+
+func TestSplitAmounts_Shares_AssignsRemainderToLargestFraction(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := domain.SplitParams{
+		Method: "shares",
+		Shares: []domain.SplitShareParams{{User: "alice", Value: 1}, {User: "bob", Value: 2}},
+	}
+
+	// Act
+	res := splitAmounts(t, params, 10)
+
+	// Assert
+	assert.Equal(t, map[string]string{"alice": "3.33", "bob": "6.67"}, res, "6.666 is rounded up before 3.333.")
+}
+
+func TestSplitAmounts_Percent_SplitsByPercent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := domain.SplitParams{
+		Method: "percent",
+		Shares: []domain.SplitShareParams{{User: "alice", Value: 70}, {User: "bob", Value: 30}},
+	}
+
+	// Act
+	res := splitAmounts(t, params, 19.99)
+
+	// Assert
+	assert.Equal(t, map[string]string{"alice": "13.99", "bob": "6.00"}, res)
+}
+
+func TestSplitAmounts_Exact_ValidatesTotal(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	split, _ := domain.NewSplit(domain.SplitParams{
+		Method: "exact",
+		Shares: []domain.SplitShareParams{{User: "alice", Value: 7.5}, {User: "bob", Value: 2.5}},
+	})
+
+	// Act
+	amounts, amountsErr := split.Amounts(decimal.NewFromInt(10))
+	_, mismatchErr := split.Amounts(decimal.NewFromInt(11))
+
+	// Assert
+	assert.Nil(t, amountsErr)
+	assert.True(t, decimal.NewFromFloat(7.5).Equal(amounts[0].Amount))
+	assert.NotNil(t, mismatchErr, "Exact amounts should add up to the total.")
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Defines values for StatementFormat.
+const (
+	StatementFormatOFX StatementFormat = "ofx"
+
+	StatementFormatQIF StatementFormat = "qif"
+)
+
+// StatementFormat defines a bank statement file format.
+type StatementFormat string
+
+// StatementTransactionParams holds raw bank statement transaction values.
+type StatementTransactionParams struct {
+	ExternalID   string
+	Date         time.Time
+	Amount       float64
+	Currency     *string
+	Payee        *string
+	Memo         *string
+	CategoryHint *string
+}
+
+// StatementTransaction represents a bank statement transaction, a candidate for a new expense.
+type StatementTransaction struct {
+	externalID   string
+	date         time.Time
+	amount       float64
+	currency     *string
+	payee        *string
+	memo         *string
+	categoryHint *string
+}
+
+// NewStatementTransaction instantiates bank statement transaction.
+func NewStatementTransaction(params StatementTransactionParams) (*StatementTransaction, error) {
+	externalID := strings.TrimSpace(params.ExternalID)
+	if len(externalID) == 0 {
+		return nil, errors.New("empty transaction id")
+	}
+	if params.Date.IsZero() {
+		return nil, errors.New("empty transaction date")
+	}
+
+	var currency *string
+	if trimmed := trimmedOrNil(params.Currency); trimmed != nil {
+		upper := strings.ToUpper(*trimmed)
+		currency = &upper
+	}
+
+	transaction := &StatementTransaction{
+		externalID:   externalID,
+		date:         params.Date,
+		amount:       params.Amount,
+		currency:     currency,
+		payee:        trimmedOrNil(params.Payee),
+		memo:         trimmedOrNil(params.Memo),
+		categoryHint: trimmedOrNil(params.CategoryHint),
+	}
+
+	return transaction, nil
+}
+
+// ExternalID returns a transaction ID assigned by a bank, e.g. OFX FITID.
+func (t StatementTransaction) ExternalID() string {
+	return t.externalID
+}
+
+// Date returns transaction date.
+func (t StatementTransaction) Date() time.Time {
+	return t.date
+}
+
+// Amount returns signed transaction amount, debits are negative.
+func (t StatementTransaction) Amount() float64 {
+	return t.amount
+}
+
+// Currency returns transaction currency if known.
+func (t StatementTransaction) Currency() *string {
+	return t.currency
+}
+
+// Payee returns transaction payee.
+func (t StatementTransaction) Payee() *string {
+	return t.payee
+}
+
+// Memo returns transaction memo.
+func (t StatementTransaction) Memo() *string {
+	return t.memo
+}
+
+// CategoryHint returns a category name provided by a statement, e.g. QIF L field.
+func (t StatementTransaction) CategoryHint() *string {
+	return t.categoryHint
+}
+
+// IsDebit indicates whether the transaction is a spending.
+func (t StatementTransaction) IsDebit() bool {
+	return t.amount < 0
+}
+
+// Comment combines payee and memo into an expense comment.
+func (t StatementTransaction) Comment() *string {
+	parts := make([]string, 0, 2)
+	if t.payee != nil {
+		parts = append(parts, *t.payee)
+	}
+	if t.memo != nil && (t.payee == nil || !strings.EqualFold(*t.payee, *t.memo)) {
+		parts = append(parts, *t.memo)
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+	comment := strings.Join(parts, " - ")
+	return &comment
+}
+
+// ToExpense creates a new expense out of a debit transaction.
+// The currency is used when the transaction has no currency of its own.
+func (t StatementTransaction) ToExpense(
+	category Category,
+	currency string,
+	opts ...func(*Expense),
+) (*Expense, error) {
+	if !t.IsDebit() {
+		return nil, fmt.Errorf("%w: transaction %s is not a debit", ErrInvalidExpense, t.externalID)
+	}
+	if t.currency != nil {
+		currency = *t.currency
+	}
+
+	opts = append(opts, SetExternalID(t.externalID))
+	expense, expenseErr := NewExpense("", category, math.Abs(t.amount), currency, 1, t.Comment(), nil, t.date, opts...)
+	if expenseErr != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExpense, expenseErr)
+	}
+
+	return expense, nil
+}
@@ -0,0 +1,121 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewStatementTransaction_ValidParams_InstantiatesTransaction(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	currency := " eur "
+	payee := " Shop "
+	memo := " "
+	params := domain.StatementTransactionParams{
+		ExternalID: " 123 ",
+		Date:       time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+		Amount:     -10.5,
+		Currency:   &currency,
+		Payee:      &payee,
+		Memo:       &memo,
+	}
+
+	// Act
+	res, resErr := domain.NewStatementTransaction(params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "123", res.ExternalID())
+	assert.Equal(t, "EUR", *res.Currency())
+	assert.Equal(t, "Shop", *res.Payee())
+	assert.Nil(t, res.Memo())
+	assert.True(t, res.IsDebit())
+}
+
+func TestNewStatementTransaction_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := []domain.StatementTransactionParams{
+		{ExternalID: " ", Date: time.Now(), Amount: -1},
+		{ExternalID: "123", Amount: -1},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewStatementTransaction(tc)
+
+		// Assert
+		assert.Nil(t, res)
+		assert.NotNil(t, resErr)
+	}
+}
+
+func TestStatementTransaction_Comment_CombinesPayeeAndMemo(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	payee := "Shop"
+	memo := "Card payment"
+	samePayee := "shop"
+	both, _ := domain.NewStatementTransaction(domain.StatementTransactionParams{
+		ExternalID: "1", Date: time.Now(), Payee: &payee, Memo: &memo,
+	})
+	same, _ := domain.NewStatementTransaction(domain.StatementTransactionParams{
+		ExternalID: "2", Date: time.Now(), Payee: &payee, Memo: &samePayee,
+	})
+	empty, _ := domain.NewStatementTransaction(domain.StatementTransactionParams{
+		ExternalID: "3", Date: time.Now(),
+	})
+
+	// Assert
+	assert.Equal(t, "Shop - Card payment", *both.Comment())
+	assert.Equal(t, "Shop", *same.Comment())
+	assert.Nil(t, empty.Comment())
+}
+
+func TestStatementTransaction_ToExpense_DebitTransaction_ReturnsExpense(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	date := time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+	transaction, _ := domain.NewStatementTransaction(domain.StatementTransactionParams{
+		ExternalID: "fitId", Date: date, Amount: -12.5,
+	})
+
+	// Act
+	res, resErr := transaction.ToExpense(*category, "USD", domain.SetCreateMetadata("userId", date))
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, 12.5, res.Price())
+	assert.Equal(t, "USD", res.Currency())
+	assert.Equal(t, "fitId", *res.ExternalID())
+	assert.Equal(t, "userId", res.CreatedBy())
+	assert.Equal(t, date, res.Date())
+}
+
+func TestStatementTransaction_ToExpense_InvalidTransaction_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	category, _ := domain.NewCategory("categoryId", nil, "category", nil, 1, "|categoryId")
+	credit, _ := domain.NewStatementTransaction(domain.StatementTransactionParams{
+		ExternalID: "1", Date: time.Now(), Amount: 10,
+	})
+	noCurrency, _ := domain.NewStatementTransaction(domain.StatementTransactionParams{
+		ExternalID: "2", Date: time.Now(), Amount: -10,
+	})
+
+	// Act
+	creditRes, creditErr := credit.ToExpense(*category, "EUR")
+	noCurrencyRes, noCurrencyErr := noCurrency.ToExpense(*category, "")
+
+	// Assert
+	assert.Nil(t, creditRes)
+	assert.True(t, errors.Is(creditErr, domain.ErrInvalidExpense))
+	assert.Nil(t, noCurrencyRes)
+	assert.True(t, errors.Is(noCurrencyErr, domain.ErrInvalidExpense))
+}
@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+)
+
+// Tag represents a free-form expense label along with the number of expenses using it.
+type Tag struct {
+	Name  string
+	Count int
+}
+
+// NormalizeTag returns the canonical tag spelling, tags are compared case-insensitively.
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// NormalizeTags returns sorted unique canonical tags, empty tags are dropped.
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if len(tag) == 0 || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+
+	return normalized
+}
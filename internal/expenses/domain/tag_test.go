@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTag_MixedCase_ReturnsTrimmedLowerCase(t *testing.T) {
+	t.Parallel()
+	// Act
+	res := NormalizeTag("  Business ")
+
+	// Assert
+	assert.Equal(t, "business", res)
+}
+
+func TestNormalizeTags_DuplicatesAndEmpty_ReturnsSortedUniqueTags(t *testing.T) {
+	t.Parallel()
+	// Act
+	res := NormalizeTags([]string{"Gift", " ", "business", "gift ", "BUSINESS"})
+
+	// Assert
+	assert.Equal(t, []string{"business", "gift"}, res)
+}
+
+func TestNormalizeTags_Nil_ReturnsEmptyTags(t *testing.T) {
+	t.Parallel()
+	// Act
+	res := NormalizeTags(nil)
+
+	// Assert
+	assert.Empty(t, res)
+}
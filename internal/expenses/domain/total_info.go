@@ -1,5 +1,7 @@
 package domain
 
+import "github.com/shopspring/decimal"
+
 // TotalInfo represents total info.
 type TotalInfo struct {
 	OriginalTotal  Total
@@ -7,6 +9,42 @@ type TotalInfo struct {
 	ExchangeRate   *ExchangeRate
 }
 
+// newTotalInfo returns total info of the sum, the sum is converted into the base currency of the exchange rates
+// when they have a rate of the currency. A sum in the base currency is converted at the rate of one.
+func newTotalInfo(sum decimal.Decimal, currency Currency, exchangeRate *ExchangeRates) TotalInfo {
+	totalInfo := TotalInfo{
+		OriginalTotal: Total{
+			Sum:      sum,
+			Currency: currency,
+		},
+	}
+
+	if exchangeRate == nil {
+		return totalInfo
+	}
+
+	rate, ok := exchangeRate.rates[currency]
+	if currency == exchangeRate.baseCurrency {
+		rate, ok = decimal.NewFromInt(1), true
+	}
+	if !ok {
+		return totalInfo
+	}
+
+	totalInfo.ExchangeRate = &ExchangeRate{
+		date:           exchangeRate.date,
+		baseCurrency:   exchangeRate.baseCurrency,
+		targetCurrency: currency,
+		rate:           rate,
+	}
+	totalInfo.ConvertedTotal = &Total{
+		Currency: exchangeRate.baseCurrency,
+		Sum:      sum.Div(rate),
+	}
+
+	return totalInfo
+}
+
 // Add combines two total info structs together.
 func (t TotalInfo) Add(t2 TotalInfo) TotalInfo {
 	origTotal := t.OriginalTotal.Add(&t2.OriginalTotal)
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// TransferParams holds raw transfer values.
+type TransferParams struct {
+	FromAccountID string
+	ToAccountID   string
+	Amount        float64
+	Rate          *float64
+	Date          time.Time
+	Comment       *string
+}
+
+// Transfer represents money moved between accounts of the household. A transfer is not spending,
+// it is kept apart from expenses and never shows up in expense reports.
+type Transfer struct {
+	id            string
+	fromAccountID string
+	toAccountID   string
+	amount        decimal.Decimal
+	rate          *decimal.Decimal
+	date          time.Time
+	comment       *string
+}
+
+// NewTransfer instantiates transfer. The amount is in the currency of the source account,
+// the rate converts it into the currency of the target account when the currencies differ.
+func NewTransfer(id string, params TransferParams) (*Transfer, error) {
+	fromAccountID, toAccountID := strings.TrimSpace(params.FromAccountID), strings.TrimSpace(params.ToAccountID)
+	if len(fromAccountID) == 0 || len(toAccountID) == 0 {
+		return nil, errors.New("empty transfer account")
+	}
+	if fromAccountID == toAccountID {
+		return nil, errors.New("money could not be transferred to the same account")
+	}
+
+	if params.Amount <= 0 {
+		return nil, errors.New("amount should be grater than zero")
+	}
+
+	var rate *decimal.Decimal
+	if params.Rate != nil {
+		if *params.Rate <= 0 {
+			return nil, errors.New("rate should be grater than zero")
+		}
+		value := decimal.NewFromFloat(*params.Rate)
+		rate = &value
+	}
+
+	if params.Date.IsZero() {
+		return nil, errors.New("empty date")
+	}
+
+	return &Transfer{
+		id:            id,
+		fromAccountID: fromAccountID,
+		toAccountID:   toAccountID,
+		amount:        decimal.NewFromFloat(params.Amount),
+		rate:          rate,
+		date:          params.Date,
+		comment:       trimmedOrNil(params.Comment),
+	}, nil
+}
+
+// ID returns transfer id.
+func (t Transfer) ID() string {
+	return t.id
+}
+
+// FromAccountID returns an ID of the account money is transferred from.
+func (t Transfer) FromAccountID() string {
+	return t.fromAccountID
+}
+
+// ToAccountID returns an ID of the account money is transferred to.
+func (t Transfer) ToAccountID() string {
+	return t.toAccountID
+}
+
+// Amount returns transferred amount in the currency of the source account.
+func (t Transfer) Amount() float64 {
+	amount, _ := t.amount.Float64()
+	return amount
+}
+
+// Rate returns an exchange rate from the source account currency to the target account currency,
+// it is nil for accounts of the same currency.
+func (t Transfer) Rate() *float64 {
+	if t.rate == nil {
+		return nil
+	}
+	rate, _ := t.rate.Float64()
+	return &rate
+}
+
+// ReceivedAmount returns transferred amount in the currency of the target account.
+func (t Transfer) ReceivedAmount() decimal.Decimal {
+	if t.rate == nil {
+		return t.amount
+	}
+	return t.amount.Mul(*t.rate)
+}
+
+// Date returns transfer date.
+func (t Transfer) Date() time.Time {
+	return t.date
+}
+
+// Comment returns transfer comment.
+func (t Transfer) Comment() *string {
+	return t.comment
+}
+
+// CheckAccounts checks the transfer is between the accounts and has a rate only when their currencies differ.
+func (t Transfer) CheckAccounts(from Account, to Account) error {
+	if from.id != t.fromAccountID || to.id != t.toAccountID {
+		return errors.New("transfer accounts mismatch")
+	}
+	if from.currency != to.currency && t.rate == nil {
+		return errors.Errorf("rate is required to transfer %s to %s", from.currency, to.currency)
+	}
+	if from.currency == to.currency && t.rate != nil {
+		return errors.New("rate could not be set for accounts of the same currency")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewTransfer_ValidParams_ReturnsTransfer(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	rate := 1.1
+	comment := " Top up "
+
+	// Act
+	res, err := domain.NewTransfer("transferId", domain.TransferParams{
+		FromAccountID: "cardId", ToAccountID: "cashId", Amount: 100, Rate: &rate,
+		Date: utcDate(2021, time.July, 1), Comment: &comment,
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "cardId", res.FromAccountID())
+	assert.Equal(t, "cashId", res.ToAccountID())
+	assert.Equal(t, 100.0, res.Amount())
+	assert.Equal(t, 1.1, *res.Rate())
+	assert.Equal(t, "110", res.ReceivedAmount().String())
+	assert.Equal(t, "Top up", *res.Comment())
+}
+
+func TestNewTransfer_InvalidParams_ReturnsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	date := utcDate(2021, time.July, 1)
+	zeroRate := 0.0
+	tests := []domain.TransferParams{
+		{FromAccountID: "", ToAccountID: "cashId", Amount: 100, Date: date},
+		{FromAccountID: "cardId", ToAccountID: "cardId", Amount: 100, Date: date},
+		{FromAccountID: "cardId", ToAccountID: "cashId", Amount: 0, Date: date},
+		{FromAccountID: "cardId", ToAccountID: "cashId", Amount: 100, Rate: &zeroRate, Date: date},
+		{FromAccountID: "cardId", ToAccountID: "cashId", Amount: 100},
+	}
+
+	for _, params := range tests {
+		// Act
+		res, err := domain.NewTransfer("transferId", params)
+
+		// Assert
+		assert.Nil(t, res)
+		assert.NotNil(t, err)
+	}
+}
+
+func TestTransfer_CheckAccounts_RequiresRateBetweenCurrencies(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	eur := newAccount(t, "eurId", "EUR", 0)
+	usd := newAccount(t, "usdId", "USD", 0)
+	cash := newAccount(t, "cashId", "EUR", 0)
+	rate := 1.2
+	withoutRate, _ := domain.NewTransfer("", domain.TransferParams{
+		FromAccountID: "eurId", ToAccountID: "usdId", Amount: 100, Date: utcDate(2021, time.July, 1),
+	})
+	withRate, _ := domain.NewTransfer("", domain.TransferParams{
+		FromAccountID: "eurId", ToAccountID: "cashId", Amount: 100, Rate: &rate, Date: utcDate(2021, time.July, 1),
+	})
+
+	// Act
+	missingRateErr := withoutRate.CheckAccounts(eur, usd)
+	extraRateErr := withRate.CheckAccounts(eur, cash)
+	mismatchErr := withoutRate.CheckAccounts(eur, cash)
+
+	// Assert
+	assert.NotNil(t, missingRateErr)
+	assert.NotNil(t, extraRateErr)
+	assert.NotNil(t, mismatchErr)
+}
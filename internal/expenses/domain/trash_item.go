@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Defines values for TrashItemType.
+const (
+	TrashItemTypeExpense TrashItemType = "expense"
+
+	TrashItemTypeCategory TrashItemType = "category"
+)
+
+// TrashItemType defines a kind of item in the trash.
+type TrashItemType string
+
+// TrashItem represents an expense or a category subtree moved to the trash.
+type TrashItem struct {
+	id        string
+	itemType  TrashItemType
+	name      string
+	parentID  *string
+	deletedBy string
+	deletedAt time.Time
+}
+
+// NewTrashItem instantiates trash item.
+func NewTrashItem(id string, itemType TrashItemType, name string, parentID *string,
+	deletedBy string, deletedAt time.Time) (*TrashItem, error) {
+	if len(id) == 0 {
+		return nil, errors.New("empty id")
+	}
+
+	switch itemType {
+	case TrashItemTypeExpense, TrashItemTypeCategory:
+	default:
+		return nil, fmt.Errorf("unknown trash item type %s", itemType)
+	}
+
+	if deletedAt.IsZero() {
+		return nil, errors.New("empty deletion date")
+	}
+
+	if parentID != nil && len(*parentID) == 0 {
+		parentID = nil
+	}
+
+	item := &TrashItem{
+		id:        id,
+		itemType:  itemType,
+		name:      name,
+		parentID:  parentID,
+		deletedBy: deletedBy,
+		deletedAt: deletedAt,
+	}
+
+	return item, nil
+}
+
+// ID returns trashed item id.
+func (t TrashItem) ID() string {
+	return t.id
+}
+
+// Type returns trashed item type.
+func (t TrashItem) Type() TrashItemType {
+	return t.itemType
+}
+
+// Name returns trashed item name.
+func (t TrashItem) Name() string {
+	return t.name
+}
+
+// ParentID returns parent category id of a trashed category.
+func (t TrashItem) ParentID() *string {
+	return t.parentID
+}
+
+// DeletedBy returns the user who moved the item to the trash.
+func (t TrashItem) DeletedBy() string {
+	return t.deletedBy
+}
+
+// DeletedAt returns the date the item was moved to the trash.
+func (t TrashItem) DeletedAt() time.Time {
+	return t.deletedAt
+}
+
+// IsCategory returns true if the trashed item is a category subtree.
+func (t TrashItem) IsCategory() bool {
+	return t.itemType == TrashItemTypeCategory
+}
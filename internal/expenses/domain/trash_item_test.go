@@ -0,0 +1,71 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewTrashItem_ValidParams_InstantiatesItem(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	parentID := "parentId"
+	deletedAt := time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	res, resErr := domain.NewTrashItem("categoryId", domain.TrashItemTypeCategory, "category",
+		&parentID, "user", deletedAt)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.NotNil(t, res)
+	assert.Equal(t, "categoryId", res.ID())
+	assert.Equal(t, domain.TrashItemTypeCategory, res.Type())
+	assert.Equal(t, "category", res.Name())
+	assert.Equal(t, parentID, *res.ParentID())
+	assert.Equal(t, "user", res.DeletedBy())
+	assert.Equal(t, deletedAt, res.DeletedAt())
+	assert.True(t, res.IsCategory())
+}
+
+func TestNewTrashItem_EmptyParentID_ResetsParent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	parentID := ""
+
+	// Act
+	res, resErr := domain.NewTrashItem("expenseId", domain.TrashItemTypeExpense, "expense",
+		&parentID, "user", time.Now())
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Nil(t, res.ParentID())
+	assert.False(t, res.IsCategory())
+}
+
+func TestNewTrashItem_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	type testCase struct {
+		id        string
+		itemType  domain.TrashItemType
+		deletedAt time.Time
+	}
+	tests := []testCase{
+		{id: "", itemType: domain.TrashItemTypeExpense, deletedAt: time.Now()},
+		{id: "id", itemType: "unknown", deletedAt: time.Now()},
+		{id: "id", itemType: domain.TrashItemTypeCategory, deletedAt: time.Time{}},
+	}
+
+	for _, tc := range tests {
+		// Act
+		res, resErr := domain.NewTrashItem(tc.id, tc.itemType, "name", nil, "user", tc.deletedAt)
+
+		// Assert
+		assert.NotNil(t, resErr)
+		assert.Nil(t, res)
+	}
+}
@@ -0,0 +1,212 @@
+package domain
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TripParams holds raw trip values.
+type TripParams struct {
+	Name         string
+	From         time.Time
+	To           time.Time
+	HomeCurrency string
+	Participants []string
+}
+
+// Trip represents a journey expenses could be attached to.
+type Trip struct {
+	id           string
+	name         string
+	from         time.Time
+	to           time.Time
+	homeCurrency Currency
+	participants []string
+}
+
+// NewTrip instantiates trip. Trip dates are truncated to days, participants are trimmed and deduplicated.
+func NewTrip(id string, params TripParams) (*Trip, error) {
+	name := strings.TrimSpace(params.Name)
+	if len(name) == 0 {
+		return nil, errors.New("empty name")
+	}
+
+	if params.From.IsZero() || params.To.IsZero() {
+		return nil, errors.New("empty trip dates")
+	}
+	from, to := truncateToDay(params.From), truncateToDay(params.To)
+	if from.After(to) {
+		return nil, errors.New("'from' date could not be after 'to' date")
+	}
+
+	homeCurrency := strings.ToUpper(strings.TrimSpace(params.HomeCurrency))
+	if len(homeCurrency) == 0 {
+		return nil, errors.New("empty home currency")
+	}
+
+	participants := make([]string, 0, len(params.Participants))
+	seen := make(map[string]bool, len(params.Participants))
+	for _, participant := range params.Participants {
+		participant = strings.TrimSpace(participant)
+		if len(participant) == 0 {
+			return nil, errors.New("empty participant")
+		}
+		if seen[participant] {
+			continue
+		}
+		seen[participant] = true
+		participants = append(participants, participant)
+	}
+
+	return &Trip{
+		id:           id,
+		name:         name,
+		from:         from,
+		to:           to,
+		homeCurrency: Currency(homeCurrency),
+		participants: participants,
+	}, nil
+}
+
+// ID returns trip id.
+func (t Trip) ID() string {
+	return t.id
+}
+
+// Name returns trip name.
+func (t Trip) Name() string {
+	return t.name
+}
+
+// From returns the first trip day.
+func (t Trip) From() time.Time {
+	return t.from
+}
+
+// To returns the last trip day.
+func (t Trip) To() time.Time {
+	return t.to
+}
+
+// HomeCurrency returns a currency trip spending is reported in.
+func (t Trip) HomeCurrency() Currency {
+	return t.homeCurrency
+}
+
+// Participants returns trip participants.
+func (t Trip) Participants() []string {
+	return t.participants
+}
+
+// Days returns the number of trip days.
+func (t Trip) Days() int {
+	return int(t.to.Sub(t.from).Hours()/24) + 1
+}
+
+// Key returns trip name key, trip names differing in case and surrounding spaces share the key.
+func (t Trip) Key() string {
+	return TripKey(t.name)
+}
+
+// TripKey returns a key of the trip name.
+func TripKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// LegacyTripUsage represents free-text trip name usage by expenses of a single currency.
+type LegacyTripUsage struct {
+	Name     string
+	Currency string
+	From     time.Time
+	To       time.Time
+	Count    int
+}
+
+// LegacyTripMigration holds a trip replacing free-text trip names sharing the trip key.
+type LegacyTripMigration struct {
+	Trip  Trip
+	Names []string
+}
+
+// NewLegacyTripMigrations groups free-text trip names by their key. A trip is named after the most used
+// spelling, spans all its expenses and uses the most used currency as the home currency.
+func NewLegacyTripMigrations(usages []LegacyTripUsage) []LegacyTripMigration {
+	type tripUsage struct {
+		names      map[string]int
+		currencies map[string]int
+		from       time.Time
+		to         time.Time
+	}
+
+	keys := make([]string, 0)
+	keyUsages := make(map[string]*tripUsage)
+	for _, usage := range usages {
+		key := TripKey(usage.Name)
+		if len(key) == 0 {
+			continue
+		}
+		keyUsage, ok := keyUsages[key]
+		if !ok {
+			keyUsage = &tripUsage{
+				names:      make(map[string]int),
+				currencies: make(map[string]int),
+				from:       usage.From,
+				to:         usage.To,
+			}
+			keyUsages[key] = keyUsage
+			keys = append(keys, key)
+		}
+		keyUsage.names[usage.Name] += usage.Count
+		keyUsage.currencies[usage.Currency] += usage.Count
+		if usage.From.Before(keyUsage.from) {
+			keyUsage.from = usage.From
+		}
+		if usage.To.After(keyUsage.to) {
+			keyUsage.to = usage.To
+		}
+	}
+	sort.Strings(keys)
+
+	migrations := make([]LegacyTripMigration, 0, len(keys))
+	for _, key := range keys {
+		keyUsage := keyUsages[key]
+		trip, tripErr := NewTrip("", TripParams{
+			Name:         mostUsed(keyUsage.names),
+			From:         keyUsage.from,
+			To:           keyUsage.to,
+			HomeCurrency: mostUsed(keyUsage.currencies),
+		})
+		if tripErr != nil {
+			continue
+		}
+
+		names := make([]string, 0, len(keyUsage.names))
+		for name := range keyUsage.names {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		migrations = append(migrations, LegacyTripMigration{
+			Trip:  *trip,
+			Names: names,
+		})
+	}
+
+	return migrations
+}
+
+// mostUsed returns the value with the highest count, ties are resolved alphabetically.
+func mostUsed(counts map[string]int) string {
+	result := ""
+	resultCount := -1
+	for value, count := range counts {
+		if count > resultCount || (count == resultCount && value < result) {
+			result = value
+			resultCount = count
+		}
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TripReport represents trip spending broken down by category and by day in the trip home currency.
+type TripReport struct {
+	Trip         Trip
+	Categories   []*CategoryExpenses
+	Days         ReportByDate
+	GrandTotal   GrandTotal
+	DailyAverage Total
+}
+
+// NewTripReport generates trip report from expenses of the trip. The daily average spreads
+// spending in the home currency over all trip days.
+func NewTripReport(trip Trip, expenses []Expense, rates []ExchangeRates) TripReport {
+	dayRates := make(map[time.Time]ExchangeRates, len(rates))
+	for _, rate := range rates {
+		dayRates[truncateToDay(rate.Date())] = rate
+	}
+
+	tripExpenses := make([]Expense, 0, len(expenses))
+	dayExpenses := make(map[time.Time][]Expense)
+	for _, expense := range expenses {
+		day := truncateToDay(expense.date)
+		var rate *ExchangeRates
+		if dayRate, ok := dayRates[day]; ok {
+			rate = dayRate.inCurrency(trip.homeCurrency)
+		}
+		expense.CalculateTotal(rate)
+		tripExpenses = append(tripExpenses, expense)
+		dayExpenses[day] = append(dayExpenses[day], expense)
+	}
+
+	days := make([]*DateExpenses, 0, len(dayExpenses))
+	for day, expenses := range dayExpenses {
+		root := buildCategoryHierarchy(buildCategoryFlatMap(expenses))
+		dateExpenses := &DateExpenses{
+			Date:          day,
+			SubCategories: root.SubCategories,
+		}
+		if rate, ok := dayRates[day]; ok {
+			dateExpenses.ExchangeRate = rate.ChangeBaseCurrency(trip.homeCurrency)
+		}
+		days = append(days, dateExpenses)
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Date.Before(days[j].Date)
+	})
+
+	root := buildCategoryHierarchy(buildCategoryFlatMap(tripExpenses))
+	grandTotal := root.CalculateTotal()
+	byDate := ReportByDate{
+		CategoryByDate: days,
+	}
+	byDate.CalculateTotal()
+
+	return TripReport{
+		Trip:       trip,
+		Categories: root.SubCategories,
+		Days:       byDate,
+		GrandTotal: grandTotal,
+		DailyAverage: Total{
+			Sum:      grandTotal.Sum(trip.homeCurrency).Div(decimal.NewFromInt(int64(trip.Days()))).Round(2),
+			Currency: trip.homeCurrency,
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewTripReport_Expenses_ReportsInHomeCurrency(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	trip, _ := domain.NewTrip("tripId", domain.TripParams{
+		Name:         "New York",
+		From:         utcDate(2021, time.July, 1),
+		To:           utcDate(2021, time.July, 4),
+		HomeCurrency: "USD",
+	})
+	tripID := trip.ID()
+	food, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	hotel, _ := domain.NewCategory("hotelId", nil, "Hotel", nil, 1, "|hotelId")
+	lunch, _ := domain.NewExpense("lunchId", *food, 10, "USD", 1, nil, &tripID, utcDate(2021, time.July, 1))
+	dinner, _ := domain.NewExpense("dinnerId", *food, 5, "EUR", 2, nil, &tripID, utcDate(2021, time.July, 2))
+	room, _ := domain.NewExpense("roomId", *hotel, 20, "EUR", 1, nil, &tripID, utcDate(2021, time.July, 1))
+	firstRates, _ := domain.NewExchageRate(utcDate(2021, time.July, 1), "EUR", map[string]float64{"USD": 2})
+	secondRates, _ := domain.NewExchageRate(utcDate(2021, time.July, 2), "EUR", map[string]float64{"USD": 1.5})
+
+	// Act
+	res := domain.NewTripReport(*trip, []domain.Expense{*dinner, *lunch, *room},
+		[]domain.ExchangeRates{*firstRates, *secondRates})
+
+	// Assert
+	assert.Equal(t, *trip, res.Trip)
+	assert.Len(t, res.Categories, 2)
+	assert.Len(t, res.Days.CategoryByDate, 2)
+	assert.Equal(t, utcDate(2021, time.July, 1), res.Days.CategoryByDate[0].Date, "Days should be sorted.")
+	assert.Len(t, res.GrandTotal.SubTotals, 2, "Should keep subtotals per currency.")
+	assert.True(t, decimal.NewFromInt(65).Equal(res.GrandTotal.Sum("USD").Round(2)), "10 + 5*2*1.5 + 20*2 USD expected.")
+	assert.Equal(t, domain.Currency("USD"), res.DailyAverage.Currency)
+	assert.True(t, decimal.NewFromFloat(16.25).Equal(res.DailyAverage.Sum), "Should spread over all trip days.")
+}
@@ -0,0 +1,97 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
+
+func TestNewTrip_ValidParams_NormalizesValues(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	params := domain.TripParams{
+		Name:         " Paris 2021 ",
+		From:         time.Date(2021, time.July, 1, 15, 30, 0, 0, time.UTC),
+		To:           time.Date(2021, time.July, 5, 8, 0, 0, 0, time.UTC),
+		HomeCurrency: " eur ",
+		Participants: []string{" Alice ", "Bob", "Alice"},
+	}
+
+	// Act
+	res, resErr := domain.NewTrip("tripId", params)
+
+	// Assert
+	assert.Nil(t, resErr)
+	assert.Equal(t, "tripId", res.ID())
+	assert.Equal(t, "Paris 2021", res.Name())
+	assert.Equal(t, utcDate(2021, time.July, 1), res.From())
+	assert.Equal(t, utcDate(2021, time.July, 5), res.To())
+	assert.Equal(t, domain.Currency("EUR"), res.HomeCurrency())
+	assert.Equal(t, []string{"Alice", "Bob"}, res.Participants())
+	assert.Equal(t, 5, res.Days())
+	assert.Equal(t, "paris 2021", res.Key())
+}
+
+func TestNewTrip_InvalidParams_ThrowsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	valid := domain.TripParams{
+		Name:         "Paris 2021",
+		From:         utcDate(2021, time.July, 1),
+		To:           utcDate(2021, time.July, 5),
+		HomeCurrency: "EUR",
+	}
+	emptyName := valid
+	emptyName.Name = " "
+	emptyDates := valid
+	emptyDates.To = time.Time{}
+	reversedDates := valid
+	reversedDates.From = utcDate(2021, time.July, 6)
+	emptyCurrency := valid
+	emptyCurrency.HomeCurrency = ""
+	emptyParticipant := valid
+	emptyParticipant.Participants = []string{"Alice", " "}
+
+	for name, params := range map[string]domain.TripParams{
+		"empty name":        emptyName,
+		"empty dates":       emptyDates,
+		"reversed dates":    reversedDates,
+		"empty currency":    emptyCurrency,
+		"empty participant": emptyParticipant,
+	} {
+		// Act
+		res, resErr := domain.NewTrip("tripId", params)
+
+		// Assert
+		assert.Nil(t, res, name)
+		assert.NotNil(t, resErr, name)
+	}
+}
+
+func TestNewLegacyTripMigrations_SameKey_MergesNames(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	usages := []domain.LegacyTripUsage{
+		{Name: "paris 2021", Currency: "EUR", From: utcDate(2021, time.July, 2), To: utcDate(2021, time.July, 3), Count: 1},
+		{Name: "Paris 2021", Currency: "EUR", From: utcDate(2021, time.July, 1), To: utcDate(2021, time.July, 2), Count: 3},
+		{Name: "Paris 2021", Currency: "USD", From: utcDate(2021, time.July, 4), To: utcDate(2021, time.July, 5), Count: 1},
+		{Name: "Berlin", Currency: "EUR", From: utcDate(2021, time.May, 1), To: utcDate(2021, time.May, 1), Count: 1},
+		{Name: " ", Currency: "EUR", From: utcDate(2021, time.May, 1), To: utcDate(2021, time.May, 1), Count: 1},
+	}
+
+	// Act
+	res := domain.NewLegacyTripMigrations(usages)
+
+	// Assert
+	assert.Len(t, res, 2)
+	assert.Equal(t, "Berlin", res[0].Trip.Name())
+	assert.Equal(t, []string{"Berlin"}, res[0].Names)
+	assert.Equal(t, "Paris 2021", res[1].Trip.Name(), "Should use the most used spelling.")
+	assert.Equal(t, []string{"Paris 2021", "paris 2021"}, res[1].Names)
+	assert.Equal(t, domain.Currency("EUR"), res[1].Trip.HomeCurrency(), "Should use the most used currency.")
+	assert.Equal(t, utcDate(2021, time.July, 1), res[1].Trip.From())
+	assert.Equal(t, utcDate(2021, time.July, 5), res[1].Trip.To())
+}
@@ -1,15 +1,23 @@
 package ports
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"mime"
 	"net/http"
+	"time"
 
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
 
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/auth"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/server/httperr"
 	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/pkg/tracer"
 )
@@ -26,6 +34,105 @@ func NewHTTPServer(app *app.Application) HTTPServer {
 	}
 }
 
+// ListExpenses returns a page of expenses.
+func (h HTTPServer) ListExpenses(echoCtx echo.Context, params ListExpensesParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle list expenses http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling list expenses HTTP request")
+
+	filterParams := domain.ExpenseListFilterParams{
+		From:       params.From,
+		To:         params.To,
+		CategoryID: params.CategoryId,
+		Currency:   params.Currency,
+		TripID:     params.TripId,
+		Text:       params.Text,
+		Limit:      params.Limit,
+		Cursor:     params.Cursor,
+	}
+	if params.SortBy != nil {
+		sortBy := string(*params.SortBy)
+		filterParams.SortBy = &sortBy
+	}
+	if params.Order != nil {
+		order := string(*params.Order)
+		filterParams.Order = &order
+	}
+	filter, filterErr := domain.NewExpenseListFilter(filterParams)
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(filterErr.Error()))
+	}
+
+	queryArgs := query.ListExpensesQuery{
+		Filter: *filter,
+	}
+	page, pageErr := h.app.Queries.ListExpenses.Handle(ctx, queryArgs)
+	if pageErr != nil {
+		tracer.AddSpanError(span, pageErr)
+		h.app.Logger.Error(ctx, "Failed to list expenses", pageErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(pageErr))
+	}
+
+	response := expensePageToResponse(*page)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// Search returns expenses and categories matching the search text.
+func (h HTTPServer) Search(echoCtx echo.Context, params SearchParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle search http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling search HTTP request")
+
+	filter, filterErr := domain.NewSearchFilter(domain.SearchFilterParams{
+		Text:      params.Q,
+		From:      params.From,
+		To:        params.To,
+		MinAmount: params.MinAmount,
+		MaxAmount: params.MaxAmount,
+	})
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(filterErr.Error()))
+	}
+
+	result, searchErr := h.app.Queries.Search.Handle(ctx, query.SearchQuery{Filter: *filter})
+	if searchErr != nil {
+		tracer.AddSpanError(span, searchErr)
+		h.app.Logger.Error(ctx, "Failed to search", searchErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(searchErr))
+	}
+
+	response := searchResultToResponse(*result)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindExpenseByID returns an expense.
+func (h HTTPServer) FindExpenseByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle get expense http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Infof(ctx, "Handling get %s expense HTTP request", id)
+
+	queryArgs := query.FindExpenseQuery{
+		ExpenseID: id,
+	}
+	expense, expenseErr := h.app.Queries.FindExpense.Handle(ctx, queryArgs)
+	if expenseErr != nil {
+		tracer.AddSpanError(span, expenseErr)
+		h.app.Logger.Error(ctx, "Failed to find expense", expenseErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(expenseErr))
+	}
+
+	if expense == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find expense with ID %s", id)))
+	}
+
+	response := expenseWithCategoryToResponse(*expense)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
 // AddExpense adds a new expense.
 func (h HTTPServer) AddExpense(echoCtx echo.Context) error {
 	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add expense http request")
@@ -41,6 +148,33 @@ func (h HTTPServer) AddExpense(echoCtx echo.Context) error {
 			httperr.BadRequest("Invalid expense format"))
 	}
 
+	if tripErr := h.checkTrip(ctx, newExpense.TripId); tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		if errors.Is(tripErr, domain.ErrTripNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(tripErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to get trip", tripErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripErr))
+	}
+
+	if merchantErr := h.checkMerchant(ctx, newExpense.MerchantId); merchantErr != nil {
+		tracer.AddSpanError(span, merchantErr)
+		if errors.Is(merchantErr, domain.ErrMerchantNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(merchantErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to get merchant", merchantErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(merchantErr))
+	}
+
+	if accountErr := h.checkAccount(ctx, newExpense.AccountId); accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		if errors.Is(accountErr, domain.ErrAccountNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(accountErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to get account", accountErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(accountErr))
+	}
+
 	catQuery := query.FindCategoryQuery{
 		CategoryID: newExpense.CategoryId,
 	}
@@ -57,64 +191,3198 @@ func (h HTTPServer) AddExpense(echoCtx echo.Context) error {
 	}
 
 	cmdArgs := command.AddExpenseCommand{
-		Category: *category,
-		Price:    newExpense.Price,
-		Currency: newExpense.Currency,
-		Quantity: newExpense.Quantity,
-		Comment:  newExpense.Comment,
-		Trip:     newExpense.Trip,
-		Date:     newExpense.Date,
-	}
-	expenseID, expenseCrtErr := h.app.Commands.AddExpense.Handle(ctx, cmdArgs)
+		Category:     *category,
+		Price:        newExpense.Price,
+		Currency:     newExpense.Currency,
+		Quantity:     newExpense.Quantity,
+		Comment:      newExpense.Comment,
+		TripID:       newExpense.TripId,
+		MerchantID:   newExpense.MerchantId,
+		AccountID:    newExpense.AccountId,
+		Tags:         tagsFromRequest(newExpense.Tags),
+		Reimbursable: flagFromRequest(newExpense.Reimbursable),
+		PaidBy:       newExpense.PaidBy,
+		Split:        splitFromRequest(newExpense.Split),
+		Date:         newExpense.Date,
+	}
+	addResult, expenseCrtErr := h.app.Commands.AddExpense.Handle(ctx, cmdArgs)
 	if expenseCrtErr != nil {
 		tracer.AddSpanError(span, expenseCrtErr)
+		if errors.Is(expenseCrtErr, domain.ErrInvalidExpense) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(expenseCrtErr.Error()))
+		}
 		h.app.Logger.Error(ctx, "Failed to create expense", expenseCrtErr)
 		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(expenseCrtErr))
 	}
 
+	response := addExpenseResultToResponse(*addResult)
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// UpdateExpense updates an expense.
+func (h HTTPServer) UpdateExpense(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update expense http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update expense HTTP request")
+
+	var expense NewExpense
+	bindErr := echoCtx.Bind(&expense)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid expense format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid expense format"))
+	}
+
+	if tripErr := h.checkTrip(ctx, expense.TripId); tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		if errors.Is(tripErr, domain.ErrTripNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(tripErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to get trip", tripErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripErr))
+	}
+
+	if merchantErr := h.checkMerchant(ctx, expense.MerchantId); merchantErr != nil {
+		tracer.AddSpanError(span, merchantErr)
+		if errors.Is(merchantErr, domain.ErrMerchantNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(merchantErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to get merchant", merchantErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(merchantErr))
+	}
+
+	if accountErr := h.checkAccount(ctx, expense.AccountId); accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		if errors.Is(accountErr, domain.ErrAccountNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(accountErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to get account", accountErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(accountErr))
+	}
+
+	cmdArgs := command.UpdateExpenseCommand{
+		ID:           id,
+		CategoryID:   expense.CategoryId,
+		Price:        expense.Price,
+		Currency:     expense.Currency,
+		Quantity:     expense.Quantity,
+		Comment:      expense.Comment,
+		TripID:       expense.TripId,
+		MerchantID:   expense.MerchantId,
+		AccountID:    expense.AccountId,
+		Tags:         tagsFromRequest(expense.Tags),
+		Reimbursable: flagFromRequest(expense.Reimbursable),
+		PaidBy:       expense.PaidBy,
+		Split:        splitFromRequest(expense.Split),
+		Date:         expense.Date,
+		UpdatedBy:    auth.UserFromContext(echoCtx),
+	}
+	updated, updateErr := h.app.Commands.UpdateExpense.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrCategoryNotFound) || errors.Is(updateErr, domain.ErrInvalidExpense) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		if errors.Is(updateErr, domain.ErrExpenseLocked) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update expense", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	if updated == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find expense with ID %s", id)))
+	}
+
+	response := expenseWithCategoryToResponse(*updated)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// DeleteExpense moves an expense to the trash.
+func (h HTTPServer) DeleteExpense(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete expense http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete expense HTTP request")
+
+	cmdArgs := command.DeleteExpenseCommand{
+		ExpenseID: id,
+		DeletedBy: auth.UserFromContext(echoCtx),
+	}
+	deleteRes, deleteErr := h.app.Commands.DeleteExpense.Handle(ctx, cmdArgs)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		if errors.Is(deleteErr, domain.ErrExpenseLocked) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(deleteErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to delete expense", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find expense with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// FindAttachments returns attachments of an expense.
+func (h HTTPServer) FindAttachments(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find attachments http request")
+	span.SetAttributes(attribute.String("expenseId", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find attachments HTTP request")
+
+	attachments, attachmentsErr := h.app.Queries.FindAttachments.Handle(ctx, query.FindAttachmentsQuery{ExpenseID: id})
+	if attachmentsErr != nil {
+		tracer.AddSpanError(span, attachmentsErr)
+		h.app.Logger.Error(ctx, "Failed to find attachments", attachmentsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(attachmentsErr))
+	}
+
+	if attachments == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find expense with ID %s", id)))
+	}
+
+	response := attachmentsToResponse(attachments)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddAttachment attaches an uploaded file to an expense.
+func (h HTTPServer) AddAttachment(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add attachment http request")
+	span.SetAttributes(attribute.String("expenseId", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add attachment HTTP request")
+
+	return h.addAttachment(ctx, echoCtx, id)
+}
+
+// addAttachment attaches the uploaded file to the expense.
+func (h HTTPServer) addAttachment(ctx context.Context, echoCtx echo.Context, expenseID string) error {
+	span := tracer.SpanFromContext(ctx)
+
+	fileHeader, fileErr := echoCtx.FormFile("file")
+	if fileErr != nil {
+		tracer.AddSpanError(span, fileErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest("Attachment file is required"))
+	}
+	file, openErr := fileHeader.Open()
+	if openErr != nil {
+		tracer.AddSpanError(span, openErr)
+		h.app.Logger.Error(ctx, "Failed to open uploaded file", openErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(openErr))
+	}
+	defer file.Close()
+
+	cmdArgs := command.AddAttachmentCommand{
+		ExpenseID: expenseID,
+		FileName:  fileHeader.Filename,
+		Content:   file,
+		CreatedBy: auth.UserFromContext(echoCtx),
+	}
+	attachmentID, addErr := h.app.Commands.AddAttachment.Handle(ctx, cmdArgs)
+	if addErr != nil {
+		tracer.AddSpanError(span, addErr)
+		if errors.Is(addErr, domain.ErrExpenseNotFound) {
+			return echoCtx.JSON(http.StatusNotFound, httperr.NotFoundRequest(addErr))
+		}
+		if errors.Is(addErr, domain.ErrInvalidAttachment) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(addErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to add attachment", addErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(addErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *attachmentID,
+	}
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// DownloadAttachment returns an attached file.
+func (h HTTPServer) DownloadAttachment(echoCtx echo.Context, id string, attachmentID string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle download attachment http request")
+	span.SetAttributes(attribute.String("expenseId", id), attribute.String("id", attachmentID))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling download attachment HTTP request")
+
+	queryArgs := query.FindAttachmentContentQuery{
+		ExpenseID:    id,
+		AttachmentID: attachmentID,
+	}
+	return h.streamAttachment(ctx, echoCtx, queryArgs)
+}
+
+// DownloadAttachmentThumbnail returns a thumbnail of an attached image.
+func (h HTTPServer) DownloadAttachmentThumbnail(echoCtx echo.Context, id string, attachmentID string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle download attachment thumbnail http request")
+	span.SetAttributes(attribute.String("expenseId", id), attribute.String("id", attachmentID))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling download attachment thumbnail HTTP request")
+
+	queryArgs := query.FindAttachmentContentQuery{
+		ExpenseID:    id,
+		AttachmentID: attachmentID,
+		Thumbnail:    true,
+	}
+	return h.streamAttachment(ctx, echoCtx, queryArgs)
+}
+
+// streamAttachment writes attachment content into the response.
+func (h HTTPServer) streamAttachment(
+	ctx context.Context,
+	echoCtx echo.Context,
+	queryArgs query.FindAttachmentContentQuery,
+) error {
+	span := tracer.SpanFromContext(ctx)
+
+	content, contentErr := h.app.Queries.FindAttachment.Handle(ctx, queryArgs)
+	if contentErr != nil {
+		tracer.AddSpanError(span, contentErr)
+		h.app.Logger.Error(ctx, "Failed to find attachment", contentErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(contentErr))
+	}
+
+	if content == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find attachment with ID %s", queryArgs.AttachmentID)))
+	}
+	defer content.Content.Close()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{
+		"filename": content.Attachment.FileName(),
+	})
+	echoCtx.Response().Header().Set(echo.HeaderContentDisposition, disposition)
+	return echoCtx.Stream(http.StatusOK, content.ContentType, content.Content)
+}
+
+// DeleteAttachment deletes an attachment of an expense.
+func (h HTTPServer) DeleteAttachment(echoCtx echo.Context, id string, attachmentID string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete attachment http request")
+	span.SetAttributes(attribute.String("expenseId", id), attribute.String("id", attachmentID))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete attachment HTTP request")
+
+	cmdArgs := command.DeleteAttachmentCommand{
+		ExpenseID:    id,
+		AttachmentID: attachmentID,
+	}
+	deleteRes, deleteErr := h.app.Commands.DeleteAttachment.Handle(ctx, cmdArgs)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		h.app.Logger.Error(ctx, "Failed to delete attachment", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find attachment with ID %s", attachmentID)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// FindReceiptByID returns a receipt with its line items.
+func (h HTTPServer) FindReceiptByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find receipt http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find receipt HTTP request")
+
+	receipt, receiptErr := h.app.Queries.FindReceipt.Handle(ctx, query.FindReceiptQuery{ReceiptID: id})
+	if receiptErr != nil {
+		tracer.AddSpanError(span, receiptErr)
+		h.app.Logger.Error(ctx, "Failed to find receipt", receiptErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(receiptErr))
+	}
+
+	if receipt == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find receipt with ID %s", id)))
+	}
+
+	response := receiptToResponse(*receipt)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddReceipt adds a new itemized receipt.
+func (h HTTPServer) AddReceipt(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add receipt http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add receipt HTTP request")
+
+	var newReceipt NewReceipt
+	bindErr := echoCtx.Bind(&newReceipt)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid receipt format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid receipt format"))
+	}
+
+	items := make([]command.AddReceiptItem, 0, len(newReceipt.Items))
+	for _, newItem := range newReceipt.Items {
+		category, categoryErr := h.app.Queries.FindCategory.Handle(ctx,
+			query.FindCategoryQuery{CategoryID: newItem.CategoryId})
+		if categoryErr != nil {
+			tracer.AddSpanError(span, categoryErr)
+			h.app.Logger.Error(ctx, "Failed to get category", categoryErr)
+			return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(categoryErr))
+		}
+		if category == nil {
+			return echoCtx.JSON(http.StatusBadRequest,
+				httperr.BadRequest(fmt.Sprintf("Invalid provided category with ID %s", newItem.CategoryId)))
+		}
+		items = append(items, command.AddReceiptItem{
+			Category: *category,
+			Price:    newItem.Price,
+			Quantity: newItem.Quantity,
+			Comment:  newItem.Comment,
+		})
+	}
+
+	cmdArgs := command.AddReceiptCommand{
+		Date:      newReceipt.Date,
+		Comment:   newReceipt.Comment,
+		Currency:  newReceipt.Currency,
+		Items:     items,
+		CreatedBy: auth.UserFromContext(echoCtx),
+	}
+	receiptID, receiptCrtErr := h.app.Commands.AddReceipt.Handle(ctx, cmdArgs)
+	if receiptCrtErr != nil {
+		tracer.AddSpanError(span, receiptCrtErr)
+		if errors.Is(receiptCrtErr, domain.ErrInvalidReceipt) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(receiptCrtErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create receipt", receiptCrtErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(receiptCrtErr))
+	}
+
 	response := NewExpenseResponse{
-		Id: *expenseID,
+		Id: *receiptID,
 	}
 
 	return echoCtx.JSON(http.StatusCreated, response)
 }
 
-// GenerateReport generates a new expense report.
-func (h HTTPServer) GenerateReport(echoCtx echo.Context, params GenerateReportParams) error {
-	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle generate report http request")
+// AddReceiptAttachment attaches an uploaded file to a receipt, the file is kept along with the first line item.
+func (h HTTPServer) AddReceiptAttachment(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add receipt attachment http request")
+	span.SetAttributes(attribute.String("id", id))
 	defer span.End()
-	h.app.Logger.Info(ctx, "Handling generate report HTTP request")
+	h.app.Logger.Info(ctx, "Handling add receipt attachment HTTP request")
 
-	dateRange, dateRangeErr := domain.NewDateRange(params.From, params.To)
-	if dateRangeErr != nil {
-		tracer.AddSpanError(span, dateRangeErr)
+	receipt, receiptErr := h.app.Queries.FindReceipt.Handle(ctx, query.FindReceiptQuery{ReceiptID: id})
+	if receiptErr != nil {
+		tracer.AddSpanError(span, receiptErr)
+		h.app.Logger.Error(ctx, "Failed to find receipt", receiptErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(receiptErr))
+	}
+
+	if receipt == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find receipt with ID %s", id)))
+	}
+
+	return h.addAttachment(ctx, echoCtx, receipt.Items()[0].ID())
+}
+
+// FindTrashItems returns trashed expenses and categories.
+func (h HTTPServer) FindTrashItems(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find trash items http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find trash items HTTP request")
+
+	items, itemsErr := h.app.Queries.FindTrashItems.Handle(ctx, query.FindTrashItemsQuery{})
+	if itemsErr != nil {
+		tracer.AddSpanError(span, itemsErr)
+		h.app.Logger.Error(ctx, "Failed to find trash items", itemsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(itemsErr))
+	}
+
+	response := trashItemsToResponse(items)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// RestoreTrashItem restores a trashed expense or category subtree.
+func (h HTTPServer) RestoreTrashItem(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle restore trash item http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling restore trash item HTTP request")
+
+	cmdArgs := command.RestoreTrashItemCommand{
+		ItemID: id,
+	}
+	item, itemErr := h.app.Commands.RestoreTrashItem.Handle(ctx, cmdArgs)
+	if itemErr != nil {
+		tracer.AddSpanError(span, itemErr)
+		if errors.Is(itemErr, domain.ErrParentCategoryUnavailable) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(itemErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to restore trash item", itemErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(itemErr))
+	}
+
+	if item == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find trash item with ID %s", id)))
+	}
+
+	response := trashItemToResponse(*item)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// ImportExpensesCsv imports expenses from a CSV file.
+func (h HTTPServer) ImportExpensesCsv(echoCtx echo.Context, params ImportExpensesCsvParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle import expenses csv http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling import expenses csv HTTP request")
+
+	mode, modeErr := importMode(params.Mode)
+	if modeErr != nil {
+		tracer.AddSpanError(span, modeErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(modeErr.Error()))
+	}
+
+	fileHeader, fileErr := echoCtx.FormFile("file")
+	if fileErr != nil {
+		tracer.AddSpanError(span, fileErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest("CSV file is required"))
+	}
+	file, openErr := fileHeader.Open()
+	if openErr != nil {
+		tracer.AddSpanError(span, openErr)
+		h.app.Logger.Error(ctx, "Failed to open uploaded file", openErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(openErr))
+	}
+	defer file.Close()
+
+	cmdArgs := command.ImportExpensesCsvCommand{
+		File:      file,
+		Mode:      mode,
+		CreatedBy: auth.UserFromContext(echoCtx),
+	}
+	if profileID := echoCtx.FormValue("profileId"); len(profileID) != 0 {
+		cmdArgs.ProfileID = &profileID
+	} else if inlineProfile := echoCtx.FormValue("profile"); len(inlineProfile) != 0 {
+		var newProfile NewImportProfile
+		if unmarshalErr := json.Unmarshal([]byte(inlineProfile), &newProfile); unmarshalErr != nil {
+			tracer.AddSpanError(span, unmarshalErr)
+			return echoCtx.JSON(http.StatusBadRequest,
+				httperr.BadRequest("Invalid import profile format"))
+		}
+		profileParams := importProfileParamsFromRequest(newProfile)
+		cmdArgs.Profile = &profileParams
+	}
+
+	report, reportErr := h.app.Commands.ImportExpensesCsv.Handle(ctx, cmdArgs)
+	if reportErr != nil {
+		tracer.AddSpanError(span, reportErr)
+		if errors.Is(reportErr, domain.ErrInvalidImport) || errors.Is(reportErr, domain.ErrImportProfileNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(reportErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to import expenses", reportErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(reportErr))
+	}
+
+	response := importReportToResponse(*report)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// ImportOfx imports expenses from an OFX bank statement.
+func (h HTTPServer) ImportOfx(echoCtx echo.Context, params ImportOfxParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle import ofx http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling import ofx HTTP request")
+
+	mode, modeErr := importMode(params.Mode)
+	if modeErr != nil {
+		tracer.AddSpanError(span, modeErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(modeErr.Error()))
+	}
+
+	cmdArgs := command.ImportStatementCommand{
+		Format:          domain.StatementFormatOFX,
+		Mode:            mode,
+		DefaultCurrency: params.DefaultCurrency,
+		CreatedBy:       auth.UserFromContext(echoCtx),
+	}
+	return h.importStatement(ctx, echoCtx, cmdArgs)
+}
+
+// ImportQif imports expenses from a QIF bank statement.
+func (h HTTPServer) ImportQif(echoCtx echo.Context, params ImportQifParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle import qif http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling import qif HTTP request")
+
+	mode, modeErr := importMode(params.Mode)
+	if modeErr != nil {
+		tracer.AddSpanError(span, modeErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(modeErr.Error()))
+	}
+
+	cmdArgs := command.ImportStatementCommand{
+		Format:          domain.StatementFormatQIF,
+		Mode:            mode,
+		DefaultCurrency: params.DefaultCurrency,
+		DateFormat:      params.DateFormat,
+		CreatedBy:       auth.UserFromContext(echoCtx),
+	}
+	return h.importStatement(ctx, echoCtx, cmdArgs)
+}
+
+// importStatement imports an uploaded bank statement file.
+func (h HTTPServer) importStatement(
+	ctx context.Context,
+	echoCtx echo.Context,
+	cmdArgs command.ImportStatementCommand,
+) error {
+	span := tracer.SpanFromContext(ctx)
+
+	fileHeader, fileErr := echoCtx.FormFile("file")
+	if fileErr != nil {
+		tracer.AddSpanError(span, fileErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest("Statement file is required"))
+	}
+	file, openErr := fileHeader.Open()
+	if openErr != nil {
+		tracer.AddSpanError(span, openErr)
+		h.app.Logger.Error(ctx, "Failed to open uploaded file", openErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(openErr))
+	}
+	defer file.Close()
+	cmdArgs.File = file
+
+	report, reportErr := h.app.Commands.ImportStatement.Handle(ctx, cmdArgs)
+	if reportErr != nil {
+		tracer.AddSpanError(span, reportErr)
+		if errors.Is(reportErr, domain.ErrInvalidImport) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(reportErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to import statement", reportErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(reportErr))
+	}
+
+	response := importReportToResponse(*report)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// ListInboxExpenses returns a page of imported expenses waiting for a category.
+func (h HTTPServer) ListInboxExpenses(echoCtx echo.Context, params ListInboxExpensesParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle list inbox expenses http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling list inbox expenses HTTP request")
+
+	filter, filterErr := domain.NewExpenseListFilter(domain.ExpenseListFilterParams{
+		Limit:  params.Limit,
+		Cursor: params.Cursor,
+	})
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(filterErr.Error()))
+	}
+
+	page, pageErr := h.app.Queries.FindInboxExpenses.Handle(ctx, query.FindInboxExpensesQuery{Filter: *filter})
+	if pageErr != nil {
+		tracer.AddSpanError(span, pageErr)
+		h.app.Logger.Error(ctx, "Failed to list inbox expenses", pageErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(pageErr))
+	}
+
+	response := expensePageToResponse(*page)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AssignInboxCategories moves inbox expenses to the given categories.
+func (h HTTPServer) AssignInboxCategories(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle assign inbox categories http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling assign inbox categories HTTP request")
+
+	var assignments []CategoryAssignment
+	bindErr := echoCtx.Bind(&assignments)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid category assignments format", bindErr)
 		return echoCtx.JSON(http.StatusBadRequest,
-			httperr.BadRequest("Date range has invalid format"))
+			httperr.BadRequest("Invalid category assignments format"))
 	}
 
-	fetchCmdArgs := command.FetchExchangeRatesCommand{
-		DateRange: *dateRange,
+	cmdArgs := command.AssignInboxCategoriesCommand{
+		Assignments: make([]domain.CategoryAssignment, 0, len(assignments)),
+		UpdatedBy:   auth.UserFromContext(echoCtx),
 	}
-	rates, ratesErr := h.app.Commands.FetchExchangeRates.Handle(ctx, fetchCmdArgs)
-	if ratesErr != nil {
-		tracer.AddSpanError(span, ratesErr)
-		h.app.Logger.Error(ctx, "Failed to fetch exchange rates", ratesErr)
-		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(ratesErr))
+	for _, assignment := range assignments {
+		cmdArgs.Assignments = append(cmdArgs.Assignments, domain.CategoryAssignment{
+			ExpenseID:  assignment.ExpenseId,
+			CategoryID: assignment.CategoryId,
+		})
+	}
+	result, assignErr := h.app.Commands.AssignInbox.Handle(ctx, cmdArgs)
+	if assignErr != nil {
+		tracer.AddSpanError(span, assignErr)
+		if errors.Is(assignErr, domain.ErrCategoryNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(assignErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to assign inbox categories", assignErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(assignErr))
 	}
 
-	queryArgs := query.FindExpensesQuery{
-		DateRange:     *dateRange,
-		Interval:      string(params.Interval),
-		ExchangeRates: rates,
+	response := CategoryAssignmentResult{
+		Updated: result.UpdateCount,
 	}
+	return echoCtx.JSON(http.StatusOK, response)
+}
 
-	expenseRpt, expenseRptErr := h.app.Queries.FindExpenses.Handle(ctx, queryArgs)
-	if expenseRptErr != nil {
-		tracer.AddSpanError(span, expenseRptErr)
-		h.app.Logger.Error(ctx, "Failed to create expense report", expenseRptErr)
-		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(expenseRptErr))
+// FindImportProfiles returns saved import profiles.
+func (h HTTPServer) FindImportProfiles(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find import profiles http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find import profiles HTTP request")
+
+	profiles, profilesErr := h.app.Queries.FindImportProfiles.Handle(ctx, query.FindImportProfilesQuery{})
+	if profilesErr != nil {
+		tracer.AddSpanError(span, profilesErr)
+		h.app.Logger.Error(ctx, "Failed to find import profiles", profilesErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(profilesErr))
 	}
 
-	response := reportToResponse(*expenseRpt)
+	response := importProfilesToResponse(profiles)
 	return echoCtx.JSON(http.StatusOK, response)
 }
+
+// AddImportProfile saves a new import profile.
+func (h HTTPServer) AddImportProfile(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add import profile http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add import profile HTTP request")
+
+	var newProfile NewImportProfile
+	bindErr := echoCtx.Bind(&newProfile)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid import profile format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid import profile format"))
+	}
+
+	cmdArgs := command.AddImportProfileCommand{
+		Profile: importProfileParamsFromRequest(newProfile),
+	}
+	profileID, profileErr := h.app.Commands.AddImportProfile.Handle(ctx, cmdArgs)
+	if profileErr != nil {
+		tracer.AddSpanError(span, profileErr)
+		if errors.Is(profileErr, domain.ErrInvalidImport) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(profileErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to add import profile", profileErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(profileErr))
+	}
+
+	response := ImportProfile{
+		Id:               *profileID,
+		NewImportProfile: newProfile,
+	}
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// ExportExpenses streams expenses matching the filter as a file.
+func (h HTTPServer) ExportExpenses(echoCtx echo.Context, params ExportExpensesParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle export expenses http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling export expenses HTTP request")
+
+	format, formatErr := domain.ParseExportFormat(string(params.Format))
+	if formatErr != nil {
+		tracer.AddSpanError(span, formatErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(formatErr.Error()))
+	}
+
+	pageSize := domain.MaxPageSize
+	filterParams := domain.ExpenseListFilterParams{
+		From:       params.From,
+		To:         params.To,
+		CategoryID: params.CategoryId,
+		Currency:   params.Currency,
+		TripID:     params.TripId,
+		Text:       params.Text,
+		Limit:      &pageSize,
+	}
+	if params.SortBy != nil {
+		sortBy := string(*params.SortBy)
+		filterParams.SortBy = &sortBy
+	}
+	if params.Order != nil {
+		order := string(*params.Order)
+		filterParams.Order = &order
+	}
+	filter, filterErr := domain.NewExpenseListFilter(filterParams)
+	if filterErr != nil {
+		tracer.AddSpanError(span, filterErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(filterErr.Error()))
+	}
+
+	settings, settingsErr := h.userSettings(ctx, echoCtx)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		h.app.Logger.Error(ctx, "Failed to find settings", settingsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settingsErr))
+	}
+
+	response := echoCtx.Response()
+	response.Header().Set(echo.HeaderContentType, exportContentType(format))
+	response.Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=\"expenses.%s\"", format))
+
+	queryArgs := query.ExportExpensesQuery{
+		Filter:   *filter,
+		Format:   format,
+		Output:   response,
+		Currency: reportCurrencyFromRequest(params.ReportCurrency, *settings),
+	}
+	exported, exportErr := h.app.Queries.ExportExpenses.Handle(ctx, queryArgs)
+	if exportErr != nil {
+		tracer.AddSpanError(span, exportErr)
+		h.app.Logger.Error(ctx, "Failed to export expenses", exportErr)
+		// Once streaming has started the status could not be changed anymore.
+		if response.Committed {
+			return nil
+		}
+		response.Header().Del(echo.HeaderContentDisposition)
+		if errors.Is(exportErr, domain.ErrInvalidReportCurrency) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(exportErr.Error()))
+		}
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(exportErr))
+	}
+
+	span.SetAttributes(attribute.Int("exported", exported))
+	if !response.Committed {
+		response.WriteHeader(http.StatusOK)
+	}
+	return nil
+}
+
+// FindRecurringExpenses returns all recurring expenses.
+func (h HTTPServer) FindRecurringExpenses(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find recurring expenses http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find recurring expenses HTTP request")
+
+	recurringExpenses, recurringErr := h.app.Queries.FindRecurring.Handle(ctx, query.FindRecurringExpensesQuery{})
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		h.app.Logger.Error(ctx, "Failed to find recurring expenses", recurringErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(recurringErr))
+	}
+
+	response := recurringExpensesToResponse(recurringExpenses)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindRecurringExpenseByID returns a recurring expense.
+func (h HTTPServer) FindRecurringExpenseByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle get recurring expense http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Infof(ctx, "Handling get %s recurring expense HTTP request", id)
+
+	queryArgs := query.FindRecurringExpenseQuery{
+		ID: id,
+	}
+	recurringExpense, recurringErr := h.app.Queries.FindRecurringByID.Handle(ctx, queryArgs)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		h.app.Logger.Error(ctx, "Failed to find recurring expense", recurringErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(recurringErr))
+	}
+
+	if recurringExpense == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find recurring expense with ID %s", id)))
+	}
+
+	response := recurringExpenseToResponse(*recurringExpense)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddRecurringExpense adds a new recurring expense.
+func (h HTTPServer) AddRecurringExpense(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add recurring expense http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add recurring expense HTTP request")
+
+	var newRecurringExpense NewRecurringExpense
+	bindErr := echoCtx.Bind(&newRecurringExpense)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid recurring expense format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid recurring expense format"))
+	}
+
+	schedule, scheduleErr := scheduleFromRequest(newRecurringExpense.Schedule)
+	if scheduleErr != nil {
+		tracer.AddSpanError(span, scheduleErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(scheduleErr.Error()))
+	}
+
+	if tripErr := h.checkTrip(ctx, newRecurringExpense.TripId); tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		if errors.Is(tripErr, domain.ErrTripNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(tripErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to get trip", tripErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripErr))
+	}
+
+	cmdArgs := command.AddRecurringExpenseCommand{
+		CategoryID: newRecurringExpense.CategoryId,
+		Price:      newRecurringExpense.Price,
+		Quantity:   newRecurringExpense.Quantity,
+		Currency:   newRecurringExpense.Currency,
+		Comment:    newRecurringExpense.Comment,
+		TripID:     newRecurringExpense.TripId,
+		Schedule:   *schedule,
+	}
+	recurringID, recurringErr := h.app.Commands.AddRecurring.Handle(ctx, cmdArgs)
+	if recurringErr != nil {
+		tracer.AddSpanError(span, recurringErr)
+		if errors.Is(recurringErr, domain.ErrCategoryNotFound) || errors.Is(recurringErr, domain.ErrInvalidExpense) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(recurringErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create recurring expense", recurringErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(recurringErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *recurringID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// UpdateRecurringExpense updates a recurring expense.
+func (h HTTPServer) UpdateRecurringExpense(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update recurring expense http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update recurring expense HTTP request")
+
+	var recurringExpense NewRecurringExpense
+	bindErr := echoCtx.Bind(&recurringExpense)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid recurring expense format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid recurring expense format"))
+	}
+
+	schedule, scheduleErr := scheduleFromRequest(recurringExpense.Schedule)
+	if scheduleErr != nil {
+		tracer.AddSpanError(span, scheduleErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(scheduleErr.Error()))
+	}
+
+	if tripErr := h.checkTrip(ctx, recurringExpense.TripId); tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		if errors.Is(tripErr, domain.ErrTripNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(tripErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to get trip", tripErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripErr))
+	}
+
+	cmdArgs := command.UpdateRecurringExpenseCommand{
+		ID:         id,
+		CategoryID: recurringExpense.CategoryId,
+		Price:      recurringExpense.Price,
+		Quantity:   recurringExpense.Quantity,
+		Currency:   recurringExpense.Currency,
+		Comment:    recurringExpense.Comment,
+		TripID:     recurringExpense.TripId,
+		Schedule:   *schedule,
+	}
+	updated, updateErr := h.app.Commands.UpdateRecurring.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrCategoryNotFound) || errors.Is(updateErr, domain.ErrInvalidExpense) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update recurring expense", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	if updated == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find recurring expense with ID %s", id)))
+	}
+
+	response := recurringExpenseToResponse(*updated)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// DeleteRecurringExpense stops a recurring expense keeping its materialized expenses.
+func (h HTTPServer) DeleteRecurringExpense(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete recurring expense http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete recurring expense HTTP request")
+
+	cmdArgs := command.DeleteRecurringExpenseCommand{
+		ID: id,
+	}
+	deleteRes, deleteErr := h.app.Commands.DeleteRecurring.Handle(ctx, cmdArgs)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		h.app.Logger.Error(ctx, "Failed to delete recurring expense", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find recurring expense with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// PreviewOccurrences returns upcoming occurrences of a recurring expense.
+func (h HTTPServer) PreviewOccurrences(echoCtx echo.Context, id string, params PreviewOccurrencesParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle preview occurrences http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling preview occurrences HTTP request")
+
+	limit := domain.DefaultPreviewSize
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > domain.MaxPreviewSize {
+			return echoCtx.JSON(http.StatusBadRequest,
+				httperr.BadRequest(fmt.Sprintf("limit should be between 1 and %d", domain.MaxPreviewSize)))
+		}
+		limit = *params.Limit
+	}
+
+	queryArgs := query.PreviewOccurrencesQuery{
+		RecurringExpenseID: id,
+		From:               time.Now(),
+		Limit:              limit,
+	}
+	if params.From != nil {
+		queryArgs.From = *params.From
+	}
+	occurrences, occurrencesErr := h.app.Queries.PreviewOccurrences.Handle(ctx, queryArgs)
+	if occurrencesErr != nil {
+		tracer.AddSpanError(span, occurrencesErr)
+		if errors.Is(occurrencesErr, domain.ErrRecurringExpenseNotFound) {
+			return echoCtx.JSON(http.StatusNotFound, httperr.NotFoundRequest(occurrencesErr))
+		}
+		h.app.Logger.Error(ctx, "Failed to preview occurrences", occurrencesErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(occurrencesErr))
+	}
+
+	response := occurrencesToResponse(occurrences)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// UpdateOccurrence skips or edits a single occurrence of a recurring expense.
+func (h HTTPServer) UpdateOccurrence(echoCtx echo.Context, id string, date openapi_types.Date) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update occurrence http request")
+	span.SetAttributes(attribute.String("id", id), attribute.String("date", date.String()))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update occurrence HTTP request")
+
+	var occurrence OccurrenceException
+	bindErr := echoCtx.Bind(&occurrence)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid occurrence format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid occurrence format"))
+	}
+
+	exceptionParams := domain.OccurrenceExceptionParams{
+		Date:     date.Time,
+		Price:    occurrence.Price,
+		Quantity: occurrence.Quantity,
+		Comment:  occurrence.Comment,
+	}
+	if occurrence.Skipped != nil {
+		exceptionParams.Skipped = *occurrence.Skipped
+	}
+	exception, exceptionErr := domain.NewOccurrenceException(exceptionParams)
+	if exceptionErr != nil {
+		tracer.AddSpanError(span, exceptionErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(exceptionErr.Error()))
+	}
+
+	cmdArgs := command.UpdateOccurrenceCommand{
+		RecurringExpenseID: id,
+		Date:               date.Time,
+		Exception:          exception,
+	}
+	return h.updateOccurrence(ctx, echoCtx, cmdArgs)
+}
+
+// RevertOccurrence reverts a single occurrence of a recurring expense to the series values.
+func (h HTTPServer) RevertOccurrence(echoCtx echo.Context, id string, date openapi_types.Date) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle revert occurrence http request")
+	span.SetAttributes(attribute.String("id", id), attribute.String("date", date.String()))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling revert occurrence HTTP request")
+
+	cmdArgs := command.UpdateOccurrenceCommand{
+		RecurringExpenseID: id,
+		Date:               date.Time,
+	}
+	return h.updateOccurrence(ctx, echoCtx, cmdArgs)
+}
+
+// updateOccurrence applies an occurrence change to a recurring expense.
+func (h HTTPServer) updateOccurrence(
+	ctx context.Context,
+	echoCtx echo.Context,
+	cmdArgs command.UpdateOccurrenceCommand,
+) error {
+	span := tracer.SpanFromContext(ctx)
+
+	recurringExpense, updateErr := h.app.Commands.UpdateOccurrence.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		switch {
+		case errors.Is(updateErr, domain.ErrRecurringExpenseNotFound),
+			errors.Is(updateErr, domain.ErrOccurrenceNotFound):
+			return echoCtx.JSON(http.StatusNotFound, httperr.NotFoundRequest(updateErr))
+		case errors.Is(updateErr, domain.ErrOccurrenceMaterialized):
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update occurrence", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	response := recurringExpenseToResponse(*recurringExpense)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindBudgets returns all budgets.
+func (h HTTPServer) FindBudgets(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find budgets http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find budgets HTTP request")
+
+	budgets, budgetsErr := h.app.Queries.FindBudgets.Handle(ctx, query.FindBudgetsQuery{})
+	if budgetsErr != nil {
+		tracer.AddSpanError(span, budgetsErr)
+		h.app.Logger.Error(ctx, "Failed to find budgets", budgetsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(budgetsErr))
+	}
+
+	response := budgetsToResponse(budgets)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddBudget adds a new category budget.
+func (h HTTPServer) AddBudget(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add budget http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add budget HTTP request")
+
+	var newBudget NewBudget
+	bindErr := echoCtx.Bind(&newBudget)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid budget format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid budget format"))
+	}
+
+	cmdArgs := command.AddBudgetCommand{
+		CategoryID: newBudget.CategoryId,
+		Period:     string(newBudget.Period),
+		Amount:     newBudget.Amount,
+		Currency:   newBudget.Currency,
+		Rollover:   newBudget.Rollover,
+		Start:      newBudget.Start,
+	}
+	budgetID, budgetErr := h.app.Commands.AddBudget.Handle(ctx, cmdArgs)
+	if budgetErr != nil {
+		tracer.AddSpanError(span, budgetErr)
+		switch {
+		case errors.Is(budgetErr, domain.ErrCategoryNotFound), errors.Is(budgetErr, domain.ErrInvalidBudget):
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(budgetErr.Error()))
+		case errors.Is(budgetErr, domain.ErrBudgetExists):
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(budgetErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create budget", budgetErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(budgetErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *budgetID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// UpdateBudget updates a budget.
+func (h HTTPServer) UpdateBudget(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update budget http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update budget HTTP request")
+
+	var budget NewBudget
+	bindErr := echoCtx.Bind(&budget)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid budget format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid budget format"))
+	}
+
+	cmdArgs := command.UpdateBudgetCommand{
+		ID:       id,
+		Period:   string(budget.Period),
+		Amount:   budget.Amount,
+		Currency: budget.Currency,
+		Rollover: budget.Rollover,
+		Start:    budget.Start,
+	}
+	updated, updateErr := h.app.Commands.UpdateBudget.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrInvalidBudget) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update budget", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	if updated == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find budget with ID %s", id)))
+	}
+
+	response := budgetToResponse(*updated)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// DeleteBudget deletes a budget.
+func (h HTTPServer) DeleteBudget(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete budget http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete budget HTTP request")
+
+	cmdArgs := command.DeleteBudgetCommand{
+		ID: id,
+	}
+	deleteRes, deleteErr := h.app.Commands.DeleteBudget.Handle(ctx, cmdArgs)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		h.app.Logger.Error(ctx, "Failed to delete budget", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find budget with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// FindBudgetStatus returns budget figures of the budget periods containing the day.
+func (h HTTPServer) FindBudgetStatus(echoCtx echo.Context, params FindBudgetStatusParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find budget status http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find budget status HTTP request")
+
+	date := time.Now().UTC()
+	if params.Period != nil {
+		date = params.Period.Time
+	}
+
+	settings, settingsErr := h.userSettings(ctx, echoCtx)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		h.app.Logger.Error(ctx, "Failed to find settings", settingsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settingsErr))
+	}
+
+	queryArgs := query.FindBudgetStatusQuery{
+		Dates:          []time.Time{date},
+		PeriodStartDay: settings.PeriodStartDay(),
+		Currency:       reportCurrencyFromRequest(params.Currency, *settings),
+	}
+	statuses, statusesErr := h.app.Queries.FindBudgetStatus.Handle(ctx, queryArgs)
+	if statusesErr != nil {
+		tracer.AddSpanError(span, statusesErr)
+		if errors.Is(statusesErr, domain.ErrInvalidReportCurrency) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(statusesErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to find budget status", statusesErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(statusesErr))
+	}
+
+	response := budgetStatusesToResponse(statuses)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindTrips returns all trips.
+func (h HTTPServer) FindTrips(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find trips http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find trips HTTP request")
+
+	trips, tripsErr := h.app.Queries.FindTrips.Handle(ctx, query.FindTripsQuery{})
+	if tripsErr != nil {
+		tracer.AddSpanError(span, tripsErr)
+		h.app.Logger.Error(ctx, "Failed to find trips", tripsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripsErr))
+	}
+
+	response := tripsToResponse(trips)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindTripByID returns a trip by id.
+func (h HTTPServer) FindTripByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find trip http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find trip HTTP request")
+
+	trip, tripErr := h.app.Queries.FindTrip.Handle(ctx, query.FindTripQuery{ID: id})
+	if tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		h.app.Logger.Error(ctx, "Failed to find trip", tripErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripErr))
+	}
+
+	if trip == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find trip with ID %s", id)))
+	}
+
+	response := tripToResponse(*trip)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddTrip adds a new trip.
+func (h HTTPServer) AddTrip(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add trip http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add trip HTTP request")
+
+	var newTrip NewTrip
+	bindErr := echoCtx.Bind(&newTrip)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid trip format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid trip format"))
+	}
+
+	cmdArgs := command.AddTripCommand{
+		Name:         newTrip.Name,
+		From:         newTrip.From,
+		To:           newTrip.To,
+		HomeCurrency: newTrip.HomeCurrency,
+		Participants: participantsFromRequest(newTrip.Participants),
+	}
+	tripID, tripErr := h.app.Commands.AddTrip.Handle(ctx, cmdArgs)
+	if tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		if errors.Is(tripErr, domain.ErrInvalidTrip) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(tripErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create trip", tripErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *tripID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// UpdateTrip updates a trip.
+func (h HTTPServer) UpdateTrip(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update trip http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update trip HTTP request")
+
+	var trip NewTrip
+	bindErr := echoCtx.Bind(&trip)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid trip format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid trip format"))
+	}
+
+	cmdArgs := command.UpdateTripCommand{
+		ID:           id,
+		Name:         trip.Name,
+		From:         trip.From,
+		To:           trip.To,
+		HomeCurrency: trip.HomeCurrency,
+		Participants: participantsFromRequest(trip.Participants),
+	}
+	updated, updateErr := h.app.Commands.UpdateTrip.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrInvalidTrip) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update trip", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	if updated == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find trip with ID %s", id)))
+	}
+
+	response := tripToResponse(*updated)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// DeleteTrip deletes a trip keeping its expenses.
+func (h HTTPServer) DeleteTrip(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete trip http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete trip HTTP request")
+
+	cmdArgs := command.DeleteTripCommand{
+		ID: id,
+	}
+	deleteRes, deleteErr := h.app.Commands.DeleteTrip.Handle(ctx, cmdArgs)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		if errors.Is(deleteErr, domain.ErrExpenseLocked) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(deleteErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to delete trip", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find trip with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// FindTripReport generates a spending report of the trip.
+func (h HTTPServer) FindTripReport(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find trip report http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find trip report HTTP request")
+
+	report, reportErr := h.app.Queries.FindTripReport.Handle(ctx, query.FindTripReportQuery{ID: id})
+	if reportErr != nil {
+		tracer.AddSpanError(span, reportErr)
+		h.app.Logger.Error(ctx, "Failed to create trip report", reportErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(reportErr))
+	}
+
+	if report == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find trip with ID %s", id)))
+	}
+
+	response := tripReportToResponse(*report)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindMerchants returns all merchants.
+func (h HTTPServer) FindMerchants(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find merchants http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find merchants HTTP request")
+
+	merchants, merchantsErr := h.app.Queries.FindMerchants.Handle(ctx, query.FindMerchantsQuery{})
+	if merchantsErr != nil {
+		tracer.AddSpanError(span, merchantsErr)
+		h.app.Logger.Error(ctx, "Failed to find merchants", merchantsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(merchantsErr))
+	}
+
+	response := merchantsToResponse(merchants)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindMerchantByID returns a merchant by id.
+func (h HTTPServer) FindMerchantByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find merchant http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find merchant HTTP request")
+
+	merchant, merchantErr := h.app.Queries.FindMerchant.Handle(ctx, query.FindMerchantQuery{ID: id})
+	if merchantErr != nil {
+		tracer.AddSpanError(span, merchantErr)
+		h.app.Logger.Error(ctx, "Failed to find merchant", merchantErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(merchantErr))
+	}
+
+	if merchant == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find merchant with ID %s", id)))
+	}
+
+	response := merchantToResponse(*merchant)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddMerchant adds a new merchant.
+func (h HTTPServer) AddMerchant(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add merchant http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add merchant HTTP request")
+
+	var newMerchant NewMerchant
+	bindErr := echoCtx.Bind(&newMerchant)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid merchant format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid merchant format"))
+	}
+
+	cmdArgs := command.AddMerchantCommand{
+		Name:              newMerchant.Name,
+		Aliases:           aliasesFromRequest(newMerchant.Aliases),
+		DefaultCategoryID: newMerchant.DefaultCategoryId,
+		Location:          newMerchant.Location,
+	}
+	merchantID, merchantErr := h.app.Commands.AddMerchant.Handle(ctx, cmdArgs)
+	if merchantErr != nil {
+		tracer.AddSpanError(span, merchantErr)
+		if errors.Is(merchantErr, domain.ErrInvalidMerchant) || errors.Is(merchantErr, domain.ErrCategoryNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(merchantErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create merchant", merchantErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(merchantErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *merchantID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// UpdateMerchant updates a merchant.
+func (h HTTPServer) UpdateMerchant(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update merchant http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update merchant HTTP request")
+
+	var merchant NewMerchant
+	bindErr := echoCtx.Bind(&merchant)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid merchant format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid merchant format"))
+	}
+
+	cmdArgs := command.UpdateMerchantCommand{
+		ID:                id,
+		Name:              merchant.Name,
+		Aliases:           aliasesFromRequest(merchant.Aliases),
+		DefaultCategoryID: merchant.DefaultCategoryId,
+		Location:          merchant.Location,
+	}
+	updated, updateErr := h.app.Commands.UpdateMerchant.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrInvalidMerchant) || errors.Is(updateErr, domain.ErrCategoryNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update merchant", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	if updated == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find merchant with ID %s", id)))
+	}
+
+	response := merchantToResponse(*updated)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// DeleteMerchant deletes a merchant keeping its expenses.
+func (h HTTPServer) DeleteMerchant(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete merchant http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete merchant HTTP request")
+
+	cmdArgs := command.DeleteMerchantCommand{
+		ID: id,
+	}
+	deleteRes, deleteErr := h.app.Commands.DeleteMerchant.Handle(ctx, cmdArgs)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		h.app.Logger.Error(ctx, "Failed to delete merchant", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find merchant with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// MergeMerchants merges merchants into the merchant.
+func (h HTTPServer) MergeMerchants(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle merge merchants http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling merge merchants HTTP request")
+
+	var merge MerchantMerge
+	bindErr := echoCtx.Bind(&merge)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid merchant merge format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid merchant merge format"))
+	}
+
+	cmdArgs := command.MergeMerchantsCommand{
+		ID:          id,
+		MerchantIDs: merge.MerchantIds,
+	}
+	merged, mergeErr := h.app.Commands.MergeMerchants.Handle(ctx, cmdArgs)
+	if mergeErr != nil {
+		tracer.AddSpanError(span, mergeErr)
+		if errors.Is(mergeErr, domain.ErrInvalidMerchant) || errors.Is(mergeErr, domain.ErrMerchantNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(mergeErr.Error()))
+		}
+		if errors.Is(mergeErr, domain.ErrExpenseLocked) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(mergeErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to merge merchants", mergeErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(mergeErr))
+	}
+
+	if merged == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find merchant with ID %s", id)))
+	}
+
+	response := merchantToResponse(*merged)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindMerchantStats returns spending history of the merchant.
+func (h HTTPServer) FindMerchantStats(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find merchant stats http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find merchant stats HTTP request")
+
+	stats, statsErr := h.app.Queries.FindMerchantStats.Handle(ctx, query.FindMerchantStatsQuery{ID: id})
+	if statsErr != nil {
+		tracer.AddSpanError(span, statsErr)
+		h.app.Logger.Error(ctx, "Failed to find merchant stats", statsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(statsErr))
+	}
+
+	if stats == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find merchant with ID %s", id)))
+	}
+
+	response := merchantStatsToResponse(*stats)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindRules returns all rules.
+func (h HTTPServer) FindRules(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find rules http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find rules HTTP request")
+
+	rules, rulesErr := h.app.Queries.FindRules.Handle(ctx, query.FindRulesQuery{})
+	if rulesErr != nil {
+		tracer.AddSpanError(span, rulesErr)
+		h.app.Logger.Error(ctx, "Failed to find rules", rulesErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(rulesErr))
+	}
+
+	response := rulesToResponse(rules)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindRuleByID returns a rule by id.
+func (h HTTPServer) FindRuleByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find rule http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find rule HTTP request")
+
+	rule, ruleErr := h.app.Queries.FindRule.Handle(ctx, query.FindRuleQuery{ID: id})
+	if ruleErr != nil {
+		tracer.AddSpanError(span, ruleErr)
+		h.app.Logger.Error(ctx, "Failed to find rule", ruleErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(ruleErr))
+	}
+
+	if rule == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find rule with ID %s", id)))
+	}
+
+	response := ruleToResponse(*rule)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddRule adds a new rule.
+func (h HTTPServer) AddRule(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add rule http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add rule HTTP request")
+
+	var newRule NewRule
+	bindErr := echoCtx.Bind(&newRule)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid rule format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid rule format"))
+	}
+
+	params, paramsErr := ruleFromRequest(newRule)
+	if paramsErr != nil {
+		tracer.AddSpanError(span, paramsErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(paramsErr.Error()))
+	}
+
+	if tripErr := h.checkTrip(ctx, params.Actions.TripID); tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		if errors.Is(tripErr, domain.ErrTripNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(tripErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to find trip", tripErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripErr))
+	}
+
+	cmdArgs := command.AddRuleCommand{
+		Name:       params.Name,
+		Priority:   params.Priority,
+		Conditions: params.Conditions,
+		Actions:    params.Actions,
+	}
+	ruleID, ruleErr := h.app.Commands.AddRule.Handle(ctx, cmdArgs)
+	if ruleErr != nil {
+		tracer.AddSpanError(span, ruleErr)
+		if errors.Is(ruleErr, domain.ErrInvalidRule) || errors.Is(ruleErr, domain.ErrCategoryNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(ruleErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create rule", ruleErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(ruleErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *ruleID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// UpdateRule updates a rule.
+func (h HTTPServer) UpdateRule(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update rule http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update rule HTTP request")
+
+	var rule NewRule
+	bindErr := echoCtx.Bind(&rule)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid rule format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid rule format"))
+	}
+
+	params, paramsErr := ruleFromRequest(rule)
+	if paramsErr != nil {
+		tracer.AddSpanError(span, paramsErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(paramsErr.Error()))
+	}
+
+	if tripErr := h.checkTrip(ctx, params.Actions.TripID); tripErr != nil {
+		tracer.AddSpanError(span, tripErr)
+		if errors.Is(tripErr, domain.ErrTripNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(tripErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to find trip", tripErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tripErr))
+	}
+
+	cmdArgs := command.UpdateRuleCommand{
+		ID:         id,
+		Name:       params.Name,
+		Priority:   params.Priority,
+		Conditions: params.Conditions,
+		Actions:    params.Actions,
+	}
+	updated, updateErr := h.app.Commands.UpdateRule.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrInvalidRule) || errors.Is(updateErr, domain.ErrCategoryNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update rule", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	if updated == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find rule with ID %s", id)))
+	}
+
+	response := ruleToResponse(*updated)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// DeleteRule deletes a rule.
+func (h HTTPServer) DeleteRule(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete rule http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete rule HTTP request")
+
+	cmdArgs := command.DeleteRuleCommand{
+		ID: id,
+	}
+	deleteRes, deleteErr := h.app.Commands.DeleteRule.Handle(ctx, cmdArgs)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		h.app.Logger.Error(ctx, "Failed to delete rule", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find rule with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// TestRule tests a rule against the last added expenses without saving it.
+func (h HTTPServer) TestRule(echoCtx echo.Context, params TestRuleParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle test rule http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling test rule HTTP request")
+
+	var rule NewRule
+	bindErr := echoCtx.Bind(&rule)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid rule format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid rule format"))
+	}
+
+	ruleParams, paramsErr := ruleFromRequest(rule)
+	if paramsErr != nil {
+		tracer.AddSpanError(span, paramsErr)
+		return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(paramsErr.Error()))
+	}
+
+	queryArgs := query.TestRuleQuery{
+		Rule:  ruleParams,
+		Limit: params.Limit,
+	}
+	test, testErr := h.app.Queries.TestRule.Handle(ctx, queryArgs)
+	if testErr != nil {
+		tracer.AddSpanError(span, testErr)
+		if errors.Is(testErr, domain.ErrInvalidRule) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(testErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to test rule", testErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(testErr))
+	}
+
+	response := ruleTestToResponse(*test)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// ApplyRules applies rules to expenses of the date range.
+func (h HTTPServer) ApplyRules(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle apply rules http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling apply rules HTTP request")
+
+	var apply RuleApply
+	bindErr := echoCtx.Bind(&apply)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid rule apply format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid rule apply format"))
+	}
+
+	cmdArgs := command.ApplyRulesCommand{
+		From:      apply.From,
+		To:        apply.To,
+		UpdatedBy: auth.UserFromContext(echoCtx),
+	}
+	result, applyErr := h.app.Commands.ApplyRules.Handle(ctx, cmdArgs)
+	if applyErr != nil {
+		tracer.AddSpanError(span, applyErr)
+		if errors.Is(applyErr, domain.ErrInvalidRule) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(applyErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to apply rules", applyErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(applyErr))
+	}
+
+	response := RuleApplyResult{
+		Updated: result.UpdateCount,
+	}
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindDuplicates returns groups of likely duplicate expenses.
+func (h HTTPServer) FindDuplicates(echoCtx echo.Context, params FindDuplicatesParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find duplicates http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find duplicates HTTP request")
+
+	queryArgs := query.FindDuplicatesQuery{
+		From:      params.From,
+		To:        params.To,
+		Days:      params.Days,
+		Tolerance: params.Tolerance,
+	}
+	groups, groupsErr := h.app.Queries.FindDuplicates.Handle(ctx, queryArgs)
+	if groupsErr != nil {
+		tracer.AddSpanError(span, groupsErr)
+		if errors.Is(groupsErr, domain.ErrInvalidDuplicateCriteria) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(groupsErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to find duplicates", groupsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(groupsErr))
+	}
+
+	response := duplicateGroupsToResponse(groups)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// ResolveDuplicates keeps one expense of a duplicate group and deletes the rest.
+func (h HTTPServer) ResolveDuplicates(echoCtx echo.Context, groupID string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle resolve duplicates http request")
+	span.SetAttributes(attribute.String("id", groupID))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling resolve duplicates HTTP request")
+
+	var resolution DuplicateResolution
+	bindErr := echoCtx.Bind(&resolution)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid duplicate resolution format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid duplicate resolution format"))
+	}
+
+	cmdArgs := command.ResolveDuplicatesCommand{
+		GroupID:    groupID,
+		KeepID:     resolution.KeepId,
+		Resolution: domain.DuplicateResolution(resolution.Action),
+		ResolvedBy: auth.UserFromContext(echoCtx),
+	}
+	kept, resolveErr := h.app.Commands.ResolveDuplicates.Handle(ctx, cmdArgs)
+	if resolveErr != nil {
+		tracer.AddSpanError(span, resolveErr)
+		if errors.Is(resolveErr, domain.ErrInvalidDuplicateResolution) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(resolveErr.Error()))
+		}
+		if errors.Is(resolveErr, domain.ErrExpenseLocked) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(resolveErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to resolve duplicates", resolveErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(resolveErr))
+	}
+
+	if kept == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find duplicate group with ID %s", groupID)))
+	}
+
+	response := expenseWithCategoryToResponse(*kept)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindTags returns all tags with their usage counts.
+func (h HTTPServer) FindTags(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find tags http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find tags HTTP request")
+
+	tags, tagsErr := h.app.Queries.FindTags.Handle(ctx, query.FindTagsQuery{})
+	if tagsErr != nil {
+		tracer.AddSpanError(span, tagsErr)
+		h.app.Logger.Error(ctx, "Failed to find tags", tagsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tagsErr))
+	}
+
+	response := tagsToResponse(tags)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// RenameTag renames a tag in all expenses.
+func (h HTTPServer) RenameTag(echoCtx echo.Context, name string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle rename tag http request")
+	span.SetAttributes(attribute.String("name", name))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling rename tag HTTP request")
+
+	var rename TagRename
+	bindErr := echoCtx.Bind(&rename)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid tag format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid tag format"))
+	}
+
+	cmdArgs := command.RenameTagCommand{
+		Name:    name,
+		NewName: rename.Name,
+	}
+	result, renameErr := h.app.Commands.RenameTag.Handle(ctx, cmdArgs)
+	if renameErr != nil {
+		tracer.AddSpanError(span, renameErr)
+		if errors.Is(renameErr, domain.ErrInvalidTag) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(renameErr.Error()))
+		}
+		if errors.Is(renameErr, domain.ErrExpenseLocked) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(renameErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to rename tag", renameErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(renameErr))
+	}
+
+	if result == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find tag %s", name)))
+	}
+
+	response := TagUpdateResult{
+		Updated: result.UpdateCount,
+	}
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// MergeTags replaces several tags with a single tag in all expenses.
+func (h HTTPServer) MergeTags(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle merge tags http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling merge tags HTTP request")
+
+	var merge TagMerge
+	bindErr := echoCtx.Bind(&merge)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid tag merge format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid tag merge format"))
+	}
+
+	cmdArgs := command.MergeTagsCommand{
+		Tags: merge.Tags,
+		Into: merge.Into,
+	}
+	result, mergeErr := h.app.Commands.MergeTags.Handle(ctx, cmdArgs)
+	if mergeErr != nil {
+		tracer.AddSpanError(span, mergeErr)
+		if errors.Is(mergeErr, domain.ErrInvalidTag) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(mergeErr.Error()))
+		}
+		if errors.Is(mergeErr, domain.ErrExpenseLocked) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(mergeErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to merge tags", mergeErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(mergeErr))
+	}
+
+	response := TagUpdateResult{
+		Updated: result.UpdateCount,
+	}
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindBalances returns who owes whom across shared expenses and settlements.
+func (h HTTPServer) FindBalances(echoCtx echo.Context, params FindBalancesParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find balances http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find balances HTTP request")
+
+	settings, settingsErr := h.userSettings(ctx, echoCtx)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		h.app.Logger.Error(ctx, "Failed to find settings", settingsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settingsErr))
+	}
+
+	queryArgs := query.FindBalancesQuery{
+		Currency: reportCurrencyFromRequest(params.Currency, *settings),
+	}
+	sheet, sheetErr := h.app.Queries.FindBalances.Handle(ctx, queryArgs)
+	if sheetErr != nil {
+		tracer.AddSpanError(span, sheetErr)
+		if errors.Is(sheetErr, domain.ErrExchangeRateNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(sheetErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to find balances", sheetErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(sheetErr))
+	}
+
+	response := balanceSheetToResponse(*sheet)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddSettlement records a settle-up payment.
+func (h HTTPServer) AddSettlement(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add settlement http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add settlement HTTP request")
+
+	var newSettlement NewSettlement
+	bindErr := echoCtx.Bind(&newSettlement)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid settlement format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid settlement format"))
+	}
+
+	cmdArgs := command.AddSettlementCommand{
+		From:     newSettlement.From,
+		To:       newSettlement.To,
+		Amount:   newSettlement.Amount,
+		Currency: newSettlement.Currency,
+		Date:     newSettlement.Date,
+	}
+	settlementID, settlementErr := h.app.Commands.AddSettlement.Handle(ctx, cmdArgs)
+	if settlementErr != nil {
+		tracer.AddSpanError(span, settlementErr)
+		if errors.Is(settlementErr, domain.ErrInvalidSettlement) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(settlementErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create settlement", settlementErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settlementErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *settlementID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// FindIncomes returns incomes of the date range.
+func (h HTTPServer) FindIncomes(echoCtx echo.Context, params FindIncomesParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find incomes http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find incomes HTTP request")
+
+	dateRange, dateRangeErr := domain.NewDateRange(params.From, params.To)
+	if dateRangeErr != nil {
+		tracer.AddSpanError(span, dateRangeErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Date range has invalid format"))
+	}
+
+	incomes, incomesErr := h.app.Queries.FindIncomes.Handle(ctx, query.FindIncomesQuery{DateRange: *dateRange})
+	if incomesErr != nil {
+		tracer.AddSpanError(span, incomesErr)
+		h.app.Logger.Error(ctx, "Failed to find incomes", incomesErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(incomesErr))
+	}
+
+	response := incomesToResponse(incomes)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindIncomeByID returns income by id.
+func (h HTTPServer) FindIncomeByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find income http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find income HTTP request")
+
+	income, incomeErr := h.app.Queries.FindIncome.Handle(ctx, query.FindIncomeQuery{ID: id})
+	if incomeErr != nil {
+		tracer.AddSpanError(span, incomeErr)
+		h.app.Logger.Error(ctx, "Failed to find income", incomeErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(incomeErr))
+	}
+
+	if income == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find income with ID %s", id)))
+	}
+
+	response := incomeToResponse(*income)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddIncome records income.
+func (h HTTPServer) AddIncome(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add income http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add income HTTP request")
+
+	var newIncome NewIncome
+	bindErr := echoCtx.Bind(&newIncome)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid income format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid income format"))
+	}
+
+	cmdArgs := command.AddIncomeCommand{
+		Source:     newIncome.Source,
+		CategoryID: newIncome.CategoryId,
+		Amount:     newIncome.Amount,
+		Currency:   newIncome.Currency,
+		Date:       newIncome.Date,
+		Comment:    newIncome.Comment,
+	}
+	incomeID, incomeErr := h.app.Commands.AddIncome.Handle(ctx, cmdArgs)
+	if incomeErr != nil {
+		tracer.AddSpanError(span, incomeErr)
+		if errors.Is(incomeErr, domain.ErrInvalidIncome) || errors.Is(incomeErr, domain.ErrCategoryNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(incomeErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create income", incomeErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(incomeErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *incomeID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// UpdateIncome updates income.
+func (h HTTPServer) UpdateIncome(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update income http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update income HTTP request")
+
+	var income NewIncome
+	bindErr := echoCtx.Bind(&income)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid income format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid income format"))
+	}
+
+	cmdArgs := command.UpdateIncomeCommand{
+		ID:         id,
+		Source:     income.Source,
+		CategoryID: income.CategoryId,
+		Amount:     income.Amount,
+		Currency:   income.Currency,
+		Date:       income.Date,
+		Comment:    income.Comment,
+	}
+	updated, updateErr := h.app.Commands.UpdateIncome.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrInvalidIncome) || errors.Is(updateErr, domain.ErrCategoryNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update income", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	if updated == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find income with ID %s", id)))
+	}
+
+	response := incomeToResponse(*updated)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// DeleteIncome deletes income.
+func (h HTTPServer) DeleteIncome(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete income http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete income HTTP request")
+
+	deleteRes, deleteErr := h.app.Commands.DeleteIncome.Handle(ctx, command.DeleteIncomeCommand{ID: id})
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		h.app.Logger.Error(ctx, "Failed to delete income", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find income with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// GenerateReport generates a new expense report.
+func (h HTTPServer) GenerateReport(echoCtx echo.Context, params GenerateReportParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle generate report http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling generate report HTTP request")
+
+	dateRange, dateRangeErr := domain.NewDateRange(params.From, params.To)
+	if dateRangeErr != nil {
+		tracer.AddSpanError(span, dateRangeErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Date range has invalid format"))
+	}
+
+	settings, settingsErr := h.userSettings(ctx, echoCtx)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		h.app.Logger.Error(ctx, "Failed to find settings", settingsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settingsErr))
+	}
+
+	fetchCmdArgs := command.FetchExchangeRatesCommand{
+		DateRange: *dateRange,
+	}
+	rates, ratesErr := h.app.Commands.FetchExchangeRates.Handle(ctx, fetchCmdArgs)
+	if ratesErr != nil {
+		tracer.AddSpanError(span, ratesErr)
+		h.app.Logger.Error(ctx, "Failed to fetch exchange rates", ratesErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(ratesErr))
+	}
+
+	queryArgs := query.FindExpensesQuery{
+		ReportFilter: reportFilterFromRequest(params.Categories, params.ExcludeCategories,
+			params.OriginalCurrencies, params.TripId, params.MinAmount, params.MaxAmount, params.Comment),
+		DateRange:      *dateRange,
+		Interval:       string(params.Interval),
+		Tags:           tagsFromRequest(params.Tags),
+		ExcludedTags:   tagsFromRequest(params.ExcludeTags),
+		ExchangeRates:  rates,
+		PeriodStartDay: settings.PeriodStartDay(),
+		Currency:       reportCurrencyFromRequest(params.Currency, *settings),
+	}
+
+	expenseRpt, expenseRptErr := h.app.Queries.FindExpenses.Handle(ctx, queryArgs)
+	if expenseRptErr != nil {
+		tracer.AddSpanError(span, expenseRptErr)
+		if errors.Is(expenseRptErr, domain.ErrInvalidReportCurrency) ||
+			errors.Is(expenseRptErr, domain.ErrInvalidReportFilter) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(expenseRptErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create expense report", expenseRptErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(expenseRptErr))
+	}
+
+	response := reportToResponse(*expenseRpt)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// GenerateCategoryReport generates expense report by category with totals per interval.
+func (h HTTPServer) GenerateCategoryReport(echoCtx echo.Context, params GenerateCategoryReportParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle generate category report http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling generate category report HTTP request")
+
+	dateRange, dateRangeErr := domain.NewDateRange(params.From, params.To)
+	if dateRangeErr != nil {
+		tracer.AddSpanError(span, dateRangeErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Date range has invalid format"))
+	}
+
+	settings, settingsErr := h.userSettings(ctx, echoCtx)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		h.app.Logger.Error(ctx, "Failed to find settings", settingsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settingsErr))
+	}
+
+	fetchCmdArgs := command.FetchExchangeRatesCommand{
+		DateRange: *dateRange,
+	}
+	rates, ratesErr := h.app.Commands.FetchExchangeRates.Handle(ctx, fetchCmdArgs)
+	if ratesErr != nil {
+		tracer.AddSpanError(span, ratesErr)
+		h.app.Logger.Error(ctx, "Failed to fetch exchange rates", ratesErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(ratesErr))
+	}
+
+	queryArgs := query.FindCategoryReportQuery{
+		ReportFilter: reportFilterFromRequest(params.Categories, params.ExcludeCategories,
+			params.OriginalCurrencies, params.TripId, params.MinAmount, params.MaxAmount, params.Comment),
+		DateRange:      *dateRange,
+		Interval:       string(params.Interval),
+		Tags:           tagsFromRequest(params.Tags),
+		ExcludedTags:   tagsFromRequest(params.ExcludeTags),
+		ExchangeRates:  rates,
+		PeriodStartDay: settings.PeriodStartDay(),
+		Currency:       reportCurrencyFromRequest(params.Currency, *settings),
+	}
+
+	categoryRpt, categoryRptErr := h.app.Queries.FindCategoryReport.Handle(ctx, queryArgs)
+	if categoryRptErr != nil {
+		tracer.AddSpanError(span, categoryRptErr)
+		if errors.Is(categoryRptErr, domain.ErrInvalidReportCurrency) ||
+			errors.Is(categoryRptErr, domain.ErrInvalidReportFilter) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(categoryRptErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create category report", categoryRptErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(categoryRptErr))
+	}
+
+	return echoCtx.JSON(http.StatusOK, categoryReportToResponse(*categoryRpt))
+}
+
+// GenerateComparisonReport compares category totals of the base period with the comparison periods.
+func (h HTTPServer) GenerateComparisonReport(echoCtx echo.Context, params GenerateComparisonReportParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle generate comparison report http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling generate comparison report HTTP request")
+
+	base, baseErr := domain.NewDateRange(params.From, params.To)
+	if baseErr != nil {
+		tracer.AddSpanError(span, baseErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Date range has invalid format"))
+	}
+
+	if len(params.CompareFrom) == 0 || len(params.CompareFrom) != len(params.CompareTo) {
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Every comparison period should have from and to dates"))
+	}
+	comparisons := make([]domain.DateRange, 0, len(params.CompareFrom))
+	for i := range params.CompareFrom {
+		comparison, comparisonErr := domain.NewDateRange(params.CompareFrom[i], params.CompareTo[i])
+		if comparisonErr != nil {
+			tracer.AddSpanError(span, comparisonErr)
+			return echoCtx.JSON(http.StatusBadRequest,
+				httperr.BadRequest("Comparison date range has invalid format"))
+		}
+		comparisons = append(comparisons, *comparison)
+	}
+
+	settings, settingsErr := h.userSettings(ctx, echoCtx)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		h.app.Logger.Error(ctx, "Failed to find settings", settingsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settingsErr))
+	}
+
+	rates := make([]domain.ExchangeRates, 0)
+	for _, period := range append([]domain.DateRange{*base}, comparisons...) {
+		periodRates, ratesErr := h.app.Commands.FetchExchangeRates.Handle(ctx,
+			command.FetchExchangeRatesCommand{DateRange: period})
+		if ratesErr != nil {
+			tracer.AddSpanError(span, ratesErr)
+			h.app.Logger.Error(ctx, "Failed to fetch exchange rates", ratesErr)
+			return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(ratesErr))
+		}
+		rates = append(rates, periodRates...)
+	}
+
+	queryArgs := query.FindComparisonReportQuery{
+		ReportFilter: reportFilterFromRequest(params.Categories, params.ExcludeCategories,
+			params.OriginalCurrencies, params.TripId, params.MinAmount, params.MaxAmount, params.Comment),
+		Base:           *base,
+		Comparisons:    comparisons,
+		Tags:           tagsFromRequest(params.Tags),
+		ExcludedTags:   tagsFromRequest(params.ExcludeTags),
+		ExchangeRates:  rates,
+		PeriodStartDay: settings.PeriodStartDay(),
+		Currency:       reportCurrencyFromRequest(params.Currency, *settings),
+	}
+	if params.AverageBy != nil {
+		queryArgs.AverageBy = string(*params.AverageBy)
+	}
+
+	comparisonRpt, comparisonRptErr := h.app.Queries.FindComparison.Handle(ctx, queryArgs)
+	if comparisonRptErr != nil {
+		tracer.AddSpanError(span, comparisonRptErr)
+		if errors.Is(comparisonRptErr, domain.ErrInvalidReportCurrency) ||
+			errors.Is(comparisonRptErr, domain.ErrInvalidReportFilter) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(comparisonRptErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create comparison report", comparisonRptErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(comparisonRptErr))
+	}
+
+	return echoCtx.JSON(http.StatusOK, comparisonReportToResponse(*comparisonRpt))
+}
+
+// GenerateCashFlowReport generates income, expenses and net per interval.
+func (h HTTPServer) GenerateCashFlowReport(echoCtx echo.Context, params GenerateCashFlowReportParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle generate cash flow report http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling generate cash flow report HTTP request")
+
+	dateRange, dateRangeErr := domain.NewDateRange(params.From, params.To)
+	if dateRangeErr != nil {
+		tracer.AddSpanError(span, dateRangeErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Date range has invalid format"))
+	}
+
+	settings, settingsErr := h.userSettings(ctx, echoCtx)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		h.app.Logger.Error(ctx, "Failed to find settings", settingsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settingsErr))
+	}
+
+	fetchCmdArgs := command.FetchExchangeRatesCommand{
+		DateRange: *dateRange,
+	}
+	rates, ratesErr := h.app.Commands.FetchExchangeRates.Handle(ctx, fetchCmdArgs)
+	if ratesErr != nil {
+		tracer.AddSpanError(span, ratesErr)
+		h.app.Logger.Error(ctx, "Failed to fetch exchange rates", ratesErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(ratesErr))
+	}
+
+	queryArgs := query.FindCashFlowQuery{
+		DateRange:      *dateRange,
+		Interval:       string(params.Interval),
+		ExchangeRates:  rates,
+		PeriodStartDay: settings.PeriodStartDay(),
+		Currency:       reportCurrencyFromRequest(params.Currency, *settings),
+	}
+	cashFlow, cashFlowErr := h.app.Queries.FindCashFlow.Handle(ctx, queryArgs)
+	if cashFlowErr != nil {
+		tracer.AddSpanError(span, cashFlowErr)
+		if errors.Is(cashFlowErr, domain.ErrInvalidReportCurrency) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(cashFlowErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create cash flow report", cashFlowErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(cashFlowErr))
+	}
+
+	response := cashFlowReportToResponse(*cashFlow)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindAccounts returns all accounts.
+func (h HTTPServer) FindAccounts(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find accounts http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find accounts HTTP request")
+
+	accounts, accountsErr := h.app.Queries.FindAccounts.Handle(ctx, query.FindAccountsQuery{})
+	if accountsErr != nil {
+		tracer.AddSpanError(span, accountsErr)
+		h.app.Logger.Error(ctx, "Failed to find accounts", accountsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(accountsErr))
+	}
+
+	response := accountsToResponse(accounts)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FindAccountByID returns an account by id.
+func (h HTTPServer) FindAccountByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find account http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find account HTTP request")
+
+	account, accountErr := h.app.Queries.FindAccount.Handle(ctx, query.FindAccountQuery{ID: id})
+	if accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		h.app.Logger.Error(ctx, "Failed to find account", accountErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(accountErr))
+	}
+
+	if account == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find account with ID %s", id)))
+	}
+
+	response := accountToResponse(*account)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddAccount adds a new account.
+func (h HTTPServer) AddAccount(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add account http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add account HTTP request")
+
+	var newAccount NewAccount
+	bindErr := echoCtx.Bind(&newAccount)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid account format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid account format"))
+	}
+
+	cmdArgs := command.AddAccountCommand{
+		Name:           newAccount.Name,
+		Type:           string(newAccount.Type),
+		Currency:       newAccount.Currency,
+		OpeningBalance: newAccount.OpeningBalance,
+	}
+	accountID, accountErr := h.app.Commands.AddAccount.Handle(ctx, cmdArgs)
+	if accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		if errors.Is(accountErr, domain.ErrInvalidAccount) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(accountErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create account", accountErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(accountErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *accountID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// UpdateAccount updates an account.
+func (h HTTPServer) UpdateAccount(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update account http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update account HTTP request")
+
+	var account NewAccount
+	bindErr := echoCtx.Bind(&account)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid account format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid account format"))
+	}
+
+	cmdArgs := command.UpdateAccountCommand{
+		ID:             id,
+		Name:           account.Name,
+		Type:           string(account.Type),
+		Currency:       account.Currency,
+		OpeningBalance: account.OpeningBalance,
+	}
+	updated, updateErr := h.app.Commands.UpdateAccount.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrInvalidAccount) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update account", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	if updated == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find account with ID %s", id)))
+	}
+
+	response := accountToResponse(*updated)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// DeleteAccount deletes an account keeping its expenses.
+func (h HTTPServer) DeleteAccount(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle delete account http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling delete account HTTP request")
+
+	cmdArgs := command.DeleteAccountCommand{
+		ID: id,
+	}
+	deleteRes, deleteErr := h.app.Commands.DeleteAccount.Handle(ctx, cmdArgs)
+	if deleteErr != nil {
+		tracer.AddSpanError(span, deleteErr)
+		h.app.Logger.Error(ctx, "Failed to delete account", deleteErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(deleteErr))
+	}
+
+	if deleteRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find account with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// FindAccountBalance returns an account balance at the moment.
+func (h HTTPServer) FindAccountBalance(echoCtx echo.Context, id string, params FindAccountBalanceParams) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find account balance http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find account balance HTTP request")
+
+	balance, balanceErr := h.app.Queries.FindAccountBalance.Handle(ctx,
+		query.FindAccountBalanceQuery{ID: id, At: params.At})
+	if balanceErr != nil {
+		tracer.AddSpanError(span, balanceErr)
+		if errors.Is(balanceErr, domain.ErrExchangeRateNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(balanceErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to find account balance", balanceErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(balanceErr))
+	}
+
+	if balance == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find account with ID %s", id)))
+	}
+
+	response := accountBalanceToResponse(*balance)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddTransfer records money moved between accounts.
+func (h HTTPServer) AddTransfer(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add transfer http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add transfer HTTP request")
+
+	var newTransfer NewTransfer
+	bindErr := echoCtx.Bind(&newTransfer)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid transfer format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid transfer format"))
+	}
+
+	cmdArgs := command.AddTransferCommand{
+		FromAccountID: newTransfer.FromAccountId,
+		ToAccountID:   newTransfer.ToAccountId,
+		Amount:        newTransfer.Amount,
+		Rate:          newTransfer.Rate,
+		Date:          newTransfer.Date,
+		Comment:       newTransfer.Comment,
+	}
+	transferID, transferErr := h.app.Commands.AddTransfer.Handle(ctx, cmdArgs)
+	if transferErr != nil {
+		tracer.AddSpanError(span, transferErr)
+		if errors.Is(transferErr, domain.ErrInvalidTransfer) || errors.Is(transferErr, domain.ErrAccountNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(transferErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create transfer", transferErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(transferErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *transferID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// FindReconciliations returns reconciliation history of an account.
+func (h HTTPServer) FindReconciliations(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find reconciliations http request")
+	span.SetAttributes(attribute.String("accountId", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find reconciliations HTTP request")
+
+	if accountErr := h.checkAccount(ctx, &id); accountErr != nil {
+		tracer.AddSpanError(span, accountErr)
+		if errors.Is(accountErr, domain.ErrAccountNotFound) {
+			return echoCtx.JSON(http.StatusNotFound,
+				httperr.NotFoundRequest(fmt.Errorf("could not find account with ID %s", id)))
+		}
+		h.app.Logger.Error(ctx, "Failed to get account", accountErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(accountErr))
+	}
+
+	reconciliations, reconciliationsErr := h.app.Queries.FindReconciliations.Handle(ctx,
+		query.FindReconciliationsQuery{AccountID: id})
+	if reconciliationsErr != nil {
+		tracer.AddSpanError(span, reconciliationsErr)
+		h.app.Logger.Error(ctx, "Failed to find reconciliations", reconciliationsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(reconciliationsErr))
+	}
+
+	response := reconciliationsToResponse(reconciliations)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// AddReconciliation starts reconciling an account against a bank statement.
+func (h HTTPServer) AddReconciliation(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle add reconciliation http request")
+	span.SetAttributes(attribute.String("accountId", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling add reconciliation HTTP request")
+
+	var newReconciliation NewReconciliation
+	bindErr := echoCtx.Bind(&newReconciliation)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid reconciliation format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid reconciliation format"))
+	}
+
+	cmdArgs := command.AddReconciliationCommand{
+		AccountID:        id,
+		StatementDate:    newReconciliation.StatementDate,
+		StatementBalance: newReconciliation.StatementBalance,
+	}
+	reconciliationID, reconciliationErr := h.app.Commands.AddReconciliation.Handle(ctx, cmdArgs)
+	if reconciliationErr != nil {
+		tracer.AddSpanError(span, reconciliationErr)
+		if errors.Is(reconciliationErr, domain.ErrAccountNotFound) {
+			return echoCtx.JSON(http.StatusNotFound,
+				httperr.NotFoundRequest(fmt.Errorf("could not find account with ID %s", id)))
+		}
+		if errors.Is(reconciliationErr, domain.ErrInvalidReconciliation) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(reconciliationErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to create reconciliation", reconciliationErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(reconciliationErr))
+	}
+
+	response := NewExpenseResponse{
+		Id: *reconciliationID,
+	}
+
+	return echoCtx.JSON(http.StatusCreated, response)
+}
+
+// FindReconciliationByID returns a reconciliation compared with the account balance.
+func (h HTTPServer) FindReconciliationByID(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find reconciliation http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find reconciliation HTTP request")
+
+	report, reportErr := h.app.Queries.FindReconciliation.Handle(ctx, query.FindReconciliationQuery{ID: id})
+	if reportErr != nil {
+		tracer.AddSpanError(span, reportErr)
+		if errors.Is(reportErr, domain.ErrExchangeRateNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(reportErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to find reconciliation", reportErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(reportErr))
+	}
+
+	if report == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find reconciliation with ID %s", id)))
+	}
+
+	response := reconciliationReportToResponse(*report)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// TickReconciliation replaces the expenses ticked off in a reconciliation.
+func (h HTTPServer) TickReconciliation(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle tick reconciliation http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling tick reconciliation HTTP request")
+
+	var expenses ReconciliationExpenses
+	bindErr := echoCtx.Bind(&expenses)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid reconciliation expenses format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid reconciliation expenses format"))
+	}
+
+	cmdArgs := command.TickReconciliationCommand{
+		ID:         id,
+		ExpenseIDs: expenses.ExpenseIds,
+	}
+	reconciliation, tickErr := h.app.Commands.TickReconciliation.Handle(ctx, cmdArgs)
+	if tickErr != nil {
+		tracer.AddSpanError(span, tickErr)
+		if errors.Is(tickErr, domain.ErrInvalidReconciliation) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(tickErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to tick reconciliation expenses", tickErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(tickErr))
+	}
+
+	if reconciliation == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find reconciliation with ID %s", id)))
+	}
+
+	response := reconciliationToResponse(*reconciliation)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// FinishReconciliation finishes a reconciliation locking its ticked off expenses.
+func (h HTTPServer) FinishReconciliation(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle finish reconciliation http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling finish reconciliation HTTP request")
+
+	reconciliation, finishErr := h.app.Commands.FinishReconciliation.Handle(ctx,
+		command.FinishReconciliationCommand{ID: id})
+	if finishErr != nil {
+		tracer.AddSpanError(span, finishErr)
+		if errors.Is(finishErr, domain.ErrInvalidReconciliation) {
+			return echoCtx.JSON(http.StatusConflict, httperr.Conflict(finishErr.Error()))
+		}
+		if errors.Is(finishErr, domain.ErrExchangeRateNotFound) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(finishErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to finish reconciliation", finishErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(finishErr))
+	}
+
+	if reconciliation == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find reconciliation with ID %s", id)))
+	}
+
+	response := reconciliationToResponse(*reconciliation)
+	return echoCtx.JSON(http.StatusOK, response)
+}
+
+// UnlockExpense unlocks a reconciled expense, so it could be edited again.
+func (h HTTPServer) UnlockExpense(echoCtx echo.Context, id string) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle unlock expense http request")
+	span.SetAttributes(attribute.String("id", id))
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling unlock expense HTTP request")
+
+	unlockRes, unlockErr := h.app.Commands.UnlockExpense.Handle(ctx, command.UnlockExpenseCommand{ExpenseID: id})
+	if unlockErr != nil {
+		tracer.AddSpanError(span, unlockErr)
+		h.app.Logger.Error(ctx, "Failed to unlock expense", unlockErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(unlockErr))
+	}
+
+	if unlockRes == nil {
+		return echoCtx.JSON(http.StatusNotFound,
+			httperr.NotFoundRequest(fmt.Errorf("could not find expense with ID %s", id)))
+	}
+
+	return echoCtx.NoContent(http.StatusNoContent)
+}
+
+// FindSettings returns report settings of the current user.
+func (h HTTPServer) FindSettings(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle find settings http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling find settings HTTP request")
+
+	settings, settingsErr := h.userSettings(ctx, echoCtx)
+	if settingsErr != nil {
+		tracer.AddSpanError(span, settingsErr)
+		h.app.Logger.Error(ctx, "Failed to find settings", settingsErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(settingsErr))
+	}
+
+	return echoCtx.JSON(http.StatusOK, settingsToResponse(*settings))
+}
+
+// UpdateSettings updates report settings of the current user.
+func (h HTTPServer) UpdateSettings(echoCtx echo.Context) error {
+	ctx, span := tracer.NewSpan(echoCtx.Request().Context(), "handle update settings http request")
+	defer span.End()
+	h.app.Logger.Info(ctx, "Handling update settings HTTP request")
+
+	var settings Settings
+	bindErr := echoCtx.Bind(&settings)
+	if bindErr != nil {
+		tracer.AddSpanError(span, bindErr)
+		h.app.Logger.Error(ctx, "Invalid settings format", bindErr)
+		return echoCtx.JSON(http.StatusBadRequest,
+			httperr.BadRequest("Invalid settings format"))
+	}
+
+	cmdArgs := command.UpdateSettingsCommand{
+		User:           auth.UserFromContext(echoCtx),
+		PeriodStartDay: settings.PeriodStartDay,
+	}
+	if settings.ReportCurrency != nil {
+		cmdArgs.ReportCurrency = *settings.ReportCurrency
+	}
+	updated, updateErr := h.app.Commands.UpdateSettings.Handle(ctx, cmdArgs)
+	if updateErr != nil {
+		tracer.AddSpanError(span, updateErr)
+		if errors.Is(updateErr, domain.ErrInvalidSettings) {
+			return echoCtx.JSON(http.StatusBadRequest, httperr.BadRequest(updateErr.Error()))
+		}
+		h.app.Logger.Error(ctx, "Failed to update settings", updateErr)
+		return echoCtx.JSON(http.StatusInternalServerError, httperr.InternalError(updateErr))
+	}
+
+	return echoCtx.JSON(http.StatusOK, settingsToResponse(*updated))
+}
+
+// userSettings returns report settings of the user of the request.
+func (h HTTPServer) userSettings(ctx context.Context, echoCtx echo.Context) (*domain.Settings, error) {
+	return h.app.Queries.FindSettings.Handle(ctx, query.FindSettingsQuery{User: auth.UserFromContext(echoCtx)})
+}
+
+// checkTrip checks that the referenced trip exists, expenses without a trip pass the check.
+func (h HTTPServer) checkTrip(ctx context.Context, tripID *string) error {
+	if tripID == nil {
+		return nil
+	}
+
+	trip, tripErr := h.app.Queries.FindTrip.Handle(ctx, query.FindTripQuery{ID: *tripID})
+	if tripErr != nil {
+		return tripErr
+	}
+
+	if trip == nil {
+		return fmt.Errorf("%w: trip %s", domain.ErrTripNotFound, *tripID)
+	}
+
+	return nil
+}
+
+// checkMerchant checks that the referenced merchant exists, expenses without a merchant pass the check.
+func (h HTTPServer) checkMerchant(ctx context.Context, merchantID *string) error {
+	if merchantID == nil {
+		return nil
+	}
+
+	merchant, merchantErr := h.app.Queries.FindMerchant.Handle(ctx, query.FindMerchantQuery{ID: *merchantID})
+	if merchantErr != nil {
+		return merchantErr
+	}
+
+	if merchant == nil {
+		return fmt.Errorf("%w: merchant %s", domain.ErrMerchantNotFound, *merchantID)
+	}
+
+	return nil
+}
+
+// checkAccount checks that the referenced account exists, expenses without an account pass the check.
+func (h HTTPServer) checkAccount(ctx context.Context, accountID *string) error {
+	if accountID == nil {
+		return nil
+	}
+
+	account, accountErr := h.app.Queries.FindAccount.Handle(ctx, query.FindAccountQuery{ID: *accountID})
+	if accountErr != nil {
+		return accountErr
+	}
+
+	if account == nil {
+		return fmt.Errorf("%w: account %s", domain.ErrAccountNotFound, *accountID)
+	}
+
+	return nil
+}
+
+// reportCurrencyFromRequest returns the requested report currency, the one of the user settings by default.
+func reportCurrencyFromRequest(currency *string, settings domain.Settings) string {
+	if currency == nil || len(*currency) == 0 {
+		return string(settings.ReportCurrency())
+	}
+	return *currency
+}
+
+// reportFilterFromRequest returns optional filters of reported expenses.
+func reportFilterFromRequest(
+	categories *[]string,
+	excludeCategories *[]string,
+	originalCurrencies *[]string,
+	tripID *string,
+	minAmount *float64,
+	maxAmount *float64,
+	comment *string,
+) query.ReportFilter {
+	filter := query.ReportFilter{
+		Categories:         valuesFromRequest(categories),
+		ExcludedCategories: valuesFromRequest(excludeCategories),
+		OriginalCurrencies: valuesFromRequest(originalCurrencies),
+		MinAmount:          minAmount,
+		MaxAmount:          maxAmount,
+	}
+	if tripID != nil {
+		filter.TripID = *tripID
+	}
+	if comment != nil {
+		filter.Comment = *comment
+	}
+	return filter
+}
+
+// valuesFromRequest returns values of an optional list parameter.
+func valuesFromRequest(values *[]string) []string {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
+
+// participantsFromRequest returns trip participants, a trip without participants gets an empty list.
+func participantsFromRequest(participants *[]string) []string {
+	if participants == nil {
+		return []string{}
+	}
+	return *participants
+}
+
+// aliasesFromRequest returns merchant aliases, a missing list means no aliases.
+func aliasesFromRequest(aliases *[]string) []string {
+	if aliases == nil {
+		return nil
+	}
+	return *aliases
+}
+
+// tagsFromRequest returns tags of the request, a missing list means no tags.
+func tagsFromRequest(tags *[]string) []string {
+	if tags == nil {
+		return nil
+	}
+	return *tags
+}
+
+// ruleFromRequest maps rule request into domain params, rules without priority get zero priority.
+func ruleFromRequest(rule NewRule) (domain.RuleParams, error) {
+	params := domain.RuleParams{
+		Name: rule.Name,
+		Conditions: domain.RuleConditions{
+			Comment:   rule.Conditions.Comment,
+			Merchant:  rule.Conditions.Merchant,
+			MinAmount: rule.Conditions.MinAmount,
+			MaxAmount: rule.Conditions.MaxAmount,
+			Currency:  rule.Conditions.Currency,
+		},
+		Actions: domain.RuleActions{
+			CategoryID:   rule.Actions.CategoryId,
+			Tags:         tagsFromRequest(rule.Actions.Tags),
+			TripID:       rule.Actions.TripId,
+			Reimbursable: flagFromRequest(rule.Actions.Reimbursable),
+		},
+	}
+	if rule.Priority != nil {
+		params.Priority = *rule.Priority
+	}
+	if rule.Conditions.Weekdays != nil {
+		for _, weekday := range *rule.Conditions.Weekdays {
+			day, dayErr := domain.ParseWeekday(string(weekday))
+			if dayErr != nil {
+				return params, dayErr
+			}
+			params.Conditions.Weekdays = append(params.Conditions.Weekdays, day)
+		}
+	}
+	return params, nil
+}
+
+// flagFromRequest returns a boolean flag of the request, a missing flag is not set.
+func flagFromRequest(flag *bool) bool {
+	return flag != nil && *flag
+}
+
+// splitFromRequest maps split request into domain params, personal expenses have no split.
+func splitFromRequest(split *Split) *domain.SplitParams {
+	if split == nil {
+		return nil
+	}
+
+	params := &domain.SplitParams{
+		Method: string(split.Method),
+		Shares: make([]domain.SplitShareParams, 0, len(split.Shares)),
+	}
+	for _, share := range split.Shares {
+		shareParams := domain.SplitShareParams{User: share.User}
+		if share.Value != nil {
+			shareParams.Value = *share.Value
+		}
+		params.Shares = append(params.Shares, shareParams)
+	}
+
+	return params
+}
+
+// exportContentType returns a media type of the export format.
+func exportContentType(format domain.ExportFormat) string {
+	switch format {
+	case domain.ExportFormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case domain.ExportFormatJSON:
+		return echo.MIMEApplicationJSONCharsetUTF8
+	default:
+		return "text/csv; charset=utf-8"
+	}
+}
+
+// importMode returns requested import mode, dry run by default.
+func importMode(mode *ImportMode) (domain.ImportMode, error) {
+	if mode == nil {
+		return domain.ImportModeDryRun, nil
+	}
+	return domain.ParseImportMode(string(*mode))
+}
+
+// importProfileParamsFromRequest maps import profile request into domain params.
+func importProfileParamsFromRequest(profile NewImportProfile) domain.ImportProfileParams {
+	return domain.ImportProfileParams{
+		Name: profile.Name,
+		Columns: domain.ImportColumns{
+			Date:     profile.Columns.Date,
+			Amount:   profile.Columns.Amount,
+			Currency: profile.Columns.Currency,
+			Quantity: profile.Columns.Quantity,
+			Comment:  profile.Columns.Comment,
+			Category: profile.Columns.Category,
+		},
+		DateFormat:       profile.DateFormat,
+		DecimalSeparator: profile.DecimalSeparator,
+		Delimiter:        profile.Delimiter,
+		DefaultCurrency:  profile.DefaultCurrency,
+	}
+}
+
+// scheduleFromRequest maps schedule request into domain schedule.
+func scheduleFromRequest(schedule Schedule) (*domain.Schedule, error) {
+	return domain.NewSchedule(domain.ScheduleParams{
+		Frequency:  string(schedule.Frequency),
+		Interval:   schedule.Interval,
+		DayOfMonth: schedule.DayOfMonth,
+		Start:      schedule.Start,
+		Until:      schedule.Until,
+		Count:      schedule.Count,
+	})
+}
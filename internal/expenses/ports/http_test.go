@@ -1,8 +1,11 @@
 package ports_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -10,7 +13,9 @@ import (
 	"testing"
 	"time"
 
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
@@ -63,7 +68,7 @@ func TestAddExpense_SuccessfulCommand_Returns201(t *testing.T) {
 	matchExpFn := func(command command.AddExpenseCommand) bool {
 		return reflect.DeepEqual(command.Category, *category)
 	}
-	addExpenseHandler.On("Handle", mock.Anything, mock.MatchedBy(matchExpFn)).Return(&expenseID, nil)
+	addExpenseHandler.On("Handle", mock.Anything, mock.MatchedBy(matchExpFn)).Return(&domain.AddExpenseResult{ID: expenseID}, nil)
 
 	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
 
@@ -166,6 +171,45 @@ func TestAddExpense_CategoryQueryNotFound_Returns400(t *testing.T) {
 	assert.NotEmpty(t, response.Body.String(), "Should not return empty body.")
 }
 
+func TestAddExpense_TripNotFound_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	expenseHandler := new(mocks.AddExpenseHandlerInterface)
+	findTripHandler := new(mocks.FindTripHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddExpense: expenseHandler,
+		},
+		Queries: app.Queries{
+			FindTrip: findTripHandler,
+		},
+		Logger: logger,
+	}
+	expenseJSON := `{"categoryId":"123","tripId":"tripId"}`
+
+	findTripHandler.On("Handle", mock.Anything, query.FindTripQuery{ID: "tripId"}).Return(nil, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/expenses", strings.NewReader(expenseJSON))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddExpense(ctx)
+
+	// Assert
+	findTripHandler.AssertExpectations(t)
+	expenseHandler.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+	assert.Contains(t, response.Body.String(), "trip not found", "Should return trip error.")
+}
+
 func TestAddExpense_FailedExpenseCommand_Returns500(t *testing.T) {
 	t.Parallel()
 	// Arrange
@@ -249,6 +293,13 @@ func TestAddExpense_InvalidPayload_Returns400(t *testing.T) {
 	assert.NotEmpty(t, response.Body.String(), "Should not return empty body.")
 }
 
+func newFindSettingsHandler(periodStartDay int) *mocks.FindSettingsHandlerInterface {
+	settings, _ := domain.NewSettings("user", domain.SettingsParams{PeriodStartDay: periodStartDay})
+	findSettings := new(mocks.FindSettingsHandlerInterface)
+	findSettings.On("Handle", mock.Anything, mock.Anything).Return(settings, nil)
+	return findSettings
+}
+
 func TestGenerateReport_SuccessfulQuery_Returns200(t *testing.T) {
 	t.Parallel()
 	// Arrange
@@ -262,6 +313,7 @@ func TestGenerateReport_SuccessfulQuery_Returns200(t *testing.T) {
 		},
 		Queries: app.Queries{
 			FindExpenses: findExpenses,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
 		},
 		Logger: logger,
 	}
@@ -360,6 +412,7 @@ func TestGenerateReport_FailedFetchCommand_Returns500(t *testing.T) {
 		},
 		Queries: app.Queries{
 			FindExpenses: findExpenses,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
 		},
 		Logger: logger,
 	}
@@ -409,6 +462,7 @@ func TestGenerateReport_FailedFindQuery_Returns500(t *testing.T) {
 		},
 		Queries: app.Queries{
 			FindExpenses: findExpenses,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
 		},
 		Logger: logger,
 	}
@@ -448,3 +502,6194 @@ func TestGenerateReport_FailedFindQuery_Returns500(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
 	assert.NotEmpty(t, response.Body.String(), "Should not return empty body.")
 }
+
+func TestListExpenses_InvalidFilter_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	listExpenses := new(mocks.ListExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			ListExpenses: listExpenses,
+		},
+		Logger: logger,
+	}
+	limit := 0
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.ListExpensesParams{
+		Limit: &limit,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ListExpenses(ctx, params)
+
+	// Assert
+	logger.AssertExpectations(t)
+	listExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+	assert.NotEmpty(t, response.Body.String(), "Should not return empty body.")
+}
+
+func TestListExpenses_FailedQuery_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	listExpenses := new(mocks.ListExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			ListExpenses: listExpenses,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	listExpenses.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ListExpenses(ctx, ports.ListExpensesParams{})
+
+	// Assert
+	logger.AssertExpectations(t)
+	listExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+	assert.NotEmpty(t, response.Body.String(), "Should not return empty body.")
+}
+
+func TestListExpenses_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	listExpenses := new(mocks.ListExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			ListExpenses: listExpenses,
+		},
+		Logger: logger,
+	}
+	sortBy := ports.SortFieldPrice
+	order := ports.SortOrderAsc
+	categoryID := "categoryId"
+	category, _ := domain.NewCategory(categoryID, nil, "category", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil, time.Now())
+	nextCursor := "cursor"
+	page := &domain.ExpensePage{
+		Expenses:   []domain.Expense{*expense},
+		NextCursor: &nextCursor,
+	}
+
+	matchQueryFn := func(query query.ListExpensesQuery) bool {
+		return query.Filter.SortField() == domain.SortFieldPrice &&
+			query.Filter.SortOrder() == domain.SortOrderAsc &&
+			*query.Filter.CategoryID() == categoryID
+	}
+	listExpenses.On("Handle", mock.Anything, mock.MatchedBy(matchQueryFn)).Return(page, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.ListExpensesParams{
+		CategoryId: &categoryID,
+		SortBy:     &sortBy,
+		Order:      &order,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ListExpenses(ctx, params)
+
+	// Assert
+	logger.AssertExpectations(t)
+	listExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), nextCursor, "Should return next cursor.")
+}
+
+func TestFindExpenseByID_FailedQuery_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpense := new(mocks.FindExpenseHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindExpense: findExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Infof", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	findExpense.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses/id", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindExpenseByID(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	findExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func TestFindExpenseByID_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpense := new(mocks.FindExpenseHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindExpense: findExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Infof", mock.Anything, mock.Anything, mock.Anything).Return()
+	findExpense.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses/id", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindExpenseByID(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	findExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestFindExpenseByID_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpense := new(mocks.FindExpenseHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindExpense: findExpense,
+		},
+		Logger: logger,
+	}
+	expenseID := "expenseId"
+	parentID := "parentId"
+	parent, _ := domain.NewCategory(parentID, nil, "parent", nil, 1, "|parentId")
+	category, _ := domain.NewCategory("categoryId", &parentID, "category", nil, 2, "|parentId|categoryId")
+	category.SetParents(&[]domain.Category{*parent})
+	expense, _ := domain.NewExpense(expenseID, *category, 10, "EUR", 1, nil, nil, time.Now())
+
+	matchQueryFn := func(query query.FindExpenseQuery) bool {
+		return query.ExpenseID == expenseID
+	}
+	logger.On("Infof", mock.Anything, mock.Anything, mock.Anything).Return()
+	findExpense.On("Handle", mock.Anything, mock.MatchedBy(matchQueryFn)).Return(expense, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses/expenseId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindExpenseByID(ctx, expenseID)
+
+	// Assert
+	logger.AssertExpectations(t)
+	findExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), parentID, "Should return category parents.")
+}
+
+func TestUpdateExpense_InvalidPayload_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateExpense := new(mocks.UpdateExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateExpense: updateExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/expenses/id", strings.NewReader("{"))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateExpense(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	updateExpense.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestUpdateExpense_InvalidExpense_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateExpense := new(mocks.UpdateExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateExpense: updateExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateExpense.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("price: %w", domain.ErrInvalidExpense))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/expenses/id", strings.NewReader(`{"categoryId":"123"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateExpense(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	updateExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestUpdateExpense_FailedCommand_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateExpense := new(mocks.UpdateExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateExpense: updateExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateExpense.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/expenses/id", strings.NewReader(`{"categoryId":"123"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateExpense(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	updateExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func TestUpdateExpense_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateExpense := new(mocks.UpdateExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateExpense: updateExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateExpense.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/expenses/id", strings.NewReader(`{"categoryId":"123"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateExpense(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	updateExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestUpdateExpense_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateExpense := new(mocks.UpdateExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateExpense: updateExpense,
+		},
+		Logger: logger,
+	}
+	expenseID := "expenseId"
+	category, _ := domain.NewCategory("123", nil, "category", nil, 1, "|123")
+	expense, _ := domain.NewExpense(expenseID, *category, 10, "EUR", 1, nil, nil, time.Now(),
+		domain.SetUpdateMetadata("updater", time.Now()))
+
+	matchCmdFn := func(cmd command.UpdateExpenseCommand) bool {
+		return cmd.ID == expenseID && cmd.CategoryID == "123"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateExpense.On("Handle", mock.Anything, mock.MatchedBy(matchCmdFn)).Return(expense, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/expenses/expenseId", strings.NewReader(`{"categoryId":"123"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateExpense(ctx, expenseID)
+
+	// Assert
+	logger.AssertExpectations(t)
+	updateExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), expenseID, "Should return updated expense.")
+}
+
+func TestDeleteExpense_FailedCommand_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteExpense := new(mocks.DeleteExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteExpense: deleteExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteExpense.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/expenses/id", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteExpense(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	deleteExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func TestDeleteExpense_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteExpense := new(mocks.DeleteExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteExpense: deleteExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteExpense.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/expenses/id", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteExpense(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	deleteExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDeleteExpense_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteExpense := new(mocks.DeleteExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteExpense: deleteExpense,
+		},
+		Logger: logger,
+	}
+
+	matchCmdFn := func(cmd command.DeleteExpenseCommand) bool {
+		return cmd.ExpenseID == "id"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteExpense.On("Handle", mock.Anything, mock.MatchedBy(matchCmdFn)).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/expenses/id", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteExpense(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	deleteExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func TestFindTrashItems_FailedQuery_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findTrashItems := new(mocks.FindTrashItemsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindTrashItems: findTrashItems,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	findTrashItems.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/trash", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindTrashItems(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	findTrashItems.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func TestFindTrashItems_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findTrashItems := new(mocks.FindTrashItemsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindTrashItems: findTrashItems,
+		},
+		Logger: logger,
+	}
+	item, _ := domain.NewTrashItem("categoryId", domain.TrashItemTypeCategory, "category", nil,
+		"user", time.Now())
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findTrashItems.On("Handle", mock.Anything, mock.Anything).Return([]domain.TrashItem{*item}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/trash", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindTrashItems(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	findTrashItems.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), "categoryId", "Should return trash items.")
+}
+
+func TestRestoreTrashItem_FailedCommand_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	restoreTrashItem := new(mocks.RestoreTrashItemHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			RestoreTrashItem: restoreTrashItem,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	restoreTrashItem.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/trash/id/restore", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.RestoreTrashItem(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	restoreTrashItem.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func TestRestoreTrashItem_ParentUnavailable_Returns409(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	restoreTrashItem := new(mocks.RestoreTrashItemHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			RestoreTrashItem: restoreTrashItem,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	restoreTrashItem.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("restore: %w", domain.ErrParentCategoryUnavailable))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/trash/id/restore", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.RestoreTrashItem(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	restoreTrashItem.AssertExpectations(t)
+	assert.Equal(t, http.StatusConflict, response.Code, "HTTP status should be 409.")
+}
+
+func TestRestoreTrashItem_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	restoreTrashItem := new(mocks.RestoreTrashItemHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			RestoreTrashItem: restoreTrashItem,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	restoreTrashItem.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/trash/id/restore", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.RestoreTrashItem(ctx, "id")
+
+	// Assert
+	logger.AssertExpectations(t)
+	restoreTrashItem.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestRestoreTrashItem_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	restoreTrashItem := new(mocks.RestoreTrashItemHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			RestoreTrashItem: restoreTrashItem,
+		},
+		Logger: logger,
+	}
+	item, _ := domain.NewTrashItem("expenseId", domain.TrashItemTypeExpense, "expense", nil, "user", time.Now())
+
+	matchCmdFn := func(cmd command.RestoreTrashItemCommand) bool {
+		return cmd.ItemID == "expenseId"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	restoreTrashItem.On("Handle", mock.Anything, mock.MatchedBy(matchCmdFn)).Return(item, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/trash/expenseId/restore", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.RestoreTrashItem(ctx, "expenseId")
+
+	// Assert
+	logger.AssertExpectations(t)
+	restoreTrashItem.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), "expenseId", "Should return restored item.")
+}
+
+func newImportRequest(t *testing.T, fields map[string]string, file *string) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for name, value := range fields {
+		assert.Nil(t, writer.WriteField(name, value))
+	}
+	if file != nil {
+		part, partErr := writer.CreateFormFile("file", "expenses.csv")
+		assert.Nil(t, partErr)
+		_, writeErr := part.Write([]byte(*file))
+		assert.Nil(t, writeErr)
+	}
+	assert.Nil(t, writer.Close())
+
+	request, _ := http.NewRequest("POST", "/imports/csv", body)
+	request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return request
+}
+
+func TestImportExpensesCsv_MissingFile_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	importExpensesCsv := new(mocks.ImportExpensesCsvHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ImportExpensesCsv: importExpensesCsv,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request := newImportRequest(t, map[string]string{"profileId": "profileId"}, nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ImportExpensesCsv(ctx, ports.ImportExpensesCsvParams{})
+
+	// Assert
+	importExpensesCsv.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestImportExpensesCsv_InvalidImport_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	importExpensesCsv := new(mocks.ImportExpensesCsvHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ImportExpensesCsv: importExpensesCsv,
+		},
+		Logger: logger,
+	}
+	file := "Date,Amount\n"
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	importExpensesCsv.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("missing columns: %w", domain.ErrInvalidImport))
+
+	response := httptest.NewRecorder()
+	request := newImportRequest(t, map[string]string{"profileId": "profileId"}, &file)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ImportExpensesCsv(ctx, ports.ImportExpensesCsvParams{})
+
+	// Assert
+	importExpensesCsv.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestImportExpensesCsv_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	importExpensesCsv := new(mocks.ImportExpensesCsvHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ImportExpensesCsv: importExpensesCsv,
+		},
+		Logger: logger,
+	}
+	file := "Date,Amount,Category\n2021-07-01,10,Food\n"
+	profile := `{"name":"bank","columns":{"date":"Date","amount":"Amount","category":"Category"},` +
+		`"dateFormat":"yyyy-MM-dd","decimalSeparator":".","defaultCurrency":"EUR"}`
+	mode := ports.ImportModeAtomic
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("", *category, 10, "EUR", 1, nil, nil, time.Now())
+	report := domain.NewImportReport(domain.ImportModeAtomic)
+	report.Accept(1, *expense)
+	report.Committed = true
+
+	matchFn := func(cmd command.ImportExpensesCsvCommand) bool {
+		return cmd.Mode == domain.ImportModeAtomic && cmd.ProfileID == nil &&
+			cmd.Profile != nil && cmd.Profile.Columns.Date == "Date"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	importExpensesCsv.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&report, nil)
+
+	response := httptest.NewRecorder()
+	request := newImportRequest(t, map[string]string{"profile": profile}, &file)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ImportExpensesCsv(ctx, ports.ImportExpensesCsvParams{Mode: &mode})
+
+	// Assert
+	importExpensesCsv.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"committed":true`, "Should return import report.")
+}
+
+func TestFindImportProfiles_FailedQuery_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findImportProfiles := new(mocks.FindImportProfilesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindImportProfiles: findImportProfiles,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	findImportProfiles.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/imports/profiles", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindImportProfiles(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	findImportProfiles.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func TestFindImportProfiles_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findImportProfiles := new(mocks.FindImportProfilesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindImportProfiles: findImportProfiles,
+		},
+		Logger: logger,
+	}
+	currency := "EUR"
+	profile, _ := domain.NewImportProfile(domain.ImportProfileParams{
+		ID:               "profileId",
+		Name:             "bank",
+		Columns:          domain.ImportColumns{Date: "Date", Amount: "Amount", Category: "Category"},
+		DateFormat:       "yyyy-MM-dd",
+		DecimalSeparator: ".",
+		DefaultCurrency:  &currency,
+	})
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findImportProfiles.On("Handle", mock.Anything, mock.Anything).Return([]domain.ImportProfile{*profile}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/imports/profiles", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindImportProfiles(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	findImportProfiles.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"id":"profileId"`, "Should return import profiles.")
+}
+
+func TestAddImportProfile_InvalidProfile_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addImportProfile := new(mocks.AddImportProfileHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddImportProfile: addImportProfile,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addImportProfile.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("empty name: %w", domain.ErrInvalidImport))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/imports/profiles", strings.NewReader(`{"name":""}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddImportProfile(ctx)
+
+	// Assert
+	addImportProfile.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddImportProfile_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addImportProfile := new(mocks.AddImportProfileHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddImportProfile: addImportProfile,
+		},
+		Logger: logger,
+	}
+	profileID := "profileId"
+
+	matchFn := func(cmd command.AddImportProfileCommand) bool {
+		return cmd.Profile.Name == "bank" && cmd.Profile.DecimalSeparator == ","
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addImportProfile.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&profileID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/imports/profiles",
+		strings.NewReader(`{"name":"bank","decimalSeparator":","}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddImportProfile(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	addImportProfile.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"id":"profileId"`, "Should return saved profile.")
+}
+
+func TestImportOfx_InvalidMode_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	importStatement := new(mocks.ImportStatementHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ImportStatement: importStatement,
+		},
+		Logger: logger,
+	}
+	file := "<OFX></OFX>"
+	mode := ports.ImportMode("unknown")
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request := newImportRequest(t, map[string]string{}, &file)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ImportOfx(ctx, ports.ImportOfxParams{Mode: &mode})
+
+	// Assert
+	importStatement.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestImportOfx_InvalidImport_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	importStatement := new(mocks.ImportStatementHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ImportStatement: importStatement,
+		},
+		Logger: logger,
+	}
+	file := "Date,Amount"
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	importStatement.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("parse: %w", domain.ErrInvalidImport))
+
+	response := httptest.NewRecorder()
+	request := newImportRequest(t, map[string]string{}, &file)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ImportOfx(ctx, ports.ImportOfxParams{})
+
+	// Assert
+	importStatement.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestImportQif_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	importStatement := new(mocks.ImportStatementHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ImportStatement: importStatement,
+		},
+		Logger: logger,
+	}
+	file := "!Type:Bank\nD07/01/21\nT-1\n^\n"
+	currency := "EUR"
+	dateFormat := "MM/dd/yy"
+	report := domain.NewImportReport(domain.ImportModeDryRun)
+	report.Skip(1, "transaction is already imported")
+
+	matchFn := func(cmd command.ImportStatementCommand) bool {
+		return cmd.Format == domain.StatementFormatQIF && cmd.Mode == domain.ImportModeDryRun &&
+			*cmd.DefaultCurrency == currency && *cmd.DateFormat == dateFormat && cmd.File != nil
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	importStatement.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&report, nil)
+
+	response := httptest.NewRecorder()
+	request := newImportRequest(t, map[string]string{}, &file)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ImportQif(ctx, ports.ImportQifParams{DefaultCurrency: &currency, DateFormat: &dateFormat})
+
+	// Assert
+	importStatement.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"skipped":1`, "Should return import report.")
+}
+
+func TestListInboxExpenses_InvalidLimit_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findInboxExpenses := new(mocks.FindInboxExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindInboxExpenses: findInboxExpenses,
+		},
+		Logger: logger,
+	}
+	limit := 0
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/imports/inbox", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ListInboxExpenses(ctx, ports.ListInboxExpensesParams{Limit: &limit})
+
+	// Assert
+	findInboxExpenses.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestListInboxExpenses_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findInboxExpenses := new(mocks.FindInboxExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindInboxExpenses: findInboxExpenses,
+		},
+		Logger: logger,
+	}
+	inbox, _ := domain.NewCategory("inboxId", nil, domain.InboxCategoryName, nil, 1, "|inboxId")
+	expense, _ := domain.NewExpense("expenseId", *inbox, 10, "EUR", 1, nil, nil, time.Now())
+	page := domain.NewExpensePage([]domain.Expense{*expense}, domain.DefaultPageSize, domain.SortFieldDate)
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findInboxExpenses.On("Handle", mock.Anything, mock.Anything).Return(&page, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/imports/inbox", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ListInboxExpenses(ctx, ports.ListInboxExpensesParams{})
+
+	// Assert
+	findInboxExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"id":"expenseId"`, "Should return inbox expenses.")
+}
+
+func TestAssignInboxCategories_UnknownCategory_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	assignInbox := new(mocks.AssignInboxCategoriesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AssignInbox: assignInbox,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	assignInbox.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("category: %w", domain.ErrCategoryNotFound))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/imports/inbox/categories",
+		strings.NewReader(`[{"expenseId":"expenseId","categoryId":"unknown"}]`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AssignInboxCategories(ctx)
+
+	// Assert
+	assignInbox.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAssignInboxCategories_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	assignInbox := new(mocks.AssignInboxCategoriesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AssignInbox: assignInbox,
+		},
+		Logger: logger,
+	}
+
+	matchFn := func(cmd command.AssignInboxCategoriesCommand) bool {
+		return reflect.DeepEqual(cmd.Assignments,
+			[]domain.CategoryAssignment{{ExpenseID: "expenseId", CategoryID: "categoryId"}})
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	assignInbox.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/imports/inbox/categories",
+		strings.NewReader(`[{"expenseId":"expenseId","categoryId":"categoryId"}]`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AssignInboxCategories(ctx)
+
+	// Assert
+	assignInbox.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"updated":1`, "Should return assignment result.")
+}
+
+func TestExportExpenses_InvalidFormat_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	exportExpenses := new(mocks.ExportExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			ExportExpenses: exportExpenses,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/exports/expenses?format=pdf", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ExportExpenses(ctx, ports.ExportExpensesParams{Format: "pdf"})
+
+	// Assert
+	exportExpenses.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestExportExpenses_FailedQuery_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	exportExpenses := new(mocks.ExportExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			ExportExpenses: exportExpenses,
+			FindSettings:   newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	exportExpenses.On("Handle", mock.Anything, mock.Anything).Return(0, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/exports/expenses?format=csv", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ExportExpenses(ctx, ports.ExportExpensesParams{Format: ports.ExportFormatCsv})
+
+	// Assert
+	exportExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+	assert.Empty(t, response.Header().Get(echo.HeaderContentDisposition), "Should not return an attachment.")
+}
+
+func TestExportExpenses_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	exportExpenses := new(mocks.ExportExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			ExportExpenses: exportExpenses,
+			FindSettings:   newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	currency := "EUR"
+	reportCurrency := "USD"
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	exportExpenses.On("Handle", mock.Anything, mock.MatchedBy(func(q query.ExportExpensesQuery) bool {
+		return q.Format == domain.ExportFormatXLSX &&
+			q.Filter.Limit() == domain.MaxPageSize &&
+			*q.Filter.Currency() == currency &&
+			q.Currency == reportCurrency
+	})).Return(1, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/exports/expenses?format=xlsx", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ExportExpenses(ctx, ports.ExportExpensesParams{
+		Format:         ports.ExportFormatXlsx,
+		Currency:       &currency,
+		ReportCurrency: &reportCurrency,
+	})
+
+	// Assert
+	exportExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Equal(t, `attachment; filename="expenses.xlsx"`, response.Header().Get(echo.HeaderContentDisposition),
+		"Should return an attachment.")
+}
+
+func TestExportExpenses_DefaultReportCurrency_PassesSettingsCurrencyToQuery(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	exportExpenses := new(mocks.ExportExpensesHandlerInterface)
+	settings, _ := domain.NewSettings("user", domain.SettingsParams{PeriodStartDay: 1, ReportCurrency: "GBP"})
+	findSettings := new(mocks.FindSettingsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			ExportExpenses: exportExpenses,
+			FindSettings:   findSettings,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findSettings.On("Handle", mock.Anything, mock.Anything).Return(settings, nil)
+	exportExpenses.On("Handle", mock.Anything, mock.MatchedBy(func(q query.ExportExpensesQuery) bool {
+		return q.Currency == "GBP"
+	})).Return(1, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/exports/expenses?format=csv", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ExportExpenses(ctx, ports.ExportExpensesParams{Format: ports.ExportFormatCsv})
+
+	// Assert
+	exportExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestExportExpenses_InvalidReportCurrency_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	exportExpenses := new(mocks.ExportExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			ExportExpenses: exportExpenses,
+			FindSettings:   newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	reportCurrency := "XYZ"
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	exportExpenses.On("Handle", mock.Anything, mock.Anything).
+		Return(0, fmt.Errorf("%w: no XYZ exchange rate", domain.ErrInvalidReportCurrency))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/exports/expenses?format=csv", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ExportExpenses(ctx, ports.ExportExpensesParams{Format: ports.ExportFormatCsv, ReportCurrency: &reportCurrency})
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+	assert.Empty(t, response.Header().Get(echo.HeaderContentDisposition), "Should not return an attachment.")
+}
+
+func newMonthlyRecurringExpense() *domain.RecurringExpense {
+	category, _ := domain.NewCategory("categoryId", nil, "Rent", nil, 1, "|categoryId")
+	schedule, _ := domain.NewSchedule(domain.ScheduleParams{
+		Frequency: "monthly",
+		Start:     time.Date(2021, time.January, 5, 0, 0, 0, 0, time.UTC),
+	})
+	recurringExpense, _ := domain.NewRecurringExpense("recurringId", *category, 500, "EUR", 1, nil, nil, *schedule)
+	return recurringExpense
+}
+
+func TestAddRecurringExpense_InvalidSchedule_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addRecurring := new(mocks.AddRecurringExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddRecurring: addRecurring,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/recurring-expenses",
+		strings.NewReader(`{"categoryId":"categoryId","schedule":{"frequency":"hourly","start":"2021-01-05T00:00:00Z"}}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddRecurringExpense(ctx)
+
+	// Assert
+	addRecurring.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddRecurringExpense_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addRecurring := new(mocks.AddRecurringExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddRecurring: addRecurring,
+		},
+		Logger: logger,
+	}
+	recurringID := "recurringId"
+
+	matchFn := func(cmd command.AddRecurringExpenseCommand) bool {
+		return cmd.CategoryID == "categoryId" && cmd.Schedule.Frequency() == domain.FrequencyMonthly &&
+			*cmd.Schedule.DayOfMonth() == 31
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addRecurring.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&recurringID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/recurring-expenses", strings.NewReader(
+		`{"categoryId":"categoryId","price":500,"quantity":1,"currency":"EUR",`+
+			`"schedule":{"frequency":"monthly","dayOfMonth":31,"start":"2021-01-05T00:00:00Z"}}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddRecurringExpense(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	addRecurring.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"recurringId"`, "Should return recurring expense ID.")
+}
+
+func TestFindRecurringExpenses_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findRecurring := new(mocks.FindRecurringExpensesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindRecurring: findRecurring,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findRecurring.On("Handle", mock.Anything, mock.Anything).
+		Return([]domain.RecurringExpense{*newMonthlyRecurringExpense()}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/recurring-expenses", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindRecurringExpenses(ctx)
+
+	// Assert
+	logger.AssertExpectations(t)
+	findRecurring.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"frequency":"monthly"`, "Should return schedule.")
+}
+
+func TestFindRecurringExpenseByID_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findRecurring := new(mocks.FindRecurringExpenseHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindRecurringByID: findRecurring,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Infof", mock.Anything, mock.Anything, mock.Anything).Return()
+	findRecurring.On("Handle", mock.Anything, query.FindRecurringExpenseQuery{ID: "recurringId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/recurring-expenses/recurringId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindRecurringExpenseByID(ctx, "recurringId")
+
+	// Assert
+	findRecurring.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDeleteRecurringExpense_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteRecurring := new(mocks.DeleteRecurringExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteRecurring: deleteRecurring,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteRecurring.On("Handle", mock.Anything, command.DeleteRecurringExpenseCommand{ID: "recurringId"}).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/recurring-expenses/recurringId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteRecurringExpense(ctx, "recurringId")
+
+	// Assert
+	deleteRecurring.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func TestPreviewOccurrences_InvalidLimit_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	preview := new(mocks.PreviewOccurrencesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			PreviewOccurrences: preview,
+		},
+		Logger: logger,
+	}
+	limit := domain.MaxPreviewSize + 1
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/recurring-expenses/recurringId/occurrences", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.PreviewOccurrences(ctx, "recurringId", ports.PreviewOccurrencesParams{Limit: &limit})
+
+	// Assert
+	preview.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestPreviewOccurrences_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	preview := new(mocks.PreviewOccurrencesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			PreviewOccurrences: preview,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	preview.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("recurring expense: %w", domain.ErrRecurringExpenseNotFound))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/recurring-expenses/recurringId/occurrences", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.PreviewOccurrences(ctx, "recurringId", ports.PreviewOccurrencesParams{})
+
+	// Assert
+	preview.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestPreviewOccurrences_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	preview := new(mocks.PreviewOccurrencesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			PreviewOccurrences: preview,
+		},
+		Logger: logger,
+	}
+	from := time.Date(2021, time.March, 6, 0, 0, 0, 0, time.UTC)
+	occurrences := []domain.Occurrence{
+		{Date: time.Date(2021, time.April, 5, 0, 0, 0, 0, time.UTC), Price: 500, Quantity: 1, Skipped: true},
+	}
+
+	matchFn := func(q query.PreviewOccurrencesQuery) bool {
+		return q.RecurringExpenseID == "recurringId" && q.From.Equal(from) && q.Limit == domain.DefaultPreviewSize
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	preview.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(occurrences, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/recurring-expenses/recurringId/occurrences", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.PreviewOccurrences(ctx, "recurringId", ports.PreviewOccurrencesParams{From: &from})
+
+	// Assert
+	preview.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"skipped":true`, "Should return occurrences.")
+}
+
+func TestUpdateOccurrence_MaterializedOccurrence_Returns409(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateOccurrence := new(mocks.UpdateOccurrenceHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateOccurrence: updateOccurrence,
+		},
+		Logger: logger,
+	}
+	date := openapi_types.Date{Time: time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateOccurrence.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("occurrence: %w", domain.ErrOccurrenceMaterialized))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/recurring-expenses/recurringId/occurrences/2021-02-05",
+		strings.NewReader(`{"skipped":true}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateOccurrence(ctx, "recurringId", date)
+
+	// Assert
+	updateOccurrence.AssertExpectations(t)
+	assert.Equal(t, http.StatusConflict, response.Code, "HTTP status should be 409.")
+}
+
+func TestUpdateOccurrence_InvalidOccurrence_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateOccurrence := new(mocks.UpdateOccurrenceHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateOccurrence: updateOccurrence,
+		},
+		Logger: logger,
+	}
+	date := openapi_types.Date{Time: time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/recurring-expenses/recurringId/occurrences/2021-02-05",
+		strings.NewReader(`{"skipped":true,"price":10}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateOccurrence(ctx, "recurringId", date)
+
+	// Assert
+	updateOccurrence.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestUpdateOccurrence_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateOccurrence := new(mocks.UpdateOccurrenceHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateOccurrence: updateOccurrence,
+		},
+		Logger: logger,
+	}
+	day := time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)
+
+	matchFn := func(cmd command.UpdateOccurrenceCommand) bool {
+		return cmd.RecurringExpenseID == "recurringId" && cmd.Exception != nil &&
+			*cmd.Exception.Price() == 550 && cmd.Exception.Date().Equal(day)
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateOccurrence.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(newMonthlyRecurringExpense(), nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/recurring-expenses/recurringId/occurrences/2021-02-05",
+		strings.NewReader(`{"price":550}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateOccurrence(ctx, "recurringId", openapi_types.Date{Time: day})
+
+	// Assert
+	updateOccurrence.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestRevertOccurrence_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateOccurrence := new(mocks.UpdateOccurrenceHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateOccurrence: updateOccurrence,
+		},
+		Logger: logger,
+	}
+	day := time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)
+
+	matchFn := func(cmd command.UpdateOccurrenceCommand) bool {
+		return cmd.RecurringExpenseID == "recurringId" && cmd.Exception == nil && cmd.Date.Equal(day)
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateOccurrence.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(newMonthlyRecurringExpense(), nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/recurring-expenses/recurringId/occurrences/2021-02-05", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.RevertOccurrence(ctx, "recurringId", openapi_types.Date{Time: day})
+
+	// Assert
+	updateOccurrence.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func newMonthlyBudget() domain.Budget {
+	budget, _ := domain.NewBudget("budgetId", domain.BudgetParams{
+		CategoryID: "categoryId",
+		Period:     "monthly",
+		Amount:     100,
+		Start:      time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+	return *budget
+}
+
+func TestAddBudget_ExistingCategoryBudget_Returns409(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addBudget := new(mocks.AddBudgetHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddBudget: addBudget,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addBudget.On("Handle", mock.Anything, mock.Anything).Return(nil, domain.ErrBudgetExists)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/budgets", strings.NewReader(
+		`{"categoryId":"categoryId","period":"monthly","amount":100,"rollover":false,"start":"2021-01-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddBudget(ctx)
+
+	// Assert
+	addBudget.AssertExpectations(t)
+	assert.Equal(t, http.StatusConflict, response.Code, "HTTP status should be 409.")
+}
+
+func TestAddBudget_InvalidBudget_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addBudget := new(mocks.AddBudgetHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddBudget: addBudget,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addBudget.On("Handle", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidBudget)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/budgets", strings.NewReader(
+		`{"categoryId":"categoryId","period":"weekly","amount":100,"rollover":false,"start":"2021-01-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddBudget(ctx)
+
+	// Assert
+	addBudget.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddBudget_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addBudget := new(mocks.AddBudgetHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddBudget: addBudget,
+		},
+		Logger: logger,
+	}
+	budgetID := "budgetId"
+
+	matchFn := func(cmd command.AddBudgetCommand) bool {
+		return cmd.CategoryID == "categoryId" && cmd.Period == "yearly" && cmd.Rollover &&
+			*cmd.Currency == "USD"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addBudget.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&budgetID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/budgets", strings.NewReader(
+		`{"categoryId":"categoryId","period":"yearly","amount":1200,"currency":"USD","rollover":true,`+
+			`"start":"2021-01-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddBudget(ctx)
+
+	// Assert
+	addBudget.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"budgetId"`, "Should return budget ID.")
+}
+
+func TestFindBudgets_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findBudgets := new(mocks.FindBudgetsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindBudgets: findBudgets,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findBudgets.On("Handle", mock.Anything, query.FindBudgetsQuery{}).Return([]domain.Budget{newMonthlyBudget()}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/budgets", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindBudgets(ctx)
+
+	// Assert
+	findBudgets.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"period":"monthly"`, "Should return budget period.")
+}
+
+func TestUpdateBudget_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateBudget := new(mocks.UpdateBudgetHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateBudget: updateBudget,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateBudget.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/budgets/budgetId", strings.NewReader(
+		`{"categoryId":"categoryId","period":"monthly","amount":150,"rollover":false,"start":"2021-01-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateBudget(ctx, "budgetId")
+
+	// Assert
+	updateBudget.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestUpdateBudget_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateBudget := new(mocks.UpdateBudgetHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateBudget: updateBudget,
+		},
+		Logger: logger,
+	}
+	budget := newMonthlyBudget()
+
+	matchFn := func(cmd command.UpdateBudgetCommand) bool {
+		return cmd.ID == "budgetId" && cmd.Amount == 150
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateBudget.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&budget, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/budgets/budgetId", strings.NewReader(
+		`{"categoryId":"categoryId","period":"monthly","amount":150,"rollover":false,"start":"2021-01-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateBudget(ctx, "budgetId")
+
+	// Assert
+	updateBudget.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestDeleteBudget_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteBudget := new(mocks.DeleteBudgetHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteBudget: deleteBudget,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteBudget.On("Handle", mock.Anything, command.DeleteBudgetCommand{ID: "budgetId"}).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/budgets/budgetId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteBudget(ctx, "budgetId")
+
+	// Assert
+	deleteBudget.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func TestFindBudgetStatus_Period_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findStatus := new(mocks.FindBudgetStatusHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindBudgetStatus: findStatus,
+			FindSettings:     newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	day := time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)
+	budget := newMonthlyBudget()
+	status := domain.NewBudgetTracker(nil, nil, 1, domain.DefaultReportCurrency).Status(budget, day)
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findStatus.On("Handle", mock.Anything, query.FindBudgetStatusQuery{
+		Dates:          []time.Time{day},
+		PeriodStartDay: domain.DefaultPeriodStartDay,
+		Currency:       string(domain.DefaultReportCurrency),
+	}).
+		Return([]domain.BudgetStatus{status}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/budgets/status?period=2021-02-05", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindBudgetStatus(ctx, ports.FindBudgetStatusParams{Period: &openapi_types.Date{Time: day}})
+
+	// Assert
+	findStatus.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"remaining":"100"`, "Should return remaining amount.")
+	assert.Contains(t, response.Body.String(), `"percentUsed":"0"`, "Should return percent used.")
+}
+
+func TestFindBudgetStatus_PeriodStartDay_QueriesShiftedPeriods(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findStatus := new(mocks.FindBudgetStatusHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindBudgetStatus: findStatus,
+			FindSettings:     newFindSettingsHandler(25),
+		},
+		Logger: logger,
+	}
+	day := time.Date(2021, time.February, 5, 0, 0, 0, 0, time.UTC)
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findStatus.On("Handle", mock.Anything, query.FindBudgetStatusQuery{
+		Dates:          []time.Time{day},
+		PeriodStartDay: 25,
+		Currency:       string(domain.DefaultReportCurrency),
+	}).
+		Return([]domain.BudgetStatus{}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/budgets/status?period=2021-02-05", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindBudgetStatus(ctx, ports.FindBudgetStatusParams{Period: &openapi_types.Date{Time: day}})
+
+	// Assert
+	findStatus.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestFindBudgetStatus_FailedQuery_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findStatus := new(mocks.FindBudgetStatusHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindBudgetStatus: findStatus,
+			FindSettings:     newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	findStatus.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/budgets/status", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindBudgetStatus(ctx, ports.FindBudgetStatusParams{})
+
+	// Assert
+	findStatus.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func newTrip() domain.Trip {
+	trip, _ := domain.NewTrip("tripId", domain.TripParams{
+		Name:         "Paris 2021",
+		From:         time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:           time.Date(2021, time.July, 2, 0, 0, 0, 0, time.UTC),
+		HomeCurrency: "EUR",
+		Participants: []string{"Alice"},
+	})
+	return *trip
+}
+
+func TestAddTrip_InvalidTrip_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addTrip := new(mocks.AddTripHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddTrip: addTrip,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addTrip.On("Handle", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidTrip)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/trips", strings.NewReader(
+		`{"name":"","from":"2021-07-01T00:00:00Z","to":"2021-07-02T00:00:00Z","homeCurrency":"EUR"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddTrip(ctx)
+
+	// Assert
+	addTrip.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddTrip_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addTrip := new(mocks.AddTripHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddTrip: addTrip,
+		},
+		Logger: logger,
+	}
+	tripID := "tripId"
+
+	matchFn := func(cmd command.AddTripCommand) bool {
+		return cmd.Name == "Paris 2021" && cmd.HomeCurrency == "EUR" && len(cmd.Participants) == 0
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addTrip.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&tripID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/trips", strings.NewReader(
+		`{"name":"Paris 2021","from":"2021-07-01T00:00:00Z","to":"2021-07-02T00:00:00Z","homeCurrency":"EUR"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddTrip(ctx)
+
+	// Assert
+	addTrip.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"tripId"`, "Should return trip ID.")
+}
+
+func TestFindTrips_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findTrips := new(mocks.FindTripsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindTrips: findTrips,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findTrips.On("Handle", mock.Anything, query.FindTripsQuery{}).Return([]domain.Trip{newTrip()}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/trips", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindTrips(ctx)
+
+	// Assert
+	findTrips.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"participants":["Alice"]`, "Should return trip participants.")
+}
+
+func TestFindTripByID_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findTrip := new(mocks.FindTripHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindTrip: findTrip,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findTrip.On("Handle", mock.Anything, query.FindTripQuery{ID: "tripId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/trips/tripId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindTripByID(ctx, "tripId")
+
+	// Assert
+	findTrip.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestUpdateTrip_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateTrip := new(mocks.UpdateTripHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateTrip: updateTrip,
+		},
+		Logger: logger,
+	}
+	trip := newTrip()
+
+	matchFn := func(cmd command.UpdateTripCommand) bool {
+		return cmd.ID == "tripId" && reflect.DeepEqual(cmd.Participants, []string{"Alice"})
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateTrip.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&trip, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/trips/tripId", strings.NewReader(
+		`{"name":"Paris 2021","from":"2021-07-01T00:00:00Z","to":"2021-07-02T00:00:00Z","homeCurrency":"EUR",`+
+			`"participants":["Alice"]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateTrip(ctx, "tripId")
+
+	// Assert
+	updateTrip.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestDeleteTrip_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteTrip := new(mocks.DeleteTripHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteTrip: deleteTrip,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteTrip.On("Handle", mock.Anything, command.DeleteTripCommand{ID: "tripId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/trips/tripId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteTrip(ctx, "tripId")
+
+	// Assert
+	deleteTrip.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDeleteTrip_LockedExpenses_Returns409(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteTrip := new(mocks.DeleteTripHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteTrip: deleteTrip,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteTrip.On("Handle", mock.Anything, command.DeleteTripCommand{ID: "tripId"}).
+		Return(nil, fmt.Errorf("%w: 1 expenses of the trip", domain.ErrExpenseLocked))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/trips/tripId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteTrip(ctx, "tripId")
+
+	// Assert
+	deleteTrip.AssertExpectations(t)
+	assert.Equal(t, http.StatusConflict, response.Code, "HTTP status should be 409.")
+}
+
+func TestFindTripReport_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findTripReport := new(mocks.FindTripReportHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindTripReport: findTripReport,
+		},
+		Logger: logger,
+	}
+	trip := newTrip()
+	tripID := trip.ID()
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	expense, _ := domain.NewExpense("expenseId", *category, 30, "EUR", 1, nil, &tripID, trip.From())
+	report := domain.NewTripReport(trip, []domain.Expense{*expense}, []domain.ExchangeRates{})
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findTripReport.On("Handle", mock.Anything, query.FindTripReportQuery{ID: "tripId"}).Return(&report, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/trips/tripId/report", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindTripReport(ctx, "tripId")
+
+	// Assert
+	findTripReport.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"dailyAverage":{"currency":"EUR","sum":"15"}`,
+		"Should return daily average.")
+	assert.Contains(t, response.Body.String(), `"days":2`, "Should return trip days.")
+}
+
+func TestFindTripReport_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findTripReport := new(mocks.FindTripReportHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindTripReport: findTripReport,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findTripReport.On("Handle", mock.Anything, query.FindTripReportQuery{ID: "tripId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/trips/tripId/report", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindTripReport(ctx, "tripId")
+
+	// Assert
+	findTripReport.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestAddExpense_Tags_PassesTagsToCommand(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addExpense := new(mocks.AddExpenseHandlerInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddExpense: addExpense,
+		},
+		Queries: app.Queries{
+			FindCategory: findCategory,
+		},
+		Logger: logger,
+	}
+	expenseID := "expenseId"
+	category, _ := domain.NewCategory("123", nil, "category", nil, 1, "path")
+
+	matchFn := func(cmd command.AddExpenseCommand) bool {
+		return reflect.DeepEqual(cmd.Tags, []string{"business", "Gift"})
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	addExpense.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&domain.AddExpenseResult{ID: expenseID}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/expenses", strings.NewReader(
+		`{"categoryId":"123","tags":["business","Gift"]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddExpense(ctx)
+
+	// Assert
+	addExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+}
+
+func TestGenerateReport_Tags_PassesTagsToQuery(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpenses := new(mocks.FindExpensesHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindExpenses: findExpenses,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	tags := []string{"business"}
+	excludeTags := []string{"gift"}
+
+	matchFn := func(query query.FindExpensesQuery) bool {
+		return reflect.DeepEqual(query.Tags, tags) && reflect.DeepEqual(query.ExcludedTags, excludeTags)
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+	findExpenses.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&domain.ReportByDate{}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports?tags=business&excludeTags=gift", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateReportParams{
+		From:        time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC),
+		Interval:    ports.IntervalMonth,
+		Tags:        &tags,
+		ExcludeTags: &excludeTags,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateReport(ctx, params)
+
+	// Assert
+	findExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestFindTags_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findTags := new(mocks.FindTagsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindTags: findTags,
+		},
+		Logger: logger,
+	}
+	tags := []domain.Tag{{Name: "business", Count: 2}}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findTags.On("Handle", mock.Anything, query.FindTagsQuery{}).Return(tags, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/tags", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindTags(ctx)
+
+	// Assert
+	findTags.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `{"count":2,"name":"business"}`, "Should return tag usage.")
+}
+
+func TestRenameTag_InvalidTag_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	renameTag := new(mocks.RenameTagHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			RenameTag: renameTag,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	renameTag.On("Handle", mock.Anything, command.RenameTagCommand{Name: "business", NewName: ""}).
+		Return(nil, domain.ErrInvalidTag)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/tags/business", strings.NewReader(`{"name":""}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.RenameTag(ctx, "business")
+
+	// Assert
+	renameTag.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestRenameTag_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	renameTag := new(mocks.RenameTagHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			RenameTag: renameTag,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	renameTag.On("Handle", mock.Anything, command.RenameTagCommand{Name: "business", NewName: "work"}).
+		Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/tags/business", strings.NewReader(`{"name":"work"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.RenameTag(ctx, "business")
+
+	// Assert
+	renameTag.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestMergeTags_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	mergeTags := new(mocks.MergeTagsHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			MergeTags: mergeTags,
+		},
+		Logger: logger,
+	}
+
+	matchFn := func(cmd command.MergeTagsCommand) bool {
+		return reflect.DeepEqual(cmd.Tags, []string{"job", "office"}) && cmd.Into == "work"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mergeTags.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&domain.UpdateResult{UpdateCount: 3}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/tags/merge", strings.NewReader(
+		`{"tags":["job","office"],"into":"work"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.MergeTags(ctx)
+
+	// Assert
+	mergeTags.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"updated":3`, "Should return number of updated expenses.")
+}
+
+func TestMergeTags_LockedExpenses_Returns409(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	mergeTags := new(mocks.MergeTagsHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			MergeTags: mergeTags,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mergeTags.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: 1 expenses with the tags", domain.ErrExpenseLocked))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/tags/merge", strings.NewReader(
+		`{"tags":["job","office"],"into":"work"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.MergeTags(ctx)
+
+	// Assert
+	mergeTags.AssertExpectations(t)
+	assert.Equal(t, http.StatusConflict, response.Code, "HTTP status should be 409.")
+}
+
+func TestAddExpense_InvalidSplit_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addExpense := new(mocks.AddExpenseHandlerInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddExpense: addExpense,
+		},
+		Queries: app.Queries{
+			FindCategory: findCategory,
+		},
+		Logger: logger,
+	}
+	category, _ := domain.NewCategory("123", nil, "category", nil, 1, "path")
+
+	matchFn := func(cmd command.AddExpenseCommand) bool {
+		return *cmd.PaidBy == "alice" && cmd.Split.Method == "percent" &&
+			reflect.DeepEqual(cmd.Split.Shares, []domain.SplitShareParams{{User: "alice", Value: 60}, {User: "bob"}})
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	addExpense.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).
+		Return(nil, fmt.Errorf("percents: %w", domain.ErrInvalidExpense))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/expenses", strings.NewReader(
+		`{"categoryId":"123","paidBy":"alice","split":{"method":"percent",`+
+			`"shares":[{"user":"alice","value":60},{"user":"bob"}]}}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddExpense(ctx)
+
+	// Assert
+	addExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestFindExpenseByID_SharedExpense_ReturnsSplitAmounts(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpense := new(mocks.FindExpenseHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindExpense: findExpense,
+		},
+		Logger: logger,
+	}
+	category, _ := domain.NewCategory("123", nil, "category", nil, 1, "path")
+	split, _ := domain.NewSplit(domain.SplitParams{
+		Method: "equal",
+		Shares: []domain.SplitShareParams{{User: "alice"}, {User: "bob"}, {User: "carol"}},
+	})
+	expense, _ := domain.NewExpense("expenseId", *category, 10, "EUR", 1, nil, nil,
+		time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC), domain.SetSplit("alice", *split))
+
+	logger.On("Infof", mock.Anything, mock.Anything, mock.Anything).Return()
+	findExpense.On("Handle", mock.Anything, mock.Anything).Return(expense, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses/expenseId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindExpenseByID(ctx, "expenseId")
+
+	// Assert
+	findExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"paidBy":"alice"`, "Should return paying user.")
+	assert.Contains(t, response.Body.String(), `{"amount":"3.34","user":"alice"}`, "Should return split amounts.")
+}
+
+func TestFindBalances_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findBalances := new(mocks.FindBalancesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindBalances: findBalances,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	currency := "USD"
+	sheet := &domain.BalanceSheet{
+		Currency: "USD",
+		Balances: []domain.Balance{
+			{User: "alice", Net: decimal.NewFromInt(5)},
+			{User: "bob", Net: decimal.NewFromInt(-5)},
+		},
+		Debts: []domain.Debt{{From: "bob", To: "alice", Amount: decimal.NewFromInt(5)}},
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findBalances.On("Handle", mock.Anything, query.FindBalancesQuery{Currency: currency}).Return(sheet, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/balances?currency=USD", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindBalances(ctx, ports.FindBalancesParams{Currency: &currency})
+
+	// Assert
+	findBalances.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"debts":[{"amount":"5.00","from":"bob","to":"alice"}]`,
+		"Should return debts.")
+}
+
+func TestFindBalances_NoExchangeRate_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findBalances := new(mocks.FindBalancesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindBalances: findBalances,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findBalances.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("calculate balances: %w", domain.ErrExchangeRateNotFound))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/balances?currency=XXX", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindBalances(ctx, ports.FindBalancesParams{})
+
+	// Assert
+	findBalances.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddSettlement_InvalidSettlement_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addSettlement := new(mocks.AddSettlementHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddSettlement: addSettlement,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addSettlement.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("amount: %w", domain.ErrInvalidSettlement))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/settlements", strings.NewReader(
+		`{"from":"bob","to":"alice","amount":0,"currency":"EUR","date":"2021-07-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddSettlement(ctx)
+
+	// Assert
+	addSettlement.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddSettlement_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addSettlement := new(mocks.AddSettlementHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddSettlement: addSettlement,
+		},
+		Logger: logger,
+	}
+	id := "settlementId"
+
+	matchFn := func(cmd command.AddSettlementCommand) bool {
+		return cmd.From == "bob" && cmd.To == "alice" && cmd.Amount == 25 && cmd.Currency == "EUR"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addSettlement.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&id, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/settlements", strings.NewReader(
+		`{"from":"bob","to":"alice","amount":25,"currency":"EUR","date":"2021-07-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddSettlement(ctx)
+
+	// Assert
+	addSettlement.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"settlementId"`, "Should return settlement ID.")
+}
+
+func newAttachmentRequest(t *testing.T, fileName string, content string) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, partErr := writer.CreateFormFile("file", fileName)
+	assert.Nil(t, partErr)
+	_, writeErr := part.Write([]byte(content))
+	assert.Nil(t, writeErr)
+	assert.Nil(t, writer.Close())
+
+	request, _ := http.NewRequest("POST", "/expenses/expenseId/attachments", body)
+	request.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+	return request
+}
+
+func TestAddAttachment_MissingFile_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addAttachment := new(mocks.AddAttachmentHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddAttachment: addAttachment,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/expenses/expenseId/attachments", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddAttachment(ctx, "expenseId")
+
+	// Assert
+	addAttachment.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddAttachment_CommandErrors_ReturnsStatus(t *testing.T) {
+	t.Parallel()
+	cases := map[string]struct {
+		err    error
+		status int
+	}{
+		"expense not found": {fmt.Errorf("expense: %w", domain.ErrExpenseNotFound), http.StatusNotFound},
+		"invalid":           {fmt.Errorf("size: %w", domain.ErrInvalidAttachment), http.StatusBadRequest},
+		"unexpected":        {errors.New("error"), http.StatusInternalServerError},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			e := echo.New()
+			logger := new(mocks.LogInterface)
+			addAttachment := new(mocks.AddAttachmentHandlerInterface)
+			app := &app.Application{
+				Commands: app.Commands{
+					AddAttachment: addAttachment,
+				},
+				Logger: logger,
+			}
+
+			logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+			logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+			addAttachment.On("Handle", mock.Anything, mock.Anything).Return(nil, tc.err)
+
+			response := httptest.NewRecorder()
+			ctx := e.NewContext(newAttachmentRequest(t, "receipt.pdf", "%PDF-1.4"), response)
+
+			// SUT
+			server := ports.NewHTTPServer(app)
+
+			// Act
+			server.AddAttachment(ctx, "expenseId")
+
+			// Assert
+			addAttachment.AssertExpectations(t)
+			assert.Equal(t, tc.status, response.Code, "Should return error HTTP status.")
+		})
+	}
+}
+
+func TestAddAttachment_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addAttachment := new(mocks.AddAttachmentHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddAttachment: addAttachment,
+		},
+		Logger: logger,
+	}
+	id := "attachmentId"
+
+	matchFn := func(cmd command.AddAttachmentCommand) bool {
+		content := new(bytes.Buffer)
+		_, _ = content.ReadFrom(cmd.Content)
+		return cmd.ExpenseID == "expenseId" && cmd.FileName == "receipt.pdf" && content.String() == "%PDF-1.4"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addAttachment.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&id, nil)
+
+	response := httptest.NewRecorder()
+	ctx := e.NewContext(newAttachmentRequest(t, "receipt.pdf", "%PDF-1.4"), response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddAttachment(ctx, "expenseId")
+
+	// Assert
+	addAttachment.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"attachmentId"`, "Should return attachment ID.")
+}
+
+func TestFindAttachments_ExpenseNotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findAttachments := new(mocks.FindAttachmentsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindAttachments: findAttachments,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findAttachments.On("Handle", mock.Anything, query.FindAttachmentsQuery{ExpenseID: "expenseId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses/expenseId/attachments", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindAttachments(ctx, "expenseId")
+
+	// Assert
+	findAttachments.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestFindAttachments_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findAttachments := new(mocks.FindAttachmentsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindAttachments: findAttachments,
+		},
+		Logger: logger,
+	}
+	thumbnailKey := "expenseId/blobId-thumbnail"
+	attachment, _ := domain.NewAttachment("attachmentId", domain.AttachmentParams{
+		ExpenseID:    "expenseId",
+		FileName:     "receipt.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		BlobKey:      "expenseId/blobId",
+		ThumbnailKey: &thumbnailKey,
+		CreatedBy:    "alice",
+		CreatedAt:    time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findAttachments.On("Handle", mock.Anything, mock.Anything).Return([]domain.Attachment{*attachment}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses/expenseId/attachments", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindAttachments(ctx, "expenseId")
+
+	// Assert
+	findAttachments.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"hasThumbnail":true`, "Should return attachment.")
+	assert.NotContains(t, response.Body.String(), "blobId", "Should not expose blob keys.")
+}
+
+func TestDownloadAttachment_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findAttachment := new(mocks.FindAttachmentContentHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindAttachment: findAttachment,
+		},
+		Logger: logger,
+	}
+	queryArgs := query.FindAttachmentContentQuery{ExpenseID: "expenseId", AttachmentID: "attachmentId", Thumbnail: true}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findAttachment.On("Handle", mock.Anything, queryArgs).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses/expenseId/attachments/attachmentId/thumbnail", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DownloadAttachmentThumbnail(ctx, "expenseId", "attachmentId")
+
+	// Assert
+	findAttachment.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDownloadAttachment_SuccessfulQuery_StreamsFile(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findAttachment := new(mocks.FindAttachmentContentHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindAttachment: findAttachment,
+		},
+		Logger: logger,
+	}
+	attachment, _ := domain.NewAttachment("attachmentId", domain.AttachmentParams{
+		ExpenseID:   "expenseId",
+		FileName:    "receipt 1.pdf",
+		ContentType: "application/pdf",
+		Size:        8,
+		BlobKey:     "expenseId/blobId",
+		CreatedAt:   time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+	})
+	content := &query.AttachmentContent{
+		Attachment:  *attachment,
+		ContentType: "application/pdf",
+		Content:     ioutil.NopCloser(strings.NewReader("%PDF-1.4")),
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findAttachment.On("Handle", mock.Anything, mock.Anything).Return(content, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/expenses/expenseId/attachments/attachmentId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DownloadAttachment(ctx, "expenseId", "attachmentId")
+
+	// Assert
+	findAttachment.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Equal(t, "application/pdf", response.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, `attachment; filename="receipt 1.pdf"`, response.Header().Get(echo.HeaderContentDisposition))
+	assert.Equal(t, "%PDF-1.4", response.Body.String(), "Should return file content.")
+}
+
+func TestDeleteAttachment_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteAttachment := new(mocks.DeleteAttachmentHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteAttachment: deleteAttachment,
+		},
+		Logger: logger,
+	}
+	cmd := command.DeleteAttachmentCommand{ExpenseID: "expenseId", AttachmentID: "attachmentId"}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteAttachment.On("Handle", mock.Anything, cmd).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/expenses/expenseId/attachments/attachmentId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteAttachment(ctx, "expenseId", "attachmentId")
+
+	// Assert
+	deleteAttachment.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDeleteAttachment_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteAttachment := new(mocks.DeleteAttachmentHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteAttachment: deleteAttachment,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteAttachment.On("Handle", mock.Anything, mock.Anything).Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/expenses/expenseId/attachments/attachmentId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteAttachment(ctx, "expenseId", "attachmentId")
+
+	// Assert
+	deleteAttachment.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func newReceipt() *domain.Receipt {
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	date := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	bread, _ := domain.NewExpense("breadId", *category, 1.5, "EUR", 2, nil, nil, date, domain.SetReceipt("receiptId"))
+	milk, _ := domain.NewExpense("milkId", *category, 0.9, "EUR", 1, nil, nil, date, domain.SetReceipt("receiptId"))
+	receipt, _ := domain.NewReceipt("receiptId", domain.ReceiptParams{Date: date, Currency: "EUR"},
+		[]domain.Expense{*bread, *milk})
+	return receipt
+}
+
+func TestAddReceipt_CategoryNotFound_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addReceipt := new(mocks.AddReceiptHandlerInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddReceipt: addReceipt,
+		},
+		Queries: app.Queries{
+			FindCategory: findCategory,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findCategory.On("Handle", mock.Anything, query.FindCategoryQuery{CategoryID: "categoryId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/receipts", strings.NewReader(
+		`{"date":"2021-07-01T00:00:00Z","currency":"EUR","items":[{"categoryId":"categoryId","price":1,"quantity":1}]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddReceipt(ctx)
+
+	// Assert
+	addReceipt.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddReceipt_InvalidReceipt_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addReceipt := new(mocks.AddReceiptHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddReceipt: addReceipt,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addReceipt.On("Handle", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidReceipt)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/receipts", strings.NewReader(
+		`{"date":"2021-07-01T00:00:00Z","currency":"EUR","items":[]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddReceipt(ctx)
+
+	// Assert
+	addReceipt.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddReceipt_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addReceipt := new(mocks.AddReceiptHandlerInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddReceipt: addReceipt,
+		},
+		Queries: app.Queries{
+			FindCategory: findCategory,
+		},
+		Logger: logger,
+	}
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	id := "receiptId"
+
+	matchFn := func(cmd command.AddReceiptCommand) bool {
+		return cmd.Currency == "EUR" && *cmd.Comment == "Grocery store" && len(cmd.Items) == 2 &&
+			cmd.Items[0].Category.ID() == "categoryId" && cmd.Items[1].Price == 0.9 && cmd.Items[0].Quantity == 2
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findCategory.On("Handle", mock.Anything, query.FindCategoryQuery{CategoryID: "categoryId"}).Return(category, nil)
+	addReceipt.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&id, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/receipts", strings.NewReader(
+		`{"date":"2021-07-01T00:00:00Z","comment":"Grocery store","currency":"EUR","items":[`+
+			`{"categoryId":"categoryId","price":1.5,"quantity":2},{"categoryId":"categoryId","price":0.9,"quantity":1}]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddReceipt(ctx)
+
+	// Assert
+	addReceipt.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"receiptId"`, "Should return receipt ID.")
+}
+
+func TestFindReceiptByID_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findReceipt := new(mocks.FindReceiptHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindReceipt: findReceipt,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findReceipt.On("Handle", mock.Anything, query.FindReceiptQuery{ReceiptID: "receiptId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/receipts/receiptId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindReceiptByID(ctx, "receiptId")
+
+	// Assert
+	findReceipt.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestFindReceiptByID_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findReceipt := new(mocks.FindReceiptHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindReceipt: findReceipt,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findReceipt.On("Handle", mock.Anything, query.FindReceiptQuery{ReceiptID: "receiptId"}).Return(newReceipt(), nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/receipts/receiptId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindReceiptByID(ctx, "receiptId")
+
+	// Assert
+	findReceipt.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"total":{"currency":"EUR","sum":"3.9"}`, "Should return receipt total.")
+	assert.Contains(t, response.Body.String(), `"receiptId":"receiptId"`, "Should return line items.")
+	assert.Contains(t, response.Body.String(), `"attachments":[]`, "Should return attachments.")
+}
+
+func TestAddReceiptAttachment_SuccessfulCommand_AttachesToFirstLineItem(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findReceipt := new(mocks.FindReceiptHandlerInterface)
+	addAttachment := new(mocks.AddAttachmentHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddAttachment: addAttachment,
+		},
+		Queries: app.Queries{
+			FindReceipt: findReceipt,
+		},
+		Logger: logger,
+	}
+	id := "attachmentId"
+
+	matchFn := func(cmd command.AddAttachmentCommand) bool {
+		return cmd.ExpenseID == "breadId" && cmd.FileName == "receipt.pdf"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findReceipt.On("Handle", mock.Anything, query.FindReceiptQuery{ReceiptID: "receiptId"}).Return(newReceipt(), nil)
+	addAttachment.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&id, nil)
+
+	response := httptest.NewRecorder()
+	ctx := e.NewContext(newAttachmentRequest(t, "receipt.pdf", "%PDF-1.4"), response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddReceiptAttachment(ctx, "receiptId")
+
+	// Assert
+	addAttachment.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"attachmentId"`, "Should return attachment ID.")
+}
+
+func TestSearch_EmptyText_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	search := new(mocks.SearchHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			Search: search,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/search?q=", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.Search(ctx, ports.SearchParams{Q: " "})
+
+	// Assert
+	search.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestSearch_QueryError_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	search := new(mocks.SearchHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			Search: search,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+	search.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/search?q=dentist", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.Search(ctx, ports.SearchParams{Q: "dentist"})
+
+	// Assert
+	search.AssertExpectations(t)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func TestSearch_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	search := new(mocks.SearchHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			Search: search,
+		},
+		Logger: logger,
+	}
+	category, _ := domain.NewCategory("dentistId", nil, "Dentist", nil, 1, "|dentistId")
+	expense, _ := domain.NewExpense("expenseId", *category, 60, "EUR", 1, nil, nil,
+		time.Date(2021, time.April, 2, 0, 0, 0, 0, time.UTC))
+	minAmount := 50.0
+	result := domain.NewSearchResult(
+		[]domain.ExpenseMatch{{Expense: *expense, Score: 1}},
+		[]domain.CategoryMatch{{Category: *category, Score: 1.5}},
+	)
+
+	matchFn := func(q query.SearchQuery) bool {
+		return q.Filter.Text() == "dentist" && q.Filter.MinAmount().String() == "50"
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	search.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&result, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/search?q=dentist&minAmount=50", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.Search(ctx, ports.SearchParams{Q: "dentist", MinAmount: &minAmount})
+
+	// Assert
+	search.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"id":"expenseId"`, "Should return matching expenses.")
+	assert.Contains(t, response.Body.String(), `"score":1.5`, "Should return category relevance.")
+}
+
+func newMerchant() domain.Merchant {
+	location := "Main St. 1"
+	merchant, _ := domain.NewMerchant("merchantId", domain.MerchantParams{
+		Name:     "Rewe",
+		Aliases:  []string{"REWE Markt"},
+		Location: &location,
+	})
+	return *merchant
+}
+
+func TestAddExpense_MerchantNotFound_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	expenseHandler := new(mocks.AddExpenseHandlerInterface)
+	findMerchantHandler := new(mocks.FindMerchantHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddExpense: expenseHandler,
+		},
+		Queries: app.Queries{
+			FindMerchant: findMerchantHandler,
+		},
+		Logger: logger,
+	}
+	expenseJSON := `{"categoryId":"123","merchantId":"merchantId"}`
+
+	findMerchantHandler.On("Handle", mock.Anything, query.FindMerchantQuery{ID: "merchantId"}).Return(nil, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/expenses", strings.NewReader(expenseJSON))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddExpense(ctx)
+
+	// Assert
+	findMerchantHandler.AssertExpectations(t)
+	expenseHandler.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+	assert.Contains(t, response.Body.String(), "merchant not found", "Should return merchant error.")
+}
+
+func TestAddMerchant_CategoryNotFound_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addMerchant := new(mocks.AddMerchantHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddMerchant: addMerchant,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addMerchant.On("Handle", mock.Anything, mock.Anything).Return(nil, domain.ErrCategoryNotFound)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/merchants", strings.NewReader(
+		`{"name":"Rewe","defaultCategoryId":"categoryId"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddMerchant(ctx)
+
+	// Assert
+	addMerchant.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddMerchant_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addMerchant := new(mocks.AddMerchantHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddMerchant: addMerchant,
+		},
+		Logger: logger,
+	}
+	merchantID := "merchantId"
+
+	matchFn := func(cmd command.AddMerchantCommand) bool {
+		return cmd.Name == "Rewe" && reflect.DeepEqual(cmd.Aliases, []string{"REWE Markt"}) &&
+			cmd.DefaultCategoryID == nil
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addMerchant.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&merchantID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/merchants", strings.NewReader(
+		`{"name":"Rewe","aliases":["REWE Markt"]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddMerchant(ctx)
+
+	// Assert
+	addMerchant.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"merchantId"`, "Should return merchant ID.")
+}
+
+func TestFindMerchants_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findMerchants := new(mocks.FindMerchantsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindMerchants: findMerchants,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findMerchants.On("Handle", mock.Anything, query.FindMerchantsQuery{}).Return([]domain.Merchant{newMerchant()}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/merchants", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindMerchants(ctx)
+
+	// Assert
+	findMerchants.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"aliases":["REWE Markt"]`, "Should return merchant aliases.")
+}
+
+func TestUpdateMerchant_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateMerchant := new(mocks.UpdateMerchantHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateMerchant: updateMerchant,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateMerchant.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/merchants/merchantId", strings.NewReader(`{"name":"Rewe"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateMerchant(ctx, "merchantId")
+
+	// Assert
+	updateMerchant.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDeleteMerchant_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteMerchant := new(mocks.DeleteMerchantHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteMerchant: deleteMerchant,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteMerchant.On("Handle", mock.Anything, command.DeleteMerchantCommand{ID: "merchantId"}).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/merchants/merchantId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteMerchant(ctx, "merchantId")
+
+	// Assert
+	deleteMerchant.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func TestMergeMerchants_SourceNotFound_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	mergeMerchants := new(mocks.MergeMerchantsHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			MergeMerchants: mergeMerchants,
+		},
+		Logger: logger,
+	}
+
+	matchFn := func(cmd command.MergeMerchantsCommand) bool {
+		return cmd.ID == "merchantId" && reflect.DeepEqual(cmd.MerchantIDs, []string{"otherId"})
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mergeMerchants.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).
+		Return(nil, fmt.Errorf("%w: merchant otherId", domain.ErrMerchantNotFound))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/merchants/merchantId/merge", strings.NewReader(
+		`{"merchantIds":["otherId"]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.MergeMerchants(ctx, "merchantId")
+
+	// Assert
+	mergeMerchants.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestMergeMerchants_LockedExpenses_Returns409(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	mergeMerchants := new(mocks.MergeMerchantsHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			MergeMerchants: mergeMerchants,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mergeMerchants.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: 1 expenses of the merged merchants", domain.ErrExpenseLocked))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/merchants/merchantId/merge", strings.NewReader(
+		`{"merchantIds":["otherId"]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.MergeMerchants(ctx, "merchantId")
+
+	// Assert
+	mergeMerchants.AssertExpectations(t)
+	assert.Equal(t, http.StatusConflict, response.Code, "HTTP status should be 409.")
+}
+
+func TestMergeMerchants_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	mergeMerchants := new(mocks.MergeMerchantsHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			MergeMerchants: mergeMerchants,
+		},
+		Logger: logger,
+	}
+	merchant := newMerchant()
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	mergeMerchants.On("Handle", mock.Anything, mock.Anything).Return(&merchant, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/merchants/merchantId/merge", strings.NewReader(
+		`{"merchantIds":["otherId"]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.MergeMerchants(ctx, "merchantId")
+
+	// Assert
+	mergeMerchants.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"name":"Rewe"`, "Should return merged merchant.")
+}
+
+func TestFindMerchantStats_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findStats := new(mocks.FindMerchantStatsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindMerchantStats: findStats,
+		},
+		Logger: logger,
+	}
+	lastVisit := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	stats := domain.MerchantStats{
+		Merchant:      newMerchant(),
+		TotalSpent:    []domain.Total{{Sum: decimal.NewFromInt(30), Currency: "EUR"}},
+		AverageTicket: []domain.Total{{Sum: decimal.NewFromInt(15), Currency: "EUR"}},
+		VisitCount:    2,
+		LastVisit:     &lastVisit,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findStats.On("Handle", mock.Anything, query.FindMerchantStatsQuery{ID: "merchantId"}).Return(&stats, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/merchants/merchantId/stats", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindMerchantStats(ctx, "merchantId")
+
+	// Assert
+	findStats.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"visitCount":2`, "Should return visit count.")
+	assert.Contains(t, response.Body.String(), `"averageTicket":[{"currency":"EUR","sum":"15"}]`,
+		"Should return average ticket.")
+}
+
+func TestFindMerchantStats_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findStats := new(mocks.FindMerchantStatsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindMerchantStats: findStats,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findStats.On("Handle", mock.Anything, query.FindMerchantStatsQuery{ID: "merchantId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/merchants/merchantId/stats", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindMerchantStats(ctx, "merchantId")
+
+	// Assert
+	findStats.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func newRule() domain.Rule {
+	comment := "coffee"
+	rule, _ := domain.NewRule("ruleId", domain.RuleParams{
+		Name:       "Coffee",
+		Priority:   1,
+		Conditions: domain.RuleConditions{Comment: &comment, Weekdays: []time.Weekday{time.Monday}},
+		Actions:    domain.RuleActions{Tags: []string{"drinks"}},
+	})
+	return *rule
+}
+
+func TestAddRule_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addRule := new(mocks.AddRuleHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddRule: addRule,
+		},
+		Logger: logger,
+	}
+	ruleID := "ruleId"
+
+	matchFn := func(cmd command.AddRuleCommand) bool {
+		return cmd.Name == "Coffee" && cmd.Priority == 1 && *cmd.Conditions.Comment == "coffee" &&
+			reflect.DeepEqual(cmd.Conditions.Weekdays, []time.Weekday{time.Saturday}) && cmd.Actions.Reimbursable
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addRule.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&ruleID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/rules", strings.NewReader(
+		`{"name":"Coffee","priority":1,"conditions":{"comment":"coffee","weekdays":["saturday"]},`+
+			`"actions":{"reimbursable":true}}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddRule(ctx)
+
+	// Assert
+	addRule.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"ruleId"`, "Should return rule ID.")
+}
+
+func TestAddRule_InvalidWeekday_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addRule := new(mocks.AddRuleHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddRule: addRule,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/rules", strings.NewReader(
+		`{"name":"Coffee","conditions":{"weekdays":["someday"]},"actions":{"reimbursable":true}}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddRule(ctx)
+
+	// Assert
+	addRule.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddRule_InvalidRule_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addRule := new(mocks.AddRuleHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddRule: addRule,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addRule.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: rule should have at least one condition", domain.ErrInvalidRule))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/rules", strings.NewReader(
+		`{"name":"Coffee","conditions":{},"actions":{"reimbursable":true}}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddRule(ctx)
+
+	// Assert
+	addRule.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestFindRules_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findRules := new(mocks.FindRulesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindRules: findRules,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findRules.On("Handle", mock.Anything, query.FindRulesQuery{}).Return([]domain.Rule{newRule()}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/rules", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindRules(ctx)
+
+	// Assert
+	findRules.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"weekdays":["monday"]`, "Should return rule weekdays.")
+}
+
+func TestFindRuleByID_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findRule := new(mocks.FindRuleHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindRule: findRule,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findRule.On("Handle", mock.Anything, query.FindRuleQuery{ID: "ruleId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/rules/ruleId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindRuleByID(ctx, "ruleId")
+
+	// Assert
+	findRule.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestUpdateRule_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateRule := new(mocks.UpdateRuleHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateRule: updateRule,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateRule.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/rules/ruleId", strings.NewReader(
+		`{"name":"Coffee","conditions":{"comment":"coffee"},"actions":{"tags":["drinks"]}}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateRule(ctx, "ruleId")
+
+	// Assert
+	updateRule.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDeleteRule_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteRule := new(mocks.DeleteRuleHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteRule: deleteRule,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteRule.On("Handle", mock.Anything, command.DeleteRuleCommand{ID: "ruleId"}).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/rules/ruleId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteRule(ctx, "ruleId")
+
+	// Assert
+	deleteRule.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func TestTestRule_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	testRule := new(mocks.TestRuleHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			TestRule: testRule,
+		},
+		Logger: logger,
+	}
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	comment := "Coffee"
+	expense, _ := domain.NewExpense("expenseId", *category, 3, "EUR", 1, &comment, nil, time.Now())
+
+	matchFn := func(q query.TestRuleQuery) bool {
+		return q.Rule.Name == "Coffee" && *q.Limit == 50
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	testRule.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).
+		Return(&domain.RuleTest{Checked: 50, Matches: []domain.Expense{*expense}}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/rules/test?limit=50", strings.NewReader(
+		`{"name":"Coffee","conditions":{"comment":"coffee"},"actions":{"tags":["drinks"]}}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+	limit := 50
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.TestRule(ctx, ports.TestRuleParams{Limit: &limit})
+
+	// Assert
+	testRule.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"checked":50`, "Should return number of checked expenses.")
+	assert.Contains(t, response.Body.String(), `"id":"expenseId"`, "Should return matching expenses.")
+}
+
+func TestApplyRules_InvalidRange_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	applyRules := new(mocks.ApplyRulesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ApplyRules: applyRules,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	applyRules.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: from date should be before to date", domain.ErrInvalidRule))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/rules/apply", strings.NewReader(
+		`{"from":"2021-08-01T00:00:00Z","to":"2021-07-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ApplyRules(ctx)
+
+	// Assert
+	applyRules.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestApplyRules_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	applyRules := new(mocks.ApplyRulesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ApplyRules: applyRules,
+		},
+		Logger: logger,
+	}
+
+	matchFn := func(cmd command.ApplyRulesCommand) bool {
+		return cmd.From.Equal(time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)) &&
+			cmd.To.Equal(time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC))
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	applyRules.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).
+		Return(&domain.UpdateResult{UpdateCount: 3}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/rules/apply", strings.NewReader(
+		`{"from":"2021-07-01T00:00:00Z","to":"2021-08-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ApplyRules(ctx)
+
+	// Assert
+	applyRules.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"updated":3`, "Should return number of updated expenses.")
+}
+
+func TestAddExpense_LikelyDuplicate_ReturnsWarning(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findCategory := new(mocks.FindExpenseCategoryHandlerInterface)
+	addExpense := new(mocks.AddExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddExpense: addExpense,
+		},
+		Queries: app.Queries{
+			FindCategory: findCategory,
+		},
+		Logger: logger,
+	}
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	duplicate, _ := domain.NewExpense("duplicateId", *category, 45.5, "EUR", 1, nil, nil, time.Now())
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findCategory.On("Handle", mock.Anything, mock.Anything).Return(category, nil)
+	addExpense.On("Handle", mock.Anything, mock.Anything).
+		Return(&domain.AddExpenseResult{ID: "expenseId", Duplicates: []domain.Expense{*duplicate}}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/expenses", strings.NewReader(
+		`{"categoryId":"categoryId","price":45.5,"currency":"EUR","quantity":1,"date":"2021-07-10T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddExpense(ctx)
+
+	// Assert
+	addExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"expenseId"`, "Should return expense ID.")
+	assert.Contains(t, response.Body.String(), `"id":"duplicateId"`, "Should return likely duplicates.")
+}
+
+func TestFindDuplicates_InvalidCriteria_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findDuplicates := new(mocks.FindDuplicatesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindDuplicates: findDuplicates,
+		},
+		Logger: logger,
+	}
+	days := 40
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findDuplicates.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: days should be between 0 and 31", domain.ErrInvalidDuplicateCriteria))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/duplicates", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindDuplicates(ctx, ports.FindDuplicatesParams{Days: &days})
+
+	// Assert
+	findDuplicates.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestFindDuplicates_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findDuplicates := new(mocks.FindDuplicatesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindDuplicates: findDuplicates,
+		},
+		Logger: logger,
+	}
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	first, _ := domain.NewExpense("firstId", *category, 45.5, "EUR", 1, nil, nil, time.Now())
+	second, _ := domain.NewExpense("secondId", *category, 45.5, "EUR", 1, nil, nil, time.Now())
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findDuplicates.On("Handle", mock.Anything, query.FindDuplicatesQuery{From: from, To: to}).
+		Return([]domain.DuplicateGroup{{ID: "firstId-secondId", Expenses: []domain.Expense{*first, *second}}}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/duplicates", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindDuplicates(ctx, ports.FindDuplicatesParams{From: from, To: to})
+
+	// Assert
+	findDuplicates.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"id":"firstId-secondId"`, "Should return duplicate groups.")
+}
+
+func TestResolveDuplicates_NotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	resolveDuplicates := new(mocks.ResolveDuplicatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ResolveDuplicates: resolveDuplicates,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	resolveDuplicates.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/duplicates/firstId-secondId/resolve", strings.NewReader(
+		`{"keepId":"firstId","action":"delete"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ResolveDuplicates(ctx, "firstId-secondId")
+
+	// Assert
+	resolveDuplicates.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestResolveDuplicates_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	resolveDuplicates := new(mocks.ResolveDuplicatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			ResolveDuplicates: resolveDuplicates,
+		},
+		Logger: logger,
+	}
+	category, _ := domain.NewCategory("categoryId", nil, "Food", nil, 1, "|categoryId")
+	kept, _ := domain.NewExpense("firstId", *category, 45.5, "EUR", 1, nil, nil, time.Now())
+
+	matchFn := func(cmd command.ResolveDuplicatesCommand) bool {
+		return cmd.GroupID == "firstId-secondId" && cmd.KeepID == "firstId" &&
+			cmd.Resolution == domain.DuplicateResolutionMerge
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	resolveDuplicates.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(kept, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/duplicates/firstId-secondId/resolve", strings.NewReader(
+		`{"keepId":"firstId","action":"merge"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.ResolveDuplicates(ctx, "firstId-secondId")
+
+	// Assert
+	resolveDuplicates.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"id":"firstId"`, "Should return kept expense.")
+}
+
+func TestAddIncome_ExpenseCategory_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addIncome := new(mocks.AddIncomeHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddIncome: addIncome,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addIncome.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: category foodId is not an income category", domain.ErrInvalidIncome))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/incomes", strings.NewReader(
+		`{"source":"ACME","categoryId":"foodId","amount":3200,"currency":"EUR","date":"2021-07-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddIncome(ctx)
+
+	// Assert
+	addIncome.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddIncome_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addIncome := new(mocks.AddIncomeHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddIncome: addIncome,
+		},
+		Logger: logger,
+	}
+	incomeID := "incomeId"
+
+	matchFn := func(cmd command.AddIncomeCommand) bool {
+		return cmd.Source == "ACME" && cmd.CategoryID == "salaryId" && cmd.Amount == 3200
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addIncome.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&incomeID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/incomes", strings.NewReader(
+		`{"source":"ACME","categoryId":"salaryId","amount":3200,"currency":"EUR","date":"2021-07-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddIncome(ctx)
+
+	// Assert
+	addIncome.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"incomeId"`, "Should return income ID.")
+}
+
+func TestFindIncomes_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findIncomes := new(mocks.FindIncomesHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindIncomes: findIncomes,
+		},
+		Logger: logger,
+	}
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC)
+	income, _ := domain.NewIncome("incomeId", domain.IncomeParams{
+		Source: "ACME", CategoryID: "salaryId", Amount: 3200, Currency: "EUR", Date: from,
+	})
+
+	matchFn := func(query query.FindIncomesQuery) bool {
+		return query.DateRange.From() == from && query.DateRange.To() == to
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findIncomes.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return([]domain.Income{*income}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/incomes", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindIncomes(ctx, ports.FindIncomesParams{From: from, To: to})
+
+	// Assert
+	findIncomes.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"source":"ACME"`, "Should return incomes.")
+}
+
+func TestUpdateIncome_NilCommandResult_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateIncome := new(mocks.UpdateIncomeHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateIncome: updateIncome,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateIncome.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/incomes/incomeId", strings.NewReader(
+		`{"source":"ACME","categoryId":"salaryId","amount":3200,"currency":"EUR","date":"2021-07-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateIncome(ctx, "incomeId")
+
+	// Assert
+	updateIncome.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDeleteIncome_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteIncome := new(mocks.DeleteIncomeHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteIncome: deleteIncome,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteIncome.On("Handle", mock.Anything, command.DeleteIncomeCommand{ID: "incomeId"}).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/incomes/incomeId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteIncome(ctx, "incomeId")
+
+	// Assert
+	deleteIncome.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func TestGenerateReport_PeriodStartDay_PassesSettingsToQuery(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpenses := new(mocks.FindExpensesHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindExpenses: findExpenses,
+			FindSettings: newFindSettingsHandler(25),
+		},
+		Logger: logger,
+	}
+
+	matchFn := func(query query.FindExpensesQuery) bool {
+		return query.PeriodStartDay == 25 && query.Interval == "quarter"
+	}
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+	findExpenses.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&domain.ReportByDate{}, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateReportParams{
+		From:     time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+		Interval: ports.IntervalQuarter,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateReport(ctx, params)
+
+	// Assert
+	findExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestGenerateReport_FailedSettingsQuery_Returns500(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpenses := new(mocks.FindExpensesHandlerInterface)
+	findSettings := new(mocks.FindSettingsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindExpenses: findExpenses,
+			FindSettings: findSettings,
+		},
+		Logger: logger,
+	}
+
+	findSettings.On("Handle", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	logger.On("Error", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateReportParams{
+		From:     time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+		Interval: ports.IntervalMonth,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateReport(ctx, params)
+
+	// Assert
+	findExpenses.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusInternalServerError, response.Code, "HTTP status should be 500.")
+}
+
+func TestGenerateReport_Currency_PassesCurrencyToQuery(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	type test struct {
+		currency *string
+		expected string
+	}
+	usd := "usd"
+	tests := []test{
+		{currency: nil, expected: "EUR"},
+		{currency: &usd, expected: "usd"},
+	}
+
+	for _, tc := range tests {
+		e := echo.New()
+		logger := new(mocks.LogInterface)
+		findExpenses := new(mocks.FindExpensesHandlerInterface)
+		fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+		app := &app.Application{
+			Commands: app.Commands{
+				FetchExchangeRates: fetchRates,
+			},
+			Queries: app.Queries{
+				FindExpenses: findExpenses,
+				FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+			},
+			Logger: logger,
+		}
+		expected := tc.expected
+
+		matchFn := func(query query.FindExpensesQuery) bool {
+			return query.Currency == expected
+		}
+		fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+		findExpenses.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).
+			Return(&domain.ReportByDate{Currency: domain.Currency(strings.ToUpper(expected))}, nil)
+		logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+		response := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", "/reports", nil)
+		ctx := e.NewContext(request, response)
+		params := ports.GenerateReportParams{
+			From:     time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+			To:       time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+			Interval: ports.IntervalMonth,
+			Currency: tc.currency,
+		}
+
+		// SUT
+		server := ports.NewHTTPServer(app)
+
+		// Act
+		server.GenerateReport(ctx, params)
+
+		// Assert
+		findExpenses.AssertExpectations(t)
+		assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+		assert.Contains(t, response.Body.String(), `"currency":"`+strings.ToUpper(expected)+`"`,
+			"Should return report currency.")
+	}
+}
+
+func TestGenerateReport_InvalidCurrency_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpenses := new(mocks.FindExpensesHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindExpenses: findExpenses,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	currency := "XYZ"
+
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+	findExpenses.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: no XYZ exchange rate", domain.ErrInvalidReportCurrency))
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateReportParams{
+		From:     time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+		Interval: ports.IntervalMonth,
+		Currency: &currency,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateReport(ctx, params)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestGenerateReport_ReportFilter_PassesFilterToQuery(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpenses := new(mocks.FindExpensesHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindExpenses: findExpenses,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	categories := []string{"foodId"}
+	excludeCategories := []string{"restaurantsId"}
+	currencies := []string{"EUR"}
+	tripID := "tripId"
+	minAmount, maxAmount := 10.0, 100.0
+	comment := "pizza"
+
+	matchFn := func(findQuery query.FindExpensesQuery) bool {
+		return reflect.DeepEqual(findQuery.ReportFilter, query.ReportFilter{
+			Categories:         categories,
+			ExcludedCategories: excludeCategories,
+			OriginalCurrencies: currencies,
+			TripID:             tripID,
+			MinAmount:          &minAmount,
+			MaxAmount:          &maxAmount,
+			Comment:            comment,
+		})
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+	findExpenses.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&domain.ReportByDate{}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports?categories=foodId&excludeCategories=restaurantsId", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateReportParams{
+		From:               time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:                 time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+		Interval:           ports.IntervalMonth,
+		Categories:         &categories,
+		ExcludeCategories:  &excludeCategories,
+		OriginalCurrencies: &currencies,
+		TripId:             &tripID,
+		MinAmount:          &minAmount,
+		MaxAmount:          &maxAmount,
+		Comment:            &comment,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateReport(ctx, params)
+
+	// Assert
+	findExpenses.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestGenerateReport_InvalidReportFilter_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findExpenses := new(mocks.FindExpensesHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindExpenses: findExpenses,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	minAmount, maxAmount := 100.0, 10.0
+
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+	findExpenses.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: min amount could not be greater than max amount", domain.ErrInvalidReportFilter))
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateReportParams{
+		From:      time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+		Interval:  ports.IntervalMonth,
+		MinAmount: &minAmount,
+		MaxAmount: &maxAmount,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateReport(ctx, params)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestFindSettings_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindSettings: newFindSettingsHandler(25),
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/settings", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindSettings(ctx)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.JSONEq(t, `{"periodStartDay":25,"reportCurrency":"EUR"}`, response.Body.String(), "Should return settings.")
+}
+
+func TestUpdateSettings_SuccessfulCommand_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateSettings := new(mocks.UpdateSettingsHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateSettings: updateSettings,
+		},
+		Logger: logger,
+	}
+	settings, _ := domain.NewSettings("user", domain.SettingsParams{PeriodStartDay: 25, ReportCurrency: "USD"})
+
+	matchFn := func(cmd command.UpdateSettingsCommand) bool {
+		return cmd.PeriodStartDay == 25 && cmd.ReportCurrency == "usd"
+	}
+	updateSettings.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(settings, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/settings", strings.NewReader(`{"periodStartDay":25,"reportCurrency":"usd"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateSettings(ctx)
+
+	// Assert
+	updateSettings.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.JSONEq(t, `{"periodStartDay":25,"reportCurrency":"USD"}`, response.Body.String(), "Should return settings.")
+}
+
+func TestUpdateSettings_InvalidSettings_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateSettings := new(mocks.UpdateSettingsHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateSettings: updateSettings,
+		},
+		Logger: logger,
+	}
+
+	updateSettings.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: period start day", domain.ErrInvalidSettings))
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/settings", strings.NewReader(`{"periodStartDay":31}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateSettings(ctx)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestGenerateCategoryReport_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findCategoryReport := new(mocks.FindCategoryReportHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindCategoryReport: findCategoryReport,
+			FindSettings:       newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.August, 31, 0, 0, 0, 0, time.UTC)
+	rate, _ := domain.NewExchageRate(from, "EUR", map[string]float64{"USD": 2})
+	rates := []domain.ExchangeRates{*rate}
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	august := time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC)
+	report := &domain.ReportByCategory{
+		Dates: []time.Time{from, august},
+		SubCategories: []*domain.CategorySeries{{
+			Category: *category,
+			Series:   []domain.IntervalTotal{{Date: from}, {Date: august}},
+		}},
+	}
+
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return(rates, nil)
+	matchFindFn := func(query query.FindCategoryReportQuery) bool {
+		return query.DateRange.From() == from && query.Interval == "month" && len(query.ExchangeRates) == 1 &&
+			len(query.Tags) == 1 && query.Tags[0] == "vacation"
+	}
+	findCategoryReport.On("Handle", mock.Anything, mock.MatchedBy(matchFindFn)).Return(report, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports/by-category", nil)
+	ctx := e.NewContext(request, response)
+	tags := []string{"vacation"}
+	params := ports.GenerateCategoryReportParams{
+		From:     from,
+		To:       to,
+		Interval: ports.IntervalMonth,
+		Tags:     &tags,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateCategoryReport(ctx, params)
+
+	// Assert
+	findCategoryReport.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"series":[{"date":"2021-07-01T00:00:00Z"`, "Should return series.")
+}
+
+func TestGenerateCategoryReport_InvalidDateRange_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	app := &app.Application{
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports/by-category", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateCategoryReportParams{
+		From:     time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		Interval: ports.IntervalMonth,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateCategoryReport(ctx, params)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestGenerateCashFlowReport_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findCashFlow := new(mocks.FindCashFlowHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindCashFlow: findCashFlow,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC)
+	rate, _ := domain.NewExchageRate(from, "EUR", map[string]float64{"USD": 2})
+	rates := []domain.ExchangeRates{*rate}
+	savingsRate := decimal.NewFromInt(25)
+	report := &domain.CashFlowReport{
+		Currency: domain.DefaultReportCurrency,
+		Periods: []domain.CashFlowPeriod{{
+			Date:        from,
+			Net:         domain.Total{Sum: decimal.NewFromInt(800), Currency: domain.DefaultReportCurrency},
+			SavingsRate: &savingsRate,
+		}},
+	}
+
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return(rates, nil)
+	matchFindFn := func(query query.FindCashFlowQuery) bool {
+		return query.DateRange.From() == from && query.Interval == "month" && len(query.ExchangeRates) == 1
+	}
+	findCashFlow.On("Handle", mock.Anything, mock.MatchedBy(matchFindFn)).Return(report, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports/cashflow", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateCashFlowReportParams{
+		From:     from,
+		To:       to,
+		Interval: ports.IntervalMonth,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateCashFlowReport(ctx, params)
+
+	// Assert
+	findCashFlow.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"savingsRate":"25.00"`, "Should return savings rate.")
+}
+
+func TestGenerateCashFlowReport_Currency_PassesCurrencyToQuery(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findCashFlow := new(mocks.FindCashFlowHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindCashFlow: findCashFlow,
+			FindSettings: newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	currency := "usd"
+
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+	matchFindFn := func(query query.FindCashFlowQuery) bool {
+		return query.Currency == currency
+	}
+	findCashFlow.On("Handle", mock.Anything, mock.MatchedBy(matchFindFn)).
+		Return(&domain.CashFlowReport{Currency: "USD"}, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports/cashflow?currency=usd", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateCashFlowReportParams{
+		From:     time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC),
+		Interval: ports.IntervalMonth,
+		Currency: &currency,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateCashFlowReport(ctx, params)
+
+	// Assert
+	findCashFlow.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+}
+
+func TestGenerateCashFlowReport_InvalidDateRange_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	app := &app.Application{
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports/cashflow", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateCashFlowReportParams{
+		From:     time.Date(2021, time.August, 1, 0, 0, 0, 0, time.UTC),
+		To:       time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		Interval: ports.IntervalMonth,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateCashFlowReport(ctx, params)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddExpense_AccountNotFound_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	expenseHandler := new(mocks.AddExpenseHandlerInterface)
+	findAccountHandler := new(mocks.FindAccountHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddExpense: expenseHandler,
+		},
+		Queries: app.Queries{
+			FindAccount: findAccountHandler,
+		},
+		Logger: logger,
+	}
+	expenseJSON := `{"categoryId":"123","accountId":"accountId"}`
+
+	findAccountHandler.On("Handle", mock.Anything, query.FindAccountQuery{ID: "accountId"}).Return(nil, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/expenses", strings.NewReader(expenseJSON))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddExpense(ctx)
+
+	// Assert
+	findAccountHandler.AssertExpectations(t)
+	expenseHandler.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+	assert.Contains(t, response.Body.String(), "account not found", "Should return account error.")
+}
+
+func TestAddAccount_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addAccount := new(mocks.AddAccountHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddAccount: addAccount,
+		},
+		Logger: logger,
+	}
+	accountID := "accountId"
+
+	matchFn := func(cmd command.AddAccountCommand) bool {
+		return cmd.Name == "Joint card" && cmd.Type == "card" && cmd.OpeningBalance == 500
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addAccount.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&accountID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/accounts", strings.NewReader(
+		`{"name":"Joint card","type":"card","currency":"EUR","openingBalance":500}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddAccount(ctx)
+
+	// Assert
+	addAccount.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"accountId"`, "Should return account ID.")
+}
+
+func TestAddAccount_InvalidAccount_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addAccount := new(mocks.AddAccountHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddAccount: addAccount,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addAccount.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: unknown account type crypto", domain.ErrInvalidAccount))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/accounts", strings.NewReader(
+		`{"name":"Wallet","type":"crypto","currency":"EUR","openingBalance":0}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddAccount(ctx)
+
+	// Assert
+	addAccount.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestFindAccounts_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findAccounts := new(mocks.FindAccountsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindAccounts: findAccounts,
+		},
+		Logger: logger,
+	}
+	account, _ := domain.NewAccount("accountId", domain.AccountParams{
+		Name: "Wallet", Type: "cash", Currency: "EUR",
+	})
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findAccounts.On("Handle", mock.Anything, query.FindAccountsQuery{}).Return([]domain.Account{*account}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/accounts", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindAccounts(ctx)
+
+	// Assert
+	findAccounts.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"type":"cash"`, "Should return accounts.")
+}
+
+func TestUpdateAccount_NilCommandResult_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	updateAccount := new(mocks.UpdateAccountHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UpdateAccount: updateAccount,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	updateAccount.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/accounts/accountId", strings.NewReader(
+		`{"name":"Wallet","type":"cash","currency":"EUR","openingBalance":0}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UpdateAccount(ctx, "accountId")
+
+	// Assert
+	updateAccount.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestDeleteAccount_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteAccount := new(mocks.DeleteAccountHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteAccount: deleteAccount,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteAccount.On("Handle", mock.Anything, command.DeleteAccountCommand{ID: "accountId"}).
+		Return(&domain.DeleteResult{DeleteCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/accounts/accountId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteAccount(ctx, "accountId")
+
+	// Assert
+	deleteAccount.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func TestFindAccountBalance_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findBalance := new(mocks.FindAccountBalanceHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindAccountBalance: findBalance,
+		},
+		Logger: logger,
+	}
+	at := time.Date(2021, time.July, 5, 0, 0, 0, 0, time.UTC)
+	account, _ := domain.NewAccount("accountId", domain.AccountParams{
+		Name: "Wallet", Type: "cash", Currency: "EUR", OpeningBalance: 100,
+	})
+	balance, _ := domain.NewAccountBalance(*account, at, nil, nil, nil)
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findBalance.On("Handle", mock.Anything, query.FindAccountBalanceQuery{ID: "accountId", At: &at}).
+		Return(balance, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/accounts/accountId/balance", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindAccountBalance(ctx, "accountId", ports.FindAccountBalanceParams{At: &at})
+
+	// Assert
+	findBalance.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"balance":"100.00"`, "Should return account balance.")
+}
+
+func TestFindAccountBalance_NilQueryResult_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findBalance := new(mocks.FindAccountBalanceHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindAccountBalance: findBalance,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findBalance.On("Handle", mock.Anything, mock.Anything).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/accounts/accountId/balance", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindAccountBalance(ctx, "accountId", ports.FindAccountBalanceParams{})
+
+	// Assert
+	findBalance.AssertExpectations(t)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestAddTransfer_AccountNotFound_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addTransfer := new(mocks.AddTransferHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddTransfer: addTransfer,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addTransfer.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: account cashId", domain.ErrAccountNotFound))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/transfers", strings.NewReader(
+		`{"fromAccountId":"cardId","toAccountId":"cashId","amount":100,"date":"2021-07-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddTransfer(ctx)
+
+	// Assert
+	addTransfer.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestAddTransfer_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addTransfer := new(mocks.AddTransferHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddTransfer: addTransfer,
+		},
+		Logger: logger,
+	}
+	transferID := "transferId"
+
+	matchFn := func(cmd command.AddTransferCommand) bool {
+		return cmd.FromAccountID == "cardId" && cmd.ToAccountID == "usdId" && cmd.Rate != nil && *cmd.Rate == 1.2
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addTransfer.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&transferID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/transfers", strings.NewReader(
+		`{"fromAccountId":"cardId","toAccountId":"usdId","amount":100,"rate":1.2,"date":"2021-07-01T00:00:00Z"}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddTransfer(ctx)
+
+	// Assert
+	addTransfer.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"transferId"`, "Should return transfer ID.")
+}
+
+func TestDeleteExpense_LockedExpense_Returns409(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	deleteExpense := new(mocks.DeleteExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			DeleteExpense: deleteExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	deleteExpense.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: expense expenseId", domain.ErrExpenseLocked))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("DELETE", "/expenses/expenseId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.DeleteExpense(ctx, "expenseId")
+
+	// Assert
+	deleteExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusConflict, response.Code, "HTTP status should be 409.")
+}
+
+func TestUnlockExpense_SuccessfulCommand_Returns204(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	unlockExpense := new(mocks.UnlockExpenseHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			UnlockExpense: unlockExpense,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	unlockExpense.On("Handle", mock.Anything, command.UnlockExpenseCommand{ExpenseID: "expenseId"}).
+		Return(&domain.UpdateResult{UpdateCount: 1}, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/expenses/expenseId/unlock", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.UnlockExpense(ctx, "expenseId")
+
+	// Assert
+	unlockExpense.AssertExpectations(t)
+	assert.Equal(t, http.StatusNoContent, response.Code, "HTTP status should be 204.")
+}
+
+func TestAddReconciliation_SuccessfulCommand_Returns201(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	addReconciliation := new(mocks.AddReconciliationHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			AddReconciliation: addReconciliation,
+		},
+		Logger: logger,
+	}
+	reconciliationID := "reconciliationId"
+
+	matchFn := func(cmd command.AddReconciliationCommand) bool {
+		return cmd.AccountID == "accountId" && cmd.StatementBalance == 90.5
+	}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	addReconciliation.On("Handle", mock.Anything, mock.MatchedBy(matchFn)).Return(&reconciliationID, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/accounts/accountId/reconciliations", strings.NewReader(
+		`{"statementDate":"2021-07-31T00:00:00Z","statementBalance":90.5}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.AddReconciliation(ctx, "accountId")
+
+	// Assert
+	addReconciliation.AssertExpectations(t)
+	assert.Equal(t, http.StatusCreated, response.Code, "HTTP status should be 201.")
+	assert.Contains(t, response.Body.String(), `"id":"reconciliationId"`, "Should return reconciliation ID.")
+}
+
+func TestFindReconciliations_AccountNotFound_Returns404(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findAccount := new(mocks.FindAccountHandlerInterface)
+	findReconciliations := new(mocks.FindReconciliationsHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindAccount:         findAccount,
+			FindReconciliations: findReconciliations,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findAccount.On("Handle", mock.Anything, query.FindAccountQuery{ID: "accountId"}).Return(nil, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/accounts/accountId/reconciliations", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindReconciliations(ctx, "accountId")
+
+	// Assert
+	findReconciliations.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusNotFound, response.Code, "HTTP status should be 404.")
+}
+
+func TestFindReconciliationByID_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findReconciliation := new(mocks.FindReconciliationHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindReconciliation: findReconciliation,
+		},
+		Logger: logger,
+	}
+	date := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	account, _ := domain.NewAccount("accountId", domain.AccountParams{
+		Name: "Joint card", Type: "card", Currency: "EUR", OpeningBalance: 100,
+	})
+	reconciliation, _ := domain.NewReconciliation("reconciliationId", domain.ReconciliationParams{
+		AccountID: "accountId", StatementDate: date, StatementBalance: 90,
+	})
+	report, _ := domain.NewReconciliationReport(*reconciliation, *account, nil, nil, nil)
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	findReconciliation.On("Handle", mock.Anything, query.FindReconciliationQuery{ID: "reconciliationId"}).
+		Return(report, nil)
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reconciliations/reconciliationId", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FindReconciliationByID(ctx, "reconciliationId")
+
+	// Assert
+	findReconciliation.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"difference":"-10.00"`, "Should return statement difference.")
+	assert.Contains(t, response.Body.String(), `"status":"open"`, "Should return reconciliation status.")
+}
+
+func TestTickReconciliation_InvalidExpenses_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	tickReconciliation := new(mocks.TickReconciliationHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			TickReconciliation: tickReconciliation,
+		},
+		Logger: logger,
+	}
+
+	cmd := command.TickReconciliationCommand{ID: "reconciliationId", ExpenseIDs: []string{"lockedId"}}
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	tickReconciliation.On("Handle", mock.Anything, cmd).
+		Return(nil, fmt.Errorf("%w: expense lockedId could not be reconciled", domain.ErrInvalidReconciliation))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/reconciliations/reconciliationId/expenses",
+		strings.NewReader(`{"expenseIds":["lockedId"]}`))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.TickReconciliation(ctx, "reconciliationId")
+
+	// Assert
+	tickReconciliation.AssertExpectations(t)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestFinishReconciliation_Difference_Returns409(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	finishReconciliation := new(mocks.FinishReconciliationHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FinishReconciliation: finishReconciliation,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+	finishReconciliation.On("Handle", mock.Anything, command.FinishReconciliationCommand{ID: "reconciliationId"}).
+		Return(nil, fmt.Errorf("%w: difference 10.00 EUR should be zero", domain.ErrInvalidReconciliation))
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/reconciliations/reconciliationId/finish", nil)
+	ctx := e.NewContext(request, response)
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.FinishReconciliation(ctx, "reconciliationId")
+
+	// Assert
+	finishReconciliation.AssertExpectations(t)
+	assert.Equal(t, http.StatusConflict, response.Code, "HTTP status should be 409.")
+}
+
+func TestGenerateComparisonReport_SuccessfulQuery_Returns200(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findComparison := new(mocks.FindComparisonReportHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindComparison: findComparison,
+			FindSettings:   newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	from := time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC)
+	compareFrom := time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC)
+	compareTo := time.Date(2021, time.June, 30, 0, 0, 0, 0, time.UTC)
+	rate, _ := domain.NewExchageRate(from, "EUR", map[string]float64{"USD": 2})
+	category, _ := domain.NewCategory("foodId", nil, "Food", nil, 1, "|foodId")
+	percent := decimal.NewFromInt(20)
+	delta := domain.TotalDelta{
+		Total:   domain.Total{Sum: decimal.NewFromInt(100), Currency: "EUR"},
+		Delta:   domain.Total{Sum: decimal.NewFromInt(20), Currency: "EUR"},
+		Percent: &percent,
+	}
+	report := &domain.ComparisonReport{
+		Currency:    "EUR",
+		Base:        domain.Report{From: from, To: to},
+		Periods:     []domain.Report{{From: compareFrom, To: compareTo}},
+		Average:     true,
+		Total:       domain.Total{Sum: decimal.NewFromInt(120), Currency: "EUR"},
+		Comparisons: []domain.TotalDelta{delta},
+		SubCategories: []*domain.CategoryComparison{{
+			Category:    *category,
+			Total:       domain.Total{Sum: decimal.NewFromInt(120), Currency: "EUR"},
+			Comparisons: []domain.TotalDelta{delta},
+		}},
+	}
+
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{*rate}, nil)
+	matchFindFn := func(query query.FindComparisonReportQuery) bool {
+		return query.Base.From() == from && len(query.Comparisons) == 1 &&
+			query.Comparisons[0].From() == compareFrom && query.AverageBy == "month" &&
+			len(query.ExchangeRates) == 2
+	}
+	findComparison.On("Handle", mock.Anything, mock.MatchedBy(matchFindFn)).Return(report, nil)
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports/compare", nil)
+	ctx := e.NewContext(request, response)
+	averageBy := ports.IntervalMonth
+	params := ports.GenerateComparisonReportParams{
+		From:        from,
+		To:          to,
+		CompareFrom: []time.Time{compareFrom},
+		CompareTo:   []time.Time{compareTo},
+		AverageBy:   &averageBy,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateComparisonReport(ctx, params)
+
+	// Assert
+	fetchRates.AssertNumberOfCalls(t, "Handle", 2)
+	findComparison.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, response.Code, "HTTP status should be 200.")
+	assert.Contains(t, response.Body.String(), `"delta":{"currency":"EUR","sum":"20"},"percent":"20.00"`,
+		"Should return deltas.")
+}
+
+func TestGenerateComparisonReport_MismatchedPeriods_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findComparison := new(mocks.FindComparisonReportHandlerInterface)
+	app := &app.Application{
+		Queries: app.Queries{
+			FindComparison: findComparison,
+		},
+		Logger: logger,
+	}
+
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports/compare", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateComparisonReportParams{
+		From:        time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC),
+		CompareFrom: []time.Time{time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateComparisonReport(ctx, params)
+
+	// Assert
+	findComparison.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
+
+func TestGenerateComparisonReport_InvalidReportFilter_Returns400(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	e := echo.New()
+	logger := new(mocks.LogInterface)
+	findComparison := new(mocks.FindComparisonReportHandlerInterface)
+	fetchRates := new(mocks.FetchExchangeRatesHandlerInterface)
+	app := &app.Application{
+		Commands: app.Commands{
+			FetchExchangeRates: fetchRates,
+		},
+		Queries: app.Queries{
+			FindComparison: findComparison,
+			FindSettings:   newFindSettingsHandler(domain.DefaultPeriodStartDay),
+		},
+		Logger: logger,
+	}
+	averageBy := ports.Interval("century")
+
+	fetchRates.On("Handle", mock.Anything, mock.Anything).Return([]domain.ExchangeRates{}, nil)
+	findComparison.On("Handle", mock.Anything, mock.Anything).
+		Return(nil, fmt.Errorf("%w: unknown interval century", domain.ErrInvalidReportFilter))
+	logger.On("Info", mock.Anything, mock.Anything, mock.Anything).Return()
+
+	response := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/reports/compare", nil)
+	ctx := e.NewContext(request, response)
+	params := ports.GenerateComparisonReportParams{
+		From:        time.Date(2021, time.July, 1, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2021, time.July, 31, 0, 0, 0, 0, time.UTC),
+		CompareFrom: []time.Time{time.Date(2020, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		CompareTo:   []time.Time{time.Date(2020, time.July, 31, 0, 0, 0, 0, time.UTC)},
+		AverageBy:   &averageBy,
+	}
+
+	// SUT
+	server := ports.NewHTTPServer(app)
+
+	// Act
+	server.GenerateComparisonReport(ctx, params)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, response.Code, "HTTP status should be 400.")
+}
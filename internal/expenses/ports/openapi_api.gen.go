@@ -8,17 +8,276 @@ import (
 	"net/http"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/labstack/echo/v4"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Returns all accounts
+	// (GET /accounts)
+	FindAccounts(ctx echo.Context) error
+	// Creates a new account
+	// (POST /accounts)
+	AddAccount(ctx echo.Context) error
+	// Deletes an account by ID
+	// (DELETE /accounts/{id})
+	DeleteAccount(ctx echo.Context, id string) error
+	// Returns an account by ID
+	// (GET /accounts/{id})
+	FindAccountByID(ctx echo.Context, id string) error
+	// Updates an account
+	// (PUT /accounts/{id})
+	UpdateAccount(ctx echo.Context, id string) error
+	// Returns account balance
+	// (GET /accounts/{id}/balance)
+	FindAccountBalance(ctx echo.Context, id string, params FindAccountBalanceParams) error
+	// Returns reconciliation history of an account
+	// (GET /accounts/{id}/reconciliations)
+	FindReconciliations(ctx echo.Context, id string) error
+	// Starts a reconciliation
+	// (POST /accounts/{id}/reconciliations)
+	AddReconciliation(ctx echo.Context, id string) error
+	// Returns balances
+	// (GET /balances)
+	FindBalances(ctx echo.Context, params FindBalancesParams) error
+	// Returns all budgets
+	// (GET /budgets)
+	FindBudgets(ctx echo.Context) error
+	// Creates a new budget
+	// (POST /budgets)
+	AddBudget(ctx echo.Context) error
+	// Returns budget figures
+	// (GET /budgets/status)
+	FindBudgetStatus(ctx echo.Context, params FindBudgetStatusParams) error
+	// Deletes a budget by ID
+	// (DELETE /budgets/{id})
+	DeleteBudget(ctx echo.Context, id string) error
+	// Updates a budget
+	// (PUT /budgets/{id})
+	UpdateBudget(ctx echo.Context, id string) error
+	// Returns likely duplicate expenses
+	// (GET /duplicates)
+	FindDuplicates(ctx echo.Context, params FindDuplicatesParams) error
+	// Resolves duplicate expenses
+	// (POST /duplicates/{groupId}/resolve)
+	ResolveDuplicates(ctx echo.Context, groupId string) error
+	// Returns expenses
+	// (GET /expenses)
+	ListExpenses(ctx echo.Context, params ListExpensesParams) error
 	// Creates a new expense
 	// (POST /expenses)
 	AddExpense(ctx echo.Context) error
+	// Deletes an expense by ID
+	// (DELETE /expenses/{id})
+	DeleteExpense(ctx echo.Context, id string) error
+	// Returns an expense by ID
+	// (GET /expenses/{id})
+	FindExpenseByID(ctx echo.Context, id string) error
+	// Updates an expense
+	// (PUT /expenses/{id})
+	UpdateExpense(ctx echo.Context, id string) error
+	// Returns expense attachments
+	// (GET /expenses/{id}/attachments)
+	FindAttachments(ctx echo.Context, id string) error
+	// Attaches a file to an expense
+	// (POST /expenses/{id}/attachments)
+	AddAttachment(ctx echo.Context, id string) error
+	// Deletes an attachment
+	// (DELETE /expenses/{id}/attachments/{attachmentId})
+	DeleteAttachment(ctx echo.Context, id string, attachmentId string) error
+	// Downloads an attachment
+	// (GET /expenses/{id}/attachments/{attachmentId})
+	DownloadAttachment(ctx echo.Context, id string, attachmentId string) error
+	// Downloads an attachment thumbnail
+	// (GET /expenses/{id}/attachments/{attachmentId}/thumbnail)
+	DownloadAttachmentThumbnail(ctx echo.Context, id string, attachmentId string) error
+	// Unlocks a reconciled expense
+	// (POST /expenses/{id}/unlock)
+	UnlockExpense(ctx echo.Context, id string) error
+	// Exports expenses
+	// (GET /exports/expenses)
+	ExportExpenses(ctx echo.Context, params ExportExpensesParams) error
+	// Imports expenses from a CSV file
+	// (POST /imports/csv)
+	ImportExpensesCsv(ctx echo.Context, params ImportExpensesCsvParams) error
+	// Returns a page of inbox expenses
+	// (GET /imports/inbox)
+	ListInboxExpenses(ctx echo.Context, params ListInboxExpensesParams) error
+	// Assigns categories to inbox expenses
+	// (POST /imports/inbox/categories)
+	AssignInboxCategories(ctx echo.Context) error
+	// Imports expenses from OFX bank statement
+	// (POST /imports/ofx)
+	ImportOfx(ctx echo.Context, params ImportOfxParams) error
+	// Returns all import profiles
+	// (GET /imports/profiles)
+	FindImportProfiles(ctx echo.Context) error
+	// Creates a new import profile
+	// (POST /imports/profiles)
+	AddImportProfile(ctx echo.Context) error
+	// Imports expenses from QIF bank statement
+	// (POST /imports/qif)
+	ImportQif(ctx echo.Context, params ImportQifParams) error
+	// Returns incomes
+	// (GET /incomes)
+	FindIncomes(ctx echo.Context, params FindIncomesParams) error
+	// Records income
+	// (POST /incomes)
+	AddIncome(ctx echo.Context) error
+	// Deletes income by ID
+	// (DELETE /incomes/{id})
+	DeleteIncome(ctx echo.Context, id string) error
+	// Returns income by ID
+	// (GET /incomes/{id})
+	FindIncomeByID(ctx echo.Context, id string) error
+	// Updates income
+	// (PUT /incomes/{id})
+	UpdateIncome(ctx echo.Context, id string) error
+	// Returns all merchants
+	// (GET /merchants)
+	FindMerchants(ctx echo.Context) error
+	// Creates a new merchant
+	// (POST /merchants)
+	AddMerchant(ctx echo.Context) error
+	// Deletes a merchant by ID
+	// (DELETE /merchants/{id})
+	DeleteMerchant(ctx echo.Context, id string) error
+	// Returns a merchant by ID
+	// (GET /merchants/{id})
+	FindMerchantByID(ctx echo.Context, id string) error
+	// Updates a merchant
+	// (PUT /merchants/{id})
+	UpdateMerchant(ctx echo.Context, id string) error
+	// Merges merchants
+	// (POST /merchants/{id}/merge)
+	MergeMerchants(ctx echo.Context, id string) error
+	// Returns merchant spending history
+	// (GET /merchants/{id}/stats)
+	FindMerchantStats(ctx echo.Context, id string) error
+	// Creates an itemized receipt
+	// (POST /receipts)
+	AddReceipt(ctx echo.Context) error
+	// Returns a receipt by ID
+	// (GET /receipts/{id})
+	FindReceiptByID(ctx echo.Context, id string) error
+	// Attaches a file to a receipt
+	// (POST /receipts/{id}/attachments)
+	AddReceiptAttachment(ctx echo.Context, id string) error
+	// Returns a reconciliation by ID
+	// (GET /reconciliations/{id})
+	FindReconciliationByID(ctx echo.Context, id string) error
+	// Ticks off reconciliation expenses
+	// (PUT /reconciliations/{id}/expenses)
+	TickReconciliation(ctx echo.Context, id string) error
+	// Finishes a reconciliation
+	// (POST /reconciliations/{id}/finish)
+	FinishReconciliation(ctx echo.Context, id string) error
+	// Returns all recurring expenses
+	// (GET /recurring-expenses)
+	FindRecurringExpenses(ctx echo.Context) error
+	// Creates a new recurring expense
+	// (POST /recurring-expenses)
+	AddRecurringExpense(ctx echo.Context) error
+	// Deletes a recurring expense by ID
+	// (DELETE /recurring-expenses/{id})
+	DeleteRecurringExpense(ctx echo.Context, id string) error
+	// Returns a recurring expense by ID
+	// (GET /recurring-expenses/{id})
+	FindRecurringExpenseByID(ctx echo.Context, id string) error
+	// Updates a recurring expense
+	// (PUT /recurring-expenses/{id})
+	UpdateRecurringExpense(ctx echo.Context, id string) error
+	// Previews upcoming occurrences
+	// (GET /recurring-expenses/{id}/occurrences)
+	PreviewOccurrences(ctx echo.Context, id string, params PreviewOccurrencesParams) error
+	// Reverts a single occurrence
+	// (DELETE /recurring-expenses/{id}/occurrences/{date})
+	RevertOccurrence(ctx echo.Context, id string, date openapi_types.Date) error
+	// Skips or edits a single occurrence
+	// (PUT /recurring-expenses/{id}/occurrences/{date})
+	UpdateOccurrence(ctx echo.Context, id string, date openapi_types.Date) error
 	// Generates expense repose
 	// (GET /reports)
 	GenerateReport(ctx echo.Context, params GenerateReportParams) error
+	// Generates category report
+	// (GET /reports/by-category)
+	GenerateCategoryReport(ctx echo.Context, params GenerateCategoryReportParams) error
+	// Generates cash flow report
+	// (GET /reports/cashflow)
+	GenerateCashFlowReport(ctx echo.Context, params GenerateCashFlowReportParams) error
+	// Generates comparison report
+	// (GET /reports/compare)
+	GenerateComparisonReport(ctx echo.Context, params GenerateComparisonReportParams) error
+	// Returns all rules
+	// (GET /rules)
+	FindRules(ctx echo.Context) error
+	// Creates a new rule
+	// (POST /rules)
+	AddRule(ctx echo.Context) error
+	// Applies rules to existing expenses
+	// (POST /rules/apply)
+	ApplyRules(ctx echo.Context) error
+	// Tests a rule
+	// (POST /rules/test)
+	TestRule(ctx echo.Context, params TestRuleParams) error
+	// Deletes a rule by ID
+	// (DELETE /rules/{id})
+	DeleteRule(ctx echo.Context, id string) error
+	// Returns a rule by ID
+	// (GET /rules/{id})
+	FindRuleByID(ctx echo.Context, id string) error
+	// Updates a rule
+	// (PUT /rules/{id})
+	UpdateRule(ctx echo.Context, id string) error
+	// Searches expenses and categories
+	// (GET /search)
+	Search(ctx echo.Context, params SearchParams) error
+	// Returns settings of the user
+	// (GET /settings)
+	FindSettings(ctx echo.Context) error
+	// Updates settings of the user
+	// (PUT /settings)
+	UpdateSettings(ctx echo.Context) error
+	// Records a settlement
+	// (POST /settlements)
+	AddSettlement(ctx echo.Context) error
+	// Returns all tags
+	// (GET /tags)
+	FindTags(ctx echo.Context) error
+	// Merges tags
+	// (POST /tags/merge)
+	MergeTags(ctx echo.Context) error
+	// Renames a tag
+	// (PUT /tags/{name})
+	RenameTag(ctx echo.Context, name string) error
+	// Records a transfer
+	// (POST /transfers)
+	AddTransfer(ctx echo.Context) error
+	// Returns trash items
+	// (GET /trash)
+	FindTrashItems(ctx echo.Context) error
+	// Restores a trash item
+	// (POST /trash/{id}/restore)
+	RestoreTrashItem(ctx echo.Context, id string) error
+	// Returns all trips
+	// (GET /trips)
+	FindTrips(ctx echo.Context) error
+	// Creates a new trip
+	// (POST /trips)
+	AddTrip(ctx echo.Context) error
+	// Deletes a trip by ID
+	// (DELETE /trips/{id})
+	DeleteTrip(ctx echo.Context, id string) error
+	// Returns a trip by ID
+	// (GET /trips/{id})
+	FindTripByID(ctx echo.Context, id string) error
+	// Updates a trip
+	// (PUT /trips/{id})
+	UpdateTrip(ctx echo.Context, id string) error
+	// Generates trip report
+	// (GET /trips/{id}/report)
+	FindTripReport(ctx echo.Context, id string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -26,21 +285,228 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// AddExpense converts echo context to params.
-func (w *ServerInterfaceWrapper) AddExpense(ctx echo.Context) error {
+// FindAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) FindAccounts(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.AddExpense(ctx)
+	err = w.Handler.FindAccounts(ctx)
 	return err
 }
 
-// GenerateReport converts echo context to params.
-func (w *ServerInterfaceWrapper) GenerateReport(ctx echo.Context) error {
+// AddAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) AddAccount(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddAccount(ctx)
+	return err
+}
+
+// DeleteAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteAccount(ctx, id)
+	return err
+}
+
+// FindAccountByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindAccountByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindAccountByID(ctx, id)
+	return err
+}
+
+// UpdateAccount converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateAccount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateAccount(ctx, id)
+	return err
+}
+
+// FindAccountBalance converts echo context to params.
+func (w *ServerInterfaceWrapper) FindAccountBalance(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GenerateReportParams
+	var params FindAccountBalanceParams
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", ctx.QueryParams(), &params.At)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter at: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindAccountBalance(ctx, id, params)
+	return err
+}
+
+// FindReconciliations converts echo context to params.
+func (w *ServerInterfaceWrapper) FindReconciliations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindReconciliations(ctx, id)
+	return err
+}
+
+// AddReconciliation converts echo context to params.
+func (w *ServerInterfaceWrapper) AddReconciliation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddReconciliation(ctx, id)
+	return err
+}
+
+// FindBalances converts echo context to params.
+func (w *ServerInterfaceWrapper) FindBalances(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindBalancesParams
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindBalances(ctx, params)
+	return err
+}
+
+// FindBudgets converts echo context to params.
+func (w *ServerInterfaceWrapper) FindBudgets(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindBudgets(ctx)
+	return err
+}
+
+// AddBudget converts echo context to params.
+func (w *ServerInterfaceWrapper) AddBudget(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddBudget(ctx)
+	return err
+}
+
+// FindBudgetStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) FindBudgetStatus(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindBudgetStatusParams
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", ctx.QueryParams(), &params.Period)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter period: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindBudgetStatus(ctx, params)
+	return err
+}
+
+// DeleteBudget converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBudget(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteBudget(ctx, id)
+	return err
+}
+
+// UpdateBudget converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateBudget(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateBudget(ctx, id)
+	return err
+}
+
+// FindDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) FindDuplicates(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindDuplicatesParams
 	// ------------- Required query parameter "from" -------------
 
 	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
@@ -55,15 +521,1594 @@ func (w *ServerInterfaceWrapper) GenerateReport(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
 
-	// ------------- Required query parameter "interval" -------------
+	// ------------- Optional query parameter "days" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "interval", ctx.QueryParams(), &params.Interval)
+	err = runtime.BindQueryParameter("form", true, false, "days", ctx.QueryParams(), &params.Days)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter days: %s", err))
+	}
+
+	// ------------- Optional query parameter "tolerance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tolerance", ctx.QueryParams(), &params.Tolerance)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tolerance: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GenerateReport(ctx, params)
+	err = w.Handler.FindDuplicates(ctx, params)
+	return err
+}
+
+// ResolveDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) ResolveDuplicates(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "groupId", runtime.ParamLocationPath, ctx.Param("groupId"), &groupId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter groupId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ResolveDuplicates(ctx, groupId)
+	return err
+}
+
+// ListExpenses converts echo context to params.
+func (w *ServerInterfaceWrapper) ListExpenses(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExpensesParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "categoryId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "categoryId", ctx.QueryParams(), &params.CategoryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter categoryId: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// ------------- Optional query parameter "tripId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tripId", ctx.QueryParams(), &params.TripId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tripId: %s", err))
+	}
+
+	// ------------- Optional query parameter "text" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "text", ctx.QueryParams(), &params.Text)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter text: %s", err))
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", ctx.QueryParams(), &params.SortBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListExpenses(ctx, params)
+	return err
+}
+
+// AddExpense converts echo context to params.
+func (w *ServerInterfaceWrapper) AddExpense(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddExpense(ctx)
+	return err
+}
+
+// DeleteExpense converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteExpense(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteExpense(ctx, id)
+	return err
+}
+
+// FindExpenseByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindExpenseByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindExpenseByID(ctx, id)
+	return err
+}
+
+// UpdateExpense converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateExpense(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateExpense(ctx, id)
+	return err
+}
+
+// FindAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) FindAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindAttachments(ctx, id)
+	return err
+}
+
+// AddAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) AddAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddAttachment(ctx, id)
+	return err
+}
+
+// DeleteAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, ctx.Param("attachmentId"), &attachmentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteAttachment(ctx, id, attachmentId)
+	return err
+}
+
+// DownloadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, ctx.Param("attachmentId"), &attachmentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DownloadAttachment(ctx, id, attachmentId)
+	return err
+}
+
+// DownloadAttachmentThumbnail converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadAttachmentThumbnail(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, ctx.Param("attachmentId"), &attachmentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DownloadAttachmentThumbnail(ctx, id, attachmentId)
+	return err
+}
+
+// UnlockExpense converts echo context to params.
+func (w *ServerInterfaceWrapper) UnlockExpense(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnlockExpense(ctx, id)
+	return err
+}
+
+// ExportExpenses converts echo context to params.
+func (w *ServerInterfaceWrapper) ExportExpenses(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportExpensesParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "categoryId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "categoryId", ctx.QueryParams(), &params.CategoryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter categoryId: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// ------------- Optional query parameter "reportCurrency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reportCurrency", ctx.QueryParams(), &params.ReportCurrency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reportCurrency: %s", err))
+	}
+
+	// ------------- Optional query parameter "tripId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tripId", ctx.QueryParams(), &params.TripId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tripId: %s", err))
+	}
+
+	// ------------- Optional query parameter "text" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "text", ctx.QueryParams(), &params.Text)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter text: %s", err))
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", ctx.QueryParams(), &params.SortBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sortBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ExportExpenses(ctx, params)
+	return err
+}
+
+// ImportExpensesCsv converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportExpensesCsv(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportExpensesCsvParams
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", ctx.QueryParams(), &params.Mode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportExpensesCsv(ctx, params)
+	return err
+}
+
+// ListInboxExpenses converts echo context to params.
+func (w *ServerInterfaceWrapper) ListInboxExpenses(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInboxExpensesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ListInboxExpenses(ctx, params)
+	return err
+}
+
+// AssignInboxCategories converts echo context to params.
+func (w *ServerInterfaceWrapper) AssignInboxCategories(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AssignInboxCategories(ctx)
+	return err
+}
+
+// ImportOfx converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportOfx(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportOfxParams
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", ctx.QueryParams(), &params.Mode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
+
+	// ------------- Optional query parameter "defaultCurrency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "defaultCurrency", ctx.QueryParams(), &params.DefaultCurrency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter defaultCurrency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportOfx(ctx, params)
+	return err
+}
+
+// FindImportProfiles converts echo context to params.
+func (w *ServerInterfaceWrapper) FindImportProfiles(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindImportProfiles(ctx)
+	return err
+}
+
+// AddImportProfile converts echo context to params.
+func (w *ServerInterfaceWrapper) AddImportProfile(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddImportProfile(ctx)
+	return err
+}
+
+// ImportQif converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportQif(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportQifParams
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", ctx.QueryParams(), &params.Mode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
+
+	// ------------- Optional query parameter "defaultCurrency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "defaultCurrency", ctx.QueryParams(), &params.DefaultCurrency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter defaultCurrency: %s", err))
+	}
+
+	// ------------- Optional query parameter "dateFormat" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dateFormat", ctx.QueryParams(), &params.DateFormat)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dateFormat: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportQif(ctx, params)
+	return err
+}
+
+// FindIncomes converts echo context to params.
+func (w *ServerInterfaceWrapper) FindIncomes(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params FindIncomesParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindIncomes(ctx, params)
+	return err
+}
+
+// AddIncome converts echo context to params.
+func (w *ServerInterfaceWrapper) AddIncome(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddIncome(ctx)
+	return err
+}
+
+// DeleteIncome converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteIncome(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteIncome(ctx, id)
+	return err
+}
+
+// FindIncomeByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindIncomeByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindIncomeByID(ctx, id)
+	return err
+}
+
+// UpdateIncome converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateIncome(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateIncome(ctx, id)
+	return err
+}
+
+// FindMerchants converts echo context to params.
+func (w *ServerInterfaceWrapper) FindMerchants(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindMerchants(ctx)
+	return err
+}
+
+// AddMerchant converts echo context to params.
+func (w *ServerInterfaceWrapper) AddMerchant(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddMerchant(ctx)
+	return err
+}
+
+// DeleteMerchant converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMerchant(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteMerchant(ctx, id)
+	return err
+}
+
+// FindMerchantByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindMerchantByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindMerchantByID(ctx, id)
+	return err
+}
+
+// UpdateMerchant converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMerchant(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateMerchant(ctx, id)
+	return err
+}
+
+// MergeMerchants converts echo context to params.
+func (w *ServerInterfaceWrapper) MergeMerchants(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.MergeMerchants(ctx, id)
+	return err
+}
+
+// FindMerchantStats converts echo context to params.
+func (w *ServerInterfaceWrapper) FindMerchantStats(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindMerchantStats(ctx, id)
+	return err
+}
+
+// AddReceipt converts echo context to params.
+func (w *ServerInterfaceWrapper) AddReceipt(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddReceipt(ctx)
+	return err
+}
+
+// FindReceiptByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindReceiptByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindReceiptByID(ctx, id)
+	return err
+}
+
+// AddReceiptAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) AddReceiptAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddReceiptAttachment(ctx, id)
+	return err
+}
+
+// FindReconciliationByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindReconciliationByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindReconciliationByID(ctx, id)
+	return err
+}
+
+// TickReconciliation converts echo context to params.
+func (w *ServerInterfaceWrapper) TickReconciliation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.TickReconciliation(ctx, id)
+	return err
+}
+
+// FinishReconciliation converts echo context to params.
+func (w *ServerInterfaceWrapper) FinishReconciliation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FinishReconciliation(ctx, id)
+	return err
+}
+
+// FindRecurringExpenses converts echo context to params.
+func (w *ServerInterfaceWrapper) FindRecurringExpenses(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindRecurringExpenses(ctx)
+	return err
+}
+
+// AddRecurringExpense converts echo context to params.
+func (w *ServerInterfaceWrapper) AddRecurringExpense(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddRecurringExpense(ctx)
+	return err
+}
+
+// DeleteRecurringExpense converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRecurringExpense(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteRecurringExpense(ctx, id)
+	return err
+}
+
+// FindRecurringExpenseByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindRecurringExpenseByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindRecurringExpenseByID(ctx, id)
+	return err
+}
+
+// UpdateRecurringExpense converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateRecurringExpense(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateRecurringExpense(ctx, id)
+	return err
+}
+
+// PreviewOccurrences converts echo context to params.
+func (w *ServerInterfaceWrapper) PreviewOccurrences(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PreviewOccurrencesParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.PreviewOccurrences(ctx, id, params)
+	return err
+}
+
+// RevertOccurrence converts echo context to params.
+func (w *ServerInterfaceWrapper) RevertOccurrence(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "date", runtime.ParamLocationPath, ctx.Param("date"), &date)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RevertOccurrence(ctx, id, date)
+	return err
+}
+
+// UpdateOccurrence converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateOccurrence(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "date", runtime.ParamLocationPath, ctx.Param("date"), &date)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateOccurrence(ctx, id, date)
+	return err
+}
+
+// GenerateReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateReport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GenerateReportParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Required query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "excludeTags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeTags", ctx.QueryParams(), &params.ExcludeTags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter excludeTags: %s", err))
+	}
+
+	// ------------- Optional query parameter "categories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "categories", ctx.QueryParams(), &params.Categories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter categories: %s", err))
+	}
+
+	// ------------- Optional query parameter "excludeCategories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeCategories", ctx.QueryParams(), &params.ExcludeCategories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter excludeCategories: %s", err))
+	}
+
+	// ------------- Optional query parameter "originalCurrencies" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "originalCurrencies", ctx.QueryParams(), &params.OriginalCurrencies)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter originalCurrencies: %s", err))
+	}
+
+	// ------------- Optional query parameter "tripId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tripId", ctx.QueryParams(), &params.TripId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tripId: %s", err))
+	}
+
+	// ------------- Optional query parameter "minAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minAmount", ctx.QueryParams(), &params.MinAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minAmount: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxAmount", ctx.QueryParams(), &params.MaxAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxAmount: %s", err))
+	}
+
+	// ------------- Optional query parameter "comment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "comment", ctx.QueryParams(), &params.Comment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GenerateReport(ctx, params)
+	return err
+}
+
+// GenerateCategoryReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateCategoryReport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GenerateCategoryReportParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Required query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "excludeTags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeTags", ctx.QueryParams(), &params.ExcludeTags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter excludeTags: %s", err))
+	}
+
+	// ------------- Optional query parameter "categories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "categories", ctx.QueryParams(), &params.Categories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter categories: %s", err))
+	}
+
+	// ------------- Optional query parameter "excludeCategories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeCategories", ctx.QueryParams(), &params.ExcludeCategories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter excludeCategories: %s", err))
+	}
+
+	// ------------- Optional query parameter "originalCurrencies" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "originalCurrencies", ctx.QueryParams(), &params.OriginalCurrencies)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter originalCurrencies: %s", err))
+	}
+
+	// ------------- Optional query parameter "tripId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tripId", ctx.QueryParams(), &params.TripId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tripId: %s", err))
+	}
+
+	// ------------- Optional query parameter "minAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minAmount", ctx.QueryParams(), &params.MinAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minAmount: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxAmount", ctx.QueryParams(), &params.MaxAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxAmount: %s", err))
+	}
+
+	// ------------- Optional query parameter "comment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "comment", ctx.QueryParams(), &params.Comment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GenerateCategoryReport(ctx, params)
+	return err
+}
+
+// GenerateCashFlowReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateCashFlowReport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GenerateCashFlowReportParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Required query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "interval", ctx.QueryParams(), &params.Interval)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GenerateCashFlowReport(ctx, params)
+	return err
+}
+
+// GenerateComparisonReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateComparisonReport(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GenerateComparisonReportParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Required query parameter "compareFrom" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "compareFrom", ctx.QueryParams(), &params.CompareFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter compareFrom: %s", err))
+	}
+
+	// ------------- Required query parameter "compareTo" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "compareTo", ctx.QueryParams(), &params.CompareTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter compareTo: %s", err))
+	}
+
+	// ------------- Optional query parameter "averageBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "averageBy", ctx.QueryParams(), &params.AverageBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter averageBy: %s", err))
+	}
+
+	// ------------- Optional query parameter "tags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tags", ctx.QueryParams(), &params.Tags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "excludeTags" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeTags", ctx.QueryParams(), &params.ExcludeTags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter excludeTags: %s", err))
+	}
+
+	// ------------- Optional query parameter "categories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "categories", ctx.QueryParams(), &params.Categories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter categories: %s", err))
+	}
+
+	// ------------- Optional query parameter "excludeCategories" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "excludeCategories", ctx.QueryParams(), &params.ExcludeCategories)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter excludeCategories: %s", err))
+	}
+
+	// ------------- Optional query parameter "originalCurrencies" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "originalCurrencies", ctx.QueryParams(), &params.OriginalCurrencies)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter originalCurrencies: %s", err))
+	}
+
+	// ------------- Optional query parameter "tripId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tripId", ctx.QueryParams(), &params.TripId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tripId: %s", err))
+	}
+
+	// ------------- Optional query parameter "minAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minAmount", ctx.QueryParams(), &params.MinAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minAmount: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxAmount", ctx.QueryParams(), &params.MaxAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxAmount: %s", err))
+	}
+
+	// ------------- Optional query parameter "comment" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "comment", ctx.QueryParams(), &params.Comment)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment: %s", err))
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GenerateComparisonReport(ctx, params)
+	return err
+}
+
+// FindRules converts echo context to params.
+func (w *ServerInterfaceWrapper) FindRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindRules(ctx)
+	return err
+}
+
+// AddRule converts echo context to params.
+func (w *ServerInterfaceWrapper) AddRule(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddRule(ctx)
+	return err
+}
+
+// ApplyRules converts echo context to params.
+func (w *ServerInterfaceWrapper) ApplyRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ApplyRules(ctx)
+	return err
+}
+
+// TestRule converts echo context to params.
+func (w *ServerInterfaceWrapper) TestRule(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TestRuleParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.TestRule(ctx, params)
+	return err
+}
+
+// DeleteRule converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteRule(ctx, id)
+	return err
+}
+
+// FindRuleByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindRuleByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindRuleByID(ctx, id)
+	return err
+}
+
+// UpdateRule converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateRule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateRule(ctx, id)
+	return err
+}
+
+// Search converts echo context to params.
+func (w *ServerInterfaceWrapper) Search(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
+	// ------------- Required query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "minAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minAmount", ctx.QueryParams(), &params.MinAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter minAmount: %s", err))
+	}
+
+	// ------------- Optional query parameter "maxAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxAmount", ctx.QueryParams(), &params.MaxAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxAmount: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Search(ctx, params)
+	return err
+}
+
+// FindSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) FindSettings(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindSettings(ctx)
+	return err
+}
+
+// UpdateSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateSettings(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateSettings(ctx)
+	return err
+}
+
+// AddSettlement converts echo context to params.
+func (w *ServerInterfaceWrapper) AddSettlement(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddSettlement(ctx)
+	return err
+}
+
+// FindTags converts echo context to params.
+func (w *ServerInterfaceWrapper) FindTags(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindTags(ctx)
+	return err
+}
+
+// MergeTags converts echo context to params.
+func (w *ServerInterfaceWrapper) MergeTags(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.MergeTags(ctx)
+	return err
+}
+
+// RenameTag converts echo context to params.
+func (w *ServerInterfaceWrapper) RenameTag(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "name", runtime.ParamLocationPath, ctx.Param("name"), &name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RenameTag(ctx, name)
+	return err
+}
+
+// AddTransfer converts echo context to params.
+func (w *ServerInterfaceWrapper) AddTransfer(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddTransfer(ctx)
+	return err
+}
+
+// FindTrashItems converts echo context to params.
+func (w *ServerInterfaceWrapper) FindTrashItems(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindTrashItems(ctx)
+	return err
+}
+
+// RestoreTrashItem converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreTrashItem(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RestoreTrashItem(ctx, id)
+	return err
+}
+
+// FindTrips converts echo context to params.
+func (w *ServerInterfaceWrapper) FindTrips(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindTrips(ctx)
+	return err
+}
+
+// AddTrip converts echo context to params.
+func (w *ServerInterfaceWrapper) AddTrip(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddTrip(ctx)
+	return err
+}
+
+// DeleteTrip converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTrip(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteTrip(ctx, id)
+	return err
+}
+
+// FindTripByID converts echo context to params.
+func (w *ServerInterfaceWrapper) FindTripByID(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindTripByID(ctx, id)
+	return err
+}
+
+// UpdateTrip converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateTrip(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateTrip(ctx, id)
+	return err
+}
+
+// FindTripReport converts echo context to params.
+func (w *ServerInterfaceWrapper) FindTripReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.FindTripReport(ctx, id)
 	return err
 }
 
@@ -95,7 +2140,93 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/accounts", wrapper.FindAccounts)
+	router.POST(baseURL+"/accounts", wrapper.AddAccount)
+	router.DELETE(baseURL+"/accounts/:id", wrapper.DeleteAccount)
+	router.GET(baseURL+"/accounts/:id", wrapper.FindAccountByID)
+	router.PUT(baseURL+"/accounts/:id", wrapper.UpdateAccount)
+	router.GET(baseURL+"/accounts/:id/balance", wrapper.FindAccountBalance)
+	router.GET(baseURL+"/accounts/:id/reconciliations", wrapper.FindReconciliations)
+	router.POST(baseURL+"/accounts/:id/reconciliations", wrapper.AddReconciliation)
+	router.GET(baseURL+"/balances", wrapper.FindBalances)
+	router.GET(baseURL+"/budgets", wrapper.FindBudgets)
+	router.POST(baseURL+"/budgets", wrapper.AddBudget)
+	router.GET(baseURL+"/budgets/status", wrapper.FindBudgetStatus)
+	router.DELETE(baseURL+"/budgets/:id", wrapper.DeleteBudget)
+	router.PUT(baseURL+"/budgets/:id", wrapper.UpdateBudget)
+	router.GET(baseURL+"/duplicates", wrapper.FindDuplicates)
+	router.POST(baseURL+"/duplicates/:groupId/resolve", wrapper.ResolveDuplicates)
+	router.GET(baseURL+"/expenses", wrapper.ListExpenses)
 	router.POST(baseURL+"/expenses", wrapper.AddExpense)
+	router.DELETE(baseURL+"/expenses/:id", wrapper.DeleteExpense)
+	router.GET(baseURL+"/expenses/:id", wrapper.FindExpenseByID)
+	router.PUT(baseURL+"/expenses/:id", wrapper.UpdateExpense)
+	router.GET(baseURL+"/expenses/:id/attachments", wrapper.FindAttachments)
+	router.POST(baseURL+"/expenses/:id/attachments", wrapper.AddAttachment)
+	router.DELETE(baseURL+"/expenses/:id/attachments/:attachmentId", wrapper.DeleteAttachment)
+	router.GET(baseURL+"/expenses/:id/attachments/:attachmentId", wrapper.DownloadAttachment)
+	router.GET(baseURL+"/expenses/:id/attachments/:attachmentId/thumbnail", wrapper.DownloadAttachmentThumbnail)
+	router.POST(baseURL+"/expenses/:id/unlock", wrapper.UnlockExpense)
+	router.GET(baseURL+"/exports/expenses", wrapper.ExportExpenses)
+	router.POST(baseURL+"/imports/csv", wrapper.ImportExpensesCsv)
+	router.GET(baseURL+"/imports/inbox", wrapper.ListInboxExpenses)
+	router.POST(baseURL+"/imports/inbox/categories", wrapper.AssignInboxCategories)
+	router.POST(baseURL+"/imports/ofx", wrapper.ImportOfx)
+	router.GET(baseURL+"/imports/profiles", wrapper.FindImportProfiles)
+	router.POST(baseURL+"/imports/profiles", wrapper.AddImportProfile)
+	router.POST(baseURL+"/imports/qif", wrapper.ImportQif)
+	router.GET(baseURL+"/incomes", wrapper.FindIncomes)
+	router.POST(baseURL+"/incomes", wrapper.AddIncome)
+	router.DELETE(baseURL+"/incomes/:id", wrapper.DeleteIncome)
+	router.GET(baseURL+"/incomes/:id", wrapper.FindIncomeByID)
+	router.PUT(baseURL+"/incomes/:id", wrapper.UpdateIncome)
+	router.GET(baseURL+"/merchants", wrapper.FindMerchants)
+	router.POST(baseURL+"/merchants", wrapper.AddMerchant)
+	router.DELETE(baseURL+"/merchants/:id", wrapper.DeleteMerchant)
+	router.GET(baseURL+"/merchants/:id", wrapper.FindMerchantByID)
+	router.PUT(baseURL+"/merchants/:id", wrapper.UpdateMerchant)
+	router.POST(baseURL+"/merchants/:id/merge", wrapper.MergeMerchants)
+	router.GET(baseURL+"/merchants/:id/stats", wrapper.FindMerchantStats)
+	router.POST(baseURL+"/receipts", wrapper.AddReceipt)
+	router.GET(baseURL+"/receipts/:id", wrapper.FindReceiptByID)
+	router.POST(baseURL+"/receipts/:id/attachments", wrapper.AddReceiptAttachment)
+	router.GET(baseURL+"/reconciliations/:id", wrapper.FindReconciliationByID)
+	router.PUT(baseURL+"/reconciliations/:id/expenses", wrapper.TickReconciliation)
+	router.POST(baseURL+"/reconciliations/:id/finish", wrapper.FinishReconciliation)
+	router.GET(baseURL+"/recurring-expenses", wrapper.FindRecurringExpenses)
+	router.POST(baseURL+"/recurring-expenses", wrapper.AddRecurringExpense)
+	router.DELETE(baseURL+"/recurring-expenses/:id", wrapper.DeleteRecurringExpense)
+	router.GET(baseURL+"/recurring-expenses/:id", wrapper.FindRecurringExpenseByID)
+	router.PUT(baseURL+"/recurring-expenses/:id", wrapper.UpdateRecurringExpense)
+	router.GET(baseURL+"/recurring-expenses/:id/occurrences", wrapper.PreviewOccurrences)
+	router.DELETE(baseURL+"/recurring-expenses/:id/occurrences/:date", wrapper.RevertOccurrence)
+	router.PUT(baseURL+"/recurring-expenses/:id/occurrences/:date", wrapper.UpdateOccurrence)
 	router.GET(baseURL+"/reports", wrapper.GenerateReport)
+	router.GET(baseURL+"/reports/by-category", wrapper.GenerateCategoryReport)
+	router.GET(baseURL+"/reports/cashflow", wrapper.GenerateCashFlowReport)
+	router.GET(baseURL+"/reports/compare", wrapper.GenerateComparisonReport)
+	router.GET(baseURL+"/rules", wrapper.FindRules)
+	router.POST(baseURL+"/rules", wrapper.AddRule)
+	router.POST(baseURL+"/rules/apply", wrapper.ApplyRules)
+	router.POST(baseURL+"/rules/test", wrapper.TestRule)
+	router.DELETE(baseURL+"/rules/:id", wrapper.DeleteRule)
+	router.GET(baseURL+"/rules/:id", wrapper.FindRuleByID)
+	router.PUT(baseURL+"/rules/:id", wrapper.UpdateRule)
+	router.GET(baseURL+"/search", wrapper.Search)
+	router.GET(baseURL+"/settings", wrapper.FindSettings)
+	router.PUT(baseURL+"/settings", wrapper.UpdateSettings)
+	router.POST(baseURL+"/settlements", wrapper.AddSettlement)
+	router.GET(baseURL+"/tags", wrapper.FindTags)
+	router.POST(baseURL+"/tags/merge", wrapper.MergeTags)
+	router.PUT(baseURL+"/tags/:name", wrapper.RenameTag)
+	router.POST(baseURL+"/transfers", wrapper.AddTransfer)
+	router.GET(baseURL+"/trash", wrapper.FindTrashItems)
+	router.POST(baseURL+"/trash/:id/restore", wrapper.RestoreTrashItem)
+	router.GET(baseURL+"/trips", wrapper.FindTrips)
+	router.POST(baseURL+"/trips", wrapper.AddTrip)
+	router.DELETE(baseURL+"/trips/:id", wrapper.DeleteTrip)
+	router.GET(baseURL+"/trips/:id", wrapper.FindTripByID)
+	router.PUT(baseURL+"/trips/:id", wrapper.UpdateTrip)
+	router.GET(baseURL+"/trips/:id/report", wrapper.FindTripReport)
 
 }
@@ -18,26 +18,215 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xYTW/jNhD9KwLbo7pOd2++bTdpkB42QLpFD4scaGlscyGRynDoXSPQfy+G+qIs2lLa",
-	"BOgpjjmcj/eGj0M/i8yUldGgyYr1s7DZHkrpP36SBDuDR/5coakASYFfUZnR/JeOFYi1sIRK70SdCpXz",
-	"1znYDFVFiq3EX1o9OUhUnphtQntIss5vKrYGS0liLZxTuUinDgs4QBGEUppgB8hLWpYwjfZZlhAJNHFc",
-	"SexKVgSl//AzwlasxU+rAZJVi8eqB6PunUlEeRR1nQqEJ6cQcrH+KnwdPjcOQnvRFfHYbzSbb5ARe+q8",
-	"3vyoQNsG3DHUWUDC0vwg8LaouDb8tLZU7FDq/IshWcw5uR0s61RYt2lTUvBylHs85tAOKA4yjUF9LQk6",
-	"9w9QGaTzYN+8FMD5vFORS/L92vc8f/ELKd8pk/6EH9le6h08SIIF/IXG/5q1E2x9wukUlJH700xj0N8g",
-	"GoygbfLIAfbGiV8L9EFp+vB+wCmQgRKslbuzjrrlCcSnjdQE7MyjZQSFTqvZSAufHCLo7BjVxpfxj631",
-	"ZIEk7oAuRIqzOEpv4qWNN1d1TJ9et+TlR86zMCcPXQuP6zzTpa0Irp+FLIr7rVh/vZzBZ/g+COdr6Pai",
-	"27MV9/nLc3ovPdaPQ53nNJAha9aWcxHR1le7SiKMdunNav7tKOK4Tus2fmV5ld78Tm9NrDhaUle8pCGT",
-	"zk2sljtNgIcmBmhXNmBwS5dG+zHjCBKDrcPBChr17JV3F+m9jtLk7vrF3ZfyXFmCpqgwvKJqVKiyE3Pj",
-	"NkVgq125ae6KJyc1KTouNKee8Bd1BqpqXpYD3LsagvxGktWK2JBNrD0Gjh/AVibKdUxfBmo1fC+Oicxz",
-	"yP+TyESSi9+YF5ugp3UGxgGmZkcs/BkFCOOPQfEbksD3JD3LBzC+zboykaVxmmbhYi8B1Wdz73rwdH7S",
-	"B0CCfKHspMKg2im9WKeGKWTp8DmpsI84rY1RhMyhouOf7Kodo0Ai4EdH++G/37se/OPvLyJtnqjsqVkd",
-	"UN4TVaJmx6oF7ISf++t7tlZUsPm9w6QbahMLePC+DoC2Mf/13dW7Kw9bBVpWSqzFB/9V87Dz6a7Ct1Zl",
-	"LEVUFIFnjkTyCetOVqK0P3T2aAnKd8IHQclbWInFxzy/6c8gAwqWfjP5seWdWmGVVVWozG9bfbPNo7zh",
-	"ZY61cHhhxE5m5zZLMiwI/GdIVoQMEzrwlDea42F4f3X1Bmn2snYhXext2GIrXUGvlknziIkEd5o5zYhl",
-	"s7Xx+lBKnv7i9HubFQ5j1g4inXMLGvy82ndNs2PaLp1lO3z5nzdkCQRo/Rg79rtFUyZ8qzCxW1UQYLJh",
-	"mVO8+uTAv6eb31e88YTyNABtyTXN8/E4BzKLMyDzBvERrCvIJqobq+Kxg+XzGVxqm35sq+vHNzwn46H+",
-	"4hFhi//lSYm3e3fiG4Fu2tlh0cq9Xa9Wz3tjifmqV6zSqThIVHJTNCh3i83xaisVhclkwUvs/LH+JwAA",
-	"///UhqO+BhUAAA==",
+	"H4sIAAAAAAAC/+y9W3PkuJEo/FcQ9X2PHGns3Thxzrz19GVWu9vTvS15fSJW84Ais6rgJoEaAJS6PNH/",
+	"/QTuIAmQKF1Lth7sURdxSSQSmYlEXv5Y1azbMwpUitVPf6xEvYMO6z/f1DXrqVR/4rb9tFn99D9/rP5/",
+	"DpvVT6v/7zx0O7d9zn+FW9fne/XHas/ZHrgkoEcjjfr/BkTNyV4SRlc/rf5Cye89INIgtkFyBwjb7tVK",
+	"Hvaw+mklJCd0u/r+vVpx+L0nHJrVT/+jBvvt+2/fKwfjz7jFtIbVT+NZ7YAXevLRmNUK68VtGO/UX6sG",
+	"S/hBkg6m81erdZhi8q3uOQdaH5If4dseqAAxXf57+wXtMWnQhrMuRgIidPBPP0kCOLYHSuj25xkYJcdU",
+	"bICLCzqF5Mp9RJLdFQY/wadezs1w93WOiCDsbbQDelMnCIl2YYiJEdhhm3/zs7P136CWq0BsV/r3P1ZA",
+	"+07BUWOxUyBg3ugB6NfVbxPYq9WbprEb/gXEnlEBR52scd/pCWv6fUtqLOHtDuqvHzBpIXHmLkGi2x1Q",
+	"1JKv0B6Q7yVQzfq2QZRJtAbUMvYVGtTvK707Fn+ICISbRn2gtZoGmrBPa8ZawFQtNow6heA/JxPb00/h",
+	"tj3Y4e18q2pFJHR6lDkEWeysvntgMOf4sPoefnD7qNmGlLjedWC42xCNNaMSwi5PDzsHLKF5cwTvsF1+",
+	"TjOIDWnhV9ylZ9thcbXruzXFpI0aRKgmad4myN9hivsPpAWkPqlTtz5IfSD8IgiV/+tfwwIIlbAFnuK+",
+	"EdTVAGN24hHgMdZidKROWZaXU0jwlV9BItypY1mhPRNEkhuwPwiEOSB2C43jar0Antof/fsUiaNl294K",
+	"jBm4L3cACaqyfEX/XUTRDg0Til4QNw2sZfks72Atk4cmXnjEXP0y3ERJTPTNFo7TG2yXO6oNa9O7WGsw",
+	"s30GTlgTc/KOUblr1ToPgHl7SPJx0/lSYtmLhMLROZ1pCPMb/TvCN5i0eN2Ck3p7DQQitG77htCt/pGz",
+	"toUGsRvglpaTGokGJKPaKMa6ZfxgPvsT3vekSXKoOZJSEruc2e2B10DlXwSkIdt7tM+Sf7xFeh87TJRI",
+	"z6K2hY0cYrVCFLZYcwQt8RQ+xR7S2DQ4/3QDPDtDjTknbl+0GrPncENYL+yEIjWwmTGpkrFSrI4I2e/8",
+	"YJ89au2O6QmGqpGjpWixDsAYxcNNTB3xt1jsPrTsNpyhkS6CJZSTTKwizxHFLxzT5opJ3KpehNasg+P6",
+	"WBky18G3FfiG0K34YpcyJInPBkF4C44JGXAMGeKNBO50GFGhjgihDveGcUcq6JbIHeul7be45xqjftED",
+	"hTYnkdwmfYE94ylNJzr0w9W9tV8QBTmQpjVu675V0hsR+nBbeRyHeeyNd4e5VIiODkNCYo9I6eE4Qeag",
+	"B3Z0HL0YXjKlFFIzmgS8SDw7HrWqlgVRCzcQK7peB61W1OrIIwUQd5CYaCp2MHc2jsJNtWMtaUd6HdRo",
+	"wnssdyu3iDkUvxGCbGnmAjIQ3bkTlvw6Ai00HQiKMsC+gOjbBHj9XpFnYt9/7bs1cLUXHbsJVzixfKFw",
+	"Q84B9pZ1e8yJYHQKUh0Rbume1n68xBVVswIxJisk+rXkAAjTBjXQSiw0R4cb4AcUBkReDhdRmp7snRou",
+	"yTr6tQWbwPHUG2EtMbZUMxeyxfGdIDpqusEQo3M7+T4SEaNLkr84LKuGVgGPVN1jdj8WU/czMFSrbRA0",
+	"R4mk++2sx+Li7S3sVATp3AZl9YW7Q3sJnKRgrUqUEGlOo9Y/GL0BbtQPLfSm99+00ekD4UKiJjY3cb1M",
+	"NRDwG9yK+LyWKSLjtRynxtyVbh5aWTAYq+LtLSaVS8C83n3Est49DFsWNeMJKf8FWrjBtPaivtNTRgpF",
+	"w/p1G+GAamk0dxrMVPOrc8R+/4XdmUt4GI4SUUEujci8VCpd2PaB7B5UMOUYwsx2QSFpejGU42P4Bjje",
+	"JqjsrzuQO+Axw7FtGyXTYxxO7bD1Y0npEk3lKTWSh+bYjmuOGDZnnebX3siHBYR1FNui3E1uBOkYNaIU",
+	"N4ao8lc9yaazXbF7r+QeqlrOGGRPQXxbTKlyA8pOHbh36smpTHUY6H4PpO5URxub6h2mW/jiFIV5tS9u",
+	"fGc2nrblTJAyGH4MaRL1sJZzNujsWctYIMr1BztHEij3sPcLZ/1+Ct5D6t6k4BasL+h+0lmIv4BgbW9O",
+	"7QSrtfvdPRc00ILeyA74FpKPBV8B9heJu/LFO8cMtgpJ/nlVMqS6LKpydtzKQZVa1XvOGU+9bzYJ0acb",
+	"I/1t+CD4L39O3N/VkoXA2+xA7vPSOuyErnlyGdEpSL2rCXg7+x52FHPgWQsd5luQMzOlj/gAvMkodr6l",
+	"VYt50+0DLLn8LOpdWNLaHH8brlNkFmoO9x18IRLPhXfRzItsmMEfYdGEyaEGspfzx942mvhWoJZQQGor",
+	"ENtkRme0Ji3BaszFSaK247laptw30PpQ/nBqEf/ZnvyRkdjRz32ZOoVv6owIxpMqpmBc8ckNyHpn/Ua+",
+	"SbTHW6gQXgugEtnFtliYD8sr1CDP0Oc93lCONl+YuY54xJ8qYA9mpsq7AsSQLl7KLBZnzQUQOEEh4Ty2",
+	"sSAc+ryt4P03hYIPduTIK0zcrKrVt1Z8W1Wrv4mBjA7b/UFN5+jHdW0w0U4ItwBf9R9Ffgm/DPZ3iFrR",
+	"r/WXcprSzS/ohj2w3ThA4oZJIfWiU0h9y9q+o+IoBTcWAOMrnxoN7Vir/SxwsJlQ/YTE9X+VM6bcVQjO",
+	"tmfoA2PN+S+c1c70MJ2OdV3ueb9IRk8+/N5jKoksVzH8o75feh6jH63u5ymNH770VA0iWUfqJF2Znp85",
+	"Ux5fR4npYc+cb0+h6DGDZU06dQ17OfA4iZRVtUtEJh+tnMnHDYA4uxXoFjgggW8y3o2dReOs/SwgXC9J",
+	"bUQOPjVn8cm0iGC3SaPcV7Lfp6cZIbYzmndATRWQGMEbhrRg5olLgfQATJ0Dto98Y66ufveqDbtFBkil",
+	"2TCOFJhpv57b6VjvsMR6CMP4kZCYS+2gwVmH/mRcXneAG+BKU6JMIu36G9NDtH/CO4MVbZx7uhrtiILU",
+	"jzWL5uB85g7y/NYlj7V3oig/z6bLHZ30Cv1cwoF3xtYEJQws2mforwBfjYJ1cfkJ3ep/rWFLKFV7yij6",
+	"yGiDDxXSglRU6Pcecwnc2E2VTLXtrykbuOVpukANPrhF9EJRC0hFLOLsmq6qSGo7me0k9qpa2Yms5M5s",
+	"RGxov6cn1UOapxZUuo/A1RX1OD9P3+mORNS5/lWJ0Qe3BGubjyYoN/dHba2ZINoNfZGyF1+8008tro2O",
+	"kzBWn8hsvPA+OGbA0Xxz+FWHXWQfMa5I/TXlF/3GfEbauU8/XtwQQcxfkRJfrgumpI26X/23GracQLuI",
+	"aubmjAjF6ImXzo0y8f4RrfLB1qbR9TbtzBs8Xew13jASZ2P0rn3YfV92gYkJOyy3Gm3zAKwU0UQhWMfZ",
+	"jGguAGEaWjTEhf2A1rBhHBCmB29jYBy56JoKEYk6fFCRJc4p1yrZ6r0Ko5pDQ7SLbVNyUXM/zG9uHLAz",
+	"Rrj12NLDDExVowVnsBx83O/o/22e6BKvQNkVD/3CRpcb+w1dvKsil3jrVw4i0CbbqDfiwVv/Ud7ho903",
+	"07gGVexY4X5Myk60PqAGNrhvZf7h7mgncda2LOnB/RZzfkA97QU01qHVuHFLFpmQ3GZMNX6tCKT0SL+6",
+	"jXYwsWg/6nEv8+Q99OgeuG4z67itgcoQaGRXnQmJzFkObaOxydAHKiappIw+72BRvfct+zjZNI8a1yqJ",
+	"G8kqFcYWjhvm1vxkopCCArE+ILespLcqseFiI4VInaHbHbOz7QCJHebB2VLzWSLUMUO4ZXSrZZFpuG9J",
+	"ei5O6hGWsjwotk4UNOdAunXPheJ4M+4WQ0RKpqSE6wqNlRPrg2nY7Vt2AJ4+pnqNC1zjUjfSbzrblKcY",
+	"B/hBrQ21eA3BxcZjWO7gYK256pUcGlRjAT8QKoCaALT2cIRaaAW+trUdZZTjZOFpUbUYIHcNiiQUho/k",
+	"QppCot2f+I+t4lXMc6Q4+nX5BnDxbj46dIF3JC6XaeAmVq7xo6k3RS5f8Z3d0jKgYBueGCG0n1aHldwg",
+	"rVQrPRwOhwqp/338WKGmqdC//VuFuk4rmELYs9A0Zx8/nqm2yTcEqEmH20vYY44lywY0CWRbIuGaVgiI",
+	"PpMNk0g/CHcdTs+hZffb5ecPLXJ1/JXaRa8UGIw660qH93to1IzQ7WVmVS3piExK98v/RhsCbSOQb1UZ",
+	"2Bf0jIzam9YVHREMtjWB7hyJeYtLTmV8KCVwGJHkuiDJAZ5RvArW8zopBlgMLhHm1nQDOVVj/KRgxh3F",
+	"wgWz+IhTZXYnNmeM9sdaEaanqJXAqQkwNG8HA/2ACC/51wd7cDFS+QGUXUlCp++s+ABwlLRwJ6+QEIhm",
+	"SVE4xkSPCbfVr5Td0tkompbV2LnB3OcwZTbhi70uJ/ivJ9GJI5/G4/BZ3WNb7NgexVe9R6Lt496+w0ov",
+	"JHR3cKfIP1ePhl4ILlpU0r03wn3V9EdTMo/SW/IYi/wkpkjzxzVrBbGWBmTj4xG21wMaYtQH577sxu+b",
+	"v0vGoL6nzcivNDtF8e1zOGU1XXoehT1XI2bvnUdSHqstvUMcSPZ4V8VHvAWpY9/07aK56tK1OyXt3gOf",
+	"2/i+hZyz5LI3Wd/CG9tUbx5tSHHHt6H1nAFzzwnjdrNG70h9a421qGW3wJFrim5w29vLO6i/dcCztvAs",
+	"W3K9suihqzw6Mji8BClbSEeDHqcdPpxES3vlJ+wPAfYq51acGcOreUvjLPshF6t5Lg/V0ZZbMrq/2FNo",
+	"lM8ocVnJLs3wqOM36c1sijOelhzWuxRxfQd1GbmGiwlrtUZS4706+VxdU2N0cns0ue0REKghmw1w/WJa",
+	"gCLJ5paVoIfQfNg5opB5siAJT/VMZIoNJTzEnLg4kGPHOii4NRvufoznYJ4BYi5JTfZ4HP5eYJhKZO/C",
+	"d158mlHGp3mAnNRWffJawewd4Z7HChoy9NWJTIyPqSdMnHf8vOkbQUJyB28du4h5LL7/VsM+rfQ+iza/",
+	"hITJUtJRAWWa3oKOFASK6ZHCZPbCin2GufJrYZSVLh0G+BRWmiI3jPCsvaiQP5hX+D2cTjVk01u0i3Yz",
+	"kFWDPcts9uiaWOz3Muo69X6ZT1R6h5yDPvdH3ptFs2+igwDYZhNfs8pFxIZQInbHgVZKYjHGEsOUed0N",
+	"MZ9xvSPW/dErDXbsARrjbTAeRcOx83kthntxR3+haJBl4kwbf453xjTkUSCRwouM7bIMYzakogXMoSm2",
+	"tSjb5YhooNHvJQniNr9rvcXmfT3eMqg1WUjCdukNuw66FoSxC9tluQ/6pVbsdMrVNaC/A2c6qkWfp8IT",
+	"4ClpfJYiPIzNvk577/dOrQ/GaJdjrCwOekJvCfbAJyyzfMwJjU0QMrGIVmPiGezWMklOHWvZHvSVxbK5",
+	"pAdnyvZ1jGQYdn6YoDZwil257L00mtcn/l5rjpGqnQ28XebhZm3Rg+0Eex2WwAluyd+h+QuVpJ27c3iY",
+	"rI++eQ7GkX/ThvG7XUdInCVrNcCg4/ZR5H/2rlgazn/vHC5Jau6PDNH40rd3dujmfVuIVW+Cs4iMrH7T",
+	"G7A2TKinswYch9LPaBEZnaGrOOdJYOlkry/LAqRzF/FdFbTX1HvD7Mh2B0J6k1+FlDeIWhpu22EvmwhE",
+	"UZrx+D4qXdvYBWZ6o3RuKEfcy71xeIr8JE282e/bwwnTrIbvHgnnbJMHSjk3Mi5PSdR/Q5i6OZ0s7wBk",
+	"pYnIHJMuEvIdyDP0GUsJnBqq4rDtW6xThXIQQo15Td2r8dStyPhXWv9FHS6s7zAjFyVlqyRSIP2Ya0Vk",
+	"knJzj6oWxPGwM/5qswpTh7+9OcaKHfuIFwHmOmRfejtCj4JARXE0+CCSHp+aS6gWYzCOUZ/+amYoSVhv",
+	"CPIKREpXtjn4Z86GBLFwNLQMrnfJLFPDzp7vI9fjAfLzD4wuvqaAmyB1QC+jB7UxSff5KAHqcRLpEdWw",
+	"7IESHZJtjWOilha91klSWGvw4dPmo470yXkG6zgg/0d7QO45TVQhQl1pNkQYRylCFb/gErjpkt6vTRw2",
+	"PIf3EF+s8+bmIqoCtfiRfZ7iNchbADrE2Z+SflXDkDh+hEmgX1L87GuLxd7ipk1fY46QVg5lcx7WJpI9",
+	"J7KGucCGa/rodIvQyNBCx4RE3MSuS//aeGRWtRBfn9Ab8qVoPo40rPuDlIj4L7RwFKScurRRBFPMG6q9",
+	"VPv2Dh8WzuVSOCBiVB1IE8/gfC/3+GBC/Tr8jXTqlvjn/60ljPnHn1KnwYxQ8Oxjp0o8+aCealMCpkyT",
+	"OaPg3tw0j67Q+798mfV2HGF7hKkkmrM3wuI7RuqpIVG65ggTdeopxNwrLlUiBgJtM0ymMHgqiUx4CTak",
+	"RvjEG+DxCFjUq0pvWLqP8zkfhxPK3XIEi+780TRVXHOH+RHJeHTvS9WnINpQz+GnSG53BEy0fvi9x23o",
+	"qdgIrmUoEpDHigGt+KX9bUgub5qEsCF2q1iSeWYmW8q4EZWW+sURRV2qlXbuSLihAtnuZIX06hwAJl9/",
+	"HXkYGoW7gT3QxkYX++gK5LF8rNuaBja1J1d4W6zlBCke9DW83UJjZKKGHm+T4vpIP+hc+OEV3maia/Wr",
+	"9VQvw1vni6rA1Fdwcy3at7i2gCfvokfemUcL0d0rA1RmHV/A4WS4kEwWerhV0GcuIKUesFd4+5e9yatz",
+	"IlfhTFB6PiBQd5itEyf6LtdN9F223s0kj0wXG4CzsJssppMFNO7n0oIQdfKirEd3HCKqBTLJvGrYRigE",
+	"4j1k9O+Kq6kHgAdO/OneN81isyhykUdjHmO1j2IsMU62hBYDG3yTShNwThboZ0yujWOxS7+DmcSNR71Z",
+	"2i6ZEm4zkUsKinAclUDhjMlZJ/s0i3k7TldUYJYxRS9SfqSf9Rc0BHIOqJJ4a4/yZMR18A/w9TLCRsQY",
+	"nt3NcfXF8LKQyH8UwHcOXsWWcd3hjpZx6xNVYhmPfbOMFqsmfqY0uqQ9vAm5sYvO8OPlq0vb3yK7lrL2",
+	"60YphebOyfUtncyTOdlPttPueSKnbzZR3gjldskp6nfWwmGtOnMNlT0Il3umoe5vueu5/XPDiflDYNlz",
+	"+2eve/+WIlIBda+eRJSVrbNZVgFz4G96uQv/cmGNq3//65X1E+/0y4b+GjZlJ6XClrY/bVI64Kd3n1Rr",
+	"IlvV/FPPkUMeEsBNqLuq3Gaa/+nsx7MfXWIKvCern1b/on8y1Xc0uOf2kVv/Y5tKj/IFZK9t8G3rXsQF",
+	"EiZQam1Y7JnJBcF9ns3VB0KbN25ktfsmkFXP8ucff4yKh6o/8d4kFSaMnv/NZrQyFFTuEOarKY/V2e9V",
+	"2ilCIB4KxLpQsaMAmxXGnDOemr2nig3XCntg22hFr8P8kMG2Fk9MpK6B+oquHjdUJL1tH640tXtN8ckI",
+	"pjv1pnEbtTKnFIT8mTWHB8NEXOo6uxlIMvVs6P0sDsIEUgW2IXkP3yeU9KeHBHNSNzgP7imSTpIYdBt/",
+	"yM//IM33oNYltXTQY1BPTWssoFF3d4yUPt6CTpyS85XBHNBX2EtbZkF5CdrgVETk2TUNxa19yw3jztPH",
+	"D8dcpkENtnmRG9KtgTSQ7h5z3IEELrTKklIwcaA1n4+cqM+2FplRJo22MSS8Ktq6sRT4bUKU/5p3xDLz",
+	"NqdENqktV9FlWjWYFQjzNDIrFH4+XLw7etN0GuNH2rMfH2wfCpjdSQueBBnsU2Xqjfkl7jDdcdPmrofU",
+	"WFsebsOfUbb5pSwJtH96OpySVUKEna+Dx+sW5uzjYiCdEn6wNodZ8DX1ks0rTUMBR5vgEHt27V2ZlY3d",
+	"SK0okivxNhWPVYfEH+ZBMQo1c6KQcFOKLSUDY4bqvTjLz9iDnKxqPEXHtIOsZKECrg25dvHeFaLsdvj+",
+	"puH4vQd+CIBguYonLnrwegLG7jA9c67cUk+azw9hTR2yoRPx8g1x1F5Tmj/FzolDgpCRH/WWgTCv5Wl9",
+	"4csIhien79+e4vY6iXlZvMSO8HLSpDakC7QjQtqMKzGTz91x9bN7NAjdRt0Q3mJChZxkizlDb0Ijcw/e",
+	"4RtAjLYH7RDA9kBHkKVY7JtmRIJHazFujlNXZCYRBZPt/nmA4cHa3D6c4JV9uK6TPCiWxPGIHg1Pdlfj",
+	"El1HpQpgt+aPDuGaMyFGaQ+N505IISDO0Ju4av1AW7mmg0D+e6gpP7tVLByfOJA+6A/ePEDoXfOmpjSM",
+	"Ue6Op78rWqxc7gBk+sTZdZ8ye/cEashVp3UtMyf7sATbKa0C/GxHfApBbOYqEcAWqpM3Irv9KLQhm+Za",
+	"hPntqaK/Y1nqjT2uE20PSQOzxeqjiS63a7lduqt1+ccnFlUW2tM3Lq8dwsOBPw8xxrPnXuefrxCHDhNq",
+	"lLnGO45FOae1YDGZvy15KTtxlK/beItcU4UWO5T62uDDGbKIFM7zbDm3d1ZuxRX0F2RX5H09gFFUSDL1",
+	"cVEg+RzWmWtv6qU8K0Ed2si250lbxIsTpUewcBe9XsrIkSHf0xa0gw0dnr7ihx03SpnJ3nTz/Lvg5rP2",
+	"HPep31jsRp7yE4tHfpll3TYflCkYCWIb1mE08iZngb/bBr4I+3uJ7H8G6/siWCdtex8I+MaVfF4W7rpC",
+	"sxi4VVtxogZGXF8bb3ek3mlx1JKv0JrgY4G7EB3YMu2KLZT4xy2SpNMC2teeFjaBo1focJy7WfRryQEq",
+	"o6SG777wRt0yAV7NwDY1qP7rmgrSERVmal0Fs4pBAGbpWG18eX6dtKKVwE0l2ZQctQHA+ZNVFvcxhkGy",
+	"Yggke4T5dQDQIK5QOXD5gLlAYhX687KyYV3ZJuwmctvOAWB2HYVUFwgLnfxY0aPL5Iv5FrhtWqEfz378",
+	"0zJMkrXA7RNMClu54IYn0YlGdeYLtCLfw53pU1aMLB/xVOS5z5iBnf+hV3OhHzcEa29M1ELyXvwfAIqV",
+	"0cCWBmXoNduwcl1qNVrIM6RCOnTAIuv24Zt2c3GjuACTa9qAxCRUzTCjKZ3MP4kkeM8XA3c5+zFSvRnu",
+	"pzHeGgwk5bvF0ykIeb9UvfQ+Z52292u1MlXyX2/Q7Q5LtMN79cUJC7VRT6oK+IDuKcz/ERPGaZ4wTSMi",
+	"e7biONl5e5+uLj5QDXzqEFMUSomlqQL7n0TIyEP4wSXtU0vW+84XSlREE1bWwqHtKW2rU1qMS5clb+1x",
+	"cusj3vhjK/3SqossA9VS8u4C7JpML0fNIuGbviBoShxHiURvWsn54Js8bjZdd0VNJxiPvHSzK1LNfh7i",
+	"bTbE1cf0JubWUzLeAM9M5r6Vz2Wif3O6Vt9Fyp5fqxY7iiFkoNAVaY5U7eqeC8ZD7aNv0rAaM5HxUldf",
+	"9hxuCOt9IHieXgXjz/YkZHndZ7yFGSkn3BJPVymDKH6m5OUhysgTjPTJ54T3Po7pkWwKMxI70jKe90Uh",
+	"4GHuReH9SLeoJrryuGibDSq2R0dfjm4xp8NaVif2HAF+vyKNZNEk+pHdgAjmT18bwhlGFVIu3iHRq9WB",
+	"32sdAZizlAbSLNDJIdDSU9tKHV2ctj+635JSf/TJHi76o1tElPujR5v2MvzRC5jZifujT8hgyR+9UJSY",
+	"Hnc9si/COl4myZ7BPv5CqXJKZAmhcz7KaL/kNBvKojes7nU3ZIYwUidMViHWNiDknMfsm2jyIpoeVhh+",
+	"IR6zcwUAEo7ZAScvQWdGMQFl1WezKK3C/Pvn979U6POvvyDG0S8XH9B+xyRDulz853cfPF0NqcnmhjUL",
+	"RwqHiAjUgNSAXlMf+6AKvbp2lf6XsCXBTX/zIt0gQf6uHnU6Iq0W+Tc90hm66PAWBNIlcZDc9d2aYtKe",
+	"XdN4YzD3+sioIDMJGRwYrcEmN9r3OhOOh9IoZmkf3jDPM56JHIfv+laSPebyXJmEfmiwybcSRhulorVl",
+	"d739aE0oTmWiGGfrI8nSZLnTolBLWjjFIFy/mSbV8Cmd5OhQ6lMzOHALsuL8j/CPi/I43Qgb4dQoC6AG",
+	"wGR8dicuF0b7zMdjxvyHY9ASM8Uoe4S43YDcEw/dHcjDWY0jYNUecXXLJxLdYoH6fctwk3Ikecduqfr2",
+	"SipLGs2+2Qy3voRREyUhz7fkzl3/toftXfvu6dFdF4TGKR0SS7iTY3IMLz73LLTgoUtpY4Hnujgnhx+N",
+	"8ipS9bWbCmWzXHpy+K48PK+n0BPX3U/ChKg8fs1+vQCCDvSTIu2etqz+mvd2+Iv+PrCeqB/M6wlGrqjL",
+	"KEKpQoIp0eETzZhKeuri4TV59YimEn/sIBcISIT2kEip7gasO5tozKKf3Kpq5j0tXcFvMJpWPPIEw7gU",
+	"y0/6l5ID7kx4ydxjvnk90BqG1kkx2rRYIs5u0R78lU7RRq54Z9JBfSYKbVBfIEVP7/Uai/0JFOiGeUQT",
+	"MJ57OjRNZ+lqwSamnnDNIKn341f3hn8U94ZohgzpP2Rwxiin+qsjxj+mI8Z9Da8lF4Z4hBvanLE90G9d",
+	"a7qKH9hmQ2pwuu2Z2HPAjdgByK490/89fkq1v+e1uLm3Tmf4axwKfWp3FQOhGPnYkc4IZouDtAJ30Q27",
+	"GrsoRm8v/3sggneAG+BaCBtBipHAN0ZnwxQR2hIKqMP7vfq450x1Prumb0PcSdt3NK77bx1JtapYx6l3",
+	"lZeK3NliEB8Ya85/4awGG2/3jh8Q76lJyXCDW2KeVji7FRXCknWkRh1rQMNntA31zbTXWaEpo+BrR+kK",
+	"D9bgnJD8F10s+d+KmyXhb7CuIahQY2FdZLWqefHhNTB9VF2eyUSs61y51iOCMpTwK9waMD+bhujfLz/9",
+	"agI19R7Y/heN2gDKpBJLM/NcZNM/Ozq0eHdwPZhV2x+EyEqKx4T+pG+gBq8ure8UYvPd6gCnxKaWeM2Q",
+	"bRG6Zt8WzSam9bCAFjbvSLeYKEVHBwCHSPS0q/CFmqxUv391VHx1VIw90zWlZoSv/nY+rFaVlsTGr2w4",
+	"lnMe25IboFE5qzP0fkLuiolazxUziPqxhY1EPZWsV0bEhFukEGRLNfW/ja9Fd/UrOSpVupm89DE+wKdf",
+	"x3TfJ+W7U7BtEZGUWdu3Qdw2OqHHRg2cQPUAo3NUzDbfllXIBtZEmiSHuPap3D59+L8mx5Z6Uaw5NMru",
+	"h3kTMkKJM3QVd1J0SxqgkmyI8idYH9CHiyuVPVgwhFsOuDkEvj+YL8xhhhGmvNYZehPbnFrVjFCTpcgs",
+	"O7YfrH1QqCqYrHPP8bxq+Gnz7dRUwrzZgG2G+NLMo2GadzSwIRQQo5AByIL7tjhlw0txXFAk6qkRnbY+",
+	"V5nLjCZuD3K8p6ev8HmO4BcwZDZWpy7LBWWUf6VBDi8AmaRQgzvJ0+SGGkxZIuguhgs5+VRRI8TPpGQ0",
+	"F/LEbhm9uRfaR8sw3JQ71hCVj+b9OtqxpR16/qCOYwE+/UxRo9v8gD/8brwd7qKM/NfFB816KlRjsZuo",
+	"JL5wAHozUjOuaZme4dXy2x0ToOfzqkXDwJg6jLUa02D2uqbK4uUUE/QQesl/kc2rXrJs09evVPatbt2T",
+	"Vr/YHQ6HQ4XU/z5+rFDTWFNk05x9/HhmPn78eN4054dDSdYJCR/cC98/iMak6PpVY3oyjcmxrYnGRGvW",
+	"FShKtl0iqU4oyKSfvtM6k53lNV/Ns4QsGPQXKY52m09ZYXQkm1USVd5l3riG5jk75GjaOY8hTG2LGcOu",
+	"0hh1m0dUFe3m5DbDZbvmzQk6xFsQT5NcYioYcLvinIWWPo7JWOjJpcBhjPgNfuooXLtvJ+xZ7lBfFIB7",
+	"1D4FgVQeext26mWE3i4yldNn8IVBt6ZxLsT2bqfxRQTYlsiNZwivfZGUNyQmIys64MrPszChvG9dVKD0",
+	"ox/7KdQ/N1uJAughO3mbYdifwjQvrkOuSqlkSf3PY+/RTnLYn/x+vJQypR7e0zcQdgHt8XE/IqG1p6iy",
+	"SqW++Xyp0oxiGdFhgTDrIrp5auXS08BJJ8QOm1eW42V+t+f5fLmiGe/by1A1i7jXaXvhTElhITu665BT",
+	"O+9+Vl+E6lkqsJ5B/Xyx1DglrpRcUv/czqQOVlmAQUTaqI9l8iSLftX+0Ur04JbgoXzaQhN1XoPW2PUT",
+	"lWlaXdOEVBv2UtKtYzcmgwyRNg9ApqEVEalHKL2WWFM+8ijp+TQCTvk4uQXq1c5r5G5Np32g/vXH//P4",
+	"h8k/koZQ0VGIJ+ZerU9S3ymd/fGpTZ58IXHBXVRHdblSRzdEmBBZ9Q+sShpsAUlSfwVzKlsspG00UlGH",
+	"oZH7UFtYl+SymaOU371XgfQwuZIFjlAuJT72JL8Y/cesbe6U6B08aVXIs09fv8pGShuKtPs+44EcbliO",
+	"Rtgm0Mi+V8MLQGLfEmlkkw710GaMM/ReF93yv1j5E4eGX1NlqJMCsVsav+lg7gKA3bz6EU87WXjCFcy+",
+	"5wokFZzR3AiLa0poQ25I0+Pg6HmG/jO0UYEbrJcI+/BAXeUlmpRRyJdyVS1Wj1lEVU+QrkOqoXshJgQH",
+	"7sllWPLETTVBaBca7tEenQ9vQli4UTqy8XmTAkFWcSY2o0IpfpwtVa3GKb9l8kARL+OSWUDdp33FdBi3",
+	"N8wJtYwzNy4m3svl2bOC3A5uEu1pjz2b62KU4M7kcwyEd021A5mOcleuO4NCR7xv1dzJbIHzfO8uuZu4",
+	"Z5mvWfBes+DdIwvehEnH9euP4NVRN3N+ejrNaOLvxa4Quyl5o34JvmNKOTEnTf0elYRyZamGzW2dY3+T",
+	"rlvQhbXt7zmte1h//CjpEC/1JQmJCO68b9+kLrt19Ttx+RHDPBQjE3oeZNJJ2jK/wL7Fta0S5UlX3w0b",
+	"xDbqNVzpOWwPdDT3VAW5IvXXIU7vQmenbKQZru59CHDLGyY2rA+e2CPPy6e033wZF/ZfPg6ndw4UhQlN",
+	"lqNzMIw1TB4Fk0Asr1N90N8hxeN3WU6s/e9BZLix0rmio+SPlw6n1QYr7ZQ4qqRqM5j1VJLWJ/LK8HYi",
+	"dvc/ccbHVWPnZbH2F0rFWUrz1Ntzhcsfykubqcwkrlugsyh7tYK56bPBbF9c5yhxweM7pIxnLXFM+TJd",
+	"5ql7qEx3ptRVZdKzMkav2hqzLC/R2nd0HxMmSblmLep708NZ5lI2xP9jWqVGO12ws88fDVdsqBoBfvpO",
+	"LxPKyvGeRTeYS8n2lpWNadVF2Bv6DFQ7lIQ682bG2SVBoWXibUpJT+39MqWKk3aDmeKs1B8m0bPYMWa8",
+	"v0ddTqd7/GLup3dghid/L82Rz4IPzaRnCJld5htK6DkxV11TnZit39esU+PFYnLD2pbd2rRHtzZ3XDLf",
+	"rwbsAfnOi/DkubN8fgbXnn+c4zNzCGbF8XlE2Yt3g+Rx0K+xU3L1L5tNY99Ru1itnPLwzypRGNx+iuC5",
+	"20l5nJzwLoKzJUIO2QFnXYUka3BB2PUDJTKe5nqLIXqAdG9PEkMatrrktvYpWuEpnkBLv+lTUnwGz/9Q",
+	"hDCrJX8BlVlZHXWb4AEx7nPVBwHnrjqgU2lZKZWowK8Gi3bi5M6cfftgMYiJuZKyI3vSHiFs+p9FTfPE",
+	"Z9RxNjjESQXt8ivZC0ekUdfEQUFfAfbB70b4x2dLxjNJA40EfKXkR9PtAmrff6thn7OahmbIFDUQryrd",
+	"nY5a7tjEJ85IFe2AltXefgEKpq5EWK3qMT1CrqV953xNrbE4v0mjqX3igd/gNjN39Plu1TQu3AApHOCt",
+	"GFWYCK+veKtclE1+aHrw+cVNpnOTRSqHL7wVqyql003SYY9UtweDkDK5BCV8U7U04OrhgB3mH02BzDYD",
+	"SBl3DyNxFY8SFA9qfpwa7OXIf/vgyzD8bWYZ2g2f0MFaChDOONkSitu3foIHgvhpCou07BaEjGwmyjHf",
+	"+gO4paFj6r10hL7pWE9l5k7K+nWctd5cN1OQ7ch298Cg4W8PA9p9qrHYVqdcDqcuTnz7uKnT875R7wda",
+	"x0mqWmkVSQwVrPP14QcXH3C0shWXE3FRCe7fqMZcs10ihSMatrHvvbqz0x9UsJz/25vYxm6CflxdjPHv",
+	"wJkdNcoEqYjSHEFoTEyDo9Br2pKv4M7uSGVMWLndil0i8lfl8VV5fFUeX5XHV+XxVXl8VR5flcei6iV5",
+	"7dG1eBnqYz2Edqg/1ljsNi27LVAeXZ7R4KVAG0RB6rhppxCcIUPkpcVmr+lMtVnCkXlB1trfUO3TE8zr",
+	"fmL3oWW3r7rfS9L9/ul4zYBIk7xG7JA6oS+F2QzBHXEb1u0xhyyzeWu+izibv7t6mgATAYrdEGYV7PAZ",
+	"zPVVD0AEo7aZTUiPUceo3F1THxCqIz31jwijA2CO8JZpluabSI5Jax7+ONjGouCifE3tTdmAIAYFEdeC",
+	"tb2N2d8Dr4FKvNXOkxIn16nHrKISVHYRng1brdE2j5XGors1uuPV2mP6WAY7XePzsNliOB6F2Xp8BBPJ",
+	"mHZFXolTp+TDEnq89l8CYcGlly3BWzky0odL11lG2Gbm113nF3TFnnI5TowNzpkNODA5ZPQBreIPlMnw",
+	"cVG62JZHFLZ+tYq8WkVerSKvVpFXq8irVeTUbipjfS91V/FtXshlZQyvva30pTUVfZUxG42rOqrjsOeE",
+	"cSIPRgfKxAb1T1VXUc1UFHvav4QiimZ3SiNM+xbOkFmY1uwU6CZlZzBjNU0oDa6OZRVq5jKuGApwgluV",
+	"B+uaal12GvGaSwvUP2b1RbOv6X18MVnQ+hdRZZH3rrai+kucK3gOMwmsNJlF9DoguElxsQrpyC5jAW2G",
+	"YWAmAilVkFyBEJjII2QD6VvQk6Sw984DH1f1f1qvWgffTHFx9fkU64pb+ohogwg5DKEPxCZByDytOd54",
+	"uyP1ztGWTnlqGFu4gu1MajOX1aMKvxBTblOXCE4kvgEhLSebNfGEyJ8sCAypxYSJ5/Wy0qCgZ+Guelee",
+	"mt7VVuSBUqg9ybw2ILSz+JiLlpeg0MRyTH2yEnq10RZ2P588gF7Ne9ox830bxTkvhcmX75DTfo+Ihu/b",
+	"lxQAP8c5TjzOfbDlS6HtfRs/Bzv1xWrSZijYMA4mIxRs5HKs1PHn9mUEoC8Ik+eIM39pVDokPP3xXADm",
+	"9S57V7/UnyPHV2vpEZUx4FFfI8I/55mf1gd0y3gjzpA7G1ppUhpa/BCnenr655j6HP0t3GBax6dD97dn",
+	"g3CT2NsZpwS6hbZV//XfQ71zNUcA9gy9cxm/sbabGSVc6JvtYXjNoO2hcq2IUEaJGpDJAqsvwesD+r3H",
+	"VBJ5SN1fDfKWDqNGk5rXbAXaMJ7R5n6/X7hiykPDr3XJVePhXTOWp5bsASbuCCUdbt02HjP/g1l9dWT/",
+	"HWG4o3n3McWzoev8pdF8P83wyxE/G/Au4m6Nzqq8aMK0RlrX3j/saluztLYwu3b30qV6+vSNPgWblf1p",
+	"Xe/SwfOom2rnSG6oXd8p617jTVDIX9TBCjYwp2gNNuXhdZ6i/XgexefFkorb9TSpuJPfwkJ2fVcxHCPT",
+	"/Id+j/b4YBK/2sTcO9YL2LG2QR0oliySCRYv/XSPZ+SO5sjsl/l60vXjIzBPuYa8owiL7Wp1LnGBGJHY",
+	"0GIQSoPaC4SjXigft5r1pZWDraPH47+NXeFtydOYgufkX8b0ZvltW6qhN0iNrrdQb5i33Ei8VU+Zxq/S",
+	"5a5LV6/zu/XwHOAKb7Ml466sv9HTV4u7wluXSC+nRWqoo6eHE6oXF/tiRTXjDPmcXLm4EVX/oZjG95mE",
+	"//ZOn6TfCnH1Xfv0MlNvy767qNadnXAHXSoplRpYsYulVxDt+7hxx8rIJfVj2kRlvzy7keoKb80SUzv1",
+	"K9zqtSSBfeaDZqB+GSdN4u0pnbDBYbFnjGMqNpqyl/THjlE42BKoTm+01WDEGbpyA3kvQ1dszxYFoOpk",
+	"IrFjt6p2DKHj+J6MS4Ub9/E0Tj9DSuTYbyetbXogT1vXlAHPhvDEblnVVK2gSdo/Dsq7VXIAUaGO6afQ",
+	"GqhsD+6VzRQByyicauALrTE+idrppitSPlVjW5zxlHVQGeCM9tSkk+QgJOOz6qhuYAkj3mRT/e12x1qY",
+	"bLWymROJbnEyjaQeMaC6rCTbdPI6BCMht45Tf4OMCCwlMvUimmjHnkxwfsbaNOVxSgTqiNAxoIw7V2IN",
+	"12mR+JA6Lc4MkZN9mbeobhldf4XE3BRJy/EkNfLTsCOyL+NEagUnfw/WeCv0EFWNozdsXalo7Uo+qiPC",
+	"ztJqCNk/pgqi9iON/5dSNkTDevqendKg2h3kI3ySNOWkPF6qiaunbhqS+tMGNeCKX3LW+TY5byZLbUXi",
+	"y1DIU3sz6d2OvJlO5BK2Bm2KtGdFWjZ3eq5WhpYKXa2yhJcXI+WuVj6w6UW4Ws0yytN2tRpu+YKrVZo5",
+	"mO/HM4cX4TK1JAOf4eXwxVHbkIDGYs6mZShI/aIJx9lvfPZAzr4CRY1yZYoyCWrxpuLZ8OEMXSVi43S6",
+	"wMCQ0Y514MPjjFN6g0l7cJHWSOw54EYEAJiyHDlNT00kcrWB1c6U5ScYnhDu+pw++8uH41myfAGBeDJA",
+	"asYRwG/cPvW8Xf202km5Fz+dn/+xY0LqN4BzvCeranWDOcFrG7HnPhpitstctazGrfqkBv/t+/8bAOVp",
+	"FIZoggEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -5,6 +5,67 @@ package ports
 
 import (
 	"time"
+
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
+)
+
+// Defines values for AccountType.
+const (
+	AccountTypeBank AccountType = "bank"
+
+	AccountTypeCard AccountType = "card"
+
+	AccountTypeCash AccountType = "cash"
+)
+
+// Defines values for BudgetPeriod.
+const (
+	BudgetPeriodMonthly BudgetPeriod = "monthly"
+
+	BudgetPeriodYearly BudgetPeriod = "yearly"
+)
+
+// Defines values for DuplicateResolutionAction.
+const (
+	DuplicateResolutionActionDelete DuplicateResolutionAction = "delete"
+
+	DuplicateResolutionActionMerge DuplicateResolutionAction = "merge"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv ExportFormat = "csv"
+
+	ExportFormatJson ExportFormat = "json"
+
+	ExportFormatXlsx ExportFormat = "xlsx"
+)
+
+// Defines values for Frequency.
+const (
+	FrequencyDaily Frequency = "daily"
+
+	FrequencyMonthly Frequency = "monthly"
+
+	FrequencyWeekly Frequency = "weekly"
+
+	FrequencyYearly Frequency = "yearly"
+)
+
+// Defines values for ImportMode.
+const (
+	ImportModeAtomic ImportMode = "atomic"
+
+	ImportModeDryRun ImportMode = "dryRun"
+)
+
+// Defines values for ImportRowStatus.
+const (
+	ImportRowStatusAccepted ImportRowStatus = "accepted"
+
+	ImportRowStatusRejected ImportRowStatus = "rejected"
+
+	ImportRowStatusSkipped ImportRowStatus = "skipped"
 )
 
 // Defines values for Interval.
@@ -13,9 +74,196 @@ const (
 
 	IntervalMonth Interval = "month"
 
+	IntervalQuarter Interval = "quarter"
+
+	IntervalWeek Interval = "week"
+
 	IntervalYear Interval = "year"
 )
 
+// Defines values for ReconciliationStatus.
+const (
+	ReconciliationStatusFinished ReconciliationStatus = "finished"
+
+	ReconciliationStatusOpen ReconciliationStatus = "open"
+)
+
+// Defines values for SortField.
+const (
+	SortFieldCreatedAt SortField = "createdAt"
+
+	SortFieldDate SortField = "date"
+
+	SortFieldPrice SortField = "price"
+)
+
+// Defines values for SortOrder.
+const (
+	SortOrderAsc SortOrder = "asc"
+
+	SortOrderDesc SortOrder = "desc"
+)
+
+// Defines values for SplitMethod.
+const (
+	SplitMethodEqual SplitMethod = "equal"
+
+	SplitMethodExact SplitMethod = "exact"
+
+	SplitMethodPercent SplitMethod = "percent"
+
+	SplitMethodShares SplitMethod = "shares"
+)
+
+// Defines values for TrashItemType.
+const (
+	TrashItemTypeCategory TrashItemType = "category"
+
+	TrashItemTypeExpense TrashItemType = "expense"
+)
+
+// Defines values for Weekday.
+const (
+	WeekdayFriday Weekday = "friday"
+
+	WeekdayMonday Weekday = "monday"
+
+	WeekdaySaturday Weekday = "saturday"
+
+	WeekdaySunday Weekday = "sunday"
+
+	WeekdayThursday Weekday = "thursday"
+
+	WeekdayTuesday Weekday = "tuesday"
+
+	WeekdayWednesday Weekday = "wednesday"
+)
+
+// Account defines model for Account.
+type Account struct {
+	// Embedded struct due to allOf(#/components/schemas/NewAccount)
+	NewAccount `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// Unique id of the account
+	Id string `json:"id"`
+}
+
+// AccountBalance defines model for AccountBalance.
+type AccountBalance struct {
+	AccountId string    `json:"accountId"`
+	At        time.Time `json:"at"`
+	Balance   string    `json:"balance"`
+	Currency  string    `json:"currency"`
+
+	// Expenses paid from the account in the account currency
+	Expenses       string `json:"expenses"`
+	OpeningBalance string `json:"openingBalance"`
+
+	// Transfers to the account in the account currency
+	TransfersIn string `json:"transfersIn"`
+
+	// Transfers from the account in the account currency
+	TransfersOut string `json:"transfersOut"`
+}
+
+// AccountType defines model for AccountType.
+type AccountType string
+
+// AddExpenseResponse defines model for AddExpenseResponse.
+type AddExpenseResponse struct {
+	// Embedded struct due to allOf(#/components/schemas/NewExpenseResponse)
+	NewExpenseResponse `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// Set when likely duplicates could not be looked up, the expense is added unchecked
+	DuplicateCheckFailed *bool `json:"duplicateCheckFailed,omitempty"`
+
+	// Likely duplicates of the newly added expense
+	Duplicates *[]Expense `json:"duplicates,omitempty"`
+}
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType  string    `json:"contentType"`
+	CreatedAt    time.Time `json:"createdAt"`
+	CreatedBy    string    `json:"createdBy"`
+	FileName     string    `json:"fileName"`
+	HasThumbnail bool      `json:"hasThumbnail"`
+	Id           string    `json:"id"`
+
+	// File size in bytes
+	Size int64 `json:"size"`
+}
+
+// Balance defines model for Balance.
+type Balance struct {
+	// Net amount, positive amounts are owed to the user
+	Net  string `json:"net"`
+	User string `json:"user"`
+}
+
+// BalanceSheet defines model for BalanceSheet.
+type BalanceSheet struct {
+	Balances []Balance `json:"balances"`
+	Currency string    `json:"currency"`
+	Debts    []Debt    `json:"debts"`
+}
+
+// Budget defines model for Budget.
+type Budget struct {
+	// Embedded struct due to allOf(#/components/schemas/NewBudget)
+	NewBudget `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// Unique id of the budget
+	Id string `json:"id"`
+}
+
+// BudgetPeriod defines model for BudgetPeriod.
+type BudgetPeriod string
+
+// BudgetStatus defines model for BudgetStatus.
+type BudgetStatus struct {
+	// Amount available in the period including the rolled over amount
+	Amount      string       `json:"amount"`
+	BudgetId    string       `json:"budgetId"`
+	CategoryId  string       `json:"categoryId"`
+	Currency    string       `json:"currency"`
+	From        time.Time    `json:"from"`
+	PercentUsed string       `json:"percentUsed"`
+	Period      BudgetPeriod `json:"period"`
+
+	// Amount left in the period, negative when overspent
+	Remaining string `json:"remaining"`
+
+	// Amount carried over from previous periods
+	RolledOver string    `json:"rolledOver"`
+	Spent      string    `json:"spent"`
+	To         time.Time `json:"to"`
+}
+
+// CashFlowPeriod defines model for CashFlowPeriod.
+type CashFlowPeriod struct {
+	Date     time.Time  `json:"date"`
+	Expenses GrandTotal `json:"expenses"`
+	Income   GrandTotal `json:"income"`
+	Net      Total      `json:"net"`
+
+	// Percentage of the income left after expenses, missing for periods without income
+	SavingsRate *string `json:"savingsRate,omitempty"`
+}
+
+// CashFlowReport defines model for CashFlowReport.
+type CashFlowReport struct {
+	// Currency net amounts are calculated in
+	Currency    string           `json:"currency"`
+	Expenses    GrandTotal       `json:"expenses"`
+	From        time.Time        `json:"from"`
+	Income      GrandTotal       `json:"income"`
+	Net         Total            `json:"net"`
+	Periods     []CashFlowPeriod `json:"periods"`
+	SavingsRate *string          `json:"savingsRate,omitempty"`
+	To          time.Time        `json:"to"`
+}
+
 // Category defines model for Category.
 type Category struct {
 	Icon *string `json:"icon,omitempty"`
@@ -29,14 +277,92 @@ type Category struct {
 	Parents *[]Category `json:"parents,omitempty"`
 }
 
+// CategoryAssignment defines model for CategoryAssignment.
+type CategoryAssignment struct {
+	CategoryId string `json:"categoryId"`
+	ExpenseId  string `json:"expenseId"`
+}
+
+// CategoryAssignmentResult defines model for CategoryAssignmentResult.
+type CategoryAssignmentResult struct {
+	// Number of moved expenses
+	Updated int `json:"updated"`
+}
+
+// CategoryComparison defines model for CategoryComparison.
+type CategoryComparison struct {
+	Category Category `json:"category"`
+
+	// Totals of the category subtree and deltas for every comparison period
+	Comparisons   []TotalDelta          `json:"comparisons"`
+	SubCategories *[]CategoryComparison `json:"subCategories,omitempty"`
+	Total         Total                 `json:"total"`
+}
+
 // CategoryExpenses defines model for CategoryExpenses.
 type CategoryExpenses struct {
+	Budget        *BudgetStatus       `json:"budget,omitempty"`
 	Category      Category            `json:"category"`
 	Expenses      *[]Expense          `json:"expenses,omitempty"`
 	GrandTotal    GrandTotal          `json:"grandTotal"`
 	SubCategories *[]CategoryExpenses `json:"subCategories,omitempty"`
 }
 
+// CategoryReport defines model for CategoryReport.
+type CategoryReport struct {
+	Categories []CategorySeries `json:"categories"`
+
+	// Currency totals are converted into
+	Currency string `json:"currency"`
+
+	// First dates of the report intervals
+	Dates      []time.Time `json:"dates"`
+	From       time.Time   `json:"from"`
+	GrandTotal GrandTotal  `json:"grandTotal"`
+	To         time.Time   `json:"to"`
+}
+
+// CategorySearchMatch defines model for CategorySearchMatch.
+type CategorySearchMatch struct {
+	Category Category `json:"category"`
+
+	// Relevance of the match
+	Score float64 `json:"score"`
+}
+
+// CategorySeries defines model for CategorySeries.
+type CategorySeries struct {
+	Category   Category   `json:"category"`
+	GrandTotal GrandTotal `json:"grandTotal"`
+
+	// Totals of the category subtree for every report interval
+	Series        []IntervalTotal   `json:"series"`
+	SubCategories *[]CategorySeries `json:"subCategories,omitempty"`
+}
+
+// ComparisonReport defines model for ComparisonReport.
+type ComparisonReport struct {
+	// Whether totals are averaged per interval
+	Average    bool                 `json:"average"`
+	Categories []CategoryComparison `json:"categories"`
+
+	// Totals and deltas for every comparison period
+	Comparisons []TotalDelta `json:"comparisons"`
+
+	// Currency totals are converted into
+	Currency string `json:"currency"`
+
+	// From date of the base period
+	From time.Time `json:"from"`
+
+	// Comparison periods
+	Periods []ReportPeriod `json:"periods"`
+
+	// To date of the base period
+	To    time.Time `json:"to"`
+	Total Total     `json:"total"`
+}
+
 // DateCategoryReport defines model for DateCategoryReport.
 type DateCategoryReport struct {
 	CategoryExpenses []CategoryExpenses `json:"categoryExpenses"`
@@ -45,6 +371,30 @@ type DateCategoryReport struct {
 	GrandTotal       GrandTotal         `json:"grandTotal"`
 }
 
+// Debt defines model for Debt.
+type Debt struct {
+	Amount string `json:"amount"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+// DuplicateGroup defines model for DuplicateGroup.
+type DuplicateGroup struct {
+	Expenses []Expense `json:"expenses"`
+	Id       string    `json:"id"`
+}
+
+// DuplicateResolution defines model for DuplicateResolution.
+type DuplicateResolution struct {
+	Action DuplicateResolutionAction `json:"action"`
+
+	// ID of the group expense to keep
+	KeepId string `json:"keepId"`
+}
+
+// DuplicateResolutionAction defines model for DuplicateResolution.Action.
+type DuplicateResolutionAction string
+
 // Error defines model for Error.
 type Error struct {
 	// Error code
@@ -78,34 +428,206 @@ type Expense struct {
 
 	// Unique id of the expense
 	Id string `json:"id"`
+
+	// ID of the receipt the expense is a line item of
+	ReceiptId *string `json:"receiptId,omitempty"`
+
+	// ID of the reconciliation the expense is locked by
+	ReconciliationId *string `json:"reconciliationId,omitempty"`
+}
+
+// ExpensePage defines model for ExpensePage.
+type ExpensePage struct {
+	Items []Expense `json:"items"`
+
+	// Cursor to fetch the next page, absent on the last page
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
 // ExpenseReport defines model for ExpenseReport.
 type ExpenseReport struct {
+	// Currency totals are converted into
+	Currency    string               `json:"currency"`
 	DateReports []DateCategoryReport `json:"dateReports"`
 	GrandTotal  GrandTotal           `json:"grandTotal"`
 }
 
+// ExpenseSearchMatch defines model for ExpenseSearchMatch.
+type ExpenseSearchMatch struct {
+	Expense Expense `json:"expense"`
+
+	// Relevance of the match
+	Score float64 `json:"score"`
+}
+
+// ExportFormat defines model for ExportFormat.
+type ExportFormat string
+
+// Frequency defines model for Frequency.
+type Frequency string
+
 // GrandTotal defines model for GrandTotal.
 type GrandTotal struct {
 	SubTotals []TotalInfo `json:"subTotals"`
 	Total     Total       `json:"total"`
 }
 
-// Interval defines model for Interval.
+// ImportColumns defines model for ImportColumns.
+type ImportColumns struct {
+	Amount string `json:"amount"`
+
+	// Column holding a category name or names path, e.g. Food/Groceries
+	Category string  `json:"category"`
+	Comment  *string `json:"comment,omitempty"`
+	Currency *string `json:"currency,omitempty"`
+	Date     string  `json:"date"`
+	Quantity *string `json:"quantity,omitempty"`
+}
+
+// ImportMode defines model for ImportMode.
+type ImportMode string
+
+// ImportProfile defines model for ImportProfile.
+type ImportProfile struct {
+	// Embedded struct due to allOf(#/components/schemas/NewImportProfile)
+	NewImportProfile `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Id string `json:"id"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	Accepted int `json:"accepted"`
+
+	// Whether accepted rows were saved
+	Committed bool        `json:"committed"`
+	Mode      ImportMode  `json:"mode"`
+	Rejected  int         `json:"rejected"`
+	Rows      []ImportRow `json:"rows"`
+	Skipped   int         `json:"skipped"`
+}
+
+// ImportRow defines model for ImportRow.
+type ImportRow struct {
+	Expense *Expense `json:"expense,omitempty"`
+
+	// Reason of the row rejection or skip
+	Reason *string `json:"reason,omitempty"`
+
+	// Data row number starting from 1, the header is not counted
+	Row    int             `json:"row"`
+	Status ImportRowStatus `json:"status"`
+}
+
+// ImportRowStatus defines model for ImportRowStatus.
+type ImportRowStatus string
+
+// Income defines model for Income.
+type Income struct {
+	// Embedded struct due to allOf(#/components/schemas/NewIncome)
+	NewIncome `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// Unique id of the income
+	Id string `json:"id"`
+}
+
+// Report interval. Weeks are ISO weeks beginning on Monday, months, quarters and years begin
+// on the period start day of the user settings.
 type Interval string
 
+// IntervalTotal defines model for IntervalTotal.
+type IntervalTotal struct {
+	Date       time.Time  `json:"date"`
+	GrandTotal GrandTotal `json:"grandTotal"`
+}
+
+// Merchant defines model for Merchant.
+type Merchant struct {
+	// Embedded struct due to allOf(#/components/schemas/NewMerchant)
+	NewMerchant `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// Unique id of the merchant
+	Id string `json:"id"`
+}
+
+// MerchantMerge defines model for MerchantMerge.
+type MerchantMerge struct {
+	// IDs of merchants to merge
+	MerchantIds []string `json:"merchantIds"`
+}
+
+// MerchantStats defines model for MerchantStats.
+type MerchantStats struct {
+	// Average spent per visit per currency
+	AverageTicket []Total    `json:"averageTicket"`
+	LastVisit     *time.Time `json:"lastVisit,omitempty"`
+	Merchant      Merchant   `json:"merchant"`
+
+	// Total spent per currency
+	TotalSpent []Total `json:"totalSpent"`
+
+	// Number of receipts and expenses without a receipt
+	VisitCount int `json:"visitCount"`
+}
+
+// NewAccount defines model for NewAccount.
+type NewAccount struct {
+	Currency string `json:"currency"`
+	Name     string `json:"name"`
+
+	// Balance before any expense or transfer, it may be negative, e.g. for a credit card
+	OpeningBalance float64     `json:"openingBalance"`
+	Type           AccountType `json:"type"`
+}
+
+// NewBudget defines model for NewBudget.
+type NewBudget struct {
+	// Amount available every period
+	Amount float64 `json:"amount"`
+
+	// Category ID, the budget includes expenses of subcategories
+	CategoryId string `json:"categoryId"`
+
+	// Budget currency, the report currency of the user settings by default
+	Currency *string      `json:"currency,omitempty"`
+	Period   BudgetPeriod `json:"period"`
+
+	// Carry unused amount over to the next period
+	Rollover bool `json:"rollover"`
+
+	// Day of the first budget period
+	Start time.Time `json:"start"`
+}
+
 // NewExpense defines model for NewExpense.
 type NewExpense struct {
+	// ID of the account the expense is paid from
+	AccountId *string `json:"accountId,omitempty"`
+
 	// Category ID of the expense
 	CategoryId string    `json:"categoryId"`
 	Comment    *string   `json:"comment,omitempty"`
 	Currency   string    `json:"currency"`
 	Date       time.Time `json:"date"`
-	Price      float64   `json:"price"`
-	Quantity   float64   `json:"quantity"`
-	TotalInfo  TotalInfo `json:"totalInfo"`
-	Trip       *string   `json:"trip,omitempty"`
+
+	// ID of the merchant the expense is paid to, new expenses are matched to merchants by comment
+	MerchantId *string `json:"merchantId,omitempty"`
+
+	// User who paid the shared expense, it is set along with the split
+	PaidBy   *string `json:"paidBy,omitempty"`
+	Price    float64 `json:"price"`
+	Quantity float64 `json:"quantity"`
+
+	// Whether the expense is to be reimbursed, e.g. by the employer
+	Reimbursable *bool  `json:"reimbursable,omitempty"`
+	Split        *Split `json:"split,omitempty"`
+
+	// Free-form labels of the expense, they are compared case-insensitively
+	Tags      *[]string `json:"tags,omitempty"`
+	TotalInfo TotalInfo `json:"totalInfo"`
+
+	// ID of the trip the expense belongs to
+	TripId *string `json:"tripId,omitempty"`
 }
 
 // NewExpenseResponse defines model for NewExpenseResponse.
@@ -114,42 +636,975 @@ type NewExpenseResponse struct {
 	Id string `json:"id"`
 }
 
-// Rate defines model for Rate.
-type Rate struct {
-	Currency string `json:"currency"`
-	Price    string `json:"price"`
+// NewImportProfile defines model for NewImportProfile.
+type NewImportProfile struct {
+	Columns ImportColumns `json:"columns"`
+
+	// Date format built of yyyy, yy, MM, dd, HH, mm and ss, e.g. dd.MM.yyyy
+	DateFormat string `json:"dateFormat"`
+
+	// Amounts decimal separator, either dot or comma
+	DecimalSeparator string `json:"decimalSeparator"`
+
+	// Currency used when the currency column is not mapped or empty
+	DefaultCurrency *string `json:"defaultCurrency,omitempty"`
+
+	// CSV fields delimiter, comma by default
+	Delimiter *string `json:"delimiter,omitempty"`
+	Name      string  `json:"name"`
 }
 
-// Total defines model for Total.
-type Total struct {
-	// Total currency
-	Currency string `json:"currency"`
+// NewIncome defines model for NewIncome.
+type NewIncome struct {
+	Amount float64 `json:"amount"`
 
-	// Total sum amount
-	Sum string `json:"sum"`
+	// Category of the income category tree
+	CategoryId string    `json:"categoryId"`
+	Comment    *string   `json:"comment,omitempty"`
+	Currency   string    `json:"currency"`
+	Date       time.Time `json:"date"`
+
+	// Who the income is received from
+	Source string `json:"source"`
 }
 
-// TotalInfo defines model for TotalInfo.
-type TotalInfo struct {
-	Converted *Total        `json:"converted,omitempty"`
-	Original  Total         `json:"original"`
-	Rate      *ExchangeRate `json:"rate,omitempty"`
+// NewMerchant defines model for NewMerchant.
+type NewMerchant struct {
+	// Alternative names the merchant is matched by, e.g. a bank statement payee
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Category of imported expenses of the merchant without a known category
+	DefaultCategoryId *string `json:"defaultCategoryId,omitempty"`
+	Location          *string `json:"location,omitempty"`
+	Name              string  `json:"name"`
 }
 
-// AddExpenseJSONBody defines parameters for AddExpense.
-type AddExpenseJSONBody NewExpense
+// NewReceipt defines model for NewReceipt.
+type NewReceipt struct {
+	// Comment of the receipt, e.g. a shop name
+	Comment  *string          `json:"comment,omitempty"`
+	Currency string           `json:"currency"`
+	Date     time.Time        `json:"date"`
+	Items    []NewReceiptItem `json:"items"`
+}
 
-// GenerateReportParams defines parameters for GenerateReport.
-type GenerateReportParams struct {
-	// from date to filter by
+// NewReceiptItem defines model for NewReceiptItem.
+type NewReceiptItem struct {
+	// Category ID of the line item
+	CategoryId string  `json:"categoryId"`
+	Comment    *string `json:"comment,omitempty"`
+	Price      float64 `json:"price"`
+	Quantity   float64 `json:"quantity"`
+}
+
+// NewReconciliation defines model for NewReconciliation.
+type NewReconciliation struct {
+	// Account balance at the end of the bank statement
+	StatementBalance float64 `json:"statementBalance"`
+
+	// End date of the bank statement
+	StatementDate time.Time `json:"statementDate"`
+}
+
+// NewRecurringExpense defines model for NewRecurringExpense.
+type NewRecurringExpense struct {
+	// Category ID of the occurrence expenses
+	CategoryId string   `json:"categoryId"`
+	Comment    *string  `json:"comment,omitempty"`
+	Currency   string   `json:"currency"`
+	Price      float64  `json:"price"`
+	Quantity   float64  `json:"quantity"`
+	Schedule   Schedule `json:"schedule"`
+
+	// ID of the trip the expense belongs to
+	TripId *string `json:"tripId,omitempty"`
+}
+
+// NewRule defines model for NewRule.
+type NewRule struct {
+	// Changes made to the matching expense. The category and the trip are set by the matching rule
+	// with the highest priority, tags of all matching rules are added.
+	Actions RuleActions `json:"actions"`
+
+	// Conditions an expense should meet, all of them should be met. Patterns are regular expressions
+	// matched case-insensitively, the amount is a total of the expense in its own currency.
+	Conditions RuleConditions `json:"conditions"`
+	Name       string         `json:"name"`
+
+	// Rules with lower priority values are evaluated first
+	Priority *int `json:"priority,omitempty"`
+}
+
+// NewSettlement defines model for NewSettlement.
+type NewSettlement struct {
+	Amount   float64   `json:"amount"`
+	Currency string    `json:"currency"`
+	Date     time.Time `json:"date"`
+
+	// User who paid the settlement
+	From string `json:"from"`
+
+	// User who received the settlement
+	To string `json:"to"`
+}
+
+// NewTransfer defines model for NewTransfer.
+type NewTransfer struct {
+	// Amount in the currency of the source account
+	Amount        float64   `json:"amount"`
+	Comment       *string   `json:"comment,omitempty"`
+	Date          time.Time `json:"date"`
+	FromAccountId string    `json:"fromAccountId"`
+
+	// Exchange rate from the source account currency to the target account currency,
+	// it is required when the currencies differ
+	Rate        *float64 `json:"rate,omitempty"`
+	ToAccountId string   `json:"toAccountId"`
+}
+
+// NewTrip defines model for NewTrip.
+type NewTrip struct {
+	// First day of the trip
 	From time.Time `json:"from"`
 
-	// to date to filter by
+	// Currency trip totals are converted into
+	HomeCurrency string    `json:"homeCurrency"`
+	Name         string    `json:"name"`
+	Participants *[]string `json:"participants,omitempty"`
+
+	// Last day of the trip
 	To time.Time `json:"to"`
+}
 
-	// results interval
-	Interval Interval `json:"interval"`
+// Occurrence defines model for Occurrence.
+type Occurrence struct {
+	Comment  *string   `json:"comment,omitempty"`
+	Date     time.Time `json:"date"`
+	Edited   bool      `json:"edited"`
+	Price    float64   `json:"price"`
+	Quantity float64   `json:"quantity"`
+	Skipped  bool      `json:"skipped"`
 }
 
-// AddExpenseJSONRequestBody defines body for AddExpense for application/json ContentType.
+// OccurrenceException defines model for OccurrenceException.
+type OccurrenceException struct {
+	Comment  *string  `json:"comment,omitempty"`
+	Price    *float64 `json:"price,omitempty"`
+	Quantity *float64 `json:"quantity,omitempty"`
+	Skipped  *bool    `json:"skipped,omitempty"`
+}
+
+// Rate defines model for Rate.
+type Rate struct {
+	Currency string `json:"currency"`
+	Price    string `json:"price"`
+}
+
+// Receipt defines model for Receipt.
+type Receipt struct {
+	Attachments []Attachment `json:"attachments"`
+	Comment     *string      `json:"comment,omitempty"`
+	Currency    string       `json:"currency"`
+	Date        time.Time    `json:"date"`
+
+	// Unique id of the receipt
+	Id    string    `json:"id"`
+	Items []Expense `json:"items"`
+	Total Total     `json:"total"`
+}
+
+// Reconciliation defines model for Reconciliation.
+type Reconciliation struct {
+	// Embedded struct due to allOf(#/components/schemas/NewReconciliation)
+	NewReconciliation `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	AccountId string    `json:"accountId"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// IDs of the ticked off expenses
+	ExpenseIds []string   `json:"expenseIds"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Unique id of the reconciliation
+	Id     string               `json:"id"`
+	Status ReconciliationStatus `json:"status"`
+}
+
+// ReconciliationExpenses defines model for ReconciliationExpenses.
+type ReconciliationExpenses struct {
+	ExpenseIds []string `json:"expenseIds"`
+}
+
+// ReconciliationItem defines model for ReconciliationItem.
+type ReconciliationItem struct {
+	Expense Expense `json:"expense"`
+	Ticked  bool    `json:"ticked"`
+}
+
+// ReconciliationReport defines model for ReconciliationReport.
+type ReconciliationReport struct {
+	// Account balance out of the reconciled and ticked off expenses and the transfers
+	ClearedBalance string `json:"clearedBalance"`
+	Currency       string `json:"currency"`
+
+	// Statement balance less the cleared balance, it should be zero to finish the reconciliation
+	Difference string `json:"difference"`
+
+	// Unreconciled expenses of the account up to the statement date
+	Items          []ReconciliationItem `json:"items"`
+	Reconciliation Reconciliation       `json:"reconciliation"`
+}
+
+// ReconciliationStatus defines model for ReconciliationStatus.
+type ReconciliationStatus string
+
+// RecurringExpense defines model for RecurringExpense.
+type RecurringExpense struct {
+	// Embedded struct due to allOf(#/components/schemas/NewRecurringExpense)
+	NewRecurringExpense `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Category   Category                    `json:"category"`
+	Exceptions []SkippedOrEditedOccurrence `json:"exceptions"`
+
+	// Unique id of the recurring expense
+	Id string `json:"id"`
+
+	// Last day occurrences were added as expenses for
+	MaterializedUntil *time.Time `json:"materializedUntil,omitempty"`
+}
+
+// ReportPeriod defines model for ReportPeriod.
+type ReportPeriod struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// Rule defines model for Rule.
+type Rule struct {
+	// Embedded struct due to allOf(#/components/schemas/NewRule)
+	NewRule `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// Unique id of the rule
+	Id string `json:"id"`
+}
+
+// Changes made to the matching expense. The category and the trip are set by the matching rule
+// with the highest priority, tags of all matching rules are added.
+type RuleActions struct {
+	CategoryId   *string   `json:"categoryId,omitempty"`
+	Reimbursable *bool     `json:"reimbursable,omitempty"`
+	Tags         *[]string `json:"tags,omitempty"`
+	TripId       *string   `json:"tripId,omitempty"`
+}
+
+// RuleApply defines model for RuleApply.
+type RuleApply struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// RuleApplyResult defines model for RuleApplyResult.
+type RuleApplyResult struct {
+	// Number of updated expenses
+	Updated int `json:"updated"`
+}
+
+// Conditions an expense should meet, all of them should be met. Patterns are regular expressions
+// matched case-insensitively, the amount is a total of the expense in its own currency.
+type RuleConditions struct {
+	// Pattern of the expense comment
+	Comment   *string  `json:"comment,omitempty"`
+	Currency  *string  `json:"currency,omitempty"`
+	MaxAmount *float64 `json:"maxAmount,omitempty"`
+
+	// Pattern of the expense merchant name
+	Merchant  *string  `json:"merchant,omitempty"`
+	MinAmount *float64 `json:"minAmount,omitempty"`
+
+	// Days of week of the expense date
+	Weekdays *[]Weekday `json:"weekdays,omitempty"`
+}
+
+// RuleTest defines model for RuleTest.
+type RuleTest struct {
+	// Number of tested expenses
+	Checked int `json:"checked"`
+
+	// Tested expenses the rule matches
+	Matches []Expense `json:"matches"`
+}
+
+// Schedule defines model for Schedule.
+type Schedule struct {
+	// Total number of occurrences, could not be set together with until
+	Count *int `json:"count,omitempty"`
+
+	// Day of month of monthly schedules, the last day is used in shorter months
+	DayOfMonth *int      `json:"dayOfMonth,omitempty"`
+	Frequency  Frequency `json:"frequency"`
+
+	// Number of frequency periods between occurrences, 1 by default
+	Interval *int      `json:"interval,omitempty"`
+	Start    time.Time `json:"start"`
+
+	// Last day of the schedule, could not be set together with count
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	// Matching categories, the most relevant first
+	Categories []CategorySearchMatch `json:"categories"`
+
+	// Matching expenses, the most relevant first
+	Expenses []ExpenseSearchMatch `json:"expenses"`
+}
+
+// Settings defines model for Settings.
+type Settings struct {
+	// Day of month months, quarters and years begin on in reports, e.g. payday
+	PeriodStartDay int `json:"periodStartDay"`
+
+	// Currency reports are converted into unless another one is requested, EUR by default
+	ReportCurrency *string `json:"reportCurrency,omitempty"`
+}
+
+// SkippedOrEditedOccurrence defines model for SkippedOrEditedOccurrence.
+type SkippedOrEditedOccurrence struct {
+	// Embedded struct due to allOf(#/components/schemas/OccurrenceException)
+	OccurrenceException `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Date time.Time `json:"date"`
+}
+
+// SortField defines model for SortField.
+type SortField string
+
+// SortOrder defines model for SortOrder.
+type SortOrder string
+
+// Split defines model for Split.
+type Split struct {
+	Method SplitMethod  `json:"method"`
+	Shares []SplitShare `json:"shares"`
+}
+
+// SplitMethod defines model for SplitMethod.
+type SplitMethod string
+
+// SplitShare defines model for SplitShare.
+type SplitShare struct {
+	// Calculated amount the user owes, it is ignored in requests
+	Amount *string `json:"amount,omitempty"`
+	User   string  `json:"user"`
+
+	// Weight, exact amount or percent of the total depending on the split method
+	Value *float64 `json:"value,omitempty"`
+}
+
+// Tag defines model for Tag.
+type Tag struct {
+	// Number of expenses tagged with the tag
+	Count int    `json:"count"`
+	Name  string `json:"name"`
+}
+
+// TagMerge defines model for TagMerge.
+type TagMerge struct {
+	// Tag the merged tags are replaced with
+	Into string   `json:"into"`
+	Tags []string `json:"tags"`
+}
+
+// TagRename defines model for TagRename.
+type TagRename struct {
+	// New tag name
+	Name string `json:"name"`
+}
+
+// TagUpdateResult defines model for TagUpdateResult.
+type TagUpdateResult struct {
+	// Number of updated expenses
+	Updated int `json:"updated"`
+}
+
+// Total defines model for Total.
+type Total struct {
+	// Total currency
+	Currency string `json:"currency"`
+
+	// Total sum amount
+	Sum string `json:"sum"`
+}
+
+// TotalDelta defines model for TotalDelta.
+type TotalDelta struct {
+	Delta Total `json:"delta"`
+
+	// Delta percentage of the comparison period total, missing when the total is zero
+	Percent *string `json:"percent,omitempty"`
+	Total   Total   `json:"total"`
+}
+
+// TotalInfo defines model for TotalInfo.
+type TotalInfo struct {
+	Converted *Total        `json:"converted,omitempty"`
+	Original  Total         `json:"original"`
+	Rate      *ExchangeRate `json:"rate,omitempty"`
+}
+
+// TrashItem defines model for TrashItem.
+type TrashItem struct {
+	DeletedAt time.Time `json:"deletedAt"`
+	DeletedBy string    `json:"deletedBy"`
+
+	// ID of trashed expense or root category
+	Id string `json:"id"`
+
+	// Category name or expense comment
+	Name string `json:"name"`
+
+	// Parent ID of trashed category
+	ParentId *string       `json:"parentId,omitempty"`
+	Type     TrashItemType `json:"type"`
+}
+
+// TrashItemType defines model for TrashItemType.
+type TrashItemType string
+
+// Trip defines model for Trip.
+type Trip struct {
+	// Embedded struct due to allOf(#/components/schemas/NewTrip)
+	NewTrip `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	// Unique id of the trip
+	Id string `json:"id"`
+}
+
+// TripReport defines model for TripReport.
+type TripReport struct {
+	CategoryExpenses []CategoryExpenses   `json:"categoryExpenses"`
+	DailyAverage     Total                `json:"dailyAverage"`
+	DateReports      []DateCategoryReport `json:"dateReports"`
+
+	// Number of trip days
+	Days       int        `json:"days"`
+	GrandTotal GrandTotal `json:"grandTotal"`
+	Trip       Trip       `json:"trip"`
+}
+
+// Weekday defines model for Weekday.
+type Weekday string
+
+// AddAccountJSONBody defines parameters for AddAccount.
+type AddAccountJSONBody NewAccount
+
+// UpdateAccountJSONBody defines parameters for UpdateAccount.
+type UpdateAccountJSONBody NewAccount
+
+// FindAccountBalanceParams defines parameters for FindAccountBalance.
+type FindAccountBalanceParams struct {
+	// moment to calculate the balance at, now by default
+	At *time.Time `json:"at,omitempty"`
+}
+
+// AddReconciliationJSONBody defines parameters for AddReconciliation.
+type AddReconciliationJSONBody NewReconciliation
+
+// FindBalancesParams defines parameters for FindBalances.
+type FindBalancesParams struct {
+	// currency to calculate balances in, the report currency of the user settings by default
+	Currency *string `json:"currency,omitempty"`
+}
+
+// AddBudgetJSONBody defines parameters for AddBudget.
+type AddBudgetJSONBody NewBudget
+
+// FindBudgetStatusParams defines parameters for FindBudgetStatus.
+type FindBudgetStatusParams struct {
+	// day of the budget periods, today by default
+	Period *openapi_types.Date `json:"period,omitempty"`
+
+	// currency budget figures are converted into, the report currency of the user settings by default
+	Currency *string `json:"currency,omitempty"`
+}
+
+// UpdateBudgetJSONBody defines parameters for UpdateBudget.
+type UpdateBudgetJSONBody NewBudget
+
+// FindDuplicatesParams defines parameters for FindDuplicates.
+type FindDuplicatesParams struct {
+	// from date to filter by
+	From time.Time `json:"from"`
+
+	// to date to filter by
+	To time.Time `json:"to"`
+
+	// maximal number of days between duplicates, 2 by default
+	Days *int `json:"days,omitempty"`
+
+	// maximal amount difference as a share of the larger amount, 0.01 by default
+	Tolerance *float64 `json:"tolerance,omitempty"`
+}
+
+// ResolveDuplicatesJSONBody defines parameters for ResolveDuplicates.
+type ResolveDuplicatesJSONBody DuplicateResolution
+
+// ListExpensesParams defines parameters for ListExpenses.
+type ListExpensesParams struct {
+	// from date to filter by
+	From *time.Time `json:"from,omitempty"`
+
+	// to date to filter by
+	To *time.Time `json:"to,omitempty"`
+
+	// category to filter by, including all its subcategories
+	CategoryId *string `json:"categoryId,omitempty"`
+
+	// currency to filter by
+	Currency *string `json:"currency,omitempty"`
+
+	// ID of the trip to filter by
+	TripId *string `json:"tripId,omitempty"`
+
+	// text to match expense comment against
+	Text *string `json:"text,omitempty"`
+
+	// field to sort expenses by
+	SortBy *SortField `json:"sortBy,omitempty"`
+
+	// sort order
+	Order *SortOrder `json:"order,omitempty"`
+
+	// maximum number of expenses to return
+	Limit *int `json:"limit,omitempty"`
+
+	// cursor of the next page returned by the previous request
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+// AddExpenseJSONBody defines parameters for AddExpense.
+type AddExpenseJSONBody NewExpense
+
+// UpdateExpenseJSONBody defines parameters for UpdateExpense.
+type UpdateExpenseJSONBody NewExpense
+
+// ExportExpensesParams defines parameters for ExportExpenses.
+type ExportExpensesParams struct {
+	// file format of the export
+	Format ExportFormat `json:"format"`
+
+	// from date to filter by
+	From *time.Time `json:"from,omitempty"`
+
+	// to date to filter by
+	To *time.Time `json:"to,omitempty"`
+
+	// category to filter by, including all its subcategories
+	CategoryId *string `json:"categoryId,omitempty"`
+
+	// currency to filter by
+	Currency *string `json:"currency,omitempty"`
+
+	// currency totals are converted into, the report currency of the user settings by default
+	ReportCurrency *string `json:"reportCurrency,omitempty"`
+
+	// ID of the trip to filter by
+	TripId *string `json:"tripId,omitempty"`
+
+	// text to match expense comment against
+	Text *string `json:"text,omitempty"`
+
+	// field to sort expenses by
+	SortBy *SortField `json:"sortBy,omitempty"`
+
+	// sort order
+	Order *SortOrder `json:"order,omitempty"`
+}
+
+// ImportExpensesCsvParams defines parameters for ImportExpensesCsv.
+type ImportExpensesCsvParams struct {
+	// import mode, dry run by default
+	Mode *ImportMode `json:"mode,omitempty"`
+}
+
+// ListInboxExpensesParams defines parameters for ListInboxExpenses.
+type ListInboxExpensesParams struct {
+	// maximum number of expenses to return
+	Limit *int `json:"limit,omitempty"`
+
+	// cursor of the next page returned by the previous request
+	Cursor *string `json:"cursor,omitempty"`
+}
+
+// AssignInboxCategoriesJSONBody defines parameters for AssignInboxCategories.
+type AssignInboxCategoriesJSONBody []CategoryAssignment
+
+// ImportOfxParams defines parameters for ImportOfx.
+type ImportOfxParams struct {
+	// import mode, dry run by default
+	Mode *ImportMode `json:"mode,omitempty"`
+
+	// currency of transactions that do not define one
+	DefaultCurrency *string `json:"defaultCurrency,omitempty"`
+}
+
+// AddImportProfileJSONBody defines parameters for AddImportProfile.
+type AddImportProfileJSONBody NewImportProfile
+
+// ImportQifParams defines parameters for ImportQif.
+type ImportQifParams struct {
+	// import mode, dry run by default
+	Mode *ImportMode `json:"mode,omitempty"`
+
+	// currency of transactions that do not define one
+	DefaultCurrency *string `json:"defaultCurrency,omitempty"`
+
+	// date format built of yyyy, yy, MM, dd, e.g. dd.MM.yyyy, MM/dd/yy by default
+	DateFormat *string `json:"dateFormat,omitempty"`
+}
+
+// FindIncomesParams defines parameters for FindIncomes.
+type FindIncomesParams struct {
+	// from date to filter by
+	From time.Time `json:"from"`
+
+	// to date to filter by
+	To time.Time `json:"to"`
+}
+
+// AddIncomeJSONBody defines parameters for AddIncome.
+type AddIncomeJSONBody NewIncome
+
+// UpdateIncomeJSONBody defines parameters for UpdateIncome.
+type UpdateIncomeJSONBody NewIncome
+
+// AddMerchantJSONBody defines parameters for AddMerchant.
+type AddMerchantJSONBody NewMerchant
+
+// UpdateMerchantJSONBody defines parameters for UpdateMerchant.
+type UpdateMerchantJSONBody NewMerchant
+
+// MergeMerchantsJSONBody defines parameters for MergeMerchants.
+type MergeMerchantsJSONBody MerchantMerge
+
+// AddReceiptJSONBody defines parameters for AddReceipt.
+type AddReceiptJSONBody NewReceipt
+
+// TickReconciliationJSONBody defines parameters for TickReconciliation.
+type TickReconciliationJSONBody ReconciliationExpenses
+
+// AddRecurringExpenseJSONBody defines parameters for AddRecurringExpense.
+type AddRecurringExpenseJSONBody NewRecurringExpense
+
+// UpdateRecurringExpenseJSONBody defines parameters for UpdateRecurringExpense.
+type UpdateRecurringExpenseJSONBody NewRecurringExpense
+
+// PreviewOccurrencesParams defines parameters for PreviewOccurrences.
+type PreviewOccurrencesParams struct {
+	// date to list occurrences from, today by default
+	From *time.Time `json:"from,omitempty"`
+
+	// maximum number of occurrences to return
+	Limit *int `json:"limit,omitempty"`
+}
+
+// UpdateOccurrenceJSONBody defines parameters for UpdateOccurrence.
+type UpdateOccurrenceJSONBody OccurrenceException
+
+// GenerateReportParams defines parameters for GenerateReport.
+type GenerateReportParams struct {
+	// from date to filter by
+	From time.Time `json:"from"`
+
+	// to date to filter by
+	To time.Time `json:"to"`
+
+	// results interval
+	Interval Interval `json:"interval"`
+
+	// tags to filter by, expenses tagged with any of them are reported
+	Tags *[]string `json:"tags,omitempty"`
+
+	// tags to filter by, expenses tagged with any of them are not reported
+	ExcludeTags *[]string `json:"excludeTags,omitempty"`
+
+	// categories to filter by, expenses of any of them or their subcategories are reported
+	Categories *[]string `json:"categories,omitempty"`
+
+	// categories to filter by, expenses of any of them or their subcategories are not reported
+	ExcludeCategories *[]string `json:"excludeCategories,omitempty"`
+
+	// currencies to filter by, expenses paid in any of them are reported
+	OriginalCurrencies *[]string `json:"originalCurrencies,omitempty"`
+
+	// ID of the trip to filter by
+	TripId *string `json:"tripId,omitempty"`
+
+	// lowest expense total in the original currency to filter by
+	MinAmount *float64 `json:"minAmount,omitempty"`
+
+	// highest expense total in the original currency to filter by
+	MaxAmount *float64 `json:"maxAmount,omitempty"`
+
+	// text to match expense comment against
+	Comment *string `json:"comment,omitempty"`
+
+	// currency totals are converted into, the report currency of the user settings by default
+	Currency *string `json:"currency,omitempty"`
+}
+
+// GenerateCategoryReportParams defines parameters for GenerateCategoryReport.
+type GenerateCategoryReportParams struct {
+	// from date to filter by
+	From time.Time `json:"from"`
+
+	// to date to filter by
+	To time.Time `json:"to"`
+
+	// results interval
+	Interval Interval `json:"interval"`
+
+	// tags to filter by, expenses tagged with any of them are reported
+	Tags *[]string `json:"tags,omitempty"`
+
+	// tags to filter by, expenses tagged with any of them are not reported
+	ExcludeTags *[]string `json:"excludeTags,omitempty"`
+
+	// categories to filter by, expenses of any of them or their subcategories are reported
+	Categories *[]string `json:"categories,omitempty"`
+
+	// categories to filter by, expenses of any of them or their subcategories are not reported
+	ExcludeCategories *[]string `json:"excludeCategories,omitempty"`
+
+	// currencies to filter by, expenses paid in any of them are reported
+	OriginalCurrencies *[]string `json:"originalCurrencies,omitempty"`
+
+	// ID of the trip to filter by
+	TripId *string `json:"tripId,omitempty"`
+
+	// lowest expense total in the original currency to filter by
+	MinAmount *float64 `json:"minAmount,omitempty"`
+
+	// highest expense total in the original currency to filter by
+	MaxAmount *float64 `json:"maxAmount,omitempty"`
+
+	// text to match expense comment against
+	Comment *string `json:"comment,omitempty"`
+
+	// currency totals are converted into, the report currency of the user settings by default
+	Currency *string `json:"currency,omitempty"`
+}
+
+// GenerateCashFlowReportParams defines parameters for GenerateCashFlowReport.
+type GenerateCashFlowReportParams struct {
+	// from date to filter by
+	From time.Time `json:"from"`
+
+	// to date to filter by
+	To time.Time `json:"to"`
+
+	// results interval
+	Interval Interval `json:"interval"`
+
+	// currency totals are converted into, the report currency of the user settings by default
+	Currency *string `json:"currency,omitempty"`
+}
+
+// GenerateComparisonReportParams defines parameters for GenerateComparisonReport.
+type GenerateComparisonReportParams struct {
+	// from date of the base period
+	From time.Time `json:"from"`
+
+	// to date of the base period
+	To time.Time `json:"to"`
+
+	// from dates of the comparison periods
+	CompareFrom []time.Time `json:"compareFrom"`
+
+	// to dates of the comparison periods, in the same order as from dates
+	CompareTo []time.Time `json:"compareTo"`
+
+	// interval period totals are averaged per, totals are not averaged by default
+	AverageBy *Interval `json:"averageBy,omitempty"`
+
+	// tags to filter by, expenses tagged with any of them are reported
+	Tags *[]string `json:"tags,omitempty"`
+
+	// tags to filter by, expenses tagged with any of them are not reported
+	ExcludeTags *[]string `json:"excludeTags,omitempty"`
+
+	// categories to filter by, expenses of any of them or their subcategories are reported
+	Categories *[]string `json:"categories,omitempty"`
+
+	// categories to filter by, expenses of any of them or their subcategories are not reported
+	ExcludeCategories *[]string `json:"excludeCategories,omitempty"`
+
+	// currencies to filter by, expenses paid in any of them are reported
+	OriginalCurrencies *[]string `json:"originalCurrencies,omitempty"`
+
+	// ID of the trip to filter by
+	TripId *string `json:"tripId,omitempty"`
+
+	// lowest expense total in the original currency to filter by
+	MinAmount *float64 `json:"minAmount,omitempty"`
+
+	// highest expense total in the original currency to filter by
+	MaxAmount *float64 `json:"maxAmount,omitempty"`
+
+	// text to match expense comment against
+	Comment *string `json:"comment,omitempty"`
+
+	// currency totals are converted into, the report currency of the user settings by default
+	Currency *string `json:"currency,omitempty"`
+}
+
+// AddRuleJSONBody defines parameters for AddRule.
+type AddRuleJSONBody NewRule
+
+// ApplyRulesJSONBody defines parameters for ApplyRules.
+type ApplyRulesJSONBody RuleApply
+
+// TestRuleJSONBody defines parameters for TestRule.
+type TestRuleJSONBody NewRule
+
+// TestRuleParams defines parameters for TestRule.
+type TestRuleParams struct {
+	// number of the last added expenses to test the rule against
+	Limit *int `json:"limit,omitempty"`
+}
+
+// UpdateRuleJSONBody defines parameters for UpdateRule.
+type UpdateRuleJSONBody NewRule
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// words to search for
+	Q string `json:"q"`
+
+	// from date to filter expenses by
+	From *time.Time `json:"from,omitempty"`
+
+	// to date to filter expenses by
+	To *time.Time `json:"to,omitempty"`
+
+	// minimal amount to filter expenses by
+	MinAmount *float64 `json:"minAmount,omitempty"`
+
+	// maximal amount to filter expenses by
+	MaxAmount *float64 `json:"maxAmount,omitempty"`
+}
+
+// UpdateSettingsJSONBody defines parameters for UpdateSettings.
+type UpdateSettingsJSONBody Settings
+
+// AddSettlementJSONBody defines parameters for AddSettlement.
+type AddSettlementJSONBody NewSettlement
+
+// MergeTagsJSONBody defines parameters for MergeTags.
+type MergeTagsJSONBody TagMerge
+
+// RenameTagJSONBody defines parameters for RenameTag.
+type RenameTagJSONBody TagRename
+
+// AddTransferJSONBody defines parameters for AddTransfer.
+type AddTransferJSONBody NewTransfer
+
+// AddTripJSONBody defines parameters for AddTrip.
+type AddTripJSONBody NewTrip
+
+// UpdateTripJSONBody defines parameters for UpdateTrip.
+type UpdateTripJSONBody NewTrip
+
+// AddAccountJSONRequestBody defines body for AddAccount for application/json ContentType.
+type AddAccountJSONRequestBody AddAccountJSONBody
+
+// UpdateAccountJSONRequestBody defines body for UpdateAccount for application/json ContentType.
+type UpdateAccountJSONRequestBody UpdateAccountJSONBody
+
+// AddReconciliationJSONRequestBody defines body for AddReconciliation for application/json ContentType.
+type AddReconciliationJSONRequestBody AddReconciliationJSONBody
+
+// AddBudgetJSONRequestBody defines body for AddBudget for application/json ContentType.
+type AddBudgetJSONRequestBody AddBudgetJSONBody
+
+// UpdateBudgetJSONRequestBody defines body for UpdateBudget for application/json ContentType.
+type UpdateBudgetJSONRequestBody UpdateBudgetJSONBody
+
+// ResolveDuplicatesJSONRequestBody defines body for ResolveDuplicates for application/json ContentType.
+type ResolveDuplicatesJSONRequestBody ResolveDuplicatesJSONBody
+
+// AddExpenseJSONRequestBody defines body for AddExpense for application/json ContentType.
 type AddExpenseJSONRequestBody AddExpenseJSONBody
+
+// UpdateExpenseJSONRequestBody defines body for UpdateExpense for application/json ContentType.
+type UpdateExpenseJSONRequestBody UpdateExpenseJSONBody
+
+// AssignInboxCategoriesJSONRequestBody defines body for AssignInboxCategories for application/json ContentType.
+type AssignInboxCategoriesJSONRequestBody AssignInboxCategoriesJSONBody
+
+// AddImportProfileJSONRequestBody defines body for AddImportProfile for application/json ContentType.
+type AddImportProfileJSONRequestBody AddImportProfileJSONBody
+
+// AddIncomeJSONRequestBody defines body for AddIncome for application/json ContentType.
+type AddIncomeJSONRequestBody AddIncomeJSONBody
+
+// UpdateIncomeJSONRequestBody defines body for UpdateIncome for application/json ContentType.
+type UpdateIncomeJSONRequestBody UpdateIncomeJSONBody
+
+// AddMerchantJSONRequestBody defines body for AddMerchant for application/json ContentType.
+type AddMerchantJSONRequestBody AddMerchantJSONBody
+
+// UpdateMerchantJSONRequestBody defines body for UpdateMerchant for application/json ContentType.
+type UpdateMerchantJSONRequestBody UpdateMerchantJSONBody
+
+// MergeMerchantsJSONRequestBody defines body for MergeMerchants for application/json ContentType.
+type MergeMerchantsJSONRequestBody MergeMerchantsJSONBody
+
+// AddReceiptJSONRequestBody defines body for AddReceipt for application/json ContentType.
+type AddReceiptJSONRequestBody AddReceiptJSONBody
+
+// TickReconciliationJSONRequestBody defines body for TickReconciliation for application/json ContentType.
+type TickReconciliationJSONRequestBody TickReconciliationJSONBody
+
+// AddRecurringExpenseJSONRequestBody defines body for AddRecurringExpense for application/json ContentType.
+type AddRecurringExpenseJSONRequestBody AddRecurringExpenseJSONBody
+
+// UpdateRecurringExpenseJSONRequestBody defines body for UpdateRecurringExpense for application/json ContentType.
+type UpdateRecurringExpenseJSONRequestBody UpdateRecurringExpenseJSONBody
+
+// UpdateOccurrenceJSONRequestBody defines body for UpdateOccurrence for application/json ContentType.
+type UpdateOccurrenceJSONRequestBody UpdateOccurrenceJSONBody
+
+// AddRuleJSONRequestBody defines body for AddRule for application/json ContentType.
+type AddRuleJSONRequestBody AddRuleJSONBody
+
+// ApplyRulesJSONRequestBody defines body for ApplyRules for application/json ContentType.
+type ApplyRulesJSONRequestBody ApplyRulesJSONBody
+
+// TestRuleJSONRequestBody defines body for TestRule for application/json ContentType.
+type TestRuleJSONRequestBody TestRuleJSONBody
+
+// UpdateRuleJSONRequestBody defines body for UpdateRule for application/json ContentType.
+type UpdateRuleJSONRequestBody UpdateRuleJSONBody
+
+// UpdateSettingsJSONRequestBody defines body for UpdateSettings for application/json ContentType.
+type UpdateSettingsJSONRequestBody UpdateSettingsJSONBody
+
+// AddSettlementJSONRequestBody defines body for AddSettlement for application/json ContentType.
+type AddSettlementJSONRequestBody AddSettlementJSONBody
+
+// MergeTagsJSONRequestBody defines body for MergeTags for application/json ContentType.
+type MergeTagsJSONRequestBody MergeTagsJSONBody
+
+// RenameTagJSONRequestBody defines body for RenameTag for application/json ContentType.
+type RenameTagJSONRequestBody RenameTagJSONBody
+
+// AddTransferJSONRequestBody defines body for AddTransfer for application/json ContentType.
+type AddTransferJSONRequestBody AddTransferJSONBody
+
+// AddTripJSONRequestBody defines body for AddTrip for application/json ContentType.
+type AddTripJSONRequestBody AddTripJSONBody
+
+// UpdateTripJSONRequestBody defines body for UpdateTrip for application/json ContentType.
+type UpdateTripJSONRequestBody UpdateTripJSONBody
@@ -1,6 +1,12 @@
 package ports
 
-import "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+)
 
 func reportToResponse(domainObj domain.ReportByDate) ExpenseReport {
 	dateCategoryReport := []DateCategoryReport{}
@@ -19,6 +25,7 @@ func reportToResponse(domainObj domain.ReportByDate) ExpenseReport {
 	}
 
 	report := ExpenseReport{
+		Currency:    string(domainObj.Currency),
 		DateReports: dateCategoryReport,
 		GrandTotal:  grandTotalToResponse(domainObj.GrandTotal),
 	}
@@ -49,10 +56,113 @@ func categoryExpensesToResponse(domainObj domain.CategoryExpenses) CategoryExpen
 	if len(categoryExpenses) != 0 {
 		response.SubCategories = &categoryExpenses
 	}
+	if domainObj.Budget != nil {
+		budget := budgetStatusToResponse(*domainObj.Budget)
+		response.Budget = &budget
+	}
+
+	return response
+}
+
+func categoryReportToResponse(domainObj domain.ReportByCategory) CategoryReport {
+	categories := make([]CategorySeries, 0, len(domainObj.SubCategories))
+	for _, category := range domainObj.SubCategories {
+		categories = append(categories, categorySeriesToResponse(*category))
+	}
+
+	return CategoryReport{
+		From:       domainObj.From,
+		To:         domainObj.To,
+		Currency:   string(domainObj.Currency),
+		Dates:      domainObj.Dates,
+		Categories: categories,
+		GrandTotal: grandTotalToResponse(domainObj.GrandTotal),
+	}
+}
+
+func categorySeriesToResponse(domainObj domain.CategorySeries) CategorySeries {
+	series := make([]IntervalTotal, 0, len(domainObj.Series))
+	for _, intervalTotal := range domainObj.Series {
+		series = append(series, IntervalTotal{
+			Date:       intervalTotal.Date,
+			GrandTotal: grandTotalToResponse(intervalTotal.GrandTotal),
+		})
+	}
+
+	response := CategorySeries{
+		Category:   categoryToResponse(domainObj.Category),
+		Series:     series,
+		GrandTotal: grandTotalToResponse(domainObj.GrandTotal),
+	}
+
+	if len(domainObj.SubCategories) != 0 {
+		subCategories := make([]CategorySeries, 0, len(domainObj.SubCategories))
+		for _, subCategory := range domainObj.SubCategories {
+			subCategories = append(subCategories, categorySeriesToResponse(*subCategory))
+		}
+		response.SubCategories = &subCategories
+	}
+
+	return response
+}
+
+func comparisonReportToResponse(domainObj domain.ComparisonReport) ComparisonReport {
+	periods := make([]ReportPeriod, 0, len(domainObj.Periods))
+	for _, period := range domainObj.Periods {
+		periods = append(periods, ReportPeriod{From: period.From, To: period.To})
+	}
+
+	categories := make([]CategoryComparison, 0, len(domainObj.SubCategories))
+	for _, category := range domainObj.SubCategories {
+		categories = append(categories, categoryComparisonToResponse(*category))
+	}
+
+	return ComparisonReport{
+		From:        domainObj.Base.From,
+		To:          domainObj.Base.To,
+		Currency:    string(domainObj.Currency),
+		Average:     domainObj.Average,
+		Periods:     periods,
+		Total:       *totalToResponse(&domainObj.Total),
+		Comparisons: totalDeltasToResponse(domainObj.Comparisons),
+		Categories:  categories,
+	}
+}
+
+func categoryComparisonToResponse(domainObj domain.CategoryComparison) CategoryComparison {
+	response := CategoryComparison{
+		Category:    categoryToResponse(domainObj.Category),
+		Total:       *totalToResponse(&domainObj.Total),
+		Comparisons: totalDeltasToResponse(domainObj.Comparisons),
+	}
+
+	if len(domainObj.SubCategories) != 0 {
+		subCategories := make([]CategoryComparison, 0, len(domainObj.SubCategories))
+		for _, subCategory := range domainObj.SubCategories {
+			subCategories = append(subCategories, categoryComparisonToResponse(*subCategory))
+		}
+		response.SubCategories = &subCategories
+	}
 
 	return response
 }
 
+func totalDeltasToResponse(domainObjs []domain.TotalDelta) []TotalDelta {
+	deltas := make([]TotalDelta, 0, len(domainObjs))
+	for _, domainObj := range domainObjs {
+		delta := TotalDelta{
+			Total: *totalToResponse(&domainObj.Total),
+			Delta: *totalToResponse(&domainObj.Delta),
+		}
+		if domainObj.Percent != nil {
+			percent := domainObj.Percent.StringFixed(2)
+			delta.Percent = &percent
+		}
+		deltas = append(deltas, delta)
+	}
+	return deltas
+}
+
 func categoryToResponse(domainObj domain.Category) Category {
 	return Category{
 		Id:    domainObj.ID(),
@@ -62,21 +172,108 @@ func categoryToResponse(domainObj domain.Category) Category {
 	}
 }
 
+func categoryWithParentsToResponse(domainObj domain.Category) Category {
+	category := categoryToResponse(domainObj)
+	if domainObj.Parents() != nil && len(*domainObj.Parents()) != 0 {
+		parents := make([]Category, 0, len(*domainObj.Parents()))
+		for _, parent := range *domainObj.Parents() {
+			parents = append(parents, categoryToResponse(parent))
+		}
+		category.Parents = &parents
+	}
+	return category
+}
+
+func expensePageToResponse(domainObj domain.ExpensePage) ExpensePage {
+	expenses := make([]Expense, 0, len(domainObj.Expenses))
+	for _, domainExpense := range domainObj.Expenses {
+		expenses = append(expenses, expenseWithCategoryToResponse(domainExpense))
+	}
+	return ExpensePage{
+		Items:      expenses,
+		NextCursor: domainObj.NextCursor,
+	}
+}
+
+func expenseWithCategoryToResponse(domainObj domain.Expense) Expense {
+	expense := expenseToResponse(domainObj)
+	category := categoryWithParentsToResponse(domainObj.Category())
+	expense.Category = &category
+	return expense
+}
+
 func expenseToResponse(domainObj domain.Expense) Expense {
 	return Expense{
-		Id: domainObj.ID(),
+		Id:               domainObj.ID(),
+		ReceiptId:        domainObj.ReceiptID(),
+		ReconciliationId: domainObj.ReconciliationID(),
 		NewExpense: NewExpense{
-			CategoryId: domainObj.Category().ID(),
-			Comment:    domainObj.Comment(),
-			Currency:   domainObj.Currency(),
-			Date:       domainObj.Date(),
-			Price:      domainObj.Price(),
-			Quantity:   domainObj.Quantity(),
-			TotalInfo:  totalInfoToResponse(domainObj.TotalInfo()),
+			CategoryId:   domainObj.Category().ID(),
+			Comment:      domainObj.Comment(),
+			Currency:     domainObj.Currency(),
+			Date:         domainObj.Date(),
+			Price:        domainObj.Price(),
+			Quantity:     domainObj.Quantity(),
+			TripId:       domainObj.TripID(),
+			MerchantId:   domainObj.MerchantID(),
+			AccountId:    domainObj.AccountID(),
+			Tags:         expenseTagsToResponse(domainObj.Tags()),
+			Reimbursable: flagToResponse(domainObj.Reimbursable()),
+			PaidBy:       domainObj.PaidBy(),
+			Split:        splitToResponse(domainObj),
+			TotalInfo:    totalInfoToResponse(domainObj.TotalInfo()),
 		},
 	}
 }
 
+func expenseTagsToResponse(domainTags []string) *[]string {
+	if len(domainTags) == 0 {
+		return nil
+	}
+	return &domainTags
+}
+
+// flagToResponse omits boolean flags which are not set.
+func flagToResponse(flag bool) *bool {
+	if !flag {
+		return nil
+	}
+	return &flag
+}
+
+func splitToResponse(domainObj domain.Expense) *Split {
+	domainSplit := domainObj.Split()
+	if domainSplit == nil {
+		return nil
+	}
+
+	amounts := make(map[string]string)
+	if domainAmounts, amountsErr := domainObj.SplitAmounts(); amountsErr == nil {
+		for _, domainAmount := range domainAmounts {
+			amounts[domainAmount.User] = domainAmount.Amount.StringFixed(2)
+		}
+	}
+
+	shares := make([]SplitShare, 0, len(domainSplit.Shares()))
+	for _, domainShare := range domainSplit.Shares() {
+		value, _ := domainShare.Value.Float64()
+		amount := amounts[domainShare.User]
+		share := SplitShare{
+			User:   domainShare.User,
+			Amount: &amount,
+		}
+		if domainSplit.Method() != domain.SplitMethodEqual {
+			share.Value = &value
+		}
+		shares = append(shares, share)
+	}
+
+	return &Split{
+		Method: SplitMethod(domainSplit.Method()),
+		Shares: shares,
+	}
+}
+
 func grandTotalToResponse(domainObj domain.GrandTotal) GrandTotal {
 	subTotals := []TotalInfo{}
 	for _, totalInfo := range domainObj.SubTotals {
@@ -137,3 +334,590 @@ func exchangeRateToResponse(domainObj domain.ExchangeRate) ExchangeRate {
 	}
 	return exchRate
 }
+
+func trashItemsToResponse(domainItems []domain.TrashItem) []TrashItem {
+	items := make([]TrashItem, 0, len(domainItems))
+	for _, domainItem := range domainItems {
+		items = append(items, trashItemToResponse(domainItem))
+	}
+	return items
+}
+
+func attachmentsToResponse(domainAttachments []domain.Attachment) []Attachment {
+	attachments := make([]Attachment, 0, len(domainAttachments))
+	for _, domainAttachment := range domainAttachments {
+		attachments = append(attachments, Attachment{
+			Id:           domainAttachment.ID(),
+			FileName:     domainAttachment.FileName(),
+			ContentType:  domainAttachment.ContentType(),
+			Size:         domainAttachment.Size(),
+			HasThumbnail: domainAttachment.ThumbnailKey() != nil,
+			CreatedAt:    domainAttachment.CreatedAt(),
+			CreatedBy:    domainAttachment.CreatedBy(),
+		})
+	}
+	return attachments
+}
+
+func searchResultToResponse(domainResult domain.SearchResult) SearchResult {
+	expenses := make([]ExpenseSearchMatch, 0, len(domainResult.Expenses))
+	for _, domainMatch := range domainResult.Expenses {
+		expenses = append(expenses, ExpenseSearchMatch{
+			Expense: expenseWithCategoryToResponse(domainMatch.Expense),
+			Score:   domainMatch.Score,
+		})
+	}
+	categories := make([]CategorySearchMatch, 0, len(domainResult.Categories))
+	for _, domainMatch := range domainResult.Categories {
+		categories = append(categories, CategorySearchMatch{
+			Category: categoryToResponse(domainMatch.Category),
+			Score:    domainMatch.Score,
+		})
+	}
+	return SearchResult{
+		Expenses:   expenses,
+		Categories: categories,
+	}
+}
+
+func receiptToResponse(domainReceipt domain.Receipt) Receipt {
+	items := make([]Expense, 0, len(domainReceipt.Items()))
+	for _, domainItem := range domainReceipt.Items() {
+		items = append(items, expenseWithCategoryToResponse(domainItem))
+	}
+	total := domainReceipt.Total()
+	return Receipt{
+		Id:          domainReceipt.ID(),
+		Date:        domainReceipt.Date(),
+		Comment:     domainReceipt.Comment(),
+		Currency:    string(domainReceipt.Currency()),
+		Total:       *totalToResponse(&total),
+		Items:       items,
+		Attachments: attachmentsToResponse(domainReceipt.Attachments()),
+	}
+}
+
+func trashItemToResponse(domainItem domain.TrashItem) TrashItem {
+	return TrashItem{
+		Id:        domainItem.ID(),
+		Type:      TrashItemType(domainItem.Type()),
+		Name:      domainItem.Name(),
+		ParentId:  domainItem.ParentID(),
+		DeletedAt: domainItem.DeletedAt(),
+		DeletedBy: domainItem.DeletedBy(),
+	}
+}
+
+func importProfilesToResponse(domainProfiles []domain.ImportProfile) []ImportProfile {
+	profiles := make([]ImportProfile, 0, len(domainProfiles))
+	for _, domainProfile := range domainProfiles {
+		profiles = append(profiles, importProfileToResponse(domainProfile))
+	}
+	return profiles
+}
+
+func importProfileToResponse(domainProfile domain.ImportProfile) ImportProfile {
+	columns := domainProfile.Columns()
+	delimiter := string(domainProfile.Delimiter())
+	return ImportProfile{
+		Id: domainProfile.ID(),
+		NewImportProfile: NewImportProfile{
+			Name: domainProfile.Name(),
+			Columns: ImportColumns{
+				Date:     columns.Date,
+				Amount:   columns.Amount,
+				Currency: columns.Currency,
+				Quantity: columns.Quantity,
+				Comment:  columns.Comment,
+				Category: columns.Category,
+			},
+			DateFormat:       domainProfile.DateFormat(),
+			DecimalSeparator: domainProfile.DecimalSeparator(),
+			Delimiter:        &delimiter,
+			DefaultCurrency:  domainProfile.DefaultCurrency(),
+		},
+	}
+}
+
+func importReportToResponse(domainReport domain.ImportReport) ImportReport {
+	rows := make([]ImportRow, 0, len(domainReport.Rows))
+	for _, domainRow := range domainReport.Rows {
+		row := ImportRow{
+			Row:    domainRow.Row,
+			Status: ImportRowStatus(domainRow.Status),
+			Reason: domainRow.Reason,
+		}
+		if domainRow.Expense != nil {
+			expense := expenseWithCategoryToResponse(*domainRow.Expense)
+			row.Expense = &expense
+		}
+		rows = append(rows, row)
+	}
+	return ImportReport{
+		Mode:      ImportMode(domainReport.Mode),
+		Committed: domainReport.Committed,
+		Accepted:  domainReport.Accepted,
+		Rejected:  domainReport.Rejected,
+		Skipped:   domainReport.Skipped,
+		Rows:      rows,
+	}
+}
+
+func recurringExpensesToResponse(domainExpenses []domain.RecurringExpense) []RecurringExpense {
+	recurringExpenses := make([]RecurringExpense, 0, len(domainExpenses))
+	for _, domainExpense := range domainExpenses {
+		recurringExpenses = append(recurringExpenses, recurringExpenseToResponse(domainExpense))
+	}
+	return recurringExpenses
+}
+
+func recurringExpenseToResponse(domainExpense domain.RecurringExpense) RecurringExpense {
+	schedule := domainExpense.Schedule()
+	interval := schedule.Interval()
+
+	exceptions := make([]SkippedOrEditedOccurrence, 0, len(domainExpense.Exceptions()))
+	for _, domainException := range domainExpense.Exceptions() {
+		skipped := domainException.Skipped()
+		exceptions = append(exceptions, SkippedOrEditedOccurrence{
+			OccurrenceException: OccurrenceException{
+				Skipped:  &skipped,
+				Price:    domainException.Price(),
+				Quantity: domainException.Quantity(),
+				Comment:  domainException.Comment(),
+			},
+			Date: domainException.Date(),
+		})
+	}
+
+	return RecurringExpense{
+		Id: domainExpense.ID(),
+		NewRecurringExpense: NewRecurringExpense{
+			CategoryId: domainExpense.Category().ID(),
+			Price:      domainExpense.Price(),
+			Quantity:   domainExpense.Quantity(),
+			Currency:   domainExpense.Currency(),
+			Comment:    domainExpense.Comment(),
+			TripId:     domainExpense.TripID(),
+			Schedule: Schedule{
+				Frequency:  Frequency(schedule.Frequency()),
+				Interval:   &interval,
+				DayOfMonth: schedule.DayOfMonth(),
+				Start:      schedule.Start(),
+				Until:      schedule.Until(),
+				Count:      schedule.Count(),
+			},
+		},
+		Category:          categoryWithParentsToResponse(domainExpense.Category()),
+		Exceptions:        exceptions,
+		MaterializedUntil: domainExpense.MaterializedUntil(),
+	}
+}
+
+func occurrencesToResponse(domainOccurrences []domain.Occurrence) []Occurrence {
+	occurrences := make([]Occurrence, 0, len(domainOccurrences))
+	for _, domainOccurrence := range domainOccurrences {
+		occurrences = append(occurrences, Occurrence{
+			Date:     domainOccurrence.Date,
+			Price:    domainOccurrence.Price,
+			Quantity: domainOccurrence.Quantity,
+			Comment:  domainOccurrence.Comment,
+			Skipped:  domainOccurrence.Skipped,
+			Edited:   domainOccurrence.Edited,
+		})
+	}
+	return occurrences
+}
+
+func budgetsToResponse(domainBudgets []domain.Budget) []Budget {
+	budgets := make([]Budget, 0, len(domainBudgets))
+	for _, domainBudget := range domainBudgets {
+		budgets = append(budgets, budgetToResponse(domainBudget))
+	}
+	return budgets
+}
+
+func budgetToResponse(domainBudget domain.Budget) Budget {
+	var currency *string
+	if domainBudget.Currency() != nil {
+		value := string(*domainBudget.Currency())
+		currency = &value
+	}
+
+	return Budget{
+		Id: domainBudget.ID(),
+		NewBudget: NewBudget{
+			CategoryId: domainBudget.CategoryID(),
+			Period:     BudgetPeriod(domainBudget.Period()),
+			Amount:     domainBudget.Amount(),
+			Currency:   currency,
+			Rollover:   domainBudget.Rollover(),
+			Start:      domainBudget.Start(),
+		},
+	}
+}
+
+func budgetStatusesToResponse(domainStatuses []domain.BudgetStatus) []BudgetStatus {
+	statuses := make([]BudgetStatus, 0, len(domainStatuses))
+	for _, domainStatus := range domainStatuses {
+		statuses = append(statuses, budgetStatusToResponse(domainStatus))
+	}
+	return statuses
+}
+
+func budgetStatusToResponse(domainStatus domain.BudgetStatus) BudgetStatus {
+	return BudgetStatus{
+		BudgetId:    domainStatus.Budget.ID(),
+		CategoryId:  domainStatus.Budget.CategoryID(),
+		Period:      BudgetPeriod(domainStatus.Budget.Period()),
+		From:        domainStatus.From,
+		To:          domainStatus.To,
+		Currency:    string(domainStatus.Currency),
+		Amount:      domainStatus.Amount.Round(2).String(),
+		RolledOver:  domainStatus.RolledOver.Round(2).String(),
+		Spent:       domainStatus.Spent.Round(2).String(),
+		Remaining:   domainStatus.Remaining.Round(2).String(),
+		PercentUsed: domainStatus.PercentUsed.String(),
+	}
+}
+
+func tripsToResponse(domainTrips []domain.Trip) []Trip {
+	trips := make([]Trip, 0, len(domainTrips))
+	for _, domainTrip := range domainTrips {
+		trips = append(trips, tripToResponse(domainTrip))
+	}
+	return trips
+}
+
+func tripToResponse(domainTrip domain.Trip) Trip {
+	participants := domainTrip.Participants()
+
+	return Trip{
+		Id: domainTrip.ID(),
+		NewTrip: NewTrip{
+			Name:         domainTrip.Name(),
+			From:         domainTrip.From(),
+			To:           domainTrip.To(),
+			HomeCurrency: string(domainTrip.HomeCurrency()),
+			Participants: &participants,
+		},
+	}
+}
+
+func merchantsToResponse(domainMerchants []domain.Merchant) []Merchant {
+	merchants := make([]Merchant, 0, len(domainMerchants))
+	for _, domainMerchant := range domainMerchants {
+		merchants = append(merchants, merchantToResponse(domainMerchant))
+	}
+	return merchants
+}
+
+func merchantToResponse(domainMerchant domain.Merchant) Merchant {
+	aliases := domainMerchant.Aliases()
+
+	return Merchant{
+		Id: domainMerchant.ID(),
+		NewMerchant: NewMerchant{
+			Name:              domainMerchant.Name(),
+			Aliases:           &aliases,
+			DefaultCategoryId: domainMerchant.DefaultCategoryID(),
+			Location:          domainMerchant.Location(),
+		},
+	}
+}
+
+func rulesToResponse(domainRules []domain.Rule) []Rule {
+	rules := make([]Rule, 0, len(domainRules))
+	for _, domainRule := range domainRules {
+		rules = append(rules, ruleToResponse(domainRule))
+	}
+	return rules
+}
+
+func ruleToResponse(domainRule domain.Rule) Rule {
+	priority := domainRule.Priority()
+	conditions := domainRule.Conditions()
+	actions := domainRule.Actions()
+
+	var weekdays *[]Weekday
+	if len(conditions.Weekdays) != 0 {
+		days := make([]Weekday, 0, len(conditions.Weekdays))
+		for _, day := range conditions.Weekdays {
+			days = append(days, Weekday(strings.ToLower(day.String())))
+		}
+		weekdays = &days
+	}
+
+	return Rule{
+		Id: domainRule.ID(),
+		NewRule: NewRule{
+			Name:     domainRule.Name(),
+			Priority: &priority,
+			Conditions: RuleConditions{
+				Comment:   conditions.Comment,
+				Merchant:  conditions.Merchant,
+				MinAmount: conditions.MinAmount,
+				MaxAmount: conditions.MaxAmount,
+				Currency:  conditions.Currency,
+				Weekdays:  weekdays,
+			},
+			Actions: RuleActions{
+				CategoryId:   actions.CategoryID,
+				Tags:         expenseTagsToResponse(actions.Tags),
+				TripId:       actions.TripID,
+				Reimbursable: flagToResponse(actions.Reimbursable),
+			},
+		},
+	}
+}
+
+func ruleTestToResponse(domainTest domain.RuleTest) RuleTest {
+	matches := make([]Expense, 0, len(domainTest.Matches))
+	for _, domainExpense := range domainTest.Matches {
+		matches = append(matches, expenseWithCategoryToResponse(domainExpense))
+	}
+	return RuleTest{
+		Checked: domainTest.Checked,
+		Matches: matches,
+	}
+}
+
+func addExpenseResultToResponse(domainResult domain.AddExpenseResult) AddExpenseResponse {
+	response := AddExpenseResponse{
+		NewExpenseResponse: NewExpenseResponse{
+			Id: domainResult.ID,
+		},
+	}
+	if len(domainResult.Duplicates) != 0 {
+		duplicates := make([]Expense, 0, len(domainResult.Duplicates))
+		for _, domainExpense := range domainResult.Duplicates {
+			duplicates = append(duplicates, expenseWithCategoryToResponse(domainExpense))
+		}
+		response.Duplicates = &duplicates
+	}
+	if domainResult.DuplicateCheckFailed {
+		response.DuplicateCheckFailed = &domainResult.DuplicateCheckFailed
+	}
+	return response
+}
+
+func duplicateGroupsToResponse(domainGroups []domain.DuplicateGroup) []DuplicateGroup {
+	groups := make([]DuplicateGroup, 0, len(domainGroups))
+	for _, domainGroup := range domainGroups {
+		expenses := make([]Expense, 0, len(domainGroup.Expenses))
+		for _, domainExpense := range domainGroup.Expenses {
+			expenses = append(expenses, expenseWithCategoryToResponse(domainExpense))
+		}
+		groups = append(groups, DuplicateGroup{
+			Id:       domainGroup.ID,
+			Expenses: expenses,
+		})
+	}
+	return groups
+}
+
+func merchantStatsToResponse(domainStats domain.MerchantStats) MerchantStats {
+	totalSpent := make([]Total, 0, len(domainStats.TotalSpent))
+	for _, total := range domainStats.TotalSpent {
+		totalSpent = append(totalSpent, *totalToResponse(&total))
+	}
+	averageTicket := make([]Total, 0, len(domainStats.AverageTicket))
+	for _, total := range domainStats.AverageTicket {
+		averageTicket = append(averageTicket, *totalToResponse(&total))
+	}
+
+	return MerchantStats{
+		Merchant:      merchantToResponse(domainStats.Merchant),
+		TotalSpent:    totalSpent,
+		AverageTicket: averageTicket,
+		VisitCount:    domainStats.VisitCount,
+		LastVisit:     domainStats.LastVisit,
+	}
+}
+
+func tripReportToResponse(domainReport domain.TripReport) TripReport {
+	categoryExpenses := make([]CategoryExpenses, 0, len(domainReport.Categories))
+	for _, category := range domainReport.Categories {
+		categoryExpenses = append(categoryExpenses, categoryExpensesToResponse(*category))
+	}
+
+	return TripReport{
+		Trip:             tripToResponse(domainReport.Trip),
+		CategoryExpenses: categoryExpenses,
+		DateReports:      reportToResponse(domainReport.Days).DateReports,
+		GrandTotal:       grandTotalToResponse(domainReport.GrandTotal),
+		DailyAverage:     *totalToResponse(&domainReport.DailyAverage),
+		Days:             domainReport.Trip.Days(),
+	}
+}
+
+func incomesToResponse(domainIncomes []domain.Income) []Income {
+	incomes := make([]Income, 0, len(domainIncomes))
+	for _, domainIncome := range domainIncomes {
+		incomes = append(incomes, incomeToResponse(domainIncome))
+	}
+	return incomes
+}
+
+func incomeToResponse(domainIncome domain.Income) Income {
+	return Income{
+		Id: domainIncome.ID(),
+		NewIncome: NewIncome{
+			Source:     domainIncome.Source(),
+			CategoryId: domainIncome.CategoryID(),
+			Amount:     domainIncome.Amount(),
+			Currency:   string(domainIncome.Currency()),
+			Date:       domainIncome.Date(),
+			Comment:    domainIncome.Comment(),
+		},
+	}
+}
+
+func cashFlowReportToResponse(domainReport domain.CashFlowReport) CashFlowReport {
+	periods := make([]CashFlowPeriod, 0, len(domainReport.Periods))
+	for _, period := range domainReport.Periods {
+		periods = append(periods, CashFlowPeriod{
+			Date:        period.Date,
+			Income:      grandTotalToResponse(period.Income),
+			Expenses:    grandTotalToResponse(period.Expenses),
+			Net:         *totalToResponse(&period.Net),
+			SavingsRate: savingsRateToResponse(period.SavingsRate),
+		})
+	}
+
+	return CashFlowReport{
+		From:        domainReport.From,
+		To:          domainReport.To,
+		Currency:    string(domainReport.Currency),
+		Periods:     periods,
+		Income:      grandTotalToResponse(domainReport.Income),
+		Expenses:    grandTotalToResponse(domainReport.Expenses),
+		Net:         *totalToResponse(&domainReport.Net),
+		SavingsRate: savingsRateToResponse(domainReport.SavingsRate),
+	}
+}
+
+func savingsRateToResponse(savingsRate *decimal.Decimal) *string {
+	if savingsRate == nil {
+		return nil
+	}
+	rate := savingsRate.StringFixed(2)
+	return &rate
+}
+
+func accountsToResponse(domainAccounts []domain.Account) []Account {
+	accounts := make([]Account, 0, len(domainAccounts))
+	for _, domainAccount := range domainAccounts {
+		accounts = append(accounts, accountToResponse(domainAccount))
+	}
+	return accounts
+}
+
+func accountToResponse(domainAccount domain.Account) Account {
+	return Account{
+		Id: domainAccount.ID(),
+		NewAccount: NewAccount{
+			Name:           domainAccount.Name(),
+			Type:           AccountType(domainAccount.Type()),
+			Currency:       string(domainAccount.Currency()),
+			OpeningBalance: domainAccount.OpeningBalance(),
+		},
+	}
+}
+
+func accountBalanceToResponse(domainBalance domain.AccountBalance) AccountBalance {
+	return AccountBalance{
+		AccountId:      domainBalance.AccountID,
+		Currency:       string(domainBalance.Currency),
+		At:             domainBalance.At,
+		OpeningBalance: domainBalance.OpeningBalance.StringFixed(2),
+		Expenses:       domainBalance.Expenses.StringFixed(2),
+		TransfersIn:    domainBalance.TransfersIn.StringFixed(2),
+		TransfersOut:   domainBalance.TransfersOut.StringFixed(2),
+		Balance:        domainBalance.Balance.StringFixed(2),
+	}
+}
+
+func reconciliationsToResponse(domainReconciliations []domain.Reconciliation) []Reconciliation {
+	reconciliations := make([]Reconciliation, 0, len(domainReconciliations))
+	for _, domainReconciliation := range domainReconciliations {
+		reconciliations = append(reconciliations, reconciliationToResponse(domainReconciliation))
+	}
+	return reconciliations
+}
+
+func reconciliationToResponse(domainReconciliation domain.Reconciliation) Reconciliation {
+	return Reconciliation{
+		Id:         domainReconciliation.ID(),
+		AccountId:  domainReconciliation.AccountID(),
+		Status:     ReconciliationStatus(domainReconciliation.Status()),
+		ExpenseIds: domainReconciliation.ExpenseIDs(),
+		CreatedAt:  domainReconciliation.CreatedAt(),
+		FinishedAt: domainReconciliation.FinishedAt(),
+		NewReconciliation: NewReconciliation{
+			StatementDate:    domainReconciliation.StatementDate(),
+			StatementBalance: domainReconciliation.StatementBalance(),
+		},
+	}
+}
+
+func reconciliationReportToResponse(domainReport domain.ReconciliationReport) ReconciliationReport {
+	items := make([]ReconciliationItem, 0, len(domainReport.Items))
+	for _, domainItem := range domainReport.Items {
+		items = append(items, ReconciliationItem{
+			Expense: expenseToResponse(domainItem.Expense),
+			Ticked:  domainItem.Ticked,
+		})
+	}
+
+	return ReconciliationReport{
+		Reconciliation: reconciliationToResponse(domainReport.Reconciliation),
+		Currency:       string(domainReport.Currency),
+		Items:          items,
+		ClearedBalance: domainReport.ClearedBalance.StringFixed(2),
+		Difference:     domainReport.Difference.StringFixed(2),
+	}
+}
+
+func tagsToResponse(domainTags []domain.Tag) []Tag {
+	tags := make([]Tag, 0, len(domainTags))
+	for _, domainTag := range domainTags {
+		tags = append(tags, Tag{
+			Name:  domainTag.Name,
+			Count: domainTag.Count,
+		})
+	}
+	return tags
+}
+
+func balanceSheetToResponse(domainSheet domain.BalanceSheet) BalanceSheet {
+	balances := make([]Balance, 0, len(domainSheet.Balances))
+	for _, domainBalance := range domainSheet.Balances {
+		balances = append(balances, Balance{
+			User: domainBalance.User,
+			Net:  domainBalance.Net.StringFixed(2),
+		})
+	}
+
+	debts := make([]Debt, 0, len(domainSheet.Debts))
+	for _, domainDebt := range domainSheet.Debts {
+		debts = append(debts, Debt{
+			From:   domainDebt.From,
+			To:     domainDebt.To,
+			Amount: domainDebt.Amount.StringFixed(2),
+		})
+	}
+
+	return BalanceSheet{
+		Currency: string(domainSheet.Currency),
+		Balances: balances,
+		Debts:    debts,
+	}
+}
+
+func settingsToResponse(domainSettings domain.Settings) Settings {
+	reportCurrency := string(domainSettings.ReportCurrency())
+	return Settings{
+		PeriodStartDay: domainSettings.PeriodStartDay(),
+		ReportCurrency: &reportCurrency,
+	}
+}
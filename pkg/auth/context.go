@@ -0,0 +1,15 @@
+package auth
+
+import "github.com/labstack/echo/v4"
+
+// ContextKeyUser is an echo context key that holds authenticated user token details.
+const ContextKeyUser string = "user"
+
+// UserFromContext returns authenticated user name or empty string if request is not authenticated.
+func UserFromContext(echoCtx echo.Context) string {
+	details, ok := echoCtx.Get(ContextKeyUser).(*SignedDetails)
+	if !ok || details == nil {
+		return ""
+	}
+	return details.User
+}
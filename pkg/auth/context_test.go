@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserFromContext_AuthenticatedRequest_ReturnsUser(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	request, _ := http.NewRequest("GET", "/", nil)
+	ctx := echo.New().NewContext(request, httptest.NewRecorder())
+	ctx.Set(ContextKeyUser, &SignedDetails{ID: "id", User: "user"})
+
+	// Act
+	result := UserFromContext(ctx)
+
+	// Assert
+	assert.Equal(t, "user", result, "Should return user name.")
+}
+
+func TestUserFromContext_AnonymousRequest_ReturnsEmptyString(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	request, _ := http.NewRequest("GET", "/", nil)
+	ctx := echo.New().NewContext(request, httptest.NewRecorder())
+
+	// Act
+	result := UserFromContext(ctx)
+
+	// Assert
+	assert.Empty(t, result, "Should return empty string.")
+}
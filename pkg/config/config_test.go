@@ -125,6 +125,19 @@ func TestValidate_ValidConfig_DoesNotThrowError(t *testing.T) {
 			Level:       "debug",
 			AccessToken: "token",
 		},
+		Trash: Trash{
+			RetentionDays:      30,
+			PurgeIntervalHours: 24,
+		},
+		Recurring: Recurring{
+			ScheduleIntervalHours: 1,
+		},
+		Attachments: Attachments{
+			Storage:       "local",
+			Path:          "storage/attachments",
+			MaxSizeMB:     10,
+			ThumbnailSize: 256,
+		},
 	}
 
 	// Act
@@ -2,10 +2,13 @@ package config
 
 // Config struct for application config.
 type Config struct {
-	Server    Server    `yaml:"server" validate:"required"`
-	Logger    Logger    `yaml:"logger" validate:"required"`
-	Database  Database  `yaml:"database" validate:"required"`
-	Telemetry Telemetry `yaml:"telemetry" validate:"required"`
+	Server      Server      `yaml:"server" validate:"required"`
+	Logger      Logger      `yaml:"logger" validate:"required"`
+	Database    Database    `yaml:"database" validate:"required"`
+	Telemetry   Telemetry   `yaml:"telemetry" validate:"required"`
+	Trash       Trash       `yaml:"trash" validate:"required"`
+	Recurring   Recurring   `yaml:"recurring" validate:"required"`
+	Attachments Attachments `yaml:"attachments" validate:"required"`
 }
 
 // Server holds data necessary for server configuration.
@@ -76,3 +79,22 @@ type Jwt struct {
 	TokenExpiration        int    `yaml:"tokenExpiration" validate:"required"`
 	RefreshTokenExpiration int    `yaml:"refreshTokenExpiration" validate:"required"`
 }
+
+// Trash holds trash bin specific configuration.
+type Trash struct {
+	RetentionDays      int `yaml:"retentionDays" validate:"required,gt=0"`
+	PurgeIntervalHours int `yaml:"purgeIntervalHours" validate:"required,gt=0"`
+}
+
+// Recurring holds recurring expenses specific configuration.
+type Recurring struct {
+	ScheduleIntervalHours int `yaml:"scheduleIntervalHours" validate:"required,gt=0"`
+}
+
+// Attachments holds expense attachments specific configuration.
+type Attachments struct {
+	Storage       string `yaml:"storage" validate:"required,oneof=local gridfs"`
+	Path          string `yaml:"path" validate:"required_if=Storage local"`
+	MaxSizeMB     int    `yaml:"maxSizeMB" validate:"required,gt=0"`
+	ThumbnailSize int    `yaml:"thumbnailSize" validate:"required,gt=0"`
+}
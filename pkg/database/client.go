@@ -92,3 +92,22 @@ func (c MongoClient) ListCollections(ctx context.Context) ([]string, error) {
 	}
 	return col, nil
 }
+
+// WithTransaction executes fn within a MongoDB transaction.
+// All operations done with the context passed to fn are committed or aborted together.
+func (c MongoClient) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, sessionErr := c.client.StartSession()
+	if sessionErr != nil {
+		return errors.Wrap(sessionErr, "start session")
+	}
+	defer session.EndSession(ctx)
+
+	_, txErr := session.WithTransaction(ctx, func(sessionCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessionCtx)
+	})
+	if txErr != nil {
+		return errors.Wrap(txErr, "transaction")
+	}
+
+	return nil
+}
@@ -49,3 +49,12 @@ func Unauthorized(message string) ErrResponse {
 		ErrorText:      message,
 	}
 }
+
+// Conflict prepares conflict error.
+func Conflict(message string) ErrResponse {
+	return ErrResponse{
+		HTTPStatusCode: http.StatusConflict,
+		StatusText:     "Conflict",
+		ErrorText:      message,
+	}
+}
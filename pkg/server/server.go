@@ -62,7 +62,7 @@ func registerMiddleware(config config.Server, e *echo.Echo) {
 		Skipper: func(e echo.Context) bool {
 			return strings.Contains(e.Path(), "login")
 		},
-		ContextKey:  "user",
+		ContextKey:  auth.ContextKeyUser,
 		TokenLookup: "header:" + echo.HeaderAuthorization,
 		AuthScheme:  "Bearer",
 		ParseTokenFunc: func(auth string, c echo.Context) (interface{}, error) {
@@ -0,0 +1,154 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AccountRepoInterface is an autogenerated mock type for the AccountRepoInterface type
+type AccountRepoInterface struct {
+	mock.Mock
+}
+
+// DeleteOne provides a mock function with given fields: ctx, id
+func (_m *AccountRepoInterface) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.DeleteResult); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *AccountRepoInterface) GetAll(ctx context.Context) ([]domain.Account, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Account
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Account); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Account)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpenses provides a mock function with given fields: ctx, id, at
+func (_m *AccountRepoInterface) GetExpenses(ctx context.Context, id string, at time.Time) ([]domain.Expense, error) {
+	ret := _m.Called(ctx, id, at)
+
+	var r0 []domain.Expense
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []domain.Expense); ok {
+		r0 = rf(ctx, id, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Expense)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, id, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *AccountRepoInterface) GetOne(ctx context.Context, id string) (*domain.Account, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.Account
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Account); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Account)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, account
+func (_m *AccountRepoInterface) Insert(ctx context.Context, account domain.Account) (*string, error) {
+	ret := _m.Called(ctx, account)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Account) *string); ok {
+		r0 = rf(ctx, account)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Account) error); ok {
+		r1 = rf(ctx, account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, account
+func (_m *AccountRepoInterface) Update(ctx context.Context, account domain.Account) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, account)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Account) *domain.UpdateResult); ok {
+		r0 = rf(ctx, account)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Account) error); ok {
+		r1 = rf(ctx, account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
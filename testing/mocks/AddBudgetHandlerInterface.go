@@ -0,0 +1,38 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	command "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AddBudgetHandlerInterface is an autogenerated mock type for the AddBudgetHandlerInterface type
+type AddBudgetHandlerInterface struct {
+	mock.Mock
+}
+
+// Handle provides a mock function with given fields: ctx, cmd
+func (_m *AddBudgetHandlerInterface) Handle(ctx context.Context, cmd command.AddBudgetCommand) (*string, error) {
+	ret := _m.Called(ctx, cmd)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, command.AddBudgetCommand) *string); ok {
+		r0 = rf(ctx, cmd)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, command.AddBudgetCommand) error); ok {
+		r1 = rf(ctx, cmd)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
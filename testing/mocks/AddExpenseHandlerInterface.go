@@ -6,7 +6,7 @@ import (
 	context "context"
 
 	command "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/command"
-
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -16,15 +16,15 @@ type AddExpenseHandlerInterface struct {
 }
 
 // Handle provides a mock function with given fields: ctx, cmd
-func (_m *AddExpenseHandlerInterface) Handle(ctx context.Context, cmd command.AddExpenseCommand) (*string, error) {
+func (_m *AddExpenseHandlerInterface) Handle(ctx context.Context, cmd command.AddExpenseCommand) (*domain.AddExpenseResult, error) {
 	ret := _m.Called(ctx, cmd)
 
-	var r0 *string
-	if rf, ok := ret.Get(0).(func(context.Context, command.AddExpenseCommand) *string); ok {
+	var r0 *domain.AddExpenseResult
+	if rf, ok := ret.Get(0).(func(context.Context, command.AddExpenseCommand) *domain.AddExpenseResult); ok {
 		r0 = rf(ctx, cmd)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*string)
+			r0 = ret.Get(0).(*domain.AddExpenseResult)
 		}
 	}
 
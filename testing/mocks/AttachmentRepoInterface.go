@@ -0,0 +1,130 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepoInterface is an autogenerated mock type for the AttachmentRepoInterface type
+type AttachmentRepoInterface struct {
+	mock.Mock
+}
+
+// DeleteMany provides a mock function with given fields: ctx, ids
+func (_m *AttachmentRepoInterface) DeleteMany(ctx context.Context, ids []string) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, ids)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *domain.DeleteResult); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, expenseID
+func (_m *AttachmentRepoInterface) GetAll(ctx context.Context, expenseID string) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx, expenseID)
+
+	var r0 []domain.Attachment
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Attachment); ok {
+		r0 = rf(ctx, expenseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, expenseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, expenseID, id
+func (_m *AttachmentRepoInterface) GetOne(ctx context.Context, expenseID string, id string) (*domain.Attachment, error) {
+	ret := _m.Called(ctx, expenseID, id)
+
+	var r0 *domain.Attachment
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.Attachment); ok {
+		r0 = rf(ctx, expenseID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Attachment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, expenseID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrphaned provides a mock function with given fields: ctx
+func (_m *AttachmentRepoInterface) GetOrphaned(ctx context.Context) ([]domain.Attachment, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Attachment
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Attachment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attachment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, attachment
+func (_m *AttachmentRepoInterface) Insert(ctx context.Context, attachment domain.Attachment) (*string, error) {
+	ret := _m.Called(ctx, attachment)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Attachment) *string); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Attachment) error); ok {
+		r1 = rf(ctx, attachment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
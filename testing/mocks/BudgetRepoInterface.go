@@ -0,0 +1,153 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BudgetRepoInterface is an autogenerated mock type for the BudgetRepoInterface type
+type BudgetRepoInterface struct {
+	mock.Mock
+}
+
+// DeleteOne provides a mock function with given fields: ctx, id
+func (_m *BudgetRepoInterface) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.DeleteResult); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *BudgetRepoInterface) GetAll(ctx context.Context) ([]domain.Budget, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Budget
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Budget); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Budget)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByCategory provides a mock function with given fields: ctx, categoryID
+func (_m *BudgetRepoInterface) GetByCategory(ctx context.Context, categoryID string) (*domain.Budget, error) {
+	ret := _m.Called(ctx, categoryID)
+
+	var r0 *domain.Budget
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Budget); ok {
+		r0 = rf(ctx, categoryID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Budget)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *BudgetRepoInterface) GetOne(ctx context.Context, id string) (*domain.Budget, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.Budget
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Budget); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Budget)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, budget
+func (_m *BudgetRepoInterface) Insert(ctx context.Context, budget domain.Budget) (*string, error) {
+	ret := _m.Called(ctx, budget)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Budget) *string); ok {
+		r0 = rf(ctx, budget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Budget) error); ok {
+		r1 = rf(ctx, budget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, budget
+func (_m *BudgetRepoInterface) Update(ctx context.Context, budget domain.Budget) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, budget)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Budget) *domain.UpdateResult); ok {
+		r0 = rf(ctx, budget)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Budget) error); ok {
+		r1 = rf(ctx, budget)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -129,6 +129,29 @@ func (_m *CategoryRepoInterface) Insert(ctx context.Context, category domain.Cat
 	return r0, r1
 }
 
+// SoftDeleteAll provides a mock function with given fields: ctx, filter, deletion
+func (_m *CategoryRepoInterface) SoftDeleteAll(ctx context.Context, filter domain.CategoryFilter, deletion domain.SoftDeletion) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, filter, deletion)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.CategoryFilter, domain.SoftDeletion) *domain.DeleteResult); ok {
+		r0 = rf(ctx, filter, deletion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.CategoryFilter, domain.SoftDeletion) error); ok {
+		r1 = rf(ctx, filter, deletion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, category
 func (_m *CategoryRepoInterface) Update(ctx context.Context, category domain.Category) (*domain.UpdateResult, error) {
 	ret := _m.Called(ctx, category)
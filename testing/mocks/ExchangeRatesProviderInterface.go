@@ -0,0 +1,38 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ExchangeRatesProviderInterface is an autogenerated mock type for the ExchangeRatesProviderInterface type
+type ExchangeRatesProviderInterface struct {
+	mock.Mock
+}
+
+// ExchangeRates provides a mock function with given fields: ctx, dateRange
+func (_m *ExchangeRatesProviderInterface) ExchangeRates(ctx context.Context, dateRange domain.DateRange) ([]domain.ExchangeRates, error) {
+	ret := _m.Called(ctx, dateRange)
+
+	var r0 []domain.ExchangeRates
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DateRange) []domain.ExchangeRates); ok {
+		r0 = rf(ctx, dateRange)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ExchangeRates)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DateRange) error); ok {
+		r1 = rf(ctx, dateRange)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -14,6 +14,89 @@ type ExpenseCategoryRepoInterface struct {
 	mock.Mock
 }
 
+// EnsureInbox provides a mock function with given fields: ctx
+func (_m *ExpenseCategoryRepoInterface) EnsureInbox(ctx context.Context) (*domain.Category, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *domain.Category
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.Category); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *ExpenseCategoryRepoInterface) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *ExpenseCategoryRepoInterface) GetAll(ctx context.Context) ([]domain.Category, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Category
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Category); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Category)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInbox provides a mock function with given fields: ctx
+func (_m *ExpenseCategoryRepoInterface) GetInbox(ctx context.Context) (*domain.Category, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *domain.Category
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.Category); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOne provides a mock function with given fields: ctx, id
 func (_m *ExpenseCategoryRepoInterface) GetOne(ctx context.Context, id string) (*domain.Category, error) {
 	ret := _m.Called(ctx, id)
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
 	mock "github.com/stretchr/testify/mock"
@@ -37,6 +38,89 @@ func (_m *ExpenseRepoInterface) DeleteAll(ctx context.Context) (*domain.DeleteRe
 	return r0, r1
 }
 
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *ExpenseRepoInterface) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAll provides a mock function with given fields: ctx, filter
+func (_m *ExpenseRepoInterface) GetAll(ctx context.Context, filter domain.ExpenseListFilter) (*domain.ExpensePage, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 *domain.ExpensePage
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ExpenseListFilter) *domain.ExpensePage); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ExpensePage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ExpenseListFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExternalIDs provides a mock function with given fields: ctx, externalIDs
+func (_m *ExpenseRepoInterface) GetExternalIDs(ctx context.Context, externalIDs []string) ([]string, error) {
+	ret := _m.Called(ctx, externalIDs)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = rf(ctx, externalIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, externalIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *ExpenseRepoInterface) GetOne(ctx context.Context, id string) (*domain.Expense, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.Expense
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Expense); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Expense)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Insert provides a mock function with given fields: ctx, expense
 func (_m *ExpenseRepoInterface) Insert(ctx context.Context, expense domain.Expense) (*string, error) {
 	ret := _m.Called(ctx, expense)
@@ -59,3 +143,95 @@ func (_m *ExpenseRepoInterface) Insert(ctx context.Context, expense domain.Expen
 
 	return r0, r1
 }
+
+// InsertMany provides a mock function with given fields: ctx, expenses
+func (_m *ExpenseRepoInterface) InsertMany(ctx context.Context, expenses []domain.Expense) ([]string, error) {
+	ret := _m.Called(ctx, expenses)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Expense) []string); ok {
+		r0 = rf(ctx, expenses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []domain.Expense) error); ok {
+		r1 = rf(ctx, expenses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReassignCategories provides a mock function with given fields: ctx, fromCategoryID, assignments, updatedBy, updatedAt
+func (_m *ExpenseRepoInterface) ReassignCategories(ctx context.Context, fromCategoryID string, assignments []domain.CategoryAssignment, updatedBy string, updatedAt time.Time) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, fromCategoryID, assignments, updatedBy, updatedAt)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, string, []domain.CategoryAssignment, string, time.Time) *domain.UpdateResult); ok {
+		r0 = rf(ctx, fromCategoryID, assignments, updatedBy, updatedAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []domain.CategoryAssignment, string, time.Time) error); ok {
+		r1 = rf(ctx, fromCategoryID, assignments, updatedBy, updatedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteOne provides a mock function with given fields: ctx, id, deletedBy
+func (_m *ExpenseRepoInterface) SoftDeleteOne(ctx context.Context, id string, deletedBy string) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, id, deletedBy)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.DeleteResult); ok {
+		r0 = rf(ctx, id, deletedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, deletedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, expense
+func (_m *ExpenseRepoInterface) Update(ctx context.Context, expense domain.Expense) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, expense)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Expense) *domain.UpdateResult); ok {
+		r0 = rf(ctx, expense)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Expense) error); ok {
+		r1 = rf(ctx, expense)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,36 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	query "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ExportExpensesHandlerInterface is an autogenerated mock type for the ExportExpensesHandlerInterface type
+type ExportExpensesHandlerInterface struct {
+	mock.Mock
+}
+
+// Handle provides a mock function with given fields: ctx, _a1
+func (_m *ExportExpensesHandlerInterface) Handle(ctx context.Context, _a1 query.ExportExpensesQuery) (int, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, query.ExportExpensesQuery) int); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, query.ExportExpensesQuery) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,39 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	query "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FindAccountsHandlerInterface is an autogenerated mock type for the FindAccountsHandlerInterface type
+type FindAccountsHandlerInterface struct {
+	mock.Mock
+}
+
+// Handle provides a mock function with given fields: ctx, _a1
+func (_m *FindAccountsHandlerInterface) Handle(ctx context.Context, _a1 query.FindAccountsQuery) ([]domain.Account, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 []domain.Account
+	if rf, ok := ret.Get(0).(func(context.Context, query.FindAccountsQuery) []domain.Account); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Account)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, query.FindAccountsQuery) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
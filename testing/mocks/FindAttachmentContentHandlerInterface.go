@@ -0,0 +1,38 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	query "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FindAttachmentContentHandlerInterface is an autogenerated mock type for the FindAttachmentContentHandlerInterface type
+type FindAttachmentContentHandlerInterface struct {
+	mock.Mock
+}
+
+// Handle provides a mock function with given fields: ctx, _a1
+func (_m *FindAttachmentContentHandlerInterface) Handle(ctx context.Context, _a1 query.FindAttachmentContentQuery) (*query.AttachmentContent, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *query.AttachmentContent
+	if rf, ok := ret.Get(0).(func(context.Context, query.FindAttachmentContentQuery) *query.AttachmentContent); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*query.AttachmentContent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, query.FindAttachmentContentQuery) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
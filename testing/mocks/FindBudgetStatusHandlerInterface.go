@@ -0,0 +1,39 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	query "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FindBudgetStatusHandlerInterface is an autogenerated mock type for the FindBudgetStatusHandlerInterface type
+type FindBudgetStatusHandlerInterface struct {
+	mock.Mock
+}
+
+// Handle provides a mock function with given fields: ctx, _a1
+func (_m *FindBudgetStatusHandlerInterface) Handle(ctx context.Context, _a1 query.FindBudgetStatusQuery) ([]domain.BudgetStatus, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 []domain.BudgetStatus
+	if rf, ok := ret.Get(0).(func(context.Context, query.FindBudgetStatusQuery) []domain.BudgetStatus); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.BudgetStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, query.FindBudgetStatusQuery) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
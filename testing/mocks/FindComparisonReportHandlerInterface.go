@@ -0,0 +1,39 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	query "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/app/query"
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FindComparisonReportHandlerInterface is an autogenerated mock type for the FindComparisonReportHandlerInterface type
+type FindComparisonReportHandlerInterface struct {
+	mock.Mock
+}
+
+// Handle provides a mock function with given fields: ctx, _a1
+func (_m *FindComparisonReportHandlerInterface) Handle(ctx context.Context, _a1 query.FindComparisonReportQuery) (*domain.ComparisonReport, error) {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 *domain.ComparisonReport
+	if rf, ok := ret.Get(0).(func(context.Context, query.FindComparisonReportQuery) *domain.ComparisonReport); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ComparisonReport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, query.FindComparisonReportQuery) error); ok {
+		r1 = rf(ctx, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ImportProfileRepoInterface is an autogenerated mock type for the ImportProfileRepoInterface type
+type ImportProfileRepoInterface struct {
+	mock.Mock
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *ImportProfileRepoInterface) GetAll(ctx context.Context) ([]domain.ImportProfile, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.ImportProfile
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.ImportProfile); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ImportProfile)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *ImportProfileRepoInterface) GetOne(ctx context.Context, id string) (*domain.ImportProfile, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.ImportProfile
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.ImportProfile); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ImportProfile)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, profile
+func (_m *ImportProfileRepoInterface) Insert(ctx context.Context, profile domain.ImportProfile) (*string, error) {
+	ret := _m.Called(ctx, profile)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ImportProfile) *string); ok {
+		r0 = rf(ctx, profile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ImportProfile) error); ok {
+		r1 = rf(ctx, profile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
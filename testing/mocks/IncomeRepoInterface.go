@@ -0,0 +1,130 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IncomeRepoInterface is an autogenerated mock type for the IncomeRepoInterface type
+type IncomeRepoInterface struct {
+	mock.Mock
+}
+
+// DeleteOne provides a mock function with given fields: ctx, id
+func (_m *IncomeRepoInterface) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.DeleteResult); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx, dateRange
+func (_m *IncomeRepoInterface) GetAll(ctx context.Context, dateRange domain.DateRange) ([]domain.Income, error) {
+	ret := _m.Called(ctx, dateRange)
+
+	var r0 []domain.Income
+	if rf, ok := ret.Get(0).(func(context.Context, domain.DateRange) []domain.Income); ok {
+		r0 = rf(ctx, dateRange)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Income)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.DateRange) error); ok {
+		r1 = rf(ctx, dateRange)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *IncomeRepoInterface) GetOne(ctx context.Context, id string) (*domain.Income, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.Income
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Income); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Income)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, income
+func (_m *IncomeRepoInterface) Insert(ctx context.Context, income domain.Income) (*string, error) {
+	ret := _m.Called(ctx, income)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Income) *string); ok {
+		r0 = rf(ctx, income)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Income) error); ok {
+		r1 = rf(ctx, income)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, income
+func (_m *IncomeRepoInterface) Update(ctx context.Context, income domain.Income) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, income)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Income) *domain.UpdateResult); ok {
+		r0 = rf(ctx, income)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Income) error); ok {
+		r1 = rf(ctx, income)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,176 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MerchantRepoInterface is an autogenerated mock type for the MerchantRepoInterface type
+type MerchantRepoInterface struct {
+	mock.Mock
+}
+
+// DeleteOne provides a mock function with given fields: ctx, id
+func (_m *MerchantRepoInterface) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.DeleteResult); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *MerchantRepoInterface) GetAll(ctx context.Context) ([]domain.Merchant, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Merchant
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Merchant); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Merchant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExpenses provides a mock function with given fields: ctx, id
+func (_m *MerchantRepoInterface) GetExpenses(ctx context.Context, id string) ([]domain.Expense, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 []domain.Expense
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Expense); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Expense)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *MerchantRepoInterface) GetOne(ctx context.Context, id string) (*domain.Merchant, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.Merchant
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Merchant); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Merchant)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, merchant
+func (_m *MerchantRepoInterface) Insert(ctx context.Context, merchant domain.Merchant) (*string, error) {
+	ret := _m.Called(ctx, merchant)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Merchant) *string); ok {
+		r0 = rf(ctx, merchant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Merchant) error); ok {
+		r1 = rf(ctx, merchant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Merge provides a mock function with given fields: ctx, merchant, sourceIDs
+func (_m *MerchantRepoInterface) Merge(ctx context.Context, merchant domain.Merchant, sourceIDs []string) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, merchant, sourceIDs)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Merchant, []string) *domain.UpdateResult); ok {
+		r0 = rf(ctx, merchant, sourceIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Merchant, []string) error); ok {
+		r1 = rf(ctx, merchant, sourceIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, merchant
+func (_m *MerchantRepoInterface) Update(ctx context.Context, merchant domain.Merchant) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, merchant)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Merchant) *domain.UpdateResult); ok {
+		r0 = rf(ctx, merchant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Merchant) error); ok {
+		r1 = rf(ctx, merchant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
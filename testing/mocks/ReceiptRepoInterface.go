@@ -0,0 +1,61 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReceiptRepoInterface is an autogenerated mock type for the ReceiptRepoInterface type
+type ReceiptRepoInterface struct {
+	mock.Mock
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *ReceiptRepoInterface) GetOne(ctx context.Context, id string) (*domain.Receipt, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.Receipt
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Receipt); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Receipt)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, receipt
+func (_m *ReceiptRepoInterface) Insert(ctx context.Context, receipt domain.Receipt) (*string, error) {
+	ret := _m.Called(ctx, receipt)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Receipt) *string); ok {
+		r0 = rf(ctx, receipt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Receipt) error); ok {
+		r1 = rf(ctx, receipt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,176 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReconciliationRepoInterface is an autogenerated mock type for the ReconciliationRepoInterface type
+type ReconciliationRepoInterface struct {
+	mock.Mock
+}
+
+// Finish provides a mock function with given fields: ctx, reconciliation
+func (_m *ReconciliationRepoInterface) Finish(ctx context.Context, reconciliation domain.Reconciliation) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, reconciliation)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Reconciliation) *domain.UpdateResult); ok {
+		r0 = rf(ctx, reconciliation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Reconciliation) error); ok {
+		r1 = rf(ctx, reconciliation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetByAccount provides a mock function with given fields: ctx, accountID
+func (_m *ReconciliationRepoInterface) GetByAccount(ctx context.Context, accountID string) ([]domain.Reconciliation, error) {
+	ret := _m.Called(ctx, accountID)
+
+	var r0 []domain.Reconciliation
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Reconciliation); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Reconciliation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *ReconciliationRepoInterface) GetOne(ctx context.Context, id string) (*domain.Reconciliation, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.Reconciliation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Reconciliation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reconciliation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpen provides a mock function with given fields: ctx, accountID
+func (_m *ReconciliationRepoInterface) GetOpen(ctx context.Context, accountID string) (*domain.Reconciliation, error) {
+	ret := _m.Called(ctx, accountID)
+
+	var r0 *domain.Reconciliation
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Reconciliation); ok {
+		r0 = rf(ctx, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Reconciliation)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, reconciliation
+func (_m *ReconciliationRepoInterface) Insert(ctx context.Context, reconciliation domain.Reconciliation) (*string, error) {
+	ret := _m.Called(ctx, reconciliation)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Reconciliation) *string); ok {
+		r0 = rf(ctx, reconciliation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Reconciliation) error); ok {
+		r1 = rf(ctx, reconciliation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnlockExpense provides a mock function with given fields: ctx, expenseID
+func (_m *ReconciliationRepoInterface) UnlockExpense(ctx context.Context, expenseID string) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, expenseID)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.UpdateResult); ok {
+		r0 = rf(ctx, expenseID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, expenseID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateExpenses provides a mock function with given fields: ctx, reconciliation
+func (_m *ReconciliationRepoInterface) UpdateExpenses(ctx context.Context, reconciliation domain.Reconciliation) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, reconciliation)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Reconciliation) *domain.UpdateResult); ok {
+		r0 = rf(ctx, reconciliation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Reconciliation) error); ok {
+		r1 = rf(ctx, reconciliation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,145 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RecurringExpenseRepoInterface is an autogenerated mock type for the RecurringExpenseRepoInterface type
+type RecurringExpenseRepoInterface struct {
+	mock.Mock
+}
+
+// DeleteOne provides a mock function with given fields: ctx, id
+func (_m *RecurringExpenseRepoInterface) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.DeleteResult); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *RecurringExpenseRepoInterface) GetAll(ctx context.Context) ([]domain.RecurringExpense, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.RecurringExpense
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.RecurringExpense); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.RecurringExpense)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *RecurringExpenseRepoInterface) GetOne(ctx context.Context, id string) (*domain.RecurringExpense, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.RecurringExpense
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.RecurringExpense); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RecurringExpense)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, recurringExpense
+func (_m *RecurringExpenseRepoInterface) Insert(ctx context.Context, recurringExpense domain.RecurringExpense) (*string, error) {
+	ret := _m.Called(ctx, recurringExpense)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RecurringExpense) *string); ok {
+		r0 = rf(ctx, recurringExpense)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.RecurringExpense) error); ok {
+		r1 = rf(ctx, recurringExpense)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, recurringExpense
+func (_m *RecurringExpenseRepoInterface) Update(ctx context.Context, recurringExpense domain.RecurringExpense) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, recurringExpense)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.RecurringExpense) *domain.UpdateResult); ok {
+		r0 = rf(ctx, recurringExpense)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.RecurringExpense) error); ok {
+		r1 = rf(ctx, recurringExpense)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateMaterializedUntil provides a mock function with given fields: ctx, id, materializedUntil
+func (_m *RecurringExpenseRepoInterface) UpdateMaterializedUntil(ctx context.Context, id string, materializedUntil time.Time) error {
+	ret := _m.Called(ctx, id, materializedUntil)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, id, materializedUntil)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -36,3 +36,49 @@ func (_m *ReportRepoInterface) GetAll(ctx context.Context, filter domain.Expense
 
 	return r0, r1
 }
+
+// GetByTrip provides a mock function with given fields: ctx, tripID
+func (_m *ReportRepoInterface) GetByTrip(ctx context.Context, tripID string) ([]domain.Expense, error) {
+	ret := _m.Called(ctx, tripID)
+
+	var r0 []domain.Expense
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Expense); ok {
+		r0 = rf(ctx, tripID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Expense)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tripID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShared provides a mock function with given fields: ctx
+func (_m *ReportRepoInterface) GetShared(ctx context.Context) ([]domain.Expense, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Expense
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Expense); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Expense)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,52 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchRepoInterface is an autogenerated mock type for the SearchRepoInterface type
+type SearchRepoInterface struct {
+	mock.Mock
+}
+
+// EnsureIndexes provides a mock function with given fields: ctx
+func (_m *SearchRepoInterface) EnsureIndexes(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Search provides a mock function with given fields: ctx, filter
+func (_m *SearchRepoInterface) Search(ctx context.Context, filter domain.SearchFilter) (*domain.SearchResult, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 *domain.SearchResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.SearchFilter) *domain.SearchResult); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SearchResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.SearchFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
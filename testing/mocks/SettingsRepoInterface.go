@@ -0,0 +1,61 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SettingsRepoInterface is an autogenerated mock type for the SettingsRepoInterface type
+type SettingsRepoInterface struct {
+	mock.Mock
+}
+
+// GetOne provides a mock function with given fields: ctx, user
+func (_m *SettingsRepoInterface) GetOne(ctx context.Context, user string) (*domain.Settings, error) {
+	ret := _m.Called(ctx, user)
+
+	var r0 *domain.Settings
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Settings); ok {
+		r0 = rf(ctx, user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Settings)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, settings
+func (_m *SettingsRepoInterface) Update(ctx context.Context, settings domain.Settings) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, settings)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Settings) *domain.UpdateResult); ok {
+		r0 = rf(ctx, settings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Settings) error); ok {
+		r1 = rf(ctx, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
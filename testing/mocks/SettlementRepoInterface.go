@@ -0,0 +1,61 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SettlementRepoInterface is an autogenerated mock type for the SettlementRepoInterface type
+type SettlementRepoInterface struct {
+	mock.Mock
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *SettlementRepoInterface) GetAll(ctx context.Context) ([]domain.Settlement, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Settlement
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Settlement); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Settlement)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, settlement
+func (_m *SettlementRepoInterface) Insert(ctx context.Context, settlement domain.Settlement) (*string, error) {
+	ret := _m.Called(ctx, settlement)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Settlement) *string); ok {
+		r0 = rf(ctx, settlement)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Settlement) error); ok {
+		r1 = rf(ctx, settlement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagRepoInterface is an autogenerated mock type for the TagRepoInterface type
+type TagRepoInterface struct {
+	mock.Mock
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *TagRepoInterface) GetAll(ctx context.Context) ([]domain.Tag, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Tag
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Tag); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Tag)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Replace provides a mock function with given fields: ctx, tags, replacement
+func (_m *TagRepoInterface) Replace(ctx context.Context, tags []string, replacement string) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, tags, replacement)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) *domain.UpdateResult); ok {
+		r0 = rf(ctx, tags, replacement)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string) error); ok {
+		r1 = rf(ctx, tags, replacement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,33 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ThumbnailGeneratorInterface is an autogenerated mock type for the ThumbnailGeneratorInterface type
+type ThumbnailGeneratorInterface struct {
+	mock.Mock
+}
+
+// Generate provides a mock function with given fields: content
+func (_m *ThumbnailGeneratorInterface) Generate(content []byte) ([]byte, error) {
+	ret := _m.Called(content)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
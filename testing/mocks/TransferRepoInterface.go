@@ -0,0 +1,62 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	time "time"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TransferRepoInterface is an autogenerated mock type for the TransferRepoInterface type
+type TransferRepoInterface struct {
+	mock.Mock
+}
+
+// GetByAccount provides a mock function with given fields: ctx, accountID, at
+func (_m *TransferRepoInterface) GetByAccount(ctx context.Context, accountID string, at time.Time) ([]domain.Transfer, error) {
+	ret := _m.Called(ctx, accountID, at)
+
+	var r0 []domain.Transfer
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []domain.Transfer); ok {
+		r0 = rf(ctx, accountID, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Transfer)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, accountID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, transfer
+func (_m *TransferRepoInterface) Insert(ctx context.Context, transfer domain.Transfer) (*string, error) {
+	ret := _m.Called(ctx, transfer)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Transfer) *string); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Transfer) error); ok {
+		r1 = rf(ctx, transfer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
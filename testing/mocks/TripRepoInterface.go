@@ -0,0 +1,176 @@
+// Code generated by mockery 2.9.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	domain "dev.azure.com/filimonovga/our-expenses/our-expenses-server/internal/expenses/domain"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TripRepoInterface is an autogenerated mock type for the TripRepoInterface type
+type TripRepoInterface struct {
+	mock.Mock
+}
+
+// DeleteOne provides a mock function with given fields: ctx, id
+func (_m *TripRepoInterface) DeleteOne(ctx context.Context, id string) (*domain.DeleteResult, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.DeleteResult
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.DeleteResult); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DeleteResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAll provides a mock function with given fields: ctx
+func (_m *TripRepoInterface) GetAll(ctx context.Context) ([]domain.Trip, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.Trip
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.Trip); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Trip)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLegacyTrips provides a mock function with given fields: ctx
+func (_m *TripRepoInterface) GetLegacyTrips(ctx context.Context) ([]domain.LegacyTripUsage, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []domain.LegacyTripUsage
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.LegacyTripUsage); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LegacyTripUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOne provides a mock function with given fields: ctx, id
+func (_m *TripRepoInterface) GetOne(ctx context.Context, id string) (*domain.Trip, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *domain.Trip
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Trip); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Trip)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, trip
+func (_m *TripRepoInterface) Insert(ctx context.Context, trip domain.Trip) (*string, error) {
+	ret := _m.Called(ctx, trip)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Trip) *string); ok {
+		r0 = rf(ctx, trip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Trip) error); ok {
+		r1 = rf(ctx, trip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkLegacyTrips provides a mock function with given fields: ctx, names, tripID
+func (_m *TripRepoInterface) LinkLegacyTrips(ctx context.Context, names []string, tripID string) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, names, tripID)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, []string, string) *domain.UpdateResult); ok {
+		r0 = rf(ctx, names, tripID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string, string) error); ok {
+		r1 = rf(ctx, names, tripID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, trip
+func (_m *TripRepoInterface) Update(ctx context.Context, trip domain.Trip) (*domain.UpdateResult, error) {
+	ret := _m.Called(ctx, trip)
+
+	var r0 *domain.UpdateResult
+	if rf, ok := ret.Get(0).(func(context.Context, domain.Trip) *domain.UpdateResult); ok {
+		r0 = rf(ctx, trip)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.UpdateResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, domain.Trip) error); ok {
+		r1 = rf(ctx, trip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}